@@ -10,6 +10,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/application"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/util/archivecrypt"
 )
 
 func (mw *Middleware) AccountCreate(cctx context.Context, req *pb.RpcAccountCreateRequest) *pb.RpcAccountCreateResponse {
@@ -174,6 +175,8 @@ func (mw *Middleware) AccountRecoverFromLegacyExport(cctx context.Context, req *
 	code := mapErrorCode(err,
 		errToCode(application.ErrAccountMismatch, pb.RpcAccountRecoverFromLegacyExportResponseError_DIFFERENT_ACCOUNT),
 		errToCode(application.ErrBadInput, pb.RpcAccountRecoverFromLegacyExportResponseError_BAD_INPUT),
+		errToCode(archivecrypt.ErrPasswordRequired, pb.RpcAccountRecoverFromLegacyExportResponseError_WRONG_PASSWORD),
+		errToCode(archivecrypt.ErrWrongPassword, pb.RpcAccountRecoverFromLegacyExportResponseError_WRONG_PASSWORD),
 	)
 	return &pb.RpcAccountRecoverFromLegacyExportResponse{
 		AccountId:       resp.AccountId,
@@ -36,10 +36,12 @@ import (
 	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/block"
 	"github.com/anyproto/anytype-heart/core/block/backlinks"
+	"github.com/anyproto/anytype-heart/core/block/backup"
 	"github.com/anyproto/anytype-heart/core/block/bookmark"
 	decorator "github.com/anyproto/anytype-heart/core/block/bookmark/bookmarkimporter"
 	"github.com/anyproto/anytype-heart/core/block/chats"
 	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/comments"
 	"github.com/anyproto/anytype-heart/core/block/dataviewservice"
 	"github.com/anyproto/anytype-heart/core/block/detailservice"
 	"github.com/anyproto/anytype-heart/core/block/editor"
@@ -81,6 +83,7 @@ import (
 	paymentscache "github.com/anyproto/anytype-heart/core/payments/cache"
 	"github.com/anyproto/anytype-heart/core/peerstatus"
 	"github.com/anyproto/anytype-heart/core/recordsbatcher"
+	"github.com/anyproto/anytype-heart/core/reminder"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/core/spaceview"
 	"github.com/anyproto/anytype-heart/core/subscription"
@@ -258,6 +261,7 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(fileoffloader.New()).
 		Register(fileacl.New()).
 		Register(chats.New()).
+		Register(comments.New()).
 		Register(source.New()).
 		Register(spacefactory.New()).
 		Register(space.New()).
@@ -311,6 +315,8 @@ func Bootstrap(a *app.App, components ...app.Component) {
 		Register(identity.New(30*time.Second, 10*time.Second)).
 		Register(templateservice.New()).
 		Register(notifications.New(time.Second * 10)).
+		Register(reminder.New()).
+		Register(backup.New()).
 		Register(paymentserviceclient.New()).
 		Register(nameservice.New()).
 		Register(nameserviceclient.New()).
@@ -24,6 +24,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	"github.com/anyproto/anytype-heart/space"
 	"github.com/anyproto/anytype-heart/util/anyerror"
+	"github.com/anyproto/anytype-heart/util/archivecrypt"
 	"github.com/anyproto/anytype-heart/util/builtinobjects"
 	"github.com/anyproto/anytype-heart/util/constant"
 	"github.com/anyproto/anytype-heart/util/metricsid"
@@ -33,8 +34,8 @@ var (
 	ErrAccountMismatch = errors.New("backup was made from different account")
 )
 
-func getUserProfile(req *pb.RpcAccountRecoverFromLegacyExportRequest) (*pb.Profile, error) {
-	archive, err := zip.OpenReader(req.Path)
+func getUserProfile(archivePath string) (*pb.Profile, error) {
+	archive, err := zip.OpenReader(archivePath)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +69,13 @@ func (s *Service) RecoverFromLegacy(req *pb.RpcAccountRecoverFromLegacyExportReq
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
-	profile, err := getUserProfile(req)
+	archivePath, cleanup, err := archivecrypt.DecryptedPath(req.Path, os.TempDir(), req.Password)
+	if err != nil {
+		return RecoverFromLegacyResponse{}, err
+	}
+	defer cleanup()
+
+	profile, err := getUserProfile(archivePath)
 	if err != nil {
 		return RecoverFromLegacyResponse{}, anyerror.CleanupError(err)
 	}
@@ -96,7 +103,7 @@ func (s *Service) RecoverFromLegacy(req *pb.RpcAccountRecoverFromLegacyExportReq
 			return RecoverFromLegacyResponse{}, walletErr
 		}
 	}
-	cfg, err := s.getBootstrapConfig(req)
+	cfg, err := s.getBootstrapConfig(archivePath)
 	if err != nil {
 		return RecoverFromLegacyResponse{}, err
 	}
@@ -146,11 +153,12 @@ func (s *Service) startApp(cfg *config.Config, derivationResult crypto.Derivatio
 	return nil
 }
 
-func (s *Service) getBootstrapConfig(req *pb.RpcAccountRecoverFromLegacyExportRequest) (*config.Config, error) {
-	archive, err := zip.OpenReader(req.Path)
+func (s *Service) getBootstrapConfig(archivePath string) (*config.Config, error) {
+	archive, err := zip.OpenReader(archivePath)
 	if err != nil {
 		return nil, err
 	}
+	defer archive.Close()
 	oldCfg, err := extractConfig(archive)
 	if err != nil {
 		return nil, fmt.Errorf("failed to extract config: %w", err)
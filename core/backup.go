@@ -0,0 +1,74 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/backup"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) BackupSetSettings(cctx context.Context, req *pb.RpcBackupSetSettingsRequest) *pb.RpcBackupSetSettingsResponse {
+	backupService := mustService[backup.Service](mw)
+
+	err := backupService.SetSettings(req.Settings)
+	code := mapErrorCode(err,
+		errToCode(backup.ErrBadInput, pb.RpcBackupSetSettingsResponseError_BAD_INPUT),
+	)
+	return &pb.RpcBackupSetSettingsResponse{
+		Error: &pb.RpcBackupSetSettingsResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) BackupGetSettings(cctx context.Context, req *pb.RpcBackupGetSettingsRequest) *pb.RpcBackupGetSettingsResponse {
+	backupService := mustService[backup.Service](mw)
+
+	settings, err := backupService.GetSettings(req.SpaceId)
+	code := mapErrorCode[pb.RpcBackupGetSettingsResponseErrorCode](err)
+	return &pb.RpcBackupGetSettingsResponse{
+		Settings: settings,
+		Error: &pb.RpcBackupGetSettingsResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) BackupRun(cctx context.Context, req *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse {
+	backupService := mustService[backup.Service](mw)
+
+	err := backupService.Backup(req.SpaceId, req.Full)
+	code := mapErrorCode(err,
+		errToCode(backup.ErrNotConfigured, pb.RpcBackupRunResponseError_NOT_CONFIGURED),
+		errToCode(backup.ErrAlreadyRunning, pb.RpcBackupRunResponseError_ALREADY_RUNNING),
+	)
+	return &pb.RpcBackupRunResponse{
+		Error: &pb.RpcBackupRunResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) BackupRestore(cctx context.Context, req *pb.RpcBackupRestoreRequest) *pb.RpcBackupRestoreResponse {
+	backupService := mustService[backup.Service](mw)
+
+	var at time.Time
+	if req.Time != 0 {
+		at = time.Unix(req.Time, 0)
+	}
+	err := backupService.Restore(req.SpaceId, req.Path, at)
+	code := mapErrorCode(err,
+		errToCode(backup.ErrBadInput, pb.RpcBackupRestoreResponseError_BAD_INPUT),
+		errToCode(backup.ErrNoBackups, pb.RpcBackupRestoreResponseError_NO_BACKUPS),
+	)
+	return &pb.RpcBackupRestoreResponse{
+		Error: &pb.RpcBackupRestoreResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
@@ -87,7 +87,7 @@ func (mw *Middleware) ObjectOpen(cctx context.Context, req *pb.RpcObjectOpenRequ
 		ObjectID: req.ObjectId,
 	}
 	err := mw.doBlockService(func(bs *block.Service) (err error) {
-		obj, err = bs.OpenBlock(ctx, id, req.IncludeRelationsAsDependentObjects)
+		obj, err = bs.OpenBlock(ctx, id, req.IncludeRelationsAsDependentObjects, req.FocusBlockId)
 		return err
 	})
 	code := mapErrorCode(err,
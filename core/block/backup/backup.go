@@ -0,0 +1,483 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/dgraph-io/badger/v4"
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/export"
+	importer "github.com/anyproto/anytype-heart/core/block/import"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
+	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+)
+
+const CName = "core.backup"
+
+const (
+	checkInterval = time.Minute
+
+	defaultMaxIncrements = 6
+	defaultKeepChains    = 2
+
+	settingsPrefix = "/backup/settings/"
+)
+
+var log = logging.Logger(CName)
+
+var (
+	ErrBadInput       = errors.New("bad input")
+	ErrNotConfigured  = errors.New("backups of the space are not configured")
+	ErrAlreadyRunning = errors.New("backup of the space is already running")
+	ErrNoBackups      = errors.New("no backups to restore")
+)
+
+// Service writes protobuf exports of spaces to the local directories by schedule. The first backup of the chain
+// contains the whole space, the next ones contain only objects changed since the previous backup of the chain
+type Service interface {
+	SetSettings(settings *model.BackupSettings) error
+	GetSettings(spaceId string) (*model.BackupSettings, error)
+	// Backup starts the backup of the space in the background
+	Backup(spaceId string, full bool) error
+	// Restore starts the import of the chain of backups from the directory to the space in the background
+	Restore(spaceId, dir string, at time.Time) error
+	app.ComponentRunnable
+}
+
+type service struct {
+	objectStore    objectstore.ObjectStore
+	sbtProvider    typeprovider.SmartBlockTypeProvider
+	exporter       export.Export
+	importer       importer.Importer
+	processService process.Service
+	notifications  notifications.Notifications
+	db             *badger.DB
+
+	now       func() time.Time
+	lock      sync.Mutex
+	running   map[string]struct{}
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+}
+
+func New() Service {
+	return &service{
+		now:     time.Now,
+		running: map[string]struct{}{},
+	}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.sbtProvider = app.MustComponent[typeprovider.SmartBlockTypeProvider](a)
+	s.exporter = app.MustComponent[export.Export](a)
+	s.importer = app.MustComponent[importer.Importer](a)
+	s.processService = app.MustComponent[process.Service](a)
+	s.notifications = app.MustComponent[notifications.Notifications](a)
+	db, err := app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	s.db = db
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	s.ctx, s.ctxCancel = context.WithCancel(context.Background())
+	go s.runScheduler()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.ctxCancel != nil {
+		s.ctxCancel()
+	}
+	return nil
+}
+
+// SetSettings stores backup settings of the space, settings with the empty path disable backups of the space
+func (s *service) SetSettings(settings *model.BackupSettings) error {
+	if settings.GetSpaceId() == "" {
+		return fmt.Errorf("%w: space id is empty", ErrBadInput)
+	}
+	key := []byte(settingsPrefix + settings.SpaceId)
+	if settings.Path == "" {
+		err := badgerhelper.DeleteValue(s.db, key)
+		if err != nil && !badgerhelper.IsNotFound(err) {
+			return err
+		}
+		return nil
+	}
+	if !filepath.IsAbs(settings.Path) {
+		return fmt.Errorf("%w: backup path should be absolute", ErrBadInput)
+	}
+	if settings.Interval < 0 || settings.MaxIncrements < 0 || settings.KeepChains < 0 {
+		return fmt.Errorf("%w: negative settings", ErrBadInput)
+	}
+	return badgerhelper.SetValue(s.db, key, settings)
+}
+
+// GetSettings returns empty settings if backups of the space are not configured
+func (s *service) GetSettings(spaceId string) (*model.BackupSettings, error) {
+	settings, err := badgerhelper.GetValue(s.db, []byte(settingsPrefix+spaceId), unmarshalSettings)
+	if badgerhelper.IsNotFound(err) {
+		return &model.BackupSettings{SpaceId: spaceId}, nil
+	}
+	return settings, err
+}
+
+func (s *service) Backup(spaceId string, full bool) error {
+	settings, err := s.GetSettings(spaceId)
+	if err != nil {
+		return err
+	}
+	if settings.Path == "" {
+		return ErrNotConfigured
+	}
+	if !s.tryStart(spaceId) {
+		return ErrAlreadyRunning
+	}
+	go func() {
+		defer s.finish(spaceId)
+		s.backupWithNotification(settings, full)
+	}()
+	return nil
+}
+
+func (s *service) Restore(spaceId, dir string, at time.Time) error {
+	if spaceId == "" || dir == "" {
+		return ErrBadInput
+	}
+	m, err := readManifest(dir)
+	if err != nil {
+		return err
+	}
+	paths, removed := m.chainAt(dir, at)
+	if len(paths) == 0 {
+		return ErrNoBackups
+	}
+	s.importer.Import(context.Background(), &importer.ImportRequest{
+		RpcObjectImportRequest: &pb.RpcObjectImportRequest{
+			SpaceId: spaceId,
+			Params: &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{
+				Path:         paths,
+				NoCollection: true,
+				// objects removed after they were written to the chain are not brought back
+				ExcludeObjectIds: removed,
+			}},
+			// objects restored from the previous backups are updated instead of creating duplicates
+			UpdateExistingObjects: true,
+			Type:                  model.Import_Pb,
+			Mode:                  pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		},
+		Origin:           objectorigin.Import(model.Import_Pb),
+		SendNotification: true,
+	})
+	return nil
+}
+
+func (s *service) runScheduler() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		s.runScheduledBackups()
+	}
+}
+
+func (s *service) runScheduledBackups() {
+	allSettings, err := s.listSettings()
+	if err != nil {
+		log.Errorf("list backup settings: %v", err)
+		return
+	}
+	for _, settings := range allSettings {
+		if settings.Interval == 0 || !s.isBackupDue(settings) || !s.tryStart(settings.SpaceId) {
+			continue
+		}
+		// backups run one by one, so they don't compete for the disk
+		s.backupWithNotification(settings, false)
+		s.finish(settings.SpaceId)
+		if s.ctx.Err() != nil {
+			return
+		}
+	}
+}
+
+func (s *service) isBackupDue(settings *model.BackupSettings) bool {
+	m, err := readManifest(spaceDir(settings))
+	if err != nil {
+		log.With("spaceId", settings.SpaceId).Errorf("read backup manifest: %v", err)
+		return false
+	}
+	interval := time.Duration(settings.Interval) * time.Second
+	return !s.now().Before(m.lastCheckTime().Add(interval))
+}
+
+func (s *service) tryStart(spaceId string) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.running[spaceId]; ok {
+		return false
+	}
+	s.running[spaceId] = struct{}{}
+	return true
+}
+
+func (s *service) finish(spaceId string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.running, spaceId)
+}
+
+func (s *service) backupWithNotification(settings *model.BackupSettings, full bool) {
+	info, err := s.backup(settings, full)
+	if err != nil {
+		log.With("spaceId", settings.SpaceId).Errorf("backup: %v", err)
+	}
+	if info == nil && err == nil {
+		return
+	}
+	s.sendNotification(settings, info, err)
+}
+
+// backup returns nil info when nothing has changed since the previous backup, the time of the check is saved
+// to the manifest, so the space is not checked again until the interval passes
+func (s *service) backup(settings *model.BackupSettings, full bool) (info *backupInfo, err error) {
+	dir := spaceDir(settings)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	m, err := readManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	m.SpaceId = settings.SpaceId
+	heads, err := s.objectHeads(settings.SpaceId, settings.IncludeArchived)
+	if err != nil {
+		return nil, fmt.Errorf("get heads: %w", err)
+	}
+	incremental := !full && !m.needsFullBackup(maxIncrements(settings))
+	var objectIds, removed []string
+	if incremental {
+		objectIds = changedObjects(m.Heads, heads)
+		removed = m.removedObjects(heads)
+		if len(objectIds) == 0 && len(removed) == 0 {
+			m.LastCheck = s.now().Unix()
+			if err = m.write(dir); err != nil {
+				return nil, fmt.Errorf("write manifest: %w", err)
+			}
+			return nil, nil
+		}
+	}
+
+	queue := s.processService.NewQueue(pb.ModelProcess{
+		Id:      bson.NewObjectId().Hex(),
+		SpaceId: settings.SpaceId,
+		Message: &pb.ModelProcessMessageOfBackup{Backup: &pb.ModelProcessBackup{}},
+	}, 4)
+	queue.SetMessage("prepare")
+	if err = queue.Start(); err != nil {
+		return nil, err
+	}
+	defer func() {
+		queue.Stop(err)
+	}()
+
+	now := s.now()
+	path, writtenIds, err := s.exporter.ExportBackup(s.ctx, pb.RpcObjectListExportRequest{
+		SpaceId:         settings.SpaceId,
+		Path:            dir,
+		ObjectIds:       objectIds,
+		Zip:             true,
+		IncludeFiles:    true,
+		IncludeArchived: settings.IncludeArchived,
+	}, queue)
+	if err != nil {
+		return nil, fmt.Errorf("export: %w", err)
+	}
+	if path == "" {
+		return nil, fmt.Errorf("export is canceled")
+	}
+	fileName := uniqueFileName(dir, backupFileName(now, incremental))
+	if err = os.Rename(path, filepath.Join(dir, fileName)); err != nil {
+		os.Remove(path)
+		return nil, err
+	}
+
+	info = &backupInfo{File: fileName, Time: now.Unix(), Incremental: incremental, Objects: len(writtenIds), Removed: removed}
+	m.add(info, writtenHeads(heads, writtenIds))
+	m.removeOldChains(dir, keepChains(settings))
+	if err = m.write(dir); err != nil {
+		return nil, fmt.Errorf("write manifest: %w", err)
+	}
+	return info, nil
+}
+
+// objectHeads returns hashes of heads of the objects written to the backup, the hash changes with every change
+// of the object, including changes received from other devices
+func (s *service) objectHeads(spaceId string, includeArchived bool) (map[string]string, error) {
+	spaceIndex := s.objectStore.SpaceIndex(spaceId)
+	var filters []database.FilterRequest
+	if includeArchived {
+		filters = append(filters, database.FilterRequest{
+			RelationKey: bundle.RelationKeyIsArchived,
+			Condition:   model.BlockContentDataviewFilter_None,
+		})
+	}
+	ids, _, err := spaceIndex.QueryObjectIds(database.Query{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	heads := make(map[string]string, len(ids))
+	for _, id := range ids {
+		sbType, err := s.sbtProvider.Type(spaceId, id)
+		if err != nil || !export.IsProtobufType(sbType) {
+			continue
+		}
+		hash, err := spaceIndex.GetLastIndexedHeadsHash(s.ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		heads[id] = hash
+	}
+	return heads, nil
+}
+
+func (s *service) sendNotification(settings *model.BackupSettings, info *backupInfo, err error) {
+	payload := &model.NotificationBackup{
+		SpaceId:   settings.SpaceId,
+		SpaceName: s.objectStore.GetSpaceName(settings.SpaceId),
+	}
+	if err != nil {
+		payload.ErrorCode = model.NotificationBackup_UNKNOWN_ERROR
+	}
+	if info != nil {
+		payload.Path = filepath.Join(spaceDir(settings), info.File)
+		payload.IsIncremental = info.Incremental
+		payload.ObjectsCount = int64(info.Objects)
+	}
+	notificationErr := s.notifications.CreateAndSend(&model.Notification{
+		Id:      uuid.New().String(),
+		Status:  model.Notification_Created,
+		IsLocal: true,
+		Payload: &model.NotificationPayloadOfBackup{Backup: payload},
+		Space:   settings.SpaceId,
+	})
+	if notificationErr != nil {
+		log.Errorf("failed to send notification: %v", notificationErr)
+	}
+}
+
+func (s *service) listSettings() ([]*model.BackupSettings, error) {
+	var allSettings []*model.BackupSettings
+	err := s.db.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{
+			PrefetchValues: true,
+			Prefix:         []byte(settingsPrefix),
+		})
+		defer it.Close()
+		for it.Rewind(); it.Valid(); it.Next() {
+			err := it.Item().Value(func(val []byte) error {
+				settings, err := unmarshalSettings(val)
+				if err != nil {
+					return err
+				}
+				allSettings = append(allSettings, settings)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return allSettings, err
+}
+
+func unmarshalSettings(raw []byte) (*model.BackupSettings, error) {
+	settings := &model.BackupSettings{}
+	if err := settings.Unmarshal(raw); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// writtenHeads returns heads of the objects written to the backup
+func writtenHeads(heads map[string]string, writtenIds []string) map[string]string {
+	res := make(map[string]string, len(writtenIds))
+	for _, id := range writtenIds {
+		if hash, ok := heads[id]; ok {
+			res[id] = hash
+		}
+	}
+	return res
+}
+
+// changedObjects returns ids of new objects and objects whose heads have changed since the previous backup
+func changedObjects(previousHeads, heads map[string]string) []string {
+	var ids []string
+	for id, hash := range heads {
+		if previousHash, ok := previousHeads[id]; !ok || previousHash != hash {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func uniqueFileName(dir, name string) string {
+	ext := filepath.Ext(name)
+	base := name[:len(name)-len(ext)]
+	for i := 1; ; i++ {
+		if _, err := os.Stat(filepath.Join(dir, name)); errors.Is(err, os.ErrNotExist) {
+			return name
+		}
+		name = base + "_" + strconv.Itoa(i) + ext
+	}
+}
+
+// spaceDir is the directory of backups of the space, the manifest is stored there
+func spaceDir(settings *model.BackupSettings) string {
+	return filepath.Join(settings.Path, settings.SpaceId)
+}
+
+func maxIncrements(settings *model.BackupSettings) int {
+	if settings.MaxIncrements == 0 {
+		return defaultMaxIncrements
+	}
+	return int(settings.MaxIncrements)
+}
+
+func keepChains(settings *model.BackupSettings) int {
+	if settings.KeepChains == 0 {
+		return defaultKeepChains
+	}
+	return int(settings.KeepChains)
+}
@@ -0,0 +1,239 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/event/mock_event"
+	"github.com/anyproto/anytype-heart/core/notifications/mock_notifications"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider/mock_typeprovider"
+	"github.com/anyproto/anytype-heart/tests/testutil"
+)
+
+const spaceId = "space1"
+
+type exporterStub struct {
+	requests []pb.RpcObjectListExportRequest
+}
+
+func (e *exporterStub) Init(*app.App) error { return nil }
+
+func (e *exporterStub) Name() string { return "export" }
+
+func (e *exporterStub) Export(context.Context, pb.RpcObjectListExportRequest) (string, int, error) {
+	return "", 0, errors.New("not implemented")
+}
+
+func (e *exporterStub) ExportBackup(_ context.Context, req pb.RpcObjectListExportRequest, _ process.Queue) (string, []string, error) {
+	e.requests = append(e.requests, req)
+	path := filepath.Join(req.Path, "export.zip")
+	if err := os.WriteFile(path, []byte("export"), 0600); err != nil {
+		return "", nil, err
+	}
+	return path, req.ObjectIds, nil
+}
+
+type fixture struct {
+	*service
+	store         *objectstore.StoreFixture
+	exporter      *exporterStub
+	notifications *mock_notifications.MockNotifications
+}
+
+func newFixture(t *testing.T) *fixture {
+	store := objectstore.NewStoreFixture(t)
+	sbtProvider := mock_typeprovider.NewMockSmartBlockTypeProvider(t)
+	sbtProvider.EXPECT().Type(mock.Anything, mock.Anything).Return(smartblock.SmartBlockTypePage, nil).Maybe()
+	notifications := mock_notifications.NewMockNotifications(t)
+
+	a := &app.App{}
+	sender := mock_event.NewMockSender(t)
+	sender.EXPECT().Broadcast(mock.Anything).Return().Maybe()
+	a.Register(testutil.PrepareMock(context.Background(), a, sender))
+	processService := process.New()
+	require.NoError(t, processService.Init(a))
+
+	ds, err := datastore.NewInMemory()
+	require.NoError(t, err)
+	db, err := ds.LocalStorage()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = ds.Close(context.Background())
+	})
+
+	exporter := &exporterStub{}
+	s := New().(*service)
+	s.objectStore = store
+	s.sbtProvider = sbtProvider
+	s.exporter = exporter
+	s.processService = processService
+	s.notifications = notifications
+	s.db = db
+	s.ctx, s.ctxCancel = context.WithCancel(context.Background())
+	t.Cleanup(s.ctxCancel)
+	return &fixture{service: s, store: store, exporter: exporter, notifications: notifications}
+}
+
+func (fx *fixture) setHeads(t *testing.T, heads map[string]string) {
+	spaceIndex := fx.store.SpaceIndex(spaceId)
+	for id, hash := range heads {
+		fx.store.AddObjects(t, spaceId, []objectstore.TestObject{{
+			bundle.RelationKeyId:      domain.String(id),
+			bundle.RelationKeySpaceId: domain.String(spaceId),
+		}})
+		require.NoError(t, spaceIndex.SaveLastIndexedHeadsHash(context.Background(), id, hash))
+	}
+}
+
+func TestService_runScheduledBackups(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	newSettings := func(t *testing.T) *model.BackupSettings {
+		return &model.BackupSettings{SpaceId: spaceId, Path: t.TempDir(), Interval: int64(time.Hour / time.Second)}
+	}
+
+	t.Run("backup is written when the interval passes", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		settings := newSettings(t)
+		require.NoError(t, fx.SetSettings(settings))
+		fx.setHeads(t, map[string]string{"id1": "1"})
+		fx.now = func() time.Time { return start }
+		var payload *model.NotificationBackup
+		fx.notifications.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(n *model.Notification) error {
+			payload = n.GetBackup()
+			return nil
+		}).Once()
+
+		// when
+		fx.runScheduledBackups()
+		fx.now = func() time.Time { return start.Add(30 * time.Minute) }
+		fx.runScheduledBackups()
+
+		// then
+		require.Len(t, fx.exporter.requests, 1)
+		assert.Empty(t, fx.exporter.requests[0].ObjectIds)
+		require.NotNil(t, payload)
+		assert.Equal(t, filepath.Join(spaceDir(settings), backupFileName(start, false)), payload.Path)
+		assert.False(t, payload.IsIncremental)
+		assert.Equal(t, model.NotificationBackup_NULL, payload.ErrorCode)
+		assert.FileExists(t, payload.Path)
+	})
+
+	t.Run("unchanged space is not checked again until the interval passes", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		settings := newSettings(t)
+		require.NoError(t, fx.SetSettings(settings))
+		heads := map[string]string{"id1": "1"}
+		fx.setHeads(t, heads)
+		m, err := readManifest(spaceDir(settings))
+		require.NoError(t, err)
+		m.add(&backupInfo{File: backupFileName(start, false), Time: start.Unix()}, heads)
+		require.NoError(t, os.MkdirAll(spaceDir(settings), 0700))
+		require.NoError(t, m.write(spaceDir(settings)))
+		checkTime := start.Add(time.Hour)
+		fx.now = func() time.Time { return checkTime }
+
+		// when
+		fx.runScheduledBackups()
+
+		// then
+		assert.Empty(t, fx.exporter.requests)
+		m, err = readManifest(spaceDir(settings))
+		require.NoError(t, err)
+		assert.Equal(t, checkTime.Unix(), m.LastCheck)
+		fx.now = func() time.Time { return checkTime.Add(time.Minute) }
+		assert.False(t, fx.isBackupDue(settings))
+		fx.now = func() time.Time { return checkTime.Add(time.Hour) }
+		assert.True(t, fx.isBackupDue(settings))
+	})
+
+	t.Run("changed objects are written to the increment", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		settings := newSettings(t)
+		require.NoError(t, fx.SetSettings(settings))
+		fx.setHeads(t, map[string]string{"id1": "1", "id2": "1"})
+		fx.now = func() time.Time { return start }
+		fx.notifications.EXPECT().CreateAndSend(mock.Anything).Return(nil).Once()
+		fx.runScheduledBackups()
+		fx.setHeads(t, map[string]string{"id2": "2"})
+		fx.now = func() time.Time { return start.Add(time.Hour) }
+		var payload *model.NotificationBackup
+		fx.notifications.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(n *model.Notification) error {
+			payload = n.GetBackup()
+			return nil
+		}).Once()
+
+		// when
+		fx.runScheduledBackups()
+
+		// then
+		require.Len(t, fx.exporter.requests, 2)
+		assert.Equal(t, []string{"id2"}, fx.exporter.requests[1].ObjectIds)
+		require.NotNil(t, payload)
+		assert.True(t, payload.IsIncremental)
+		assert.Equal(t, int64(1), payload.ObjectsCount)
+	})
+}
+
+func TestService_backupWithNotification(t *testing.T) {
+	t.Run("notification is not sent when nothing is backed up", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		settings := &model.BackupSettings{SpaceId: spaceId, Path: t.TempDir()}
+		heads := map[string]string{"id1": "1"}
+		fx.setHeads(t, heads)
+		require.NoError(t, os.MkdirAll(spaceDir(settings), 0700))
+		m, err := readManifest(spaceDir(settings))
+		require.NoError(t, err)
+		m.add(&backupInfo{File: "backup.zip", Time: time.Now().Unix()}, heads)
+		require.NoError(t, m.write(spaceDir(settings)))
+
+		// when
+		fx.backupWithNotification(settings, false)
+
+		// then
+		assert.Empty(t, fx.exporter.requests)
+		fx.notifications.AssertNotCalled(t, "CreateAndSend", mock.Anything)
+	})
+
+	t.Run("error is reported by the notification", func(t *testing.T) {
+		// given
+		fx := newFixture(t)
+		file := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(file, nil, 0600))
+		// the backup directory can't be created inside the file
+		settings := &model.BackupSettings{SpaceId: spaceId, Path: file}
+		var payload *model.NotificationBackup
+		fx.notifications.EXPECT().CreateAndSend(mock.Anything).RunAndReturn(func(n *model.Notification) error {
+			payload = n.GetBackup()
+			return nil
+		}).Once()
+
+		// when
+		fx.backupWithNotification(settings, false)
+
+		// then
+		require.NotNil(t, payload)
+		assert.Equal(t, model.NotificationBackup_UNKNOWN_ERROR, payload.ErrorCode)
+		assert.Empty(t, payload.Path)
+	})
+}
@@ -0,0 +1,184 @@
+package backup
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	manifestFile = "manifest.json"
+	timeLayout   = "20060102T150405"
+)
+
+// manifest describes backups written to the backup directory of the space. Each chain starts with the full
+// backup followed by increments containing objects changed since the previous backup of the chain
+type manifest struct {
+	SpaceId string   `json:"spaceId"`
+	Chains  []*chain `json:"chains"`
+	// Heads contains hashes of the heads of objects written to the last chain, object id -> heads hash.
+	// Objects failed to be written are not added, so they are written by the next backup
+	Heads map[string]string `json:"heads"`
+	// LastCheck is the time of the last scheduled check which found nothing to back up
+	LastCheck int64 `json:"lastCheck,omitempty"`
+}
+
+type chain struct {
+	Backups []*backupInfo `json:"backups"`
+}
+
+type backupInfo struct {
+	File        string `json:"file"`
+	Time        int64  `json:"time"`
+	Incremental bool   `json:"incremental"`
+	Objects     int    `json:"objects"`
+	// Removed contains ids of objects written to the previous backups of the chain and deleted since then,
+	// or archived when archived objects are not backed up. The list includes removals of the previous backups
+	Removed []string `json:"removed,omitempty"`
+}
+
+func readManifest(dir string) (*manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return &manifest{Heads: map[string]string{}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	m := &manifest{}
+	if err = json.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("unmarshal manifest: %w", err)
+	}
+	if m.Heads == nil {
+		m.Heads = map[string]string{}
+	}
+	return m, nil
+}
+
+// write replaces the manifest atomically, so the manifest is never left half-written
+func (m *manifest) write(dir string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := filepath.Join(dir, manifestFile+".tmp")
+	if err = os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, filepath.Join(dir, manifestFile))
+}
+
+func (m *manifest) lastChain() *chain {
+	if len(m.Chains) == 0 {
+		return nil
+	}
+	return m.Chains[len(m.Chains)-1]
+}
+
+func (m *manifest) lastBackupTime() time.Time {
+	last := m.lastBackup()
+	if last == nil {
+		return time.Time{}
+	}
+	return time.Unix(last.Time, 0)
+}
+
+// lastCheckTime returns the time of the last backup or the last check which found nothing to back up
+func (m *manifest) lastCheckTime() time.Time {
+	last := m.lastBackupTime()
+	if checked := time.Unix(m.LastCheck, 0); m.LastCheck != 0 && checked.After(last) {
+		return checked
+	}
+	return last
+}
+
+// needsFullBackup tells if the next backup starts the new chain
+func (m *manifest) needsFullBackup(maxIncrements int) bool {
+	last := m.lastChain()
+	// the first backup of the chain is the full one
+	return last == nil || len(last.Backups) == 0 || len(last.Backups)-1 >= maxIncrements
+}
+
+func (m *manifest) lastBackup() *backupInfo {
+	last := m.lastChain()
+	if last == nil || len(last.Backups) == 0 {
+		return nil
+	}
+	return last.Backups[len(last.Backups)-1]
+}
+
+// removedObjects returns ids of objects written to the last chain which are missing from the current heads
+func (m *manifest) removedObjects(heads map[string]string) []string {
+	var removed []string
+	if last := m.lastBackup(); last != nil {
+		for _, id := range last.Removed {
+			if _, ok := heads[id]; !ok {
+				removed = append(removed, id)
+			}
+		}
+	}
+	for id := range m.Heads {
+		if _, ok := heads[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+	return removed
+}
+
+// add adds the backup to the manifest, writtenHeads contains heads of the objects written to the backup
+func (m *manifest) add(info *backupInfo, writtenHeads map[string]string) {
+	if !info.Incremental {
+		m.Chains = append(m.Chains, &chain{})
+		m.Heads = map[string]string{}
+	}
+	last := m.lastChain()
+	last.Backups = append(last.Backups, info)
+	for _, id := range info.Removed {
+		delete(m.Heads, id)
+	}
+	for id, hash := range writtenHeads {
+		m.Heads[id] = hash
+	}
+}
+
+// removeOldChains removes files of the chains exceeding the limit, starting from the oldest one
+func (m *manifest) removeOldChains(dir string, keepChains int) {
+	for len(m.Chains) > keepChains {
+		for _, info := range m.Chains[0].Backups {
+			if err := os.Remove(filepath.Join(dir, info.File)); err != nil && !errors.Is(err, os.ErrNotExist) {
+				log.With("file", info.File).Warnf("remove old backup: %v", err)
+			}
+		}
+		m.Chains = m.Chains[1:]
+	}
+}
+
+// chainAt returns paths of the backups to restore the state of the space at the given time, starting from
+// the full backup, and ids of objects removed by that time, which should not be restored. The zero time means
+// the latest state
+func (m *manifest) chainAt(dir string, at time.Time) (paths, removed []string) {
+	for i := len(m.Chains) - 1; i >= 0; i-- {
+		for _, info := range m.Chains[i].Backups {
+			if !at.IsZero() && time.Unix(info.Time, 0).After(at) {
+				break
+			}
+			paths = append(paths, filepath.Join(dir, info.File))
+			removed = info.Removed
+		}
+		if len(paths) > 0 {
+			return paths, removed
+		}
+	}
+	return nil, nil
+}
+
+func backupFileName(t time.Time, incremental bool) string {
+	kind := "full"
+	if incremental {
+		kind = "incremental"
+	}
+	return fmt.Sprintf("backup_%s_%s.zip", t.Format(timeLayout), kind)
+}
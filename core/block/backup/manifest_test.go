@@ -0,0 +1,150 @@
+package backup
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func addBackup(t *testing.T, m *manifest, dir string, at time.Time, incremental bool, heads map[string]string) *backupInfo {
+	info := &backupInfo{File: backupFileName(at, incremental), Time: at.Unix(), Incremental: incremental}
+	if incremental {
+		info.Removed = m.removedObjects(heads)
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(dir, info.File), []byte("backup"), 0600))
+	m.add(info, heads)
+	return info
+}
+
+func TestManifest(t *testing.T) {
+	start := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	hour := func(n int) time.Time {
+		return start.Add(time.Duration(n) * time.Hour)
+	}
+
+	t.Run("read missing manifest", func(t *testing.T) {
+		m, err := readManifest(t.TempDir())
+		require.NoError(t, err)
+		assert.True(t, m.needsFullBackup(defaultMaxIncrements))
+		assert.True(t, m.lastBackupTime().IsZero())
+		paths, _ := m.chainAt("", time.Time{})
+		assert.Empty(t, paths)
+	})
+
+	t.Run("chains", func(t *testing.T) {
+		dir := t.TempDir()
+		m, err := readManifest(dir)
+		require.NoError(t, err)
+
+		addBackup(t, m, dir, hour(0), false, map[string]string{"a": "1"})
+		assert.False(t, m.needsFullBackup(2))
+		addBackup(t, m, dir, hour(1), true, map[string]string{"a": "2"})
+		addBackup(t, m, dir, hour(2), true, map[string]string{"a": "3"})
+		assert.True(t, m.needsFullBackup(2))
+		addBackup(t, m, dir, hour(3), false, map[string]string{"a": "4"})
+		assert.Len(t, m.Chains, 2)
+		assert.Equal(t, hour(3).Unix(), m.lastBackupTime().Unix())
+
+		require.NoError(t, m.write(dir))
+		m, err = readManifest(dir)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"a": "4"}, m.Heads)
+
+		paths, _ := m.chainAt(dir, time.Time{})
+		assert.Equal(t, []string{filepath.Join(dir, backupFileName(hour(3), false))}, paths)
+		paths, _ = m.chainAt(dir, hour(1).Add(time.Minute))
+		assert.Equal(t, []string{
+			filepath.Join(dir, backupFileName(hour(0), false)),
+			filepath.Join(dir, backupFileName(hour(1), true)),
+		}, paths)
+		paths, _ = m.chainAt(dir, hour(-1))
+		assert.Empty(t, paths)
+	})
+
+	t.Run("removed objects", func(t *testing.T) {
+		dir := t.TempDir()
+		m, err := readManifest(dir)
+		require.NoError(t, err)
+
+		addBackup(t, m, dir, hour(0), false, map[string]string{"a": "1", "b": "1", "c": "1"})
+		info := addBackup(t, m, dir, hour(1), true, map[string]string{"a": "2"})
+		assert.ElementsMatch(t, []string{"b", "c"}, info.Removed)
+		assert.Equal(t, map[string]string{"a": "2"}, m.Heads)
+
+		// the object restored from the bin is written again
+		info = addBackup(t, m, dir, hour(2), true, map[string]string{"a": "2", "b": "1"})
+		assert.Equal(t, []string{"c"}, info.Removed)
+		assert.Equal(t, map[string]string{"a": "2", "b": "1"}, m.Heads)
+
+		_, removed := m.chainAt(dir, hour(0))
+		assert.Empty(t, removed)
+		_, removed = m.chainAt(dir, hour(1))
+		assert.ElementsMatch(t, []string{"b", "c"}, removed)
+		_, removed = m.chainAt(dir, time.Time{})
+		assert.Equal(t, []string{"c"}, removed)
+
+		addBackup(t, m, dir, hour(3), false, map[string]string{"a": "2"})
+		_, removed = m.chainAt(dir, time.Time{})
+		assert.Empty(t, removed)
+	})
+
+	t.Run("objects failed to be written are written by the next backup", func(t *testing.T) {
+		m, err := readManifest(t.TempDir())
+		require.NoError(t, err)
+		heads := map[string]string{"a": "1", "failed": "1"}
+		m.add(&backupInfo{}, writtenHeads(heads, []string{"a"}))
+
+		assert.Equal(t, []string{"failed"}, changedObjects(m.Heads, heads))
+		assert.Empty(t, m.removedObjects(heads))
+	})
+
+	t.Run("remove old chains", func(t *testing.T) {
+		dir := t.TempDir()
+		m, err := readManifest(dir)
+		require.NoError(t, err)
+		addBackup(t, m, dir, hour(0), false, nil)
+		addBackup(t, m, dir, hour(1), true, nil)
+		addBackup(t, m, dir, hour(2), false, nil)
+		addBackup(t, m, dir, hour(3), false, nil)
+
+		m.removeOldChains(dir, 2)
+
+		require.Len(t, m.Chains, 2)
+		assert.Equal(t, hour(2).Unix(), m.Chains[0].Backups[0].Time)
+		assert.NoFileExists(t, filepath.Join(dir, backupFileName(hour(0), false)))
+		assert.NoFileExists(t, filepath.Join(dir, backupFileName(hour(1), true)))
+		assert.FileExists(t, filepath.Join(dir, backupFileName(hour(2), false)))
+	})
+
+	t.Run("last check time", func(t *testing.T) {
+		dir := t.TempDir()
+		m, err := readManifest(dir)
+		require.NoError(t, err)
+		addBackup(t, m, dir, hour(1), false, nil)
+		assert.Equal(t, hour(1).Unix(), m.lastCheckTime().Unix())
+
+		m.LastCheck = hour(2).Unix()
+		assert.Equal(t, hour(2).Unix(), m.lastCheckTime().Unix())
+
+		addBackup(t, m, dir, hour(3), true, nil)
+		assert.Equal(t, hour(3).Unix(), m.lastCheckTime().Unix())
+	})
+}
+
+func TestChangedObjects(t *testing.T) {
+	previous := map[string]string{"same": "1", "changed": "1", "removed": "1"}
+	heads := map[string]string{"same": "1", "changed": "2", "new": "1"}
+
+	assert.ElementsMatch(t, []string{"changed", "new"}, changedObjects(previous, heads))
+}
+
+func TestUniqueFileName(t *testing.T) {
+	dir := t.TempDir()
+	assert.Equal(t, "backup.zip", uniqueFileName(dir, "backup.zip"))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "backup.zip"), nil, 0600))
+	assert.Equal(t, "backup_1.zip", uniqueFileName(dir, "backup.zip"))
+}
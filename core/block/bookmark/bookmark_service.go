@@ -17,6 +17,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/import/web/parsers"
 	"github.com/anyproto/anytype-heart/core/block/simple/bookmark"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/domain/objectorigin"
@@ -312,6 +313,16 @@ func (s *service) ContentUpdaters(spaceID string, url string, parseBlock bool) (
 					return
 				}
 				c.Blocks = blocks
+				// blocks are taken from the whole page, only the author and the date are read as the article
+				article, err := parsers.ParseArticleMetadata(body, url)
+				if err != nil {
+					log.Errorf("parse article metadata: %s", err)
+					return
+				}
+				c.Author = article.Author
+				if !article.Published.IsZero() {
+					c.PublishedDate = article.Published.Unix()
+				}
 			}
 		}()
 	}
@@ -0,0 +1,371 @@
+package comments
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/chatobject"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/core/session"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+const CName = "core.block.comments"
+
+var log = logging.Logger("anytype-mw-comments")
+
+var ErrBadAnchor = errors.New("comment anchor is not a text range of the block")
+
+// Service manages comment threads of objects. Comments are stored in the discussion object derived from
+// the commented object, and every thread is anchored to the text range marked by the Comment mark,
+// so anchors move together with the text when it is edited
+type Service interface {
+	AddComment(ctx context.Context, sessionCtx session.Context, objectId string, req AddCommentRequest) (string, error)
+	EditComment(ctx context.Context, objectId string, commentId string, message *model.ChatMessageMessageContent) error
+	DeleteComment(ctx context.Context, sessionCtx session.Context, objectId string, commentId string) error
+	SetResolved(ctx context.Context, objectId string, threadId string, resolved bool) error
+	ListComments(ctx context.Context, objectId string, includeResolved bool) ([]*model.Comment, error)
+
+	chatobject.MentionNotifier
+	app.Component
+}
+
+type AddCommentRequest struct {
+	// ThreadId is empty to start the new thread anchored to Range of the block
+	ThreadId string
+	BlockId  string
+	Range    *model.Range
+	Message  *model.ChatMessageMessageContent
+}
+
+var _ Service = (*service)(nil)
+
+type service struct {
+	objectGetter  cache.ObjectGetter
+	objectCreator objectcreator.Service
+	spaceService  space.Service
+	notifications notifications.Notifications
+	objectStore   objectstore.ObjectStore
+}
+
+func New() Service {
+	return &service{}
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	s.objectCreator = app.MustComponent[objectcreator.Service](a)
+	s.spaceService = app.MustComponent[space.Service](a)
+	s.notifications = app.MustComponent[notifications.Notifications](a)
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	return nil
+}
+
+func (s *service) AddComment(ctx context.Context, sessionCtx session.Context, objectId string, req AddCommentRequest) (string, error) {
+	comment := &model.Comment{
+		ThreadId: req.ThreadId,
+		Message:  req.Message,
+	}
+	var spaceId string
+	err := cache.Do(s.objectGetter, objectId, func(sb smartblock.SmartBlock) error {
+		spaceId = sb.SpaceID()
+		if req.ThreadId != "" {
+			return nil
+		}
+		anchor, err := newAnchor(sb, req.BlockId, req.Range)
+		if err != nil {
+			return err
+		}
+		comment.Anchor = anchor
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	discussionId, err := s.discussionId(ctx, spaceId, objectId, true)
+	if err != nil {
+		return "", err
+	}
+	var commentId string
+	err = cache.Do(s.objectGetter, discussionId, func(sb chatobject.StoreObject) error {
+		if req.ThreadId != "" {
+			if thread, err := sb.GetComment(ctx, req.ThreadId); err != nil {
+				return err
+			} else if thread.ThreadId != "" {
+				return fmt.Errorf("comment %s is not a thread", req.ThreadId)
+			}
+		}
+		commentId, err = sb.AddComment(ctx, sessionCtx, comment)
+		return err
+	})
+	if err != nil || req.ThreadId != "" {
+		return commentId, err
+	}
+
+	updated, err := s.updateTextBlocks(sessionCtx, objectId, func(b *model.Block) bool {
+		return b.Id == req.BlockId
+	}, func(tb text.Block) {
+		marks := tb.Model().GetText().GetMarks().GetMarks()
+		marks = append(marks, &model.BlockContentTextMark{
+			Range: &model.Range{From: req.Range.From, To: req.Range.To},
+			Type:  model.BlockContentTextMark_Comment,
+			Param: commentId,
+		})
+		tb.SetText(tb.GetText(), &model.BlockContentTextMarks{Marks: marks})
+	})
+	if err == nil && updated == 0 {
+		// the block is removed while the comment is added
+		err = ErrBadAnchor
+	}
+	if err != nil {
+		// the thread without the mark can't be shown at the text, so it is removed
+		rollbackErr := cache.Do(s.objectGetter, discussionId, func(sb chatobject.StoreObject) error {
+			return sb.DeleteComment(ctx, sessionCtx, commentId)
+		})
+		if rollbackErr != nil {
+			log.Errorf("delete comment %s without anchor: %v", commentId, rollbackErr)
+		}
+		return "", fmt.Errorf("mark anchor: %w", err)
+	}
+	return commentId, nil
+}
+
+func (s *service) EditComment(ctx context.Context, objectId string, commentId string, message *model.ChatMessageMessageContent) error {
+	return s.doDiscussion(ctx, objectId, func(sb chatobject.StoreObject) error {
+		return sb.EditComment(ctx, commentId, message)
+	})
+}
+
+// DeleteComment deletes the comment. When the first comment of the thread is deleted, the thread is deleted
+// together with its anchor, replies of other participants are not shown anymore
+func (s *service) DeleteComment(ctx context.Context, sessionCtx session.Context, objectId string, commentId string) error {
+	var isThread bool
+	err := s.doDiscussion(ctx, objectId, func(sb chatobject.StoreObject) error {
+		comment, err := sb.GetComment(ctx, commentId)
+		if err != nil {
+			return err
+		}
+		isThread = comment.ThreadId == ""
+		return sb.DeleteComment(ctx, sessionCtx, commentId)
+	})
+	if err != nil || !isThread {
+		return err
+	}
+	// the anchor text may be already removed, so no blocks to update is fine
+	_, err = s.updateTextBlocks(sessionCtx, objectId, func(b *model.Block) bool {
+		return hasCommentMark(b, commentId)
+	}, func(tb text.Block) {
+		marks := tb.Model().GetText().GetMarks().GetMarks()
+		filtered := make([]*model.BlockContentTextMark, 0, len(marks))
+		for _, mark := range marks {
+			if mark.Type != model.BlockContentTextMark_Comment || mark.Param != commentId {
+				filtered = append(filtered, mark)
+			}
+		}
+		tb.SetText(tb.GetText(), &model.BlockContentTextMarks{Marks: filtered})
+	})
+	return err
+}
+
+func (s *service) SetResolved(ctx context.Context, objectId string, threadId string, resolved bool) error {
+	return s.doDiscussion(ctx, objectId, func(sb chatobject.StoreObject) error {
+		return sb.SetThreadResolved(ctx, threadId, resolved)
+	})
+}
+
+// ListComments returns comments of the object. Anchors of threads are set to the current ranges of their marks,
+// the anchor keeps the initial range when the commented text has been deleted
+func (s *service) ListComments(ctx context.Context, objectId string, includeResolved bool) ([]*model.Comment, error) {
+	var (
+		spaceId string
+		anchors = map[string]*model.CommentAnchor{}
+	)
+	err := cache.Do(s.objectGetter, objectId, func(sb smartblock.SmartBlock) error {
+		spaceId = sb.SpaceID()
+		return sb.Iterate(func(b simple.Block) (isContinue bool) {
+			for _, mark := range b.Model().GetText().GetMarks().GetMarks() {
+				if mark.Type == model.BlockContentTextMark_Comment {
+					anchors[mark.Param] = &model.CommentAnchor{BlockId: b.Model().Id, Range: mark.Range}
+				}
+			}
+			return true
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	discussionId, err := s.discussionId(ctx, spaceId, objectId, false)
+	if err != nil || discussionId == "" {
+		return nil, err
+	}
+	var comments []*model.Comment
+	err = cache.Do(s.objectGetter, discussionId, func(sb chatobject.StoreObject) error {
+		comments, err = sb.GetComments(ctx, includeResolved)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, comment := range comments {
+		if anchor, ok := anchors[comment.Id]; ok && comment.Anchor != nil {
+			comment.Anchor.BlockId = anchor.BlockId
+			comment.Anchor.Range = anchor.Range
+		}
+	}
+	return comments, nil
+}
+
+func (s *service) NotifyMention(spaceId, objectId string, comment *model.Comment) {
+	threadId := comment.ThreadId
+	if threadId == "" {
+		threadId = comment.Id
+	}
+	notification := &model.Notification{
+		// the same id on every device of the account, so the mention is notified only once
+		Id:    "comment-" + comment.Id,
+		Space: spaceId,
+		Payload: &model.NotificationPayloadOfComment{Comment: &model.NotificationComment{
+			SpaceId:   spaceId,
+			ObjectId:  objectId,
+			ThreadId:  threadId,
+			CommentId: comment.Id,
+			Identity:  comment.Creator,
+			Text:      comment.GetMessage().GetText(),
+			SpaceName: s.objectStore.GetSpaceName(spaceId),
+		}},
+	}
+	// comments are applied under the lock of the discussion object, so the notification object is not locked in place
+	go func() {
+		if err := s.notifications.CreateAndSend(notification); err != nil {
+			log.Errorf("send comment mention notification: %v", err)
+		}
+	}()
+}
+
+func (s *service) doDiscussion(ctx context.Context, objectId string, proc func(sb chatobject.StoreObject) error) error {
+	spaceId, err := s.spaceId(objectId)
+	if err != nil {
+		return err
+	}
+	discussionId, err := s.discussionId(ctx, spaceId, objectId, false)
+	if err != nil {
+		return err
+	}
+	if discussionId == "" {
+		return fmt.Errorf("object %s has no comments", objectId)
+	}
+	return cache.Do(s.objectGetter, discussionId, proc)
+}
+
+func (s *service) spaceId(objectId string) (spaceId string, err error) {
+	err = cache.Do(s.objectGetter, objectId, func(sb smartblock.SmartBlock) error {
+		spaceId = sb.SpaceID()
+		return nil
+	})
+	return spaceId, err
+}
+
+// discussionId returns the id of the discussion object of the object, empty id is returned
+// when the object has no discussion yet and create is not set
+func (s *service) discussionId(ctx context.Context, spaceId, objectId string, create bool) (string, error) {
+	spc, err := s.spaceService.Get(ctx, spaceId)
+	if err != nil {
+		return "", fmt.Errorf("get space: %w", err)
+	}
+	uk, err := domain.NewUniqueKey(coresb.SmartBlockTypeChatDerivedObject, objectId)
+	if err != nil {
+		return "", err
+	}
+	discussionId, err := spc.DeriveObjectID(ctx, uk)
+	if err != nil {
+		return "", err
+	}
+	exists, err := spc.Storage().HasTree(discussionId)
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		return discussionId, nil
+	}
+	if !create {
+		return "", nil
+	}
+	_, err = s.objectCreator.AddChatDerivedObject(ctx, spc, objectId)
+	if err != nil && !errors.Is(err, treestorage.ErrTreeExists) {
+		return "", fmt.Errorf("add discussion object: %w", err)
+	}
+	return discussionId, nil
+}
+
+// updateTextBlocks updates matched text blocks of the object and returns the number of updated blocks
+func (s *service) updateTextBlocks(sessionCtx session.Context, objectId string, match func(b *model.Block) bool, update func(tb text.Block)) (updated int, err error) {
+	err = cache.Do(s.objectGetter, objectId, func(sb smartblock.SmartBlock) error {
+		st := sb.NewStateCtx(sessionCtx)
+		var ids []string
+		_ = st.Iterate(func(b simple.Block) (isContinue bool) {
+			if b.Model().GetText() != nil && match(b.Model()) {
+				ids = append(ids, b.Model().Id)
+			}
+			return true
+		})
+		for _, id := range ids {
+			if tb, ok := st.Get(id).(text.Block); ok {
+				update(tb)
+				updated++
+			}
+		}
+		if updated == 0 {
+			return nil
+		}
+		return sb.Apply(st)
+	})
+	if err != nil {
+		return 0, err
+	}
+	return updated, nil
+}
+
+func hasCommentMark(b *model.Block, threadId string) bool {
+	for _, mark := range b.GetText().GetMarks().GetMarks() {
+		if mark.Type == model.BlockContentTextMark_Comment && mark.Param == threadId {
+			return true
+		}
+	}
+	return false
+}
+
+func newAnchor(sb smartblock.SmartBlock, blockId string, rng *model.Range) (*model.CommentAnchor, error) {
+	tb, ok := sb.Pick(blockId).(text.Block)
+	if !ok || rng == nil {
+		return nil, ErrBadAnchor
+	}
+	value := textutil.StrToUTF16(tb.GetText())
+	if rng.From < 0 || rng.From >= rng.To || int(rng.To) > len(value) {
+		return nil, ErrBadAnchor
+	}
+	return &model.CommentAnchor{
+		BlockId: blockId,
+		Range:   &model.Range{From: rng.From, To: rng.To},
+		Quote:   textutil.UTF16ToStr(value[rng.From:rng.To]),
+	}, nil
+}
@@ -102,6 +102,10 @@ func (s *Service) CreateLinkToTheNewObject(
 	if req.ContextId == "" {
 		return
 	}
+	contextId, err := s.syncedContextId(req.ContextId, req.TargetId)
+	if err != nil {
+		return "", "", nil, err
+	}
 
 	if req.Block == nil {
 		req.Block = &model.Block{
@@ -122,7 +126,7 @@ func (s *Service) CreateLinkToTheNewObject(
 		}
 	}
 
-	err = cache.DoStateCtx(s, sctx, req.ContextId, func(st *state.State, sb basic.Creatable) error {
+	err = cache.DoStateCtx(s, sctx, contextId, func(st *state.State, sb basic.Creatable) error {
 		linkID, err = sb.CreateBlock(st, pb.RpcBlockCreateRequest{
 			TargetId: req.TargetId,
 			Block:    req.Block,
@@ -47,6 +47,15 @@ type BookmarkFetchRequest struct {
 }
 
 func (s *Service) CreateBlock(ctx session.Context, req pb.RpcBlockCreateRequest) (id string, err error) {
+	contextId := req.ContextId
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return "", err
+	}
+	if req.Block != nil {
+		if err = checkNestedSyncedBlocks(contextId, req.ContextId, req.Block); err != nil {
+			return "", err
+		}
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, b basic.Creatable) error {
 		id, err = b.CreateBlock(st, req)
 		return err
@@ -58,8 +67,21 @@ func (s *Service) DuplicateBlocks(
 	sctx session.Context,
 	req pb.RpcBlockListDuplicateRequest,
 ) (newIds []string, err error) {
-	if req.ContextId == req.TargetContextId || req.TargetContextId == "" {
+	if req.TargetContextId == "" {
+		req.TargetContextId = req.ContextId
+	}
+	targetContextId := req.TargetContextId
+	if req.TargetContextId, err = s.syncedContextId(req.TargetContextId, req.TargetId); err != nil {
+		return nil, err
+	}
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return nil, err
+	}
+	if req.ContextId == req.TargetContextId {
 		err = cache.DoStateCtx(s, sctx, req.ContextId, func(st *state.State, sb basic.Duplicatable) error {
+			if err := checkNestedSyncedSubtrees(targetContextId, req.TargetContextId, st, req.BlockIds); err != nil {
+				return err
+			}
 			newIds, err = sb.Duplicate(st, st, req.TargetId, req.Position, req.BlockIds)
 			return err
 		})
@@ -67,6 +89,9 @@ func (s *Service) DuplicateBlocks(
 	}
 
 	err = cache.DoStateCtx(s, sctx, req.ContextId, func(srcState *state.State, sb basic.Duplicatable) error {
+		if err := checkNestedSyncedSubtrees(targetContextId, req.TargetContextId, srcState, req.BlockIds); err != nil {
+			return err
+		}
 		return cache.DoState(s, req.TargetContextId, func(targetState *state.State, tb basic.Creatable) error {
 			newIds, err = sb.Duplicate(srcState, targetState, req.TargetId, req.Position, req.BlockIds)
 			return err
@@ -77,6 +102,9 @@ func (s *Service) DuplicateBlocks(
 }
 
 func (s *Service) UnlinkBlock(ctx session.Context, req pb.RpcBlockListDeleteRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b basic.Unlinkable) error {
 		return b.Unlink(ctx, req.BlockIds...)
 	})
@@ -85,12 +113,18 @@ func (s *Service) UnlinkBlock(ctx session.Context, req pb.RpcBlockListDeleteRequ
 func (s *Service) SetDivStyle(
 	ctx session.Context, contextId string, style model.BlockContentDivStyle, ids ...string,
 ) (err error) {
+	if contextId, err = s.syncedContextId(contextId, ids...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b basic.CommonOperations) error {
 		return b.SetDivStyle(ctx, style, ids...)
 	})
 }
 
 func (s *Service) SplitBlock(ctx session.Context, req pb.RpcBlockSplitRequest) (blockId string, err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return "", err
+	}
 	err = cache.Do(s, req.ContextId, func(b stext.Text) error {
 		blockId, err = b.Split(ctx, req)
 		return err
@@ -99,6 +133,9 @@ func (s *Service) SplitBlock(ctx session.Context, req pb.RpcBlockSplitRequest) (
 }
 
 func (s *Service) MergeBlock(ctx session.Context, req pb.RpcBlockMergeRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.FirstBlockId, req.SecondBlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b stext.Text) error {
 		return b.Merge(ctx, req.FirstBlockId, req.SecondBlockId)
 	})
@@ -106,13 +143,19 @@ func (s *Service) MergeBlock(ctx session.Context, req pb.RpcBlockMergeRequest) (
 
 func (s *Service) TurnInto(
 	ctx session.Context, contextId string, style model.BlockContentTextStyle, ids ...string,
-) error {
+) (err error) {
+	if contextId, err = s.syncedContextId(contextId, ids...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b stext.Text) error {
 		return b.TurnInto(ctx, style, ids...)
 	})
 }
 
 func (s *Service) ReplaceBlock(ctx session.Context, req pb.RpcBlockReplaceRequest) (newId string, err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return "", err
+	}
 	err = cache.Do(s, req.ContextId, func(b basic.Replaceable) error {
 		newId, err = b.Replace(ctx, req.BlockId, req.Block)
 		return err
@@ -121,6 +164,9 @@ func (s *Service) ReplaceBlock(ctx session.Context, req pb.RpcBlockReplaceReques
 }
 
 func (s *Service) SetFields(ctx session.Context, req pb.RpcBlockSetFieldsRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b basic.CommonOperations) error {
 		return b.SetFields(ctx, &pb.RpcBlockListSetFieldsRequestBlockField{
 			BlockId: req.BlockId,
@@ -130,6 +176,13 @@ func (s *Service) SetFields(ctx session.Context, req pb.RpcBlockSetFieldsRequest
 }
 
 func (s *Service) SetFieldsList(ctx session.Context, req pb.RpcBlockListSetFieldsRequest) (err error) {
+	blockIds := make([]string, 0, len(req.BlockFields))
+	for _, f := range req.BlockFields {
+		blockIds = append(blockIds, f.BlockId)
+	}
+	if req.ContextId, err = s.syncedContextId(req.ContextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b basic.CommonOperations) error {
 		return b.SetFields(ctx, req.BlockFields...)
 	})
@@ -150,6 +203,13 @@ func (s *Service) Copy(
 func (s *Service) Paste(
 	ctx session.Context, req pb.RpcBlockPasteRequest, groupId string,
 ) (blockIds []string, uploadArr []pb.RpcBlockUploadRequest, caretPosition int32, isSameBlockCaret bool, err error) {
+	contextId := req.ContextId
+	if req.ContextId, err = s.syncedContextId(req.ContextId, append([]string{req.FocusedBlockId}, req.SelectedBlockIds...)...); err != nil {
+		return nil, nil, 0, false, err
+	}
+	if err = checkNestedSyncedBlocks(contextId, req.ContextId, req.AnySlot...); err != nil {
+		return nil, nil, 0, false, err
+	}
 	err = cache.Do(s, req.ContextId, func(cb clipboard.Clipboard) error {
 		blockIds, uploadArr, caretPosition, isSameBlockCaret, err = cb.Paste(ctx, &req, groupId)
 		return err
@@ -161,6 +221,9 @@ func (s *Service) Paste(
 func (s *Service) Cut(
 	ctx session.Context, req pb.RpcBlockCutRequest,
 ) (textSlot string, htmlSlot string, anySlot []*model.Block, err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, blockModelIds(req.Blocks)...); err != nil {
+		return "", "", nil, err
+	}
 	err = cache.Do(s, req.ContextId, func(cb clipboard.Clipboard) error {
 		textSlot, htmlSlot, anySlot, err = cb.Cut(ctx, req)
 		return err
@@ -176,19 +239,28 @@ func (s *Service) Export(req pb.RpcBlockExportRequest) (path string, err error)
 	return path, err
 }
 
-func (s *Service) SetTextText(ctx session.Context, req pb.RpcBlockTextSetTextRequest) error {
+func (s *Service) SetTextText(ctx session.Context, req pb.RpcBlockTextSetTextRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b stext.Text) error {
 		return b.SetText(ctx, req)
 	})
 }
 
-func (s *Service) SetLatexText(ctx session.Context, req pb.RpcBlockLatexSetTextRequest) error {
+func (s *Service) SetLatexText(ctx session.Context, req pb.RpcBlockLatexSetTextRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b basic.CommonOperations) error {
 		return b.SetLatexText(ctx, req)
 	})
 }
 
-func (s *Service) SetLatexProcessor(ctx session.Context, req pb.RpcBlockLatexSetTextRequest) error {
+func (s *Service) SetLatexProcessor(ctx session.Context, req pb.RpcBlockLatexSetTextRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b basic.CommonOperations) error {
 		return b.SetLatexText(ctx, req)
 	})
@@ -196,7 +268,10 @@ func (s *Service) SetLatexProcessor(ctx session.Context, req pb.RpcBlockLatexSet
 
 func (s *Service) SetTextStyle(
 	ctx session.Context, contextId string, style model.BlockContentTextStyle, blockIds ...string,
-) error {
+) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b stext.Text) error {
 		return b.UpdateTextBlocks(ctx, blockIds, true, func(t text.Block) error {
 			t.SetStyle(style)
@@ -205,7 +280,10 @@ func (s *Service) SetTextStyle(
 	})
 }
 
-func (s *Service) SetTextChecked(ctx session.Context, req pb.RpcBlockTextSetCheckedRequest) error {
+func (s *Service) SetTextChecked(ctx session.Context, req pb.RpcBlockTextSetCheckedRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b stext.Text) error {
 		return b.UpdateTextBlocks(ctx, []string{req.BlockId}, true, func(t text.Block) error {
 			t.SetChecked(req.Checked)
@@ -214,7 +292,10 @@ func (s *Service) SetTextChecked(ctx session.Context, req pb.RpcBlockTextSetChec
 	})
 }
 
-func (s *Service) SetTextColor(ctx session.Context, contextId string, color string, blockIds ...string) error {
+func (s *Service) SetTextColor(ctx session.Context, contextId string, color string, blockIds ...string) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b stext.Text) error {
 		return b.UpdateTextBlocks(ctx, blockIds, true, func(t text.Block) error {
 			t.SetTextColor(color)
@@ -223,7 +304,10 @@ func (s *Service) SetTextColor(ctx session.Context, contextId string, color stri
 	})
 }
 
-func (s *Service) ClearTextStyle(ctx session.Context, contextId string, blockIds ...string) error {
+func (s *Service) ClearTextStyle(ctx session.Context, contextId string, blockIds ...string) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b stext.Text) error {
 		return b.UpdateTextBlocks(ctx, blockIds, true, func(t text.Block) error {
 			t.Model().BackgroundColor = ""
@@ -253,7 +337,10 @@ func (s *Service) ClearTextStyle(ctx session.Context, contextId string, blockIds
 	})
 }
 
-func (s *Service) ClearTextContent(ctx session.Context, contextId string, blockIds ...string) error {
+func (s *Service) ClearTextContent(ctx session.Context, contextId string, blockIds ...string) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b stext.Text) error {
 		return b.UpdateTextBlocks(ctx, blockIds, true, func(t text.Block) error {
 			t.SetText("", nil)
@@ -264,13 +351,19 @@ func (s *Service) ClearTextContent(ctx session.Context, contextId string, blockI
 
 func (s *Service) SetTextMark(
 	ctx session.Context, contextId string, mark *model.BlockContentTextMark, blockIds ...string,
-) error {
+) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b stext.Text) error {
 		return b.SetMark(ctx, mark, blockIds...)
 	})
 }
 
-func (s *Service) SetTextIcon(ctx session.Context, contextId, image, emoji string, blockIds ...string) error {
+func (s *Service) SetTextIcon(ctx session.Context, contextId, image, emoji string, blockIds ...string) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b stext.Text) error {
 		return b.SetIcon(ctx, image, emoji, blockIds...)
 	})
@@ -279,6 +372,9 @@ func (s *Service) SetTextIcon(ctx session.Context, contextId, image, emoji strin
 func (s *Service) SetBackgroundColor(
 	ctx session.Context, contextId string, color string, blockIds ...string,
 ) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b basic.Updatable) error {
 		return b.Update(ctx, func(b simple.Block) error {
 			b.Model().BackgroundColor = color
@@ -288,6 +384,9 @@ func (s *Service) SetBackgroundColor(
 }
 
 func (s *Service) SetLinkAppearance(ctx session.Context, req pb.RpcBlockLinkListSetAppearanceRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b basic.Updatable) error {
 		return b.Update(ctx, func(b simple.Block) error {
 			if linkBlock, ok := b.(link.Block); ok {
@@ -306,6 +405,9 @@ func (s *Service) SetLinkAppearance(ctx session.Context, req pb.RpcBlockLinkList
 func (s *Service) SetAlign(
 	ctx session.Context, contextId string, align model.BlockAlign, blockIds ...string,
 ) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.DoStateCtx(s, ctx, contextId, func(st *state.State, sb smartblock.SmartBlock) error {
 		return st.SetAlign(align, blockIds...)
 	})
@@ -314,6 +416,9 @@ func (s *Service) SetAlign(
 func (s *Service) SetVerticalAlign(
 	ctx session.Context, contextId string, align model.BlockVerticalAlign, blockIds ...string,
 ) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(sb smartblock.SmartBlock) error {
 		return sb.SetVerticalAlign(ctx, align, blockIds...)
 	})
@@ -399,7 +504,10 @@ func (s *Service) DropFiles(req pb.RpcFileDropRequest) (err error) {
 	})
 }
 
-func (s *Service) SetFileTargetObjectId(ctx session.Context, contextId string, blockId, targetObjectId string) error {
+func (s *Service) SetFileTargetObjectId(ctx session.Context, contextId string, blockId, targetObjectId string) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockId); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b file.File) error {
 		return b.SetFileTargetObjectId(ctx, blockId, targetObjectId)
 	})
@@ -407,7 +515,10 @@ func (s *Service) SetFileTargetObjectId(ctx session.Context, contextId string, b
 
 func (s *Service) SetFileStyle(
 	ctx session.Context, contextId string, style model.BlockContentFileStyle, blockIds ...string,
-) error {
+) (err error) {
+	if contextId, err = s.syncedContextId(contextId, blockIds...); err != nil {
+		return err
+	}
 	return cache.Do(s, contextId, func(b file.File) error {
 		return b.SetFileStyle(ctx, style, blockIds...)
 	})
@@ -434,12 +545,18 @@ func (s *Service) Redo(
 }
 
 func (s *Service) BookmarkFetch(ctx session.Context, req BookmarkFetchRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b bookmark.Bookmark) error {
 		return b.Fetch(ctx, req.BlockId, req.Url, req.ObjectOrigin)
 	})
 }
 
 func (s *Service) BookmarkCreateAndFetch(ctx session.Context, req bookmark.CreateAndFetchRequest) (id string, err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return "", err
+	}
 	err = cache.Do(s, req.ContextId, func(b bookmark.Bookmark) error {
 		id, err = b.CreateAndFetch(ctx, req)
 		return err
@@ -447,7 +564,10 @@ func (s *Service) BookmarkCreateAndFetch(ctx session.Context, req bookmark.Creat
 	return
 }
 
-func (s *Service) SetRelationKey(ctx session.Context, req pb.RpcBlockRelationSetKeyRequest) error {
+func (s *Service) SetRelationKey(ctx session.Context, req pb.RpcBlockRelationSetKeyRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b basic.CommonOperations) error {
 		return b.AddRelationAndSet(ctx, pb.RpcBlockRelationAddRequest{
 			RelationKey: req.Key, BlockId: req.BlockId, ContextId: req.ContextId,
@@ -455,7 +575,10 @@ func (s *Service) SetRelationKey(ctx session.Context, req pb.RpcBlockRelationSet
 	})
 }
 
-func (s *Service) AddRelationBlock(ctx session.Context, req pb.RpcBlockRelationAddRequest) error {
+func (s *Service) AddRelationBlock(ctx session.Context, req pb.RpcBlockRelationAddRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.Do(s, req.ContextId, func(b basic.CommonOperations) error {
 		return b.AddRelationAndSet(ctx, req)
 	})
@@ -509,6 +632,9 @@ func (s *Service) ListAvailableRelations(ctx session.Context, objectId string) (
 func (s *Service) ListConvertToObjects(
 	ctx session.Context, req pb.RpcBlockListConvertToObjectsRequest,
 ) (linkIds []string, err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return nil, err
+	}
 	err = cache.Do(s, req.ContextId, func(b basic.CommonOperations) error {
 		linkIds, err = b.ExtractBlocksToObjects(ctx, s.objectCreator, s.templateService, req)
 		return err
@@ -521,6 +647,10 @@ func (s *Service) MoveBlocksToNewPage(
 	sctx session.Context,
 	req pb.RpcBlockListMoveToNewObjectRequest,
 ) (linkID string, err error) {
+	srcContextId, err := s.syncedContextId(req.ContextId, req.BlockIds...)
+	if err != nil {
+		return "", err
+	}
 	// 1. Create new page, link
 	linkID, objectID, _, err := s.CreateLinkToTheNewObject(ctx, sctx, &pb.RpcBlockLinkCreateWithObjectRequest{
 		ContextId:           req.ContextId,
@@ -535,7 +665,7 @@ func (s *Service) MoveBlocksToNewPage(
 
 	// 2. Move blocks to new page
 	// TODO Use DoState2
-	err = cache.DoState(s, req.ContextId, func(srcState *state.State, sb basic.Movable) error {
+	err = cache.DoState(s, srcContextId, func(srcState *state.State, sb basic.Movable) error {
 		return cache.DoState(s, objectID, func(destState *state.State, tb basic.Movable) error {
 			return sb.Move(srcState, destState, "", model.Block_Inner, req.BlockIds)
 		})
@@ -551,8 +681,18 @@ type Movable interface {
 	basic.Restrictionable
 }
 
-func (s *Service) MoveBlocks(req pb.RpcBlockListMoveToExistingObjectRequest) error {
+func (s *Service) MoveBlocks(req pb.RpcBlockListMoveToExistingObjectRequest) (err error) {
+	targetContextId := req.TargetContextId
+	if req.TargetContextId, err = s.syncedContextId(req.TargetContextId, req.DropTargetId); err != nil {
+		return err
+	}
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return err
+	}
 	return cache.DoState2(s, req.ContextId, req.TargetContextId, func(srcState, destState *state.State, sb, tb Movable) error {
+		if err := checkNestedSyncedSubtrees(targetContextId, req.TargetContextId, srcState, req.BlockIds); err != nil {
+			return err
+		}
 		if err := sb.Restrictions().Object.Check(model.Restrictions_Blocks); err != nil {
 			return restriction.ErrRestricted
 		}
@@ -564,6 +704,9 @@ func (s *Service) MoveBlocks(req pb.RpcBlockListMoveToExistingObjectRequest) err
 }
 
 func (s *Service) CreateTableBlock(ctx session.Context, req pb.RpcBlockTableCreateRequest) (id string, err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return "", err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		id, err = e.TableCreate(st, req)
 		return err
@@ -571,14 +714,20 @@ func (s *Service) CreateTableBlock(ctx session.Context, req pb.RpcBlockTableCrea
 	return
 }
 
-func (s *Service) TableRowCreate(ctx session.Context, req pb.RpcBlockTableRowCreateRequest) error {
+func (s *Service) TableRowCreate(ctx session.Context, req pb.RpcBlockTableRowCreateRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return err
+	}
 	return cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		_, err := e.RowCreate(st, req)
 		return err
 	})
 }
 
-func (s *Service) TableColumnCreate(ctx session.Context, req pb.RpcBlockTableColumnCreateRequest) error {
+func (s *Service) TableColumnCreate(ctx session.Context, req pb.RpcBlockTableColumnCreateRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return err
+	}
 	return cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		_, err := e.ColumnCreate(st, req)
 		return err
@@ -586,6 +735,12 @@ func (s *Service) TableColumnCreate(ctx session.Context, req pb.RpcBlockTableCol
 }
 
 func (s *Service) TableRowDelete(ctx session.Context, req pb.RpcBlockTableRowDeleteRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return err
+	}
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.RowDelete(st, req)
 	})
@@ -593,6 +748,9 @@ func (s *Service) TableRowDelete(ctx session.Context, req pb.RpcBlockTableRowDel
 }
 
 func (s *Service) TableColumnDelete(ctx session.Context, req pb.RpcBlockTableColumnDeleteRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.ColumnDelete(st, req)
 	})
@@ -600,13 +758,19 @@ func (s *Service) TableColumnDelete(ctx session.Context, req pb.RpcBlockTableCol
 }
 
 func (s *Service) TableColumnMove(ctx session.Context, req pb.RpcBlockTableColumnMoveRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.ColumnMove(st, req)
 	})
 	return
 }
 
-func (s *Service) TableRowDuplicate(ctx session.Context, req pb.RpcBlockTableRowDuplicateRequest) error {
+func (s *Service) TableRowDuplicate(ctx session.Context, req pb.RpcBlockTableRowDuplicateRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return err
+	}
 	return cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		_, err := e.RowDuplicate(st, req)
 		return err
@@ -616,6 +780,9 @@ func (s *Service) TableRowDuplicate(ctx session.Context, req pb.RpcBlockTableRow
 func (s *Service) TableColumnDuplicate(
 	ctx session.Context, req pb.RpcBlockTableColumnDuplicateRequest,
 ) (id string, err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockId); err != nil {
+		return "", err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		id, err = e.ColumnDuplicate(st, req)
 		return err
@@ -624,6 +791,9 @@ func (s *Service) TableColumnDuplicate(
 }
 
 func (s *Service) TableExpand(ctx session.Context, req pb.RpcBlockTableExpandRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.Expand(st, req)
 	})
@@ -631,6 +801,9 @@ func (s *Service) TableExpand(ctx session.Context, req pb.RpcBlockTableExpandReq
 }
 
 func (s *Service) TableRowListFill(ctx session.Context, req pb.RpcBlockTableRowListFillRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.RowListFill(st, req)
 	})
@@ -638,6 +811,9 @@ func (s *Service) TableRowListFill(ctx session.Context, req pb.RpcBlockTableRowL
 }
 
 func (s *Service) TableRowListClean(ctx session.Context, req pb.RpcBlockTableRowListCleanRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.RowListClean(st, req)
 	})
@@ -645,6 +821,9 @@ func (s *Service) TableRowListClean(ctx session.Context, req pb.RpcBlockTableRow
 }
 
 func (s *Service) TableRowSetHeader(ctx session.Context, req pb.RpcBlockTableRowSetHeaderRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.TargetId); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.RowSetHeader(st, req)
 	})
@@ -652,6 +831,9 @@ func (s *Service) TableRowSetHeader(ctx session.Context, req pb.RpcBlockTableRow
 }
 
 func (s *Service) TableSort(ctx session.Context, req pb.RpcBlockTableSortRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.ColumnId); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.Sort(st, req)
 	})
@@ -659,12 +841,35 @@ func (s *Service) TableSort(ctx session.Context, req pb.RpcBlockTableSortRequest
 }
 
 func (s *Service) TableColumnListFill(ctx session.Context, req pb.RpcBlockTableColumnListFillRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return err
+	}
 	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
 		return e.ColumnListFill(st, req)
 	})
 	return err
 }
 
+func (s *Service) TableCellListMerge(ctx session.Context, req pb.RpcBlockTableCellListMergeRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return err
+	}
+	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
+		return e.CellListMerge(st, req)
+	})
+	return err
+}
+
+func (s *Service) TableCellListSplit(ctx session.Context, req pb.RpcBlockTableCellListSplitRequest) (err error) {
+	if req.ContextId, err = s.syncedContextId(req.ContextId, req.BlockIds...); err != nil {
+		return err
+	}
+	err = cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, e table.TableEditor) error {
+		return e.CellListSplit(st, req)
+	})
+	return err
+}
+
 func (s *Service) CreateWidgetBlock(ctx session.Context, req *pb.RpcBlockCreateWidgetRequest) (string, error) {
 	var id string
 	err := cache.DoStateCtx(s, ctx, req.ContextId, func(st *state.State, w widget.Widget) error {
@@ -272,6 +272,7 @@ func (bs *basic) Move(srcState, destState *state.State, targetBlockId string, po
 	if err != nil {
 		return err
 	}
+	table.SplitMergedCellsOnRowsMove(srcState, targetBlockId, position, blockIds)
 
 	var replacementCandidate simple.Block
 	for _, id := range blockIds {
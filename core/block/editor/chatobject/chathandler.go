@@ -14,8 +14,12 @@ import (
 	"github.com/anyproto/anytype-heart/pb"
 )
 
+// changedMessages contains ids of messages changed since the last full-text indexing of the chat
+type changedMessages map[string]struct{}
+
 type ChatHandler struct {
-	subscription *subscription
+	subscription    *subscription
+	changedMessages changedMessages
 }
 
 func (d ChatHandler) CollectionName() string {
@@ -44,6 +48,7 @@ func (d ChatHandler) BeforeCreate(ctx context.Context, ch storestate.ChangeOp) (
 	model := msg.toModel()
 	model.OrderId = ch.Change.Order
 	d.subscription.add(model)
+	d.changedMessages[model.Id] = struct{}{}
 
 	return
 }
@@ -71,6 +76,7 @@ func (d ChatHandler) BeforeDelete(ctx context.Context, ch storestate.ChangeOp) (
 	}
 
 	d.subscription.delete(messageId)
+	d.changedMessages[messageId] = struct{}{}
 	return storestate.DeleteModeDelete, nil
 }
 
@@ -109,6 +115,7 @@ func (d ChatHandler) UpgradeKeyModifier(ch storestate.ChangeOp, key *pb.KeyModif
 				result.Set(modifiedAtKey, a.NewNumberInt(int(ch.Change.Timestamp)))
 				model.ModifiedAt = ch.Change.Timestamp
 				d.subscription.updateFull(model)
+				d.changedMessages[model.Id] = struct{}{}
 			default:
 				return nil, false, fmt.Errorf("invalid key path %s", key.KeyPath)
 			}
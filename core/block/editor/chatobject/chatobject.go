@@ -19,9 +19,12 @@ import (
 	"github.com/anyproto/anytype-heart/core/event"
 	"github.com/anyproto/anytype-heart/core/session"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
+var log = logging.Logger("anytype-mw-editor-chat")
+
 const (
 	collectionName = "chats"
 	descOrder      = "-_o.id"
@@ -35,11 +38,22 @@ type StoreObject interface {
 	AddMessage(ctx context.Context, sessionCtx session.Context, message *model.ChatMessage) (string, error)
 	GetMessages(ctx context.Context, req GetMessagesRequest) ([]*model.ChatMessage, error)
 	GetMessagesByIds(ctx context.Context, messageIds []string) ([]*model.ChatMessage, error)
+	// TakeChangedMessageIds returns ids of messages added, edited or deleted since the previous call
+	TakeChangedMessageIds() []string
+	// RestoreChangedMessageIds marks taken messages as changed again when they have failed to be indexed
+	RestoreChangedMessageIds(messageIds []string)
 	EditMessage(ctx context.Context, messageId string, newMessage *model.ChatMessage) error
 	ToggleMessageReaction(ctx context.Context, messageId string, emoji string) error
 	DeleteMessage(ctx context.Context, messageId string) error
 	SubscribeLastMessages(ctx context.Context, limit int) ([]*model.ChatMessage, int, error)
 	Unsubscribe() error
+
+	AddComment(ctx context.Context, sessionCtx session.Context, comment *model.Comment) (string, error)
+	GetComments(ctx context.Context, includeResolved bool) ([]*model.Comment, error)
+	GetComment(ctx context.Context, commentId string) (*model.Comment, error)
+	EditComment(ctx context.Context, commentId string, message *model.ChatMessageMessageContent) error
+	SetThreadResolved(ctx context.Context, threadId string, resolved bool) error
+	DeleteComment(ctx context.Context, sessionCtx session.Context, commentId string) error
 }
 
 type GetMessagesRequest struct {
@@ -52,29 +66,41 @@ type AccountService interface {
 	AccountID() string
 }
 
+// FulltextQueue is used to reindex chat messages after changes
+type FulltextQueue interface {
+	AddToIndexQueue(ctx context.Context, ids ...string) error
+}
+
 type storeObject struct {
 	anystoredebug.AnystoreDebug
 	smartblock.SmartBlock
 	locker smartblock.Locker
 
-	accountService AccountService
-	storeSource    source.Store
-	store          *storestate.StoreState
-	eventSender    event.Sender
-	subscription   *subscription
-	crdtDb         anystore.DB
+	accountService      AccountService
+	fulltextQueue       FulltextQueue
+	mentionNotifier     MentionNotifier
+	storeSource         source.Store
+	store               *storestate.StoreState
+	eventSender         event.Sender
+	subscription        *subscription
+	commentSubscription *commentSubscription
+	changedMessages     changedMessages
+	crdtDb              anystore.DB
 
 	arenaPool *anyenc.ArenaPool
 }
 
-func New(sb smartblock.SmartBlock, accountService AccountService, eventSender event.Sender, crdtDb anystore.DB) StoreObject {
+func New(sb smartblock.SmartBlock, accountService AccountService, fulltextQueue FulltextQueue, mentionNotifier MentionNotifier, eventSender event.Sender, crdtDb anystore.DB) StoreObject {
 	return &storeObject{
-		SmartBlock:     sb,
-		locker:         sb.(smartblock.Locker),
-		accountService: accountService,
-		arenaPool:      &anyenc.ArenaPool{},
-		eventSender:    eventSender,
-		crdtDb:         crdtDb,
+		SmartBlock:      sb,
+		locker:          sb.(smartblock.Locker),
+		accountService:  accountService,
+		fulltextQueue:   fulltextQueue,
+		mentionNotifier: mentionNotifier,
+		arenaPool:       &anyenc.ArenaPool{},
+		eventSender:     eventSender,
+		changedMessages: changedMessages{},
+		crdtDb:          crdtDb,
 	}
 }
 
@@ -84,9 +110,13 @@ func (s *storeObject) Init(ctx *smartblock.InitContext) error {
 		return err
 	}
 	s.subscription = newSubscription(s.SpaceID(), s.Id(), s.eventSender)
+	s.commentSubscription = newCommentSubscription(s.SpaceID(), s.commentedObjectId(), s.accountService.AccountID(), s.eventSender, s.mentionNotifier)
 
 	stateStore, err := storestate.New(ctx.Ctx, s.Id(), s.crdtDb, ChatHandler{
-		subscription: s.subscription,
+		subscription:    s.subscription,
+		changedMessages: s.changedMessages,
+	}, CommentHandler{
+		subscription: s.commentSubscription,
 	})
 	if err != nil {
 		return fmt.Errorf("create state store: %w", err)
@@ -102,14 +132,52 @@ func (s *storeObject) Init(ctx *smartblock.InitContext) error {
 	if err != nil {
 		return fmt.Errorf("read store doc: %w", err)
 	}
+	// messages received while the chat was closed
+	s.addToFulltextQueue()
 
 	s.AnystoreDebug = anystoredebug.New(s.SmartBlock, stateStore)
 
 	return nil
 }
 
+// commentedObjectId returns the id of the object the chat is derived from, comments of the chat are comments of this object
+func (s *storeObject) commentedObjectId() string {
+	if uk := s.UniqueKey(); uk != nil && uk.InternalKey() != "" {
+		return uk.InternalKey()
+	}
+	return s.Id()
+}
+
 func (s *storeObject) onUpdate() {
 	s.subscription.flush()
+	s.commentSubscription.flush()
+	s.addToFulltextQueue()
+}
+
+// addToFulltextQueue reindexes changed messages. Messages are not a part of the object state,
+// so the indexer doesn't know that they have been changed
+func (s *storeObject) addToFulltextQueue() {
+	if len(s.changedMessages) == 0 {
+		return
+	}
+	if err := s.fulltextQueue.AddToIndexQueue(context.Background(), s.Id()); err != nil {
+		log.With("objectId", s.Id()).Errorf("add chat to full-text index queue: %v", err)
+	}
+}
+
+func (s *storeObject) TakeChangedMessageIds() []string {
+	ids := make([]string, 0, len(s.changedMessages))
+	for id := range s.changedMessages {
+		ids = append(ids, id)
+	}
+	clear(s.changedMessages)
+	return ids
+}
+
+func (s *storeObject) RestoreChangedMessageIds(messageIds []string) {
+	for _, id := range messageIds {
+		s.changedMessages[id] = struct{}{}
+	}
 }
 
 func (s *storeObject) GetMessagesByIds(ctx context.Context, messageIds []string) ([]*model.ChatMessage, error) {
@@ -332,6 +400,156 @@ func (s *storeObject) Unsubscribe() error {
 	return nil
 }
 
+func (s *storeObject) AddComment(ctx context.Context, sessionCtx session.Context, comment *model.Comment) (string, error) {
+	arena := s.arenaPool.Get()
+	defer func() {
+		arena.Reset()
+		s.arenaPool.Put(arena)
+	}()
+	obj := marshalComment(arena, comment)
+
+	builder := storestate.Builder{}
+	err := builder.Create(commentsCollectionName, storestate.IdFromChange, obj)
+	if err != nil {
+		return "", fmt.Errorf("create comment: %w", err)
+	}
+
+	s.commentSubscription.setSessionContext(sessionCtx)
+	return s.pushStoreChange(ctx, builder)
+}
+
+// GetComments returns comments ordered by creation. Replies to deleted threads are skipped,
+// as well as resolved threads unless includeResolved is set
+func (s *storeObject) GetComments(ctx context.Context, includeResolved bool) ([]*model.Comment, error) {
+	coll, err := s.store.Collection(ctx, commentsCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("get collection: %w", err)
+	}
+	arena := s.arenaPool.Get()
+	defer func() {
+		arena.Reset()
+		s.arenaPool.Put(arena)
+	}()
+
+	iter, err := coll.Find(nil).Sort(ascOrder).Iter(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("find iter: %w", err)
+	}
+	defer iter.Close()
+
+	var (
+		comments []*model.Comment
+		threads  = map[string]*model.Comment{}
+	)
+	for iter.Next() {
+		doc, err := iter.Doc()
+		if err != nil {
+			return nil, fmt.Errorf("get doc: %w", err)
+		}
+		comment := newCommentWrapper(arena, doc.Value()).toModel()
+		if comment.ThreadId == "" {
+			threads[comment.Id] = comment
+		}
+		comments = append(comments, comment)
+	}
+
+	res := comments[:0]
+	for _, comment := range comments {
+		threadId := comment.ThreadId
+		if threadId == "" {
+			threadId = comment.Id
+		}
+		thread, ok := threads[threadId]
+		if !ok || (thread.Resolved && !includeResolved) {
+			continue
+		}
+		res = append(res, comment)
+	}
+	return res, nil
+}
+
+func (s *storeObject) GetComment(ctx context.Context, commentId string) (*model.Comment, error) {
+	coll, err := s.store.Collection(ctx, commentsCollectionName)
+	if err != nil {
+		return nil, fmt.Errorf("get collection: %w", err)
+	}
+	doc, err := coll.FindId(ctx, commentId)
+	if err != nil {
+		return nil, fmt.Errorf("find comment: %w", err)
+	}
+	return newCommentWrapper(nil, doc.Value()).toModel(), nil
+}
+
+func (s *storeObject) EditComment(ctx context.Context, commentId string, message *model.ChatMessageMessageContent) error {
+	arena := s.arenaPool.Get()
+	defer func() {
+		arena.Reset()
+		s.arenaPool.Put(arena)
+	}()
+	content := arena.NewObject()
+	content.Set("message", marshalMessageContent(arena, message))
+
+	builder := storestate.Builder{}
+	err := builder.Modify(commentsCollectionName, commentId, []string{contentKey}, pb.ModifyOp_Set, content)
+	if err != nil {
+		return fmt.Errorf("modify content: %w", err)
+	}
+	_, err = s.pushStoreChange(ctx, builder)
+	return err
+}
+
+func (s *storeObject) SetThreadResolved(ctx context.Context, threadId string, resolved bool) error {
+	arena := s.arenaPool.Get()
+	defer func() {
+		arena.Reset()
+		s.arenaPool.Put(arena)
+	}()
+
+	comment, err := s.GetComment(ctx, threadId)
+	if err != nil {
+		return err
+	}
+	if comment.ThreadId != "" {
+		return fmt.Errorf("comment %s is not a thread", threadId)
+	}
+
+	var resolvedBy string
+	resolvedVal := arena.NewFalse()
+	if resolved {
+		resolvedBy = s.accountService.AccountID()
+		resolvedVal = arena.NewTrue()
+	}
+	builder := storestate.Builder{}
+	if err = builder.Modify(commentsCollectionName, threadId, []string{resolvedKey}, pb.ModifyOp_Set, resolvedVal); err != nil {
+		return fmt.Errorf("modify resolved: %w", err)
+	}
+	if err = builder.Modify(commentsCollectionName, threadId, []string{resolvedByKey}, pb.ModifyOp_Set, arena.NewString(resolvedBy)); err != nil {
+		return fmt.Errorf("modify resolvedBy: %w", err)
+	}
+	_, err = s.pushStoreChange(ctx, builder)
+	return err
+}
+
+func (s *storeObject) DeleteComment(ctx context.Context, sessionCtx session.Context, commentId string) error {
+	builder := storestate.Builder{}
+	builder.Delete(commentsCollectionName, commentId)
+	s.commentSubscription.setSessionContext(sessionCtx)
+	_, err := s.pushStoreChange(ctx, builder)
+	return err
+}
+
+func (s *storeObject) pushStoreChange(ctx context.Context, builder storestate.Builder) (string, error) {
+	changeId, err := s.storeSource.PushStoreChange(ctx, source.PushStoreChangeParams{
+		Changes: builder.ChangeSet,
+		State:   s.store,
+		Time:    time.Now(),
+	})
+	if err != nil {
+		return "", fmt.Errorf("push change: %w", err)
+	}
+	return changeId, nil
+}
+
 func (s *storeObject) TryClose(objectTTL time.Duration) (res bool, err error) {
 	if !s.locker.TryLock() {
 		return false, nil
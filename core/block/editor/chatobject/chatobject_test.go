@@ -32,10 +32,20 @@ func (a *accountServiceStub) AccountID() string {
 	return a.accountId
 }
 
+type fulltextQueueStub struct {
+	ids []string
+}
+
+func (q *fulltextQueueStub) AddToIndexQueue(ctx context.Context, ids ...string) error {
+	q.ids = append(q.ids, ids...)
+	return nil
+}
+
 type fixture struct {
 	*storeObject
 	source             *mock_source.MockStore
 	accountServiceStub *accountServiceStub
+	fulltextQueue      *fulltextQueueStub
 	sourceCreator      string
 	events             []*pb.EventMessage
 }
@@ -57,11 +67,14 @@ func newFixture(t *testing.T) *fixture {
 
 	sb := smarttest.New("chatId1")
 
-	object := New(sb, accountService, eventSender, db)
+	fulltextQueue := &fulltextQueueStub{}
+
+	object := New(sb, accountService, fulltextQueue, nil, eventSender, db)
 
 	fx := &fixture{
 		storeObject:        object.(*storeObject),
 		accountServiceStub: accountService,
+		fulltextQueue:      fulltextQueue,
 		sourceCreator:      testCreator,
 	}
 	eventSender.EXPECT().Broadcast(mock.Anything).Run(func(event *pb.Event) {
@@ -107,6 +120,13 @@ func TestAddMessage(t *testing.T) {
 
 	got := messages[0]
 	assertMessagesEqual(t, want, got)
+
+	assert.Equal(t, []string{"chatId1"}, fx.fulltextQueue.ids)
+	assert.Equal(t, []string{messageId}, fx.TakeChangedMessageIds())
+	assert.Empty(t, fx.TakeChangedMessageIds())
+
+	fx.RestoreChangedMessageIds([]string{messageId})
+	assert.Equal(t, []string{messageId}, fx.TakeChangedMessageIds())
 }
 
 func TestGetMessages(t *testing.T) {
@@ -201,6 +221,8 @@ func TestEditMessage(t *testing.T) {
 		assert.True(t, got.ModifiedAt > 0)
 		got.ModifiedAt = 0
 		assertMessagesEqual(t, want, got)
+
+		assert.Equal(t, []string{messageId}, fx.TakeChangedMessageIds())
 	})
 
 	t.Run("edit other's message", func(t *testing.T) {
@@ -255,8 +277,11 @@ func TestToggleReaction(t *testing.T) {
 	editedMessage.Message.Text = "edited text!"
 
 	t.Run("can toggle own reactions", func(t *testing.T) {
+		fx.TakeChangedMessageIds()
 		err = fx.ToggleMessageReaction(ctx, messageId, "👻")
 		require.NoError(t, err)
+		// reactions are not indexed
+		assert.Empty(t, fx.TakeChangedMessageIds())
 		err = fx.ToggleMessageReaction(ctx, messageId, "🐻")
 		require.NoError(t, err)
 		err = fx.ToggleMessageReaction(ctx, messageId, "👺")
@@ -0,0 +1,114 @@
+package chatobject
+
+import (
+	"github.com/anyproto/any-store/anyenc"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	commentsCollectionName = "comments"
+
+	threadIdKey   = "threadId"
+	anchorKey     = "anchor"
+	resolvedKey   = "resolved"
+	resolvedByKey = "resolvedBy"
+)
+
+/*
+Comments of the object are stored in the discussion object of the object, in the separate collection
+
+	{
+	  "id": "<changeCid>",
+	  "threadId": "<commentId>", // first comment of the thread, empty for the first comment itself
+	  "creator": "<authorId>",
+	  "createdAt": "<ts>",
+	  "modifiedAt": "<ts>",
+	  "content": { // [set] by the creator only
+	    "message": { "text": "comment text", "style": 0, "marks": [] }
+	  },
+	  "anchor": { // only in the first comment of the thread
+	    "blockId": "<blockId>",
+	    "from": 0,
+	    "to": 10,
+	    "quote": "commented text"
+	  },
+	  "resolved": false, // [set] only in the first comment of the thread
+	  "resolvedBy": "<userId>"
+	}
+*/
+
+type commentWrapper struct {
+	val   *anyenc.Value
+	arena *anyenc.Arena
+}
+
+func newCommentWrapper(arena *anyenc.Arena, val *anyenc.Value) *commentWrapper {
+	return &commentWrapper{arena: arena, val: val}
+}
+
+func (c *commentWrapper) getCreator() string {
+	return string(c.val.GetStringBytes(creatorKey))
+}
+
+func (c *commentWrapper) getThreadId() string {
+	return string(c.val.GetStringBytes(threadIdKey))
+}
+
+func (c *commentWrapper) setCreator(v string) {
+	c.val.Set(creatorKey, c.arena.NewString(v))
+}
+
+func (c *commentWrapper) setCreatedAt(v int64) {
+	c.val.Set(createdAtKey, c.arena.NewNumberInt(int(v)))
+}
+
+func marshalComment(arena *anyenc.Arena, comment *model.Comment) *anyenc.Value {
+	content := arena.NewObject()
+	content.Set("message", marshalMessageContent(arena, comment.Message))
+
+	root := arena.NewObject()
+	root.Set(creatorKey, arena.NewString(comment.Creator))
+	root.Set(createdAtKey, arena.NewNumberInt(int(comment.CreatedAt)))
+	root.Set(modifiedAtKey, arena.NewNumberInt(int(comment.ModifiedAt)))
+	root.Set(contentKey, content)
+	if comment.ThreadId != "" {
+		root.Set(threadIdKey, arena.NewString(comment.ThreadId))
+		return root
+	}
+	if anchor := comment.Anchor; anchor != nil {
+		val := arena.NewObject()
+		val.Set("blockId", arena.NewString(anchor.BlockId))
+		val.Set("from", arena.NewNumberInt(int(anchor.GetRange().GetFrom())))
+		val.Set("to", arena.NewNumberInt(int(anchor.GetRange().GetTo())))
+		val.Set("quote", arena.NewString(anchor.Quote))
+		root.Set(anchorKey, val)
+	}
+	root.Set(resolvedKey, arena.NewFalse())
+	return root
+}
+
+func (c *commentWrapper) toModel() *model.Comment {
+	comment := &model.Comment{
+		Id:         string(c.val.GetStringBytes("id")),
+		ThreadId:   c.getThreadId(),
+		OrderId:    string(c.val.GetStringBytes(orderKey, "id")),
+		Creator:    c.getCreator(),
+		CreatedAt:  int64(c.val.GetInt(createdAtKey)),
+		ModifiedAt: int64(c.val.GetInt(modifiedAtKey)),
+		Message:    messageContentToModel(c.val.Get(contentKey, "message")),
+		Resolved:   c.val.GetBool(resolvedKey),
+		ResolvedBy: string(c.val.GetStringBytes(resolvedByKey)),
+	}
+	if anchor := c.val.Get(anchorKey); anchor != nil {
+		comment.Anchor = &model.CommentAnchor{
+			BlockId: string(anchor.GetStringBytes("blockId")),
+			Range: &model.Range{
+				From: int32(anchor.GetInt("from")),
+				To:   int32(anchor.GetInt("to")),
+			},
+			Quote: string(anchor.GetStringBytes("quote")),
+		}
+	}
+	return comment
+}
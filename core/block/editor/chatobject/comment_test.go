@@ -0,0 +1,171 @@
+package chatobject
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type mentionNotifierStub struct {
+	comments []*model.Comment
+}
+
+func (n *mentionNotifierStub) NotifyMention(spaceId, objectId string, comment *model.Comment) {
+	n.comments = append(n.comments, comment)
+}
+
+func givenComment(threadId, text string) *model.Comment {
+	comment := &model.Comment{
+		ThreadId: threadId,
+		Message:  &model.ChatMessageMessageContent{Text: text},
+	}
+	if threadId == "" {
+		comment.Anchor = &model.CommentAnchor{
+			BlockId: "blockId1",
+			Range:   &model.Range{From: 1, To: 5},
+			Quote:   "quote",
+		}
+	}
+	return comment
+}
+
+func TestAddComment(t *testing.T) {
+	ctx := context.Background()
+	sessionCtx := session.NewContext()
+	fx := newFixture(t)
+
+	threadId, err := fx.AddComment(ctx, sessionCtx, givenComment("", "thread"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, sessionCtx.GetMessages())
+	replyId, err := fx.AddComment(ctx, nil, givenComment(threadId, "reply"))
+	require.NoError(t, err)
+
+	comments, err := fx.GetComments(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+
+	assert.Equal(t, threadId, comments[0].Id)
+	assert.Equal(t, testCreator, comments[0].Creator)
+	assert.Equal(t, "thread", comments[0].Message.Text)
+	assert.Equal(t, givenComment("", "").Anchor, comments[0].Anchor)
+	assert.False(t, comments[0].Resolved)
+
+	assert.Equal(t, replyId, comments[1].Id)
+	assert.Equal(t, threadId, comments[1].ThreadId)
+	assert.Nil(t, comments[1].Anchor)
+}
+
+func TestEditComment(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+
+	commentId, err := fx.AddComment(ctx, nil, givenComment("", "thread"))
+	require.NoError(t, err)
+
+	t.Run("edit own comment", func(t *testing.T) {
+		err = fx.EditComment(ctx, commentId, &model.ChatMessageMessageContent{Text: "edited"})
+		require.NoError(t, err)
+
+		comment, err := fx.GetComment(ctx, commentId)
+		require.NoError(t, err)
+		assert.Equal(t, "edited", comment.Message.Text)
+		assert.NotZero(t, comment.ModifiedAt)
+	})
+	t.Run("can't edit someone else's comment", func(t *testing.T) {
+		fx.sourceCreator = "accountId2"
+		defer func() { fx.sourceCreator = testCreator }()
+
+		_ = fx.EditComment(ctx, commentId, &model.ChatMessageMessageContent{Text: "changed"})
+
+		comment, err := fx.GetComment(ctx, commentId)
+		require.NoError(t, err)
+		assert.Equal(t, "edited", comment.Message.Text)
+	})
+}
+
+func TestSetThreadResolved(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+
+	threadId, err := fx.AddComment(ctx, nil, givenComment("", "thread"))
+	require.NoError(t, err)
+	replyId, err := fx.AddComment(ctx, nil, givenComment(threadId, "reply"))
+	require.NoError(t, err)
+
+	require.NoError(t, fx.SetThreadResolved(ctx, threadId, true))
+
+	comments, err := fx.GetComments(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, comments)
+
+	comments, err = fx.GetComments(ctx, true)
+	require.NoError(t, err)
+	require.Len(t, comments, 2)
+	assert.True(t, comments[0].Resolved)
+	assert.Equal(t, testCreator, comments[0].ResolvedBy)
+
+	t.Run("reopen", func(t *testing.T) {
+		require.NoError(t, fx.SetThreadResolved(ctx, threadId, false))
+
+		comments, err := fx.GetComments(ctx, false)
+		require.NoError(t, err)
+		require.Len(t, comments, 2)
+		assert.False(t, comments[0].Resolved)
+		assert.Empty(t, comments[0].ResolvedBy)
+	})
+	t.Run("reply can't be resolved", func(t *testing.T) {
+		assert.Error(t, fx.SetThreadResolved(ctx, replyId, true))
+	})
+}
+
+func TestDeleteComment(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+
+	threadId, err := fx.AddComment(ctx, nil, givenComment("", "thread"))
+	require.NoError(t, err)
+	_, err = fx.AddComment(ctx, nil, givenComment(threadId, "reply"))
+	require.NoError(t, err)
+
+	require.NoError(t, fx.DeleteComment(ctx, nil, threadId))
+
+	comments, err := fx.GetComments(ctx, true)
+	require.NoError(t, err)
+	assert.Empty(t, comments)
+}
+
+func TestCommentMentions(t *testing.T) {
+	ctx := context.Background()
+	fx := newFixture(t)
+	notifier := &mentionNotifierStub{}
+	fx.commentSubscription.mentionNotifier = notifier
+
+	mention := func(param string) *model.Comment {
+		comment := givenComment("", "hey")
+		comment.Message.Marks = []*model.BlockContentTextMark{{
+			Range: &model.Range{From: 0, To: 3},
+			Type:  model.BlockContentTextMark_Mention,
+			Param: param,
+		}}
+		return comment
+	}
+
+	fx.sourceCreator = "accountId2"
+	_, err := fx.AddComment(ctx, nil, mention(domain.NewParticipantId(fx.SpaceID(), testCreator)))
+	require.NoError(t, err)
+	_, err = fx.AddComment(ctx, nil, mention(domain.NewParticipantId(fx.SpaceID(), "accountId3")))
+	require.NoError(t, err)
+
+	fx.sourceCreator = testCreator
+	_, err = fx.AddComment(ctx, nil, mention(domain.NewParticipantId(fx.SpaceID(), testCreator)))
+	require.NoError(t, err)
+
+	require.Len(t, notifier.comments, 1)
+	assert.Equal(t, "accountId2", notifier.comments[0].Creator)
+}
@@ -0,0 +1,197 @@
+package chatobject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	anystore "github.com/anyproto/any-store"
+	"github.com/anyproto/any-store/anyenc"
+	"github.com/anyproto/any-store/query"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/storestate"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/core/session"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// MentionNotifier notifies the account about comments it is mentioned in
+type MentionNotifier interface {
+	NotifyMention(spaceId, objectId string, comment *model.Comment)
+}
+
+type CommentHandler struct {
+	subscription *commentSubscription
+}
+
+func (d CommentHandler) CollectionName() string {
+	return commentsCollectionName
+}
+
+func (d CommentHandler) Init(ctx context.Context, s *storestate.StoreState) (err error) {
+	coll, err := s.Collection(ctx, commentsCollectionName)
+	if err != nil {
+		return err
+	}
+	iErr := coll.EnsureIndex(ctx, anystore.IndexInfo{
+		Fields: []string{"_o.id"},
+	})
+	if iErr != nil && !errors.Is(iErr, anystore.ErrIndexExists) {
+		return iErr
+	}
+	return
+}
+
+func (d CommentHandler) BeforeCreate(ctx context.Context, ch storestate.ChangeOp) (err error) {
+	comment := newCommentWrapper(ch.Arena, ch.Value)
+	comment.setCreatedAt(ch.Change.Timestamp)
+	comment.setCreator(ch.Change.Creator)
+
+	model := comment.toModel()
+	model.OrderId = ch.Change.Order
+	d.subscription.set(model)
+	d.subscription.notifyMentions(model)
+	return
+}
+
+func (d CommentHandler) BeforeModify(ctx context.Context, ch storestate.ChangeOp) (mode storestate.ModifyMode, err error) {
+	return storestate.ModifyModeUpdate, nil
+}
+
+func (d CommentHandler) BeforeDelete(ctx context.Context, ch storestate.ChangeOp) (mode storestate.DeleteMode, err error) {
+	coll, err := ch.State.Collection(ctx, commentsCollectionName)
+	if err != nil {
+		return storestate.DeleteModeDelete, fmt.Errorf("get collection: %w", err)
+	}
+
+	commentId := ch.Change.Change.GetDelete().GetDocumentId()
+
+	doc, err := coll.FindId(ctx, commentId)
+	if err != nil {
+		return storestate.DeleteModeDelete, fmt.Errorf("get comment: %w", err)
+	}
+
+	comment := newCommentWrapper(ch.Arena, doc.Value())
+	if comment.getCreator() != ch.Change.Creator {
+		return storestate.DeleteModeDelete, errors.New("can't delete not own comment")
+	}
+
+	d.subscription.delete(commentId)
+	return storestate.DeleteModeDelete, nil
+}
+
+func (d CommentHandler) UpgradeKeyModifier(ch storestate.ChangeOp, key *pb.KeyModify, mod query.Modifier) query.Modifier {
+	return query.ModifyFunc(func(a *anyenc.Arena, v *anyenc.Value) (result *anyenc.Value, modified bool, err error) {
+		if len(key.KeyPath) == 0 {
+			return nil, false, fmt.Errorf("no key path")
+		}
+
+		comment := newCommentWrapper(a, v)
+		switch key.KeyPath[0] {
+		case contentKey:
+			if comment.getCreator() != ch.Change.Creator {
+				return v, false, errors.Join(storestate.ErrValidation, fmt.Errorf("can't modify someone else's comment"))
+			}
+		case resolvedKey, resolvedByKey:
+			if comment.getThreadId() != "" {
+				return v, false, errors.Join(storestate.ErrValidation, fmt.Errorf("only the thread can be resolved"))
+			}
+		default:
+			return nil, false, fmt.Errorf("invalid key path %s", key.KeyPath)
+		}
+
+		result, modified, err = mod.Modify(a, v)
+		if err != nil {
+			return nil, false, err
+		}
+
+		if modified {
+			switch key.KeyPath[0] {
+			case contentKey:
+				result.Set(modifiedAtKey, a.NewNumberInt(int(ch.Change.Timestamp)))
+			case resolvedByKey:
+				if resolvedBy := string(result.GetStringBytes(resolvedByKey)); resolvedBy != "" && resolvedBy != ch.Change.Creator {
+					return v, false, errors.Join(storestate.ErrValidation, fmt.Errorf("can't resolve on behalf of someone else"))
+				}
+			}
+			d.subscription.set(newCommentWrapper(a, result).toModel())
+		}
+
+		return result, modified, nil
+	})
+}
+
+// commentSubscription sends changes of comments to clients of the commented object
+type commentSubscription struct {
+	spaceId         string
+	objectId        string
+	myIdentity      string
+	eventSender     event.Sender
+	mentionNotifier MentionNotifier
+
+	sessionContext session.Context
+	eventsBuffer   []*pb.EventMessage
+}
+
+func newCommentSubscription(spaceId, objectId, myIdentity string, eventSender event.Sender, mentionNotifier MentionNotifier) *commentSubscription {
+	return &commentSubscription{
+		spaceId:         spaceId,
+		objectId:        objectId,
+		myIdentity:      myIdentity,
+		eventSender:     eventSender,
+		mentionNotifier: mentionNotifier,
+	}
+}
+
+// setSessionContext sets the session context for the current operation
+func (s *commentSubscription) setSessionContext(ctx session.Context) {
+	s.sessionContext = ctx
+}
+
+func (s *commentSubscription) flush() {
+	defer func() {
+		s.eventsBuffer = s.eventsBuffer[:0]
+	}()
+
+	if len(s.eventsBuffer) == 0 {
+		return
+	}
+
+	if s.sessionContext != nil {
+		s.sessionContext.SetMessages(s.objectId, slices.Clone(s.eventsBuffer))
+		s.sessionContext = nil
+	} else {
+		s.eventSender.Broadcast(&pb.Event{
+			ContextId: s.objectId,
+			Messages:  slices.Clone(s.eventsBuffer),
+		})
+	}
+}
+
+func (s *commentSubscription) set(comment *model.Comment) {
+	s.eventsBuffer = append(s.eventsBuffer, event.NewMessage(s.spaceId, &pb.EventMessageValueOfCommentSet{
+		CommentSet: &pb.EventCommentSet{Comment: comment},
+	}))
+}
+
+func (s *commentSubscription) delete(commentId string) {
+	s.eventsBuffer = append(s.eventsBuffer, event.NewMessage(s.spaceId, &pb.EventMessageValueOfCommentDelete{
+		CommentDelete: &pb.EventCommentDelete{Id: commentId},
+	}))
+}
+
+func (s *commentSubscription) notifyMentions(comment *model.Comment) {
+	if s.mentionNotifier == nil || comment.Creator == s.myIdentity {
+		return
+	}
+	participantId := domain.NewParticipantId(s.spaceId, s.myIdentity)
+	for _, mark := range comment.GetMessage().GetMarks() {
+		if mark.Type == model.BlockContentTextMark_Mention && (mark.Param == participantId || mark.Param == s.myIdentity) {
+			s.mentionNotifier.NotifyMention(s.spaceId, s.objectId, comment)
+			return
+		}
+	}
+}
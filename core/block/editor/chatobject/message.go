@@ -70,12 +70,12 @@ func (m *messageWrapper) setCreatedAt(v int64) {
 
 */
 
-func marshalModel(arena *anyenc.Arena, msg *model.ChatMessage) *anyenc.Value {
+func marshalMessageContent(arena *anyenc.Arena, content *model.ChatMessageMessageContent) *anyenc.Value {
 	message := arena.NewObject()
-	message.Set("text", arena.NewString(msg.Message.Text))
-	message.Set("style", arena.NewNumberInt(int(msg.Message.Style)))
+	message.Set("text", arena.NewString(content.GetText()))
+	message.Set("style", arena.NewNumberInt(int(content.GetStyle())))
 	marks := arena.NewArray()
-	for i, inMark := range msg.Message.Marks {
+	for i, inMark := range content.GetMarks() {
 		mark := arena.NewObject()
 		mark.Set("from", arena.NewNumberInt(int(inMark.Range.From)))
 		mark.Set("to", arena.NewNumberInt(int(inMark.Range.To)))
@@ -86,6 +86,11 @@ func marshalModel(arena *anyenc.Arena, msg *model.ChatMessage) *anyenc.Value {
 		marks.SetArrayItem(i, mark)
 	}
 	message.Set("marks", marks)
+	return message
+}
+
+func marshalModel(arena *anyenc.Arena, msg *model.ChatMessage) *anyenc.Value {
+	message := marshalMessageContent(arena, msg.Message)
 
 	attachments := arena.NewObject()
 	for i, inAttachment := range msg.Attachments {
@@ -133,7 +138,14 @@ func (m *messageWrapper) toModel() *model.ChatMessage {
 }
 
 func (m *messageWrapper) contentToModel() *model.ChatMessageMessageContent {
-	inMarks := m.val.GetArray(contentKey, "message", "marks")
+	return messageContentToModel(m.val.Get(contentKey, "message"))
+}
+
+func messageContentToModel(val *anyenc.Value) *model.ChatMessageMessageContent {
+	if val == nil {
+		return &model.ChatMessageMessageContent{}
+	}
+	inMarks := val.GetArray("marks")
 	marks := make([]*model.BlockContentTextMark, 0, len(inMarks))
 	for _, inMark := range inMarks {
 		mark := &model.BlockContentTextMark{
@@ -147,8 +159,8 @@ func (m *messageWrapper) contentToModel() *model.ChatMessageMessageContent {
 		marks = append(marks, mark)
 	}
 	return &model.ChatMessageMessageContent{
-		Text:  string(m.val.GetStringBytes(contentKey, "message", "text")),
-		Style: model.BlockContentTextStyle(m.val.GetInt("content", "message", "style")),
+		Text:  string(val.GetStringBytes("text")),
+		Style: model.BlockContentTextStyle(val.GetInt("style")),
 		Marks: marks,
 	}
 }
@@ -375,6 +375,7 @@ func (cb *clipboard) pasteAny(
 
 	destState := state.NewDoc(clipboardRootId, nil).(*state.State)
 
+	req.AnySlot = removeSyncedSubtrees(req.AnySlot)
 	for _, b := range req.AnySlot {
 		if b.Id == "" {
 			b.Id = bson.NewObjectId().Hex()
@@ -614,6 +615,41 @@ func (cb *clipboard) processFileBlock(f *model.BlockContentOfFile) {
 	f.File.TargetObjectId = objectId
 }
 
+// removeSyncedSubtrees removes blocks shown by copied synced blocks, because these blocks are stored in the source
+// object, and the pasted synced block shows them again. Blocks copied without their synced block are pasted as is
+func removeSyncedSubtrees(blocks []*model.Block) []*model.Block {
+	byId := make(map[string]*model.Block, len(blocks))
+	for _, b := range blocks {
+		byId[b.Id] = b
+	}
+	removed := make(map[string]struct{})
+	var remove func(ids []string)
+	remove = func(ids []string) {
+		for _, id := range ids {
+			if b, ok := byId[id]; ok {
+				removed[id] = struct{}{}
+				remove(b.ChildrenIds)
+			}
+		}
+	}
+	for _, b := range blocks {
+		if b.GetSynced() != nil {
+			remove(b.ChildrenIds)
+			b.ChildrenIds = nil
+		}
+	}
+	if len(removed) == 0 {
+		return blocks
+	}
+	result := make([]*model.Block, 0, len(blocks)-len(removed))
+	for _, b := range blocks {
+		if _, ok := removed[b.Id]; !ok {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
 func renderText(s *state.State, ignoreStyle bool) string {
 	texts := make([]string, 0)
 	texts, _ = renderBlock(s, texts, s.RootId(), -1, 0, ignoreStyle)
@@ -1894,3 +1894,27 @@ func TestProcessFileBlock(t *testing.T) {
 		assert.Equal(t, fileObject1, fb.File.TargetObjectId)
 	})
 }
+
+func TestRemoveSyncedSubtrees(t *testing.T) {
+	synced := &model.Block{
+		Id:          "synced",
+		ChildrenIds: []string{"source"},
+		Content: &model.BlockContentOfSynced{Synced: &model.BlockContentSynced{
+			TargetObjectId: "object",
+			TargetBlockId:  "source",
+		}},
+	}
+	blocks := []*model.Block{
+		synced,
+		{Id: "source", ChildrenIds: []string{"child"}, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "source"}}},
+		{Id: "child", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "child"}}},
+		{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "text"}}},
+	}
+
+	result := removeSyncedSubtrees(blocks)
+
+	require.Len(t, result, 2)
+	assert.Equal(t, "synced", result[0].Id)
+	assert.Empty(t, result[0].ChildrenIds)
+	assert.Equal(t, "text", result[1].Id)
+}
@@ -76,6 +76,7 @@ type ObjectFactory struct {
 	deviceService       deviceService
 	lastUsedUpdater     lastused.ObjectUsageUpdater
 	spaceIdResolver     idresolver.Resolver
+	mentionNotifier     chatobject.MentionNotifier
 }
 
 func NewObjectFactory() *ObjectFactory {
@@ -109,6 +110,7 @@ func (f *ObjectFactory) Init(a *app.App) (err error) {
 	f.deviceService = app.MustComponent[deviceService](a)
 	f.lastUsedUpdater = app.MustComponent[lastused.ObjectUsageUpdater](a)
 	f.spaceIdResolver = app.MustComponent[idresolver.Resolver](a)
+	f.mentionNotifier = app.MustComponent[chatobject.MentionNotifier](a)
 	return nil
 }
 
@@ -213,7 +215,7 @@ func (f *ObjectFactory) New(space smartblock.Space, sbType coresb.SmartBlockType
 	case coresb.SmartBlockTypeDevicesObject:
 		return NewDevicesObject(sb, f.deviceService), nil
 	case coresb.SmartBlockTypeChatDerivedObject:
-		return chatobject.New(sb, f.accountService, f.eventSender, f.objectStore.GetCrdtDb(space.Id())), nil
+		return chatobject.New(sb, f.accountService, f.objectStore, f.mentionNotifier, f.eventSender, f.objectStore.GetCrdtDb(space.Id())), nil
 	case coresb.SmartBlockTypeAccountObject:
 		return accountobject.New(sb, f.accountService.Keys(), store, f.layoutConverter, f.fileObjectService, f.lastUsedUpdater, f.objectStore.GetCrdtDb(space.Id()), f.config), nil
 	default:
@@ -66,3 +66,34 @@ func isBacklinksChanged(msgs []simple.EventMessage) bool {
 	}
 	return false
 }
+
+// collectBlockLinks returns links and mentions of the state pointing to blocks, links to blocks of the object itself
+// are kept, so cross-references inside long documents are tracked too
+func collectBlockLinks(s *state.State) []*model.BlockLink {
+	var links []*model.BlockLink
+	add := func(blockId, targetObjectId, targetBlockId string) {
+		if targetObjectId == "" || targetBlockId == "" {
+			return
+		}
+		links = append(links, &model.BlockLink{
+			ObjectId:       s.RootId(),
+			BlockId:        blockId,
+			TargetObjectId: targetObjectId,
+			TargetBlockId:  targetBlockId,
+		})
+	}
+	_ = s.Iterate(func(b simple.Block) (isContinue bool) {
+		switch content := b.Model().Content.(type) {
+		case *model.BlockContentOfLink:
+			add(b.Model().Id, content.Link.TargetBlockId, content.Link.FocusBlockId)
+		case *model.BlockContentOfText:
+			for _, m := range content.Text.GetMarks().GetMarks() {
+				if m.Type == model.BlockContentTextMark_Mention || m.Type == model.BlockContentTextMark_Object {
+					add(b.Model().Id, m.Param, m.FocusBlockId)
+				}
+			}
+		}
+		return true
+	})
+	return links
+}
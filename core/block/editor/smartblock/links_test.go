@@ -0,0 +1,47 @@
+package smartblock
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestCollectBlockLinks(t *testing.T) {
+	mention := func(param, focusBlockId string) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{
+			Range:        &model.Range{From: 0, To: 1},
+			Type:         model.BlockContentTextMark_Mention,
+			Param:        param,
+			FocusBlockId: focusBlockId,
+		}
+	}
+	blocks := map[string]simple.Block{}
+	for _, b := range []*model.Block{
+		{Id: "page", ChildrenIds: []string{"text", "link", "objectLink", "selfLink"}},
+		{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text: "mentions",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+				mention("spec", "heading"),
+				mention("spec", ""),
+				{Range: &model.Range{From: 0, To: 1}, Type: model.BlockContentTextMark_Bold},
+			}},
+		}}},
+		{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "spec", FocusBlockId: "section"}}},
+		{Id: "objectLink", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "spec"}}},
+		{Id: "selfLink", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page", FocusBlockId: "text"}}},
+	} {
+		blocks[b.Id] = simple.New(b)
+	}
+
+	links := collectBlockLinks(state.NewDoc("page", blocks).(*state.State))
+
+	assert.ElementsMatch(t, []*model.BlockLink{
+		{ObjectId: "page", BlockId: "text", TargetObjectId: "spec", TargetBlockId: "heading"},
+		{ObjectId: "page", BlockId: "link", TargetObjectId: "spec", TargetBlockId: "section"},
+		{ObjectId: "page", BlockId: "selfLink", TargetObjectId: "page", TargetBlockId: "text"},
+	}, links)
+}
@@ -192,6 +192,9 @@ type DocInfo struct {
 	Details *domain.Details
 
 	SmartblockType smartblock.SmartBlockType
+
+	// BlockLinks are links and mentions pointing to blocks of objects
+	BlockLinks []*model.BlockLink
 }
 
 // TODO Maybe create constructor? Don't want to forget required fields
@@ -235,8 +238,12 @@ type smartBlock struct {
 	undo                 undo.History
 	source               source.Source
 	lastDepDetails       map[string]*domain.Details
+	syncedViews          map[string]*syncedView // subtrees shown by synced blocks, by id of the synced block
+	syncedLoads          map[string]uint64      // generations of the latest pending loads of subtrees, by id of the synced block
+	syncedGeneration     uint64
 	restrictions         restriction.Restrictions
 	isDeleted            bool
+	isClosed             bool // background routines must not touch the object after close
 	disableLayouts       bool
 
 	includeRelationObjectsAsDependents bool // used by some clients
@@ -461,6 +468,10 @@ func (sb *smartBlock) fetchMeta() (details []*model.ObjectViewDetailsSet, err er
 	depIds := sb.dependentSmartIds(sb.includeRelationObjectsAsDependents, true, true)
 	sb.setDependentIDs(depIds)
 
+	// clients receive the object from scratch, so subtrees of synced blocks are sent again
+	sb.syncedViews = nil
+	sb.refreshSyncedBlocks()
+
 	perSpace := sb.partitionIdsBySpace(sb.depIds)
 
 	recordsCh := make(chan *domain.Details, 10)
@@ -563,6 +574,9 @@ func (sb *smartBlock) onMetaChange(details *domain.Details) {
 		return
 	}
 	id := details.GetString(bundle.RelationKeyId)
+	if len(sb.sessions) > 0 {
+		sb.refreshSyncedBlocks(id)
+	}
 	var msgs []*pb.EventMessage
 	if v, exists := sb.lastDepDetails[id]; exists {
 		diff := domain.StructDiff(v, details)
@@ -852,6 +866,9 @@ func (sb *smartBlock) ResetToVersion(s *state.State) (err error) {
 func (sb *smartBlock) CheckSubscriptions() (changed bool) {
 	depIDs := sb.dependentSmartIds(sb.includeRelationObjectsAsDependents, true, true)
 	changed = sb.setDependentIDs(depIDs)
+	if len(sb.sessions) > 0 {
+		sb.refreshSyncedBlocks()
+	}
 
 	if sb.recordsSub == nil {
 		return true
@@ -1154,6 +1171,7 @@ func (sb *smartBlock) Close() (err error) {
 }
 
 func (sb *smartBlock) closeLocked() (err error) {
+	sb.isClosed = true
 	sb.execHooks(HookOnClose, ApplyInfo{State: sb.Doc.(*state.State)})
 	if sb.closeRecordsSub != nil {
 		sb.closeRecordsSub()
@@ -1343,6 +1361,7 @@ func (sb *smartBlock) getDocInfo(st *state.State) DocInfo {
 		Id:             sb.Id(),
 		Space:          sb.Space(),
 		Links:          links,
+		BlockLinks:     collectBlockLinks(st),
 		Heads:          heads,
 		Creator:        creator,
 		Details:        sb.CombinedDetails(),
@@ -0,0 +1,224 @@
+package smartblock
+
+import (
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/synced"
+	"github.com/anyproto/anytype-heart/core/event"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// syncedView is the subtree of the source object last sent to clients of the object
+type syncedView struct {
+	source syncedSource
+	blocks map[string]simple.Block
+}
+
+type syncedSource struct {
+	objectId string
+	blockId  string
+}
+
+// syncedSources returns sources of synced blocks of the object by ids of synced blocks. Blocks of the source
+// keep their ids, so the same source is shown only by the first synced block, and the object can't be the source of itself
+func (sb *smartBlock) syncedSources() map[string]syncedSource {
+	var (
+		sources map[string]syncedSource
+		shown   = make(map[syncedSource]struct{})
+	)
+	_ = sb.Doc.Iterate(func(b simple.Block) (isContinue bool) {
+		s, ok := b.(synced.Block)
+		if !ok {
+			return true
+		}
+		objectId, blockId := s.Source()
+		source := syncedSource{objectId: objectId, blockId: blockId}
+		if _, exists := shown[source]; exists || objectId == sb.Id() {
+			return true
+		}
+		shown[source] = struct{}{}
+		if sources == nil {
+			sources = make(map[string]syncedSource)
+		}
+		sources[b.Model().Id] = source
+		return true
+	})
+	return sources
+}
+
+// refreshSyncedBlocks sends subtrees of the given source objects and of synced blocks not shown yet to clients
+// of the object. Sources are read in the background, see synced.Block. Loads may finish in any order,
+// so a subtree is applied only by the latest load of its synced block
+func (sb *smartBlock) refreshSyncedBlocks(objectIds ...string) {
+	sources := sb.syncedSources()
+	toLoad := make(map[string]syncedSource, len(sources))
+	for id, source := range sources {
+		if view, ok := sb.syncedViews[id]; !ok || view.source != source || slices.Contains(objectIds, source.objectId) {
+			toLoad[id] = source
+		}
+	}
+	var hasStaleViews bool
+	for id := range sb.syncedViews {
+		if _, ok := sources[id]; !ok {
+			hasStaleViews = true
+		}
+	}
+	if len(toLoad) == 0 && !hasStaleViews {
+		return
+	}
+	sb.syncedGeneration++
+	generation := sb.syncedGeneration
+	if sb.syncedLoads == nil {
+		sb.syncedLoads = make(map[string]uint64)
+	}
+	for id := range toLoad {
+		sb.syncedLoads[id] = generation
+	}
+	go func() {
+		subtrees := make(map[string][]simple.Block, len(toLoad))
+		for id, source := range toLoad {
+			subtrees[id] = sb.loadSyncedSubtree(source)
+		}
+		sb.Lock()
+		defer sb.Unlock()
+		if sb.isClosed {
+			return
+		}
+		if msgs := sb.applySyncedLoad(generation, subtrees); len(msgs) > 0 {
+			sb.SendEvent(msgs)
+		}
+	}()
+}
+
+// applySyncedLoad returns events for the subtrees loaded by the given generation of refresh,
+// skipping subtrees which are loaded again by a later refresh
+func (sb *smartBlock) applySyncedLoad(generation uint64, subtrees map[string][]simple.Block) []*pb.EventMessage {
+	for id := range subtrees {
+		if sb.syncedLoads[id] != generation {
+			delete(subtrees, id)
+			continue
+		}
+		delete(sb.syncedLoads, id)
+	}
+	return sb.syncedEvents(subtrees)
+}
+
+// loadSyncedSubtree returns copies of blocks of the subtree, nil is returned when the source is not available.
+// Synced blocks can't be nested, so synced blocks inside the subtree are not shown
+func (sb *smartBlock) loadSyncedSubtree(source syncedSource) (blocks []simple.Block) {
+	err := sb.space.Do(source.objectId, func(src SmartBlock) error {
+		var restrictions = &model.BlockRestrictions{Remove: true, Drag: true}
+		if src.Restrictions().Object.Check(model.Restrictions_Blocks) != nil {
+			restrictions.Edit = true
+		}
+		var collect func(id string)
+		collect = func(id string) {
+			b := src.Pick(id)
+			if b == nil {
+				return
+			}
+			if _, ok := b.(synced.Block); ok {
+				return
+			}
+			b = b.Copy()
+			b.Model().Restrictions = restrictions
+			blocks = append(blocks, b)
+			for _, childId := range b.Model().ChildrenIds {
+				collect(childId)
+			}
+		}
+		collect(source.blockId)
+		return nil
+	})
+	if err != nil {
+		log.With("objectID", sb.Id()).Warn("failed to load source of synced block", zap.String("sourceId", source.objectId), zap.Error(err))
+	}
+	return blocks
+}
+
+// syncedEvents updates subtrees shown by synced blocks and returns events for clients. Blocks of subtrees
+// are not a part of the object state, so these events are never converted to changes
+func (sb *smartBlock) syncedEvents(subtrees map[string][]simple.Block) (msgs []*pb.EventMessage) {
+	sources := sb.syncedSources()
+	if sb.syncedViews == nil {
+		sb.syncedViews = make(map[string]*syncedView)
+	}
+	var removedIds []string
+	for id, view := range sb.syncedViews {
+		if _, ok := sources[id]; !ok {
+			for blockId := range view.blocks {
+				removedIds = append(removedIds, blockId)
+			}
+			delete(sb.syncedViews, id)
+		}
+	}
+	var added []*model.Block
+	for id, blocks := range subtrees {
+		source, ok := sources[id]
+		if !ok {
+			continue
+		}
+		view := sb.syncedViews[id]
+		if view == nil || view.source != source {
+			if view != nil {
+				for blockId := range view.blocks {
+					removedIds = append(removedIds, blockId)
+				}
+			}
+			view = &syncedView{source: source, blocks: map[string]simple.Block{}}
+			sb.syncedViews[id] = view
+		}
+		newBlocks := make(map[string]simple.Block, len(blocks))
+		for _, b := range blocks {
+			blockId := b.Model().Id
+			if sb.Doc.Pick(blockId) != nil {
+				// ids of blocks shown in the object must be unique
+				continue
+			}
+			newBlocks[blockId] = b
+			prev, exists := view.blocks[blockId]
+			if !exists {
+				added = append(added, b.Model())
+				continue
+			}
+			diff, err := prev.Diff(sb.SpaceID(), b)
+			if err != nil {
+				log.With("objectID", sb.Id()).Warn("failed to make diff of synced block", zap.Error(err))
+				continue
+			}
+			for _, msg := range diff {
+				msgs = append(msgs, msg.Msg)
+			}
+		}
+		for blockId := range view.blocks {
+			if _, ok := newBlocks[blockId]; !ok {
+				removedIds = append(removedIds, blockId)
+			}
+		}
+		if wasShown, shown := len(view.blocks) > 0, len(newBlocks) > 0; wasShown != shown {
+			var childrenIds []string
+			if shown {
+				childrenIds = []string{source.blockId}
+			}
+			msgs = append(msgs, event.NewMessage(sb.SpaceID(), &pb.EventMessageValueOfBlockSetChildrenIds{
+				BlockSetChildrenIds: &pb.EventBlockSetChildrenIds{Id: id, ChildrenIds: childrenIds},
+			}))
+		}
+		view.blocks = newBlocks
+	}
+	if len(added) > 0 {
+		msgs = append([]*pb.EventMessage{event.NewMessage(sb.SpaceID(), &pb.EventMessageValueOfBlockAdd{
+			BlockAdd: &pb.EventBlockAdd{Blocks: added},
+		})}, msgs...)
+	}
+	if len(removedIds) > 0 {
+		msgs = append(msgs, event.NewMessage(sb.SpaceID(), &pb.EventMessageValueOfBlockDelete{
+			BlockDelete: &pb.EventBlockDelete{BlockIds: removedIds},
+		}))
+	}
+	return msgs
+}
@@ -0,0 +1,124 @@
+package smartblock
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	_ "github.com/anyproto/anytype-heart/core/block/simple/synced"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestSmartBlock_syncedEvents(t *testing.T) {
+	newSynced := func(id, objectId, blockId string) *model.Block {
+		return &model.Block{Id: id, Content: &model.BlockContentOfSynced{Synced: &model.BlockContentSynced{
+			TargetObjectId: objectId,
+			TargetBlockId:  blockId,
+		}}}
+	}
+	newText := func(id, text string, childrenIds ...string) simple.Block {
+		return simple.New(&model.Block{Id: id, ChildrenIds: childrenIds, Content: &model.BlockContentOfText{
+			Text: &model.BlockContentText{Text: text},
+		}})
+	}
+
+	blocks := map[string]simple.Block{}
+	for _, b := range []*model.Block{
+		{Id: "host", ChildrenIds: []string{"synced", "synced2", "self"}},
+		newSynced("synced", "source", "root"),
+		newSynced("synced2", "source", "root"),
+		newSynced("self", "host", "host"),
+	} {
+		blocks[b.Id] = simple.New(b)
+	}
+	fx := &smartBlock{
+		Doc:    state.NewDoc("host", blocks),
+		source: &sourceStub{id: "host", spaceId: testSpaceId},
+	}
+
+	t.Run("sources", func(t *testing.T) {
+		assert.Equal(t, map[string]syncedSource{
+			"synced": {objectId: "source", blockId: "root"},
+		}, fx.syncedSources())
+	})
+
+	const id = "synced"
+
+	t.Run("subtree is shown", func(t *testing.T) {
+		msgs := fx.syncedEvents(map[string][]simple.Block{
+			id: {newText("root", "source", "child"), newText("child", "child")},
+		})
+		require.Len(t, msgs, 2)
+		assert.Len(t, msgs[0].GetBlockAdd().Blocks, 2)
+		assert.Equal(t, []string{"root"}, msgs[1].GetBlockSetChildrenIds().ChildrenIds)
+	})
+	t.Run("subtree is changed", func(t *testing.T) {
+		msgs := fx.syncedEvents(map[string][]simple.Block{
+			id: {newText("root", "changed")},
+		})
+		require.Len(t, msgs, 3)
+		assert.Empty(t, msgs[0].GetBlockSetChildrenIds().ChildrenIds)
+		assert.Equal(t, "changed", msgs[1].GetBlockSetText().Text.Value)
+		assert.Equal(t, []string{"child"}, msgs[2].GetBlockDelete().BlockIds)
+	})
+	t.Run("source is not available", func(t *testing.T) {
+		msgs := fx.syncedEvents(map[string][]simple.Block{id: nil})
+		require.Len(t, msgs, 2)
+		assert.Nil(t, msgs[0].GetBlockSetChildrenIds().ChildrenIds)
+		assert.Equal(t, []string{"root"}, msgs[1].GetBlockDelete().BlockIds)
+	})
+	t.Run("outdated load is skipped", func(t *testing.T) {
+		fx.syncedLoads = map[string]uint64{id: 2}
+		msgs := fx.applySyncedLoad(1, map[string][]simple.Block{id: {newText("root", "outdated")}})
+		assert.Empty(t, msgs)
+
+		msgs = fx.applySyncedLoad(2, map[string][]simple.Block{id: {newText("root", "latest")}})
+		require.Len(t, msgs, 2)
+		assert.Equal(t, "latest", msgs[0].GetBlockAdd().Blocks[0].GetText().Text)
+		assert.Empty(t, fx.syncedLoads)
+	})
+}
+
+func TestSmartBlock_loadSyncedSubtree(t *testing.T) {
+	blocks := map[string]simple.Block{}
+	for _, b := range []*model.Block{
+		{Id: "source", ChildrenIds: []string{"root"}},
+		{Id: "root", ChildrenIds: []string{"child", "nested"}, Content: &model.BlockContentOfText{Text: &model.BlockContentText{}}},
+		{Id: "child", Content: &model.BlockContentOfText{Text: &model.BlockContentText{}}},
+		{Id: "nested", Content: &model.BlockContentOfSynced{Synced: &model.BlockContentSynced{TargetObjectId: "other", TargetBlockId: "block"}}},
+	} {
+		blocks[b.Id] = simple.New(b)
+	}
+	source := &smartBlock{
+		Doc:    state.NewDoc("source", blocks),
+		source: &sourceStub{id: "source", spaceId: testSpaceId},
+	}
+	fx := &smartBlock{
+		source: &sourceStub{id: "host", spaceId: testSpaceId},
+		space:  &syncedSpaceStub{objects: map[string]SmartBlock{"source": source}},
+	}
+
+	var ids []string
+	for _, b := range fx.loadSyncedSubtree(syncedSource{objectId: "source", blockId: "root"}) {
+		ids = append(ids, b.Model().Id)
+	}
+	// synced blocks can't be nested
+	assert.Equal(t, []string{"root", "child"}, ids)
+}
+
+type syncedSpaceStub struct {
+	Space
+	objects map[string]SmartBlock
+}
+
+func (s *syncedSpaceStub) Do(objectId string, apply func(sb SmartBlock) error) error {
+	sb, ok := s.objects[objectId]
+	if !ok {
+		return fmt.Errorf("object %s not found", objectId)
+	}
+	return apply(sb)
+}
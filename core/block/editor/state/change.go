@@ -10,6 +10,7 @@ import (
 	"golang.org/x/exp/slices"
 
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/relationutils"
 	"github.com/anyproto/anytype-heart/pb"
@@ -492,7 +493,7 @@ func (s *State) fillChanges(msgs []simple.EventMessage) {
 		case *pb.EventMessageValueOfBlockSetDiv:
 			updMsgs = append(updMsgs, msg.Msg)
 		case *pb.EventMessageValueOfBlockSetText:
-			updMsgs = append(updMsgs, msg.Msg)
+			updMsgs = append(updMsgs, s.withTextEdits(msg.Msg, o.BlockSetText))
 		case *pb.EventMessageValueOfBlockSetFields:
 			updMsgs = append(updMsgs, msg.Msg)
 		case *pb.EventMessageValueOfBlockSetFile:
@@ -617,6 +618,42 @@ func (s *State) fillChanges(msgs []simple.EventMessage) {
 	s.changes = append(s.changes, s.makeDeviceInfoChanges()...)
 }
 
+// withTextEdits returns the copy of the message with edits made to the previous text of the block and marks of the
+// previous text. They are stored only in changes and let concurrent changes of the same block be merged
+func (s *State) withTextEdits(msg *pb.EventMessage, set *pb.EventBlockSetText) *pb.EventMessage {
+	if set.Text == nil && set.Marks == nil {
+		return msg
+	}
+	orig := s.PickOrigin(set.Id)
+	if orig == nil || orig.Model().GetText() == nil {
+		return msg
+	}
+	prev := orig.Model().GetText()
+	value := prev.Text
+	if set.Text != nil {
+		value = set.Text.Value
+	}
+	withEdits := *set
+	withEdits.Text = &pb.EventBlockSetTextText{
+		Value: value,
+		Edits: text.Edits(prev.Text, value),
+	}
+	if set.Marks != nil {
+		prevMarks := prev.Marks
+		if prevMarks == nil {
+			prevMarks = &model.BlockContentTextMarks{}
+		}
+		withEdits.Marks = &pb.EventBlockSetTextMarks{
+			Value:    set.Marks.Value,
+			Previous: prevMarks,
+		}
+	}
+	return &pb.EventMessage{
+		SpaceId: msg.SpaceId,
+		Value:   &pb.EventMessageValueOfBlockSetText{BlockSetText: &withEdits},
+	}
+}
+
 func (s *State) filterLocalAndDerivedRelations(newRelLinks pbtypes.RelationLinks) pbtypes.RelationLinks {
 	var relLinksWithoutLocal pbtypes.RelationLinks
 	for _, link := range newRelLinks {
@@ -10,6 +10,7 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/dataview"
+	"github.com/anyproto/anytype-heart/core/block/simple/text"
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -995,3 +996,115 @@ func TestTableChanges(t *testing.T) {
 
 	})
 }
+
+func TestState_ConcurrentTextChanges(t *testing.T) {
+	bold := func(from, to int32) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{Range: &model.Range{From: from, To: to}, Type: model.BlockContentTextMark_Bold}
+	}
+	d := NewDoc("root", nil).(*State)
+	s := d.NewState()
+	s.Add(simple.New(&model.Block{Id: "root", ChildrenIds: []string{"text"}}))
+	s.Add(simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "Hello world"}}}))
+	_, _, err := ApplyState("", s, true)
+	require.NoError(t, err)
+
+	editText := func(value string, marks ...*model.BlockContentTextMark) []*pb.ChangeContent {
+		s := d.Copy().NewState()
+		s.Get("text").(text.Block).SetText(value, &model.BlockContentTextMarks{Marks: marks})
+		_, _, err := ApplyState("", s, true)
+		require.NoError(t, err)
+		return s.GetChanges()
+	}
+	changesA := editText("Hello big world")
+	changesB := editText("Hello world!", bold(6, 11))
+
+	applyChanges := func(changes ...[]*pb.ChangeContent) *model.BlockContentText {
+		doc := d.Copy()
+		for _, ch := range changes {
+			s := doc.NewState()
+			require.NoError(t, s.ApplyChange(ch...))
+			_, _, err := ApplyStateFastOne("", s)
+			require.NoError(t, err)
+		}
+		return doc.Pick("text").Model().GetText()
+	}
+
+	t.Run("changes keep edits of the previous text", func(t *testing.T) {
+		require.Len(t, changesA, 1)
+		edits := changesA[0].GetBlockUpdate().Events[0].GetBlockSetText().Text.Edits
+		assert.Equal(t, []*pb.EventBlockSetTextTextEdit{{From: 6, Inserted: "big "}}, edits)
+	})
+	t.Run("concurrent edits of the block are merged", func(t *testing.T) {
+		ab := applyChanges(changesA, changesB)
+		ba := applyChanges(changesB, changesA)
+		assert.Equal(t, "Hello big world!", ab.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{bold(10, 15)}, ab.Marks.Marks)
+		assert.Equal(t, ab, ba)
+	})
+}
+
+func TestState_ConcurrentMarksChanges(t *testing.T) {
+	bold := func(from, to int32) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{Range: &model.Range{From: from, To: to}, Type: model.BlockContentTextMark_Bold}
+	}
+	italic := func(from, to int32) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{Range: &model.Range{From: from, To: to}, Type: model.BlockContentTextMark_Italic}
+	}
+	d := NewDoc("root", nil).(*State)
+	s := d.NewState()
+	s.Add(simple.New(&model.Block{Id: "root", ChildrenIds: []string{"text"}}))
+	s.Add(simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text:  "Hello world",
+		Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{bold(6, 11)}},
+	}}}))
+	_, _, err := ApplyState("", s, true)
+	require.NoError(t, err)
+
+	editText := func(value string, marks ...*model.BlockContentTextMark) []*pb.ChangeContent {
+		s := d.Copy().NewState()
+		s.Get("text").(text.Block).SetText(value, &model.BlockContentTextMarks{Marks: marks})
+		_, _, err := ApplyState("", s, true)
+		require.NoError(t, err)
+		return s.GetChanges()
+	}
+	applyChanges := func(changes ...[]*pb.ChangeContent) *model.BlockContentText {
+		doc := d.Copy()
+		for _, ch := range changes {
+			s := doc.NewState()
+			require.NoError(t, s.ApplyChange(ch...))
+			_, _, err := ApplyStateFastOne("", s)
+			require.NoError(t, err)
+		}
+		return doc.Pick("text").Model().GetText()
+	}
+
+	t.Run("removed mark stays removed when the other side inserts text", func(t *testing.T) {
+		removeBold := editText("Hello world")
+		insertText := editText("Hello big world", bold(10, 15))
+
+		ab := applyChanges(removeBold, insertText)
+		ba := applyChanges(insertText, removeBold)
+		assert.Equal(t, "Hello big world", ab.Text)
+		assert.Empty(t, ab.Marks.Marks)
+		assert.Equal(t, ab, ba)
+	})
+	t.Run("marks added by both sides are kept", func(t *testing.T) {
+		addItalic := editText("Hello world", bold(6, 11), italic(0, 5))
+		insertText := editText("Hello big world!", bold(10, 15))
+
+		ab := applyChanges(addItalic, insertText)
+		ba := applyChanges(insertText, addItalic)
+		assert.Equal(t, "Hello big world!", ab.Text)
+		assert.ElementsMatch(t, []*model.BlockContentTextMark{italic(0, 5), bold(10, 15)}, ab.Marks.Marks)
+		assert.Equal(t, ab, ba)
+	})
+	t.Run("mark removed by one side and extended by the other", func(t *testing.T) {
+		removeBold := editText("Hello world")
+		extendBold := editText("Hello world", bold(0, 11))
+
+		ab := applyChanges(removeBold, extendBold)
+		ba := applyChanges(extendBold, removeBold)
+		assert.Equal(t, []*model.BlockContentTextMark{bold(0, 6)}, ab.Marks.Marks)
+		assert.Equal(t, ab, ba)
+	})
+}
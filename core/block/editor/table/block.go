@@ -81,6 +81,9 @@ func (b *block) Duplicate(s *state.State) (newID string, visitedIds []string, bl
 		row = row.Copy()
 		row.Model().Id = genID()
 		blocks = append(blocks, row)
+		for _, span := range row.Model().GetTableRow().GetCellSpans() {
+			span.ColumnId = colMapping[span.ColumnId]
+		}
 
 		for j, cellID := range row.Model().ChildrenIds {
 			_, oldColID, err2 := ParseCellID(cellID)
@@ -130,6 +133,7 @@ func (b *block) Normalize(s *state.State) error {
 		// actually we cannot get error here, as all rows are checked in normalizeRows
 		log.Errorf("normalize header rows: %v", err)
 	}
+	tb.normalizeCellSpans()
 	return nil
 }
 
@@ -141,6 +141,8 @@ func TestDuplicate(t *testing.T) {
 			"row1-col3": mkTextBlock("13"),
 			"row2-col1": mkTextBlock("21"),
 			"row2-col2": mkTextBlock("22"),
+		}), withRowBlockContents(map[string]*model.BlockContentTableRow{
+			"row1": mkSpans("col1", 1, 2),
 		}))
 	old, err := NewTable(s, "table")
 	require.NoError(t, err)
@@ -177,4 +179,7 @@ func TestDuplicate(t *testing.T) {
 
 		assertNotEqual(oldRow.Model(), newRow.Model())
 	}
+
+	spans, _ := got.MergedCells()
+	assert.Equal(t, map[string]CellSpan{MakeCellID(got.RowIDs()[0], got.ColumnIDs()[0]): {RowSpan: 1, ColSpan: 2}}, spans)
 }
@@ -3,6 +3,7 @@ package table
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 
 	"github.com/globalsign/mgo/bson"
@@ -38,6 +39,9 @@ type TableEditor interface {
 	Expand(s *state.State, req pb.RpcBlockTableExpandRequest) error
 	Sort(s *state.State, req pb.RpcBlockTableSortRequest) error
 
+	CellListMerge(s *state.State, req pb.RpcBlockTableCellListMergeRequest) error
+	CellListSplit(s *state.State, req pb.RpcBlockTableCellListSplitRequest) error
+
 	cleanupTables(_ smartblock.ApplyInfo) error
 	cloneColumnStyles(s *state.State, srcColID string, targetColID string) error
 }
@@ -199,6 +203,7 @@ func (t *editor) CellCreate(s *state.State, rowID string, colID string, b *model
 }
 
 func (t *editor) RowCreate(s *state.State, req pb.RpcBlockTableRowCreateRequest) (string, error) {
+	targetID := req.TargetId
 	switch req.Position {
 	case model.Block_Top, model.Block_Bottom:
 	case model.Block_Inner:
@@ -215,7 +220,10 @@ func (t *editor) RowCreate(s *state.State, req pb.RpcBlockTableRowCreateRequest)
 	if err != nil {
 		return "", err
 	}
-	if err := s.InsertTo(req.TargetId, req.Position, rowID); err != nil {
+	err = keepMergedRegions(s, targetID, func() error {
+		return s.InsertTo(req.TargetId, req.Position, rowID)
+	})
+	if err != nil {
 		return "", fmt.Errorf("insert row: %w", err)
 	}
 	return rowID, nil
@@ -227,10 +235,12 @@ func (t *editor) RowDelete(s *state.State, req pb.RpcBlockTableRowDeleteRequest)
 		return fmt.Errorf("pick target row: %w", err)
 	}
 
-	if !s.Unlink(req.TargetId) {
-		return fmt.Errorf("unlink row block")
-	}
-	return nil
+	return keepMergedRegions(s, req.TargetId, func() error {
+		if !s.Unlink(req.TargetId) {
+			return fmt.Errorf("unlink row block")
+		}
+		return nil
+	})
 }
 
 func (t *editor) RowDuplicate(s *state.State, req pb.RpcBlockTableRowDuplicateRequest) (newRowID string, err error) {
@@ -246,6 +256,12 @@ func (t *editor) RowDuplicate(s *state.State, req pb.RpcBlockTableRowDuplicateRe
 		return "", fmt.Errorf("pick target row: %w", err)
 	}
 
+	var regions []mergedRegion
+	tb, err := NewTable(s, req.TargetId)
+	if err == nil {
+		regions = tb.mergedRegions()
+	}
+
 	newRow := srcRow.Copy()
 	newRow.Model().Id = t.generateRowID()
 	if !s.Add(newRow) {
@@ -273,6 +289,16 @@ func (t *editor) RowDuplicate(s *state.State, req pb.RpcBlockTableRowDuplicateRe
 		newRow.Model().ChildrenIds[i] = newCell.Model().Id
 	}
 
+	if tb != nil {
+		// cells merged within the source row are merged in the copy too
+		for _, r := range regions {
+			if len(r.rowIDs) == 1 && r.rowIDs[0] == req.BlockId {
+				regions = append(regions, mergedRegion{rowIDs: []string{newRow.Model().Id}, colIDs: r.colIDs})
+			}
+		}
+		tb.setMergedRegions(regions)
+	}
+
 	return newRow.Model().Id, nil
 }
 
@@ -346,7 +372,11 @@ func (t *editor) RowSetHeader(s *state.State, req pb.RpcBlockTableRowSetHeaderRe
 	if row.Model().GetTableRow().IsHeader != req.IsHeader {
 		row.Model().GetTableRow().IsHeader = req.IsHeader
 
-		err = tb.normalizeHeaderRows()
+		// the row may be moved to the other part of the table, so it can not stay merged with neighbours
+		tb.splitMergedRegions(func(r mergedRegion) bool {
+			return len(r.rowIDs) > 1 && r.containsRow(req.TargetId)
+		})
+		err = keepMergedRegions(s, req.TargetId, tb.normalizeHeaderRows)
 		if err != nil {
 			return fmt.Errorf("normalize rows: %w", err)
 		}
@@ -381,7 +411,10 @@ func (t *editor) ColumnCreate(s *state.State, req pb.RpcBlockTableColumnCreateRe
 	if err != nil {
 		return "", err
 	}
-	if err = s.InsertTo(req.TargetId, req.Position, colID); err != nil {
+	err = keepMergedRegions(s, req.TargetId, func() error {
+		return s.InsertTo(req.TargetId, req.Position, colID)
+	})
+	if err != nil {
 		return "", fmt.Errorf("insert column header: %w", err)
 	}
 
@@ -399,6 +432,13 @@ func (t *editor) ColumnDelete(s *state.State, req pb.RpcBlockTableColumnDeleteRe
 		return fmt.Errorf("initialize table state: %w", err)
 	}
 
+	return keepMergedRegions(s, req.TargetId, func() error {
+		return tb.deleteColumn(req.TargetId)
+	})
+}
+
+func (tb Table) deleteColumn(targetID string) error {
+	s := tb.s
 	for _, rowID := range tb.RowIDs() {
 		row, err := pickRow(s, rowID)
 		if err != nil {
@@ -411,7 +451,7 @@ func (t *editor) ColumnDelete(s *state.State, req pb.RpcBlockTableColumnDeleteRe
 				return fmt.Errorf("parse cell id %s: %w", cellID, err)
 			}
 
-			if colID == req.TargetId {
+			if colID == targetID {
 				if !s.Unlink(cellID) {
 					return fmt.Errorf("unlink cell %s", cellID)
 				}
@@ -419,7 +459,7 @@ func (t *editor) ColumnDelete(s *state.State, req pb.RpcBlockTableColumnDeleteRe
 			}
 		}
 	}
-	if !s.Unlink(req.TargetId) {
+	if !s.Unlink(targetID) {
 		return fmt.Errorf("unlink column header")
 	}
 
@@ -450,6 +490,7 @@ func (t *editor) ColumnDuplicate(s *state.State, req pb.RpcBlockTableColumnDupli
 	if err != nil {
 		return "", fmt.Errorf("init table block: %w", err)
 	}
+	regions := tb.mergedRegions()
 
 	newCol := srcCol.Copy()
 	newCol.Model().Id = t.generateColID()
@@ -498,6 +539,14 @@ func (t *editor) ColumnDuplicate(s *state.State, req pb.RpcBlockTableColumnDupli
 		tb.normalizeRow(colIdx, row)
 	}
 
+	// cells merged within the source column are merged in the copy too
+	for _, r := range regions {
+		if len(r.colIDs) == 1 && r.colIDs[0] == req.BlockId {
+			regions = append(regions, mergedRegion{rowIDs: r.rowIDs, colIDs: []string{newCol.Model().Id}})
+		}
+	}
+	tb.setMergedRegions(regions)
+
 	return newCol.Model().Id, nil
 }
 
@@ -524,11 +573,21 @@ func (t *editor) ColumnMove(s *state.State, req pb.RpcBlockTableColumnMoveReques
 		return fmt.Errorf("init table block: %w", err)
 	}
 
-	if !s.Unlink(req.TargetId) {
-		return fmt.Errorf("unlink target column")
-	}
-	if err = s.InsertTo(req.DropTargetId, req.Position, req.TargetId); err != nil {
-		return fmt.Errorf("insert column: %w", err)
+	// merged cells can not be torn apart, so the ones the column is moved from or into are split
+	tb.splitMergedRegions(func(r mergedRegion) bool {
+		return len(r.colIDs) > 1 && (r.containsColumn(req.TargetId) || isInsertedInside(r.colIDs, req.DropTargetId, req.Position))
+	})
+	err = keepMergedRegions(s, req.TargetId, func() error {
+		if !s.Unlink(req.TargetId) {
+			return fmt.Errorf("unlink target column")
+		}
+		if err := s.InsertTo(req.DropTargetId, req.Position, req.TargetId); err != nil {
+			return fmt.Errorf("insert column: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	colIdx := tb.MakeColumnIndex()
@@ -628,6 +687,20 @@ func (t *editor) Sort(s *state.State, req pb.RpcBlockTableSortRequest) error {
 		return fmt.Errorf("init table block: %w", err)
 	}
 
+	// merged cells spanning several rows can not be kept while rows are reordered
+	tb.splitMergedRegions(func(r mergedRegion) bool {
+		if len(r.rowIDs) < 2 {
+			return false
+		}
+		for _, rowID := range r.rowIDs {
+			if row, err := pickRow(s, rowID); err == nil && !row.Model().GetTableRow().GetIsHeader() {
+				return true
+			}
+		}
+		return false
+	})
+	_, covered := tb.MergedCells()
+
 	rows := s.Get(tb.Rows().Id)
 	sorter := tableSorter{
 		rowIDs: make([]string, 0, len(rows.Model().ChildrenIds)),
@@ -648,6 +721,14 @@ func (t *editor) Sort(s *state.State, req pb.RpcBlockTableSortRequest) error {
 		}
 
 		sorter.rowIDs = append(sorter.rowIDs, rowID)
+		if anchorID, ok := covered[MakeCellID(rowID, req.ColumnId)]; ok {
+			// the cell of the column is hidden under the merged cell, so the row is sorted by its text
+			if cell := s.Pick(anchorID); cell != nil {
+				sorter.values[i] = cell.Model().GetText().GetText()
+			}
+			i++
+			continue
+		}
 		for _, cellID := range row.Model().ChildrenIds {
 			_, colID, err := ParseCellID(cellID)
 			if err != nil {
@@ -676,6 +757,84 @@ func (t *editor) Sort(s *state.State, req pb.RpcBlockTableSortRequest) error {
 	return nil
 }
 
+func (t *editor) CellListMerge(s *state.State, req pb.RpcBlockTableCellListMergeRequest) error {
+	if len(req.BlockIds) == 0 {
+		return fmt.Errorf("empty cell list")
+	}
+	rowID, _, err := ParseCellID(req.BlockIds[0])
+	if err != nil {
+		return fmt.Errorf("parse cell id %s: %w", req.BlockIds[0], err)
+	}
+	tb, err := NewTable(s, rowID)
+	if err != nil {
+		return fmt.Errorf("init table: %w", err)
+	}
+
+	rect, err := tb.cellsRect(req.BlockIds)
+	if err != nil {
+		return err
+	}
+
+	// merged cells intersecting the rectangle become part of the new one
+	var (
+		regions = tb.mergedRegions()
+		rowIdx  = tb.makeRowIndex()
+		colIdx  = tb.MakeColumnIndex()
+	)
+	for grown := true; grown; {
+		grown = false
+		for _, r := range regions {
+			if rr, ok := regionRect(r, rowIdx, colIdx); ok && rr.intersects(rect) && rect.union(rr) != rect {
+				rect = rect.union(rr)
+				grown = true
+			}
+		}
+	}
+	regions = slices.DeleteFunc(regions, func(r mergedRegion) bool {
+		rr, ok := regionRect(r, rowIdx, colIdx)
+		return ok && rr.intersects(rect)
+	})
+	if rect.isSingleCell() {
+		return fmt.Errorf("at least two cells should be merged")
+	}
+
+	rowIDs, colIDs := tb.RowIDs(), tb.ColumnIDs()
+	region := mergedRegion{
+		rowIDs: slices.Clone(rowIDs[rect.top : rect.bottom+1]),
+		colIDs: slices.Clone(colIDs[rect.left : rect.right+1]),
+	}
+	if err = tb.mergeCellsContent(region); err != nil {
+		return err
+	}
+	tb.setMergedRegions(append(regions, region))
+	return nil
+}
+
+func (t *editor) CellListSplit(s *state.State, req pb.RpcBlockTableCellListSplitRequest) error {
+	if len(req.BlockIds) == 0 {
+		return fmt.Errorf("empty cell list")
+	}
+	rowID, _, err := ParseCellID(req.BlockIds[0])
+	if err != nil {
+		return fmt.Errorf("parse cell id %s: %w", req.BlockIds[0], err)
+	}
+	tb, err := NewTable(s, rowID)
+	if err != nil {
+		return fmt.Errorf("init table: %w", err)
+	}
+
+	for _, id := range req.BlockIds {
+		rowID, colID, err := ParseCellID(id)
+		if err != nil {
+			return fmt.Errorf("parse cell id %s: %w", id, err)
+		}
+		tb.splitMergedRegions(func(r mergedRegion) bool {
+			return r.containsRow(rowID) && r.containsColumn(colID)
+		})
+	}
+	return nil
+}
+
 func (t *editor) cleanupTables(_ smartblock.ApplyInfo) error {
 	if t.sb == nil {
 		return fmt.Errorf("nil smartblock")
@@ -760,6 +919,86 @@ func (t *editor) cloneColumnStyles(s *state.State, srcColID, targetColID string)
 	return nil
 }
 
+// cellsRect returns the rectangle enclosing the cells
+func (tb Table) cellsRect(cellIDs []string) (cellRect, error) {
+	var (
+		rowIdx = tb.makeRowIndex()
+		colIdx = tb.MakeColumnIndex()
+		rect   cellRect
+	)
+	for i, id := range cellIDs {
+		rowID, colID, err := ParseCellID(id)
+		if err != nil {
+			return rect, fmt.Errorf("parse cell id %s: %w", id, err)
+		}
+		rowNumber, ok := rowIdx[rowID]
+		if !ok {
+			return rect, fmt.Errorf("cell %s: %w", id, errRowNotFound)
+		}
+		colNumber, ok := colIdx[colID]
+		if !ok {
+			return rect, fmt.Errorf("cell %s: %w", id, errColumnNotFound)
+		}
+		cell := cellRect{top: rowNumber, left: colNumber, bottom: rowNumber, right: colNumber}
+		if i == 0 {
+			rect = cell
+			continue
+		}
+		rect = rect.union(cell)
+	}
+	return rect, nil
+}
+
+// mergeCellsContent moves text of all cells of the region to its top-left cell, line by line
+func (tb Table) mergeCellsContent(region mergedRegion) error {
+	s := tb.s
+	anchorID := MakeCellID(region.rowIDs[0], region.colIDs[0])
+	for _, rowID := range region.rowIDs {
+		for _, colID := range region.colIDs {
+			cellID := MakeCellID(rowID, colID)
+			if cellID == anchorID || !s.Exists(cellID) {
+				continue
+			}
+			if cell, ok := s.Pick(cellID).(text.Block); ok && !cell.IsEmpty() {
+				anchor, err := tb.getOrAddCell(region.rowIDs[0], region.colIDs[0])
+				if err != nil {
+					return err
+				}
+				if !anchor.IsEmpty() {
+					anchor.Model().GetText().Text += "\n"
+				}
+				if err = anchor.Merge(cell, text.DontSetStyle()); err != nil {
+					return fmt.Errorf("merge cell %s: %w", cellID, err)
+				}
+			}
+			if !s.Unlink(cellID) {
+				return fmt.Errorf("unlink cell %s", cellID)
+			}
+		}
+	}
+	return nil
+}
+
+func (tb Table) getOrAddCell(rowID, colID string) (text.Block, error) {
+	cellID := MakeCellID(rowID, colID)
+	if !tb.s.Exists(cellID) {
+		if _, err := addCell(tb.s, rowID, colID); err != nil {
+			return nil, fmt.Errorf("add cell: %w", err)
+		}
+		row, err := getRow(tb.s, rowID)
+		if err != nil {
+			return nil, fmt.Errorf("get row %s: %w", rowID, err)
+		}
+		row.Model().ChildrenIds = append(row.Model().ChildrenIds, cellID)
+		tb.normalizeRow(nil, row)
+	}
+	cell, ok := tb.s.Get(cellID).(text.Block)
+	if !ok {
+		return nil, fmt.Errorf("cell %s is not a text block", cellID)
+	}
+	return cell, nil
+}
+
 func (t *editor) addColumnHeader(s *state.State) (string, error) {
 	b := simple.New(&model.Block{
 		Id: t.generateColID(),
@@ -0,0 +1,252 @@
+package table
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple/table"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// CellSpan is the number of rows and columns covered by the merged cell
+type CellSpan struct {
+	RowSpan, ColSpan int
+}
+
+// mergedRegion is the merged cell described by ids of rows and columns it covers,
+// so it survives insertion, deletion and moving of rows and columns
+type mergedRegion struct {
+	rowIDs []string
+	colIDs []string
+}
+
+func (r mergedRegion) containsRow(rowID string) bool {
+	return slices.Contains(r.rowIDs, rowID)
+}
+
+func (r mergedRegion) containsColumn(colID string) bool {
+	return slices.Contains(r.colIDs, colID)
+}
+
+// isInsertedInside reports whether the block inserted at the position of the target gets between the ids
+func isInsertedInside(ids []string, targetID string, pos model.BlockPosition) bool {
+	i := slices.Index(ids, targetID)
+	switch {
+	case i == -1:
+		return false
+	case pos == model.Block_Top:
+		return i > 0
+	case pos == model.Block_Bottom:
+		return i < len(ids)-1
+	}
+	return false
+}
+
+// cellRect is the merged cell in terms of row and column numbers, bounds are inclusive
+type cellRect struct {
+	top, left, bottom, right int
+}
+
+func (r cellRect) intersects(o cellRect) bool {
+	return r.top <= o.bottom && o.top <= r.bottom && r.left <= o.right && o.left <= r.right
+}
+
+func (r cellRect) union(o cellRect) cellRect {
+	return cellRect{
+		top:    min(r.top, o.top),
+		left:   min(r.left, o.left),
+		bottom: max(r.bottom, o.bottom),
+		right:  max(r.right, o.right),
+	}
+}
+
+func (r cellRect) isSingleCell() bool {
+	return r.top == r.bottom && r.left == r.right
+}
+
+func (tb Table) makeRowIndex() map[string]int {
+	rowIdx := map[string]int{}
+	for i, r := range tb.RowIDs() {
+		rowIdx[r] = i
+	}
+	return rowIdx
+}
+
+// mergedRegions collects merged cells of all rows. Spans going beyond the table are clipped
+func (tb Table) mergedRegions() []mergedRegion {
+	var (
+		rowIDs  = tb.RowIDs()
+		colIDs  = tb.ColumnIDs()
+		colIdx  = tb.MakeColumnIndex()
+		regions []mergedRegion
+	)
+	for i, rowID := range rowIDs {
+		row := tb.s.Pick(rowID)
+		if row == nil {
+			continue
+		}
+		for _, span := range row.Model().GetTableRow().GetCellSpans() {
+			j, ok := colIdx[span.ColumnId]
+			if !ok {
+				continue
+			}
+			regions = append(regions, mergedRegion{
+				rowIDs: slices.Clone(rowIDs[i:min(i+max(int(span.RowSpan), 1), len(rowIDs))]),
+				colIDs: slices.Clone(colIDs[j:min(j+max(int(span.ColSpan), 1), len(colIDs))]),
+			})
+		}
+	}
+	return regions
+}
+
+// regionRects returns current positions of regions. Regions grow when rows or columns are inserted into them
+// and shrink when their rows or columns are deleted. Regions overlapping the preceding ones are dropped
+func (tb Table) regionRects(regions []mergedRegion) []cellRect {
+	var (
+		rowIdx = tb.makeRowIndex()
+		colIdx = tb.MakeColumnIndex()
+		rects  = make([]cellRect, 0, len(regions))
+	)
+	for _, r := range regions {
+		rect, ok := regionRect(r, rowIdx, colIdx)
+		if !ok || rect.isSingleCell() {
+			continue
+		}
+		if slices.ContainsFunc(rects, rect.intersects) {
+			continue
+		}
+		rects = append(rects, rect)
+	}
+	return rects
+}
+
+// regionRect returns the current position of the region, false if all its rows or columns are deleted
+func regionRect(r mergedRegion, rowIdx, colIdx map[string]int) (cellRect, bool) {
+	top, bottom, ok := bounds(r.rowIDs, rowIdx)
+	if !ok {
+		return cellRect{}, false
+	}
+	left, right, ok := bounds(r.colIDs, colIdx)
+	if !ok {
+		return cellRect{}, false
+	}
+	return cellRect{top: top, left: left, bottom: bottom, right: right}, true
+}
+
+func bounds(ids []string, idx map[string]int) (first, last int, ok bool) {
+	for _, id := range ids {
+		i, found := idx[id]
+		if !found {
+			continue
+		}
+		if !ok {
+			first, last, ok = i, i, true
+			continue
+		}
+		first, last = min(first, i), max(last, i)
+	}
+	return first, last, ok
+}
+
+// setMergedRegions writes regions to spans of rows at their current positions
+func (tb Table) setMergedRegions(regions []mergedRegion) {
+	var (
+		rowIDs = tb.RowIDs()
+		colIDs = tb.ColumnIDs()
+		rects  = tb.regionRects(regions)
+		spans  = make(map[string][]*model.BlockContentTableRowCellSpan, len(rects))
+	)
+	sort.Slice(rects, func(i, j int) bool {
+		return rects[i].left < rects[j].left
+	})
+	for _, r := range rects {
+		rowID := rowIDs[r.top]
+		spans[rowID] = append(spans[rowID], &model.BlockContentTableRowCellSpan{
+			ColumnId: colIDs[r.left],
+			RowSpan:  int32(r.bottom - r.top + 1),
+			ColSpan:  int32(r.right - r.left + 1),
+		})
+	}
+
+	for _, rowID := range rowIDs {
+		row, err := pickRow(tb.s, rowID)
+		if err != nil {
+			continue
+		}
+		if table.CellSpansEqual(row.Model().GetTableRow().GetCellSpans(), spans[rowID]) {
+			continue
+		}
+		if rb, ok := tb.s.Get(rowID).(table.RowBlock); ok {
+			rb.SetCellSpans(spans[rowID])
+		}
+	}
+}
+
+// normalizeCellSpans removes spans of unknown columns, clips spans to the table and drops overlapping ones
+func (tb Table) normalizeCellSpans() {
+	tb.setMergedRegions(tb.mergedRegions())
+}
+
+// splitMergedRegions splits merged cells matching the filter back to separate cells
+func (tb Table) splitMergedRegions(filter func(r mergedRegion) bool) {
+	regions := tb.mergedRegions()
+	kept := slices.DeleteFunc(slices.Clone(regions), filter)
+	if len(kept) != len(regions) {
+		tb.setMergedRegions(kept)
+	}
+}
+
+// SplitMergedCellsOnRowsMove splits merged cells, which rows are moved from or into, as merged cells can not be torn apart.
+// It should be called before the move of the rows checked by CheckTableBlocksMove
+func SplitMergedCellsOnRowsMove(st *state.State, target string, pos model.BlockPosition, blockIds []string) {
+	tb, err := NewTable(st, target)
+	if err != nil || !lo.Every(tb.RowIDs(), append(blockIds, target)) {
+		return
+	}
+	tb.splitMergedRegions(func(r mergedRegion) bool {
+		return len(r.rowIDs) > 1 && (slices.ContainsFunc(blockIds, r.containsRow) || isInsertedInside(r.rowIDs, target, pos))
+	})
+}
+
+// MergedCells returns sizes of merged cells by ids of their top-left cells
+// and ids of the top-left cells by ids of cells hidden under merged cells
+func (tb Table) MergedCells() (spans map[string]CellSpan, covered map[string]string) {
+	var (
+		rowIDs = tb.RowIDs()
+		colIDs = tb.ColumnIDs()
+	)
+	spans = map[string]CellSpan{}
+	covered = map[string]string{}
+	for _, r := range tb.regionRects(tb.mergedRegions()) {
+		anchorID := MakeCellID(rowIDs[r.top], colIDs[r.left])
+		spans[anchorID] = CellSpan{RowSpan: r.bottom - r.top + 1, ColSpan: r.right - r.left + 1}
+		for i := r.top; i <= r.bottom; i++ {
+			for j := r.left; j <= r.right; j++ {
+				if id := MakeCellID(rowIDs[i], colIDs[j]); id != anchorID {
+					covered[id] = anchorID
+				}
+			}
+		}
+	}
+	return spans, covered
+}
+
+// keepMergedRegions applies the change of the table structure keeping merged cells,
+// so they grow when rows or columns are inserted inside them and shrink when covered ones are deleted
+func keepMergedRegions(s *state.State, id string, change func() error) error {
+	tb, err := NewTable(s, id)
+	if err != nil {
+		return change()
+	}
+	regions := tb.mergedRegions()
+	if err = change(); err != nil {
+		return err
+	}
+	if len(regions) > 0 {
+		tb.setMergedRegions(regions)
+	}
+	return nil
+}
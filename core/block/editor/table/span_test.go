@@ -0,0 +1,365 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func mkSpans(columnID string, rowSpan, colSpan int32) *model.BlockContentTableRow {
+	return &model.BlockContentTableRow{
+		CellSpans: []*model.BlockContentTableRowCellSpan{{ColumnId: columnID, RowSpan: rowSpan, ColSpan: colSpan}},
+	}
+}
+
+func cellSpans(t *testing.T, s *state.State, rowID string) []*model.BlockContentTableRowCellSpan {
+	row, err := pickRow(s, rowID)
+	require.NoError(t, err)
+	return row.Model().GetTableRow().GetCellSpans()
+}
+
+func TestTable_MergedCells(t *testing.T) {
+	t.Run("spans and covered cells", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2", "col3"}, []string{"row1", "row2", "row3"}, nil,
+			withRowBlockContents(map[string]*model.BlockContentTableRow{
+				"row1": mkSpans("col2", 2, 2),
+			}))
+		tb, err := NewTable(s, "table")
+		require.NoError(t, err)
+
+		// when
+		spans, covered := tb.MergedCells()
+
+		// then
+		assert.Equal(t, map[string]CellSpan{"row1-col2": {RowSpan: 2, ColSpan: 2}}, spans)
+		assert.Equal(t, map[string]string{
+			"row1-col3": "row1-col2",
+			"row2-col2": "row1-col2",
+			"row2-col3": "row1-col2",
+		}, covered)
+	})
+
+	t.Run("spans are clipped to the table", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2"}, nil,
+			withRowBlockContents(map[string]*model.BlockContentTableRow{
+				"row2": mkSpans("col1", 5, 5),
+			}))
+		tb, err := NewTable(s, "table")
+		require.NoError(t, err)
+
+		// when
+		spans, _ := tb.MergedCells()
+
+		// then
+		assert.Equal(t, map[string]CellSpan{"row2-col1": {RowSpan: 1, ColSpan: 2}}, spans)
+	})
+}
+
+func TestTable_normalizeCellSpans(t *testing.T) {
+	// given
+	s := mkTestTable([]string{"col1", "col2", "col3"}, []string{"row1", "row2", "row3"}, nil,
+		withRowBlockContents(map[string]*model.BlockContentTableRow{
+			"row1": {CellSpans: []*model.BlockContentTableRowCellSpan{
+				{ColumnId: "col1", RowSpan: 2, ColSpan: 2},
+				{ColumnId: "unknown", RowSpan: 2, ColSpan: 2},
+				{ColumnId: "col3", RowSpan: 1, ColSpan: 1},
+			}},
+			"row2": mkSpans("col2", 2, 2),
+			"row3": mkSpans("col1", 1, 3),
+		}))
+	tb, err := NewTable(s, "table")
+	require.NoError(t, err)
+
+	// when
+	tb.normalizeCellSpans()
+
+	// then
+	assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 2, ColSpan: 2}}, cellSpans(t, s, "row1"))
+	assert.Empty(t, cellSpans(t, s, "row2"))
+	assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 1, ColSpan: 3}}, cellSpans(t, s, "row3"))
+}
+
+func TestEditor_CellListMerge(t *testing.T) {
+	t.Run("merge cells with content", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2"},
+			[][]string{{"row1-col1", "row1-col2"}, {"row2-col1", "row2-col2"}},
+			withBlockContents(map[string]*model.Block{
+				"row1-col1": mkTextBlock("one"),
+				"row1-col2": mkTextBlock("two"),
+				"row2-col2": mkTextBlock("four"),
+			}))
+		e := editor{}
+
+		// when
+		err := e.CellListMerge(s, pb.RpcBlockTableCellListMergeRequest{BlockIds: []string{"row2-col2", "row1-col1"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 2, ColSpan: 2}}, cellSpans(t, s, "row1"))
+		assert.Equal(t, "one\ntwo\nfour", s.Pick("row1-col1").Model().GetText().Text)
+		assert.Equal(t, []string{"row1-col1"}, s.Pick("row1").Model().ChildrenIds)
+		assert.Empty(t, s.Pick("row2").Model().ChildrenIds)
+	})
+
+	t.Run("top-left cell is created", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2"}, []string{"row1"}, [][]string{{"row1-col2"}},
+			withBlockContents(map[string]*model.Block{
+				"row1-col2": mkTextBlock("two"),
+			}))
+		e := editor{}
+
+		// when
+		err := e.CellListMerge(s, pb.RpcBlockTableCellListMergeRequest{BlockIds: []string{"row1-col1", "row1-col2"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "two", s.Pick("row1-col1").Model().GetText().Text)
+		assert.Equal(t, []string{"row1-col1"}, s.Pick("row1").Model().ChildrenIds)
+	})
+
+	t.Run("intersecting merged cells are absorbed", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2", "col3"}, []string{"row1", "row2", "row3"}, nil,
+			withRowBlockContents(map[string]*model.BlockContentTableRow{
+				"row2": mkSpans("col2", 2, 2),
+			}))
+		e := editor{}
+
+		// when
+		err := e.CellListMerge(s, pb.RpcBlockTableCellListMergeRequest{BlockIds: []string{"row1-col1", "row2-col2"}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 3, ColSpan: 3}}, cellSpans(t, s, "row1"))
+		assert.Empty(t, cellSpans(t, s, "row2"))
+	})
+
+	t.Run("single cell", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2"}, []string{"row1"}, nil)
+		e := editor{}
+
+		// when
+		err := e.CellListMerge(s, pb.RpcBlockTableCellListMergeRequest{BlockIds: []string{"row1-col1"}})
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("unknown column", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2"}, []string{"row1"}, nil)
+		e := editor{}
+
+		// when
+		err := e.CellListMerge(s, pb.RpcBlockTableCellListMergeRequest{BlockIds: []string{"row1-col1", "row1-col3"}})
+
+		// then
+		assert.ErrorIs(t, err, errColumnNotFound)
+	})
+}
+
+func TestEditor_CellListSplit(t *testing.T) {
+	// given
+	s := mkTestTable([]string{"col1", "col2", "col3"}, []string{"row1", "row2"}, nil,
+		withRowBlockContents(map[string]*model.BlockContentTableRow{
+			"row1": {CellSpans: []*model.BlockContentTableRowCellSpan{
+				{ColumnId: "col1", RowSpan: 2, ColSpan: 1},
+				{ColumnId: "col2", RowSpan: 1, ColSpan: 2},
+			}},
+		}))
+	e := editor{}
+
+	// when
+	err := e.CellListSplit(s, pb.RpcBlockTableCellListSplitRequest{BlockIds: []string{"row2-col1"}})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col2", RowSpan: 1, ColSpan: 2}}, cellSpans(t, s, "row1"))
+}
+
+func TestEditor_MergedCellsOnStructureChange(t *testing.T) {
+	mkTable := func() *state.State {
+		return mkTestTable([]string{"col1", "col2", "col3"}, []string{"row1", "row2", "row3"}, nil,
+			withRowBlockContents(map[string]*model.BlockContentTableRow{
+				"row1": mkSpans("col1", 2, 2),
+			}))
+	}
+
+	t.Run("row inserted inside merged cell", func(t *testing.T) {
+		// given
+		s := mkTable()
+		e := editor{generateRowID: idFromSlice([]string{"new"})}
+
+		// when
+		_, err := e.RowCreate(s, pb.RpcBlockTableRowCreateRequest{TargetId: "row1", Position: model.Block_Bottom})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 3, ColSpan: 2}}, cellSpans(t, s, "row1"))
+	})
+
+	t.Run("row inserted below merged cell", func(t *testing.T) {
+		// given
+		s := mkTable()
+		e := editor{generateRowID: idFromSlice([]string{"new"})}
+
+		// when
+		_, err := e.RowCreate(s, pb.RpcBlockTableRowCreateRequest{TargetId: "row2", Position: model.Block_Bottom})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 2, ColSpan: 2}}, cellSpans(t, s, "row1"))
+	})
+
+	t.Run("top row of merged cell deleted", func(t *testing.T) {
+		// given
+		s := mkTable()
+		e := editor{}
+
+		// when
+		err := e.RowDelete(s, pb.RpcBlockTableRowDeleteRequest{TargetId: "row1"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 1, ColSpan: 2}}, cellSpans(t, s, "row2"))
+	})
+
+	t.Run("column inserted inside merged cell", func(t *testing.T) {
+		// given
+		s := mkTable()
+		e := editor{generateColID: idFromSlice([]string{"new"})}
+
+		// when
+		_, err := e.ColumnCreate(s, pb.RpcBlockTableColumnCreateRequest{TargetId: "col2", Position: model.Block_Left})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 2, ColSpan: 3}}, cellSpans(t, s, "row1"))
+	})
+
+	t.Run("first column of merged cell deleted", func(t *testing.T) {
+		// given
+		s := mkTable()
+		e := editor{}
+
+		// when
+		err := e.ColumnDelete(s, pb.RpcBlockTableColumnDeleteRequest{TargetId: "col1"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col2", RowSpan: 2, ColSpan: 1}}, cellSpans(t, s, "row1"))
+	})
+
+	t.Run("column moved out of merged cell", func(t *testing.T) {
+		// given
+		s := mkTable()
+		e := editor{}
+
+		// when
+		err := e.ColumnMove(s, pb.RpcBlockTableColumnMoveRequest{TargetId: "col2", DropTargetId: "col3", Position: model.Block_Right})
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, cellSpans(t, s, "row1"))
+	})
+
+	t.Run("column moved aside merged cell", func(t *testing.T) {
+		// given
+		s := mkTable()
+		e := editor{}
+
+		// when
+		err := e.ColumnMove(s, pb.RpcBlockTableColumnMoveRequest{TargetId: "col3", DropTargetId: "col1", Position: model.Block_Left})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 2, ColSpan: 2}}, cellSpans(t, s, "row1"))
+	})
+
+	t.Run("row duplicated inside merged cell", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2"}, nil,
+			withRowBlockContents(map[string]*model.BlockContentTableRow{
+				"row2": mkSpans("col1", 1, 2),
+			}))
+		e := editor{generateRowID: idFromSlice([]string{"new"})}
+
+		// when
+		_, err := e.RowDuplicate(s, pb.RpcBlockTableRowDuplicateRequest{BlockId: "row2", TargetId: "row2", Position: model.Block_Bottom})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 1, ColSpan: 2}}, cellSpans(t, s, "row2"))
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 1, ColSpan: 2}}, cellSpans(t, s, "new"))
+	})
+
+	t.Run("sort splits cells merged across rows", func(t *testing.T) {
+		// given
+		s := mkTestTable([]string{"col1", "col2"}, []string{"row1", "row2", "row3"},
+			[][]string{{"row1-col1"}, {"row2-col1"}, {"row3-col1"}},
+			withRowBlockContents(map[string]*model.BlockContentTableRow{
+				"row1": mkSpans("col1", 2, 1),
+				"row3": mkSpans("col1", 1, 2),
+			}),
+			withBlockContents(map[string]*model.Block{
+				"row1-col1": mkTextBlock("c"),
+				"row2-col1": mkTextBlock("b"),
+				"row3-col1": mkTextBlock("a"),
+			}))
+		e := editor{}
+
+		// when
+		err := e.Sort(s, pb.RpcBlockTableSortRequest{ColumnId: "col2", Type: model.BlockContentDataviewSort_Desc})
+
+		// then
+		require.NoError(t, err)
+		tb, err := NewTable(s, "table")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"row3", "row1", "row2"}, tb.RowIDs())
+		assert.Empty(t, cellSpans(t, s, "row1"))
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 1, ColSpan: 2}}, cellSpans(t, s, "row3"))
+	})
+
+	t.Run("row moved into merged cell", func(t *testing.T) {
+		// given
+		s := mkTable()
+
+		// when
+		SplitMergedCellsOnRowsMove(s, "row1", model.Block_Bottom, []string{"row3"})
+
+		// then
+		assert.Empty(t, cellSpans(t, s, "row1"))
+	})
+
+	t.Run("row moved above merged cell", func(t *testing.T) {
+		// given
+		s := mkTable()
+
+		// when
+		SplitMergedCellsOnRowsMove(s, "row1", model.Block_Top, []string{"row3"})
+
+		// then
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 2, ColSpan: 2}}, cellSpans(t, s, "row1"))
+	})
+	t.Run("check of the rows move keeps merged cells", func(t *testing.T) {
+		// given
+		s := mkTable()
+
+		// when
+		_, _, err := CheckTableBlocksMove(s, "row1", model.Block_Bottom, []string{"row3"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 2, ColSpan: 2}}, cellSpans(t, s, "row1"))
+	})
+}
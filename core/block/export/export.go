@@ -3,7 +3,9 @@ package export
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
 	"path/filepath"
@@ -24,10 +26,14 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/process"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/synced"
 	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/converter/docx"
 	"github.com/anyproto/anytype-heart/core/converter/dot"
 	"github.com/anyproto/anytype-heart/core/converter/graphjson"
+	"github.com/anyproto/anytype-heart/core/converter/html"
 	"github.com/anyproto/anytype-heart/core/converter/md"
+	"github.com/anyproto/anytype-heart/core/converter/opml"
 	"github.com/anyproto/anytype-heart/core/converter/pbc"
 	"github.com/anyproto/anytype-heart/core/converter/pbjson"
 	"github.com/anyproto/anytype-heart/core/domain"
@@ -44,6 +50,7 @@ import (
 	"github.com/anyproto/anytype-heart/space"
 	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider"
 	"github.com/anyproto/anytype-heart/util/anyerror"
+	"github.com/anyproto/anytype-heart/util/archivecrypt"
 	"github.com/anyproto/anytype-heart/util/constant"
 	"github.com/anyproto/anytype-heart/util/text"
 )
@@ -65,8 +72,14 @@ const (
 
 var log = logging.Logger("anytype-mw-export")
 
+var ErrPasswordWithoutZip = errors.New("password can be set only for zip archive")
+
 type Export interface {
 	Export(ctx context.Context, req pb.RpcObjectListExportRequest) (path string, succeed int, err error)
+	// ExportBackup writes the protobuf archive of the space backup and returns ids of the written objects.
+	// Archived objects requested by ids are written when IncludeArchived is set. The progress is reported
+	// to the started queue of the caller, the notification is not sent
+	ExportBackup(ctx context.Context, req pb.RpcObjectListExportRequest, queue process.Queue) (path string, objectIds []string, err error)
 	app.Component
 }
 
@@ -121,6 +134,16 @@ func (e *export) Export(ctx context.Context, req pb.RpcObjectListExportRequest)
 	return exportCtx.exportObjects(ctx, queue)
 }
 
+func (e *export) ExportBackup(ctx context.Context, req pb.RpcObjectListExportRequest, queue process.Queue) (path string, objectIds []string, err error) {
+	req.Format = model.Export_Protobuf
+	exportCtx := newExportContext(e, req)
+	exportCtx.isBackup = true
+	if path, _, err = exportCtx.exportObjects(ctx, queue); err != nil {
+		return "", nil, err
+	}
+	return path, exportCtx.writtenIds, nil
+}
+
 func (e *export) sendNotification(err error, req pb.RpcObjectListExportRequest) {
 	errCode := model.NotificationExport_NULL
 	if err != nil {
@@ -151,7 +174,15 @@ type exportContext struct {
 	isJson         bool
 	reqIds         []string
 	zip            bool
+	password       string
 	path           string
+	mdFrontMatter  bool
+	viewId         string
+	views          []dataviewView
+	// isBackup makes archived objects requested by ids exportable and collects ids of the written objects
+	isBackup   bool
+	writtenIds []string
+	writtenMu  sync.Mutex
 
 	*export
 }
@@ -168,6 +199,9 @@ func newExportContext(e *export, req pb.RpcObjectListExportRequest) *exportConte
 		isJson:         req.IsJson,
 		reqIds:         req.ObjectIds,
 		zip:            req.Zip,
+		password:       req.Password,
+		mdFrontMatter:  req.MdIncludeFrontMatter,
+		viewId:         req.ViewId,
 		export:         e,
 	}
 }
@@ -182,11 +216,18 @@ func (e *exportContext) copy() *exportContext {
 		format:         e.format,
 		isJson:         e.isJson,
 		reqIds:         e.reqIds,
+		mdFrontMatter:  e.mdFrontMatter,
+		viewId:         e.viewId,
+		views:          e.views,
+		isBackup:       e.isBackup,
 		export:         e.export,
 	}
 }
 
 func (e *exportContext) exportObjects(ctx context.Context, queue process.Queue) (string, int, error) {
+	if e.password != "" && !e.zip {
+		return "", 0, ErrPasswordWithoutZip
+	}
 	err := e.docsForExport()
 	if err != nil {
 		return "", 0, err
@@ -198,6 +239,10 @@ func (e *exportContext) exportObjects(ctx context.Context, queue process.Queue)
 	}
 	succeed, err := e.exportByFormat(ctx, wr, queue)
 	if err != nil {
+		if e.password != "" {
+			wr.Close()
+			removePlainArchive(wr)
+		}
 		return "", 0, err
 	}
 	wr.Close()
@@ -213,7 +258,17 @@ func (e *exportContext) getWriter() (writer, error) {
 		err error
 	)
 	if e.zip {
-		if wr, err = newZipWriter(e.path, tempFileName); err != nil {
+		dir := e.path
+		if e.password != "" {
+			// the archive is encrypted after it's written, so the plain one is never placed in the export directory
+			if dir, err = os.MkdirTemp("", "anytype-export-"); err != nil {
+				return nil, err
+			}
+		}
+		if wr, err = newZipWriter(dir, tempFileName); err != nil {
+			if e.password != "" {
+				os.RemoveAll(dir)
+			}
 			err = anyerror.CleanupError(err)
 			return nil, err
 		}
@@ -238,7 +293,14 @@ func (e *exportContext) exportByFormat(ctx context.Context, wr writer, queue pro
 		succeed = e.exportDotAndSVG(ctx, succeed, wr, queue)
 	} else if e.format == model.Export_GRAPH_JSON {
 		succeed = e.exportGraphJson(ctx, succeed, wr, queue)
+	} else if e.format == model.Export_ICS {
+		succeed = e.exportICS(wr, queue)
+	} else if isTableExport(e.format) {
+		succeed = e.exportTable(wr, queue)
 	} else {
+		if e.format == model.Export_HTML {
+			e.registerSiteNames(wr)
+		}
 		tasks := make([]process.Task, 0, len(e.docs))
 		var succeedAsync int64
 		tasks = e.exportDocs(ctx, wr, &succeedAsync, tasks)
@@ -248,6 +310,11 @@ func (e *exportContext) exportByFormat(ctx context.Context, wr writer, queue pro
 			return 0, nil
 		}
 		succeed += int(succeedAsync)
+		if e.format == model.Export_HTML {
+			if err = e.writeSiteIndex(wr); err != nil {
+				log.Errorf("failed to write site index: %s", err)
+			}
+		}
 	}
 	if err := queue.Finalize(); err != nil {
 		cleanupFile(wr)
@@ -268,6 +335,7 @@ func (e *exportContext) exportDocs(ctx context.Context,
 				log.With("objectID", did).Warnf("can't export doc: %v", werr)
 			} else {
 				atomic.AddInt64(succeed, 1)
+				e.addWrittenId(did)
 			}
 		}
 		tasks = append(tasks, task)
@@ -275,6 +343,15 @@ func (e *exportContext) exportDocs(ctx context.Context,
 	return tasks
 }
 
+func (e *exportContext) addWrittenId(id string) {
+	if !e.isBackup {
+		return
+	}
+	e.writtenMu.Lock()
+	defer e.writtenMu.Unlock()
+	e.writtenIds = append(e.writtenIds, id)
+}
+
 func (e *exportContext) exportGraphJson(ctx context.Context, succeed int, wr writer, queue process.Queue) int {
 	mc := graphjson.NewMultiConverter(e.sbtProvider)
 	mc.SetKnownDocs(e.docs)
@@ -301,6 +378,9 @@ func (e *exportContext) exportDotAndSVG(ctx context.Context, succeed int, wr wri
 
 func (e *exportContext) renameZipArchive(wr writer, succeed int) (string, int, error) {
 	zipName := getZipName(e.path)
+	if e.password != "" {
+		return e.encryptZipArchive(wr, zipName, succeed)
+	}
 	err := os.Rename(wr.Path(), zipName)
 	if err != nil {
 		os.Remove(wr.Path())
@@ -309,12 +389,44 @@ func (e *exportContext) renameZipArchive(wr writer, succeed int) (string, int, e
 	return zipName, succeed, nil
 }
 
+func (e *exportContext) encryptZipArchive(wr writer, zipName string, succeed int) (string, int, error) {
+	defer removePlainArchive(wr)
+	if err := archivecrypt.EncryptFile(wr.Path(), zipName, e.password); err != nil {
+		return "", 0, fmt.Errorf("encrypt archive: %w", anyerror.CleanupError(err))
+	}
+	return zipName, succeed, nil
+}
+
+// removePlainArchive removes the unencrypted archive together with its temporary directory
+func removePlainArchive(wr writer) {
+	if err := os.RemoveAll(filepath.Dir(wr.Path())); err != nil {
+		log.Errorf("failed to remove unencrypted archive: %v", err)
+	}
+}
+
 func isAnyblockExport(format model.ExportFormat) bool {
 	return format == model.Export_Protobuf || format == model.Export_JSON
 }
 
+func isTableExport(format model.ExportFormat) bool {
+	return format == model.Export_CSV || format == model.Export_TSV
+}
+
+// isDocumentExport reports whether the format produces human-readable documents
+// that reference exported files instead of embedding file objects
+func isDocumentExport(format model.ExportFormat) bool {
+	return format == model.Export_Markdown || format == model.Export_HTML ||
+		format == model.Export_DOCX || format == model.Export_OPML
+}
+
 func (e *exportContext) docsForExport() (err error) {
 	isProtobuf := isAnyblockExport(e.format)
+	if e.format == model.Export_ICS {
+		return e.getViewObjects(findCalendarView)
+	}
+	if isTableExport(e.format) {
+		return e.getViewObjects(findView)
+	}
 	if len(e.reqIds) == 0 {
 		return e.getExistedObjects(isProtobuf)
 	}
@@ -363,15 +475,21 @@ func (e *exportContext) queryAndFilterObjectsByRelation(spaceId string, reqIds [
 }
 
 func (e *exportContext) queryObjectsByIds(spaceId string, reqIds []string, relationKey domain.RelationKey) ([]database.Record, error) {
-	return e.objectStore.SpaceIndex(spaceId).Query(database.Query{
-		Filters: []database.FilterRequest{
-			{
-				RelationKey: relationKey,
-				Condition:   model.BlockContentDataviewFilter_In,
-				Value:       domain.StringList(reqIds),
-			},
+	filters := []database.FilterRequest{
+		{
+			RelationKey: relationKey,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.StringList(reqIds),
 		},
-	})
+	}
+	if e.isBackup && e.includeArchive {
+		// the explicit filter by isArchived disables the default one, which skips archived objects
+		filters = append(filters, database.FilterRequest{
+			RelationKey: bundle.RelationKeyIsArchived,
+			Condition:   model.BlockContentDataviewFilter_None,
+		})
+	}
+	return e.objectStore.SpaceIndex(spaceId).Query(database.Query{Filters: filters})
 }
 
 func (e *exportContext) processNotProtobuf() error {
@@ -776,7 +894,7 @@ func (e *exportContext) addNestedObject(id string, nestedDocs map[string]*domain
 				log.Errorf("failed to get smartblocktype of id %s", link)
 				continue
 			}
-			if !validType(sbt) {
+			if !IsProtobufType(sbt) {
 				continue
 			}
 			rec, qErr := e.objectStore.SpaceIndex(e.spaceId).QueryByIds([]string{link})
@@ -904,11 +1022,16 @@ func (e *exportContext) writeMultiDoc(ctx context.Context, mw converter.MultiCon
 }
 
 func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string) (err error) {
+	var subtrees map[string][]*model.Block
+	if isDocumentExport(e.format) {
+		subtrees = e.syncedSubtrees(docId)
+	}
 	return cache.Do(e.picker, docId, func(b sb.SmartBlock) error {
 		st := b.NewState()
 		if st.CombinedDetails().GetBool(bundle.RelationKeyIsDeleted) {
 			return nil
 		}
+		expandSyncedBlocks(st, subtrees)
 
 		if e.includeFiles && b.Type() == smartblock.SmartBlockTypeFileObject {
 			fileName, err := e.saveFile(ctx, wr, b, e.spaceId == "")
@@ -916,8 +1039,8 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string) (
 				return fmt.Errorf("save file: %w", err)
 			}
 			st.SetDetailAndBundledRelation(bundle.RelationKeySource, domain.String(fileName))
-			// Don't save file objects in markdown
-			if e.format == model.Export_Markdown {
+			// Don't save file objects in markdown, html, docx and opml
+			if isDocumentExport(e.format) {
 				return nil
 			}
 		}
@@ -925,16 +1048,29 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string) (
 		var conv converter.Converter
 		switch e.format {
 		case model.Export_Markdown:
-			conv = md.NewMDConverter(st, wr.Namer())
+			if e.mdFrontMatter {
+				conv = md.NewMDConverterWithFrontMatter(st, wr.Namer(), e.objectStore.SpaceIndex(b.SpaceID()))
+			} else {
+				conv = md.NewMDConverter(st, wr.Namer())
+			}
 		case model.Export_Protobuf:
 			conv = pbc.NewConverter(st, e.isJson)
 		case model.Export_JSON:
 			conv = pbjson.NewConverter(st)
+		case model.Export_HTML:
+			conv = html.NewSiteConverter(st, wr.Namer())
+		case model.Export_DOCX:
+			conv = docx.NewConverter(st, wr.Namer(), e.imageLoader(ctx, b.SpaceID()))
+		case model.Export_OPML:
+			conv = opml.NewConverter(st, wr.Namer())
 		}
 		conv.SetKnownDocs(e.docs)
+		if as, ok := conv.(converter.AnchorsSetter); ok {
+			as.SetAnchors(e.linkedBlocks(b.SpaceID(), docId))
+		}
 		result := conv.Convert(b.Type().ToProto())
 		var filename string
-		if e.format == model.Export_Markdown {
+		if isDocumentExport(e.format) {
 			filename = makeMarkdownName(st, wr, docId, conv.Ext(), e.spaceId)
 		} else if docId == b.Space().DerivedIDs().Home {
 			filename = "index" + conv.Ext()
@@ -949,6 +1085,79 @@ func (e *exportContext) writeDoc(ctx context.Context, wr writer, docId string) (
 	})
 }
 
+// linkedBlocks returns blocks of the object which are targets of links from exported objects
+func (e *exportContext) linkedBlocks(spaceId, docId string) []string {
+	links, err := e.objectStore.SpaceIndex(spaceId).GetInboundBlockLinks(docId)
+	if err != nil {
+		log.With("objectID", docId).Warnf("failed to get block links: %v", err)
+		return nil
+	}
+	var blockIds []string
+	for _, link := range links {
+		if _, ok := e.docs[link.ObjectId]; ok && !lo.Contains(blockIds, link.TargetBlockId) {
+			blockIds = append(blockIds, link.TargetBlockId)
+		}
+	}
+	return blockIds
+}
+
+// syncedSubtrees returns copies of subtrees shown by synced blocks of the object by ids of synced blocks.
+// Sources are read before the object is locked, see synced.Block
+func (e *exportContext) syncedSubtrees(docId string) map[string][]*model.Block {
+	sources := make(map[string][2]string)
+	err := cache.Do(e.picker, docId, func(b sb.SmartBlock) error {
+		return b.Iterate(func(b simple.Block) (isContinue bool) {
+			if s, ok := b.(synced.Block); ok {
+				objectId, blockId := s.Source()
+				if objectId != docId {
+					sources[b.Model().Id] = [2]string{objectId, blockId}
+				}
+			}
+			return true
+		})
+	})
+	if err != nil || len(sources) == 0 {
+		return nil
+	}
+	subtrees := make(map[string][]*model.Block, len(sources))
+	for id, source := range sources {
+		err = cache.Do(e.picker, source[0], func(b sb.SmartBlock) error {
+			var collect func(blockId string)
+			collect = func(blockId string) {
+				if sb := b.Pick(blockId); sb != nil {
+					m := sb.Copy().Model()
+					subtrees[id] = append(subtrees[id], m)
+					for _, childId := range m.ChildrenIds {
+						collect(childId)
+					}
+				}
+			}
+			collect(source[1])
+			return nil
+		})
+		if err != nil {
+			log.With("objectID", docId).Warnf("can't read source of synced block: %v", err)
+		}
+	}
+	return subtrees
+}
+
+// expandSyncedBlocks adds subtrees to the exported state as children of synced blocks
+func expandSyncedBlocks(st *state.State, subtrees map[string][]*model.Block) {
+	for id, blocks := range subtrees {
+		syncedBlock := st.Get(id)
+		if syncedBlock == nil || len(blocks) == 0 || st.Exists(blocks[0].Id) {
+			continue
+		}
+		for _, b := range blocks {
+			if !st.Exists(b.Id) {
+				st.Add(simple.New(b))
+			}
+		}
+		syncedBlock.Model().ChildrenIds = []string{blocks[0].Id}
+	}
+}
+
 func (e *exportContext) saveFile(ctx context.Context, wr writer, fileObject sb.SmartBlock, exportAllSpaces bool) (fileName string, err error) {
 	fullId := domain.FullFileId{
 		SpaceId: fileObject.Space().Id(),
@@ -982,6 +1191,32 @@ func (e *exportContext) saveFile(ctx context.Context, wr writer, fileObject sb.S
 	return fileName, wr.WriteFile(fileName, rd, file.Info().LastModifiedDate)
 }
 
+// imageLoader returns the original content of image file objects, so they can be embedded into documents
+func (e *exportContext) imageLoader(ctx context.Context, spaceId string) docx.ImageLoader {
+	return func(fileObjectId string) ([]byte, error) {
+		details, err := e.objectStore.SpaceIndex(spaceId).GetDetails(fileObjectId)
+		if err != nil {
+			return nil, fmt.Errorf("get file object details: %w", err)
+		}
+		image, err := e.fileService.ImageByHash(ctx, domain.FullFileId{
+			SpaceId: spaceId,
+			FileId:  domain.FileId(details.GetString(bundle.RelationKeyFileId)),
+		})
+		if err != nil {
+			return nil, err
+		}
+		file, err := image.GetOriginalFile()
+		if err != nil {
+			return nil, err
+		}
+		rd, err := file.Reader(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return io.ReadAll(rd)
+	}
+}
+
 func (e *exportContext) createProfileFile(spaceID string, wr writer) error {
 	spc, err := e.spaceService.Get(context.Background(), spaceID)
 	if err != nil {
@@ -1067,7 +1302,7 @@ func objectValid(sbType smartblock.SmartBlockType, info *database.ObjectInfo, in
 	if !isProtobuf && (!validTypeForNonProtobuf(sbType) || !validLayoutForNonProtobuf(info.Details)) {
 		return false
 	}
-	if isProtobuf && !validType(sbType) {
+	if isProtobuf && !IsProtobufType(sbType) {
 		return false
 	}
 	if strings.HasPrefix(info.Id, addr.BundledObjectTypeURLPrefix) || strings.HasPrefix(info.Id, addr.BundledRelationURLPrefix) {
@@ -1119,7 +1354,8 @@ func (fn *namer) Get(path, hash, title, ext string) (name string) {
 	}
 }
 
-func validType(sbType smartblock.SmartBlockType) bool {
+// IsProtobufType tells if objects of the type are written to the protobuf export of the whole space
+func IsProtobufType(sbType smartblock.SmartBlockType) bool {
 	return sbType == smartblock.SmartBlockTypeProfilePage ||
 		sbType == smartblock.SmartBlockTypePage ||
 		sbType == smartblock.SmartBlockTypeTemplate ||
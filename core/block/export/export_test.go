@@ -4,12 +4,15 @@ import (
 	"archive/zip"
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/anyproto/any-sync/app"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
@@ -29,6 +32,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/space/spacecore/typeprovider/mock_typeprovider"
 	"github.com/anyproto/anytype-heart/tests/testutil"
+	"github.com/anyproto/anytype-heart/util/archivecrypt"
 )
 
 func TestFileNamer_Get(t *testing.T) {
@@ -1462,3 +1466,27 @@ func Test_queryObjectsFromStoreByIds(t *testing.T) {
 		assert.Len(t, records, 2000)
 	})
 }
+
+func Test_encryptedZipArchive(t *testing.T) {
+	// given
+	exportPath := t.TempDir()
+	expCtx := &exportContext{zip: true, path: exportPath, password: "secret"}
+	wr, err := expCtx.getWriter()
+	require.NoError(t, err)
+	require.NoError(t, wr.WriteFile("object.pb", strings.NewReader("content"), 0))
+	require.NoError(t, wr.Close())
+
+	// the unencrypted archive is not written to the export directory
+	entries, err := os.ReadDir(exportPath)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	// when
+	zipName, _, err := expCtx.renameZipArchive(wr, 1)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, exportPath, filepath.Dir(zipName))
+	assert.True(t, archivecrypt.IsEncrypted(zipName))
+	assert.NoDirExists(t, filepath.Dir(wr.Path()))
+}
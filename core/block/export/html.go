@@ -0,0 +1,64 @@
+package export
+
+import (
+	"bytes"
+	"path/filepath"
+	"sort"
+
+	"github.com/anyproto/anytype-heart/core/converter/html"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+)
+
+const (
+	siteIndexId    = "index"
+	siteIndexTitle = "Index"
+)
+
+// registerSiteNames names pages and files of the static site before the export,
+// so links between pages point to the files the export writes. Files are not linked
+// if they are not copied to the site
+func (e *exportContext) registerSiteNames(wr writer) {
+	wr.Namer().Get("", siteIndexId, siteIndexId, filepath.Ext(html.IndexPage))
+	for id, details := range e.docs {
+		if !e.includeFiles && !html.IsSitePage(details) {
+			delete(e.docs, id)
+			continue
+		}
+		var path string
+		// space can be empty in case user want to export all spaces
+		if e.spaceId == "" {
+			path = filepath.Join(spaceDirectory, details.GetString(bundle.RelationKeySpaceId))
+		}
+		if html.IsSitePage(details) {
+			wr.Namer().Get(path, id, html.PageTitle(details, id), ".html")
+			continue
+		}
+		name := details.GetString(bundle.RelationKeyName)
+		if ext := details.GetString(bundle.RelationKeyFileExt); ext != "" {
+			name += "." + ext
+		}
+		wr.Namer().Get(filepath.Join(path, Files), id, name, filepath.Ext(name))
+	}
+}
+
+// writeSiteIndex writes the page listing all exported pages sorted by title
+func (e *exportContext) writeSiteIndex(wr writer) error {
+	pages := make([]html.SitePage, 0, len(e.docs))
+	for id, details := range e.docs {
+		if !html.IsSitePage(details) || details.GetBool(bundle.RelationKeyIsDeleted) {
+			continue
+		}
+		title := html.PageTitle(details, id)
+		pages = append(pages, html.SitePage{
+			Title: title,
+			Path:  wr.Namer().Get("", id, title, ".html"),
+		})
+	}
+	sort.Slice(pages, func(i, j int) bool {
+		if pages[i].Title != pages[j].Title {
+			return pages[i].Title < pages[j].Title
+		}
+		return pages[i].Path < pages[j].Path
+	})
+	return wr.WriteFile(html.IndexPage, bytes.NewReader(html.SiteIndex(siteIndexTitle, pages)), 0)
+}
@@ -0,0 +1,87 @@
+package export
+
+import (
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/converter/ics"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const defaultCalendarName = "calendar"
+
+// findCalendarView returns the calendar view with the given id or the first calendar view of the dataview if the id is empty
+func findCalendarView(dataview *model.BlockContentDataview, viewId string) (*model.BlockContentDataviewView, error) {
+	var calendarView *model.BlockContentDataviewView
+	for _, view := range dataview.Views {
+		if viewId == "" && view.Type == model.BlockContentDataviewView_Calendar || view.Id == viewId {
+			calendarView = view
+			break
+		}
+	}
+	switch {
+	case calendarView == nil && viewId != "":
+		return nil, fmt.Errorf("view %s not found", viewId)
+	case calendarView == nil:
+		return nil, fmt.Errorf("dataview has no calendar views")
+	case calendarView.Type != model.BlockContentDataviewView_Calendar:
+		return nil, fmt.Errorf("view %s is not a calendar", viewId)
+	}
+	if calendarView.GroupRelationKey == "" {
+		return nil, fmt.Errorf("calendar view %s has no date relation", calendarView.Id)
+	}
+	return calendarView, nil
+}
+
+// calendarDateRelations returns the relations of the calendar view. Dates include time if the view shows them
+// with time, or, when the view has no settings of the relation, if the relation includes time
+func (e *exportContext) calendarDateRelations(calendar dataviewView) ics.DateRelations {
+	relations := ics.DateRelations{Date: domain.RelationKey(calendar.view.GroupRelationKey)}
+	if relations.Date == ics.StartDateRelationKey {
+		relations.End = ics.EndDateRelationKey
+	}
+	for _, rel := range calendar.view.Relations {
+		if rel.Key == relations.Date.String() {
+			relations.IncludeTime = rel.DateIncludeTime
+			return relations
+		}
+	}
+	uk, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelation, relations.Date.String())
+	if err != nil {
+		return relations
+	}
+	records, err := e.objectStore.SpaceIndex(calendar.spaceId).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyUniqueKey,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.String(uk.Marshal()),
+			},
+		},
+	})
+	if err != nil {
+		log.With("objectID", calendar.id).Warnf("can't get date relation of calendar: %v", err)
+		return relations
+	}
+	if len(records) > 0 {
+		relations.IncludeTime = records[0].Details.GetBool(bundle.RelationKeyRelationFormatIncludeTime)
+	}
+	return relations
+}
+
+func (e *exportContext) exportICS(wr writer, queue process.Queue) (succeed int) {
+	for _, calendar := range e.views {
+		mc := ics.NewMultiConverter(e.calendarDateRelations(calendar))
+		mc.SetKnownDocs(e.docs)
+		written, err := e.writeView(wr, queue, calendar, mc, defaultCalendarName)
+		succeed += written
+		if err != nil {
+			return succeed
+		}
+	}
+	return succeed
+}
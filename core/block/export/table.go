@@ -0,0 +1,94 @@
+package export
+
+import (
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/converter/csv"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const defaultTableName = "table"
+
+// exportTable writes visible relations of the view objects to the csv or tsv file per view
+func (e *exportContext) exportTable(wr writer, queue process.Queue) (succeed int) {
+	separator := csv.CommaSeparator
+	if e.format == model.Export_TSV {
+		separator = csv.TabSeparator
+	}
+	for _, view := range e.views {
+		spaceIndex := e.objectStore.SpaceIndex(view.spaceId)
+		columns := viewColumns(spaceIndex, view.view)
+		mc := csv.NewMultiConverter(columns, separator)
+		mc.SetKnownDocs(e.referencedDocs(spaceIndex, view, columns))
+		written, err := e.writeView(wr, queue, view, mc, defaultTableName)
+		succeed += written
+		if err != nil {
+			return succeed
+		}
+	}
+	return succeed
+}
+
+func viewColumns(spaceIndex spaceindex.Store, view *model.BlockContentDataviewView) []csv.Column {
+	columns := make([]csv.Column, 0, len(view.Relations))
+	for _, viewRelation := range view.Relations {
+		if !viewRelation.IsVisible {
+			continue
+		}
+		relation, err := spaceIndex.GetRelationByKey(viewRelation.Key)
+		if err != nil {
+			log.With("relationKey", viewRelation.Key).Warnf("can't get relation of the view: %v", err)
+			continue
+		}
+		columns = append(columns, csv.Column{
+			Key:         domain.RelationKey(relation.Key),
+			Name:        relation.Name,
+			Format:      relation.Format,
+			DateFormat:  viewRelation.DateFormat,
+			TimeFormat:  viewRelation.TimeFormat,
+			IncludeTime: viewRelation.DateIncludeTime,
+		})
+	}
+	return columns
+}
+
+// referencedDocs returns details of the objects and options the view objects refer to, so their names
+// are written instead of ids
+func (e *exportContext) referencedDocs(spaceIndex spaceindex.Store, view dataviewView, columns []csv.Column) map[string]*domain.Details {
+	var ids []string
+	for _, objectId := range view.objectIds {
+		details, ok := e.docs[objectId]
+		if !ok {
+			continue
+		}
+		for _, column := range columns {
+			switch column.Format {
+			case model.RelationFormat_tag, model.RelationFormat_status, model.RelationFormat_object, model.RelationFormat_file:
+				value := details.Get(column.Key)
+				if id := value.String(); id != "" {
+					ids = append(ids, id)
+				} else {
+					ids = append(ids, value.StringList()...)
+				}
+			}
+		}
+	}
+	docs := make(map[string]*domain.Details, len(e.docs)+len(ids))
+	for id, details := range e.docs {
+		docs[id] = details
+	}
+	if len(ids) == 0 {
+		return docs
+	}
+	records, err := spaceIndex.QueryByIds(ids)
+	if err != nil {
+		log.With("objectID", view.id).Warnf("can't get objects referenced by the view: %v", err)
+		return docs
+	}
+	for _, record := range records {
+		docs[record.Details.GetString(bundle.RelationKeyId)] = record.Details
+	}
+	return docs
+}
@@ -0,0 +1,240 @@
+package export
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"slices"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	sb "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// dataviewView is the view of the set or collection, every view is exported to the separate file.
+// Objects are stored in the order of the view sorts or in the order of the collection if the view has no sorts
+type dataviewView struct {
+	id        string
+	name      string
+	spaceId   string
+	view      *model.BlockContentDataviewView
+	objectIds []string
+}
+
+type viewFinder func(dataview *model.BlockContentDataview, viewId string) (*model.BlockContentDataviewView, error)
+
+// getViewObjects collects objects shown in the views of the requested sets and collections
+func (e *exportContext) getViewObjects(findView viewFinder) error {
+	if len(e.reqIds) == 0 {
+		return fmt.Errorf("%s export requires ids of sets or collections", e.format)
+	}
+	for _, id := range e.reqIds {
+		view, err := e.getDataviewView(id, findView)
+		if err != nil {
+			return fmt.Errorf("get view of %s: %w", id, err)
+		}
+		e.views = append(e.views, view)
+	}
+	return nil
+}
+
+func (e *exportContext) getDataviewView(id string, findView viewFinder) (dataviewView, error) {
+	var (
+		view       = dataviewView{id: id}
+		sourceIds  []string
+		collection bool
+	)
+	err := cache.Do(e.picker, id, func(b sb.SmartBlock) error {
+		st := b.NewState()
+		dataview := findDataview(st)
+		if dataview == nil {
+			return fmt.Errorf("object has no dataview")
+		}
+		var err error
+		if view.view, err = findView(dataview, e.viewId); err != nil {
+			return err
+		}
+		details := st.CombinedDetails()
+		view.spaceId = b.SpaceID()
+		view.name = details.GetString(bundle.RelationKeyName)
+		collection = details.GetInt64(bundle.RelationKeyLayout) == int64(model.ObjectType_collection)
+		if collection {
+			sourceIds = st.GetStoreSlice(template.CollectionStoreKey)
+		} else {
+			sourceIds = details.GetStringList(bundle.RelationKeySetOf)
+		}
+		return nil
+	})
+	if err != nil {
+		return view, err
+	}
+	if len(sourceIds) == 0 {
+		return view, nil
+	}
+
+	filters := []database.FilterRequest{
+		{
+			RelationKey: bundle.RelationKeyIsArchived,
+			Condition:   model.BlockContentDataviewFilter_NotEqual,
+			Value:       domain.Bool(true),
+		},
+		{
+			RelationKey: bundle.RelationKeyIsDeleted,
+			Condition:   model.BlockContentDataviewFilter_NotEqual,
+			Value:       domain.Bool(true),
+		},
+	}
+	if view.view.Type == model.BlockContentDataviewView_Calendar {
+		// objects without the date are not shown in the calendar
+		filters = append(filters, database.FilterRequest{
+			RelationKey: domain.RelationKey(view.view.GroupRelationKey),
+			Condition:   model.BlockContentDataviewFilter_Exists,
+		})
+	}
+	if collection {
+		filters = append(filters, database.FilterRequest{
+			RelationKey: bundle.RelationKeyId,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.StringList(sourceIds),
+		})
+	} else {
+		sourceFilter, err := e.setSourceFilter(view.spaceId, sourceIds)
+		if err != nil {
+			return view, err
+		}
+		filters = append(filters, sourceFilter)
+	}
+	if len(view.view.Filters) > 0 {
+		filters = append(filters, database.FilterRequest{
+			Operator:      model.BlockContentDataviewFilter_And,
+			NestedFilters: database.FiltersFromProto(view.view.Filters),
+		})
+	}
+	records, err := e.objectStore.SpaceIndex(view.spaceId).Query(database.Query{
+		Filters: filters,
+		Sorts:   database.SortsFromProto(view.view.Sorts),
+	})
+	if err != nil {
+		return view, fmt.Errorf("query objects: %w", err)
+	}
+	for _, record := range records {
+		objectId := record.Details.GetString(bundle.RelationKeyId)
+		e.docs[objectId] = record.Details
+		view.objectIds = append(view.objectIds, objectId)
+	}
+	if collection && len(view.view.Sorts) == 0 {
+		// without sorts objects of the collection are shown in the order they were added or moved by the user
+		sortByCollectionOrder(view.objectIds, sourceIds)
+	}
+	return view, nil
+}
+
+func sortByCollectionOrder(objectIds, collectionIds []string) {
+	positions := make(map[string]int, len(collectionIds))
+	for i, id := range collectionIds {
+		positions[id] = i
+	}
+	slices.SortStableFunc(objectIds, func(a, b string) int {
+		return cmp.Compare(positions[a], positions[b])
+	})
+}
+
+// setSourceFilter matches objects of the set: objects of its types or objects having its relations
+func (e *exportContext) setSourceFilter(spaceId string, setOf []string) (database.FilterRequest, error) {
+	spaceIndex := e.objectStore.SpaceIndex(spaceId)
+	var (
+		typeIds []string
+		filters []database.FilterRequest
+	)
+	for _, id := range setOf {
+		details, err := spaceIndex.GetDetails(id)
+		if err != nil {
+			return database.FilterRequest{}, fmt.Errorf("get details of set source %s: %w", id, err)
+		}
+		switch details.GetInt64(bundle.RelationKeyLayout) {
+		case int64(model.ObjectType_objectType):
+			typeIds = append(typeIds, id)
+		case int64(model.ObjectType_relation):
+			filters = append(filters, database.FilterRequest{
+				RelationKey: domain.RelationKey(details.GetString(bundle.RelationKeyRelationKey)),
+				Condition:   model.BlockContentDataviewFilter_Exists,
+			})
+		}
+	}
+	if len(typeIds) > 0 {
+		filters = append(filters, database.FilterRequest{
+			RelationKey: bundle.RelationKeyType,
+			Condition:   model.BlockContentDataviewFilter_In,
+			Value:       domain.StringList(typeIds),
+		})
+	}
+	if len(filters) == 0 {
+		return database.FilterRequest{}, fmt.Errorf("set has no valid sources")
+	}
+	return database.FilterRequest{
+		Operator:      model.BlockContentDataviewFilter_Or,
+		NestedFilters: filters,
+	}, nil
+}
+
+func findDataview(st *state.State) *model.BlockContentDataview {
+	var dataview *model.BlockContentDataview
+	st.Iterate(func(b simple.Block) (isContinue bool) {
+		dataview = b.Model().GetDataview()
+		return dataview == nil
+	})
+	return dataview
+}
+
+// findView returns the view with the given id or the first view of the dataview if the id is empty
+func findView(dataview *model.BlockContentDataview, viewId string) (*model.BlockContentDataviewView, error) {
+	if len(dataview.Views) == 0 {
+		return nil, fmt.Errorf("dataview has no views")
+	}
+	if viewId == "" {
+		return dataview.Views[0], nil
+	}
+	for _, view := range dataview.Views {
+		if view.Id == viewId {
+			return view, nil
+		}
+	}
+	return nil, fmt.Errorf("view %s not found", viewId)
+}
+
+// writeView converts objects of the view to the single file named after the set or collection.
+// It returns an error only if the export is canceled
+func (e *exportContext) writeView(wr writer, queue process.Queue, view dataviewView, mc converter.MultiConverter, defaultName string) (succeed int, err error) {
+	for _, objectId := range view.objectIds {
+		err = queue.Wait(func() {
+			werr := cache.Do(e.picker, objectId, func(b sb.SmartBlock) error {
+				return mc.Add(b.Space(), b.NewState().Copy())
+			})
+			if werr != nil {
+				log.With("objectID", objectId).Warnf("can't export doc: %v", werr)
+			} else {
+				succeed++
+			}
+		})
+		if err != nil {
+			return succeed, err
+		}
+	}
+	name := view.name
+	if name == "" {
+		name = defaultName
+	}
+	fileName := wr.Namer().Get("", view.id, name, mc.Ext())
+	if err := wr.WriteFile(fileName, bytes.NewReader(mc.Convert(model.SmartBlockType_Page)), 0); err != nil {
+		log.With("objectID", view.id).Warnf("can't write view: %v", err)
+	}
+	return succeed, nil
+}
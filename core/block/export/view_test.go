@@ -0,0 +1,71 @@
+package export
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/converter/ics"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestFindView(t *testing.T) {
+	dataview := &model.BlockContentDataview{Views: []*model.BlockContentDataviewView{
+		{Id: "grid", Type: model.BlockContentDataviewView_Table},
+		{Id: "calendar", Type: model.BlockContentDataviewView_Calendar, GroupRelationKey: "dueDate"},
+	}}
+
+	t.Run("view by id", func(t *testing.T) {
+		view, err := findView(dataview, "calendar")
+		require.NoError(t, err)
+		assert.Equal(t, "calendar", view.Id)
+	})
+	t.Run("first view if id is empty", func(t *testing.T) {
+		view, err := findView(dataview, "")
+		require.NoError(t, err)
+		assert.Equal(t, "grid", view.Id)
+	})
+	t.Run("unknown view", func(t *testing.T) {
+		_, err := findView(dataview, "unknown")
+		assert.Error(t, err)
+	})
+	t.Run("first calendar view if id is empty", func(t *testing.T) {
+		view, err := findCalendarView(dataview, "")
+		require.NoError(t, err)
+		assert.Equal(t, "calendar", view.Id)
+	})
+	t.Run("unknown calendar view", func(t *testing.T) {
+		_, err := findCalendarView(dataview, "unknown")
+		assert.Error(t, err)
+	})
+	t.Run("view is not a calendar", func(t *testing.T) {
+		_, err := findCalendarView(dataview, "grid")
+		assert.Error(t, err)
+	})
+}
+
+func TestCalendarDateRelations(t *testing.T) {
+	e := &exportContext{}
+
+	t.Run("time of dates is taken from the view", func(t *testing.T) {
+		relations := e.calendarDateRelations(dataviewView{view: &model.BlockContentDataviewView{
+			GroupRelationKey: "dueDate",
+			Relations:        []*model.BlockContentDataviewRelation{{Key: "dueDate", DateIncludeTime: true}},
+		}})
+		assert.Equal(t, ics.DateRelations{Date: "dueDate", IncludeTime: true}, relations)
+	})
+	t.Run("imported events have the end date", func(t *testing.T) {
+		relations := e.calendarDateRelations(dataviewView{view: &model.BlockContentDataviewView{
+			GroupRelationKey: ics.StartDateRelationKey.String(),
+			Relations:        []*model.BlockContentDataviewRelation{{Key: ics.StartDateRelationKey.String()}},
+		}})
+		assert.Equal(t, ics.DateRelations{Date: ics.StartDateRelationKey, End: ics.EndDateRelationKey}, relations)
+	})
+}
+
+func TestSortByCollectionOrder(t *testing.T) {
+	objectIds := []string{"a", "b", "c"}
+	sortByCollectionOrder(objectIds, []string{"c", "removed", "a", "b"})
+	assert.Equal(t, []string{"c", "a", "b"}, objectIds)
+}
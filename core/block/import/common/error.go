@@ -10,6 +10,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/common/source"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/archivecrypt"
 )
 
 var (
@@ -135,6 +136,8 @@ func GetImportNotificationErrorCode(err error) model.ImportErrorCode {
 		return model.Import_FILE_IMPORT_NO_OBJECTS_IN_ZIP_ARCHIVE
 	case errors.Is(err, ErrPbNotAnyBlockFormat):
 		return model.Import_PB_NOT_ANYBLOCK_FORMAT
+	case isWrongPasswordError(err):
+		return model.Import_PB_WRONG_PASSWORD
 	case errors.Is(err, ErrCancel):
 		return model.Import_IMPORT_IS_CANCELED
 	case errors.Is(err, ErrCsvLimitExceeded):
@@ -169,7 +172,11 @@ func IsNoObjectError(err error) bool {
 func isDefinedError(err error) bool {
 	return errors.Is(err, ErrCancel) || errors.Is(err, ErrCsvLimitExceeded) || errors.Is(err, ErrNotionServerExceedRateLimit) ||
 		errors.Is(err, ErrNotionServerIsUnavailable) || errors.Is(err, ErrFileLoad) || errors.Is(err, ErrPbNotAnyBlockFormat) ||
-		errors.Is(err, ErrWrongHTMLFormat)
+		errors.Is(err, ErrWrongHTMLFormat) || isWrongPasswordError(err)
+}
+
+func isWrongPasswordError(err error) bool {
+	return errors.Is(err, archivecrypt.ErrPasswordRequired) || errors.Is(err, archivecrypt.ErrWrongPassword)
 }
 
 func GetGalleryResponseCode(err error) pb.RpcObjectImportExperienceResponseErrorCode {
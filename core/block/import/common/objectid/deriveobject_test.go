@@ -101,4 +101,84 @@ func TestDerivedObject_GetIDAndPayload(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "oldId", id)
 	})
+	t.Run("existing object type with the same name", func(t *testing.T) {
+		// given
+		sf := objectstore.NewStoreFixture(t)
+		service := mock_space.NewMockService(t)
+		deriveObject := newDerivedObject(newExistingObject(sf), service, sf)
+		sn := &common.Snapshot{
+			Id: "oldId",
+			Snapshot: &common.SnapshotModel{
+				Data: &common.StateSnapshot{
+					Details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+						bundle.RelationKeyName:   domain.String("Book"),
+						bundle.RelationKeyLayout: domain.Int64(int64(model.ObjectType_objectType)),
+					}),
+					Key: "newKey",
+				},
+				SbType: coresb.SmartBlockTypeObjectType,
+			},
+		}
+
+		uniqueKey, err := domain.NewUniqueKey(coresb.SmartBlockTypeObjectType, "existingKey")
+		assert.Nil(t, err)
+		sf.AddObjects(t, "spaceId", []objectstore.TestObject{
+			{
+				bundle.RelationKeyUniqueKey: domain.String(uniqueKey.Marshal()),
+				bundle.RelationKeyId:        domain.String("typeId"),
+				bundle.RelationKeyName:      domain.String("Book"),
+				bundle.RelationKeyLayout:    domain.Int64(int64(model.ObjectType_objectType)),
+				bundle.RelationKeySpaceId:   domain.String("spaceId"),
+			},
+		})
+
+		// when
+		id, _, err := deriveObject.GetIDAndPayload(context.Background(), "spaceId", sn, time.Now(), false, objectorigin.Import(model.Import_Markdown))
+
+		// then
+		assert.Nil(t, err)
+		assert.Equal(t, "typeId", id)
+		assert.Equal(t, "existingKey", deriveObject.GetInternalKey(sn.Snapshot.SbType))
+	})
+	t.Run("archived object type with the same name", func(t *testing.T) {
+		// given
+		sf := objectstore.NewStoreFixture(t)
+		existingObject := newExistingObject(sf)
+		sn := &common.Snapshot{
+			Id: "oldId",
+			Snapshot: &common.SnapshotModel{
+				Data: &common.StateSnapshot{
+					Details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+						bundle.RelationKeyName:   domain.String("Book"),
+						bundle.RelationKeyLayout: domain.Int64(int64(model.ObjectType_objectType)),
+					}),
+					Key: "newKey",
+				},
+				SbType: coresb.SmartBlockTypeObjectType,
+			},
+		}
+		sf.AddObjects(t, "spaceId", []objectstore.TestObject{
+			{
+				bundle.RelationKeyId:         domain.String("archivedTypeId"),
+				bundle.RelationKeyName:       domain.String("Book"),
+				bundle.RelationKeyLayout:     domain.Int64(int64(model.ObjectType_objectType)),
+				bundle.RelationKeySpaceId:    domain.String("spaceId"),
+				bundle.RelationKeyIsArchived: domain.Bool(true),
+			},
+			{
+				bundle.RelationKeyId:        domain.String("deletedTypeId"),
+				bundle.RelationKeyName:      domain.String("Book"),
+				bundle.RelationKeyLayout:    domain.Int64(int64(model.ObjectType_objectType)),
+				bundle.RelationKeySpaceId:   domain.String("spaceId"),
+				bundle.RelationKeyIsDeleted: domain.Bool(true),
+			},
+		})
+
+		// when
+		id, _, err := existingObject.GetIDAndPayload(context.Background(), "spaceId", sn, false)
+
+		// then
+		assert.Nil(t, err)
+		assert.Empty(t, id)
+	})
 }
@@ -43,6 +43,9 @@ func (e *existingObject) GetIDAndPayload(_ context.Context, spaceID string, sn *
 	if sn.Snapshot.SbType == sb.SmartBlockTypeRelation {
 		return e.getExistingRelation(sn, spaceID), treestorage.TreeStorageCreatePayload{}, nil
 	}
+	if sn.Snapshot.SbType == sb.SmartBlockTypeObjectType {
+		return e.getExistingObjectType(sn, spaceID), treestorage.TreeStorageCreatePayload{}, nil
+	}
 	return "", treestorage.TreeStorageCreatePayload{}, nil
 }
 
@@ -152,3 +155,39 @@ func (e *existingObject) getExistingRelation(snapshot *common.Snapshot, spaceID
 	}
 	return ""
 }
+
+func (e *existingObject) getExistingObjectType(snapshot *common.Snapshot, spaceID string) string {
+	name := snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)
+	if name == "" {
+		return ""
+	}
+	ids, _, err := e.objectStore.SpaceIndex(spaceID).QueryObjectIds(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyName,
+				Value:       domain.String(name),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				RelationKey: bundle.RelationKeyLayout,
+				Value:       domain.Int64(model.ObjectType_objectType),
+			},
+			// objects of the import must not get a type which is in the bin
+			{
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				RelationKey: bundle.RelationKeyIsArchived,
+				Value:       domain.Bool(true),
+			},
+			{
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				RelationKey: bundle.RelationKeyIsDeleted,
+				Value:       domain.Bool(true),
+			},
+		},
+	})
+	if err == nil && len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
@@ -50,8 +50,9 @@ func (c *CollectionStrategy) CreateObjects(path string, csvTable [][]string, par
 	if err != nil {
 		return "", nil, err
 	}
-	relations, relationsSnapshots, errRelationLimit := getDetailsFromCSVTable(csvTable, params.UseFirstRowForRelations)
-	objectsSnapshots, errRowLimit := getObjectsFromCSVRows(path, csvTable, relations, params)
+	columns := newColumnConverter(params.GetColumnFormats())
+	relations, relationsSnapshots, errRelationLimit := getDetailsFromCSVTable(csvTable, params.UseFirstRowForRelations, columns)
+	objectsSnapshots, errRowLimit := getObjectsFromCSVRows(path, csvTable, relations, params, columns)
 	targetIDs := make([]string, 0, len(objectsSnapshots))
 	for _, objectsSnapshot := range objectsSnapshots {
 		targetIDs = append(targetIDs, objectsSnapshot.Id)
@@ -64,6 +65,7 @@ func (c *CollectionStrategy) CreateObjects(path string, csvTable [][]string, par
 	snapshots = append(snapshots, snapshot)
 	snapshots = append(snapshots, objectsSnapshots...)
 	snapshots = append(snapshots, relationsSnapshots...)
+	snapshots = append(snapshots, columns.snapshots...)
 	progress.AddDone(1)
 	if errRelationLimit != nil || errRowLimit != nil {
 		return "", nil, common.ErrCsvLimitExceeded
@@ -82,7 +84,7 @@ func updateDetailsForTransposeCollection(details *domain.Details, transpose bool
 	}
 }
 
-func getDetailsFromCSVTable(csvTable [][]string, useFirstRowForRelations bool) ([]*model.Relation, []*common.Snapshot, error) {
+func getDetailsFromCSVTable(csvTable [][]string, useFirstRowForRelations bool, columns *columnConverter) ([]*model.Relation, []*common.Snapshot, error) {
 	if len(csvTable) == 0 {
 		return nil, nil, nil
 	}
@@ -110,12 +112,13 @@ func getDetailsFromCSVTable(csvTable [][]string, useFirstRowForRelations bool) (
 			relationName = getDefaultRelationName(i)
 		}
 		key := bson.NewObjectId().Hex()
+		format := columns.columnFormat(relationName, key, columnValues(csvTable, i, useFirstRowForRelations))
 		relations = append(relations, &model.Relation{
-			Format: model.RelationFormat_longtext,
+			Format: format,
 			Name:   relationName,
 			Key:    key,
 		})
-		details := getRelationDetails(relationName, key, float64(model.RelationFormat_longtext))
+		details := getRelationDetails(relationName, key, float64(format))
 		id := details.GetString(bundle.RelationKeyId)
 		relationsSnapshots = append(relationsSnapshots, &common.Snapshot{
 			Id: id,
@@ -187,7 +190,7 @@ func getRelationDetails(name, key string, format float64) *domain.Details {
 	return details
 }
 
-func getObjectsFromCSVRows(path string, csvTable [][]string, relations []*model.Relation, params *pb.RpcObjectImportRequestCsvParams) ([]*common.Snapshot, error) {
+func getObjectsFromCSVRows(path string, csvTable [][]string, relations []*model.Relation, params *pb.RpcObjectImportRequestCsvParams, columns *columnConverter) ([]*common.Snapshot, error) {
 	snapshots := make([]*common.Snapshot, 0, len(csvTable))
 	numberOfObjectsLimit := len(csvTable)
 	var err error
@@ -210,7 +213,7 @@ func getObjectsFromCSVRows(path string, csvTable [][]string, relations []*model.
 				},
 			}),
 		}).NewState()
-		details, relationLinks := getDetailsForObject(csvTable[i], relations, path, i, params.TransposeRowsAndColumns)
+		details, relationLinks := getDetailsForObject(csvTable[i], relations, path, i, params.TransposeRowsAndColumns, columns)
 		st.SetDetails(details)
 		st.AddRelationLinks(relationLinks...)
 		template.InitTemplate(st, template.WithTitle)
@@ -234,7 +237,7 @@ func buildSourcePath(path string, i int, transpose bool) string {
 		transposePart
 }
 
-func getDetailsForObject(relationsValues []string, relations []*model.Relation, path string, objectOrderIndex int, transpose bool) (*domain.Details, []*model.RelationLink) {
+func getDetailsForObject(relationsValues []string, relations []*model.Relation, path string, objectOrderIndex int, transpose bool, columns *columnConverter) (*domain.Details, []*model.RelationLink) {
 	details := domain.NewDetails()
 	relationLinks := make([]*model.RelationLink, 0)
 	for j, value := range relationsValues {
@@ -242,7 +245,9 @@ func getDetailsForObject(relationsValues []string, relations []*model.Relation,
 			break
 		}
 		relation := relations[j]
-		details.SetString(domain.RelationKey(relation.Key), value)
+		if converted, ok := columns.convertValue(relation, value); ok {
+			details.Set(domain.RelationKey(relation.Key), converted)
+		}
 		relationLinks = append(relationLinks, &model.RelationLink{
 			Key:    relation.Key,
 			Format: relation.Format,
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -318,6 +319,11 @@ func assertSnapshotsHaveDetails(t *testing.T, want []string, objects *common.Sna
 		if key == bundle.RelationKeySourceFilePath || key == bundle.RelationKeyLayout {
 			continue
 		}
+		if ts, ok := value.TryInt64(); ok {
+			// dates are converted to timestamps
+			assert.Contains(t, want, time.Unix(ts, 0).Format("January 2, 2006 3:04 PM"))
+			continue
+		}
 		assert.Contains(t, want, value.String())
 	}
 }
@@ -515,6 +521,7 @@ func TestCsv_GetSnapshots1000RowsFile(t *testing.T) {
 	for _, snapshot := range sn.Snapshots {
 		// only objects created from rows
 		if snapshot.Snapshot.SbType != sb.SmartBlockTypeRelation &&
+			snapshot.Snapshot.SbType != sb.SmartBlockTypeRelationOption &&
 			!lo.Contains(snapshot.Snapshot.Data.ObjectTypes, bundle.TypeKeyCollection.String()) {
 			objects = append(objects, snapshot)
 		}
@@ -541,6 +548,7 @@ func TestCsv_GetSnapshots1000RowsFile(t *testing.T) {
 	for _, snapshot := range sn.Snapshots {
 		// only objects created from rows
 		if snapshot.Snapshot.SbType != sb.SmartBlockTypeRelation &&
+			snapshot.Snapshot.SbType != sb.SmartBlockTypeRelationOption &&
 			!lo.Contains(snapshot.Snapshot.Data.ObjectTypes, bundle.TypeKeyCollection.String()) {
 			objects = append(objects, snapshot)
 		}
@@ -549,6 +557,115 @@ func TestCsv_GetSnapshots1000RowsFile(t *testing.T) {
 	assert.Len(t, objects, limitForRows-1)
 }
 
+func TestCsv_GetSnapshotsColumnFormats(t *testing.T) {
+	getSnapshots := func(columnFormats ...*pb.RpcObjectImportRequestCsvParamsColumnFormat) *common.Response {
+		csv := CSV{}
+		sn, err := csv.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfCsvParams{
+				CsvParams: &pb.RpcObjectImportRequestCsvParams{
+					Path:                    []string{filepath.Join("testdata", "formats.csv")},
+					UseFirstRowForRelations: true,
+					ColumnFormats:           columnFormats,
+				},
+			},
+			Type: model.Import_Csv,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, process.NewNoOp())
+		assert.Nil(t, err)
+		assert.NotNil(t, sn)
+		return sn
+	}
+	collectSnapshots := func(sn *common.Response) (map[string]*model.Relation, map[string]string, map[string]*domain.Details) {
+		relations := make(map[string]*model.Relation)
+		options := make(map[string]string)
+		objects := make(map[string]*domain.Details)
+		for _, snapshot := range sn.Snapshots {
+			details := snapshot.Snapshot.Data.Details
+			switch {
+			case snapshot.Snapshot.SbType == sb.SmartBlockTypeRelation:
+				relations[details.GetString(bundle.RelationKeyName)] = &model.Relation{
+					Key:    details.GetString(bundle.RelationKeyRelationKey),
+					Format: model.RelationFormat(details.GetInt64(bundle.RelationKeyRelationFormat)),
+				}
+			case snapshot.Snapshot.SbType == sb.SmartBlockTypeRelationOption:
+				options[snapshot.Id] = details.GetString(bundle.RelationKeyName)
+			case !lo.Contains(snapshot.Snapshot.Data.ObjectTypes, bundle.TypeKeyCollection.String()):
+				objects[details.GetString(bundle.RelationKeyName)] = details
+			}
+		}
+		return relations, options, objects
+	}
+
+	t.Run("formats are inferred from values", func(t *testing.T) {
+		// when
+		relations, options, objects := collectSnapshots(getSnapshots())
+
+		// then
+		assert.Equal(t, model.RelationFormat_number, relations["Price"].Format)
+		assert.Equal(t, model.RelationFormat_date, relations["Date"].Format)
+		assert.Equal(t, model.RelationFormat_checkbox, relations["Done"].Format)
+		assert.Equal(t, model.RelationFormat_url, relations["Site"].Format)
+		assert.Equal(t, model.RelationFormat_email, relations["Email"].Format)
+		assert.Equal(t, model.RelationFormat_tag, relations["Tags"].Format)
+		assert.Equal(t, model.RelationFormat_status, relations["Status"].Format)
+		assert.Equal(t, model.RelationFormat_longtext, relations["Notes"].Format)
+		assert.Equal(t, model.RelationFormat_longtext, relations["Zip"].Format)
+		assert.Len(t, options, 6) // fruit, red, yellow, vegetable, Ripe, Unripe
+
+		apple := objects["Apple"]
+		assert.Equal(t, 1.5, apple.GetFloat64(domain.RelationKey(relations["Price"].Key)))
+		assert.Equal(t, time.Date(2023, 12, 25, 0, 0, 0, 0, time.Local).Unix(), apple.GetInt64(domain.RelationKey(relations["Date"].Key)))
+		assert.True(t, apple.GetBool(domain.RelationKey(relations["Done"].Key)))
+		assert.Equal(t, "01234", apple.GetString(domain.RelationKey(relations["Zip"].Key)))
+		tags := lo.Map(apple.GetStringList(domain.RelationKey(relations["Tags"].Key)), func(id string, _ int) string { return options[id] })
+		assert.Equal(t, []string{"fruit", "red"}, tags)
+
+		tomato := objects["Tomato"]
+		assert.False(t, tomato.Has(domain.RelationKey(relations["Price"].Key)))
+		assert.False(t, tomato.GetBool(domain.RelationKey(relations["Done"].Key)))
+		assert.Equal(t, apple.GetStringList(domain.RelationKey(relations["Status"].Key)), tomato.GetStringList(domain.RelationKey(relations["Status"].Key)))
+		assert.Equal(t, "Red, round", tomato.GetString(domain.RelationKey(relations["Notes"].Key)))
+	})
+	t.Run("formats are set by the client", func(t *testing.T) {
+		// when
+		relations, options, objects := collectSnapshots(getSnapshots(
+			&pb.RpcObjectImportRequestCsvParamsColumnFormat{Name: "Price", Format: model.RelationFormat_shorttext},
+			&pb.RpcObjectImportRequestCsvParamsColumnFormat{Name: "Notes", Format: model.RelationFormat_tag},
+			&pb.RpcObjectImportRequestCsvParamsColumnFormat{Name: "Zip", Format: model.RelationFormat_object},
+		))
+
+		// then
+		assert.Equal(t, model.RelationFormat_shorttext, relations["Price"].Format)
+		assert.Equal(t, model.RelationFormat_tag, relations["Notes"].Format)
+		assert.Equal(t, model.RelationFormat_longtext, relations["Zip"].Format)
+		assert.Equal(t, "1.5", objects["Apple"].GetString(domain.RelationKey(relations["Price"].Key)))
+		notes := lo.Map(objects["Tomato"].GetStringList(domain.RelationKey(relations["Notes"].Key)), func(id string, _ int) string { return options[id] })
+		assert.Equal(t, []string{"Red", "round"}, notes)
+	})
+}
+
+func Test_inferColumnFormat(t *testing.T) {
+	for _, tc := range []struct {
+		values []string
+		format model.RelationFormat
+	}{
+		{nil, model.RelationFormat_longtext},
+		{[]string{"1", "-2.5", "1e3"}, model.RelationFormat_number},
+		{[]string{"1", "007"}, model.RelationFormat_longtext},
+		{[]string{"TRUE", "false"}, model.RelationFormat_checkbox},
+		{[]string{"2023-01-02", "2023-01-03"}, model.RelationFormat_date},
+		{[]string{"July 13, 2022 8:54 AM"}, model.RelationFormat_date},
+		{[]string{"12/31/2023", "01/02/2023"}, model.RelationFormat_date},
+		{[]string{"https://example.com", "not a url"}, model.RelationFormat_longtext},
+		{[]string{"a", "b", "a", "b"}, model.RelationFormat_status},
+		{[]string{"a, b", "b", "a", "c, b"}, model.RelationFormat_tag},
+		{[]string{"a", "a"}, model.RelationFormat_longtext},
+		{[]string{"a", "b", "c", "a"}, model.RelationFormat_longtext},
+	} {
+		assert.Equal(t, tc.format, inferColumnFormat(tc.values), tc.values)
+	}
+}
+
 func Test_findUniqueRelationAndAddNumber(t *testing.T) {
 	t.Run("All relations are unique", func(t *testing.T) {
 		relations := []string{"relation", "relation1", "relation2", "relation3"}
@@ -0,0 +1,335 @@
+package csv
+
+import (
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	// columnSampleSize is the number of rows used to infer the format of the column
+	columnSampleSize = 200
+	// minOptionsSampleSize is the number of values needed to tell the tag or status column from the text one
+	minOptionsSampleSize = 4
+	// maxColumnOptions is the maximum number of distinct values in the tag or status column
+	maxColumnOptions = 20
+	maxOptionLength  = 50
+	optionSeparator  = ","
+)
+
+var (
+	csvDateLayouts = []string{
+		time.DateOnly,
+		time.RFC3339,
+		"2006-01-02T15:04:05",
+		time.DateTime,
+		"2006-01-02 15:04",
+		"01/02/2006",
+		"02/01/2006",
+		"01/02/2006 15:04",
+		"02/01/2006 15:04",
+		"02.01.2006",
+		"02.01.2006 15:04",
+		"January 2, 2006 3:04 PM",
+		"January 2, 2006",
+		"Jan 2, 2006",
+		"2 January 2006",
+		"2 Jan 2006",
+	}
+
+	csvCheckboxValues = map[string]bool{
+		"true":  true,
+		"yes":   true,
+		"false": false,
+		"no":    false,
+	}
+
+	// numbers with leading zeros, i.e. zip codes or identifiers, are kept as text
+	csvNumberRegexp = regexp.MustCompile(`^[-+]?(0|[1-9]\d*)(\.\d+)?([eE][-+]?\d+)?$`)
+
+	supportedColumnFormats = map[model.RelationFormat]struct{}{
+		model.RelationFormat_longtext:  {},
+		model.RelationFormat_shorttext: {},
+		model.RelationFormat_number:    {},
+		model.RelationFormat_date:      {},
+		model.RelationFormat_checkbox:  {},
+		model.RelationFormat_url:       {},
+		model.RelationFormat_email:     {},
+		model.RelationFormat_phone:     {},
+		model.RelationFormat_tag:       {},
+		model.RelationFormat_status:    {},
+	}
+)
+
+// columnConverter chooses formats of the relations created from csv columns and converts cell values
+// to these formats. Options of tag and status relations are created once per file
+type columnConverter struct {
+	formats     map[string]model.RelationFormat // column name -> format requested by the client
+	dateLayouts map[string]string               // relation key -> layout of the dates in the column
+	options     map[string]map[string]string    // relation key -> option name -> option id
+	snapshots   []*common.Snapshot
+}
+
+func newColumnConverter(columnFormats []*pb.RpcObjectImportRequestCsvParamsColumnFormat) *columnConverter {
+	c := &columnConverter{
+		formats:     make(map[string]model.RelationFormat, len(columnFormats)),
+		dateLayouts: make(map[string]string),
+		options:     make(map[string]map[string]string),
+	}
+	for _, columnFormat := range columnFormats {
+		if _, ok := supportedColumnFormats[columnFormat.Format]; !ok {
+			log.With("column", columnFormat.Name).Warnf("unsupported csv column format: %s", columnFormat.Format)
+			continue
+		}
+		c.formats[strings.TrimSpace(columnFormat.Name)] = columnFormat.Format
+	}
+	return c
+}
+
+// columnFormat returns the format requested for the column, or infers it from the column values
+func (c *columnConverter) columnFormat(name, key string, values []string) model.RelationFormat {
+	format, ok := c.formats[name]
+	if !ok {
+		format = inferColumnFormat(values)
+	}
+	if format == model.RelationFormat_date {
+		c.dateLayouts[key] = findDateLayout(values)
+	}
+	return format
+}
+
+// convertValue returns false if the value can't be stored in the relation, such values are skipped
+func (c *columnConverter) convertValue(relation *model.Relation, value string) (domain.Value, bool) {
+	trimmedValue := strings.TrimSpace(value)
+	switch relation.Format {
+	case model.RelationFormat_checkbox:
+		if b, ok := csvCheckboxValues[strings.ToLower(trimmedValue)]; ok {
+			return domain.Bool(b), true
+		}
+	case model.RelationFormat_number:
+		if f, err := strconv.ParseFloat(trimmedValue, 64); err == nil {
+			return domain.Float64(f), true
+		}
+	case model.RelationFormat_date:
+		if ts, ok := parseDate(trimmedValue, c.dateLayouts[relation.Key]); ok {
+			return domain.Int64(ts), true
+		}
+	case model.RelationFormat_tag:
+		if names := splitOptions(trimmedValue); len(names) > 0 {
+			return domain.StringList(c.provideOptions(relation.Key, names)), true
+		}
+	case model.RelationFormat_status:
+		if trimmedValue != "" {
+			return domain.StringList(c.provideOptions(relation.Key, []string{trimmedValue})), true
+		}
+	default:
+		return domain.String(value), true
+	}
+	return domain.Invalid(), false
+}
+
+func (c *columnConverter) provideOptions(relationKey string, names []string) []string {
+	options, ok := c.options[relationKey]
+	if !ok {
+		options = make(map[string]string)
+		c.options[relationKey] = options
+	}
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if id, ok := options[name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		key, details := getRelationOptionDetails(name, relationKey)
+		id := details.GetString(bundle.RelationKeyId)
+		c.snapshots = append(c.snapshots, &common.Snapshot{
+			Id: id,
+			Snapshot: &common.SnapshotModel{
+				SbType: smartblock.SmartBlockTypeRelationOption,
+				Data: &common.StateSnapshot{
+					Details:     details,
+					ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+					Key:         key,
+				},
+			},
+		})
+		options[name] = id
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// columnValues returns sample values of the column, skipping the first row if it contains relation names
+func columnValues(csvTable [][]string, column int, useFirstRowForRelations bool) []string {
+	firstRow := 0
+	if useFirstRowForRelations {
+		firstRow = 1
+	}
+	values := make([]string, 0, columnSampleSize)
+	for i := firstRow; i < len(csvTable) && len(values) < columnSampleSize; i++ {
+		if column >= len(csvTable[i]) {
+			continue
+		}
+		if value := strings.TrimSpace(csvTable[i][column]); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// inferColumnFormat chooses the most specific format all non-empty values of the column match.
+// Columns with few distinct values repeating across rows become status columns, or tag columns
+// if values contain comma-separated lists
+func inferColumnFormat(values []string) model.RelationFormat {
+	if len(values) == 0 {
+		return model.RelationFormat_longtext
+	}
+	switch {
+	case allValues(values, isCheckbox):
+		return model.RelationFormat_checkbox
+	case allValues(values, csvNumberRegexp.MatchString):
+		return model.RelationFormat_number
+	case findDateLayout(values) != "":
+		return model.RelationFormat_date
+	case allValues(values, isURL):
+		return model.RelationFormat_url
+	case allValues(values, isEmail):
+		return model.RelationFormat_email
+	}
+	if len(values) < minOptionsSampleSize {
+		return model.RelationFormat_longtext
+	}
+	if format, ok := inferOptionsFormat(values); ok {
+		return format
+	}
+	return model.RelationFormat_longtext
+}
+
+func inferOptionsFormat(values []string) (model.RelationFormat, bool) {
+	var (
+		counts      = make(map[string]int)
+		occurrences int
+		multiple    bool
+	)
+	for _, value := range values {
+		names := splitOptions(value)
+		if len(names) > 1 {
+			multiple = true
+		}
+		for _, name := range names {
+			if len(name) > maxOptionLength {
+				return 0, false
+			}
+			counts[name]++
+			occurrences++
+		}
+	}
+	// every option should be used at least twice on average, otherwise it's just a text
+	if len(counts) == 0 || len(counts) > maxColumnOptions || len(counts)*2 > occurrences {
+		return 0, false
+	}
+	if multiple {
+		return model.RelationFormat_tag, true
+	}
+	return model.RelationFormat_status, true
+}
+
+// findDateLayout returns the first layout all values match, so the day-first and month-first dates
+// are told apart by the values where the day is greater than 12
+func findDateLayout(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	for _, layout := range csvDateLayouts {
+		if allValues(values, func(value string) bool {
+			_, err := time.ParseInLocation(layout, value, time.Local)
+			return err == nil
+		}) {
+			return layout
+		}
+	}
+	return ""
+}
+
+// parseDate uses the layout of the column, values of columns with the format set by the client are matched
+// against all supported layouts
+func parseDate(value, layout string) (int64, bool) {
+	layouts := csvDateLayouts
+	if layout != "" {
+		layouts = []string{layout}
+	}
+	for _, l := range layouts {
+		if t, err := time.ParseInLocation(l, value, time.Local); err == nil {
+			return t.Unix(), true
+		}
+	}
+	return 0, false
+}
+
+func splitOptions(value string) []string {
+	var (
+		names = make([]string, 0)
+		seen  = make(map[string]struct{})
+	)
+	for _, name := range strings.Split(value, optionSeparator) {
+		name = strings.TrimSpace(name)
+		if _, exists := seen[name]; exists || name == "" {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names
+}
+
+func allValues(values []string, match func(string) bool) bool {
+	for _, value := range values {
+		if !match(value) {
+			return false
+		}
+	}
+	return true
+}
+
+func isCheckbox(value string) bool {
+	_, ok := csvCheckboxValues[strings.ToLower(value)]
+	return ok
+}
+
+func isURL(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func isEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+	return err == nil && address.Address == value
+}
+
+func getRelationOptionDetails(name, relationKey string) (string, *domain.Details) {
+	id := bson.NewObjectId().Hex()
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, relationKey)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+	details.SetInt64(bundle.RelationKeyCreatedDate, time.Now().Unix())
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, id)
+	if err != nil {
+		log.Warnf("failed to create unique key for csv relation option: %v", err)
+		return id, details
+	}
+	details.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	return id, details
+}
@@ -0,0 +1,335 @@
+package enex
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var log = logging.Logger("import-enex")
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name               = "Enex"
+	rootCollectionName = "Evernote Import"
+	enexExtension      = ".enex"
+	resourcesDir       = "enex"
+)
+
+type ENEX struct {
+	collectionService *collection.Service
+	tempDirProvider   core.TempDirProvider
+}
+
+func New(collectionService *collection.Service, tempDirProvider core.TempDirProvider) common.Converter {
+	return &ENEX{
+		collectionService: collectionService,
+		tempDirProvider:   tempDirProvider,
+	}
+}
+
+func (e *ENEX) Name() string {
+	return Name
+}
+
+func (e *ENEX) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetEnexParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (e *ENEX) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := e.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	c := &notesConverter{
+		tempDir: e.tempDirProvider.TempDir(),
+		tags:    make(map[string]string),
+	}
+	notebooks := e.getSnapshots(req, progress, paths, c, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	snapshots := c.snapshots
+	rootCollection := common.NewImportCollection(e.collectionService)
+	notebookIds := make([]string, 0, len(notebooks))
+	for _, nb := range notebooks {
+		settings := common.MakeImportCollectionSetting(nb.name, nb.noteIds, "", nil, false, false, true)
+		notebookSnapshot, err := rootCollection.MakeImportCollection(settings)
+		if err != nil {
+			allErrors.Add(err)
+			if allErrors.ShouldAbortImport(len(paths), req.Type) {
+				return nil, allErrors
+			}
+			continue
+		}
+		snapshots = append(snapshots, notebookSnapshot)
+		notebookIds = append(notebookIds, notebookSnapshot.Id)
+	}
+	settings := common.MakeImportCollectionSetting(rootCollectionName, notebookIds, "", nil, true, true, true)
+	rootCol, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &common.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &common.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+// notebook is the exported ENEX file, Evernote exports each notebook to its own file
+type notebook struct {
+	name    string
+	noteIds []string
+}
+
+func (e *ENEX) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	c *notesConverter,
+	allErrors *common.ConvertError,
+) []*notebook {
+	var notebooks []*notebook
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil
+		}
+		notebooks = append(notebooks, e.handleImportPath(p, len(paths), c, allErrors)...)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil
+		}
+	}
+	return notebooks
+}
+
+func (e *ENEX) handleImportPath(p string, pathsCount int, c *notesConverter, allErrors *common.ConvertError) []*notebook {
+	importSource := source.GetSource(p)
+	defer importSource.Close()
+	err := importSource.Initialize(p)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Enex) {
+			return nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{enexExtension}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil
+	}
+	var notebooks []*notebook
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), enexExtension) {
+			return true
+		}
+		nb := &notebook{name: strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))}
+		err := parseNotes(fileReader, func(n *note) error {
+			nb.noteIds = append(nb.noteIds, c.addNote(p, fileName, n))
+			return nil
+		})
+		fileReader.Close()
+		if err != nil {
+			allErrors.Add(err)
+			if allErrors.ShouldAbortImport(pathsCount, model.Import_Enex) {
+				return false
+			}
+		}
+		if len(nb.noteIds) > 0 {
+			notebooks = append(notebooks, nb)
+		}
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	return notebooks
+}
+
+// notesConverter creates page objects from notes. Tags of notes are stored in the bundled tag relation,
+// options of the relation are created once per import
+type notesConverter struct {
+	tempDir   string
+	snapshots []*common.Snapshot
+	tags      map[string]string
+}
+
+func (c *notesConverter) addNote(importPath, fileName string, n *note) string {
+	resources := c.saveResources(n)
+	content := enmlToHTML(n.Content, func(hash string) (string, bool, bool) {
+		res, ok := resources[hash]
+		return res.path, res.isImage, ok
+	})
+	blocks, _, err := anymark.HTMLToBlocks([]byte(content), "")
+	if err != nil {
+		log.Warnf("failed to convert note content: %v", err)
+	}
+	replaceFileLinks(blocks, resources)
+
+	// times of the imported file are used when the note doesn't have them
+	details := common.GetCommonDetails(importPath, n.Title, "", model.ObjectType_basic)
+	details.SetString(bundle.RelationKeySourceFilePath, filepath.Join(fileName, n.Created+" "+n.Title))
+	if created := parseTime(n.Created); !created.IsZero() {
+		details.SetInt64(bundle.RelationKeyCreatedDate, created.Unix())
+	}
+	if updated := parseTime(n.Updated); !updated.IsZero() {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, updated.Unix())
+	}
+	var relationLinks []*model.RelationLink
+	if n.Attributes.SourceUrl != "" {
+		details.SetString(bundle.RelationKeySource, n.Attributes.SourceUrl)
+		relationLinks = append(relationLinks, bundle.MustGetRelationLink(bundle.RelationKeySource))
+	}
+	if len(n.Tags) > 0 {
+		details.SetStringList(bundle.RelationKeyTag, c.provideTags(n.Tags))
+		relationLinks = append(relationLinks, bundle.MustGetRelationLink(bundle.RelationKeyTag))
+	}
+
+	snapshot := &common.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:        blocks,
+				Details:       details,
+				RelationLinks: relationLinks,
+				ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}
+	c.snapshots = append(c.snapshots, snapshot)
+	return snapshot.Id
+}
+
+type savedResource struct {
+	path    string
+	isImage bool
+}
+
+// saveResources writes resources of the note to the temporary directory, files are uploaded from there
+// when file blocks are synced
+func (c *notesConverter) saveResources(n *note) map[string]savedResource {
+	resources := make(map[string]savedResource, len(n.Resources))
+	for i := range n.Resources {
+		res := &n.Resources[i]
+		data, hash, err := res.decode()
+		if err != nil {
+			log.Warnf("failed to decode resource of the note: %v", err)
+			continue
+		}
+		dir := filepath.Join(c.tempDir, resourcesDir, hash)
+		if err = os.MkdirAll(dir, 0700); err != nil {
+			log.Warnf("failed to create directory for the resource: %v", err)
+			continue
+		}
+		path := filepath.Join(dir, filepath.Base(res.fileName(hash)))
+		if err = os.WriteFile(path, data, 0600); err != nil {
+			log.Warnf("failed to write resource: %v", err)
+			continue
+		}
+		resources[hash] = savedResource{path: path, isImage: res.isImage()}
+	}
+	return resources
+}
+
+// replaceFileLinks replaces paragraphs with links to resources by file blocks
+func replaceFileLinks(blocks []*model.Block, resources map[string]savedResource) {
+	paths := make(map[string]struct{}, len(resources))
+	for _, res := range resources {
+		paths[res.path] = struct{}{}
+	}
+	for _, block := range blocks {
+		for _, mark := range block.GetText().GetMarks().GetMarks() {
+			if mark.Type != model.BlockContentTextMark_Link {
+				continue
+			}
+			if _, ok := paths[mark.Param]; ok {
+				block.Content = anymark.ConvertTextToFile(mark.Param)
+				break
+			}
+		}
+	}
+}
+
+func (c *notesConverter) provideTags(names []string) []string {
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if id, ok := c.tags[name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		key, details, err := getTagOptionDetails(name)
+		if err != nil {
+			log.Warnf("failed to create tag option: %v", err)
+			continue
+		}
+		id := details.GetString(bundle.RelationKeyId)
+		c.snapshots = append(c.snapshots, &common.Snapshot{
+			Id: id,
+			Snapshot: &common.SnapshotModel{
+				SbType: smartblock.SmartBlockTypeRelationOption,
+				Data: &common.StateSnapshot{
+					Details:     details,
+					ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+					Key:         key,
+				},
+			},
+		})
+		c.tags[name] = id
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func getTagOptionDetails(name string) (string, *domain.Details, error) {
+	key := bson.NewObjectId().Hex()
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, key)
+	if err != nil {
+		return "", nil, fmt.Errorf("create unique key: %w", err)
+	}
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, bundle.RelationKeyTag.String())
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+	return key, details, nil
+}
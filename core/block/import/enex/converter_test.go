@@ -0,0 +1,123 @@
+package enex
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/mock_core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestENEX_GetSnapshots(t *testing.T) {
+	t.Run("notes with tags, to-dos and resources", func(t *testing.T) {
+		// given
+		tempDir := t.TempDir()
+		tempDirProvider := mock_core.NewMockTempDirProvider(t)
+		tempDirProvider.EXPECT().TempDir().Return(tempDir)
+		e := &ENEX{tempDirProvider: tempDirProvider}
+
+		// when
+		sn, err := e.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfEnexParams{
+				EnexParams: &pb.RpcObjectImportRequestEnexParams{Path: []string{filepath.Join("testdata", "Personal.enex")}},
+			},
+			Type: model.Import_Enex,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, process.NewNoOp())
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		tags := map[string]string{}
+		notes := map[string]*common.StateSnapshot{}
+		var collections []*common.StateSnapshot
+		for _, snapshot := range sn.Snapshots {
+			data := snapshot.Snapshot.Data
+			switch {
+			case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelationOption:
+				assert.Equal(t, bundle.RelationKeyTag.String(), data.Details.GetString(bundle.RelationKeyRelationKey))
+				tags[snapshot.Id] = data.Details.GetString(bundle.RelationKeyName)
+			case data.ObjectTypes[0] == bundle.TypeKeyCollection.String():
+				collections = append(collections, data)
+			default:
+				notes[data.Details.GetString(bundle.RelationKeyName)] = data
+			}
+		}
+		assert.Len(t, tags, 2)
+		assert.Len(t, collections, 2)
+		require.Len(t, notes, 2)
+
+		shopping := notes["Shopping list"]
+		require.NotNil(t, shopping)
+		assert.Equal(t, time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC).Unix(), shopping.Details.GetInt64(bundle.RelationKeyCreatedDate))
+		assert.Equal(t, time.Date(2024, 5, 1, 11, 30, 0, 0, time.UTC).Unix(), shopping.Details.GetInt64(bundle.RelationKeyLastModifiedDate))
+		assert.Equal(t, "https://example.com/list", shopping.Details.GetString(bundle.RelationKeySource))
+		var tagNames []string
+		for _, id := range shopping.Details.GetStringList(bundle.RelationKeyTag) {
+			tagNames = append(tagNames, tags[id])
+		}
+		assert.Equal(t, []string{"home", "errands"}, tagNames)
+
+		var (
+			checkboxes = map[string]bool{}
+			files      []*model.BlockContentFile
+		)
+		for _, block := range shopping.Blocks {
+			if text := block.GetText(); text != nil {
+				assert.NotContains(t, text.Text, "secret")
+				if text.Style == model.BlockContentText_Checkbox {
+					checkboxes[text.Text] = text.Checked
+				}
+			}
+			if file := block.GetFile(); file != nil {
+				files = append(files, file)
+			}
+		}
+		assert.Equal(t, map[string]bool{"Milk": true, "Bread": false}, checkboxes)
+		require.Len(t, files, 2)
+		assert.Equal(t, model.BlockContentFile_Image, files[0].Type)
+		assert.Equal(t, "pixel.png", filepath.Base(files[0].Name))
+		assert.Equal(t, "document.txt", filepath.Base(files[1].Name))
+		data, readErr := os.ReadFile(files[1].Name)
+		require.NoError(t, readErr)
+		assert.Equal(t, "attached document\n", string(data))
+
+		checkboxes = map[string]bool{}
+		for _, block := range notes["Ideas"].Blocks {
+			if text := block.GetText(); text != nil && text.Style == model.BlockContentText_Checkbox {
+				checkboxes[text.Text] = text.Checked
+			}
+		}
+		assert.Equal(t, map[string]bool{"Paint the fence": true, "Plant trees": false}, checkboxes)
+	})
+	t.Run("no enex files", func(t *testing.T) {
+		// given
+		tempDirProvider := mock_core.NewMockTempDirProvider(t)
+		tempDirProvider.EXPECT().TempDir().Return(t.TempDir())
+		e := &ENEX{tempDirProvider: tempDirProvider}
+
+		// when
+		_, err := e.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfEnexParams{
+				EnexParams: &pb.RpcObjectImportRequestEnexParams{Path: []string{t.TempDir()}},
+			},
+			Type: model.Import_Enex,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, process.NewNoOp())
+
+		// then
+		require.NotNil(t, err)
+		assert.ErrorIs(t, err.GetResultError(model.Import_Enex), common.ErrFileImportNoObjectsInDirectory)
+	})
+}
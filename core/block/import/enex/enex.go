@@ -0,0 +1,178 @@
+package enex
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const enexTimeLayout = "20060102T150405Z"
+
+type note struct {
+	Title      string         `xml:"title"`
+	Content    string         `xml:"content"`
+	Created    string         `xml:"created"`
+	Updated    string         `xml:"updated"`
+	Tags       []string       `xml:"tag"`
+	Attributes noteAttributes `xml:"note-attributes"`
+	Resources  []resource     `xml:"resource"`
+}
+
+type noteAttributes struct {
+	SourceUrl string `xml:"source-url"`
+	Author    string `xml:"author"`
+}
+
+type resource struct {
+	Data     resourceData `xml:"data"`
+	Mime     string       `xml:"mime"`
+	FileName string       `xml:"resource-attributes>file-name"`
+}
+
+type resourceData struct {
+	Encoding string `xml:"encoding,attr"`
+	Value    string `xml:",chardata"`
+}
+
+// decode returns the content of the resource and its hash, which is used to reference the resource from ENML
+func (r *resource) decode() ([]byte, string, error) {
+	if r.Data.Encoding != "" && r.Data.Encoding != "base64" {
+		return nil, "", fmt.Errorf("unsupported resource encoding: %s", r.Data.Encoding)
+	}
+	data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(r.Data.Value), ""))
+	if err != nil {
+		return nil, "", fmt.Errorf("decode resource: %w", err)
+	}
+	hash := md5.Sum(data)
+	return data, hex.EncodeToString(hash[:]), nil
+}
+
+func (r *resource) fileName(hash string) string {
+	if r.FileName != "" {
+		return r.FileName
+	}
+	return hash + extensionByMime(r.Mime)
+}
+
+func (r *resource) isImage() bool {
+	return strings.HasPrefix(r.Mime, "image/")
+}
+
+var commonExtensions = map[string]string{
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+	"image/gif":       ".gif",
+	"application/pdf": ".pdf",
+	"audio/mpeg":      ".mp3",
+	"video/mp4":       ".mp4",
+}
+
+func extensionByMime(mimeType string) string {
+	if ext, ok := commonExtensions[mimeType]; ok {
+		return ext
+	}
+	if extensions, err := mime.ExtensionsByType(mimeType); err == nil && len(extensions) > 0 {
+		return extensions[0]
+	}
+	return ""
+}
+
+func parseTime(value string) time.Time {
+	t, err := time.Parse(enexTimeLayout, strings.TrimSpace(value))
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
+
+// parseNotes reads notes of the ENEX file one by one, so resources of only one note are kept in memory
+func parseNotes(r io.Reader, onNote func(n *note) error) error {
+	decoder := xml.NewDecoder(r)
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("parse enex: %w", err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "note" {
+			continue
+		}
+		n := &note{}
+		if err = decoder.DecodeElement(n, &start); err != nil {
+			return fmt.Errorf("parse enex note: %w", err)
+		}
+		if err = onNote(n); err != nil {
+			return err
+		}
+	}
+}
+
+var (
+	reEnNote      = regexp.MustCompile(`(?is)<en-note\b[^>]*>(.*)</en-note>`)
+	reEnTodo      = regexp.MustCompile(`(?i)<en-todo\b([^>]*?)/?>(\s*</en-todo>)?`)
+	reEnMedia     = regexp.MustCompile(`(?i)<en-media\b([^>]*?)/?>(\s*</en-media>)?`)
+	reEnCrypt     = regexp.MustCompile(`(?is)<en-crypt\b.*?</en-crypt>`)
+	reCheckedItem = regexp.MustCompile(`(?i)<li\b[^>]*--en-checked:\s*(true|false)[^>]*>(\s*<div\b[^>]*>)?`)
+	reAttribute   = regexp.MustCompile(`([\w-]+)\s*=\s*"([^"]*)"`)
+)
+
+func attributes(raw string) map[string]string {
+	attrs := make(map[string]string)
+	for _, match := range reAttribute.FindAllStringSubmatch(raw, -1) {
+		attrs[strings.ToLower(match[1])] = html.UnescapeString(match[2])
+	}
+	return attrs
+}
+
+// enmlToHTML replaces Evernote elements of the note content with HTML understood by the HTML converter.
+// Media is replaced with the image or the link to the file at the path returned by resourcePath
+func enmlToHTML(enml string, resourcePath func(hash string) (path string, isImage bool, ok bool)) string {
+	content := enml
+	if match := reEnNote.FindStringSubmatch(content); match != nil {
+		content = match[1]
+	}
+	content = reEnCrypt.ReplaceAllString(content, "")
+	content = reEnTodo.ReplaceAllStringFunc(content, func(todo string) string {
+		if strings.EqualFold(attributes(reEnTodo.FindStringSubmatch(todo)[1])["checked"], "true") {
+			return "[x] "
+		}
+		return "[ ] "
+	})
+	// checklists of the new Evernote are lists with the checked state in the style of items
+	content = reCheckedItem.ReplaceAllStringFunc(content, func(item string) string {
+		match := reCheckedItem.FindStringSubmatch(item)
+		checkbox := "[ ] "
+		if strings.EqualFold(match[1], "true") {
+			checkbox = "[x] "
+		}
+		return "<li>" + match[2] + checkbox
+	})
+	return reEnMedia.ReplaceAllStringFunc(content, func(media string) string {
+		hash := attributes(reEnMedia.FindStringSubmatch(media)[1])["hash"]
+		path, isImage, ok := resourcePath(hash)
+		if !ok {
+			return ""
+		}
+		escapedPath := (&url.URL{Path: path}).EscapedPath()
+		if isImage {
+			return fmt.Sprintf(`<img src="%s">`, escapedPath)
+		}
+		// the file link is placed to its own paragraph, so it is converted to the file block
+		return fmt.Sprintf(`<div><a href="%s">%s</a></div>`, escapedPath, html.EscapeString(path))
+	})
+}
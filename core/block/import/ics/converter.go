@@ -0,0 +1,297 @@
+package ics
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter/ics"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var log = logging.Logger("ics-import")
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name               = "Ics"
+	rootCollectionName = "ICS Import"
+	icsExtension       = ".ics"
+
+	startDateRelationName = "Start date"
+	endDateRelationName   = "End date"
+)
+
+type ICS struct {
+	service *collection.Service
+}
+
+func New(service *collection.Service) common.Converter {
+	return &ICS{service: service}
+}
+
+func (i *ICS) Name() string {
+	return Name
+}
+
+func (i *ICS) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetIcsParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (i *ICS) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := i.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	c := newCalendarConverter(req.GetIcsParams().GetTypeKey())
+	targetObjects := i.getSnapshots(req, progress, paths, c, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	snapshots := c.snapshots
+	rootCollection := common.NewImportCollection(i.service)
+	settings := common.MakeImportCollectionSetting(rootCollectionName, targetObjects, "", nil, true, true, true)
+	rootCol, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &common.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &common.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (i *ICS) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	c *calendarConverter,
+	allErrors *common.ConvertError,
+) []string {
+	targetObjects := make([]string, 0)
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil
+		}
+		targetObjects = append(targetObjects, i.handleImportPath(p, len(paths), c, allErrors)...)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil
+		}
+	}
+	return targetObjects
+}
+
+func (i *ICS) handleImportPath(p string, pathsCount int, c *calendarConverter, allErrors *common.ConvertError) []string {
+	importSource := source.GetSource(p)
+	defer importSource.Close()
+	err := importSource.Initialize(p)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Ics) {
+			return nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{icsExtension}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil
+	}
+	var targetObjects []string
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), icsExtension) {
+			return true
+		}
+		events, err := ics.Parse(fileReader)
+		fileReader.Close()
+		if err != nil {
+			allErrors.Add(err)
+			return !allErrors.ShouldAbortImport(pathsCount, model.Import_Ics)
+		}
+		for _, event := range events {
+			targetObjects = append(targetObjects, c.addEvent(fileName, event))
+		}
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	return targetObjects
+}
+
+// calendarConverter creates objects of the given type from events. Start and end dates of the events are stored
+// in the "Start date" and "End date" relations, due dates of to-dos are stored in the bundled dueDate relation.
+// Relations of the dates have fixed keys, so every import derives the same relation objects, and date relations
+// of the space with these names are reused by the import, see objectid.existingObject. The relations include time
+// when any of the events is not an all-day event
+type calendarConverter struct {
+	typeKey          domain.TypeKey
+	layout           model.ObjectTypeLayout
+	snapshots        []*common.Snapshot
+	relations        map[string]*model.RelationLink
+	relationsDetails map[string]*domain.Details
+}
+
+func newCalendarConverter(typeKey string) *calendarConverter {
+	c := &calendarConverter{
+		typeKey:          domain.TypeKey(typeKey),
+		layout:           model.ObjectType_basic,
+		relations:        make(map[string]*model.RelationLink),
+		relationsDetails: make(map[string]*domain.Details),
+	}
+	if c.typeKey == "" {
+		c.typeKey = bundle.TypeKeyPage
+	}
+	if objectType, err := bundle.GetType(c.typeKey); err == nil {
+		c.layout = objectType.Layout
+	}
+	return c
+}
+
+func (c *calendarConverter) addEvent(fileName string, event ics.Event) string {
+	details := domain.NewDetails()
+	var relationLinks []*model.RelationLink
+	setDetail := func(link *model.RelationLink, value domain.Value) {
+		details.Set(domain.RelationKey(link.Key), value)
+		relationLinks = append(relationLinks, link)
+	}
+	setDate := func(link *model.RelationLink, date time.Time) {
+		if !date.IsZero() {
+			setDetail(link, domain.Int64(date.Unix()))
+		}
+	}
+	setEventDate := func(name string, key domain.RelationKey, date time.Time) {
+		link := c.provideDateRelation(name, key)
+		if !event.AllDay {
+			c.relationsDetails[name].SetBool(bundle.RelationKeyRelationFormatIncludeTime, true)
+		}
+		setDate(link, date)
+	}
+
+	details.SetString(bundle.RelationKeyName, event.Summary)
+	details.SetString(bundle.RelationKeySourceFilePath, filepath.Join(fileName, event.Uid))
+	details.SetInt64(bundle.RelationKeyLayout, int64(c.layout))
+	if event.Description != "" {
+		setDetail(bundle.MustGetRelationLink(bundle.RelationKeyDescription), domain.String(event.Description))
+	}
+	if event.Url != "" {
+		setDetail(bundle.MustGetRelationLink(bundle.RelationKeyUrl), domain.String(event.Url))
+	}
+	if event.Rule != "" {
+		setDetail(bundle.MustGetRelationLink(bundle.RelationKeyRecurrence), domain.String(event.Rule))
+	}
+	if event.Todo {
+		setDetail(bundle.MustGetRelationLink(bundle.RelationKeyDone), domain.Bool(event.Done))
+		setDate(bundle.MustGetRelationLink(bundle.RelationKeyDueDate), event.Due)
+	}
+	if !event.Start.IsZero() {
+		setEventDate(startDateRelationName, ics.StartDateRelationKey, event.Start)
+	}
+	end := event.End
+	if event.AllDay && end.After(event.Start) {
+		// the end date of all-day events is exclusive
+		end = end.AddDate(0, 0, -1)
+	}
+	if !end.IsZero() && !end.Equal(event.Start) {
+		setEventDate(endDateRelationName, ics.EndDateRelationKey, end)
+	}
+	if !event.Created.IsZero() {
+		details.SetInt64(bundle.RelationKeyCreatedDate, event.Created.Unix())
+	}
+	if !event.Modified.IsZero() {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, event.Modified.Unix())
+	}
+
+	st := state.NewDoc("root", map[string]simple.Block{
+		"root": simple.New(&model.Block{
+			Content: &model.BlockContentOfSmartblock{
+				Smartblock: &model.BlockContentSmartblock{},
+			},
+		}),
+	}).NewState()
+	st.SetDetails(details)
+	st.AddRelationLinks(relationLinks...)
+	template.InitTemplate(st, template.WithTitle)
+	snapshot := &common.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:        st.Blocks(),
+				Details:       details,
+				RelationLinks: st.GetRelationLinks(),
+				ObjectTypes:   []string{c.typeKey.String()},
+			},
+		},
+	}
+	c.snapshots = append(c.snapshots, snapshot)
+	return snapshot.Id
+}
+
+func (c *calendarConverter) provideDateRelation(name string, key domain.RelationKey) *model.RelationLink {
+	if link, ok := c.relations[name]; ok {
+		return link
+	}
+	details := getRelationDetails(name, key.String(), float64(model.RelationFormat_date))
+	c.snapshots = append(c.snapshots, &common.Snapshot{
+		Id: details.GetString(bundle.RelationKeyId),
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelation,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelation.String()},
+				Key:         key.String(),
+			},
+		},
+	})
+	link := &model.RelationLink{Key: key.String(), Format: model.RelationFormat_date}
+	c.relations[name] = link
+	c.relationsDetails[name] = details
+	return link
+}
+
+func getRelationDetails(name, key string, format float64) *domain.Details {
+	details := domain.NewDetails()
+	details.SetFloat64(bundle.RelationKeyRelationFormat, format)
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, key)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relation))
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelation, key)
+	if err != nil {
+		log.Warnf("failed to create unique key for ics relation: %v", err)
+		return details
+	}
+	details.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	return details
+}
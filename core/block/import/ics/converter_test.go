@@ -0,0 +1,108 @@
+package ics
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/converter/ics"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestICS_GetSnapshots(t *testing.T) {
+	t.Run("events and to-dos", func(t *testing.T) {
+		// given
+		i := &ICS{}
+
+		// when
+		sn, err := i.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfIcsParams{
+				IcsParams: &pb.RpcObjectImportRequestIcsParams{
+					Path:    []string{filepath.Join("testdata", "calendar.ics")},
+					TypeKey: bundle.TypeKeyTask.String(),
+				},
+			},
+			Type: model.Import_Ics,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, process.NewNoOp())
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		relations := map[string]string{}
+		includeTime := map[string]bool{}
+		objects := map[string]*common.StateSnapshot{}
+		for _, snapshot := range sn.Snapshots {
+			data := snapshot.Snapshot.Data
+			switch {
+			case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelation:
+				assert.Equal(t, int64(model.RelationFormat_date), data.Details.GetInt64(bundle.RelationKeyRelationFormat))
+				relations[data.Details.GetString(bundle.RelationKeyName)] = data.Key
+				includeTime[data.Key] = data.Details.GetBool(bundle.RelationKeyRelationFormatIncludeTime)
+			case snapshot.Id == sn.RootCollectionID:
+				assert.Equal(t, bundle.TypeKeyCollection.String(), data.ObjectTypes[0])
+			default:
+				assert.Equal(t, bundle.TypeKeyTask.String(), data.ObjectTypes[0])
+				assert.Equal(t, int64(model.ObjectType_todo), data.Details.GetInt64(bundle.RelationKeyLayout))
+				objects[data.Details.GetString(bundle.RelationKeyName)] = data
+			}
+		}
+		require.Len(t, relations, 2)
+		require.Len(t, objects, 3)
+		assert.Equal(t, ics.StartDateRelationKey.String(), relations[startDateRelationName])
+		assert.Equal(t, ics.EndDateRelationKey.String(), relations[endDateRelationName])
+		// the calendar has events with time
+		assert.True(t, includeTime[ics.StartDateRelationKey.String()])
+		assert.True(t, includeTime[ics.EndDateRelationKey.String()])
+		startKey := domain.RelationKey(relations[startDateRelationName])
+		endKey := domain.RelationKey(relations[endDateRelationName])
+
+		conference := objects["Conference"].Details
+		assert.Equal(t, "Three days, two talks", conference.GetString(bundle.RelationKeyDescription))
+		assert.Equal(t, "https://example.com/conference", conference.GetString(bundle.RelationKeyUrl))
+		assert.Equal(t, time.Date(2024, 4, 10, 0, 0, 0, 0, time.Local).Unix(), conference.GetInt64(startKey))
+		assert.Equal(t, time.Date(2024, 4, 12, 0, 0, 0, 0, time.Local).Unix(), conference.GetInt64(endKey))
+		assert.False(t, conference.Has(bundle.RelationKeyDone))
+
+		standup := objects["Standup"]
+		assert.Equal(t, "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", standup.Details.GetString(bundle.RelationKeyRecurrence))
+		assert.Equal(t, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC).Unix(), standup.Details.GetInt64(startKey))
+		assert.Equal(t, time.Date(2024, 1, 2, 9, 15, 0, 0, time.UTC).Unix(), standup.Details.GetInt64(endKey))
+		assert.NotEmpty(t, standup.Blocks)
+
+		report := objects["Send the report"].Details
+		assert.True(t, report.Has(bundle.RelationKeyDone))
+		assert.False(t, report.GetBool(bundle.RelationKeyDone))
+		assert.Equal(t, time.Date(2024, 1, 5, 17, 0, 0, 0, time.UTC).Unix(), report.GetInt64(bundle.RelationKeyDueDate))
+		assert.False(t, report.Has(startKey))
+	})
+	t.Run("no ics files in dir", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		i := &ICS{}
+
+		// when
+		_, err := i.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfIcsParams{
+				IcsParams: &pb.RpcObjectImportRequestIcsParams{Path: []string{dir}},
+			},
+			Type: model.Import_Ics,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, process.NewNoOp())
+
+		// then
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(model.Import_Ics), common.ErrFileImportNoObjectsInDirectory))
+	})
+}
@@ -23,9 +23,13 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/common/syncer"
 	"github.com/anyproto/anytype-heart/core/block/import/common/workerpool"
 	"github.com/anyproto/anytype-heart/core/block/import/csv"
+	"github.com/anyproto/anytype-heart/core/block/import/enex"
 	"github.com/anyproto/anytype-heart/core/block/import/html"
+	"github.com/anyproto/anytype-heart/core/block/import/ics"
 	"github.com/anyproto/anytype-heart/core/block/import/markdown"
 	"github.com/anyproto/anytype-heart/core/block/import/notion"
+	"github.com/anyproto/anytype-heart/core/block/import/opml"
+	"github.com/anyproto/anytype-heart/core/block/import/outliner"
 	pbc "github.com/anyproto/anytype-heart/core/block/import/pb"
 	"github.com/anyproto/anytype-heart/core/block/import/txt"
 	"github.com/anyproto/anytype-heart/core/block/import/web"
@@ -92,6 +96,10 @@ func (i *Import) Init(a *app.App) (err error) {
 		html.New(col, i.tempDirProvider),
 		txt.New(col),
 		csv.New(col),
+		ics.New(col),
+		enex.New(col, i.tempDirProvider),
+		outliner.New(col, i.tempDirProvider),
+		opml.New(col),
 	}
 	for _, c := range converters {
 		i.converters[c.Name()] = c
@@ -480,8 +480,18 @@ func Test_ListImports(t *testing.T) {
 	assert.True(t, res[0].Type == pb.RpcObjectImportListImportResponseType(0) || res[1].Type == pb.RpcObjectImportListImportResponseType(0))
 }
 
+// clearWebParsers removes parsers registered by the web parsers package for the test
+func clearWebParsers(t *testing.T) {
+	registered := parsers.Parsers
+	parsers.Parsers = []parsers.RegisterParser{}
+	t.Cleanup(func() {
+		parsers.Parsers = registered
+	})
+}
+
 func Test_ImportWebNoParser(t *testing.T) {
 	i := Import{}
+	clearWebParsers(t)
 	i.converters = make(map[string]common.Converter, 0)
 	i.converters[web.Name] = web.NewConverter()
 
@@ -502,6 +512,7 @@ func Test_ImportWebNoParser(t *testing.T) {
 
 func Test_ImportWebFailedToParse(t *testing.T) {
 	i := Import{}
+	clearWebParsers(t)
 
 	i.converters = make(map[string]common.Converter, 0)
 	i.converters[web.Name] = web.NewConverter()
@@ -531,7 +542,7 @@ func Test_ImportWebFailedToParse(t *testing.T) {
 
 func Test_ImportWebSuccess(t *testing.T) {
 	i := Import{}
-	parsers.Parsers = []parsers.RegisterParser{}
+	clearWebParsers(t)
 
 	i.converters = make(map[string]common.Converter, 0)
 
@@ -574,7 +585,7 @@ func Test_ImportWebSuccess(t *testing.T) {
 
 func Test_ImportWebFailedToCreateObject(t *testing.T) {
 	i := Import{}
-	parsers.Parsers = []parsers.RegisterParser{}
+	clearWebParsers(t)
 
 	i.converters = make(map[string]common.Converter, 0)
 	i.converters[web.Name] = web.NewConverter()
@@ -41,6 +41,8 @@ type blocksRenderer struct {
 	inTable       bool
 	listParentID  string
 	listNestIsNum []bool
+
+	onHashtag func(tag string)
 }
 
 func newBlocksRenderer(baseFilepath string, allFileShortPaths []string, inTable bool) *blocksRenderer {
@@ -227,6 +229,31 @@ func (r *blocksRenderer) AddImageBlock(source string) {
 	r.addChildIDToParentBlock(newBlock.Id)
 }
 
+func (r *blocksRenderer) AddFileBlock(path string) {
+	newBlock := model.Block{
+		Id:      bson.NewObjectId().Hex(),
+		Content: ConvertTextToFile(path),
+	}
+
+	r.blocks = append(r.blocks, &newBlock)
+	r.addChildIDToParentBlock(newBlock.Id)
+}
+
+func (r *blocksRenderer) AddLinkBlock(targetPath string) {
+	newBlock := model.Block{
+		Id: bson.NewObjectId().Hex(),
+		Content: &model.BlockContentOfLink{
+			Link: &model.BlockContentLink{
+				TargetBlockId: targetPath,
+				Style:         model.BlockContentLink_Page,
+			},
+		},
+	}
+
+	r.blocks = append(r.blocks, &newBlock)
+	r.addChildIDToParentBlock(newBlock.Id)
+}
+
 func (r *blocksRenderer) AddDivider() {
 	r.marksStartQueue = []int{}
 	r.marksBuffer = []*model.BlockContentTextMark{}
@@ -0,0 +1,133 @@
+package anymark
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/text"
+)
+
+// cellSpanMarker wraps the size of the merged cell written to the beginning of its text,
+// so the size survives html to markdown conversion, which has no merged cells
+const cellSpanMarker = "\uE000"
+
+var reCellSpan = regexp.MustCompile(`^\x{E000}(\d+)x(\d+)\x{E000}`)
+
+type cellPosition struct {
+	row, col int
+}
+
+// expandMergedCells replaces merged cells of html tables with the grid of separate cells,
+// the top-left one keeps the content and gets the marker with the size of the merged cell
+func expandMergedCells(source string) string {
+	lower := strings.ToLower(source)
+	if !strings.Contains(lower, "rowspan") && !strings.Contains(lower, "colspan") {
+		return source
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(source))
+	if err != nil {
+		return source
+	}
+	var expanded bool
+	doc.Find("table").Each(func(_ int, table *goquery.Selection) {
+		if expandTableCells(table) {
+			expanded = true
+		}
+	})
+	if !expanded {
+		return source
+	}
+	res, err := doc.Html()
+	if err != nil {
+		return source
+	}
+	return res
+}
+
+func expandTableCells(table *goquery.Selection) (expanded bool) {
+	// rows of nested tables are expanded separately
+	rows := table.Find("tr").FilterFunction(func(_ int, tr *goquery.Selection) bool {
+		return tr.Closest("table").IsSelection(table)
+	})
+	covered := map[cellPosition]bool{}
+	rows.Each(func(rowNumber int, tr *goquery.Selection) {
+		var colNumber, lastCovered int
+		tr.ChildrenFiltered("td, th").Each(func(_ int, cell *goquery.Selection) {
+			for covered[cellPosition{rowNumber, colNumber}] {
+				cell.BeforeHtml(emptyCell(cell))
+				colNumber++
+			}
+			rowSpan, colSpan := spanAttr(cell, "rowspan"), spanAttr(cell, "colspan")
+			if rowSpan > 1 || colSpan > 1 {
+				expanded = true
+				cell.RemoveAttr("rowspan")
+				cell.RemoveAttr("colspan")
+				cell.PrependHtml(fmt.Sprintf("%s%dx%d%s", cellSpanMarker, rowSpan, colSpan, cellSpanMarker))
+				for i := 1; i < colSpan; i++ {
+					cell.AfterHtml(emptyCell(cell))
+				}
+				for i := 1; i < rowSpan; i++ {
+					for j := 0; j < colSpan; j++ {
+						covered[cellPosition{rowNumber + i, colNumber + j}] = true
+					}
+				}
+			}
+			colNumber += colSpan
+		})
+		// cells covered by merged cells from the rows above can be the last ones in the row
+		for pos := range covered {
+			if pos.row == rowNumber {
+				lastCovered = max(lastCovered, pos.col+1)
+			}
+		}
+		for ; colNumber < lastCovered; colNumber++ {
+			tr.AppendHtml("<td></td>")
+		}
+	})
+	return expanded
+}
+
+func emptyCell(cell *goquery.Selection) string {
+	if goquery.NodeName(cell) == "th" {
+		return "<th></th>"
+	}
+	return "<td></td>"
+}
+
+func spanAttr(cell *goquery.Selection, name string) int {
+	v, err := strconv.Atoi(strings.TrimSpace(cell.AttrOr(name, "1")))
+	if err != nil || v < 1 {
+		return 1
+	}
+	return v
+}
+
+// cutCellSpan removes the marker of the merged cell from the text block and returns the size of the merged cell
+func cutCellSpan(b *model.Block) (rowSpan, colSpan int, ok bool) {
+	t := b.GetText()
+	if t == nil {
+		return 0, 0, false
+	}
+	m := reCellSpan.FindStringSubmatch(t.Text)
+	if m == nil {
+		return 0, 0, false
+	}
+	rowSpan, _ = strconv.Atoi(m[1])
+	colSpan, _ = strconv.Atoi(m[2])
+
+	shift := int32(text.UTF16RuneCountString(m[0]))
+	t.Text = t.Text[len(m[0]):]
+	for _, mark := range t.GetMarks().GetMarks() {
+		if mark.Range == nil {
+			continue
+		}
+		mark.Range.From = max(mark.Range.From-shift, 0)
+		mark.Range.To = max(mark.Range.To-shift, 0)
+	}
+	return rowSpan, colSpan, true
+}
@@ -0,0 +1,35 @@
+package anymark
+
+import (
+	"bytes"
+)
+
+var (
+	byteOrderMark           = []byte("\xef\xbb\xbf")
+	frontMatterDelimiter    = []byte("---")
+	frontMatterEndDelimiter = []byte("...")
+)
+
+// SplitFrontMatter cuts the leading YAML front matter block, delimited by "---" lines, off the markdown source.
+// If the source doesn't start with a front matter block, frontMatter is nil and body is the whole source
+func SplitFrontMatter(source []byte) (frontMatter, body []byte) {
+	rest := bytes.TrimPrefix(source, byteOrderMark)
+	line, rest, found := bytes.Cut(rest, []byte("\n"))
+	if !found || !isFrontMatterDelimiter(line, frontMatterDelimiter) {
+		return nil, source
+	}
+	start := len(source) - len(rest)
+	for len(rest) > 0 {
+		line, next, _ := bytes.Cut(rest, []byte("\n"))
+		if isFrontMatterDelimiter(line, frontMatterDelimiter) || isFrontMatterDelimiter(line, frontMatterEndDelimiter) {
+			end := len(source) - len(rest)
+			return source[start:end], next
+		}
+		rest = next
+	}
+	return nil, source
+}
+
+func isFrontMatterDelimiter(line, delimiter []byte) bool {
+	return bytes.Equal(bytes.TrimRight(line, " \t\r"), delimiter)
+}
@@ -0,0 +1,51 @@
+package anymark
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitFrontMatter(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		source      string
+		frontMatter string
+		body        string
+	}{
+		{
+			name:        "front matter",
+			source:      "---\ntitle: test\ntags: [a, b]\n---\n# Header\n",
+			frontMatter: "title: test\ntags: [a, b]\n",
+			body:        "# Header\n",
+		},
+		{
+			name:        "windows line endings and yaml document end",
+			source:      "---\r\ntitle: test\r\n...\r\ntext",
+			frontMatter: "title: test\r\n",
+			body:        "text",
+		},
+		{
+			name:        "empty front matter",
+			source:      "---\n---\ntext",
+			frontMatter: "",
+			body:        "text",
+		},
+		{
+			name:   "no front matter",
+			source: "text\n---\nmore text",
+			body:   "text\n---\nmore text",
+		},
+		{
+			name:   "not closed front matter",
+			source: "---\ntitle: test\n",
+			body:   "---\ntitle: test\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			frontMatter, body := SplitFrontMatter([]byte(tc.source))
+			assert.Equal(t, tc.frontMatter, string(frontMatter))
+			assert.Equal(t, tc.body, string(body))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -89,3 +90,43 @@ func TestConvertHTMLToBlocks(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertHTMLToBlocksWithMergedCells(t *testing.T) {
+	html := `<table>` +
+		`<tr><th colspan="2">Name</th><th>Country</th></tr>` +
+		`<tr><td rowspan="2"><b>Alfreds</b></td><td>Maria</td><td>Germany</td></tr>` +
+		`<tr><td>Francisco</td><td>Mexico</td></tr>` +
+		`</table>`
+
+	blocks, _, err := HTMLToBlocks([]byte(html), "http://test.com/test")
+	require.NoError(t, err)
+
+	var (
+		columns, rows []*model.Block
+		texts         = map[string]*model.BlockContentText{}
+	)
+	for _, b := range blocks {
+		switch b.Content.(type) {
+		case *model.BlockContentOfTableColumn:
+			columns = append(columns, b)
+		case *model.BlockContentOfTableRow:
+			rows = append(rows, b)
+		case *model.BlockContentOfText:
+			texts[b.Id] = b.GetText()
+		}
+	}
+	require.Len(t, columns, 3)
+	require.Len(t, rows, 3)
+
+	assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: columns[0].Id, RowSpan: 1, ColSpan: 2}}, rows[0].GetTableRow().CellSpans)
+	assert.Equal(t, []*model.BlockContentTableRowCellSpan{{ColumnId: columns[0].Id, RowSpan: 2, ColSpan: 1}}, rows[1].GetTableRow().CellSpans)
+	assert.Empty(t, rows[2].GetTableRow().CellSpans)
+
+	assert.Len(t, rows[0].ChildrenIds, 2)
+	assert.Len(t, rows[2].ChildrenIds, 2)
+
+	anchor := texts[rows[1].ChildrenIds[0]]
+	require.NotNil(t, anchor)
+	assert.Equal(t, "Alfreds", anchor.Text)
+	assert.Equal(t, &model.Range{From: 0, To: 7}, anchor.Marks.Marks[0].Range)
+}
@@ -28,28 +28,58 @@ var (
 	reWikiWbr = regexp.MustCompile(`<wbr[^>]*>`)
 )
 
-func convertBlocks(source []byte, r ...renderer.NodeRenderer) error {
+type options struct {
+	wikiSyntax bool
+	onHashtag  func(tag string)
+}
+
+// Option configures MarkdownToBlocks conversion
+type Option func(o *options)
+
+// WithWikiSyntax enables [[wiki-links]], ![[embeds]] and #hashtags used by Obsidian, Logseq and Foam.
+// Wiki-links are converted to link marks and embeds to file or link blocks, all pointing to file paths.
+// Every found hashtag is passed to onHashtag
+func WithWikiSyntax(onHashtag func(tag string)) Option {
+	return func(o *options) {
+		o.wikiSyntax = true
+		o.onHashtag = onHashtag
+	}
+}
+
+func convertBlocks(source []byte, extensions []goldmark.Extender, r ...renderer.NodeRenderer) error {
 	nodeRenderers := make([]util.PrioritizedValue, 0, len(r))
 	for _, nodeRenderer := range r {
 		nodeRenderers = append(nodeRenderers, util.Prioritized(nodeRenderer, 100))
 	}
 	gm := goldmark.New(goldmark.WithRenderer(
 		renderer.NewRenderer(renderer.WithNodeRenderers(nodeRenderers...)),
-	), goldmark.WithExtensions(extension.Table), goldmark.WithExtensions(extension.Strikethrough))
+	), goldmark.WithExtensions(extension.Table), goldmark.WithExtensions(extension.Strikethrough), goldmark.WithExtensions(extensions...))
 	return gm.Convert(source, &bytes.Buffer{})
 }
 
 func MarkdownToBlocks(markdownSource []byte,
 	baseFilepath string,
-	allFileShortPaths []string) (blocks []*model.Block, rootBlockIDs []string, err error) {
+	allFileShortPaths []string,
+	opts ...Option) (blocks []*model.Block, rootBlockIDs []string, err error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
 	br := newBlocksRenderer(baseFilepath, allFileShortPaths, false)
+	br.onHashtag = o.onHashtag
 
 	r := NewRenderer(br)
 
 	te := table.NewEditor(nil)
 	tr := NewTableRenderer(br, te)
+
+	var extensions []goldmark.Extender
+	if o.wikiSyntax {
+		extensions = append(extensions, &wikiSyntax{})
+	}
+	tr.cellExtensions = extensions
 	// allFileShortPaths,
-	err = convertBlocks(markdownSource, r, tr)
+	err = convertBlocks(markdownSource, extensions, r, tr)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -70,6 +100,7 @@ func HTMLToBlocks(source []byte, url string) (blocks []*model.Block, rootBlockID
 	preprocessedSource = reNotionTable.ReplaceAllStringFunc(preprocessedSource, func(match string) string {
 		return strings.ReplaceAll(match, "\n", "")
 	})
+	preprocessedSource = expandMergedCells(preprocessedSource)
 
 	converter := html2md.NewConverter("", true, &html2md.Options{
 		DisableEscaping:  true,
@@ -93,7 +124,7 @@ func HTMLToBlocks(source []byte, url string) (blocks []*model.Block, rootBlockID
 	blRenderer := newBlocksRenderer("", nil, false)
 	r := NewRenderer(blRenderer)
 	tr := NewTableRenderer(blRenderer, table.NewEditor(nil))
-	err = convertBlocks([]byte(md), r, tr)
+	err = convertBlocks([]byte(md), nil, r, tr)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
 type MdCase struct {
@@ -46,3 +48,74 @@ func TestConvertMdToBlocks(t *testing.T) {
 		})
 	}
 }
+
+func TestMarkdownToBlocksWithWikiSyntax(t *testing.T) {
+	source := "See [[Other Note|the note]] and [[dir/Page#Heading]] #project/alpha #123 C#\n\n" +
+		"![[picture.png]]\n\n" +
+		"![[Embedded note]]\n\n" +
+		"`#code` [[broken\n"
+	var hashtags []string
+
+	blocks, _, err := MarkdownToBlocks([]byte(source), "notes", nil, WithWikiSyntax(func(tag string) {
+		hashtags = append(hashtags, tag)
+	}))
+
+	require.NoError(t, err)
+	require.Len(t, blocks, 4)
+	txt := blocks[0].GetText()
+	require.NotNil(t, txt)
+	require.Equal(t, "See the note and dir/Page#Heading #project/alpha #123 C#", txt.Text)
+	require.Equal(t, []*model.BlockContentTextMark{
+		{Range: &model.Range{From: 4, To: 12}, Type: model.BlockContentTextMark_Link, Param: "notes/Other Note.md"},
+		{Range: &model.Range{From: 17, To: 33}, Type: model.BlockContentTextMark_Link, Param: "notes/dir/Page.md"},
+	}, txt.Marks.Marks)
+	require.Equal(t, []string{"project/alpha"}, hashtags)
+
+	file := blocks[1].GetFile()
+	require.NotNil(t, file)
+	require.Equal(t, "notes/picture.png", file.Name)
+	require.Equal(t, model.BlockContentFile_Image, file.Type)
+
+	link := blocks[2].GetLink()
+	require.NotNil(t, link)
+	require.Equal(t, "notes/Embedded note.md", link.TargetBlockId)
+
+	require.Equal(t, "#code [[broken", blocks[3].GetText().Text)
+}
+
+func TestMarkdownToBlocksWithWikiSyntax_DottedNames(t *testing.T) {
+	source := "See [[v1.2 notes]]\n\n" +
+		"![[v1.2 notes]]\n\n" +
+		"| Column |\n| --- |\n| ![[picture.png]] |\n"
+
+	blocks, _, err := MarkdownToBlocks([]byte(source), "notes", nil, WithWikiSyntax(nil))
+
+	require.NoError(t, err)
+	txt := blocks[0].GetText()
+	require.NotNil(t, txt)
+	require.Equal(t, "notes/v1.2 notes.md", txt.Marks.Marks[0].Param)
+
+	// the importer resolves it to the note, if it exists
+	file := blocks[1].GetFile()
+	require.NotNil(t, file)
+	require.Equal(t, "notes/v1.2 notes", file.Name)
+
+	var cellMarks []*model.BlockContentTextMark
+	for _, b := range blocks {
+		if b.GetText() != nil && b.GetText().Text == "picture.png" {
+			cellMarks = b.GetText().Marks.Marks
+		}
+	}
+	require.Equal(t, []*model.BlockContentTextMark{
+		{Range: &model.Range{From: 0, To: 11}, Type: model.BlockContentTextMark_Link, Param: "notes/picture.png.md"},
+	}, cellMarks)
+}
+
+func TestMarkdownToBlocksWithoutWikiSyntax(t *testing.T) {
+	blocks, _, err := MarkdownToBlocks([]byte("[[Note]] #tag"), "", nil)
+
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	require.Equal(t, "[[Note]] #tag", blocks[0].GetText().Text)
+	require.Empty(t, blocks[0].GetText().Marks.GetMarks())
+}
@@ -62,6 +62,8 @@ func (r *Renderer) RegisterFuncs(reg renderer.NodeRendererFuncRegisterer) {
 	reg.Register(ast.KindText, r.renderText)
 	reg.Register(ast.KindString, r.renderString)
 	reg.Register(ext.KindStrikethrough, r.renderStrikethrough)
+	reg.Register(KindWikiLink, r.renderWikiLink)
+	reg.Register(KindHashtag, r.renderHashtag)
 }
 
 func (r *Renderer) writeLines(source []byte, n ast.Node) {
@@ -433,3 +435,44 @@ func (r *Renderer) renderStrikethrough(_ util.BufWriter, _ []byte, _ ast.Node, e
 	}
 	return ast.WalkContinue, nil
 }
+
+func (r *Renderer) renderWikiLink(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*WikiLink)
+	linkPath := wikiLinkPath(r.GetBaseFilepath(), string(n.Target))
+	// table cells can't contain blocks, so embeds are rendered there as links
+	if n.Embed && !r.inTable {
+		if ext := filepath.Ext(string(n.Target)); ext == "" || strings.EqualFold(ext, ".md") {
+			r.AddLinkBlock(linkPath)
+		} else {
+			// the importer turns it into the link block, if the note with such name exists
+			r.AddFileBlock(filepath.Join(r.GetBaseFilepath(), string(n.Target)))
+		}
+		return ast.WalkSkipChildren, nil
+	}
+
+	r.SetMarkStart()
+	start := int32(text.UTF16RuneCountString(r.GetText()))
+	label := string(n.Label)
+	r.AddMark(model.BlockContentTextMark{
+		Range: &model.Range{From: start, To: start + int32(text.UTF16RuneCountString(label))},
+		Type:  model.BlockContentTextMark_Link,
+		Param: linkPath,
+	})
+	r.AddTextToBuffer(label)
+	return ast.WalkSkipChildren, nil
+}
+
+func (r *Renderer) renderHashtag(_ util.BufWriter, _ []byte, node ast.Node, entering bool) (ast.WalkStatus, error) {
+	if !entering {
+		return ast.WalkContinue, nil
+	}
+	n := node.(*Hashtag)
+	r.AddTextToBuffer("#" + string(n.Tag))
+	if r.onHashtag != nil {
+		r.onHashtag(string(n.Tag))
+	}
+	return ast.WalkSkipChildren, nil
+}
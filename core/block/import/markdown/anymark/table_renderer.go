@@ -2,6 +2,7 @@ package anymark
 
 import (
 	"bytes"
+	"slices"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
@@ -23,6 +24,13 @@ type tableState struct {
 	currTableRow        string
 	columnsIDs          []string
 	currColumnIDIndex   int
+	cellSpans           []pendingCellSpan
+}
+
+// pendingCellSpan is the merged cell found in the html source, cells are merged when the whole table is rendered
+type pendingCellSpan struct {
+	rowID, colID     string
+	rowSpan, colSpan int
 }
 
 func (s *tableState) setRenderFunction(kind ast.NodeKind, rendererFunc renderer.NodeRendererFunc) {
@@ -34,6 +42,7 @@ func (s *tableState) resetState() {
 	s.currTableRow = ""
 	s.columnsIDs = nil
 	s.currColumnIDIndex = 0
+	s.cellSpans = nil
 }
 
 type TableRenderer struct {
@@ -41,6 +50,8 @@ type TableRenderer struct {
 	tableState    tableState
 	tableEditor   te.TableEditor
 	blocksState   *state.State
+	// cellExtensions are used to parse markdown of cells, like the wiki syntax
+	cellExtensions []goldmark.Extender
 }
 
 func NewTableRenderer(br *blocksRenderer, tableEditor te.TableEditor) *TableRenderer {
@@ -84,6 +95,9 @@ func (r *TableRenderer) renderTable(_ util.BufWriter, _ []byte, node ast.Node, e
 		}
 		return ast.WalkContinue, nil
 	}
+	if err := r.mergeCells(); err != nil {
+		return ast.WalkContinue, err
+	}
 	blocksToAdd := make([]*model.Block, 0, len(r.blocksState.Blocks()))
 	for _, block := range r.blocksState.Blocks() {
 		if block.GetContent() != nil {
@@ -158,10 +172,16 @@ func (r *TableRenderer) renderTableCell(_ util.BufWriter,
 	}
 	if node != nil {
 		// recursive handler of markdown inside table cell
-		ren := NewRenderer(newBlocksRenderer("", nil, true))
+		cellRenderer := newBlocksRenderer("", nil, true)
+		if len(r.cellExtensions) > 0 {
+			// wiki-links of cells point to the files relative to the document
+			cellRenderer.baseFilepath = r.blockRenderer.GetBaseFilepath()
+			cellRenderer.onHashtag = r.blockRenderer.onHashtag
+		}
+		ren := NewRenderer(cellRenderer)
 		gm := goldmark.New(goldmark.WithRenderer(
 			renderer.NewRenderer(renderer.WithNodeRenderers(util.Prioritized(ren, 100))),
-		))
+		), goldmark.WithExtensions(r.cellExtensions...))
 		n := node.Lines()
 
 		status, err := r.createCell(n, gm, source, ren)
@@ -192,6 +212,14 @@ func (r *TableRenderer) createCell(n *text.Segments,
 			if _, ok := block.Content.(*model.BlockContentOfText); !ok {
 				block.Content = &model.BlockContentOfText{Text: &model.BlockContentText{}}
 			}
+			if rowSpan, colSpan, ok := cutCellSpan(block); ok {
+				r.tableState.cellSpans = append(r.tableState.cellSpans, pendingCellSpan{
+					rowID:   r.tableState.currTableRow,
+					colID:   colID,
+					rowSpan: rowSpan,
+					colSpan: colSpan,
+				})
+			}
 			_, err = r.tableEditor.CellCreate(r.blocksState, r.tableState.currTableRow, colID, block)
 			if err != nil {
 				return ast.WalkContinue, err
@@ -201,6 +229,38 @@ func (r *TableRenderer) createCell(n *text.Segments,
 	return 0, nil
 }
 
+func (r *TableRenderer) mergeCells() error {
+	if len(r.tableState.cellSpans) == 0 {
+		return nil
+	}
+	tb, err := te.NewTable(r.blocksState, r.tableState.tableID)
+	if err != nil {
+		return err
+	}
+	var (
+		rowIDs = tb.RowIDs()
+		colIDs = tb.ColumnIDs()
+	)
+	for _, span := range r.tableState.cellSpans {
+		top, left := slices.Index(rowIDs, span.rowID), slices.Index(colIDs, span.colID)
+		if top == -1 || left == -1 {
+			continue
+		}
+		bottom := min(top+span.rowSpan, len(rowIDs)) - 1
+		right := min(left+span.colSpan, len(colIDs)) - 1
+		if bottom == top && right == left {
+			continue
+		}
+		err = r.tableEditor.CellListMerge(r.blocksState, pb.RpcBlockTableCellListMergeRequest{
+			BlockIds: []string{te.MakeCellID(span.rowID, span.colID), te.MakeCellID(rowIDs[bottom], colIDs[right])},
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (r *TableRenderer) getColumnID() (string, error) {
 	var (
 		colID string
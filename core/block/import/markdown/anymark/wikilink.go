@@ -0,0 +1,156 @@
+package anymark
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/text"
+	"github.com/yuin/goldmark/util"
+)
+
+// KindWikiLink is a NodeKind of the WikiLink node
+var KindWikiLink = ast.NewNodeKind("WikiLink")
+
+// WikiLink represents [[Target]], [[Target|Label]] and ![[Target]] embeds used by Obsidian, Logseq and Foam
+type WikiLink struct {
+	ast.BaseInline
+	Target []byte
+	Label  []byte
+	Embed  bool
+}
+
+func (n *WikiLink) Kind() ast.NodeKind {
+	return KindWikiLink
+}
+
+func (n *WikiLink) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{
+		"Target": string(n.Target),
+		"Label":  string(n.Label),
+	}, nil)
+}
+
+// KindHashtag is a NodeKind of the Hashtag node
+var KindHashtag = ast.NewNodeKind("Hashtag")
+
+// Hashtag represents #tag, including nested tags like #project/alpha
+type Hashtag struct {
+	ast.BaseInline
+	Tag []byte
+}
+
+func (n *Hashtag) Kind() ast.NodeKind {
+	return KindHashtag
+}
+
+func (n *Hashtag) Dump(source []byte, level int) {
+	ast.DumpHelper(n, source, level, map[string]string{"Tag": string(n.Tag)}, nil)
+}
+
+type wikiLinkParser struct{}
+
+func (p *wikiLinkParser) Trigger() []byte {
+	return []byte{'!', '['}
+}
+
+func (p *wikiLinkParser) Parse(_ ast.Node, block text.Reader, _ parser.Context) ast.Node {
+	line, _ := block.PeekLine()
+	var embed bool
+	if bytes.HasPrefix(line, []byte("!")) {
+		embed = true
+		line = line[1:]
+	}
+	if !bytes.HasPrefix(line, []byte("[[")) {
+		return nil
+	}
+	end := bytes.Index(line, []byte("]]"))
+	if end < 0 {
+		return nil
+	}
+	content := line[2:end]
+	if len(bytes.TrimSpace(content)) == 0 || bytes.ContainsAny(content, "[\n") {
+		return nil
+	}
+	target, label, hasLabel := bytes.Cut(content, []byte("|"))
+	target, _, _ = bytes.Cut(target, []byte("#"))
+	target = bytes.TrimSpace(target)
+	if len(target) == 0 {
+		return nil
+	}
+	if !hasLabel {
+		label = content
+	}
+	consumed := end + 2
+	if embed {
+		consumed++
+	}
+	block.Advance(consumed)
+	return &WikiLink{
+		Target: bytes.Clone(target),
+		Label:  bytes.Clone(bytes.TrimSpace(label)),
+		Embed:  embed,
+	}
+}
+
+type hashtagParser struct{}
+
+func (p *hashtagParser) Trigger() []byte {
+	return []byte{'#'}
+}
+
+func (p *hashtagParser) Parse(_ ast.Node, block text.Reader, _ parser.Context) ast.Node {
+	if preceding := block.PrecendingCharacter(); !unicode.IsSpace(preceding) && preceding != '(' {
+		return nil
+	}
+	line, _ := block.PeekLine()
+	tag := line[1:]
+	var (
+		length   int
+		hasAlpha bool
+	)
+	for length < len(tag) {
+		r, size := utf8.DecodeRune(tag[length:])
+		if !isHashtagRune(r) {
+			break
+		}
+		if !unicode.IsDigit(r) {
+			hasAlpha = true
+		}
+		length += size
+	}
+	tag = bytes.TrimRight(tag[:length], "/")
+	if len(tag) == 0 || !hasAlpha {
+		return nil
+	}
+	block.Advance(len(tag) + 1)
+	return &Hashtag{Tag: bytes.Clone(tag)}
+}
+
+func isHashtagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-' || r == '/'
+}
+
+type wikiSyntax struct{}
+
+func (e *wikiSyntax) Extend(m goldmark.Markdown) {
+	// wiki-links must be parsed before the regular links and images
+	m.Parser().AddOptions(parser.WithInlineParsers(
+		util.Prioritized(&wikiLinkParser{}, 199),
+		util.Prioritized(&hashtagParser{}, 999),
+	))
+}
+
+// wikiLinkPath converts the wiki-link target to the path of the note. Note names can contain dots, like "v1.2 notes",
+// so the extension is added to every target. The importer falls back to the literal path for links to other files
+func wikiLinkPath(baseFilepath, target string) string {
+	if !strings.EqualFold(filepath.Ext(target), ".md") {
+		target += ".md"
+	}
+	return filepath.Join(baseFilepath, target)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/import/common"
 	"github.com/anyproto/anytype-heart/core/block/import/common/source"
 	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/uri"
@@ -28,6 +29,10 @@ type FileInfo struct {
 	Title                 string
 	ParsedBlocks          []*model.Block
 	CollectionsObjectsIds []string
+	FrontMatter           []frontMatterProperty
+	ObjectTypeKey         domain.TypeKey
+	RelationLinks         []*model.RelationLink
+	Hashtags              []string
 }
 
 func newMDConverter(tempDirProvider core.TempDirProvider) *mdConverter {
@@ -55,6 +60,7 @@ func (m *mdConverter) processFiles(importPath string, allErrors *common.ConvertE
 		return nil
 	}
 	fileInfo := m.getFileInfo(importSource, allErrors)
+	m.resolveShortLinks(fileInfo, importSource)
 	for name, file := range fileInfo {
 		m.processBlocks(name, file, fileInfo, importSource)
 		for _, b := range file.ParsedBlocks {
@@ -197,6 +203,80 @@ func (m *mdConverter) processCSVFileLink(block *model.Block, files map[string]*F
 	files[link].HasInboundLinks = true
 }
 
+// resolveShortLinks points links and embeds, which can't be found by the relative path, to the file with the same name
+// anywhere in the import, because Obsidian and Foam resolve wiki-links by the note name
+func (m *mdConverter) resolveShortLinks(files map[string]*FileInfo, importSource source.Source) {
+	pathsByName := make(map[string][]string, len(files))
+	for path := range files {
+		name := strings.ToLower(filepath.Base(path))
+		pathsByName[name] = append(pathsByName[name], path)
+	}
+	resolvePath := func(link string) (string, bool) {
+		if link == "" || strings.Contains(link, "://") {
+			return link, true
+		}
+		if _, ok := files[m.getOriginalName(link, importSource)]; ok {
+			return link, true
+		}
+		name := strings.ToLower(filepath.Base(link))
+		candidates := pathsByName[name]
+		if len(candidates) == 0 && !strings.EqualFold(filepath.Ext(name), ".md") {
+			candidates = pathsByName[name+".md"]
+		}
+		if len(candidates) == 0 {
+			return link, false
+		}
+		// prefer the file closest to the import root, like Obsidian does
+		resolved := candidates[0]
+		for _, candidate := range candidates[1:] {
+			if len(candidate) < len(resolved) || (len(candidate) == len(resolved) && candidate < resolved) {
+				resolved = candidate
+			}
+		}
+		return resolved, true
+	}
+	resolve := func(link string) string {
+		resolved, ok := resolvePath(link)
+		if ok {
+			return resolved
+		}
+		// wiki-links get the note extension, but they can point to other files as well
+		if literal, isNote := strings.CutSuffix(link, ".md"); isNote && filepath.Ext(literal) != "" {
+			if resolved, ok = resolvePath(literal); ok {
+				return resolved
+			}
+		}
+		return link
+	}
+	for _, file := range files {
+		for _, b := range file.ParsedBlocks {
+			switch {
+			case b.GetText() != nil && b.GetText().Marks != nil:
+				for _, mark := range b.GetText().Marks.Marks {
+					if mark.Type == model.BlockContentTextMark_Link {
+						mark.Param = resolve(mark.Param)
+					}
+				}
+			case b.GetLink() != nil:
+				b.GetLink().TargetBlockId = resolve(b.GetLink().TargetBlockId)
+			case b.GetFile() != nil:
+				name := b.GetFile().Name
+				// embeds of notes with dots in the name, like ![[v1.2 notes]], are parsed as files
+				if name != "" && !strings.Contains(name, "://") {
+					if notePath, ok := resolvePath(name + ".md"); ok {
+						b.Content = &model.BlockContentOfLink{Link: &model.BlockContentLink{
+							TargetBlockId: notePath,
+							Style:         model.BlockContentLink_Page,
+						}}
+						continue
+					}
+				}
+				b.GetFile().Name = resolve(name)
+			}
+		}
+	}
+}
+
 func (m *mdConverter) processFileBlock(block *model.Block, importedSource source.Source, importPath string) {
 	if f := block.GetFile(); f != nil {
 		if block.Id == "" {
@@ -272,7 +352,11 @@ func (m *mdConverter) createBlocksFromFile(importSource source.Source, filePath
 		if err != nil {
 			return err
 		}
-		files[filePath].ParsedBlocks, _, err = anymark.MarkdownToBlocks(b, filepath.Dir(filePath), nil)
+		file := files[filePath]
+		b = m.extractFrontMatter(filePath, b, file)
+		file.ParsedBlocks, _, err = anymark.MarkdownToBlocks(b, filepath.Dir(filePath), nil, anymark.WithWikiSyntax(func(tag string) {
+			file.Hashtags = append(file.Hashtags, tag)
+		}))
 		if err != nil {
 			log.Errorf("failed to read blocks: %s", err)
 		}
@@ -280,6 +364,21 @@ func (m *mdConverter) createBlocksFromFile(importSource source.Source, filePath
 	return nil
 }
 
+func (m *mdConverter) extractFrontMatter(filePath string, source []byte, file *FileInfo) []byte {
+	frontMatter, body := anymark.SplitFrontMatter(source)
+	if frontMatter == nil {
+		return source
+	}
+	properties, err := parseFrontMatter(frontMatter)
+	if err != nil {
+		// leading block is not a valid front matter, so treat it as a part of the document
+		log.With("path", filePath).Warnf("failed to parse front matter: %s", err)
+		return source
+	}
+	file.FrontMatter = properties
+	return body
+}
+
 func (m *mdConverter) getOriginalName(link string, importSource source.Source) string {
 	if originalFileNameGetter, ok := importSource.(source.OriginalFileNameGetter); ok {
 		return originalFileNameGetter.GetFileOriginalName(link)
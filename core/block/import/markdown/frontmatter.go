@@ -0,0 +1,569 @@
+package markdown
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/samber/lo"
+	"gopkg.in/yaml.v3"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	frontMatterTitleKey = "title"
+	frontMatterNameKey  = "name"
+	frontMatterTypeKey  = "type"
+	frontMatterTagsKey  = "tags"
+)
+
+var (
+	errFrontMatterNotMapping = errors.New("front matter is not a mapping")
+
+	frontMatterDateLayouts = []string{
+		time.DateOnly,
+		time.RFC3339,
+		"2006-01-02T15:04:05",
+		time.DateTime,
+		"2006-01-02 15:04",
+	}
+
+	markdownLinkRegexp = regexp.MustCompile(`^\[(?:[^\]\\]|\\.)*\]\((.+)\)$`)
+	wikiLinkRegexp     = regexp.MustCompile(`^\[\[([^\]|#]+)(?:[|#][^\]]*)?\]\]$`)
+
+	frontMatterBundledRelations = makeFrontMatterBundledRelations()
+	frontMatterBundledTypes     = makeFrontMatterBundledTypes()
+)
+
+// frontMatterProperty is a single key of the YAML front matter
+type frontMatterProperty struct {
+	key   string
+	value interface{}
+}
+
+func parseFrontMatter(data []byte) ([]frontMatterProperty, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, err
+	}
+	if root.Kind == 0 {
+		// empty front matter
+		return nil, nil
+	}
+	if len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return nil, errFrontMatterNotMapping
+	}
+	mapping := root.Content[0]
+	properties := make([]frontMatterProperty, 0, len(mapping.Content)/2)
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		key := strings.TrimSpace(mapping.Content[i].Value)
+		var value interface{}
+		if err := mapping.Content[i+1].Decode(&value); err != nil {
+			return nil, err
+		}
+		if key == "" || value == nil {
+			continue
+		}
+		properties = append(properties, frontMatterProperty{key: key, value: value})
+	}
+	return properties, nil
+}
+
+func frontMatterTitle(properties []frontMatterProperty) string {
+	for _, property := range properties {
+		switch strings.ToLower(property.key) {
+		case frontMatterTitleKey, frontMatterNameKey:
+			if title, ok := scalarString(property.value); ok {
+				return strings.TrimSpace(title)
+			}
+		}
+	}
+	return ""
+}
+
+// frontMatterConverter turns front matter and hashtags of imported files into object details.
+// Relations, relation options and object types are created once per import and shared between files
+type frontMatterConverter struct {
+	relations map[string][]*model.Relation // created relations by lowercase name
+	options   map[string]map[string]string // relation key -> option name -> option id
+	types     map[string]domain.TypeKey    // created object types by lowercase name
+	snapshots []*common.Snapshot
+}
+
+func newFrontMatterConverter() *frontMatterConverter {
+	return &frontMatterConverter{
+		relations: make(map[string][]*model.Relation),
+		options:   make(map[string]map[string]string),
+		types:     make(map[string]domain.TypeKey),
+	}
+}
+
+func (c *frontMatterConverter) setDetails(files map[string]*FileInfo, progress process.Progress, details map[string]*domain.Details, allErrors *common.ConvertError) {
+	progress.SetProgressMessage("Start converting front matter")
+	for name, file := range files {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return
+		}
+
+		if file.PageID == "" {
+			continue
+		}
+
+		c.convert(name, file, files, details[name])
+		c.addHashtags(file, details[name])
+	}
+}
+
+func (c *frontMatterConverter) convert(path string, file *FileInfo, files map[string]*FileInfo, details *domain.Details) {
+	for _, property := range file.FrontMatter {
+		switch strings.ToLower(property.key) {
+		case frontMatterTitleKey, frontMatterNameKey:
+			// already used as object name
+			continue
+		case frontMatterTypeKey:
+			if typeName, ok := scalarString(property.value); ok && strings.TrimSpace(typeName) != "" {
+				c.setObjectType(file, details, strings.TrimSpace(typeName))
+				continue
+			}
+		}
+		rel, value := c.provideRelation(path, property, files)
+		if rel == nil {
+			log.With("key", property.key).Warnf("failed to convert front matter property")
+			continue
+		}
+		if rel.Format == model.RelationFormat_tag || rel.Format == model.RelationFormat_status {
+			value = domain.StringList(c.provideOptions(rel.Key, value.StringList()))
+		}
+		details.Set(domain.RelationKey(rel.Key), value)
+		file.RelationLinks = append(file.RelationLinks, &model.RelationLink{Key: rel.Key, Format: rel.Format})
+	}
+}
+
+// addHashtags adds #tags found in the text to the tag relation
+func (c *frontMatterConverter) addHashtags(file *FileInfo, details *domain.Details) {
+	names := optionNames(lo.ToAnySlice(file.Hashtags))
+	if len(names) == 0 {
+		return
+	}
+	tagIds := details.GetStringList(bundle.RelationKeyTag)
+	for _, id := range c.provideOptions(bundle.RelationKeyTag.String(), names) {
+		if !slices.Contains(tagIds, id) {
+			tagIds = append(tagIds, id)
+		}
+	}
+	details.SetStringList(bundle.RelationKeyTag, tagIds)
+	if !pbtypes.RelationLinks(file.RelationLinks).Has(bundle.RelationKeyTag.String()) {
+		file.RelationLinks = append(file.RelationLinks, bundle.MustGetRelationLink(bundle.RelationKeyTag))
+	}
+}
+
+func (c *frontMatterConverter) setObjectType(file *FileInfo, details *domain.Details, name string) {
+	lowerName := strings.ToLower(name)
+	if objectType, ok := frontMatterBundledTypes[lowerName]; ok {
+		file.ObjectTypeKey = domain.TypeKey(objectType.Key)
+		details.SetInt64(bundle.RelationKeyLayout, int64(objectType.Layout))
+		return
+	}
+	if typeKey, ok := c.types[lowerName]; ok {
+		file.ObjectTypeKey = typeKey
+		return
+	}
+	typeKey := domain.TypeKey(bson.NewObjectId().Hex())
+	typeDetails := domain.NewDetails()
+	typeDetails.SetString(bundle.RelationKeyName, name)
+	typeDetails.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_objectType))
+	typeDetails.SetInt64(bundle.RelationKeyRecommendedLayout, int64(model.ObjectType_basic))
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeObjectType, typeKey.String())
+	if err != nil {
+		log.Warnf("failed to create unique key for front matter type: %v", err)
+		return
+	}
+	typeDetails.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	c.snapshots = append(c.snapshots, &common.Snapshot{
+		Id: uniqueKey.Marshal(),
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeObjectType,
+			Data: &common.StateSnapshot{
+				Details:     typeDetails,
+				ObjectTypes: []string{bundle.TypeKeyObjectType.String()},
+				Key:         typeKey.String(),
+			},
+		},
+	})
+	c.types[lowerName] = typeKey
+	file.ObjectTypeKey = typeKey
+}
+
+// provideRelation finds a bundled or already created relation with the same name which can hold the value,
+// otherwise it creates a new relation with the format inferred from the value
+func (c *frontMatterConverter) provideRelation(path string, property frontMatterProperty, files map[string]*FileInfo) (*model.Relation, domain.Value) {
+	lowerName := strings.ToLower(property.key)
+	if lowerName == frontMatterTagsKey {
+		lowerName = strings.ToLower(bundle.MustGetRelation(bundle.RelationKeyTag).Name)
+	}
+	if rel, ok := frontMatterBundledRelations[lowerName]; ok {
+		if value, ok := convertFrontMatterValue(path, rel.Format, property.value, files); ok {
+			return rel, value
+		}
+	}
+	for _, rel := range c.relations[lowerName] {
+		if value, ok := convertFrontMatterValue(path, rel.Format, property.value, files); ok {
+			return rel, value
+		}
+	}
+	format := inferFrontMatterFormat(path, property.value, files)
+	value, ok := convertFrontMatterValue(path, format, property.value, files)
+	if !ok {
+		return nil, domain.Invalid()
+	}
+	key := bson.NewObjectId().Hex()
+	rel := &model.Relation{Key: key, Name: property.key, Format: format}
+	details := getRelationDetails(property.key, key, float64(format))
+	c.snapshots = append(c.snapshots, &common.Snapshot{
+		Id: details.GetString(bundle.RelationKeyId),
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelation,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelation.String()},
+				Key:         key,
+			},
+		},
+	})
+	c.relations[lowerName] = append(c.relations[lowerName], rel)
+	return rel, value
+}
+
+func (c *frontMatterConverter) provideOptions(relationKey string, names []string) []string {
+	options, ok := c.options[relationKey]
+	if !ok {
+		options = make(map[string]string)
+		c.options[relationKey] = options
+	}
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if id, ok := options[name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		key, details := getRelationOptionDetails(name, relationKey)
+		id := details.GetString(bundle.RelationKeyId)
+		c.snapshots = append(c.snapshots, &common.Snapshot{
+			Id: id,
+			Snapshot: &common.SnapshotModel{
+				SbType: smartblock.SmartBlockTypeRelationOption,
+				Data: &common.StateSnapshot{
+					Details:     details,
+					ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+					Key:         key,
+				},
+			},
+		})
+		options[name] = id
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func inferFrontMatterFormat(path string, value interface{}, files map[string]*FileInfo) model.RelationFormat {
+	switch v := value.(type) {
+	case bool:
+		return model.RelationFormat_checkbox
+	case int, int64, uint64, float64:
+		return model.RelationFormat_number
+	case time.Time:
+		return model.RelationFormat_date
+	case string:
+		if _, ok := parseFrontMatterDate(v); ok {
+			return model.RelationFormat_date
+		}
+		if _, ok := resolveFrontMatterLink(path, v, files); ok {
+			return model.RelationFormat_object
+		}
+		if isFrontMatterURL(v) {
+			return model.RelationFormat_url
+		}
+		if isFrontMatterEmail(v) {
+			return model.RelationFormat_email
+		}
+	case []interface{}:
+		if len(v) > 0 && len(resolveFrontMatterLinks(path, v, files)) == len(v) {
+			return model.RelationFormat_object
+		}
+		return model.RelationFormat_tag
+	}
+	return model.RelationFormat_longtext
+}
+
+func convertFrontMatterValue(path string, format model.RelationFormat, value interface{}, files map[string]*FileInfo) (domain.Value, bool) {
+	switch format {
+	case model.RelationFormat_checkbox:
+		switch v := value.(type) {
+		case bool:
+			return domain.Bool(v), true
+		case string:
+			if b, err := strconv.ParseBool(v); err == nil {
+				return domain.Bool(b), true
+			}
+		}
+	case model.RelationFormat_number:
+		switch v := value.(type) {
+		case int:
+			return domain.Float64(float64(v)), true
+		case int64:
+			return domain.Float64(float64(v)), true
+		case uint64:
+			return domain.Float64(float64(v)), true
+		case float64:
+			return domain.Float64(v), true
+		case string:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+				return domain.Float64(f), true
+			}
+		}
+	case model.RelationFormat_date:
+		switch v := value.(type) {
+		case time.Time:
+			return domain.Int64(v.Unix()), true
+		case string:
+			if ts, ok := parseFrontMatterDate(v); ok {
+				return domain.Int64(ts), true
+			}
+		}
+	case model.RelationFormat_tag, model.RelationFormat_status:
+		names := optionNames(value)
+		if len(names) == 0 {
+			return domain.Invalid(), false
+		}
+		if format == model.RelationFormat_status {
+			names = names[:1]
+		}
+		return domain.StringList(names), true
+	case model.RelationFormat_object:
+		ids := resolveFrontMatterLinks(path, value, files)
+		if len(ids) > 0 {
+			return domain.StringList(ids), true
+		}
+	case model.RelationFormat_longtext, model.RelationFormat_shorttext, model.RelationFormat_url,
+		model.RelationFormat_email, model.RelationFormat_phone:
+		if s, ok := scalarString(value); ok {
+			return domain.String(s), true
+		}
+		if list := scalarList(value); len(list) > 0 {
+			return domain.String(strings.Join(list, ", ")), true
+		}
+	}
+	return domain.Invalid(), false
+}
+
+// resolveFrontMatterLinks returns ids of imported pages the value links to, skipping unresolved links
+func resolveFrontMatterLinks(path string, value interface{}, files map[string]*FileInfo) []string {
+	var ids []string
+	for _, link := range scalarList(value) {
+		if id, ok := resolveFrontMatterLink(path, link, files); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// resolveFrontMatterLink supports markdown links, wiki-links and bare paths to markdown files
+func resolveFrontMatterLink(path, link string, files map[string]*FileInfo) (string, bool) {
+	link = strings.TrimSpace(link)
+	if match := wikiLinkRegexp.FindStringSubmatch(link); match != nil {
+		return resolveWikiLink(strings.TrimSpace(match[1]), files)
+	}
+	if match := markdownLinkRegexp.FindStringSubmatch(link); match != nil {
+		link = match[1]
+	}
+	if unescaped, err := url.PathUnescape(link); err == nil {
+		link = unescaped
+	}
+	if !strings.EqualFold(filepath.Ext(link), ".md") {
+		return "", false
+	}
+	for _, candidate := range []string{filepath.Join(filepath.Dir(path), link), filepath.Clean(link)} {
+		if file, ok := files[candidate]; ok && file.PageID != "" {
+			file.HasInboundLinks = true
+			return file.PageID, true
+		}
+	}
+	return "", false
+}
+
+func resolveWikiLink(name string, files map[string]*FileInfo) (string, bool) {
+	if !strings.EqualFold(filepath.Ext(name), ".md") {
+		name += ".md"
+	}
+	for filePath, file := range files {
+		if file.PageID == "" {
+			continue
+		}
+		if strings.EqualFold(filepath.Base(filePath), name) || strings.HasSuffix(strings.ToLower(filePath), strings.ToLower(string(filepath.Separator)+name)) {
+			file.HasInboundLinks = true
+			return file.PageID, true
+		}
+	}
+	return "", false
+}
+
+func parseFrontMatterDate(value string) (int64, bool) {
+	value = strings.TrimSpace(value)
+	for _, layout := range frontMatterDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t.Unix(), true
+		}
+	}
+	return 0, false
+}
+
+func isFrontMatterURL(value string) bool {
+	u, err := url.Parse(strings.TrimSpace(value))
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func isFrontMatterEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+	return err == nil && address.Address == value
+}
+
+func scalarString(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case string:
+		return v, true
+	case bool, int, int64, uint64, float64:
+		return fmt.Sprint(v), true
+	case time.Time:
+		return v.Format(time.RFC3339), true
+	}
+	return "", false
+}
+
+func optionNames(value interface{}) []string {
+	var (
+		names = make([]string, 0)
+		seen  = make(map[string]struct{})
+	)
+	for _, name := range scalarList(value) {
+		name = strings.TrimPrefix(strings.TrimSpace(name), "#")
+		if _, exists := seen[name]; exists || name == "" {
+			continue
+		}
+		seen[name] = struct{}{}
+		names = append(names, name)
+	}
+	return names
+}
+
+// scalarList flattens the value into a list of strings.
+// Unquoted wiki-links, i.e. [[Note]], are parsed by YAML as nested lists, so we restore them here
+func scalarList(value interface{}) []string {
+	if s, ok := scalarString(value); ok {
+		return []string{s}
+	}
+	list, ok := value.([]interface{})
+	if !ok {
+		return nil
+	}
+	result := make([]string, 0, len(list))
+	for _, item := range list {
+		if s, ok := scalarString(item); ok {
+			result = append(result, s)
+			continue
+		}
+		if nested, ok := item.([]interface{}); ok && len(nested) == 1 {
+			if s, ok := nested[0].(string); ok {
+				result = append(result, "[["+s+"]]")
+			}
+		}
+	}
+	return result
+}
+
+func getRelationDetails(name, key string, format float64) *domain.Details {
+	details := domain.NewDetails()
+	details.SetFloat64(bundle.RelationKeyRelationFormat, format)
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, key)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relation))
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelation, key)
+	if err != nil {
+		log.Warnf("failed to create unique key for front matter relation: %v", err)
+		return details
+	}
+	details.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	return details
+}
+
+func getRelationOptionDetails(name, relationKey string) (string, *domain.Details) {
+	id := bson.NewObjectId().Hex()
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, relationKey)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+	details.SetInt64(bundle.RelationKeyCreatedDate, time.Now().Unix())
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, id)
+	if err != nil {
+		log.Warnf("failed to create unique key for front matter relation option: %v", err)
+		return id, details
+	}
+	details.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	return id, details
+}
+
+// makeFrontMatterBundledRelations indexes editable bundled relations by lowercase name.
+// Relations sharing the same name are left out, as we can't choose between them
+func makeFrontMatterBundledRelations() map[string]*model.Relation {
+	relations := make(map[string]*model.Relation)
+	ambiguous := make(map[string]struct{})
+	for _, id := range bundle.ListRelationsUrls() {
+		key, err := bundle.RelationKeyFromID(id)
+		if err != nil {
+			continue
+		}
+		rel, err := bundle.GetRelation(key)
+		if err != nil || rel.Hidden || rel.ReadOnly || rel.Name == "" {
+			continue
+		}
+		name := strings.ToLower(rel.Name)
+		if _, exists := relations[name]; exists {
+			ambiguous[name] = struct{}{}
+		}
+		relations[name] = rel
+	}
+	for name := range ambiguous {
+		delete(relations, name)
+	}
+	return relations
+}
+
+func makeFrontMatterBundledTypes() map[string]*model.ObjectType {
+	types := make(map[string]*model.ObjectType)
+	for _, key := range bundle.ListTypesKeys() {
+		if bundle.IsInternalType(key) {
+			continue
+		}
+		objectType, err := bundle.GetType(key)
+		if err != nil {
+			continue
+		}
+		types[strings.ToLower(objectType.Name)] = objectType
+	}
+	return types
+}
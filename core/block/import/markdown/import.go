@@ -28,7 +28,7 @@ var (
 	log              = logging.Logger("markdown-import")
 )
 
-const numberOfStages = 9 // 8 cycles to get snapshots and 1 cycle to create objects
+const numberOfStages = 10 // 9 cycles to get snapshots and 1 cycle to create objects
 
 type Markdown struct {
 	blockConverter *mdConverter
@@ -135,9 +135,11 @@ func (m *Markdown) getSnapshotsAndRootObjectsIds(
 
 	progress.SetTotal(int64(numberOfStages * len(files)))
 	details := make(map[string]*domain.Details, 0)
+	frontMatter := newFrontMatterConverter()
 
 	if m.processImportStep(pathsCount, files, progress, allErrors, details, m.setInboundLinks) ||
 		m.processImportStep(pathsCount, files, progress, allErrors, details, m.setNewID) ||
+		m.processImportStep(pathsCount, files, progress, allErrors, details, frontMatter.setDetails) ||
 		m.processImportStep(pathsCount, files, progress, allErrors, details, m.addLinkToObjectBlocks) ||
 		m.processImportStep(pathsCount, files, progress, allErrors, details, m.linkPagesWithRootFile) ||
 		m.processImportStep(pathsCount, files, progress, allErrors, details, m.addLinkBlocks) ||
@@ -146,7 +148,11 @@ func (m *Markdown) getSnapshotsAndRootObjectsIds(
 		return nil, nil
 	}
 
-	return m.createSnapshots(pathsCount, files, progress, details, allErrors), m.retrieveRootObjectsIds(files)
+	snapshots := m.createSnapshots(pathsCount, files, progress, details, allErrors)
+	if len(snapshots) > 0 {
+		snapshots = append(snapshots, frontMatter.snapshots...)
+	}
+	return snapshots, m.retrieveRootObjectsIds(files)
 }
 
 func (m *Markdown) processImportStep(pathCount int,
@@ -406,15 +412,20 @@ func (m *Markdown) createSnapshots(
 			}
 			continue
 		}
+		objectTypeKey := bundle.TypeKeyPage
+		if file.ObjectTypeKey != "" {
+			objectTypeKey = file.ObjectTypeKey
+		}
 		snapshots = append(snapshots, &common.Snapshot{
 			Id:       file.PageID,
 			FileName: name,
 			Snapshot: &common.SnapshotModel{
 				SbType: smartblock.SmartBlockTypePage,
 				Data: &common.StateSnapshot{
-					Blocks:      file.ParsedBlocks,
-					Details:     details[name],
-					ObjectTypes: []string{bundle.TypeKeyPage.String()},
+					Blocks:        file.ParsedBlocks,
+					Details:       details[name],
+					ObjectTypes:   []string{objectTypeKey.String()},
+					RelationLinks: file.RelationLinks,
 				}},
 		})
 	}
@@ -568,7 +579,7 @@ func (m *Markdown) setNewID(files map[string]*FileInfo, progress process.Progres
 
 func (m *Markdown) setDetails(file *FileInfo, fileName string, details map[string]*domain.Details) {
 	var title, emoji string
-	if len(file.ParsedBlocks) > 0 {
+	if title = frontMatterTitle(file.FrontMatter); title == "" && len(file.ParsedBlocks) > 0 {
 		title, emoji = m.extractTitleAndEmojiFromBlock(file)
 	}
 	details[fileName] = common.GetCommonDetails(fileName, title, emoji, model.ObjectType_basic)
@@ -6,16 +6,22 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/anyproto/anytype-heart/core/block/import/common"
 	"github.com/anyproto/anytype-heart/core/block/import/common/test"
 	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/tests/blockbuilder"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
 
 func TestMarkdown_GetSnapshots(t *testing.T) {
@@ -59,6 +65,210 @@ func TestMarkdown_GetSnapshots(t *testing.T) {
 		}
 		assert.True(t, found)
 	})
+	t.Run("import front matter into details, relations and types", func(t *testing.T) {
+		// given
+		testDirectory := t.TempDir()
+		err := os.WriteFile(filepath.Join(testDirectory, "book.md"), []byte("---\n"+
+			"title: Dune\n"+
+			"type: Novel\n"+
+			"tags: [sci-fi, \"#classic\"]\n"+
+			"Status: Done\n"+
+			"rating: 4.5\n"+
+			"read: true\n"+
+			"published: 1965-08-01\n"+
+			"website: https://dune.fandom.com\n"+
+			"author: \"[[Frank Herbert]]\"\n"+
+			"related: [[Frank Herbert.md]]\n"+
+			"---\n"+
+			"# Heading\n"), os.ModePerm)
+		assert.Nil(t, err)
+		err = os.WriteFile(filepath.Join(testDirectory, "Frank Herbert.md"), []byte("---\ntype: human\n---\ntext\n"), os.ModePerm)
+		assert.Nil(t, err)
+		h := &Markdown{blockConverter: newMDConverter(&MockTempDir{})}
+		p := process.NewNoOp()
+
+		// when
+		sn, ce := h.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfMarkdownParams{
+				MarkdownParams: &pb.RpcObjectImportRequestMarkdownParams{Path: []string{testDirectory}},
+			},
+			Type: model.Import_Markdown,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, p)
+
+		// then
+		assert.Nil(t, ce)
+		assert.NotNil(t, sn)
+		var (
+			book, author  *common.Snapshot
+			relationNames = map[string]*common.Snapshot{}
+			optionNames   = map[string]*common.Snapshot{}
+			types         []*common.Snapshot
+		)
+		for _, snapshot := range sn.Snapshots {
+			switch snapshot.Snapshot.SbType {
+			case smartblock.SmartBlockTypeRelation:
+				relationNames[snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)] = snapshot
+			case smartblock.SmartBlockTypeRelationOption:
+				optionNames[snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)] = snapshot
+			case smartblock.SmartBlockTypeObjectType:
+				types = append(types, snapshot)
+			}
+			switch snapshot.FileName {
+			case filepath.Join(testDirectory, "book.md"):
+				book = snapshot
+			case filepath.Join(testDirectory, "Frank Herbert.md"):
+				author = snapshot
+			}
+		}
+		assert.NotNil(t, book)
+		assert.NotNil(t, author)
+
+		assert.Len(t, types, 1)
+		assert.Equal(t, "Novel", types[0].Snapshot.Data.Details.GetString(bundle.RelationKeyName))
+		assert.Equal(t, []string{types[0].Snapshot.Data.Key}, book.Snapshot.Data.ObjectTypes)
+		assert.Equal(t, []string{bundle.TypeKeyProfile.String()}, author.Snapshot.Data.ObjectTypes)
+		assert.Equal(t, int64(model.ObjectType_profile), author.Snapshot.Data.Details.GetInt64(bundle.RelationKeyLayout))
+
+		details := book.Snapshot.Data.Details
+		assert.Equal(t, "Dune", details.GetString(bundle.RelationKeyName))
+		assert.Equal(t, "Heading", book.Snapshot.Data.Blocks[0].GetText().GetText())
+		assert.Equal(t, []string{
+			optionNames["sci-fi"].Id,
+			optionNames["classic"].Id,
+		}, details.GetStringList(bundle.RelationKeyTag))
+		assert.Equal(t, bundle.RelationKeyTag.String(), optionNames["classic"].Snapshot.Data.Details.GetString(bundle.RelationKeyRelationKey))
+		assert.Equal(t, []string{optionNames["Done"].Id}, details.GetStringList(bundle.RelationKeyStatus))
+
+		expectedFormats := map[string]model.RelationFormat{
+			"rating":    model.RelationFormat_number,
+			"read":      model.RelationFormat_checkbox,
+			"published": model.RelationFormat_date,
+			"website":   model.RelationFormat_url,
+			"related":   model.RelationFormat_object,
+		}
+		assert.Len(t, relationNames, len(expectedFormats))
+		for name, format := range expectedFormats {
+			relation := relationNames[name]
+			assert.NotNil(t, relation, name)
+			assert.Equal(t, float64(format), relation.Snapshot.Data.Details.GetFloat64(bundle.RelationKeyRelationFormat), name)
+			assert.NotNil(t, pbtypes.RelationLinks(book.Snapshot.Data.RelationLinks).Get(relation.Snapshot.Data.Key), name)
+		}
+		assert.Equal(t, 4.5, details.GetFloat64(domain.RelationKey(relationNames["rating"].Snapshot.Data.Key)))
+		assert.True(t, details.GetBool(domain.RelationKey(relationNames["read"].Snapshot.Data.Key)))
+		assert.Equal(t, time.Date(1965, 8, 1, 0, 0, 0, 0, time.UTC).Unix(), details.GetInt64(domain.RelationKey(relationNames["published"].Snapshot.Data.Key)))
+		assert.Equal(t, "https://dune.fandom.com", details.GetString(domain.RelationKey(relationNames["website"].Snapshot.Data.Key)))
+		assert.Equal(t, []string{author.Id}, details.GetStringList(domain.RelationKey(relationNames["related"].Snapshot.Data.Key)))
+		assert.Equal(t, []string{author.Id}, details.GetStringList(bundle.RelationKeyAuthor))
+	})
+	t.Run("import wiki-links, embeds and hashtags", func(t *testing.T) {
+		// given
+		testDirectory := t.TempDir()
+		for path, content := range map[string]string{
+			filepath.Join("daily", "today.md"):     "Met [[Alice|her]] about #work\n\n![[diagram.png]]\n",
+			filepath.Join("people", "Alice.md"):    "---\ntags: [person]\n---\n#work colleague\n",
+			filepath.Join("assets", "diagram.png"): "png",
+		} {
+			err := os.MkdirAll(filepath.Join(testDirectory, filepath.Dir(path)), os.ModePerm)
+			assert.Nil(t, err)
+			err = os.WriteFile(filepath.Join(testDirectory, path), []byte(content), os.ModePerm)
+			assert.Nil(t, err)
+		}
+		h := &Markdown{blockConverter: newMDConverter(&MockTempDir{})}
+		p := process.NewNoOp()
+
+		// when
+		sn, ce := h.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfMarkdownParams{
+				MarkdownParams: &pb.RpcObjectImportRequestMarkdownParams{Path: []string{testDirectory}},
+			},
+			Type: model.Import_Markdown,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, p)
+
+		// then
+		assert.Nil(t, ce)
+		assert.NotNil(t, sn)
+		var (
+			today, alice *common.Snapshot
+			options      = map[string]string{}
+		)
+		for _, snapshot := range sn.Snapshots {
+			switch snapshot.FileName {
+			case filepath.Join(testDirectory, "daily", "today.md"):
+				today = snapshot
+			case filepath.Join(testDirectory, "people", "Alice.md"):
+				alice = snapshot
+			}
+			if snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelationOption {
+				options[snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyName)] = snapshot.Id
+			}
+		}
+		assert.NotNil(t, today)
+		assert.NotNil(t, alice)
+		assert.Len(t, options, 2)
+
+		text := today.Snapshot.Data.Blocks[0].GetText()
+		assert.Equal(t, "Met her about #work", text.Text)
+		assert.Len(t, text.Marks.Marks, 1)
+		assert.Equal(t, model.BlockContentTextMark_Mention, text.Marks.Marks[0].Type)
+		assert.Equal(t, alice.Id, text.Marks.Marks[0].Param)
+
+		file := today.Snapshot.Data.Blocks[1].GetFile()
+		assert.NotNil(t, file)
+		assert.Equal(t, model.BlockContentFile_Image, file.Type)
+		assert.True(t, strings.HasSuffix(file.Name, filepath.Join("assets", "diagram.png")))
+
+		assert.Equal(t, []string{options["work"]}, today.Snapshot.Data.Details.GetStringList(bundle.RelationKeyTag))
+		assert.Equal(t, []string{options["person"], options["work"]}, alice.Snapshot.Data.Details.GetStringList(bundle.RelationKeyTag))
+	})
+	t.Run("import wiki-links to notes with dots in the name", func(t *testing.T) {
+		// given
+		testDirectory := t.TempDir()
+		for path, content := range map[string]string{
+			"index.md":        "See [[v1.2 notes]]\n\n![[v1.2 notes]]\n\n![[v1.2 scheme.png]]\n",
+			"v1.2 notes.md":   "release notes\n",
+			"v1.2 scheme.png": "png",
+		} {
+			err := os.WriteFile(filepath.Join(testDirectory, path), []byte(content), os.ModePerm)
+			assert.Nil(t, err)
+		}
+		h := &Markdown{blockConverter: newMDConverter(&MockTempDir{})}
+		p := process.NewNoOp()
+
+		// when
+		sn, ce := h.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfMarkdownParams{
+				MarkdownParams: &pb.RpcObjectImportRequestMarkdownParams{Path: []string{testDirectory}},
+			},
+			Type: model.Import_Markdown,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, p)
+
+		// then
+		assert.Nil(t, ce)
+		var index, notes *common.Snapshot
+		for _, snapshot := range sn.Snapshots {
+			switch snapshot.FileName {
+			case filepath.Join(testDirectory, "index.md"):
+				index = snapshot
+			case filepath.Join(testDirectory, "v1.2 notes.md"):
+				notes = snapshot
+			}
+		}
+		assert.NotNil(t, index)
+		assert.NotNil(t, notes)
+
+		text := index.Snapshot.Data.Blocks[0].GetText()
+		assert.Len(t, text.Marks.Marks, 1)
+		assert.Equal(t, notes.Id, text.Marks.Marks[0].Param)
+		link := index.Snapshot.Data.Blocks[1].GetLink()
+		assert.NotNil(t, link)
+		assert.Equal(t, notes.Id, link.TargetBlockId)
+		file := index.Snapshot.Data.Blocks[2].GetFile()
+		assert.NotNil(t, file)
+		assert.True(t, strings.HasSuffix(file.Name, "v1.2 scheme.png"))
+	})
 	t.Run("no object error", func(t *testing.T) {
 		// given
 		testDirectory := t.TempDir()
@@ -0,0 +1,250 @@
+package opml
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/converter/opml"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name               = "Opml"
+	rootCollectionName = "OPML Import"
+	opmlExtension      = ".opml"
+
+	typeLink      = "link"
+	completeValue = "true"
+)
+
+// dateLayouts are layouts of RFC 822 dates used in the head of the document
+var dateLayouts = []string{time.RFC1123Z, time.RFC1123, time.RFC822Z, time.RFC822}
+
+type OPML struct {
+	service *collection.Service
+}
+
+func New(service *collection.Service) common.Converter {
+	return &OPML{service: service}
+}
+
+func (o *OPML) Name() string {
+	return Name
+}
+
+func (o *OPML) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetOpmlParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (o *OPML) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := o.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	snapshots := o.getSnapshots(req, progress, paths, allErrors)
+	if allErrors.ShouldAbortImport(len(paths), req.Type) {
+		return nil, allErrors
+	}
+	targetObjects := make([]string, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		targetObjects = append(targetObjects, snapshot.Id)
+	}
+	rootCollection := common.NewImportCollection(o.service)
+	settings := common.MakeImportCollectionSetting(rootCollectionName, targetObjects, "", nil, true, true, true)
+	rootCol, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &common.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &common.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+func (o *OPML) getSnapshots(req *pb.RpcObjectImportRequest,
+	progress process.Progress,
+	paths []string,
+	allErrors *common.ConvertError,
+) []*common.Snapshot {
+	var snapshots []*common.Snapshot
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil
+		}
+		snapshots = append(snapshots, o.handleImportPath(p, len(paths), allErrors)...)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil
+		}
+	}
+	return snapshots
+}
+
+func (o *OPML) handleImportPath(p string, pathsCount int, allErrors *common.ConvertError) []*common.Snapshot {
+	importSource := source.GetSource(p)
+	defer importSource.Close()
+	err := importSource.Initialize(p)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Opml) {
+			return nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{opmlExtension}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil
+	}
+	var snapshots []*common.Snapshot
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		if !strings.EqualFold(filepath.Ext(fileName), opmlExtension) {
+			return true
+		}
+		doc, err := opml.Parse(fileReader)
+		fileReader.Close()
+		if err != nil {
+			allErrors.Add(err)
+			return !allErrors.ShouldAbortImport(pathsCount, model.Import_Opml)
+		}
+		snapshots = append(snapshots, documentSnapshot(fileName, doc))
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+	}
+	return snapshots
+}
+
+// documentSnapshot creates the page from the document, the title of the head becomes the name of the page
+func documentSnapshot(fileName string, doc *opml.Document) *common.Snapshot {
+	details := common.GetCommonDetails(fileName, strings.TrimSpace(doc.Head.Title), "", model.ObjectType_basic)
+	if created, ok := parseDate(doc.Head.DateCreated); ok {
+		details.SetInt64(bundle.RelationKeyCreatedDate, created.Unix())
+	}
+	if modified, ok := parseDate(doc.Head.DateModified); ok {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, modified.Unix())
+	}
+	oc := &outlineConverter{}
+	oc.convert(doc.Body.Outlines)
+	return &common.Snapshot{
+		Id:       uuid.New().String(),
+		FileName: fileName,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:      oc.blocks,
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}
+}
+
+// outlineConverter converts outlines into blocks, nested outlines become child blocks
+type outlineConverter struct {
+	blocks []*model.Block
+}
+
+func (oc *outlineConverter) convert(outlines []*opml.Outline) []string {
+	ids := make([]string, 0, len(outlines))
+	for _, outline := range outlines {
+		b := oc.block(outline)
+		b.ChildrenIds = append(b.ChildrenIds, oc.convert(outline.Outlines)...)
+		oc.blocks = append(oc.blocks, b)
+		ids = append(ids, b.Id)
+	}
+	return ids
+}
+
+// block converts the outline into the bookmark, when the outline refers to the feed or the web page,
+// or into the text block. The note of the outline is added as the first child paragraph,
+// outlines with notes become toggles to keep the note folded like in outliners
+func (oc *outlineConverter) block(outline *opml.Outline) *model.Block {
+	b := &model.Block{Id: bson.NewObjectId().Hex()}
+	text := outlineText(outline)
+	if url := outlineUrl(outline); url != "" {
+		b.Content = &model.BlockContentOfBookmark{Bookmark: &model.BlockContentBookmark{Url: url, Title: text}}
+		return b
+	}
+	content := &model.BlockContentText{Text: text, Style: model.BlockContentText_Marked}
+	switch {
+	case outline.Complete != "":
+		content.Style = model.BlockContentText_Checkbox
+		content.Checked = strings.EqualFold(outline.Complete, completeValue)
+	case outline.Note != "":
+		content.Style = model.BlockContentText_Toggle
+	}
+	b.Content = &model.BlockContentOfText{Text: content}
+	if outline.Note != "" {
+		note := &model.Block{
+			Id: bson.NewObjectId().Hex(),
+			Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+				Text:  outline.Note,
+				Style: model.BlockContentText_Paragraph,
+			}},
+		}
+		oc.blocks = append(oc.blocks, note)
+		b.ChildrenIds = []string{note.Id}
+	}
+	return b
+}
+
+func outlineText(outline *opml.Outline) string {
+	if outline.Text != "" {
+		return outline.Text
+	}
+	return outline.Title
+}
+
+func outlineUrl(outline *opml.Outline) string {
+	if outline.XmlUrl != "" {
+		return outline.XmlUrl
+	}
+	if strings.EqualFold(outline.Type, typeLink) {
+		return outline.Url
+	}
+	return ""
+}
+
+func parseDate(value string) (time.Time, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range dateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,92 @@
+package opml
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestOPML_GetSnapshots(t *testing.T) {
+	t.Run("outline with notes and feeds", func(t *testing.T) {
+		// given
+		o := &OPML{}
+
+		// when
+		sn, err := o.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfOpmlParams{
+				OpmlParams: &pb.RpcObjectImportRequestOpmlParams{Path: []string{filepath.Join("testdata", "outline.opml")}},
+			},
+			Type: model.Import_Opml,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, process.NewNoOp())
+
+		// then
+		assert.Nil(t, err)
+		require.NotNil(t, sn)
+		require.Len(t, sn.Snapshots, 2)
+		page := sn.Snapshots[0].Snapshot.Data
+		assert.Equal(t, sn.RootCollectionID, sn.Snapshots[1].Id)
+		assert.Equal(t, "Reading list", page.Details.GetString(bundle.RelationKeyName))
+		assert.Equal(t, time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC).Unix(), page.Details.GetInt64(bundle.RelationKeyCreatedDate))
+
+		blocks := map[string]*model.Block{}
+		byText := map[string]*model.Block{}
+		for _, b := range page.Blocks {
+			blocks[b.Id] = b
+			if text := b.GetText(); text != nil {
+				byText[text.Text] = b
+			}
+			if bm := b.GetBookmark(); bm != nil {
+				byText[bm.Title] = b
+			}
+		}
+		books := byText["Books"]
+		require.NotNil(t, books)
+		assert.Equal(t, model.BlockContentText_Marked, books.GetText().Style)
+		require.Len(t, books.ChildrenIds, 2)
+
+		dune := blocks[books.ChildrenIds[0]]
+		assert.Equal(t, model.BlockContentText_Toggle, dune.GetText().Style)
+		require.Len(t, dune.ChildrenIds, 1)
+		note := blocks[dune.ChildrenIds[0]].GetText()
+		assert.Equal(t, "Reread the appendix", note.Text)
+		assert.Equal(t, model.BlockContentText_Paragraph, note.Style)
+
+		solaris := blocks[books.ChildrenIds[1]].GetText()
+		assert.Equal(t, model.BlockContentText_Checkbox, solaris.Style)
+		assert.True(t, solaris.Checked)
+
+		assert.Equal(t, "https://example.com/feed.xml", byText["Example blog"].GetBookmark().Url)
+		assert.Equal(t, "https://example.com/docs", byText["Docs"].GetBookmark().Url)
+		assert.Len(t, byText["Feeds"].ChildrenIds, 2)
+	})
+	t.Run("no opml files in dir", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		o := &OPML{}
+
+		// when
+		_, err := o.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfOpmlParams{
+				OpmlParams: &pb.RpcObjectImportRequestOpmlParams{Path: []string{dir}},
+			},
+			Type: model.Import_Opml,
+			Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+		}, process.NewNoOp())
+
+		// then
+		assert.NotNil(t, err)
+		assert.True(t, errors.Is(err.GetResultError(model.Import_Opml), common.ErrFileImportNoObjectsInDirectory))
+	})
+}
@@ -0,0 +1,211 @@
+package outliner
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/globalsign/mgo/bson"
+	"github.com/gogo/protobuf/types"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const divider = "---"
+
+var (
+	codeRegexp    = regexp.MustCompile("(?s)^```([^\\n`]*)\\n(.*?)\\n?```$")
+	headingRegexp = regexp.MustCompile(`^(#{1,6})\s+(.*)$`)
+	taskRegexp    = regexp.MustCompile(`^(?:(TODO|DOING|NOW|LATER|WAITING|DONE|CANCELED|CANCELLED)|\{\{(?:\[\[)?(TODO|DONE)(?:\]\])?\}\})\s*`)
+)
+
+// converter creates snapshots of pages. Relations and options created from page properties
+// are shared between all graphs of the import
+type converter struct {
+	relations map[string][]*model.Relation // created relations by lowercase name
+	options   map[string]map[string]string // relation key -> option name -> option id
+	snapshots []*common.Snapshot
+}
+
+func newConverter() *converter {
+	return &converter{
+		relations: make(map[string][]*model.Relation),
+		options:   make(map[string]map[string]string),
+	}
+}
+
+// convertGraph creates snapshots of all pages of the graph and returns ids of regular and journal pages
+func (c *converter) convertGraph(g *graph) (pages, journals []string) {
+	g.sortPages()
+	// pages which are only referenced are added to the graph during conversion, so the slice grows
+	for i := 0; i < len(g.pages); i++ {
+		pg := g.pages[i]
+		snapshot := c.pageSnapshot(g, pg)
+		c.snapshots = append(c.snapshots, snapshot)
+		if pg.journal.IsZero() {
+			pages = append(pages, snapshot.Id)
+		} else {
+			journals = append(journals, snapshot.Id)
+		}
+	}
+	return pages, journals
+}
+
+func (c *converter) pageSnapshot(g *graph, pg *page) *common.Snapshot {
+	pc := &pageConverter{graph: g, ids: make(map[string]struct{})}
+	pc.convert(pg.blocks, viewBullet)
+
+	details := common.GetCommonDetails(pg.file, pg.title, pg.icon, model.ObjectType_basic)
+	if pg.sourcePath != pg.file {
+		details.SetString(bundle.RelationKeySourceFilePath, pg.sourcePath)
+	}
+	if pg.created != 0 {
+		details.SetInt64(bundle.RelationKeyCreatedDate, pg.created)
+	}
+	if pg.modified != 0 {
+		details.SetInt64(bundle.RelationKeyLastModifiedDate, pg.modified)
+	}
+	relationLinks := c.setProperties(g, pg, details)
+	if !pg.journal.IsZero() {
+		// the date relation links the journal page to the date object of its day
+		rel := c.journalDateRelation()
+		details.SetInt64(domain.RelationKey(rel.Key), pg.journal.Unix())
+		if !pbtypes.RelationLinks(relationLinks).Has(rel.Key) {
+			relationLinks = append(relationLinks, &model.RelationLink{Key: rel.Key, Format: rel.Format})
+		}
+	}
+
+	return &common.Snapshot{
+		Id:       pg.id,
+		FileName: pg.sourcePath,
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypePage,
+			Data: &common.StateSnapshot{
+				Blocks:        pc.blocks,
+				Details:       details,
+				RelationLinks: relationLinks,
+				ObjectTypes:   []string{bundle.TypeKeyPage.String()},
+			},
+		},
+	}
+}
+
+// pageConverter converts the outline of the page into blocks, nested outline nodes become child blocks
+type pageConverter struct {
+	graph  *graph
+	blocks []*model.Block
+	ids    map[string]struct{}
+}
+
+func (pc *pageConverter) convert(nodes []*node, view viewType) []string {
+	ids := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		b := pc.block(n, view)
+		b.ChildrenIds = pc.convert(n.children, n.view)
+		pc.blocks = append(pc.blocks, b)
+		ids = append(ids, b.Id)
+	}
+	return ids
+}
+
+func (pc *pageConverter) block(n *node, view viewType) *model.Block {
+	b := &model.Block{
+		Id:    pc.blockId(n.uid),
+		Align: n.align,
+	}
+	if n.file != "" {
+		b.Content = anymark.ConvertTextToFile(n.file)
+		return b
+	}
+	text, style := blockStyle(n.text)
+	if text == divider {
+		b.Content = &model.BlockContentOfDiv{Div: &model.BlockContentDiv{Style: model.BlockContentDiv_Line}}
+		return b
+	}
+	if !style.defined {
+		style.style = listStyle(view)
+		if n.heading > 0 {
+			style.style = headerStyle(n.heading)
+		}
+	}
+	content := &model.BlockContentText{
+		Style:   style.style,
+		Checked: style.checked,
+	}
+	if style.style == model.BlockContentText_Code {
+		content.Text = text
+		if style.lang != "" {
+			b.Fields = &types.Struct{Fields: map[string]*types.Value{"lang": pbtypes.String(style.lang)}}
+		}
+	} else {
+		p := newInlineParser(pc.graph, 0)
+		p.parse(text)
+		content.Text = p.text()
+		content.Marks = &model.BlockContentTextMarks{Marks: p.marks}
+	}
+	b.Content = &model.BlockContentOfText{Text: content}
+	return b
+}
+
+// blockId keeps uids of blocks, so the blocks stay addressable after import
+func (pc *pageConverter) blockId(uid string) string {
+	if _, exists := pc.ids[uid]; uid == "" || exists {
+		uid = bson.NewObjectId().Hex()
+	}
+	pc.ids[uid] = struct{}{}
+	return uid
+}
+
+type textStyle struct {
+	style   model.BlockContentTextStyle
+	defined bool // style is defined by the markup of the block
+	checked bool
+	lang    string
+}
+
+// blockStyle recognizes block level markup: code blocks, tasks, headings and quotes
+func blockStyle(text string) (string, textStyle) {
+	text = strings.TrimSpace(text)
+	if match := codeRegexp.FindStringSubmatch(text); match != nil {
+		return match[2], textStyle{style: model.BlockContentText_Code, defined: true, lang: strings.TrimSpace(match[1])}
+	}
+	if match := taskRegexp.FindStringSubmatch(text); match != nil {
+		status := match[1] + match[2]
+		checked := status == "DONE" || status == "CANCELED" || status == "CANCELLED"
+		return text[len(match[0]):], textStyle{style: model.BlockContentText_Checkbox, defined: true, checked: checked}
+	}
+	if match := headingRegexp.FindStringSubmatch(text); match != nil {
+		return match[2], textStyle{style: headerStyle(len(match[1])), defined: true}
+	}
+	if quote, ok := strings.CutPrefix(text, "> "); ok {
+		return quote, textStyle{style: model.BlockContentText_Quote, defined: true}
+	}
+	return text, textStyle{}
+}
+
+func headerStyle(level int) model.BlockContentTextStyle {
+	switch level {
+	case 1:
+		return model.BlockContentText_Header1
+	case 2:
+		return model.BlockContentText_Header2
+	case 3:
+		return model.BlockContentText_Header3
+	}
+	return model.BlockContentText_Header4
+}
+
+func listStyle(view viewType) model.BlockContentTextStyle {
+	switch view {
+	case viewNumbered:
+		return model.BlockContentText_Numbered
+	case viewDocument:
+		return model.BlockContentText_Paragraph
+	}
+	return model.BlockContentText_Marked
+}
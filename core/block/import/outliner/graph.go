@@ -0,0 +1,261 @@
+package outliner
+
+import (
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	titleProperty = "title"
+	aliasProperty = "alias"
+	iconProperty  = "icon"
+)
+
+var (
+	propertyRegexp     = regexp.MustCompile(`^([\p{L}\p{N}][\p{L}\p{N} _\-/.]*?)::(?:\s+(.*))?$`)
+	imageRegexp        = regexp.MustCompile(`^!\[([^\]]*)\]\(([^)\s]+)(?:\s+"[^"]*")?\)(?:\{[^}]*\})?$`)
+	journalTitleRegexp = regexp.MustCompile(`^([A-Za-z]+)\.? (\d{1,2})(?:st|nd|rd|th)?, (\d{4})$`)
+
+	journalDateLayouts = []string{time.DateOnly, logseqJournalLayout, "2006/01/02"}
+)
+
+// page is a page of the outliner graph, the content of the page is a tree of blocks
+type page struct {
+	id         string // id of the snapshot
+	title      string
+	aliases    []string
+	icon       string
+	file       string // imported file the page is read from
+	sourcePath string // unique path of the page, Roam keeps all pages in a single file
+	journal    time.Time
+	created    int64
+	modified   int64
+	properties []property
+	blocks     []*node
+}
+
+// property is written as `key:: value` both in Roam and Logseq
+type property struct {
+	key   string
+	value string
+}
+
+type viewType int
+
+const (
+	viewBullet viewType = iota
+	viewNumbered
+	viewDocument
+)
+
+type node struct {
+	uid      string
+	text     string
+	heading  int
+	align    model.BlockAlign
+	view     viewType // how the children of the block are shown
+	file     string   // path or URL of the embedded image
+	children []*node
+}
+
+type blockRef struct {
+	page *page
+	node *node
+}
+
+// graph indexes pages and blocks of the single import path to resolve references between them
+type graph struct {
+	path     string
+	pages    []*page
+	titles   map[string]*page // by lowercase title or alias
+	journals map[string]*page // by date
+	blocks   map[string]blockRef
+}
+
+func newGraph(path string) *graph {
+	return &graph{
+		path:     path,
+		titles:   make(map[string]*page),
+		journals: make(map[string]*page),
+		blocks:   make(map[string]blockRef),
+	}
+}
+
+// addPage adds the page to the graph. Pages with the same title are merged, for example
+// Logseq keeps the journal page and the page referencing the same date as different files
+func (g *graph) addPage(pg *page) {
+	pg.applyProperties()
+	if existing := g.findPage(pg.title); existing != nil {
+		existing.properties = append(existing.properties, pg.properties...)
+		existing.blocks = append(existing.blocks, pg.blocks...)
+		existing.aliases = append(existing.aliases, pg.aliases...)
+		g.indexBlocks(existing, pg.blocks)
+		return
+	}
+	pg.id = uuid.New().String()
+	g.pages = append(g.pages, pg)
+	g.titles[strings.ToLower(pg.title)] = pg
+	for _, alias := range pg.aliases {
+		if _, exists := g.titles[strings.ToLower(alias)]; !exists {
+			g.titles[strings.ToLower(alias)] = pg
+		}
+	}
+	if !pg.journal.IsZero() {
+		g.journals[pg.journal.Format(time.DateOnly)] = pg
+	}
+	g.indexBlocks(pg, pg.blocks)
+}
+
+func (g *graph) indexBlocks(pg *page, nodes []*node) {
+	for _, n := range nodes {
+		if n.uid != "" {
+			g.blocks[n.uid] = blockRef{page: pg, node: n}
+		}
+		g.indexBlocks(pg, n.children)
+	}
+}
+
+func (g *graph) findPage(title string) *page {
+	if pg, ok := g.titles[strings.ToLower(title)]; ok {
+		return pg
+	}
+	if date, ok := parseJournalTitle(title); ok {
+		return g.journals[date.Format(time.DateOnly)]
+	}
+	return nil
+}
+
+// page returns the page with the given title. Outliners create pages when they are referenced,
+// so missing pages are added to the graph without content
+func (g *graph) page(title string) *page {
+	title = strings.TrimSpace(title)
+	if pg := g.findPage(title); pg != nil {
+		return pg
+	}
+	pg := &page{
+		title:      title,
+		file:       g.path,
+		sourcePath: filepath.Join(g.path, title),
+	}
+	if date, ok := parseJournalTitle(title); ok {
+		pg.journal = date
+	}
+	g.addPage(pg)
+	return pg
+}
+
+// sortPages orders pages by title to keep the result of the import stable
+func (g *graph) sortPages() {
+	sort.SliceStable(g.pages, func(i, j int) bool {
+		return strings.ToLower(g.pages[i].title) < strings.ToLower(g.pages[j].title)
+	})
+}
+
+// resolveAssets finds blocks embedding images and resolves paths of local images against the import source
+func (g *graph) resolveAssets(importSource source.Source, tempDirProvider core.TempDirProvider) {
+	for _, pg := range g.pages {
+		walkNodes(pg.blocks, func(n *node) {
+			match := imageRegexp.FindStringSubmatch(strings.TrimSpace(n.text))
+			if match == nil {
+				return
+			}
+			link := match[2]
+			if isURL(link) {
+				n.file = link
+				return
+			}
+			fileName := filepath.Join(filepath.Dir(pg.file), filepath.FromSlash(link))
+			path, createFileBlock, err := common.ProvideFileName(fileName, importSource, "", tempDirProvider)
+			if err != nil {
+				log.Warnf("failed to resolve image of the page: %v", err)
+				return
+			}
+			if createFileBlock {
+				n.file = path
+			}
+		})
+	}
+}
+
+func walkNodes(nodes []*node, f func(n *node)) {
+	for _, n := range nodes {
+		f(n)
+		walkNodes(n.children, f)
+	}
+}
+
+// applyProperties takes the title, aliases and icon of the page from its properties
+func (pg *page) applyProperties() {
+	for _, prop := range pg.properties {
+		switch strings.ToLower(prop.key) {
+		case titleProperty:
+			if title := strings.TrimSpace(prop.value); title != "" {
+				pg.title = title
+			}
+		case aliasProperty:
+			pg.aliases = append(pg.aliases, splitPageNames(prop.value)...)
+		case iconProperty:
+			pg.icon = strings.TrimSpace(prop.value)
+		}
+	}
+}
+
+func parseProperty(line string) (property, bool) {
+	match := propertyRegexp.FindStringSubmatch(strings.TrimSpace(line))
+	if match == nil {
+		return property{}, false
+	}
+	return property{key: strings.TrimSpace(match[1]), value: strings.TrimSpace(match[2])}, true
+}
+
+// parseJournalTitle parses titles of journal pages, like "January 2nd, 2024" in Roam or "Jan 2nd, 2024" in Logseq
+func parseJournalTitle(title string) (time.Time, bool) {
+	title = strings.TrimSpace(title)
+	if match := journalTitleRegexp.FindStringSubmatch(title); match != nil {
+		value := match[1] + " " + match[2] + ", " + match[3]
+		for _, layout := range []string{"January 2, 2006", "Jan 2, 2006"} {
+			if date, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+				return date, true
+			}
+		}
+		return time.Time{}, false
+	}
+	for _, layout := range journalDateLayouts {
+		if date, err := time.ParseInLocation(layout, title, time.Local); err == nil {
+			return date, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// journalTitle formats the date as Logseq does by default
+func journalTitle(date time.Time) string {
+	day := date.Day()
+	suffix := "th"
+	switch {
+	case day%100 >= 11 && day%100 <= 13:
+	case day%10 == 1:
+		suffix = "st"
+	case day%10 == 2:
+		suffix = "nd"
+	case day%10 == 3:
+		suffix = "rd"
+	}
+	return date.Format("Jan ") + strconv.Itoa(day) + suffix + date.Format(", 2006")
+}
+
+func isURL(link string) bool {
+	lower := strings.ToLower(link)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://")
+}
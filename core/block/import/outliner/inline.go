@@ -0,0 +1,328 @@
+package outliner
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	textutil "github.com/anyproto/anytype-heart/util/text"
+)
+
+// maxRefDepth limits nesting of block references, which can refer to each other
+const maxRefDepth = 3
+
+type delimiter struct {
+	value    string
+	markType model.BlockContentTextMarkType
+	param    string
+	boundary bool // delimiter is used only at word boundaries, e.g. "_" in snake_case is not a mark
+}
+
+// embedRegexp matches embeds of blocks and pages, {{embed ((uid))}} in Logseq and {{[[embed]]: ((uid))}} in Roam
+var embedRegexp = regexp.MustCompile(`^\s*(?:\[\[)?embed(?:\]\])?:?\s*(.+?)\s*$`)
+
+// delimiters are ordered so that longer delimiters are matched first
+var delimiters = []delimiter{
+	{value: "**", markType: model.BlockContentTextMark_Bold},
+	{value: "__", markType: model.BlockContentTextMark_Italic},
+	{value: "~~", markType: model.BlockContentTextMark_Strikethrough},
+	{value: "^^", markType: model.BlockContentTextMark_BackgroundColor, param: "yellow"},
+	{value: "==", markType: model.BlockContentTextMark_BackgroundColor, param: "yellow"},
+	{value: "*", markType: model.BlockContentTextMark_Italic, boundary: true},
+	{value: "_", markType: model.BlockContentTextMark_Italic, boundary: true},
+}
+
+// inlineParser converts the inline Markdown of Roam and Logseq into text with marks.
+// Page references become mentions of pages and block references become links to the page of the block
+type inlineParser struct {
+	graph *graph
+	depth int
+	buf   strings.Builder
+	pos   int // position in UTF-16 code units, as ranges of marks are counted
+	last  rune
+	marks []*model.BlockContentTextMark
+}
+
+func newInlineParser(g *graph, depth int) *inlineParser {
+	return &inlineParser{graph: g, depth: depth}
+}
+
+func (p *inlineParser) text() string {
+	return p.buf.String()
+}
+
+func (p *inlineParser) write(s string) {
+	if s == "" {
+		return
+	}
+	p.buf.WriteString(s)
+	p.pos += textutil.UTF16RuneCountString(s)
+	p.last, _ = utf8.DecodeLastRuneInString(s)
+}
+
+func (p *inlineParser) addMark(markType model.BlockContentTextMarkType, from int, param string) {
+	if from == p.pos {
+		return
+	}
+	p.marks = append(p.marks, &model.BlockContentTextMark{
+		Range: &model.Range{From: int32(from), To: int32(p.pos)},
+		Type:  markType,
+		Param: param,
+	})
+}
+
+func (p *inlineParser) parse(s string) {
+	for len(s) > 0 {
+		if n := p.parseToken(s); n > 0 {
+			s = s[n:]
+			continue
+		}
+		_, size := utf8.DecodeRuneInString(s)
+		p.write(s[:size])
+		s = s[size:]
+	}
+}
+
+// parseToken converts the markup at the beginning of the string and returns the number of consumed bytes
+func (p *inlineParser) parseToken(s string) int {
+	switch {
+	case strings.HasPrefix(s, "`"):
+		end := strings.Index(s[1:], "`")
+		if end <= 0 {
+			return 0
+		}
+		from := p.pos
+		p.write(s[1 : end+1])
+		p.addMark(model.BlockContentTextMark_Keyboard, from, "")
+		return end + 2
+	case strings.HasPrefix(s, "(("):
+		end := strings.Index(s, "))")
+		if end <= 2 || strings.ContainsAny(s[2:end], " \t\n()") {
+			return 0
+		}
+		p.blockRef(s[2:end], "")
+		return end + 2
+	case strings.HasPrefix(s, "{{"):
+		end := strings.Index(s, "}}")
+		if end < 0 {
+			return 0
+		}
+		match := embedRegexp.FindStringSubmatch(s[2:end])
+		if match == nil {
+			return 0
+		}
+		p.parse(match[1])
+		return end + 2
+	case (strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")) && p.atBoundary():
+		n := urlLength(s)
+		from := p.pos
+		p.write(s[:n])
+		p.addMark(model.BlockContentTextMark_Link, from, s[:n])
+		return n
+	case strings.HasPrefix(s, "#[["):
+		end := closingBrackets(s[1:])
+		if end < 0 {
+			return 0
+		}
+		p.pageRef(s[3:end+1], "")
+		return end + 3
+	case strings.HasPrefix(s, "[["):
+		end := closingBrackets(s)
+		if end < 0 {
+			return 0
+		}
+		p.pageRef(s[2:end], "")
+		return end + 2
+	case strings.HasPrefix(s, "#") && p.atBoundary():
+		n := tagLength(s[1:])
+		if n == 0 {
+			return 0
+		}
+		p.pageRef(s[1:n+1], "")
+		return n + 1
+	case strings.HasPrefix(s, "!["):
+		if n := p.parseLink(s[1:]); n > 0 {
+			return n + 1
+		}
+		return 0
+	case strings.HasPrefix(s, "["):
+		return p.parseLink(s)
+	}
+	for _, d := range delimiters {
+		if n := p.parseDelimited(s, d); n > 0 {
+			return n
+		}
+	}
+	return 0
+}
+
+func (p *inlineParser) parseDelimited(s string, d delimiter) int {
+	if !strings.HasPrefix(s, d.value) {
+		return 0
+	}
+	if d.boundary && !p.atBoundary() {
+		return 0
+	}
+	inner := s[len(d.value):]
+	end := strings.Index(inner, d.value)
+	if end <= 0 {
+		return 0
+	}
+	content := inner[:end]
+	if strings.TrimSpace(content) != content {
+		return 0
+	}
+	if d.boundary {
+		next, _ := utf8.DecodeRuneInString(inner[end+len(d.value):])
+		if isWordRune(next) {
+			return 0
+		}
+	}
+	from := p.pos
+	p.parse(content)
+	p.addMark(d.markType, from, d.param)
+	return len(d.value)*2 + end
+}
+
+// parseLink converts [label](target) links, targets can be URLs, page or block references
+func (p *inlineParser) parseLink(s string) int {
+	labelEnd := closingBracket(s, '[', ']')
+	if labelEnd < 0 || !strings.HasPrefix(s[labelEnd+1:], "(") {
+		return 0
+	}
+	targetEnd := closingBracket(s[labelEnd+1:], '(', ')')
+	if targetEnd < 0 {
+		return 0
+	}
+	label := s[1:labelEnd]
+	target := strings.TrimSpace(s[labelEnd+2 : labelEnd+1+targetEnd])
+	consumed := labelEnd + 2 + targetEnd
+	switch {
+	case strings.HasPrefix(target, "((") && strings.HasSuffix(target, "))"):
+		p.blockRef(target[2:len(target)-2], label)
+	case strings.HasPrefix(target, "[[") && strings.HasSuffix(target, "]]"):
+		p.pageRef(target[2:len(target)-2], label)
+	default:
+		if label == "" {
+			label = target
+		}
+		from := p.pos
+		p.parse(label)
+		p.addMark(model.BlockContentTextMark_Link, from, target)
+	}
+	return consumed
+}
+
+// pageRef writes the mention of the page, or the link to the page when the label differs from the title
+func (p *inlineParser) pageRef(title, label string) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		p.write(label)
+		return
+	}
+	pg := p.graph.page(title)
+	from := p.pos
+	if label == "" {
+		p.write(pg.title)
+		p.addMark(model.BlockContentTextMark_Mention, from, pg.id)
+		return
+	}
+	p.parse(label)
+	p.addMark(model.BlockContentTextMark_Object, from, pg.id)
+}
+
+// blockRef writes the text of the referenced block as the link to the block. Blocks keep their uids on import,
+// so the uid is the id of the block in the page containing it
+func (p *inlineParser) blockRef(uid, label string) {
+	ref, ok := p.graph.blocks[uid]
+	if !ok {
+		p.write("((" + uid + "))")
+		return
+	}
+	from := p.pos
+	if label == "" && p.depth < maxRefDepth {
+		nested := newInlineParser(p.graph, p.depth+1)
+		text, _ := blockStyle(ref.node.text)
+		nested.parse(text)
+		label = nested.text()
+		p.write(label)
+	} else {
+		p.parse(label)
+	}
+	if label == "" {
+		p.write(ref.page.title)
+	}
+	p.addMark(model.BlockContentTextMark_Object, from, ref.page.id)
+	if from != p.pos {
+		p.marks[len(p.marks)-1].FocusBlockId = uid
+	}
+}
+
+func (p *inlineParser) atBoundary() bool {
+	return p.pos == 0 || !isWordRune(p.last)
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// urlLength returns the length of the bare URL at the beginning of the string
+func urlLength(s string) int {
+	n := strings.IndexFunc(s, func(r rune) bool {
+		return unicode.IsSpace(r) || strings.ContainsRune("<>\"", r)
+	})
+	if n < 0 {
+		n = len(s)
+	}
+	return len(strings.TrimRight(s[:n], ".,;:!?)"))
+}
+
+// tagLength returns the length of the tag name at the beginning of the string, trailing punctuation is not a part of the tag
+func tagLength(s string) int {
+	n := 0
+	for i, r := range s {
+		if unicode.IsSpace(r) || strings.ContainsRune(",;!?()[]{}\"'#", r) {
+			break
+		}
+		n = i + utf8.RuneLen(r)
+	}
+	return len(strings.TrimRight(s[:n], ".:"))
+}
+
+// closingBrackets returns the index of "]]" closing the reference at the beginning of the string,
+// Roam allows nested references in page titles
+func closingBrackets(s string) int {
+	depth := 0
+	for i := 0; i+1 < len(s); i++ {
+		switch s[i : i+2] {
+		case "[[":
+			depth++
+			i++
+		case "]]":
+			depth--
+			if depth == 0 {
+				return i
+			}
+			i++
+		}
+	}
+	return -1
+}
+
+func closingBracket(s string, open, closing byte) int {
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case open:
+			depth++
+		case closing:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
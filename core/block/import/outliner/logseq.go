@@ -0,0 +1,204 @@
+package outliner
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	logseqIdProperty      = "id"
+	logseqHeadingProperty = "heading"
+	codeFence             = "```"
+)
+
+// logseqHiddenProperties are block properties Logseq uses internally, they are not shown in the block text
+var logseqHiddenProperties = map[string]struct{}{
+	logseqIdProperty:      {},
+	logseqHeadingProperty: {},
+	"collapsed":           {},
+	"background-color":    {},
+	"ls-type":             {},
+	"hl-type":             {},
+	"hl-page":             {},
+	"hl-stamp":            {},
+	"hl-color":            {},
+}
+
+// parseLogseq reads the Markdown page of the Logseq graph. Blocks of the page are list items,
+// the nesting of blocks is defined by the indentation
+func parseLogseq(r io.Reader, fileName string) (*page, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read logseq page: %w", err)
+	}
+	name := strings.TrimSuffix(filepath.Base(fileName), filepath.Ext(fileName))
+	pg := &page{
+		file:       fileName,
+		sourcePath: fileName,
+	}
+	if filepath.Base(filepath.Dir(fileName)) == logseqJournalsDir {
+		if date, err := time.ParseInLocation(logseqJournalLayout, name, time.Local); err == nil {
+			pg.journal = date
+			pg.title = journalTitle(date)
+		}
+	}
+	if pg.title == "" {
+		pg.title = logseqPageTitle(name)
+	}
+	preamble, blocks := parseOutline(string(data))
+	for _, line := range preamble {
+		if prop, ok := parseProperty(line); ok {
+			pg.properties = append(pg.properties, prop)
+		}
+	}
+	// page properties are also written as the first block without content
+	if len(blocks) > 0 && blocks[0].text == "" && len(blocks[0].children) == 0 && len(blocks[0].properties) > 0 {
+		pg.properties = append(pg.properties, blocks[0].properties...)
+		blocks = blocks[1:]
+	}
+	for _, b := range blocks {
+		pg.blocks = append(pg.blocks, b.node())
+	}
+	return pg, nil
+}
+
+// logseqPageTitle restores the page title from the file name, Logseq replaces slashes of namespaces by "___"
+func logseqPageTitle(name string) string {
+	name = strings.ReplaceAll(name, "___", "/")
+	if unescaped, err := url.PathUnescape(name); err == nil {
+		name = unescaped
+	}
+	return name
+}
+
+type logseqBlock struct {
+	indent     int
+	lines      []string
+	inFence    bool
+	text       string
+	properties []property
+	uid        string
+	heading    int
+	children   []*logseqBlock
+}
+
+func (b *logseqBlock) addLine(line string) {
+	if strings.HasPrefix(strings.TrimSpace(line), codeFence) {
+		b.inFence = !b.inFence
+	}
+	b.lines = append(b.lines, line)
+}
+
+// finish separates properties from the content of the block
+func (b *logseqBlock) finish() {
+	var (
+		content []string
+		inFence bool
+	)
+	for _, line := range b.lines {
+		if strings.HasPrefix(strings.TrimSpace(line), codeFence) {
+			inFence = !inFence
+		}
+		if !inFence {
+			if prop, ok := parseProperty(line); ok {
+				b.addProperty(prop)
+				continue
+			}
+		}
+		content = append(content, line)
+	}
+	b.text = strings.TrimRight(strings.Join(content, "\n"), "\n ")
+	for _, child := range b.children {
+		child.finish()
+	}
+}
+
+func (b *logseqBlock) addProperty(prop property) {
+	key := strings.ToLower(prop.key)
+	switch key {
+	case logseqIdProperty:
+		b.uid = prop.value
+	case logseqHeadingProperty:
+		if level, err := strconv.Atoi(prop.value); err == nil {
+			b.heading = level
+		} else if prop.value == "true" {
+			b.heading = 1
+		}
+	}
+	if _, hidden := logseqHiddenProperties[key]; hidden {
+		return
+	}
+	b.properties = append(b.properties, prop)
+}
+
+// node converts the block into the outline node. Visible block properties are kept in the text,
+// as objects don't have relations of separate blocks
+func (b *logseqBlock) node() *node {
+	n := &node{
+		uid:     b.uid,
+		text:    b.text,
+		heading: b.heading,
+	}
+	for _, prop := range b.properties {
+		if n.text != "" {
+			n.text += "\n"
+		}
+		n.text += prop.key + ":: " + prop.value
+	}
+	for _, child := range b.children {
+		n.children = append(n.children, child.node())
+	}
+	return n
+}
+
+// parseOutline splits the Markdown page into the lines before the first list item and the tree of blocks
+func parseOutline(data string) (preamble []string, blocks []*logseqBlock) {
+	var (
+		stack   []*logseqBlock
+		current *logseqBlock
+	)
+	for _, line := range strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n") {
+		trimmed := strings.TrimLeft(line, " \t")
+		indent := len(line) - len(trimmed)
+		isItem := trimmed == "-" || strings.HasPrefix(trimmed, "- ")
+		if isItem && (current == nil || !current.inFence) {
+			b := &logseqBlock{indent: indent}
+			b.addLine(strings.TrimPrefix(strings.TrimPrefix(trimmed, "-"), " "))
+			for len(stack) > 0 && stack[len(stack)-1].indent >= indent {
+				stack = stack[:len(stack)-1]
+			}
+			if len(stack) == 0 {
+				blocks = append(blocks, b)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, b)
+			}
+			stack = append(stack, b)
+			current = b
+			continue
+		}
+		if current == nil {
+			preamble = append(preamble, line)
+			continue
+		}
+		// continuation lines are indented by the width of the list marker
+		current.addLine(trimIndent(line, current.indent+2))
+	}
+	for _, b := range blocks {
+		b.finish()
+	}
+	return preamble, blocks
+}
+
+func trimIndent(line string, width int) string {
+	i := 0
+	for i < len(line) && i < width && (line[i] == ' ' || line[i] == '\t') {
+		i++
+	}
+	return line[i:]
+}
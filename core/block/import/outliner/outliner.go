@@ -0,0 +1,187 @@
+package outliner
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/collection"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/common/source"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/core"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var log = logging.Logger("import-outliner")
+
+const numberOfStages = 2 // 1 cycle to get snapshots and 1 cycle to create objects
+const (
+	Name                = "Outliner"
+	rootCollectionName  = "Outliner Import"
+	journalsCollection  = "Journals"
+	roamExtension       = ".json"
+	logseqExtension     = ".md"
+	logseqJournalsDir   = "journals"
+	logseqJournalLayout = "2006_01_02"
+)
+
+// Outliner imports graphs of outliner applications: Roam Research JSON exports and Logseq Markdown graphs.
+// Each import path is a separate graph, so references are resolved only between pages of the same path
+type Outliner struct {
+	collectionService *collection.Service
+	tempDirProvider   core.TempDirProvider
+}
+
+func New(collectionService *collection.Service, tempDirProvider core.TempDirProvider) common.Converter {
+	return &Outliner{
+		collectionService: collectionService,
+		tempDirProvider:   tempDirProvider,
+	}
+}
+
+func (o *Outliner) Name() string {
+	return Name
+}
+
+func (o *Outliner) GetParams(req *pb.RpcObjectImportRequest) []string {
+	if p := req.GetOutlinerParams(); p != nil {
+		return p.Path
+	}
+	return nil
+}
+
+func (o *Outliner) GetSnapshots(ctx context.Context, req *pb.RpcObjectImportRequest, progress process.Progress) (*common.Response, *common.ConvertError) {
+	paths := o.GetParams(req)
+	if len(paths) == 0 {
+		return nil, nil
+	}
+	progress.SetProgressMessage("Start creating snapshots from files")
+	allErrors := common.NewError(req.Mode)
+	c := newConverter()
+	var pages, journals []string
+	for _, p := range paths {
+		if err := progress.TryStep(1); err != nil {
+			allErrors.Add(common.ErrCancel)
+			return nil, allErrors
+		}
+		g := o.handleImportPath(p, len(paths), allErrors)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+		if g == nil {
+			continue
+		}
+		graphPages, graphJournals := c.convertGraph(g)
+		pages = append(pages, graphPages...)
+		journals = append(journals, graphJournals...)
+	}
+	snapshots := c.snapshots
+	rootCollection := common.NewImportCollection(o.collectionService)
+	if len(journals) > 0 {
+		settings := common.MakeImportCollectionSetting(journalsCollection, journals, "", nil, false, false, true)
+		journalsSnapshot, err := rootCollection.MakeImportCollection(settings)
+		if err != nil {
+			allErrors.Add(err)
+			if allErrors.ShouldAbortImport(len(paths), req.Type) {
+				return nil, allErrors
+			}
+		} else {
+			snapshots = append(snapshots, journalsSnapshot)
+			pages = append(pages, journalsSnapshot.Id)
+		}
+	}
+	settings := common.MakeImportCollectionSetting(rootCollectionName, pages, "", nil, true, true, true)
+	rootCol, err := rootCollection.MakeImportCollection(settings)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(len(paths), req.Type) {
+			return nil, allErrors
+		}
+	}
+	var rootCollectionID string
+	if rootCol != nil {
+		snapshots = append(snapshots, rootCol)
+		rootCollectionID = rootCol.Id
+	}
+	progress.SetTotal(int64(numberOfStages * len(snapshots)))
+	if allErrors.IsEmpty() {
+		return &common.Response{Snapshots: snapshots, RootCollectionID: rootCollectionID}, nil
+	}
+	return &common.Response{
+		Snapshots:        snapshots,
+		RootCollectionID: rootCollectionID,
+	}, allErrors
+}
+
+// handleImportPath reads pages of the graph. Roam exports the whole graph into a single JSON file,
+// while Logseq keeps every page in its own Markdown file
+func (o *Outliner) handleImportPath(p string, pathsCount int, allErrors *common.ConvertError) *graph {
+	importSource := source.GetSource(p)
+	defer importSource.Close()
+	err := importSource.Initialize(p)
+	if err != nil {
+		allErrors.Add(err)
+		if allErrors.ShouldAbortImport(pathsCount, model.Import_Outliner) {
+			return nil
+		}
+	}
+	if importSource.CountFilesWithGivenExtensions([]string{roamExtension, logseqExtension}) == 0 {
+		allErrors.Add(common.ErrorBySourceType(importSource))
+		return nil
+	}
+	g := newGraph(p)
+	iterateErr := importSource.Iterate(func(fileName string, fileReader io.ReadCloser) (isContinue bool) {
+		defer fileReader.Close()
+		var (
+			pages []*page
+			err   error
+		)
+		switch strings.ToLower(filepath.Ext(fileName)) {
+		case roamExtension:
+			pages, err = parseRoam(fileReader, fileName)
+		case logseqExtension:
+			if isLogseqServiceFile(fileName) {
+				return true
+			}
+			var pg *page
+			pg, err = parseLogseq(fileReader, fileName)
+			if pg != nil {
+				pages = []*page{pg}
+			}
+		default:
+			return true
+		}
+		if err != nil {
+			allErrors.Add(err)
+			return !allErrors.ShouldAbortImport(pathsCount, model.Import_Outliner)
+		}
+		for _, pg := range pages {
+			g.addPage(pg)
+		}
+		return true
+	})
+	if iterateErr != nil {
+		allErrors.Add(iterateErr)
+		return nil
+	}
+	if len(g.pages) == 0 {
+		return nil
+	}
+	// files are resolved while the source is still open
+	g.resolveAssets(importSource, o.tempDirProvider)
+	return g
+}
+
+// isLogseqServiceFile reports whether the file is a backup or a version of the page kept by Logseq
+func isLogseqServiceFile(fileName string) bool {
+	for _, dir := range strings.Split(filepath.ToSlash(filepath.Dir(fileName)), "/") {
+		if dir == "bak" || dir == "version-files" || dir == ".recycle" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,305 @@
+package outliner
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/mock_core"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+type importResult struct {
+	pages     map[string]*common.Snapshot
+	relations map[string]*common.StateSnapshot
+	options   map[string]string
+	journals  []string
+}
+
+func importGraph(t *testing.T, path string) *importResult {
+	o := &Outliner{tempDirProvider: mock_core.NewMockTempDirProvider(t)}
+	sn, err := o.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+		Params: &pb.RpcObjectImportRequestParamsOfOutlinerParams{
+			OutlinerParams: &pb.RpcObjectImportRequestOutlinerParams{Path: []string{path}},
+		},
+		Type: model.Import_Outliner,
+		Mode: pb.RpcObjectImportRequest_IGNORE_ERRORS,
+	}, process.NewNoOp())
+	require.Nil(t, err)
+	require.NotNil(t, sn)
+
+	result := &importResult{
+		pages:     make(map[string]*common.Snapshot),
+		relations: make(map[string]*common.StateSnapshot),
+		options:   make(map[string]string),
+	}
+	for _, snapshot := range sn.Snapshots {
+		data := snapshot.Snapshot.Data
+		name := data.Details.GetString(bundle.RelationKeyName)
+		switch {
+		case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelation:
+			result.relations[name] = data
+		case snapshot.Snapshot.SbType == smartblock.SmartBlockTypeRelationOption:
+			result.options[snapshot.Id] = name
+		case data.ObjectTypes[0] == bundle.TypeKeyCollection.String():
+			if name == journalsCollection {
+				result.journals = pbtypes.GetStringList(data.Collections, template.CollectionStoreKey)
+			}
+		default:
+			result.pages[name] = snapshot
+		}
+	}
+	return result
+}
+
+func relationKey(relation *common.StateSnapshot) domain.RelationKey {
+	return domain.RelationKey(relation.Details.GetString(bundle.RelationKeyRelationKey))
+}
+
+// childBlocks returns children of the block, or top level blocks of the page when the parent is empty
+func childBlocks(snapshot *common.Snapshot, parentId string) []*model.Block {
+	blocks := make(map[string]*model.Block)
+	isChild := make(map[string]bool)
+	for _, b := range snapshot.Snapshot.Data.Blocks {
+		blocks[b.Id] = b
+		for _, id := range b.ChildrenIds {
+			isChild[id] = true
+		}
+	}
+	var childIds []string
+	if parentId != "" {
+		childIds = blocks[parentId].ChildrenIds
+	} else {
+		for _, b := range snapshot.Snapshot.Data.Blocks {
+			if !isChild[b.Id] {
+				childIds = append(childIds, b.Id)
+			}
+		}
+	}
+	result := make([]*model.Block, 0, len(childIds))
+	for _, id := range childIds {
+		result = append(result, blocks[id])
+	}
+	return result
+}
+
+func TestOutliner_GetSnapshots(t *testing.T) {
+	t.Run("roam json export", func(t *testing.T) {
+		// when
+		result := importGraph(t, filepath.Join("testdata", "roam.json"))
+
+		// then
+		require.Len(t, result.pages, 3)
+		journal := result.pages["January 2nd, 2024"]
+		alice := result.pages["Alice"]
+		notes := result.pages["Meeting notes"]
+		require.NotNil(t, journal)
+		require.NotNil(t, alice)
+		require.NotNil(t, notes)
+		assert.Empty(t, notes.Snapshot.Data.Blocks)
+		assert.Equal(t, []string{journal.Id}, result.journals)
+
+		journalDate := time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local).Unix()
+		journalDateRelation := result.relations[journalDateProperty]
+		require.NotNil(t, journalDateRelation)
+		assert.Equal(t, int64(model.RelationFormat_date), journalDateRelation.Details.GetInt64(bundle.RelationKeyRelationFormat))
+		assert.Equal(t, journalDate, journal.Snapshot.Data.Details.GetInt64(relationKey(journalDateRelation)))
+		assert.True(t, pbtypes.RelationLinks(journal.Snapshot.Data.RelationLinks).Has(relationKey(journalDateRelation).String()))
+		assert.False(t, alice.Snapshot.Data.Details.Has(relationKey(journalDateRelation)))
+		assert.Equal(t, int64(1704189600), journal.Snapshot.Data.Details.GetInt64(bundle.RelationKeyLastModifiedDate))
+
+		blocks := childBlocks(journal, "")
+		require.Len(t, blocks, 2)
+		call := blocks[0].GetText()
+		assert.Equal(t, "call00001", blocks[0].Id)
+		assert.Equal(t, model.BlockContentText_Checkbox, call.Style)
+		assert.Equal(t, "Call Alice", call.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 5, To: 10}, Type: model.BlockContentTextMark_Mention, Param: alice.Id},
+		}, call.Marks.Marks)
+
+		assert.Equal(t, model.BlockContentText_Header2, blocks[1].GetText().Style)
+		steps := childBlocks(journal, blocks[1].Id)
+		require.Len(t, steps, 2)
+		assert.Equal(t, "First important step", steps[0].GetText().Text)
+		assert.Equal(t, model.BlockContentText_Numbered, steps[0].GetText().Style)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 6, To: 15}, Type: model.BlockContentTextMark_BackgroundColor, Param: "yellow"},
+		}, steps[0].GetText().Marks.Marks)
+		assert.Equal(t, model.Block_AlignCenter, steps[1].Align)
+
+		// attributes become relations
+		aliceDetails := alice.Snapshot.Data.Details
+		role := result.relations["Role"]
+		met := result.relations["Met"]
+		require.NotNil(t, role)
+		require.NotNil(t, met)
+		assert.Equal(t, int64(model.RelationFormat_longtext), role.Details.GetInt64(bundle.RelationKeyRelationFormat))
+		assert.Equal(t, int64(model.RelationFormat_date), met.Details.GetInt64(bundle.RelationKeyRelationFormat))
+		assert.Equal(t, "Engineer", aliceDetails.GetString(relationKey(role)))
+		assert.Equal(t, journalDate, aliceDetails.GetInt64(relationKey(met)))
+		assert.Len(t, alice.Snapshot.Data.RelationLinks, 2)
+
+		// block references link to the referenced block
+		aliceBlocks := childBlocks(alice, "")
+		require.Len(t, aliceBlocks, 2)
+		see := aliceBlocks[0].GetText()
+		assert.Equal(t, "See First important step and notes", see.Text)
+		assert.Contains(t, see.Marks.Marks, &model.BlockContentTextMark{
+			Range: &model.Range{From: 4, To: 24}, Type: model.BlockContentTextMark_Object, Param: journal.Id, FocusBlockId: steps[0].Id,
+		})
+		assert.Contains(t, see.Marks.Marks, &model.BlockContentTextMark{
+			Range: &model.Range{From: 29, To: 34}, Type: model.BlockContentTextMark_Object, Param: notes.Id,
+		})
+		assert.Equal(t, "Call Alice", aliceBlocks[1].GetText().Text)
+	})
+	t.Run("logseq graph", func(t *testing.T) {
+		// when
+		result := importGraph(t, filepath.Join("testdata", "logseq"))
+
+		// then
+		for _, name := range []string{"Books", "Projects/Anytype", "Jan 2nd, 2024", "Jan 3rd, 2024", "Dune", "John", "reading"} {
+			assert.Contains(t, result.pages, name)
+		}
+		assert.Len(t, result.pages, 7)
+		assert.Len(t, result.journals, 2)
+
+		books := result.pages["Books"]
+		journal := result.pages["Jan 2nd, 2024"]
+		details := books.Snapshot.Data.Details
+
+		// page properties
+		tags := details.GetStringList(bundle.RelationKeyTag)
+		require.Len(t, tags, 2)
+		assert.Equal(t, "reading", result.options[tags[0]])
+		assert.Equal(t, "library", result.options[tags[1]])
+		rating := result.relations["rating"]
+		website := result.relations["website"]
+		started := result.relations["started"]
+		require.NotNil(t, rating)
+		require.NotNil(t, website)
+		require.NotNil(t, started)
+		assert.Equal(t, float64(5), details.GetFloat64(relationKey(rating)))
+		assert.Equal(t, "https://example.com/books", details.GetString(relationKey(website)))
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local).Unix(), details.GetInt64(relationKey(started)))
+
+		// blocks
+		blocks := childBlocks(books, "")
+		require.Len(t, blocks, 4)
+		assert.Equal(t, model.BlockContentText_Header1, blocks[0].GetText().Style)
+		assert.Equal(t, "Reading list", blocks[0].GetText().Text)
+
+		task := blocks[1]
+		assert.Equal(t, "6512bd43-d9ca-4c1f-9c2a-1a2b3c4d5e6f", task.Id)
+		assert.Equal(t, model.BlockContentText_Checkbox, task.GetText().Style)
+		assert.False(t, task.GetText().Checked)
+		assert.Equal(t, "Read Dune", task.GetText().Text)
+		assert.Equal(t, result.pages["Dune"].Id, task.GetText().Marks.Marks[0].Param)
+
+		children := childBlocks(books, task.Id)
+		require.Len(t, children, 2)
+		assert.Equal(t, "Chapter one is slow\nbut it gets better", children[0].GetText().Text)
+		assert.Equal(t, model.BlockContentText_Marked, children[0].GetText().Style)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 8, To: 11}, Type: model.BlockContentTextMark_Bold},
+			{Range: &model.Range{From: 15, To: 19}, Type: model.BlockContentTextMark_Italic},
+		}, children[0].GetText().Marks.Marks)
+		assert.Equal(t, "Buy a copy", children[1].GetText().Text)
+		assert.True(t, children[1].GetText().Checked)
+
+		image := blocks[2].GetFile()
+		require.NotNil(t, image)
+		assert.Equal(t, model.BlockContentFile_Image, image.Type)
+		assert.Equal(t, filepath.Join("testdata", "logseq", "assets", "cover.png"), image.Name)
+
+		code := blocks[3]
+		assert.Equal(t, model.BlockContentText_Code, code.GetText().Style)
+		assert.Equal(t, "- not a block", code.GetText().Text)
+		assert.Equal(t, "yaml", code.Fields.Fields["lang"].GetStringValue())
+
+		// references in the journal
+		journalDateRelation := result.relations[journalDateProperty]
+		require.NotNil(t, journalDateRelation)
+		assert.Equal(t, time.Date(2024, 1, 2, 0, 0, 0, 0, time.Local).Unix(), journal.Snapshot.Data.Details.GetInt64(relationKey(journalDateRelation)))
+		journalBlocks := childBlocks(journal, "")
+		require.Len(t, journalBlocks, 2)
+		started0 := journalBlocks[0].GetText()
+		assert.Equal(t, "Started Read Dune with reading", started0.Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 8, To: 17}, Type: model.BlockContentTextMark_Object, Param: books.Id, FocusBlockId: task.Id},
+			{Range: &model.Range{From: 23, To: 30}, Type: model.BlockContentTextMark_Mention, Param: result.pages["reading"].Id},
+		}, started0.Marks.Marks)
+		met := journalBlocks[1].GetText()
+		assert.Equal(t, "Met with John on Jan 3rd, 2024 about Projects/Anytype", met.Text)
+		assert.Equal(t, result.pages["Projects/Anytype"].Id, met.Marks.Marks[2].Param)
+
+		project := childBlocks(result.pages["Projects/Anytype"], "")
+		require.Len(t, project, 1)
+		assert.Equal(t, "Work in progress, see https://anytype.io", project[0].GetText().Text)
+		assert.Equal(t, []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 22, To: 40}, Type: model.BlockContentTextMark_Link, Param: "https://anytype.io"},
+		}, project[0].GetText().Marks.Marks)
+	})
+}
+
+func TestInlineParser(t *testing.T) {
+	for _, tc := range []struct {
+		name, input, text string
+		marks             []*model.BlockContentTextMark
+	}{
+		{
+			name:  "snake case is not italic",
+			input: "use snake_case_names",
+			text:  "use snake_case_names",
+		},
+		{
+			name:  "code is not parsed",
+			input: "run `a **b**`",
+			text:  "run a **b**",
+			marks: []*model.BlockContentTextMark{
+				{Range: &model.Range{From: 4, To: 11}, Type: model.BlockContentTextMark_Keyboard},
+			},
+		},
+		{
+			name:  "markdown link",
+			input: "[Any **type**](https://anytype.io)",
+			text:  "Any type",
+			marks: []*model.BlockContentTextMark{
+				{Range: &model.Range{From: 4, To: 8}, Type: model.BlockContentTextMark_Bold},
+				{Range: &model.Range{From: 0, To: 8}, Type: model.BlockContentTextMark_Link, Param: "https://anytype.io"},
+			},
+		},
+		{
+			name:  "unknown block reference is kept",
+			input: "see ((missing))",
+			text:  "see ((missing))",
+		},
+		{
+			name:  "ranges are counted in utf-16",
+			input: "😀 **bold**",
+			text:  "😀 bold",
+			marks: []*model.BlockContentTextMark{
+				{Range: &model.Range{From: 3, To: 7}, Type: model.BlockContentTextMark_Bold},
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			p := newInlineParser(newGraph(""), 0)
+			p.parse(tc.input)
+			assert.Equal(t, tc.text, p.text())
+			assert.Equal(t, tc.marks, p.marks)
+		})
+	}
+}
@@ -0,0 +1,308 @@
+package outliner
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/globalsign/mgo/bson"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+const (
+	tagsProperty        = "tags"
+	journalDateProperty = "Journal date"
+)
+
+// pageBuiltinProperties describe the page itself and are not converted into relations
+var pageBuiltinProperties = map[string]struct{}{
+	titleProperty:             {},
+	aliasProperty:             {},
+	iconProperty:              {},
+	logseqIdProperty:          {},
+	"collapsed":               {},
+	"public":                  {},
+	"filters":                 {},
+	"exclude-from-graph-view": {},
+}
+
+var (
+	pageRefRegexp = regexp.MustCompile(`#\[\[([^\]]+)\]\]|\[\[([^\]]+)\]\]|#([^\s,#\[\]]+)`)
+
+	bundledRelations = makeBundledRelations()
+)
+
+// setProperties converts page properties into relations. Relations are matched by name
+// with bundled relations and relations created earlier in the import, if the value fits their format
+func (c *converter) setProperties(g *graph, pg *page, details *domain.Details) []*model.RelationLink {
+	var relationLinks []*model.RelationLink
+	for _, prop := range pg.properties {
+		key := strings.ToLower(prop.key)
+		if _, builtin := pageBuiltinProperties[key]; builtin || prop.value == "" {
+			continue
+		}
+		var (
+			rel   *model.Relation
+			value domain.Value
+		)
+		if key == tagsProperty {
+			rel = bundle.MustGetRelation(bundle.RelationKeyTag)
+			value = domain.StringList(c.provideOptions(rel.Key, splitPageNames(prop.value)))
+		} else {
+			rel, value = c.provideRelation(g, prop)
+		}
+		details.Set(domain.RelationKey(rel.Key), value)
+		if !pbtypes.RelationLinks(relationLinks).Has(rel.Key) {
+			relationLinks = append(relationLinks, &model.RelationLink{Key: rel.Key, Format: rel.Format})
+		}
+	}
+	return relationLinks
+}
+
+func (c *converter) provideRelation(g *graph, prop property) (*model.Relation, domain.Value) {
+	lowerName := strings.ToLower(prop.key)
+	if rel, ok := bundledRelations[lowerName]; ok {
+		if value, ok := c.convertValue(g, rel, prop.value); ok {
+			return rel, value
+		}
+	}
+	for _, rel := range c.relations[lowerName] {
+		if value, ok := c.convertValue(g, rel, prop.value); ok {
+			return rel, value
+		}
+	}
+	rel := c.newRelation(prop.key, inferFormat(prop.value))
+	value, _ := c.convertValue(g, rel, prop.value)
+	return rel, value
+}
+
+// journalDateRelation returns the date relation linking journal pages to the date objects of their days.
+// Date properties of pages with the same name share it
+func (c *converter) journalDateRelation() *model.Relation {
+	for _, rel := range c.relations[strings.ToLower(journalDateProperty)] {
+		if rel.Format == model.RelationFormat_date {
+			return rel
+		}
+	}
+	return c.newRelation(journalDateProperty, model.RelationFormat_date)
+}
+
+func (c *converter) newRelation(name string, format model.RelationFormat) *model.Relation {
+	key := bson.NewObjectId().Hex()
+	rel := &model.Relation{Key: key, Name: name, Format: format}
+	details := getRelationDetails(name, key, float64(rel.Format))
+	c.snapshots = append(c.snapshots, &common.Snapshot{
+		Id: details.GetString(bundle.RelationKeyId),
+		Snapshot: &common.SnapshotModel{
+			SbType: smartblock.SmartBlockTypeRelation,
+			Data: &common.StateSnapshot{
+				Details:     details,
+				ObjectTypes: []string{bundle.TypeKeyRelation.String()},
+				Key:         key,
+			},
+		},
+	})
+	lowerName := strings.ToLower(name)
+	c.relations[lowerName] = append(c.relations[lowerName], rel)
+	return rel
+}
+
+func (c *converter) convertValue(g *graph, rel *model.Relation, value string) (domain.Value, bool) {
+	switch rel.Format {
+	case model.RelationFormat_checkbox:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return domain.Bool(b), true
+		}
+	case model.RelationFormat_number:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return domain.Float64(f), true
+		}
+	case model.RelationFormat_date:
+		if date, ok := parseDate(value); ok {
+			return domain.Int64(date.Unix()), true
+		}
+	case model.RelationFormat_object:
+		if names, ok := pageRefs(value); ok {
+			ids := make([]string, 0, len(names))
+			for _, name := range names {
+				ids = append(ids, g.page(name).id)
+			}
+			return domain.StringList(ids), true
+		}
+	case model.RelationFormat_tag, model.RelationFormat_status:
+		names := splitPageNames(value)
+		if rel.Format == model.RelationFormat_status && len(names) > 1 {
+			return domain.Invalid(), false
+		}
+		return domain.StringList(c.provideOptions(rel.Key, names)), true
+	case model.RelationFormat_url:
+		if isURL(value) {
+			return domain.String(value), true
+		}
+	case model.RelationFormat_longtext, model.RelationFormat_shorttext:
+		return domain.String(value), true
+	}
+	return domain.Invalid(), false
+}
+
+func inferFormat(value string) model.RelationFormat {
+	if _, err := strconv.ParseBool(value); err == nil {
+		return model.RelationFormat_checkbox
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return model.RelationFormat_number
+	}
+	if _, ok := parseDate(value); ok {
+		return model.RelationFormat_date
+	}
+	if _, ok := pageRefs(value); ok {
+		return model.RelationFormat_object
+	}
+	if isURL(value) {
+		return model.RelationFormat_url
+	}
+	return model.RelationFormat_longtext
+}
+
+func (c *converter) provideOptions(relationKey string, names []string) []string {
+	options, ok := c.options[relationKey]
+	if !ok {
+		options = make(map[string]string)
+		c.options[relationKey] = options
+	}
+	ids := make([]string, 0, len(names))
+	for _, name := range names {
+		if id, ok := options[name]; ok {
+			ids = append(ids, id)
+			continue
+		}
+		key, details := getRelationOptionDetails(name, relationKey)
+		id := details.GetString(bundle.RelationKeyId)
+		c.snapshots = append(c.snapshots, &common.Snapshot{
+			Id: id,
+			Snapshot: &common.SnapshotModel{
+				SbType: smartblock.SmartBlockTypeRelationOption,
+				Data: &common.StateSnapshot{
+					Details:     details,
+					ObjectTypes: []string{bundle.TypeKeyRelationOption.String()},
+					Key:         key,
+				},
+			},
+		})
+		options[name] = id
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+// parseDate parses dates of journal pages, both written as text and as references
+func parseDate(value string) (time.Time, bool) {
+	if names, ok := pageRefs(value); ok && len(names) == 1 {
+		value = names[0]
+	}
+	if date, ok := parseJournalTitle(value); ok {
+		return date, true
+	}
+	if date, err := time.Parse(time.RFC3339, value); err == nil {
+		return date, true
+	}
+	return time.Time{}, false
+}
+
+// pageRefs returns titles of referenced pages, when the value consists only of references, like "[[Books]], #reading"
+func pageRefs(value string) ([]string, bool) {
+	var names []string
+	for _, match := range pageRefRegexp.FindAllStringSubmatch(value, -1) {
+		names = append(names, strings.TrimSpace(match[1]+match[2]+match[3]))
+	}
+	rest := pageRefRegexp.ReplaceAllString(value, "")
+	if len(names) == 0 || strings.Trim(rest, ", ") != "" {
+		return nil, false
+	}
+	return names, true
+}
+
+// splitPageNames returns names of the values, which are either references or comma separated
+func splitPageNames(value string) []string {
+	names, ok := pageRefs(value)
+	if !ok {
+		names = strings.Split(value, ",")
+	}
+	var (
+		result = make([]string, 0, len(names))
+		seen   = make(map[string]struct{}, len(names))
+	)
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if _, exists := seen[name]; exists || name == "" {
+			continue
+		}
+		seen[name] = struct{}{}
+		result = append(result, name)
+	}
+	return result
+}
+
+func getRelationDetails(name, key string, format float64) *domain.Details {
+	details := domain.NewDetails()
+	details.SetFloat64(bundle.RelationKeyRelationFormat, format)
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, key)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relation))
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelation, key)
+	if err != nil {
+		log.Warnf("failed to create unique key for outliner relation: %v", err)
+		return details
+	}
+	details.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	return details
+}
+
+func getRelationOptionDetails(name, relationKey string) (string, *domain.Details) {
+	id := bson.NewObjectId().Hex()
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, name)
+	details.SetString(bundle.RelationKeyRelationKey, relationKey)
+	details.SetInt64(bundle.RelationKeyLayout, int64(model.ObjectType_relationOption))
+	details.SetInt64(bundle.RelationKeyCreatedDate, time.Now().Unix())
+	uniqueKey, err := domain.NewUniqueKey(smartblock.SmartBlockTypeRelationOption, id)
+	if err != nil {
+		log.Warnf("failed to create unique key for outliner relation option: %v", err)
+		return id, details
+	}
+	details.SetString(bundle.RelationKeyId, uniqueKey.Marshal())
+	return id, details
+}
+
+// makeBundledRelations indexes editable bundled relations by lowercase name.
+// Relations sharing the same name are left out, as we can't choose between them
+func makeBundledRelations() map[string]*model.Relation {
+	relations := make(map[string]*model.Relation)
+	ambiguous := make(map[string]struct{})
+	for _, id := range bundle.ListRelationsUrls() {
+		key, err := bundle.RelationKeyFromID(id)
+		if err != nil {
+			continue
+		}
+		rel, err := bundle.GetRelation(key)
+		if err != nil || rel.Hidden || rel.ReadOnly || rel.Name == "" {
+			continue
+		}
+		name := strings.ToLower(rel.Name)
+		if _, exists := relations[name]; exists {
+			ambiguous[name] = struct{}{}
+		}
+		relations[name] = rel
+	}
+	for name := range ambiguous {
+		delete(relations, name)
+	}
+	return relations
+}
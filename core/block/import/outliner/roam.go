@@ -0,0 +1,102 @@
+package outliner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// roamJournalUidLayout is the uid of daily notes in Roam
+const roamJournalUidLayout = "01-02-2006"
+
+type roamPage struct {
+	Title      string       `json:"title"`
+	Uid        string       `json:"uid"`
+	CreateTime int64        `json:"create-time"`
+	EditTime   int64        `json:"edit-time"`
+	Children   []*roamBlock `json:"children"`
+}
+
+type roamBlock struct {
+	String    string       `json:"string"`
+	Uid       string       `json:"uid"`
+	Heading   int          `json:"heading"`
+	TextAlign string       `json:"text-align"`
+	ViewType  string       `json:"view-type"`
+	Children  []*roamBlock `json:"children"`
+}
+
+// parseRoam reads the JSON export of the Roam graph. Attributes of the top level blocks become page properties
+func parseRoam(r io.Reader, fileName string) ([]*page, error) {
+	var roamPages []*roamPage
+	if err := json.NewDecoder(r).Decode(&roamPages); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			// other JSON files, like Logseq assets, are skipped
+			log.With("file", filepath.Base(fileName)).Warnf("file is not a roam export: %v", err)
+			return nil, nil
+		}
+		return nil, fmt.Errorf("parse roam export: %w", err)
+	}
+	pages := make([]*page, 0, len(roamPages))
+	for _, rp := range roamPages {
+		title := strings.TrimSpace(rp.Title)
+		if title == "" {
+			continue
+		}
+		pg := &page{
+			title:      title,
+			file:       fileName,
+			sourcePath: filepath.Join(fileName, title),
+			created:    rp.CreateTime / 1000,
+			modified:   rp.EditTime / 1000,
+		}
+		if rp.Uid != "" {
+			pg.sourcePath = filepath.Join(fileName, rp.Uid)
+		}
+		if date, err := time.ParseInLocation(roamJournalUidLayout, rp.Uid, time.Local); err == nil {
+			pg.journal = date
+		}
+		for _, rb := range rp.Children {
+			if prop, ok := parseProperty(rb.String); ok && len(rb.Children) == 0 {
+				pg.properties = append(pg.properties, prop)
+				continue
+			}
+			pg.blocks = append(pg.blocks, rb.node())
+		}
+		pages = append(pages, pg)
+	}
+	return pages, nil
+}
+
+func (rb *roamBlock) node() *node {
+	n := &node{
+		uid:     rb.Uid,
+		text:    rb.String,
+		heading: rb.Heading,
+	}
+	switch rb.TextAlign {
+	case "center":
+		n.align = model.Block_AlignCenter
+	case "right":
+		n.align = model.Block_AlignRight
+	case "justify":
+		n.align = model.Block_AlignJustify
+	}
+	switch rb.ViewType {
+	case "numbered":
+		n.view = viewNumbered
+	case "document":
+		n.view = viewDocument
+	}
+	for _, child := range rb.Children {
+		n.children = append(n.children, child.node())
+	}
+	return n
+}
@@ -7,6 +7,7 @@ import (
 	"io"
 	"math/rand"
 	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -26,6 +27,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/archivecrypt"
 	"github.com/anyproto/anytype-heart/util/constant"
 	"github.com/anyproto/anytype-heart/util/slice"
 )
@@ -112,27 +114,61 @@ func (p *Pb) getSnapshots(
 			allErrors.Add(common.ErrCancel)
 			return nil, nil, nil
 		}
-		snapshots, widget, workspace := p.handleImportPath(len(path), path, allErrors, isMigration, params.GetImportType())
+		snapshots, widget, workspace := p.handleImportPath(len(path), path, params.GetPassword(), allErrors, isMigration, params.GetImportType())
 		if allErrors.ShouldAbortImport(len(params.GetPath()), model.Import_Pb) {
 			return nil, nil, nil
 		}
-		allSnapshots = append(allSnapshots, snapshots...)
+		allSnapshots = appendSnapshots(allSnapshots, snapshots)
 		widgetSnapshot = widget
 		workspaceSnapshot = workspace
 	}
-	return allSnapshots, widgetSnapshot, workspaceSnapshot
+	return excludeSnapshots(allSnapshots, params.GetExcludeObjectIds()), widgetSnapshot, workspaceSnapshot
+}
+
+func excludeSnapshots(snapshots []*common.Snapshot, excludeIds []string) []*common.Snapshot {
+	if len(excludeIds) == 0 {
+		return snapshots
+	}
+	return slices.DeleteFunc(snapshots, func(snapshot *common.Snapshot) bool {
+		return slices.Contains(excludeIds, snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyId))
+	})
+}
+
+// appendSnapshots replaces snapshots of the objects found in several archives with the snapshot from the last one,
+// so the chain of incremental backups is restored by importing its archives starting from the oldest one
+func appendSnapshots(allSnapshots, snapshots []*common.Snapshot) []*common.Snapshot {
+	if len(allSnapshots) == 0 {
+		return snapshots
+	}
+	newIds := make(map[string]struct{}, len(snapshots))
+	for _, snapshot := range snapshots {
+		if id := snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyId); id != "" {
+			newIds[id] = struct{}{}
+		}
+	}
+	allSnapshots = slices.DeleteFunc(allSnapshots, func(snapshot *common.Snapshot) bool {
+		_, replaced := newIds[snapshot.Snapshot.Data.Details.GetString(bundle.RelationKeyId)]
+		return replaced
+	})
+	return append(allSnapshots, snapshots...)
 }
 
 func (p *Pb) handleImportPath(
 	pathCount int,
-	path string,
+	path, password string,
 	allErrors *common.ConvertError,
 	isMigration bool,
 	importType pb.RpcObjectImportRequestPbParamsType,
 ) ([]*common.Snapshot, *common.Snapshot, *common.Snapshot) {
+	path, cleanup, err := p.decryptArchive(path, password)
+	if err != nil {
+		allErrors.Add(err)
+		return nil, nil, nil
+	}
+	defer cleanup()
 	importSource := source.GetSource(path)
 	defer importSource.Close()
-	err := p.extractFiles(path, importSource)
+	err = p.extractFiles(path, importSource)
 	if err != nil {
 		allErrors.Add(err)
 		if allErrors.ShouldAbortImport(pathCount, model.Import_Pb) {
@@ -164,6 +200,14 @@ func (p *Pb) handleImportPath(
 	return p.getSnapshotsFromProvidedFiles(pathCount, importSource, allErrors, path, profileID, needToImportWidgets, isMigration, importType)
 }
 
+// decryptArchive returns the path of the decrypted copy of the encrypted archive, other paths are returned as is
+func (p *Pb) decryptArchive(path, password string) (string, func(), error) {
+	if !archivecrypt.IsEncrypted(path) {
+		return path, func() {}, nil
+	}
+	return archivecrypt.DecryptedPath(path, p.tempDirProvider.TempDir(), password)
+}
+
 func (p *Pb) extractFiles(importPath string, importSource source.Source) error {
 	err := importSource.Initialize(importPath)
 	if err != nil {
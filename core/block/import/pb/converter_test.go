@@ -15,13 +15,17 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/anyproto/anytype-heart/core/block/import/common"
 	"github.com/anyproto/anytype-heart/core/block/import/common/test"
 	"github.com/anyproto/anytype-heart/core/block/process"
+	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/core/mock_core"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/archivecrypt"
 )
 
 func Test_GetSnapshotsSuccess(t *testing.T) {
@@ -256,6 +260,63 @@ func TestPb_GetSnapshots(t *testing.T) {
 		assert.NotNil(t, ce)
 		assert.True(t, errors.Is(ce.GetResultError(model.Import_Pb), common.ErrFileImportNoObjectsInZipArchive))
 	})
+	t.Run("encrypted archive", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		encryptedPath := newEncryptedArchive(t, dir, "secret")
+		tempDirProvider := mock_core.NewMockTempDirProvider(t)
+		tempDirProvider.EXPECT().TempDir().Return(dir)
+		p := &Pb{tempDirProvider: tempDirProvider}
+
+		// when
+		res, ce := p.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{
+				Path:     []string{encryptedPath},
+				Password: "secret",
+			}},
+		}, process.NewNoOp())
+
+		// then
+		assert.Nil(t, ce)
+		assert.Len(t, res.Snapshots, 2)
+		entries, err := os.ReadDir(dir)
+		assert.NoError(t, err)
+		assert.Len(t, entries, 1)
+	})
+	t.Run("encrypted archive with wrong password", func(t *testing.T) {
+		// given
+		dir := t.TempDir()
+		encryptedPath := newEncryptedArchive(t, dir, "secret")
+		tempDirProvider := mock_core.NewMockTempDirProvider(t)
+		tempDirProvider.EXPECT().TempDir().Return(dir)
+		p := &Pb{tempDirProvider: tempDirProvider}
+
+		// when
+		_, ce := p.GetSnapshots(context.Background(), &pb.RpcObjectImportRequest{
+			Params: &pb.RpcObjectImportRequestParamsOfPbParams{PbParams: &pb.RpcObjectImportRequestPbParams{
+				Path:     []string{encryptedPath},
+				Password: "wrong",
+			}},
+		}, process.NewNoOp())
+
+		// then
+		assert.NotNil(t, ce)
+		assert.Equal(t, model.Import_PB_WRONG_PASSWORD, common.GetImportNotificationErrorCode(ce.GetResultError(model.Import_Pb)))
+	})
+}
+
+func newEncryptedArchive(t *testing.T, dir, password string) string {
+	wr, err := newZipWriter(dir)
+	require.NoError(t, err)
+	f, err := os.Open(filepath.Join("testdata", "bafyreig5sd7mlmhindapjuvzc4gnetdbszztb755sa7nflojkljmu56mmi.pb"))
+	require.NoError(t, err)
+	defer f.Close()
+	require.NoError(t, wr.WriteFile("bafyreig5sd7mlmhindapjuvzc4gnetdbszztb755sa7nflojkljmu56mmi.pb", f))
+	require.NoError(t, wr.Close())
+	encryptedPath := filepath.Join(dir, "encrypted.zip")
+	require.NoError(t, archivecrypt.EncryptFile(wr.Path(), encryptedPath, password))
+	require.NoError(t, os.Remove(wr.Path()))
+	return encryptedPath
 }
 
 func newZipWriter(path string) (*zipWriter, error) {
@@ -299,3 +360,31 @@ func (d *zipWriter) Close() (err error) {
 	}
 	return d.f.Close()
 }
+
+func TestAppendSnapshots(t *testing.T) {
+	newSnapshot := func(id, name string) *common.Snapshot {
+		return &common.Snapshot{
+			Id: id + name,
+			Snapshot: &common.SnapshotModel{
+				Data: &common.StateSnapshot{
+					Details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+						bundle.RelationKeyId:   domain.String(id),
+						bundle.RelationKeyName: domain.String(name),
+					}),
+				},
+			},
+		}
+	}
+	allSnapshots := appendSnapshots(nil, []*common.Snapshot{newSnapshot("id1", "first"), newSnapshot("id2", "first")})
+	allSnapshots = appendSnapshots(allSnapshots, []*common.Snapshot{newSnapshot("id2", "second"), newSnapshot("id3", "second")})
+
+	var names []string
+	for _, snapshot := range allSnapshots {
+		names = append(names, snapshot.Id)
+	}
+	assert.Equal(t, []string{"id1first", "id2second", "id3second"}, names)
+
+	allSnapshots = excludeSnapshots(allSnapshots, []string{"id1", "id3"})
+	assert.Len(t, allSnapshots, 1)
+	assert.Equal(t, "id2second", allSnapshots[0].Id)
+}
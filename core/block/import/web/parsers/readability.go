@@ -0,0 +1,587 @@
+package parsers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+	"golang.org/x/net/html"
+
+	"github.com/anyproto/anytype-heart/core/block/import/common"
+	"github.com/anyproto/anytype-heart/core/block/import/markdown/anymark"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/linkpreview"
+)
+
+const (
+	maxBylineLength = 100
+	// paragraphs shorter than minParagraphLength don't affect the score of their parents
+	minParagraphLength = 25
+	// article elements with less text are considered as teasers and the content is searched by scores
+	minArticleLength = 250
+)
+
+var (
+	reUnlikelyCandidates = regexp.MustCompile(`(?i)-ad-|ai2html|banner|breadcrumbs|combx|comment|community|cover-wrap|disqus|extra|footer|gdpr|header|legends|menu|related|remark|replies|rss|shoutbox|sidebar|skyscraper|social|sponsor|supplemental|ad-break|agegate|pagination|pager|popup|yom-remote|newsletter|subscribe|share`)
+	reMaybeCandidate     = regexp.MustCompile(`(?i)and|article|body|column|content|main|shadow`)
+	rePositive           = regexp.MustCompile(`(?i)article|body|content|entry|hentry|h-entry|main|page|post|text|blog|story`)
+	reNegative           = regexp.MustCompile(`(?i)-ad-|hidden|^hid$| hid$| hid |^hid |banner|combx|comment|com-|contact|foot|footer|footnote|gdpr|masthead|media|meta|outbrain|promo|related|scroll|share|shoutbox|sidebar|skyscraper|sponsor|shopping|tags|tool|widget`)
+)
+
+// elements that never contain the content of the article
+const removedElements = "script, style, noscript, template, iframe, form, button, input, select, textarea, svg, canvas, nav, aside, footer, link, meta, object, embed, [hidden], [aria-hidden=true]"
+
+// Article is the readable content of the web page with its metadata
+type Article struct {
+	Title       string
+	Description string
+	Author      string
+	Published   time.Time
+	// Url is the canonical address of the article, the fetched address is used if the page doesn't provide it
+	Url    string
+	Blocks []*model.Block
+}
+
+var errNotHtmlPage = errors.New("url doesn't point to the html page")
+
+// ReadabilityParser extracts the main content of any web page, it is used when there is no specific parser for the url.
+// Pages are fetched by the link preview, so the same limits and decoding are applied as for bookmarks
+type ReadabilityParser struct {
+	linkPreview linkpreview.LinkPreview
+}
+
+func NewReadabilityParser() Parser {
+	lp := linkpreview.New()
+	// link preview doesn't depend on other components
+	_ = lp.Init(nil)
+	return &ReadabilityParser{linkPreview: lp}
+}
+
+func init() {
+	RegisterFunc(NewReadabilityParser)
+}
+
+func (r *ReadabilityParser) MatchUrl(rawUrl string) bool {
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func (r *ReadabilityParser) ParseUrl(rawUrl string) (*common.StateSnapshot, error) {
+	_, body, isFile, err := r.linkPreview.Fetch(context.Background(), rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("ReadabilityParser: ParseUrl: %w", err)
+	}
+	if isFile {
+		return nil, fmt.Errorf("ReadabilityParser: ParseUrl: %w", errNotHtmlPage)
+	}
+	article, err := ParseArticle(body, rawUrl)
+	if err != nil {
+		return nil, fmt.Errorf("ReadabilityParser: ParseUrl: %w", err)
+	}
+	return article.snapshot(), nil
+}
+
+func (a *Article) snapshot() *common.StateSnapshot {
+	details := domain.NewDetails()
+	details.SetString(bundle.RelationKeyName, a.Title)
+	details.SetString(bundle.RelationKeySource, a.Url)
+	details.SetString(bundle.RelationKeyType, bundle.TypeKeyBookmark.String())
+	relationLinks := []*model.RelationLink{bundle.MustGetRelationLink(bundle.RelationKeySource)}
+	if a.Description != "" {
+		details.SetString(bundle.RelationKeyDescription, a.Description)
+	}
+	if a.Author != "" {
+		details.SetString(bundle.RelationKeyAuthorName, a.Author)
+		relationLinks = append(relationLinks, bundle.MustGetRelationLink(bundle.RelationKeyAuthorName))
+	}
+	if !a.Published.IsZero() {
+		details.SetInt64(bundle.RelationKeyPublishedDate, a.Published.Unix())
+		relationLinks = append(relationLinks, bundle.MustGetRelationLink(bundle.RelationKeyPublishedDate))
+	}
+	return &common.StateSnapshot{
+		Blocks:        a.Blocks,
+		Details:       details,
+		RelationLinks: relationLinks,
+	}
+}
+
+// ParseArticle extracts the article from the html page: metadata is read from meta tags and JSON-LD,
+// the content is the element with the best score of paragraphs, similar to the Readability algorithm.
+// Relative links and images are resolved against pageUrl
+func ParseArticle(body []byte, pageUrl string) (*Article, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parse html: %w", err)
+	}
+	article := &Article{Url: pageUrl}
+	readMetadata(doc, article)
+
+	content := extractContent(doc)
+	removeTitleHeading(content, article.Title)
+	resolveLinks(content, pageUrl)
+	contentHtml, err := content.Html()
+	if err != nil {
+		return nil, fmt.Errorf("render content: %w", err)
+	}
+	article.Blocks, _, err = anymark.HTMLToBlocks([]byte(contentHtml), pageUrl)
+	if err != nil {
+		return nil, fmt.Errorf("convert content to blocks: %w", err)
+	}
+	return article, nil
+}
+
+// ParseArticleMetadata reads only the metadata of the article, the content of the page is left as is
+func ParseArticleMetadata(body []byte, pageUrl string) (*Article, error) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("parse html: %w", err)
+	}
+	article := &Article{Url: pageUrl}
+	readMetadata(doc, article)
+	return article, nil
+}
+
+type linkedData struct {
+	headline      string
+	description   string
+	author        string
+	datePublished string
+}
+
+func readMetadata(doc *goquery.Document, article *Article) {
+	ld := readLinkedData(doc)
+	article.Title = firstNonEmpty(
+		metaContent(doc, "og:title", "twitter:title", "dc.title"),
+		ld.headline,
+		strings.TrimSpace(doc.Find("title").First().Text()),
+		strings.TrimSpace(doc.Find("h1").First().Text()),
+	)
+	article.Description = firstNonEmpty(
+		metaContent(doc, "og:description", "description", "twitter:description"),
+		ld.description,
+	)
+	article.Author = firstNonEmpty(
+		personName(metaContent(doc, "author", "article:author", "parsely-author", "sailthru.author", "dc.creator")),
+		ld.author,
+		byline(doc),
+	)
+	article.Published = parseDate(firstNonEmpty(
+		metaContent(doc, "article:published_time", "datepublished", "pubdate", "publishdate", "parsely-pub-date", "sailthru.date", "dc.date", "date"),
+		ld.datePublished,
+		doc.Find("time[datetime]").First().AttrOr("datetime", ""),
+	))
+	canonical := firstNonEmpty(
+		doc.Find("link[rel=canonical]").First().AttrOr("href", ""),
+		metaContent(doc, "og:url"),
+	)
+	if u := resolveUrl(article.Url, canonical); u != "" {
+		article.Url = u
+	}
+}
+
+// metaContent returns the content of the first meta tag with one of the names, names are checked in the given order
+func metaContent(doc *goquery.Document, names ...string) string {
+	values := make(map[string]string)
+	doc.Find("meta[content]").Each(func(_ int, meta *goquery.Selection) {
+		content := strings.TrimSpace(meta.AttrOr("content", ""))
+		if content == "" {
+			return
+		}
+		for _, attr := range []string{"property", "name", "itemprop"} {
+			name := strings.ToLower(strings.TrimSpace(meta.AttrOr(attr, "")))
+			if _, ok := values[name]; name != "" && !ok {
+				values[name] = content
+			}
+		}
+	})
+	for _, name := range names {
+		if value := values[name]; value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+func readLinkedData(doc *goquery.Document) linkedData {
+	var ld linkedData
+	doc.Find(`script[type="application/ld+json"]`).EachWithBreak(func(_ int, script *goquery.Selection) bool {
+		var data interface{}
+		if err := json.Unmarshal([]byte(script.Text()), &data); err != nil {
+			return true
+		}
+		for _, obj := range linkedDataObjects(data) {
+			if obj["headline"] == nil && obj["datePublished"] == nil {
+				continue
+			}
+			ld.headline = jsonString(obj["headline"])
+			ld.description = jsonString(obj["description"])
+			ld.author = jsonName(obj["author"])
+			ld.datePublished = jsonString(obj["datePublished"])
+			return false
+		}
+		return true
+	})
+	return ld
+}
+
+// linkedDataObjects flattens the JSON-LD document, which can be a single object, an array or a graph of objects
+func linkedDataObjects(data interface{}) []map[string]interface{} {
+	switch v := data.(type) {
+	case []interface{}:
+		var objects []map[string]interface{}
+		for _, item := range v {
+			objects = append(objects, linkedDataObjects(item)...)
+		}
+		return objects
+	case map[string]interface{}:
+		if graph, ok := v["@graph"]; ok {
+			return linkedDataObjects(graph)
+		}
+		return []map[string]interface{}{v}
+	}
+	return nil
+}
+
+func jsonString(value interface{}) string {
+	s, _ := value.(string)
+	return strings.TrimSpace(s)
+}
+
+// jsonName returns the name of the person, which is either a string or an object with the name, or the first of them
+func jsonName(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case map[string]interface{}:
+		return jsonString(v["name"])
+	case []interface{}:
+		for _, item := range v {
+			if name := jsonName(item); name != "" {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+func byline(doc *goquery.Document) string {
+	var author string
+	doc.Find(`[rel=author], [itemprop=author], .byline, .author`).EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		text := strings.Join(strings.Fields(s.Text()), " ")
+		text = strings.TrimSpace(strings.TrimPrefix(text, "By "))
+		if text != "" && utf8.RuneCountInString(text) <= maxBylineLength {
+			author = text
+			return false
+		}
+		return true
+	})
+	return author
+}
+
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	time.RFC1123,
+	"January 2, 2006",
+	"Jan 2, 2006",
+	"2 January 2006",
+}
+
+func parseDate(value string) time.Time {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// extractContent removes the boilerplate of the page and returns the element with the article
+func extractContent(doc *goquery.Document) *goquery.Selection {
+	doc.Find(removedElements).Remove()
+	// headers of the article contain its title and byline, only headers of the page are removed
+	doc.Find("header").Each(func(_ int, header *goquery.Selection) {
+		if header.Closest("article").Length() == 0 {
+			header.Remove()
+		}
+	})
+	removeUnlikelyCandidates(doc)
+	fixLazyImages(doc)
+
+	content := articleElement(doc)
+	if content == nil {
+		content = topCandidate(doc)
+	}
+	cleanContent(content)
+	return content
+}
+
+func removeUnlikelyCandidates(doc *goquery.Document) {
+	doc.Find("body *").Each(func(_ int, s *goquery.Selection) {
+		switch goquery.NodeName(s) {
+		case "a", "article", "main", "table", "thead", "tbody", "tr", "td", "th", "pre", "code", "img", "figure", "li":
+			return
+		}
+		if s.Closest("table, pre, code").Length() > 0 {
+			return
+		}
+		matchString := s.AttrOr("class", "") + " " + s.AttrOr("id", "")
+		if reUnlikelyCandidates.MatchString(matchString) && !reMaybeCandidate.MatchString(matchString) {
+			s.Remove()
+		}
+	})
+}
+
+// fixLazyImages moves sources of lazy loaded images to the src attribute
+func fixLazyImages(doc *goquery.Document) {
+	doc.Find("img").Each(func(_ int, img *goquery.Selection) {
+		for _, attr := range []string{"data-src", "data-original", "data-lazy-src", "data-url"} {
+			if src := strings.TrimSpace(img.AttrOr(attr, "")); src != "" {
+				img.SetAttr("src", src)
+				return
+			}
+		}
+	})
+}
+
+// articleElement returns the longest element marked up as the article body
+func articleElement(doc *goquery.Document) *goquery.Selection {
+	for _, selector := range []string{"[itemprop=articleBody]", "article", "main, [role=main]"} {
+		var (
+			best       *goquery.Selection
+			bestLength int
+		)
+		doc.Find(selector).Each(func(_ int, s *goquery.Selection) {
+			if length := textLength(s); length > bestLength {
+				best, bestLength = s, length
+			}
+		})
+		if bestLength >= minArticleLength {
+			return best
+		}
+	}
+	return nil
+}
+
+// topCandidate scores parents of paragraphs by the amount of text in them and returns the best one
+// together with its siblings that look like the part of the article
+func topCandidate(doc *goquery.Document) *goquery.Selection {
+	scores := make(map[*html.Node]float64)
+	var candidates []*goquery.Selection
+	addScore := func(s *goquery.Selection, score float64) {
+		if s.Length() == 0 || goquery.NodeName(s) == "html" {
+			return
+		}
+		node := s.Get(0)
+		if _, ok := scores[node]; !ok {
+			scores[node] = initialScore(s)
+			candidates = append(candidates, s)
+		}
+		scores[node] += score
+	}
+	doc.Find("p, pre, td, blockquote").Each(func(_ int, p *goquery.Selection) {
+		text := strings.TrimSpace(p.Text())
+		length := utf8.RuneCountInString(text)
+		if length < minParagraphLength {
+			return
+		}
+		score := 1 + float64(strings.Count(text, ",")) + math.Min(float64(length)/100, 3)
+		parent := p.Parent()
+		addScore(parent, score)
+		addScore(parent.Parent(), score/2)
+	})
+
+	var (
+		top      *goquery.Selection
+		topScore float64
+	)
+	for _, candidate := range candidates {
+		score := scores[candidate.Get(0)] * (1 - linkDensity(candidate))
+		scores[candidate.Get(0)] = score
+		if top == nil || score > topScore {
+			top, topScore = candidate, score
+		}
+	}
+	if top == nil {
+		return doc.Find("body")
+	}
+	if goquery.NodeName(top) == "body" {
+		return top
+	}
+
+	// content of the article is sometimes split between sibling elements
+	threshold := math.Max(10, topScore*0.2)
+	siblings := top.Parent().Children()
+	top.BeforeHtml("<div></div>")
+	content := top.Prev()
+	siblings.Each(func(_ int, sibling *goquery.Selection) {
+		if sibling.Get(0) == top.Get(0) || isArticleSibling(sibling, scores, threshold) {
+			content.AppendSelection(sibling)
+		}
+	})
+	return content
+}
+
+func isArticleSibling(s *goquery.Selection, scores map[*html.Node]float64, threshold float64) bool {
+	if score, ok := scores[s.Get(0)]; ok && score >= threshold {
+		return true
+	}
+	if goquery.NodeName(s) != "p" {
+		return false
+	}
+	length := textLength(s)
+	density := linkDensity(s)
+	if length > 80 && density < 0.25 {
+		return true
+	}
+	text := strings.TrimSpace(s.Text())
+	return length > 0 && density == 0 && (strings.HasSuffix(text, ".") || strings.HasSuffix(text, "!") || strings.HasSuffix(text, "?"))
+}
+
+func initialScore(s *goquery.Selection) float64 {
+	var score float64
+	switch goquery.NodeName(s) {
+	case "div", "article", "section":
+		score = 5
+	case "pre", "td", "blockquote":
+		score = 3
+	case "address", "ol", "ul", "dl", "dd", "dt", "li", "form":
+		score = -3
+	case "h1", "h2", "h3", "h4", "h5", "h6", "th":
+		score = -5
+	}
+	return score + classWeight(s)
+}
+
+func classWeight(s *goquery.Selection) float64 {
+	var weight float64
+	for _, value := range []string{s.AttrOr("class", ""), s.AttrOr("id", "")} {
+		if value == "" {
+			continue
+		}
+		if reNegative.MatchString(value) {
+			weight -= 25
+		}
+		if rePositive.MatchString(value) {
+			weight += 25
+		}
+	}
+	return weight
+}
+
+// cleanContent removes bylines, blocks of links, like lists of related articles, and elements with negative class names
+func cleanContent(content *goquery.Selection) {
+	// author is stored in the relation of the object
+	content.Find(".byline").Remove()
+	content.Find("div, section, ul, ol, table").Each(func(_ int, s *goquery.Selection) {
+		if s.Find("img, pre, table").Length() > 0 && classWeight(s) >= 0 {
+			return
+		}
+		length := textLength(s)
+		if classWeight(s) < 0 && length < minArticleLength {
+			s.Remove()
+			return
+		}
+		if length < 200 && linkDensity(s) > 0.5 {
+			s.Remove()
+		}
+	})
+}
+
+// removeTitleHeading removes the heading with the title of the article, the title is used as the name of the object
+func removeTitleHeading(content *goquery.Selection, title string) {
+	title = strings.Join(strings.Fields(title), " ")
+	if title == "" {
+		return
+	}
+	content.Find("h1, h2").EachWithBreak(func(_ int, heading *goquery.Selection) bool {
+		if strings.EqualFold(strings.Join(strings.Fields(heading.Text()), " "), title) {
+			heading.Remove()
+			return false
+		}
+		return true
+	})
+}
+
+// resolveLinks makes links and sources of images absolute, the html converter only supports paths from the root
+func resolveLinks(content *goquery.Selection, pageUrl string) {
+	for attr, selector := range map[string]string{"href": "a[href]", "src": "img[src]"} {
+		content.Find(selector).Each(func(_ int, s *goquery.Selection) {
+			if resolved := resolveUrl(pageUrl, s.AttrOr(attr, "")); resolved != "" {
+				s.SetAttr(attr, resolved)
+			}
+		})
+	}
+}
+
+func textLength(s *goquery.Selection) int {
+	return utf8.RuneCountInString(strings.TrimSpace(s.Text()))
+}
+
+func linkDensity(s *goquery.Selection) float64 {
+	length := textLength(s)
+	if length == 0 {
+		return 0
+	}
+	var linkLength int
+	s.Find("a").Each(func(_ int, a *goquery.Selection) {
+		linkLength += textLength(a)
+	})
+	return float64(linkLength) / float64(length)
+}
+
+func resolveUrl(base, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+	refUrl, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	baseUrl, err := url.Parse(base)
+	if err != nil {
+		return refUrl.String()
+	}
+	return baseUrl.ResolveReference(refUrl).String()
+}
+
+// personName filters out links to profiles, which are used by article:author and similar tags instead of names
+func personName(value string) string {
+	if u, err := url.Parse(value); err == nil && u.Scheme != "" && u.Host != "" {
+		return ""
+	}
+	return value
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,159 @@
+package parsers
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func parseFixture(t *testing.T, name, pageUrl string) *Article {
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	article, err := ParseArticle(body, pageUrl)
+	require.NoError(t, err)
+	return article
+}
+
+func blocksText(blocks []*model.Block) string {
+	var texts []string
+	for _, b := range blocks {
+		if text := b.GetText(); text != nil {
+			texts = append(texts, text.Text)
+		}
+	}
+	return strings.Join(texts, "\n")
+}
+
+func TestParseArticle(t *testing.T) {
+	t.Run("article element with meta tags", func(t *testing.T) {
+		// when
+		article := parseFixture(t, "article.html", "https://garden.example.com/articles/tomatoes?utm_source=feed")
+
+		// then
+		assert.Equal(t, "How to grow tomatoes", article.Title)
+		assert.Equal(t, "A short guide to growing tomatoes at home", article.Description)
+		assert.Equal(t, "Jane Gardener", article.Author)
+		assert.Equal(t, time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC).Unix(), article.Published.Unix())
+		assert.Equal(t, "https://garden.example.com/articles/tomatoes", article.Url)
+
+		styles := map[model.BlockContentTextStyle][]string{}
+		var (
+			images int
+			tables int
+		)
+		for _, b := range article.Blocks {
+			if text := b.GetText(); text != nil {
+				styles[text.Style] = append(styles[text.Style], text.Text)
+			}
+			if file := b.GetFile(); file != nil && file.Type == model.BlockContentFile_Image {
+				assert.Equal(t, "https://garden.example.com/images/tomatoes.jpg", file.Name)
+				images++
+			}
+			if b.GetTable() != nil {
+				tables++
+			}
+		}
+		assert.Equal(t, []string{"Choosing the variety", "Watering schedule"}, styles[model.BlockContentText_Header2])
+		assert.Equal(t, []string{"Cherry tomatoes", "Plum tomatoes", "Beefsteak tomatoes"}, styles[model.BlockContentText_Marked])
+		require.Len(t, styles[model.BlockContentText_Code], 1)
+		assert.Contains(t, styles[model.BlockContentText_Code][0], "morning: 1 liter")
+		assert.Equal(t, []string{"Water the soil, not the leaves."}, styles[model.BlockContentText_Quote])
+		assert.Equal(t, 1, images)
+		assert.Equal(t, 1, tables)
+
+		text := blocksText(article.Blocks)
+		assert.Contains(t, text, "first fruits can be picked in July")
+		for _, boilerplate := range []string{"How to grow tomatoes", "By Jane Gardener", "Garden Weekly", "cookies", "Related articles", "Great article", "Copyright"} {
+			assert.NotContains(t, text, boilerplate)
+		}
+	})
+	t.Run("scored content with JSON-LD", func(t *testing.T) {
+		// when
+		article := parseFixture(t, "blog.html", "https://travel.example.com/2023/alps/")
+
+		// then
+		assert.Equal(t, "Notes from the mountains", article.Title)
+		assert.Equal(t, "A week of hiking in the Alps", article.Description)
+		assert.Equal(t, "Alex Walker", article.Author)
+		assert.Equal(t, time.Date(2023, 8, 20, 16, 0, 0, 0, time.UTC).Unix(), article.Published.Unix())
+		assert.Equal(t, "https://travel.example.com/2023/alps/", article.Url)
+
+		var (
+			numbered []string
+			images   []string
+		)
+		for _, b := range article.Blocks {
+			if text := b.GetText(); text != nil && text.Style == model.BlockContentText_Numbered {
+				numbered = append(numbered, text.Text)
+			}
+			if file := b.GetFile(); file != nil && file.Type == model.BlockContentFile_Image {
+				images = append(images, file.Name)
+			}
+		}
+		assert.Equal(t, []string{"Warm jacket", "Water bottle"}, numbered)
+		assert.Equal(t, []string{"https://travel.example.com/2023/alps/photos/glacier.jpg"}, images)
+
+		text := blocksText(article.Blocks)
+		assert.Contains(t, text, "We started the trip in a small village")
+		assert.Contains(t, text, "promised to return next summer")
+		for _, boilerplate := range []string{"Home", "Subscribe", "Beautiful photos", "Fjords of Norway"} {
+			assert.NotContains(t, text, boilerplate)
+		}
+	})
+}
+
+func TestParseArticleMetadata(t *testing.T) {
+	// given
+	body, err := os.ReadFile(filepath.Join("testdata", "article.html"))
+	require.NoError(t, err)
+
+	// when
+	article, err := ParseArticleMetadata(body, "https://garden.example.com/articles/tomatoes")
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "How to grow tomatoes", article.Title)
+	assert.Equal(t, "Jane Gardener", article.Author)
+	assert.Equal(t, time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC).Unix(), article.Published.Unix())
+	assert.Empty(t, article.Blocks)
+}
+
+func TestReadabilityParser_snapshot(t *testing.T) {
+	// given
+	article := parseFixture(t, "article.html", "https://garden.example.com/articles/tomatoes")
+
+	// when
+	snapshot := article.snapshot()
+
+	// then
+	assert.Equal(t, "How to grow tomatoes", snapshot.Details.GetString(bundle.RelationKeyName))
+	assert.Equal(t, "https://garden.example.com/articles/tomatoes", snapshot.Details.GetString(bundle.RelationKeySource))
+	assert.Equal(t, "Jane Gardener", snapshot.Details.GetString(bundle.RelationKeyAuthorName))
+	assert.Equal(t, article.Published.Unix(), snapshot.Details.GetInt64(bundle.RelationKeyPublishedDate))
+	var keys []string
+	for _, link := range snapshot.RelationLinks {
+		keys = append(keys, link.Key)
+	}
+	assert.ElementsMatch(t, []string{
+		bundle.RelationKeySource.String(),
+		bundle.RelationKeyAuthorName.String(),
+		bundle.RelationKeyPublishedDate.String(),
+	}, keys)
+	assert.Equal(t, article.Blocks, snapshot.Blocks)
+}
+
+func TestReadabilityParser_MatchUrl(t *testing.T) {
+	p := NewReadabilityParser()
+	assert.True(t, p.MatchUrl("https://example.com/article"))
+	assert.True(t, p.MatchUrl("http://example.com"))
+	assert.False(t, p.MatchUrl("file:///home/user/page.html"))
+	assert.False(t, p.MatchUrl("not a url"))
+}
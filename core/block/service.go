@@ -41,6 +41,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/core"
 	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/addr"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
 	"github.com/anyproto/anytype-heart/pkg/lib/logging"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
@@ -52,6 +53,7 @@ import (
 	_ "github.com/anyproto/anytype-heart/core/block/editor/table"
 	_ "github.com/anyproto/anytype-heart/core/block/simple/file"
 	_ "github.com/anyproto/anytype-heart/core/block/simple/link"
+	_ "github.com/anyproto/anytype-heart/core/block/simple/synced"
 	_ "github.com/anyproto/anytype-heart/core/block/simple/widget"
 )
 
@@ -167,7 +169,9 @@ func (s *Service) GetObjectByFullID(ctx context.Context, id domain.FullID) (sb s
 	return spc.GetObject(ctx, id.ObjectID)
 }
 
-func (s *Service) OpenBlock(sctx session.Context, id domain.FullID, includeRelationsAsDependentObjects bool) (obj *model.ObjectView, err error) {
+// OpenBlock opens the object for the session. focusBlockId is returned in the object view if the object has the block,
+// so clients can scroll to the target of the block link
+func (s *Service) OpenBlock(sctx session.Context, id domain.FullID, includeRelationsAsDependentObjects bool, focusBlockId string) (obj *model.ObjectView, err error) {
 	id = s.resolveFullId(id)
 	startTime := time.Now()
 	err = s.DoFullId(id, func(ob smartblock.SmartBlock) error {
@@ -198,6 +202,9 @@ func (s *Service) OpenBlock(sctx session.Context, id domain.FullID, includeRelat
 		if v, ok := ob.(withVirtualBlocks); ok {
 			v.InjectVirtualBlocks(id.ObjectID, obj)
 		}
+		if focusBlockId != "" && ob.Pick(focusBlockId) != nil {
+			obj.FocusBlockId = focusBlockId
+		}
 
 		afterHashesTime := time.Now()
 		metrics.Service.Send(&metrics.OpenBlockEvent{
@@ -618,6 +625,10 @@ func (s *Service) CreateObjectFromUrl(ctx context.Context, req *pb.RpcObjectCrea
 			return "", nil, err
 		}
 	}
+	err = s.setArticleDetails(ctx, req.SpaceId, id, details, objectDetails, content)
+	if err != nil {
+		return "", nil, err
+	}
 
 	if content != nil && len(content.Blocks) > 0 {
 		err := s.pasteBlocks(id, content)
@@ -675,6 +686,36 @@ func (s *Service) updateBookmarkContentWithUserDetails(userDetails, objectDetail
 	return shouldUpdate
 }
 
+// setArticleDetails sets the author and the publication date of the clipped article. Their relations are bundled,
+// so they are installed to the space first
+func (s *Service) setArticleDetails(ctx context.Context, spaceId, id string, userDetails, objectDetails *domain.Details, content *bookmark.ObjectContent) error {
+	articleDetails := domain.NewDetails()
+	if content.Author != "" && !userDetails.Has(bundle.RelationKeyAuthorName) {
+		articleDetails.SetString(bundle.RelationKeyAuthorName, content.Author)
+	}
+	if content.PublishedDate != 0 && !userDetails.Has(bundle.RelationKeyPublishedDate) {
+		articleDetails.SetInt64(bundle.RelationKeyPublishedDate, content.PublishedDate)
+	}
+	if articleDetails.Len() == 0 {
+		return nil
+	}
+	sourceIds := make([]string, 0, articleDetails.Len())
+	for _, key := range articleDetails.Keys() {
+		sourceIds = append(sourceIds, addr.BundledRelationURLPrefix+key.String())
+	}
+	if _, _, err := s.SpaceInstallBundledObjects(ctx, spaceId, sourceIds); err != nil {
+		return fmt.Errorf("install article relations: %w", err)
+	}
+	return cache.Do(s, id, func(sb smartblock.SmartBlock) error {
+		st := sb.NewState()
+		for key, value := range articleDetails.Iterate() {
+			st.SetDetailAndBundledRelation(key, value)
+			objectDetails.Set(key, value)
+		}
+		return sb.Apply(st)
+	})
+}
+
 func (s *Service) replaceLink(id, oldId, newId string) error {
 	return cache.Do(s, id, func(b basic.CommonOperations) error {
 		return b.ReplaceLink(oldId, newId)
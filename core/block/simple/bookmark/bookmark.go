@@ -21,6 +21,9 @@ func init() {
 type ObjectContent struct {
 	BookmarkContent *model.BlockContentBookmark
 	Blocks          []*model.Block
+	// Author and PublishedDate are filled when the content of the page is parsed as an article
+	Author        string
+	PublishedDate int64
 }
 
 func NewBookmark(m *model.Block) simple.Block {
@@ -87,6 +87,10 @@ func (l *Link) Diff(spaceId string, b simple.Block) (msgs []simple.EventMessage,
 		hasChanges = true
 		changes.TargetBlockId = &pb.EventBlockSetLinkTargetBlockId{Value: link.content.TargetBlockId}
 	}
+	if l.content.FocusBlockId != link.content.FocusBlockId {
+		hasChanges = true
+		changes.FocusBlockId = &pb.EventBlockSetLinkFocusBlockId{Value: link.content.FocusBlockId}
+	}
 
 	if l.content.IconSize != link.content.IconSize {
 		hasChanges = true
@@ -143,6 +147,9 @@ func (l *Link) ApplyEvent(e *pb.EventBlockSetLink) error {
 	if e.TargetBlockId != nil {
 		l.content.TargetBlockId = e.TargetBlockId.GetValue()
 	}
+	if e.FocusBlockId != nil {
+		l.content.FocusBlockId = e.FocusBlockId.GetValue()
+	}
 
 	if e.IconSize != nil {
 		l.content.IconSize = e.IconSize.GetValue()
@@ -174,9 +181,10 @@ func (l *Link) ToText(targetDetails *domain.Details) simple.Block {
 		tb.Marks = &model.BlockContentTextMarks{
 			Marks: []*model.BlockContentTextMark{
 				{
-					Range: &model.Range{0, int32(text.UTF16RuneCountString(name))},
-					Type:  model.BlockContentTextMark_Mention,
-					Param: l.content.TargetBlockId,
+					Range:        &model.Range{From: 0, To: int32(text.UTF16RuneCountString(name))},
+					Type:         model.BlockContentTextMark_Mention,
+					Param:        l.content.TargetBlockId,
+					FocusBlockId: l.content.FocusBlockId,
 				},
 			},
 		}
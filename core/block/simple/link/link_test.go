@@ -91,6 +91,28 @@ func TestLink_Diff(t *testing.T) {
 			},
 		}), diff)
 	})
+	t.Run("focus block changed", func(t *testing.T) {
+		// given
+		b1 := testBlock()
+		b2 := testBlock()
+
+		// when
+		b2.content.FocusBlockId = "heading"
+		diff, err := b1.Diff("", b2)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, diff, 1)
+		assert.Equal(t, test.MakeEvent(&pb.EventMessageValueOfBlockSetLink{
+			BlockSetLink: &pb.EventBlockSetLink{
+				Id:           b1.Id,
+				FocusBlockId: &pb.EventBlockSetLinkFocusBlockId{Value: "heading"},
+			},
+		}), diff)
+
+		require.NoError(t, b1.ApplyEvent(diff[0].Msg.GetBlockSetLink()))
+		assert.Equal(t, "heading", b1.content.FocusBlockId)
+	})
 }
 
 func TestLink_ToText(t *testing.T) {
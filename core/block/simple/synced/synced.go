@@ -0,0 +1,88 @@
+package synced
+
+import (
+	"fmt"
+
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/base"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+)
+
+func init() {
+	simple.RegisterCreator(NewSynced)
+}
+
+func NewSynced(m *model.Block) simple.Block {
+	if synced := m.GetSynced(); synced != nil {
+		return &Synced{
+			Base:    base.NewBase(m).(*base.Base),
+			content: synced,
+		}
+	}
+	return nil
+}
+
+// Block shows the block subtree of the source object. The block itself has no children,
+// blocks of the subtree are stored and edited in the source object only.
+// An object and sources of its synced blocks are always locked one by one, never at the same time,
+// because objects can show each other's blocks and locking both would deadlock
+type Block interface {
+	simple.Block
+	FillSmartIds(ids []string) []string
+	HasSmartIds() bool
+	Source() (objectId, blockId string)
+}
+
+type Synced struct {
+	*base.Base
+	content *model.BlockContentSynced
+}
+
+func (s *Synced) Copy() simple.Block {
+	return NewSynced(pbtypes.CopyBlock(s.Model()))
+}
+
+func (s *Synced) Validate() error {
+	if s.content.TargetObjectId == "" {
+		return fmt.Errorf("targetObjectId is empty")
+	}
+	if s.content.TargetBlockId == "" {
+		return fmt.Errorf("targetBlockId is empty")
+	}
+	return nil
+}
+
+func (s *Synced) Diff(spaceId string, b simple.Block) (msgs []simple.EventMessage, err error) {
+	synced, ok := b.(*Synced)
+	if !ok {
+		return nil, fmt.Errorf("can't make diff with different block type")
+	}
+	// the source of the block can't be changed, so only common fields are compared
+	return s.Base.Diff(spaceId, synced)
+}
+
+func (s *Synced) Source() (objectId, blockId string) {
+	return s.content.TargetObjectId, s.content.TargetBlockId
+}
+
+func (s *Synced) FillSmartIds(ids []string) []string {
+	if s.content.TargetObjectId != "" {
+		ids = append(ids, s.content.TargetObjectId)
+	}
+	return ids
+}
+
+func (s *Synced) HasSmartIds() bool {
+	return s.content.TargetObjectId != ""
+}
+
+func (s *Synced) ReplaceLinkIds(replacer func(oldId string) (newId string)) {
+	if s.content.TargetObjectId != "" {
+		s.content.TargetObjectId = replacer(s.content.TargetObjectId)
+	}
+}
+
+func (s *Synced) IsEmpty() bool {
+	return s.content.TargetObjectId == ""
+}
@@ -0,0 +1,52 @@
+package synced
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newSynced(objectId, blockId string) *Synced {
+	return NewSynced(&model.Block{
+		Id: "synced",
+		Content: &model.BlockContentOfSynced{Synced: &model.BlockContentSynced{
+			TargetObjectId: objectId,
+			TargetBlockId:  blockId,
+		}},
+	}).(*Synced)
+}
+
+func TestSynced_Validate(t *testing.T) {
+	assert.NoError(t, newSynced("object", "block").Validate())
+	assert.Error(t, newSynced("", "block").Validate())
+	assert.Error(t, newSynced("object", "").Validate())
+}
+
+func TestSynced_FillSmartIds(t *testing.T) {
+	assert.Equal(t, []string{"object"}, newSynced("object", "block").FillSmartIds(nil))
+	assert.Empty(t, newSynced("", "block").FillSmartIds(nil))
+}
+
+func TestSynced_ReplaceLinkIds(t *testing.T) {
+	b := newSynced("object", "block")
+	b.ReplaceLinkIds(func(oldId string) string {
+		return oldId + "-new"
+	})
+	objectId, blockId := b.Source()
+	assert.Equal(t, "object-new", objectId)
+	assert.Equal(t, "block", blockId)
+}
+
+func TestSynced_Diff(t *testing.T) {
+	b1 := newSynced("object", "block")
+	b2 := b1.Copy().(*Synced)
+	b2.Model().BackgroundColor = "red"
+
+	msgs, err := b1.Diff("space", b2)
+	require.NoError(t, err)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "red", msgs[0].Msg.GetBlockSetBackgroundColor().BackgroundColor)
+}
@@ -2,6 +2,7 @@ package table
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/base"
@@ -29,6 +30,7 @@ type RowBlock interface {
 	simple.Block
 	ApplyEvent(e *pb.EventBlockSetTableRow) (err error)
 	SetIsHeader(v bool)
+	SetCellSpans(spans []*model.BlockContentTableRowCellSpan)
 }
 
 type rowBlock struct {
@@ -44,6 +46,10 @@ func (b *rowBlock) SetIsHeader(v bool) {
 	b.content.IsHeader = v
 }
 
+func (b *rowBlock) SetCellSpans(spans []*model.BlockContentTableRowCellSpan) {
+	b.content.CellSpans = spans
+}
+
 func (b *rowBlock) Diff(spaceId string, sb simple.Block) (msgs []simple.EventMessage, err error) {
 	other, ok := sb.(*rowBlock)
 	if !ok {
@@ -62,6 +68,11 @@ func (b *rowBlock) Diff(spaceId string, sb simple.Block) (msgs []simple.EventMes
 		changes.IsHeader = &pb.EventBlockSetTableRowIsHeader{Value: other.content.IsHeader}
 	}
 
+	if !CellSpansEqual(b.content.CellSpans, other.content.CellSpans) {
+		hasChanges = true
+		changes.CellSpans = &pb.EventBlockSetTableRowCellSpans{Value: other.content.CellSpans}
+	}
+
 	if hasChanges {
 		msgs = append(msgs, simple.EventMessage{Msg: event.NewMessage(spaceId, &pb.EventMessageValueOfBlockSetTableRow{BlockSetTableRow: changes})})
 	}
@@ -72,5 +83,15 @@ func (b *rowBlock) ApplyEvent(e *pb.EventBlockSetTableRow) (err error) {
 	if e.IsHeader != nil {
 		b.content.IsHeader = e.IsHeader.GetValue()
 	}
+	if e.CellSpans != nil {
+		b.content.CellSpans = e.CellSpans.GetValue()
+	}
 	return
 }
+
+// CellSpansEqual reports whether rows have the same merged cells
+func CellSpansEqual(a, b []*model.BlockContentTableRowCellSpan) bool {
+	return slices.EqualFunc(a, b, func(x, y *model.BlockContentTableRowCellSpan) bool {
+		return x.ColumnId == y.ColumnId && x.RowSpan == y.RowSpan && x.ColSpan == y.ColSpan
+	})
+}
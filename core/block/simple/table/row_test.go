@@ -38,4 +38,37 @@ func TestDiff(t *testing.T) {
 			},
 		}), diff)
 	})
+	t.Run("change cell spans", func(t *testing.T) {
+		// given
+		b1 := testBlock()
+		b2 := testBlock()
+		spans := []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 2, ColSpan: 3}}
+
+		// when
+		b2.content.CellSpans = spans
+		diff, err := b1.Diff("", b2)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, diff, 1)
+
+		assert.Equal(t, test.MakeEvent(&pb.EventMessageValueOfBlockSetTableRow{
+			BlockSetTableRow: &pb.EventBlockSetTableRow{
+				Id:        b1.Id,
+				CellSpans: &pb.EventBlockSetTableRowCellSpans{Value: spans},
+			},
+		}), diff)
+	})
+	t.Run("apply cell spans", func(t *testing.T) {
+		// given
+		b := testBlock()
+		spans := []*model.BlockContentTableRowCellSpan{{ColumnId: "col1", RowSpan: 1, ColSpan: 2}}
+
+		// when
+		err := b.ApplyEvent(&pb.EventBlockSetTableRow{CellSpans: &pb.EventBlockSetTableRowCellSpans{Value: spans}})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, spans, b.content.CellSpans)
+	})
 }
@@ -0,0 +1,352 @@
+package text
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+	"unicode/utf16"
+
+	"github.com/mb0/diff"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// Edits returns edits turning the previous text into the next one. Edits are stored in changes
+// along with the full text, so concurrent edits of the block are merged when changes are applied
+func Edits(prev, next string) []*pb.EventBlockSetTextTextEdit {
+	a, b := []rune(prev), []rune(next)
+	changes := diff.Diff(len(a), len(b), &runesDiff{a: a, b: b})
+	edits := make([]*pb.EventBlockSetTextTextEdit, 0, len(changes))
+	var pos, offset int
+	for _, c := range changes {
+		offset += utf16Len(a[pos:c.A])
+		pos = c.A
+		edits = append(edits, &pb.EventBlockSetTextTextEdit{
+			From:     int32(offset),
+			Deleted:  string(a[c.A : c.A+c.Del]),
+			Inserted: string(b[c.B : c.B+c.Ins]),
+		})
+	}
+	return edits
+}
+
+type runesDiff struct{ a, b []rune }
+
+func (d *runesDiff) Equal(i, j int) bool { return d.a[i] == d.b[j] }
+
+// edit replaces del runes starting at from by ins
+type edit struct {
+	from int
+	del  int
+	ins  []rune
+}
+
+func runeEdits(a, b []rune) []edit {
+	changes := diff.Diff(len(a), len(b), &runesDiff{a: a, b: b})
+	edits := make([]edit, 0, len(changes))
+	for _, c := range changes {
+		edits = append(edits, edit{from: c.A, del: c.Del, ins: b[c.B : c.B+c.Ins]})
+	}
+	return edits
+}
+
+// previousText restores the text the edits were made to. False is returned when edits don't match the text
+func previousText(value []rune, protoEdits []*pb.EventBlockSetTextTextEdit) (prev []rune, edits []edit, ok bool) {
+	var offset, pos int
+	prev = make([]rune, 0, len(value))
+	edits = make([]edit, 0, len(protoEdits))
+	for _, e := range protoEdits {
+		for offset < int(e.From) && pos < len(value) {
+			offset += utf16Len(value[pos : pos+1])
+			prev = append(prev, value[pos])
+			pos++
+		}
+		ins, del := []rune(e.Inserted), []rune(e.Deleted)
+		if offset != int(e.From) || pos+len(ins) > len(value) || string(value[pos:pos+len(ins)]) != e.Inserted {
+			return nil, nil, false
+		}
+		edits = append(edits, edit{from: len(prev), del: len(del), ins: ins})
+		prev = append(prev, del...)
+		offset += utf16Len(del)
+		pos += len(ins)
+	}
+	return append(prev, value[pos:]...), edits, true
+}
+
+// positionMap maps positions in one of the merged texts to positions in the result
+type positionMap struct {
+	start []int // position of the rune in the result, or of the next rune when the rune is deleted
+	end   []int // position after the previous rune in the result
+}
+
+func newPositionMap(length int) *positionMap {
+	return &positionMap{start: make([]int, length+1), end: make([]int, length+1)}
+}
+
+// mapRange maps the range of runes, false is returned when all runes of the range are deleted
+func (m *positionMap) mapRange(from, to int) (int, int, bool) {
+	from, to = m.start[from], m.end[to]
+	return from, to, from < to
+}
+
+// runeOrigin contains positions of the rune of the merged text in the base text and in texts of both sides, -1 when absent
+type runeOrigin struct {
+	base, local, remote int
+}
+
+type textMerge struct {
+	result  []rune
+	origins []runeOrigin
+	local   *positionMap
+	remote  *positionMap
+}
+
+// mergeEdits applies both local and remote edits of the base text. Insertions of both sides are kept,
+// local insertions go first at the same position, text deleted by any side is deleted
+func mergeEdits(base []rune, local, remote []edit) *textMerge {
+	var (
+		localDeleted  = make([]bool, len(base))
+		remoteDeleted = make([]bool, len(base))
+		localIns      = make(map[int][]rune, len(local))
+		remoteIns     = make(map[int][]rune, len(remote))
+		localLen      = len(base)
+		remoteLen     = len(base)
+	)
+	for _, e := range local {
+		for i := e.from; i < e.from+e.del; i++ {
+			localDeleted[i] = true
+		}
+		localIns[e.from] = append(localIns[e.from], e.ins...)
+		localLen += len(e.ins) - e.del
+	}
+	for _, e := range remote {
+		for i := e.from; i < e.from+e.del; i++ {
+			remoteDeleted[i] = true
+		}
+		remoteIns[e.from] = append(remoteIns[e.from], e.ins...)
+		remoteLen += len(e.ins) - e.del
+	}
+
+	m := &textMerge{
+		result:  make([]rune, 0, len(base)),
+		origins: make([]runeOrigin, 0, len(base)),
+		local:   newPositionMap(localLen),
+		remote:  newPositionMap(remoteLen),
+	}
+	var localPos, remotePos int
+	// emit writes the rune to the result when it is kept and records its position in texts of both sides
+	emit := func(r rune, basePos int, inLocal, inRemote, keep bool) {
+		if inLocal {
+			m.local.start[localPos] = len(m.result)
+		}
+		if inRemote {
+			m.remote.start[remotePos] = len(m.result)
+		}
+		if keep {
+			origin := runeOrigin{base: basePos, local: -1, remote: -1}
+			if inLocal {
+				origin.local = localPos
+			}
+			if inRemote {
+				origin.remote = remotePos
+			}
+			m.result = append(m.result, r)
+			m.origins = append(m.origins, origin)
+		}
+		if inLocal {
+			localPos++
+			m.local.end[localPos] = len(m.result)
+		}
+		if inRemote {
+			remotePos++
+			m.remote.end[remotePos] = len(m.result)
+		}
+	}
+	for i := 0; i <= len(base); i++ {
+		for _, r := range localIns[i] {
+			emit(r, -1, true, false, true)
+		}
+		for _, r := range remoteIns[i] {
+			emit(r, -1, false, true, true)
+		}
+		if i < len(base) {
+			emit(base[i], i, !localDeleted[i], !remoteDeleted[i], !localDeleted[i] && !remoteDeleted[i])
+		}
+	}
+	m.local.start[localLen] = len(m.result)
+	m.remote.start[remoteLen] = len(m.result)
+	return m
+}
+
+// mergeText merges the text and marks set by the change with the block changed by the concurrent change.
+// Edits of the change are made to the text the change was based on. When the block is not changed concurrently,
+// the text and marks are set as is and false is returned
+func (t *Text) mergeText(e *pb.EventBlockSetText) bool {
+	if e.Text == nil {
+		return false
+	}
+	prevMarks := e.Marks.GetPrevious()
+	if len(e.Text.Edits) == 0 && prevMarks == nil {
+		// the change has no edits of the previous text
+		return false
+	}
+	value := []rune(e.Text.Value)
+	base, remote, ok := previousText(value, e.Text.Edits)
+	if !ok {
+		return false
+	}
+	textChanged := string(base) != t.content.Text
+	if !textChanged && (prevMarks == nil || marksEq(prevMarks, t.content.Marks)) {
+		return false
+	}
+	current := []rune(t.content.Text)
+	m := mergeEdits(base, runeEdits(base, current), remote)
+
+	var marks []*model.BlockContentTextMark
+	switch {
+	case e.Marks == nil:
+		marks = mapMarks(t.content.Marks.GetMarks(), current, m.result, m.local)
+	case prevMarks == nil:
+		// changes made before marks of the previous text were stored in changes
+		marks = append(mapMarks(t.content.Marks.GetMarks(), current, m.result, m.local),
+			mapMarks(e.Marks.GetValue().GetMarks(), value, m.result, m.remote)...)
+	default:
+		marks = m.mergeMarks(
+			markedRunes(prevMarks.GetMarks(), base),
+			markedRunes(t.content.Marks.GetMarks(), current),
+			markedRunes(e.Marks.GetValue().GetMarks(), value),
+		)
+	}
+	t.content.Text = string(m.result)
+	t.content.Marks = &model.BlockContentTextMarks{Marks: marks}
+	t.normalizeMarks()
+	return true
+}
+
+type markKey struct {
+	markType     model.BlockContentTextMarkType
+	param        string
+	focusBlockId string
+}
+
+// markedRunes returns flags of runes of the text covered by marks, per kind of marks
+func markedRunes(marks []*model.BlockContentTextMark, text []rune) map[markKey][]bool {
+	offsets := utf16Offsets(text)
+	res := make(map[markKey][]bool, len(marks))
+	for _, mark := range marks {
+		if mark.Range == nil {
+			continue
+		}
+		key := markKey{markType: mark.Type, param: mark.Param, focusBlockId: mark.FocusBlockId}
+		flags, ok := res[key]
+		if !ok {
+			flags = make([]bool, len(text))
+			res[key] = flags
+		}
+		from := sort.SearchInts(offsets, int(mark.Range.From))
+		to := min(sort.SearchInts(offsets, int(mark.Range.To)), len(text))
+		for i := from; i < to; i++ {
+			flags[i] = true
+		}
+	}
+	return res
+}
+
+// mergeMarks merges marks of both sides against marks of the base text. The rune keeps the mark of the side
+// which has changed it, so a mark removed by one side stays removed whatever the other side does with the text
+func (m *textMerge) mergeMarks(base, local, remote map[markKey][]bool) []*model.BlockContentTextMark {
+	keys := make([]markKey, 0, len(base)+len(local)+len(remote))
+	for _, flags := range []map[markKey][]bool{base, local, remote} {
+		for key := range flags {
+			if !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	// the order doesn't depend on the order the changes are applied in
+	slices.SortFunc(keys, func(a, b markKey) int {
+		return cmp.Or(cmp.Compare(a.markType, b.markType), cmp.Compare(a.param, b.param), cmp.Compare(a.focusBlockId, b.focusBlockId))
+	})
+
+	offsets := utf16Offsets(m.result)
+	var marks []*model.BlockContentTextMark
+	for _, key := range keys {
+		from := -1
+		for pos := 0; pos <= len(m.result); pos++ {
+			marked := pos < len(m.result) && m.isMarked(pos, base[key], local[key], remote[key])
+			if marked && from < 0 {
+				from = pos
+			}
+			if !marked && from >= 0 {
+				marks = append(marks, &model.BlockContentTextMark{
+					Range:        &model.Range{From: int32(offsets[from]), To: int32(offsets[pos])},
+					Type:         key.markType,
+					Param:        key.param,
+					FocusBlockId: key.focusBlockId,
+				})
+				from = -1
+			}
+		}
+	}
+	return marks
+}
+
+func (m *textMerge) isMarked(pos int, base, local, remote []bool) bool {
+	origin := m.origins[pos]
+	inLocal := origin.local >= 0 && local != nil && local[origin.local]
+	inRemote := origin.remote >= 0 && remote != nil && remote[origin.remote]
+	switch {
+	case origin.base < 0 && origin.local >= 0:
+		return inLocal
+	case origin.base < 0:
+		return inRemote
+	}
+	if inBase := base != nil && base[origin.base]; inLocal != inBase {
+		return inLocal
+	}
+	return inRemote
+}
+
+// mapMarks moves marks of the text to positions of the merged text, ranges of marks are in UTF-16 code units
+func mapMarks(marks []*model.BlockContentTextMark, text, result []rune, positions *positionMap) []*model.BlockContentTextMark {
+	textOffsets, resultOffsets := utf16Offsets(text), utf16Offsets(result)
+	mapped := make([]*model.BlockContentTextMark, 0, len(marks))
+	for _, mark := range marks {
+		if mark.Range == nil {
+			continue
+		}
+		from := sort.SearchInts(textOffsets, int(mark.Range.From))
+		to := sort.SearchInts(textOffsets, int(mark.Range.To))
+		if from >= len(textOffsets) || to >= len(textOffsets) {
+			continue
+		}
+		from, to, ok := positions.mapRange(from, to)
+		if !ok {
+			continue
+		}
+		mapped = append(mapped, &model.BlockContentTextMark{
+			Range:        &model.Range{From: int32(resultOffsets[from]), To: int32(resultOffsets[to])},
+			Type:         mark.Type,
+			Param:        mark.Param,
+			FocusBlockId: mark.FocusBlockId,
+		})
+	}
+	return mapped
+}
+
+// utf16Offsets returns offsets of runes in UTF-16 code units, the last offset is the length of the text
+func utf16Offsets(runes []rune) []int {
+	offsets := make([]int, len(runes)+1)
+	for i, r := range runes {
+		offsets[i+1] = offsets[i] + utf16.RuneLen(r)
+	}
+	return offsets
+}
+
+func utf16Len(runes []rune) (n int) {
+	for _, r := range runes {
+		n += utf16.RuneLen(r)
+	}
+	return n
+}
@@ -0,0 +1,98 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestEdits(t *testing.T) {
+	for _, tc := range []struct {
+		prev, next string
+	}{
+		{"", "text"},
+		{"text", ""},
+		{"Hello world", "Hello big world!"},
+		{"😀 smile", "😀 big smile 😀"},
+		{"abc", "xyz"},
+	} {
+		edits := Edits(tc.prev, tc.next)
+		prev, _, ok := previousText([]rune(tc.next), edits)
+		require.True(t, ok)
+		assert.Equal(t, tc.prev, string(prev))
+	}
+
+	t.Run("positions in UTF-16 code units", func(t *testing.T) {
+		edits := Edits("😀 smile", "😀 big smile")
+		require.Len(t, edits, 1)
+		assert.Equal(t, &pb.EventBlockSetTextTextEdit{From: 3, Inserted: "big "}, edits[0])
+	})
+	t.Run("edits don't match the text", func(t *testing.T) {
+		_, _, ok := previousText([]rune("Hello"), []*pb.EventBlockSetTextTextEdit{{From: 2, Inserted: "xx"}})
+		assert.False(t, ok)
+	})
+}
+
+func TestText_ApplyEvent(t *testing.T) {
+	newText := func(value string, marks ...*model.BlockContentTextMark) *Text {
+		return NewText(&model.Block{Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  value,
+			Marks: &model.BlockContentTextMarks{Marks: marks},
+		}}}).(*Text)
+	}
+	bold := func(from, to int32) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{Range: &model.Range{From: from, To: to}, Type: model.BlockContentTextMark_Bold}
+	}
+	setText := func(prev, next string, marks ...*model.BlockContentTextMark) *pb.EventBlockSetText {
+		e := &pb.EventBlockSetText{Text: &pb.EventBlockSetTextText{Value: next, Edits: Edits(prev, next)}}
+		if marks != nil {
+			e.Marks = &pb.EventBlockSetTextMarks{Value: &model.BlockContentTextMarks{Marks: marks}}
+		}
+		return e
+	}
+
+	t.Run("text is not changed concurrently", func(t *testing.T) {
+		b := newText("Hello world")
+		require.NoError(t, b.ApplyEvent(setText("Hello world", "Hello big world", bold(6, 9))))
+		assert.Equal(t, "Hello big world", b.GetText())
+		assert.Equal(t, []*model.BlockContentTextMark{bold(6, 9)}, b.Model().GetText().Marks.Marks)
+	})
+	t.Run("concurrent insertions", func(t *testing.T) {
+		b := newText("Hello big world", bold(0, 5))
+		require.NoError(t, b.ApplyEvent(setText("Hello world", "Hello world!", bold(6, 11))))
+		assert.Equal(t, "Hello big world!", b.GetText())
+		assert.Equal(t, []*model.BlockContentTextMark{bold(0, 5), bold(10, 15)}, b.Model().GetText().Marks.Marks)
+	})
+	t.Run("concurrent deletion and insertion", func(t *testing.T) {
+		b := newText("one three")
+		require.NoError(t, b.ApplyEvent(setText("one two three", "one two three four")))
+		assert.Equal(t, "one three four", b.GetText())
+	})
+	t.Run("insertions at the same position", func(t *testing.T) {
+		b := newText("ab")
+		require.NoError(t, b.ApplyEvent(setText("", "cd")))
+		assert.Equal(t, "abcd", b.GetText())
+	})
+	t.Run("focus block of marks is kept", func(t *testing.T) {
+		mention := &model.BlockContentTextMark{
+			Range:        &model.Range{From: 6, To: 11},
+			Type:         model.BlockContentTextMark_Mention,
+			Param:        "obj",
+			FocusBlockId: "heading",
+		}
+		b := newText("Hello big world")
+		require.NoError(t, b.ApplyEvent(setText("Hello world", "Hello world!", mention)))
+		require.Len(t, b.Model().GetText().Marks.Marks, 1)
+		assert.Equal(t, "heading", b.Model().GetText().Marks.Marks[0].FocusBlockId)
+	})
+	t.Run("marks of deleted text are removed", func(t *testing.T) {
+		b := newText("Hello world", bold(6, 11))
+		require.NoError(t, b.ApplyEvent(setText("Hello big world", "Hello big", bold(6, 9))))
+		assert.Equal(t, "Hello ", b.GetText())
+		assert.Empty(t, b.Model().GetText().Marks.Marks)
+	})
+}
@@ -205,9 +205,10 @@ func (t *Text) SetMarkForAllText(mark *model.BlockContentTextMark) {
 	}
 	t.content.Marks.Marks = filteredMarks
 	t.content.Marks.Marks = append(t.content.Marks.Marks, &model.BlockContentTextMark{
-		Range: mRange,
-		Type:  mark.Type,
-		Param: mark.Param,
+		Range:        mRange,
+		Type:         mark.Type,
+		Param:        mark.Param,
+		FocusBlockId: mark.FocusBlockId,
 	})
 	return
 }
@@ -288,8 +289,9 @@ func (t *Text) Split(pos int32) (simple.Block, error) {
 					From: 0,
 					To:   mark.Range.To - pos,
 				},
-				Type:  mark.Type,
-				Param: mark.Param,
+				Type:         mark.Type,
+				Param:        mark.Param,
+				FocusBlockId: mark.FocusBlockId,
 			}
 			newMarks.Marks = append(newMarks.Marks, newMark)
 			mark.Range.To = pos
@@ -476,8 +478,9 @@ func (t *Text) splitMarks(marks []*model.BlockContentTextMark, r *model.Range, n
 					From: m.Range.From,
 					To:   m.Range.To,
 				},
-				Type:  m.Type,
-				Param: m.Param,
+				Type:         m.Type,
+				Param:        m.Param,
+				FocusBlockId: m.FocusBlockId,
 			})
 		} else
 
@@ -488,8 +491,9 @@ func (t *Text) splitMarks(marks []*model.BlockContentTextMark, r *model.Range, n
 					From: m.Range.From,
 					To:   r.From,
 				},
-				Type:  m.Type,
-				Param: m.Param,
+				Type:         m.Type,
+				Param:        m.Param,
+				FocusBlockId: m.FocusBlockId,
 			})
 
 		} else
@@ -506,8 +510,9 @@ func (t *Text) splitMarks(marks []*model.BlockContentTextMark, r *model.Range, n
 					From: m.Range.From,
 					To:   r.From,
 				},
-				Type:  m.Type,
-				Param: m.Param,
+				Type:         m.Type,
+				Param:        m.Param,
+				FocusBlockId: m.FocusBlockId,
 			})
 
 			botMarks = append(botMarks, &model.BlockContentTextMark{
@@ -515,8 +520,9 @@ func (t *Text) splitMarks(marks []*model.BlockContentTextMark, r *model.Range, n
 					From: r.From + newTextLen,
 					To:   m.Range.To - (r.To - r.From) + newTextLen,
 				},
-				Type:  m.Type,
-				Param: m.Param,
+				Type:         m.Type,
+				Param:        m.Param,
+				FocusBlockId: m.FocusBlockId,
 			})
 
 		} else
@@ -527,8 +533,9 @@ func (t *Text) splitMarks(marks []*model.BlockContentTextMark, r *model.Range, n
 					From: m.Range.From - (r.To - r.From) + newTextLen,
 					To:   m.Range.To - (r.To - r.From) + newTextLen,
 				},
-				Type:  m.Type,
-				Param: m.Param,
+				Type:         m.Type,
+				Param:        m.Param,
+				FocusBlockId: m.FocusBlockId,
 			})
 		} else if (m.Range.From >= r.From) && (m.Range.To >= r.To) {
 			botMarks = append(botMarks, &model.BlockContentTextMark{
@@ -536,8 +543,9 @@ func (t *Text) splitMarks(marks []*model.BlockContentTextMark, r *model.Range, n
 					From: r.From + newTextLen,
 					To:   m.Range.To - (r.To - r.From) + newTextLen,
 				},
-				Type:  m.Type,
-				Param: m.Param,
+				Type:         m.Type,
+				Param:        m.Param,
+				FocusBlockId: m.FocusBlockId,
 			})
 		} else if (m.Range.From < r.From) && (m.Range.To > r.From) && (m.Range.To <= r.To) {
 			topMarks = append(topMarks, &model.BlockContentTextMark{
@@ -545,8 +553,9 @@ func (t *Text) splitMarks(marks []*model.BlockContentTextMark, r *model.Range, n
 					From: m.Range.From,
 					To:   r.From,
 				},
-				Type:  m.Type,
-				Param: m.Param,
+				Type:         m.Type,
+				Param:        m.Param,
+				FocusBlockId: m.FocusBlockId,
 			})
 		} else
 		//  (*******<b>**)rem lorem</b>  :--->   __PASTE__ <b>em lorem</b>
@@ -556,8 +565,9 @@ func (t *Text) splitMarks(marks []*model.BlockContentTextMark, r *model.Range, n
 					From: r.From + newTextLen,
 					To:   m.Range.To - (r.To - r.From) + newTextLen,
 				},
-				Type:  m.Type,
-				Param: m.Param,
+				Type:         m.Type,
+				Param:        m.Param,
+				FocusBlockId: m.FocusBlockId,
 			})
 		}
 	}
@@ -623,8 +633,9 @@ func (t *Text) Merge(b simple.Block, opts ...MergeOption) error {
 				From: m.Range.From + curLen,
 				To:   m.Range.To + curLen,
 			},
-			Type:  m.Type,
-			Param: m.Param,
+			Type:         m.Type,
+			Param:        m.Param,
+			FocusBlockId: m.FocusBlockId,
 		})
 	}
 	t.normalizeMarks()
@@ -644,7 +655,7 @@ func (t *Text) normalizeMarksPure(marks []*model.BlockContentTextMark) (outputMa
 		}
 		m := outputMarks[i]
 		sm := outputMarks[i+1]
-		if m.Type == sm.Type && m.Param == sm.Param && m.Range.To >= sm.Range.From {
+		if m.Type == sm.Type && m.Param == sm.Param && m.FocusBlockId == sm.FocusBlockId && m.Range.To >= sm.Range.From {
 			m.Range.To = sm.Range.To
 			outputMarks[i+1] = nil
 			outputMarks = append(outputMarks[:i+1], outputMarks[i+2:]...)
@@ -699,10 +710,13 @@ func (t *Text) ApplyEvent(e *pb.EventBlockSetText) error {
 	if e.Style != nil {
 		t.content.Style = e.Style.GetValue()
 	}
+	var merged bool
 	if e.Text != nil {
-		t.content.Text = e.Text.GetValue()
+		if merged = t.mergeText(e); !merged {
+			t.content.Text = e.Text.GetValue()
+		}
 	}
-	if e.Marks != nil {
+	if e.Marks != nil && !merged {
 		t.content.Marks = e.Marks.GetValue()
 	}
 	if e.Checked != nil {
@@ -114,6 +114,25 @@ func TestText_Split(t *testing.T) {
 		assert.Equal(t, model.Range{0, 5}, *b.content.Marks.Marks[0].Range)
 		assert.Equal(t, model.Range{1, 5}, *b.content.Marks.Marks[1].Range)
 	})
+	t.Run("keep focus block of mentions", func(t *testing.T) {
+		b := NewText(&model.Block{Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text: "see heading",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{{
+				Type:         model.BlockContentTextMark_Mention,
+				Param:        "obj",
+				FocusBlockId: "heading",
+				Range:        &model.Range{From: 4, To: 11},
+			}}},
+		}}}).(*Text)
+		newBlock, err := b.Split(7)
+		require.NoError(t, err)
+		nb := newBlock.(*Text)
+		require.Len(t, nb.content.Marks.Marks, 1)
+		require.Len(t, b.content.Marks.Marks, 1)
+		assert.Equal(t, "heading", nb.content.Marks.Marks[0].FocusBlockId)
+		assert.Equal(t, "heading", b.content.Marks.Marks[0].FocusBlockId)
+		assert.Equal(t, model.Range{From: 0, To: 4}, *b.content.Marks.Marks[0].Range)
+	})
 	t.Run("out of range", func(t *testing.T) {
 		b := testBlock()
 		_, err := b.Split(11)
@@ -201,6 +220,37 @@ func TestText_RangeSplit(t *testing.T) {
 	})
 }
 
+func TestText_RangeTextPaste(t *testing.T) {
+	mention := func(from, to int32, focusBlockId string) *model.BlockContentTextMark {
+		return &model.BlockContentTextMark{
+			Type:         model.BlockContentTextMark_Mention,
+			Param:        "obj",
+			FocusBlockId: focusBlockId,
+			Range:        &model.Range{From: from, To: to},
+		}
+	}
+	t.Run("keep focus block of mentions", func(t *testing.T) {
+		b := NewText(&model.Block{Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  "see heading",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mention(4, 11, "heading")}},
+		}}}).(*Text)
+		copied := &model.Block{Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text:  "title",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{mention(0, 5, "title")}},
+		}}}
+
+		_, err := b.RangeTextPaste(7, 7, copied, false)
+		require.NoError(t, err)
+
+		assert.Equal(t, "see heatitleding", b.content.Text)
+		assert.ElementsMatch(t, []*model.BlockContentTextMark{
+			mention(4, 7, "heading"),
+			mention(7, 12, "title"),
+			mention(12, 16, "heading"),
+		}, b.content.Marks.Marks)
+	})
+}
+
 func TestText_normalizeMarks(t *testing.T) {
 	b := NewText(&model.Block{
 		Restrictions: &model.BlockRestrictions{},
@@ -331,6 +381,42 @@ func TestText_Merge(t *testing.T) {
 		assert.Equal(t, mergeTo.content.Style, model.BlockContentText_Header1)
 		assert.Equal(t, mergeTo.BackgroundColor, "grey")
 	})
+
+	t.Run("keep focus block of mentions", func(t *testing.T) {
+		// given
+		mergeTo := NewText(&model.Block{
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{
+					Text: "see ",
+				},
+			},
+		}).(*Text)
+
+		mergeFrom := NewText(&model.Block{
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{
+					Text: "heading",
+					Marks: &model.BlockContentTextMarks{
+						Marks: []*model.BlockContentTextMark{{
+							Type:         model.BlockContentTextMark_Mention,
+							Param:        "obj",
+							FocusBlockId: "heading",
+							Range:        &model.Range{From: 0, To: 7},
+						}},
+					},
+				},
+			},
+		}).(*Text)
+
+		// when
+		err := mergeTo.Merge(mergeFrom)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, mergeTo.content.Marks.Marks, 1)
+		assert.Equal(t, "heading", mergeTo.content.Marks.Marks[0].FocusBlockId)
+		assert.Equal(t, model.Range{From: 4, To: 11}, *mergeTo.content.Marks.Marks[0].Range)
+	})
 }
 
 func TestText_SetMarkForAllText(t *testing.T) {
@@ -362,6 +448,13 @@ func TestText_SetMarkForAllText(t *testing.T) {
 		Type: model.BlockContentTextMark_Bold,
 	})
 	assert.Len(t, tb.Model().GetText().Marks.Marks, 2)
+	tb.SetMarkForAllText(&model.BlockContentTextMark{
+		Type:         model.BlockContentTextMark_Mention,
+		Param:        "obj",
+		FocusBlockId: "heading",
+	})
+	marks := tb.Model().GetText().Marks.Marks
+	assert.Equal(t, "heading", marks[len(marks)-1].FocusBlockId)
 }
 
 func TestText_IncompatibleTypes(t *testing.T) {
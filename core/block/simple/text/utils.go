@@ -54,7 +54,7 @@ func markEq(m1, m2 *model.BlockContentTextMark) bool {
 	if m1.Type != m2.Type {
 		return false
 	}
-	if m1.Param != m2.Param {
+	if m1.Param != m2.Param || m1.FocusBlockId != m2.FocusBlockId {
 		return false
 	}
 	if *m1.Range != *m2.Range {
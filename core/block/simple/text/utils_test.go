@@ -52,4 +52,9 @@ func Test_marksEq(t *testing.T) {
 		m2.Marks[0].Type = model.BlockContentTextMark_Italic
 		assert.False(t, marksEq(newMarks(), m2))
 	})
+	t.Run("focus block", func(t *testing.T) {
+		m2 := newMarks()
+		m2.Marks[3].FocusBlockId = "blockId"
+		assert.False(t, marksEq(newMarks(), m2))
+	})
 }
@@ -0,0 +1,142 @@
+package block
+
+import (
+	"errors"
+
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/synced"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var (
+	ErrMixedSyncedBlocks  = errors.New("blocks of the object and blocks of synced blocks can't be changed together")
+	ErrNestedSyncedBlocks = errors.New("synced blocks can't be added to synced blocks")
+)
+
+// syncedContextId returns the id of the object the blocks belong to. Blocks shown by synced blocks are
+// stored in the source object, so edits of them made in the object are applied to the source object.
+// All blocks should belong to the same object, otherwise ErrMixedSyncedBlocks is returned
+func (s *Service) syncedContextId(contextId string, blockIds ...string) (string, error) {
+	return resolveSyncedContextId(s, contextId, blockIds)
+}
+
+func resolveSyncedContextId(getter cache.ObjectGetter, contextId string, blockIds []string) (string, error) {
+	var ids []string
+	for _, id := range blockIds {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return contextId, nil
+	}
+	type source struct{ objectId, blockId string }
+	var (
+		missing []string
+		sources []source
+	)
+	err := cache.Do(getter, contextId, func(sb smartblock.SmartBlock) error {
+		for _, id := range ids {
+			if sb.Pick(id) == nil {
+				missing = append(missing, id)
+			}
+		}
+		// synced blocks are looked up only when some blocks are missing from the object,
+		// so edits of the object's own blocks don't walk the whole tree
+		if len(missing) == 0 {
+			return nil
+		}
+		return sb.Iterate(func(b simple.Block) (isContinue bool) {
+			if s, ok := b.(synced.Block); ok {
+				objectId, blockId := s.Source()
+				if objectId != contextId {
+					sources = append(sources, source{objectId: objectId, blockId: blockId})
+				}
+			}
+			return true
+		})
+	})
+	if err != nil || len(missing) == 0 {
+		// errors of the object are returned by the operation itself
+		return contextId, nil
+	}
+	for _, src := range sources {
+		var found int
+		err = cache.Do(getter, src.objectId, func(sb smartblock.SmartBlock) error {
+			for _, id := range missing {
+				if inSubtree(sb, src.blockId, id) {
+					found++
+				}
+			}
+			return nil
+		})
+		if err != nil || found == 0 {
+			continue
+		}
+		if found != len(ids) {
+			return "", ErrMixedSyncedBlocks
+		}
+		return src.objectId, nil
+	}
+	return contextId, nil
+}
+
+// checkNestedSyncedBlocks returns ErrNestedSyncedBlocks when synced blocks are added to the subtree of a synced block,
+// which is the case when blocks are added to the source object instead of the object itself
+func checkNestedSyncedBlocks(contextId, syncedContextId string, blocks ...*model.Block) error {
+	if contextId == syncedContextId {
+		return nil
+	}
+	for _, b := range blocks {
+		if b.GetSynced() != nil {
+			return ErrNestedSyncedBlocks
+		}
+	}
+	return nil
+}
+
+// checkNestedSyncedSubtrees is checkNestedSyncedBlocks for the blocks of the state with their subtrees
+func checkNestedSyncedSubtrees(contextId, syncedContextId string, st *state.State, blockIds []string) error {
+	if contextId == syncedContextId {
+		return nil
+	}
+	var blocks []*model.Block
+	var collect func(ids []string)
+	collect = func(ids []string) {
+		for _, id := range ids {
+			if b := st.Pick(id); b != nil {
+				blocks = append(blocks, b.Model())
+				collect(b.Model().ChildrenIds)
+			}
+		}
+	}
+	collect(blockIds)
+	return checkNestedSyncedBlocks(contextId, syncedContextId, blocks...)
+}
+
+func blockModelIds(blocks []*model.Block) []string {
+	ids := make([]string, 0, len(blocks))
+	for _, b := range blocks {
+		ids = append(ids, b.Id)
+	}
+	return ids
+}
+
+func inSubtree(sb smartblock.SmartBlock, rootId, id string) bool {
+	if rootId == id {
+		return true
+	}
+	root := sb.Pick(rootId)
+	if root == nil {
+		return false
+	}
+	for _, childId := range root.Model().ChildrenIds {
+		if inSubtree(sb, childId, id) {
+			return true
+		}
+	}
+	return false
+}
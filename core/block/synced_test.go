@@ -0,0 +1,173 @@
+package block
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
+	"github.com/anyproto/anytype-heart/core/block/object/idresolver/mock_idresolver"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space/clientspace/mock_clientspace"
+	"github.com/anyproto/anytype-heart/space/mock_space"
+)
+
+type objectsStub map[string]smartblock.SmartBlock
+
+func (o objectsStub) GetObject(_ context.Context, id string) (smartblock.SmartBlock, error) {
+	if sb, ok := o[id]; ok {
+		return sb, nil
+	}
+	return nil, domain.ErrObjectNotFound
+}
+
+func (o objectsStub) GetObjectByFullID(ctx context.Context, id domain.FullID) (smartblock.SmartBlock, error) {
+	return o.GetObject(ctx, id.ObjectID)
+}
+
+func addBlock(sb *smarttest.SmartTest, id string, content model.IsBlockContent, childrenIds ...string) {
+	sb.AddBlock(simple.New(&model.Block{Id: id, Content: content, ChildrenIds: childrenIds}))
+}
+
+func textContent() model.IsBlockContent {
+	return &model.BlockContentOfText{Text: &model.BlockContentText{}}
+}
+
+// givenSyncedObjects returns the host showing the "toggle" subtree of the source by the synced block
+func givenSyncedObjects() objectsStub {
+	host := smarttest.New("host")
+	addBlock(host, "host", &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}}, "hostText", "synced")
+	addBlock(host, "hostText", textContent())
+	addBlock(host, "synced", &model.BlockContentOfSynced{Synced: &model.BlockContentSynced{
+		TargetObjectId: "source",
+		TargetBlockId:  "toggle",
+	}})
+
+	source := smarttest.New("source")
+	addBlock(source, "source", &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}}, "toggle", "sourceText")
+	addBlock(source, "toggle", textContent(), "child")
+	addBlock(source, "child", textContent())
+	addBlock(source, "sourceText", textContent())
+
+	return objectsStub{"host": host, "source": source}
+}
+
+func TestResolveSyncedContextId(t *testing.T) {
+	objects := givenSyncedObjects()
+
+	for _, tc := range []struct {
+		name      string
+		blockIds  []string
+		contextId string
+		err       error
+	}{
+		{name: "no blocks", contextId: "host"},
+		{name: "block of the object", blockIds: []string{"hostText"}, contextId: "host"},
+		{name: "synced block itself", blockIds: []string{"synced"}, contextId: "host"},
+		{name: "root of the subtree", blockIds: []string{"toggle"}, contextId: "source"},
+		{name: "blocks of the subtree", blockIds: []string{"child", "toggle"}, contextId: "source"},
+		{name: "empty ids are ignored", blockIds: []string{"", "child"}, contextId: "source"},
+		{name: "block of the source outside of the subtree", blockIds: []string{"sourceText"}, contextId: "host"},
+		{name: "unknown block", blockIds: []string{"unknown"}, contextId: "host"},
+		{name: "blocks of the object and of the subtree", blockIds: []string{"hostText", "child"}, err: ErrMixedSyncedBlocks},
+		{name: "blocks of the subtree and outside of it", blockIds: []string{"child", "sourceText"}, err: ErrMixedSyncedBlocks},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			contextId, err := resolveSyncedContextId(objects, "host", tc.blockIds)
+			if tc.err != nil {
+				assert.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.contextId, contextId)
+		})
+	}
+}
+
+func TestResolveSyncedContextId_NoSyncedBlocks(t *testing.T) {
+	host := smarttest.New("host")
+	addBlock(host, "host", &model.BlockContentOfSmartblock{Smartblock: &model.BlockContentSmartblock{}}, "hostText")
+	addBlock(host, "hostText", textContent())
+
+	// the object has no synced blocks, so no other objects are opened
+	contextId, err := resolveSyncedContextId(objectsStub{"host": host}, "host", []string{"unknown"})
+	require.NoError(t, err)
+	assert.Equal(t, "host", contextId)
+}
+
+// noIterateObject fails the test when the blocks of the object are walked
+type noIterateObject struct {
+	smartblock.SmartBlock
+	t *testing.T
+}
+
+func (o noIterateObject) Iterate(func(b simple.Block) (isContinue bool)) error {
+	o.t.Error("blocks of the object are walked")
+	return nil
+}
+
+func TestResolveSyncedContextId_BlocksOfTheObject(t *testing.T) {
+	objects := givenSyncedObjects()
+	objects["host"] = noIterateObject{SmartBlock: objects["host"], t: t}
+
+	// blocks of the object itself are found without looking for synced blocks
+	contextId, err := resolveSyncedContextId(objects, "host", []string{"hostText", "synced"})
+	require.NoError(t, err)
+	assert.Equal(t, "host", contextId)
+}
+
+func TestCheckNestedSyncedSubtrees(t *testing.T) {
+	objects := givenSyncedObjects()
+	st := objects["host"].NewState()
+
+	t.Run("synced block is added to the object", func(t *testing.T) {
+		assert.NoError(t, checkNestedSyncedSubtrees("host", "host", st, []string{"synced"}))
+	})
+	t.Run("synced block is added to the subtree of synced block", func(t *testing.T) {
+		assert.ErrorIs(t, checkNestedSyncedSubtrees("host", "source", st, []string{"synced"}), ErrNestedSyncedBlocks)
+	})
+	t.Run("synced block is a child of added block", func(t *testing.T) {
+		assert.ErrorIs(t, checkNestedSyncedSubtrees("host", "source", st, []string{"host"}), ErrNestedSyncedBlocks)
+	})
+	t.Run("blocks without synced blocks are added to the subtree of synced block", func(t *testing.T) {
+		assert.NoError(t, checkNestedSyncedSubtrees("host", "source", st, []string{"hostText"}))
+	})
+}
+
+func TestService_SyncedBlockEdits(t *testing.T) {
+	objects := givenSyncedObjects()
+	resolver := mock_idresolver.NewMockResolver(t)
+	resolver.EXPECT().ResolveSpaceID(mock.Anything).Return("space1", nil).Maybe()
+	spc := mock_clientspace.NewMockSpace(t)
+	spc.EXPECT().GetObject(mock.Anything, mock.Anything).RunAndReturn(objects.GetObject).Maybe()
+	spaceService := mock_space.NewMockService(t)
+	spaceService.EXPECT().Get(mock.Anything, "space1").Return(spc, nil).Maybe()
+	s := &Service{resolver: resolver, spaceService: spaceService}
+
+	t.Run("edit of the subtree is applied to the source", func(t *testing.T) {
+		err := s.SetAlign(nil, "host", model.Block_AlignCenter, "child")
+		require.NoError(t, err)
+
+		assert.Equal(t, model.Block_AlignCenter, objects["source"].Pick("child").Model().Align)
+		assert.Nil(t, objects["host"].Pick("child"))
+	})
+	t.Run("edit of the object is applied to the object", func(t *testing.T) {
+		err := s.SetAlign(nil, "host", model.Block_AlignRight, "hostText")
+		require.NoError(t, err)
+
+		assert.Equal(t, model.Block_AlignRight, objects["host"].Pick("hostText").Model().Align)
+	})
+	t.Run("mixed selection is rejected", func(t *testing.T) {
+		err := s.SetAlign(nil, "host", model.Block_AlignRight, "hostText", "child")
+		assert.ErrorIs(t, err, ErrMixedSyncedBlocks)
+
+		assert.Equal(t, model.Block_AlignRight, objects["host"].Pick("hostText").Model().Align)
+		assert.Equal(t, model.Block_AlignCenter, objects["source"].Pick("child").Model().Align)
+	})
+}
@@ -0,0 +1,84 @@
+package core
+
+import (
+	"context"
+
+	"github.com/anyproto/anytype-heart/core/block/comments"
+	"github.com/anyproto/anytype-heart/pb"
+)
+
+func (mw *Middleware) CommentAdd(cctx context.Context, req *pb.RpcCommentAddRequest) *pb.RpcCommentAddResponse {
+	ctx := mw.newContext(cctx)
+	commentService := mustService[comments.Service](mw)
+
+	commentId, err := commentService.AddComment(cctx, ctx, req.ObjectId, comments.AddCommentRequest{
+		ThreadId: req.ThreadId,
+		BlockId:  req.BlockId,
+		Range:    req.Range,
+		Message:  req.Message,
+	})
+	code := mapErrorCode(err, errToCode(comments.ErrBadAnchor, pb.RpcCommentAddResponseError_BAD_INPUT))
+	return &pb.RpcCommentAddResponse{
+		CommentId: commentId,
+		Event:     ctx.GetResponseEvent(),
+		Error: &pb.RpcCommentAddResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) CommentEdit(cctx context.Context, req *pb.RpcCommentEditRequest) *pb.RpcCommentEditResponse {
+	commentService := mustService[comments.Service](mw)
+
+	err := commentService.EditComment(cctx, req.ObjectId, req.CommentId, req.Message)
+	code := mapErrorCode[pb.RpcCommentEditResponseErrorCode](err)
+	return &pb.RpcCommentEditResponse{
+		Error: &pb.RpcCommentEditResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) CommentDelete(cctx context.Context, req *pb.RpcCommentDeleteRequest) *pb.RpcCommentDeleteResponse {
+	ctx := mw.newContext(cctx)
+	commentService := mustService[comments.Service](mw)
+
+	err := commentService.DeleteComment(cctx, ctx, req.ObjectId, req.CommentId)
+	code := mapErrorCode[pb.RpcCommentDeleteResponseErrorCode](err)
+	return &pb.RpcCommentDeleteResponse{
+		Event: ctx.GetResponseEvent(),
+		Error: &pb.RpcCommentDeleteResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) CommentSetResolved(cctx context.Context, req *pb.RpcCommentSetResolvedRequest) *pb.RpcCommentSetResolvedResponse {
+	commentService := mustService[comments.Service](mw)
+
+	err := commentService.SetResolved(cctx, req.ObjectId, req.ThreadId, req.Resolved)
+	code := mapErrorCode[pb.RpcCommentSetResolvedResponseErrorCode](err)
+	return &pb.RpcCommentSetResolvedResponse{
+		Error: &pb.RpcCommentSetResolvedResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
+
+func (mw *Middleware) CommentList(cctx context.Context, req *pb.RpcCommentListRequest) *pb.RpcCommentListResponse {
+	commentService := mustService[comments.Service](mw)
+
+	list, err := commentService.ListComments(cctx, req.ObjectId, req.IncludeResolved)
+	code := mapErrorCode[pb.RpcCommentListResponseErrorCode](err)
+	return &pb.RpcCommentListResponse{
+		Comments: list,
+		Error: &pb.RpcCommentListResponseError{
+			Code:        code,
+			Description: getErrorDescription(err),
+		},
+	}
+}
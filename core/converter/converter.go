@@ -19,3 +19,8 @@ type MultiConverter interface {
 	Converter
 	Add(space smartblock.Space, state *state.State) error
 }
+
+// AnchorsSetter is implemented by converters which render anchors of blocks, so links pointing to the blocks can be followed
+type AnchorsSetter interface {
+	SetAnchors(blockIds []string)
+}
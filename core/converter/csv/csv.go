@@ -0,0 +1,163 @@
+package csv
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	CommaSeparator = ','
+	TabSeparator   = '\t'
+
+	listSeparator = ", "
+)
+
+var dateLayouts = map[model.BlockContentDataviewRelationDateFormat]string{
+	model.BlockContentDataviewRelation_MonthAbbrBeforeDay: "Jan 2, 2006",
+	model.BlockContentDataviewRelation_MonthAbbrAfterDay:  "2 Jan 2006",
+	model.BlockContentDataviewRelation_Short:              "02.01.2006",
+	model.BlockContentDataviewRelation_ShortUS:            "01/02/2006",
+	model.BlockContentDataviewRelation_ISO:                "2006-01-02",
+}
+
+var timeLayouts = map[model.BlockContentDataviewRelationTimeFormat]string{
+	model.BlockContentDataviewRelation_Format12: "3:04 PM",
+	model.BlockContentDataviewRelation_Format24: "15:04",
+}
+
+// Column is the visible relation of the dataview view
+type Column struct {
+	Key    domain.RelationKey
+	Name   string
+	Format model.RelationFormat
+	// view settings of the date relation
+	DateFormat  model.BlockContentDataviewRelationDateFormat
+	TimeFormat  model.BlockContentDataviewRelationTimeFormat
+	IncludeTime bool
+}
+
+// table renders objects as rows of the table in the order they are added.
+// Values of object, tag and status relations are rendered as names of the known docs
+type table struct {
+	columns   []Column
+	separator rune
+	knownDocs map[string]*domain.Details
+	rows      [][]string
+}
+
+// NewMultiConverter creates the converter of the view with the given columns, the separator is
+// CommaSeparator for csv and TabSeparator for tsv
+func NewMultiConverter(columns []Column, separator rune) converter.MultiConverter {
+	return &table{columns: columns, separator: separator}
+}
+
+func (t *table) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	t.knownDocs = docs
+	return t
+}
+
+func (t *table) FileHashes() []string {
+	return nil
+}
+
+func (t *table) ImageHashes() []string {
+	return nil
+}
+
+func (t *table) Add(_ smartblock.Space, st *state.State) error {
+	details := st.CombinedDetails()
+	row := make([]string, 0, len(t.columns))
+	for _, column := range t.columns {
+		row = append(row, t.renderValue(column, details.Get(column.Key)))
+	}
+	t.rows = append(t.rows, row)
+	return nil
+}
+
+func (t *table) Convert(model.SmartBlockType) []byte {
+	var buf bytes.Buffer
+	wr := csv.NewWriter(&buf)
+	wr.Comma = t.separator
+	header := make([]string, 0, len(t.columns))
+	for _, column := range t.columns {
+		header = append(header, column.Name)
+	}
+	// writing to the buffer never fails
+	_ = wr.Write(header)
+	_ = wr.WriteAll(t.rows)
+	return buf.Bytes()
+}
+
+func (t *table) Ext() string {
+	if t.separator == TabSeparator {
+		return ".tsv"
+	}
+	return ".csv"
+}
+
+func (t *table) renderValue(column Column, value domain.Value) string {
+	if !value.Ok() || value.IsNull() {
+		return ""
+	}
+	switch column.Format {
+	case model.RelationFormat_number:
+		if f, ok := value.TryFloat64(); ok {
+			return strconv.FormatFloat(f, 'f', -1, 64)
+		}
+	case model.RelationFormat_checkbox:
+		return strconv.FormatBool(value.Bool())
+	case model.RelationFormat_date:
+		if ts, ok := value.TryInt64(); ok && ts != 0 {
+			return formatDate(column, time.Unix(ts, 0))
+		}
+		return ""
+	case model.RelationFormat_tag, model.RelationFormat_status, model.RelationFormat_object, model.RelationFormat_file:
+		ids := value.StringList()
+		if id := value.String(); id != "" {
+			ids = []string{id}
+		}
+		return strings.Join(t.objectNames(ids), listSeparator)
+	}
+	if list, ok := value.TryStringList(); ok {
+		return strings.Join(list, listSeparator)
+	}
+	return value.String()
+}
+
+// objectNames skips objects which are not known, i.e. deleted options
+func (t *table) objectNames(ids []string) []string {
+	names := make([]string, 0, len(ids))
+	for _, id := range ids {
+		details, ok := t.knownDocs[id]
+		if !ok {
+			continue
+		}
+		name := details.GetString(bundle.RelationKeyName)
+		if name == "" {
+			name = details.GetString(bundle.RelationKeySnippet)
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+func formatDate(column Column, date time.Time) string {
+	layout, ok := dateLayouts[column.DateFormat]
+	if !ok {
+		layout = dateLayouts[model.BlockContentDataviewRelation_MonthAbbrBeforeDay]
+	}
+	if column.IncludeTime {
+		layout += " " + timeLayouts[column.TimeFormat]
+	}
+	return date.Format(layout)
+}
@@ -0,0 +1,94 @@
+package csv
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newObjectState(id string, details map[domain.RelationKey]domain.Value) *state.State {
+	st := state.NewDoc(id, nil).(*state.State)
+	for key, value := range details {
+		st.SetDetail(key, value)
+	}
+	return st
+}
+
+func TestTable_Convert(t *testing.T) {
+	due := time.Date(2024, 3, 5, 14, 30, 0, 0, time.Local)
+	columns := []Column{
+		{Key: bundle.RelationKeyName, Name: "Name", Format: model.RelationFormat_shorttext},
+		{Key: bundle.RelationKeyTag, Name: "Tag", Format: model.RelationFormat_tag},
+		{Key: bundle.RelationKeyAssignee, Name: "Assignee", Format: model.RelationFormat_object},
+		{Key: bundle.RelationKeyDone, Name: "Done", Format: model.RelationFormat_checkbox},
+		{
+			Key:         bundle.RelationKeyDueDate,
+			Name:        "Due date",
+			Format:      model.RelationFormat_date,
+			DateFormat:  model.BlockContentDataviewRelation_ISO,
+			TimeFormat:  model.BlockContentDataviewRelation_Format24,
+			IncludeTime: true,
+		},
+		{Key: "estimate", Name: "Estimate", Format: model.RelationFormat_number},
+	}
+	knownDocs := map[string]*domain.Details{
+		"tag1":   domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("urgent")}),
+		"tag2":   domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("backend")}),
+		"person": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("Alice")}),
+	}
+
+	t.Run("csv", func(t *testing.T) {
+		c := NewMultiConverter(columns, CommaSeparator)
+		c.SetKnownDocs(knownDocs)
+		require.NoError(t, c.Add(nil, newObjectState("task1", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:     domain.String("Fix sync, again"),
+			bundle.RelationKeyTag:      domain.StringList([]string{"tag1", "deleted", "tag2"}),
+			bundle.RelationKeyAssignee: domain.String("person"),
+			bundle.RelationKeyDone:     domain.Bool(true),
+			bundle.RelationKeyDueDate:  domain.Int64(due.Unix()),
+			"estimate":                 domain.Float64(1.5),
+		})))
+		require.NoError(t, c.Add(nil, newObjectState("task2", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Write docs"),
+		})))
+
+		assert.Equal(t, ".csv", c.Ext())
+		assert.Equal(t, "Name,Tag,Assignee,Done,Due date,Estimate\n"+
+			"\"Fix sync, again\",\"urgent, backend\",Alice,true,2024-03-05 14:30,1.5\n"+
+			"Write docs,,,,,\n", string(c.Convert(model.SmartBlockType_Page)))
+	})
+	t.Run("tsv", func(t *testing.T) {
+		c := NewMultiConverter(columns[:2], TabSeparator)
+		c.SetKnownDocs(knownDocs)
+		require.NoError(t, c.Add(nil, newObjectState("task1", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Fix sync, again"),
+			bundle.RelationKeyTag:  domain.StringList([]string{"tag1", "tag2"}),
+		})))
+
+		assert.Equal(t, ".tsv", c.Ext())
+		assert.Equal(t, "Name\tTag\nFix sync, again\turgent, backend\n", string(c.Convert(model.SmartBlockType_Page)))
+	})
+}
+
+func TestFormatDate(t *testing.T) {
+	date := time.Date(2024, 3, 5, 9, 7, 0, 0, time.Local)
+	for _, tc := range []struct {
+		column Column
+		want   string
+	}{
+		{Column{DateFormat: model.BlockContentDataviewRelation_MonthAbbrBeforeDay}, "Mar 5, 2024"},
+		{Column{DateFormat: model.BlockContentDataviewRelation_MonthAbbrAfterDay}, "5 Mar 2024"},
+		{Column{DateFormat: model.BlockContentDataviewRelation_Short}, "05.03.2024"},
+		{Column{DateFormat: model.BlockContentDataviewRelation_ShortUS, IncludeTime: true}, "03/05/2024 9:07 AM"},
+		{Column{DateFormat: model.BlockContentDataviewRelation_ISO}, "2024-03-05"},
+	} {
+		assert.Equal(t, tc.want, formatDate(tc.column, date))
+	}
+}
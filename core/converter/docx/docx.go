@@ -0,0 +1,737 @@
+package docx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/table"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/pbtypes"
+	"github.com/anyproto/anytype-heart/util/text"
+	"github.com/anyproto/anytype-heart/util/uri"
+)
+
+var log = logging.Logger("docx-export")
+
+const (
+	// width of the text on the A4 page with default margins
+	textWidthTwips = 9026
+	emuPerTwip     = 635
+	emuPerPixel    = 9525
+	twipsPerPixel  = 15
+	maxImageWidth  = textWidthTwips * emuPerTwip
+)
+
+// FileNamer gives names to exported documents and files, the same object always gets the same name
+type FileNamer interface {
+	Get(path, hash, title, ext string) (name string)
+}
+
+// ImageLoader returns the content of the image stored in the file object, images are embedded to the document
+type ImageLoader func(fileObjectId string) ([]byte, error)
+
+// NewConverter creates the converter of the object to the Word document. When images is nil,
+// images are exported as links to files, like other files
+func NewConverter(s *state.State, fn FileNamer, images ImageLoader) converter.Converter {
+	return &DOCX{s: s, fn: fn, images: images}
+}
+
+type DOCX struct {
+	s      *state.State
+	fn     FileNamer
+	images ImageLoader
+
+	knownDocs   map[string]*domain.Details
+	fileHashes  []string
+	imageHashes []string
+
+	body          *bytes.Buffer
+	rels          []relationship
+	hyperlinks    map[string]string
+	media         []mediaFile
+	numberedLists []numberedList
+	drawings      int
+}
+
+type numberedList struct {
+	numId int
+	level int
+}
+
+func (d *DOCX) Convert(model.SmartBlockType) []byte {
+	root := d.s.Pick(d.s.RootId())
+	if root == nil {
+		return nil
+	}
+	d.body = bytes.NewBuffer(nil)
+	d.hyperlinks = make(map[string]string)
+	d.rels = []relationship{
+		{id: "rId1", relType: relTypeStyles, target: "styles.xml"},
+		{id: "rId2", relType: relTypeNumbering, target: "numbering.xml"},
+	}
+	d.renderChildren(root.Model(), 0)
+	result, err := d.pack(d.title())
+	if err != nil {
+		log.Errorf("failed to pack document: %v", err)
+		return nil
+	}
+	return result
+}
+
+func (d *DOCX) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	d.knownDocs = docs
+	return d
+}
+
+func (d *DOCX) FileHashes() []string {
+	return d.fileHashes
+}
+
+func (d *DOCX) ImageHashes() []string {
+	return d.imageHashes
+}
+
+func (d *DOCX) Ext() string {
+	return ".docx"
+}
+
+func (d *DOCX) title() string {
+	details := d.s.CombinedDetails()
+	title := details.GetString(bundle.RelationKeyName)
+	if title == "" {
+		title = details.GetString(bundle.RelationKeySnippet)
+	}
+	return title
+}
+
+func (d *DOCX) renderChildren(parent *model.Block, level int) {
+	var numId int
+	for _, id := range parent.ChildrenIds {
+		b := d.s.Pick(id)
+		if b == nil {
+			continue
+		}
+		m := b.Model()
+		// consecutive numbered items are one list, any other block starts the new list
+		if m.GetText() != nil && m.GetText().Style == model.BlockContentText_Numbered {
+			if numId == 0 {
+				numId = d.newNumberedList(level)
+			}
+		} else {
+			numId = 0
+		}
+		d.render(m, level, numId)
+	}
+}
+
+func (d *DOCX) render(b *model.Block, level, numId int) {
+	switch b.Content.(type) {
+	case *model.BlockContentOfSmartblock:
+	case *model.BlockContentOfText:
+		d.renderText(b, level, numId)
+	case *model.BlockContentOfFile:
+		d.renderFile(b, level)
+	case *model.BlockContentOfBookmark:
+		d.renderBookmark(b, level)
+	case *model.BlockContentOfDiv:
+		d.renderDiv(b, level)
+	case *model.BlockContentOfLink:
+		d.renderLink(b, level)
+	case *model.BlockContentOfLatex:
+		d.renderLatex(b, level)
+	case *model.BlockContentOfTable:
+		d.renderTable(b)
+	default:
+		d.renderChildren(b, level)
+	}
+}
+
+func (d *DOCX) newNumberedList(level int) int {
+	// numId 1 is used by bulleted lists
+	numId := len(d.numberedLists) + bulletNumId + 1
+	d.numberedLists = append(d.numberedLists, numberedList{numId: numId, level: listLevel(level)})
+	return numId
+}
+
+func listLevel(level int) int {
+	if level > maxListLevel {
+		return maxListLevel
+	}
+	return level
+}
+
+// paragraph holds properties of the paragraph, they are written in the order required by the schema
+type paragraph struct {
+	style     string
+	numId     int
+	level     int
+	border    string
+	fill      string
+	align     model.BlockAlign
+	keepLines bool
+}
+
+func (d *DOCX) writeParagraph(p paragraph, content func()) {
+	props := &strings.Builder{}
+	if p.style != "" {
+		fmt.Fprintf(props, `<w:pStyle w:val="%s"/>`, p.style)
+	}
+	if p.keepLines {
+		props.WriteString(`<w:keepLines/>`)
+	}
+	if p.numId != 0 {
+		fmt.Fprintf(props, `<w:numPr><w:ilvl w:val="%d"/><w:numId w:val="%d"/></w:numPr>`, listLevel(p.level), p.numId)
+	}
+	if p.border != "" {
+		fmt.Fprintf(props, `<w:pBdr><w:bottom w:val="%s" w:sz="6" w:space="1" w:color="auto"/></w:pBdr>`, p.border)
+	}
+	if p.fill != "" {
+		fmt.Fprintf(props, `<w:shd w:val="clear" w:color="auto" w:fill="%s"/>`, p.fill)
+	}
+	// list items are indented by the numbering
+	if p.numId == 0 && p.level > 0 {
+		fmt.Fprintf(props, `<w:ind w:left="%d"/>`, listIndent*p.level)
+	}
+	switch p.align {
+	case model.Block_AlignCenter:
+		props.WriteString(`<w:jc w:val="center"/>`)
+	case model.Block_AlignRight:
+		props.WriteString(`<w:jc w:val="right"/>`)
+	case model.Block_AlignJustify:
+		props.WriteString(`<w:jc w:val="both"/>`)
+	}
+	d.body.WriteString(`<w:p>`)
+	if props.Len() > 0 {
+		fmt.Fprintf(d.body, `<w:pPr>%s</w:pPr>`, props.String())
+	}
+	if content != nil {
+		content()
+	}
+	d.body.WriteString(`</w:p>`)
+}
+
+var textStyles = map[model.BlockContentTextStyle]string{
+	model.BlockContentText_Title:       "Title",
+	model.BlockContentText_Description: "Subtitle",
+	model.BlockContentText_Header1:     "Heading1",
+	model.BlockContentText_Header2:     "Heading2",
+	model.BlockContentText_Header3:     "Heading3",
+	model.BlockContentText_Header4:     "Heading4",
+	model.BlockContentText_Quote:       "Quote",
+	model.BlockContentText_Code:        "Code",
+	model.BlockContentText_Callout:     "Callout",
+	model.BlockContentText_Checkbox:    "ListParagraph",
+	model.BlockContentText_Marked:      "ListParagraph",
+	model.BlockContentText_Numbered:    "ListParagraph",
+}
+
+func (d *DOCX) renderText(b *model.Block, level, numId int) {
+	content := b.GetText()
+	p := paragraph{
+		style: textStyles[content.Style],
+		level: level,
+		fill:  backgroundColor(b.BackgroundColor),
+		align: b.Align,
+	}
+	var prefix string
+	switch content.Style {
+	case model.BlockContentText_Title:
+		if content.Text == "" {
+			prefix = d.title()
+		}
+	case model.BlockContentText_Marked:
+		p.numId = bulletNumId
+	case model.BlockContentText_Numbered:
+		p.numId = numId
+	case model.BlockContentText_Checkbox:
+		prefix = "☐ "
+		if content.Checked {
+			prefix = "☒ "
+		}
+	case model.BlockContentText_Callout:
+		if content.IconEmoji != "" {
+			prefix = content.IconEmoji + " "
+		}
+	case model.BlockContentText_Code:
+		p.keepLines = true
+	}
+	d.writeParagraph(p, func() {
+		if prefix != "" {
+			d.writeRun(run{}, prefix)
+		}
+		d.writeRuns(content)
+	})
+	d.renderChildren(b, level+1)
+}
+
+// run holds properties of the piece of text, they are written in the order required by the schema
+type run struct {
+	bold      bool
+	italic    bool
+	strike    bool
+	code      bool
+	underline bool
+	color     string
+	fill      string
+	link      string
+}
+
+func (d *DOCX) writeRun(r run, value string) {
+	if value == "" {
+		return
+	}
+	var linkId string
+	if r.link != "" {
+		linkId = d.addHyperlink(r.link)
+		fmt.Fprintf(d.body, `<w:hyperlink r:id="%s" w:history="1">`, linkId)
+	}
+	props := &strings.Builder{}
+	if linkId != "" {
+		props.WriteString(`<w:rStyle w:val="Hyperlink"/>`)
+	}
+	if r.code {
+		props.WriteString(`<w:rFonts w:ascii="Courier New" w:hAnsi="Courier New" w:cs="Courier New"/>`)
+	}
+	if r.bold {
+		props.WriteString(`<w:b/>`)
+	}
+	if r.italic {
+		props.WriteString(`<w:i/>`)
+	}
+	if r.strike {
+		props.WriteString(`<w:strike/>`)
+	}
+	if r.color != "" {
+		fmt.Fprintf(props, `<w:color w:val="%s"/>`, r.color)
+	}
+	if r.underline {
+		props.WriteString(`<w:u w:val="single"/>`)
+	}
+	if r.fill != "" {
+		fmt.Fprintf(props, `<w:shd w:val="clear" w:color="auto" w:fill="%s"/>`, r.fill)
+	}
+	d.body.WriteString(`<w:r>`)
+	if props.Len() > 0 {
+		fmt.Fprintf(d.body, `<w:rPr>%s</w:rPr>`, props.String())
+	}
+	for i, line := range strings.Split(value, "\n") {
+		if i > 0 {
+			d.body.WriteString(`<w:br/>`)
+		}
+		if line != "" {
+			fmt.Fprintf(d.body, `<w:t xml:space="preserve">%s</w:t>`, escape(line))
+		}
+	}
+	d.body.WriteString(`</w:r>`)
+	if linkId != "" {
+		d.body.WriteString(`</w:hyperlink>`)
+	}
+}
+
+// writeRuns splits the text by bounds of marks, so every piece of the text is written with all marks covering it.
+// Ranges of marks are in UTF-16 code units
+func (d *DOCX) writeRuns(content *model.BlockContentText) {
+	value := text.StrToUTF16(content.Text)
+	marks := content.GetMarks().GetMarks()
+	bounds := []int{0, len(value)}
+	for _, m := range marks {
+		if m.Range == nil || m.Range.From >= m.Range.To {
+			continue
+		}
+		bounds = append(bounds, clamp(int(m.Range.From), len(value)), clamp(int(m.Range.To), len(value)))
+	}
+	sort.Ints(bounds)
+	base := run{color: textColor(content.Color)}
+	for i := 1; i < len(bounds); i++ {
+		from, to := bounds[i-1], bounds[i]
+		if from == to {
+			continue
+		}
+		r := base
+		piece := text.UTF16ToStr(value[from:to])
+		for _, m := range marks {
+			if m.Range == nil || int(m.Range.From) > from || int(m.Range.To) < to {
+				continue
+			}
+			piece = d.applyMark(&r, m, piece)
+		}
+		d.writeRun(r, piece)
+	}
+}
+
+func (d *DOCX) applyMark(r *run, m *model.BlockContentTextMark, piece string) string {
+	switch m.Type {
+	case model.BlockContentTextMark_Bold:
+		r.bold = true
+	case model.BlockContentTextMark_Italic:
+		r.italic = true
+	case model.BlockContentTextMark_Strikethrough:
+		r.strike = true
+	case model.BlockContentTextMark_Underscored:
+		r.underline = true
+	case model.BlockContentTextMark_Keyboard:
+		r.code = true
+	case model.BlockContentTextMark_TextColor:
+		r.color = textColor(m.Param)
+	case model.BlockContentTextMark_BackgroundColor:
+		r.fill = backgroundColor(m.Param)
+	case model.BlockContentTextMark_Link:
+		if u, err := uri.ParseURI(m.Param); err == nil {
+			r.link = u.String()
+		}
+	case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object:
+		if _, path, ok := d.docLink(m.Param); ok {
+			r.link = path
+		}
+	case model.BlockContentTextMark_Emoji:
+		// the emoji is stored in the mark, the text contains its placeholder
+		return m.Param
+	}
+	return piece
+}
+
+func clamp(pos, length int) int {
+	if pos < 0 {
+		return 0
+	}
+	if pos > length {
+		return length
+	}
+	return pos
+}
+
+func (d *DOCX) addHyperlink(target string) string {
+	if id, ok := d.hyperlinks[target]; ok {
+		return id
+	}
+	id := d.addRelationship(relTypeHyperlink, target, true)
+	d.hyperlinks[target] = id
+	return id
+}
+
+func (d *DOCX) addRelationship(relType, target string, external bool) string {
+	id := fmt.Sprintf("rId%d", len(d.rels)+1)
+	d.rels = append(d.rels, relationship{id: id, relType: relType, target: target, external: external})
+	return id
+}
+
+// docLink returns the title of the exported object and the name of its document
+func (d *DOCX) docLink(id string) (title, path string, ok bool) {
+	details, ok := d.knownDocs[id]
+	if !ok {
+		return "", "", false
+	}
+	title = details.GetString(bundle.RelationKeyName)
+	if title == "" {
+		title = details.GetString(bundle.RelationKeySnippet)
+	}
+	if title == "" {
+		title = id
+	}
+	return title, filepath.ToSlash(d.fn.Get("", id, title, d.Ext())), true
+}
+
+func (d *DOCX) renderFile(b *model.Block, level int) {
+	file := b.GetFile()
+	if file == nil || file.State != model.BlockContentFile_Done {
+		return
+	}
+	p := paragraph{level: level, align: b.Align}
+	if file.Type == model.BlockContentFile_Image {
+		d.imageHashes = append(d.imageHashes, file.TargetObjectId)
+		if d.renderImage(b, p) {
+			d.renderChildren(b, level+1)
+			return
+		}
+	} else {
+		d.fileHashes = append(d.fileHashes, file.TargetObjectId)
+	}
+	path := d.fn.Get("files", file.TargetObjectId, filepath.Base(file.Name), filepath.Ext(file.Name))
+	d.writeParagraph(p, func() {
+		d.writeRun(run{link: filepath.ToSlash(path)}, file.Name)
+	})
+	d.renderChildren(b, level+1)
+}
+
+var imageExtensions = map[string]string{
+	"png":  "png",
+	"jpeg": "jpeg",
+	"gif":  "gif",
+}
+
+// renderImage embeds the image to the document, false is returned for images of formats not supported by Word
+func (d *DOCX) renderImage(b *model.Block, p paragraph) bool {
+	if d.images == nil {
+		return false
+	}
+	file := b.GetFile()
+	data, err := d.images(file.TargetObjectId)
+	if err != nil {
+		log.Warnf("failed to load image: %v", err)
+		return false
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || cfg.Width == 0 || cfg.Height == 0 {
+		return false
+	}
+	ext, ok := imageExtensions[format]
+	if !ok {
+		return false
+	}
+	name := fmt.Sprintf("image%d.%s", len(d.media)+1, ext)
+	d.media = append(d.media, mediaFile{name: name, data: data})
+	relId := d.addRelationship(relTypeImage, "media/"+name, false)
+
+	width := int64(cfg.Width) * emuPerPixel
+	// width of the image block is relative to the width of the page
+	if relative := pbtypes.GetFloat64(b.Fields, "width"); relative > 0 && relative <= 1 {
+		width = int64(relative * maxImageWidth)
+	}
+	if width > maxImageWidth {
+		width = maxImageWidth
+	}
+	height := width * int64(cfg.Height) / int64(cfg.Width)
+
+	d.drawings++
+	id := d.drawings
+	description := escape(file.Name)
+	d.writeParagraph(p, func() {
+		fmt.Fprintf(d.body, `<w:r><w:drawing><wp:inline distT="0" distB="0" distL="0" distR="0">`+
+			`<wp:extent cx="%[1]d" cy="%[2]d"/><wp:docPr id="%[3]d" name="Picture %[3]d" descr="%[4]s"/>`+
+			`<a:graphic><a:graphicData uri="http://schemas.openxmlformats.org/drawingml/2006/picture"><pic:pic>`+
+			`<pic:nvPicPr><pic:cNvPr id="%[3]d" name="%[4]s"/><pic:cNvPicPr/></pic:nvPicPr>`+
+			`<pic:blipFill><a:blip r:embed="%[5]s"/><a:stretch><a:fillRect/></a:stretch></pic:blipFill>`+
+			`<pic:spPr><a:xfrm><a:off x="0" y="0"/><a:ext cx="%[1]d" cy="%[2]d"/></a:xfrm><a:prstGeom prst="rect"><a:avLst/></a:prstGeom></pic:spPr>`+
+			`</pic:pic></a:graphicData></a:graphic></wp:inline></w:drawing></w:r>`,
+			width, height, id, description, relId)
+	})
+	return true
+}
+
+func (d *DOCX) renderBookmark(b *model.Block, level int) {
+	bm := b.GetBookmark()
+	if bm == nil || bm.Url == "" {
+		d.renderChildren(b, level)
+		return
+	}
+	title := bm.Title
+	if title == "" {
+		title = bm.Url
+	}
+	var link string
+	if u, err := uri.ParseURI(bm.Url); err == nil {
+		link = u.String()
+	}
+	d.writeParagraph(paragraph{level: level}, func() {
+		d.writeRun(run{link: link}, title)
+	})
+	d.renderChildren(b, level+1)
+}
+
+func (d *DOCX) renderDiv(b *model.Block, level int) {
+	border := "single"
+	if b.GetDiv().Style == model.BlockContentDiv_Dots {
+		border = "dotted"
+	}
+	d.writeParagraph(paragraph{level: level, border: border}, nil)
+	d.renderChildren(b, level)
+}
+
+func (d *DOCX) renderLink(b *model.Block, level int) {
+	l := b.GetLink()
+	if l == nil || l.TargetBlockId == "" {
+		return
+	}
+	title, path, ok := d.docLink(l.TargetBlockId)
+	if !ok {
+		return
+	}
+	d.writeParagraph(paragraph{level: level}, func() {
+		d.writeRun(run{link: path}, title)
+	})
+	d.renderChildren(b, level+1)
+}
+
+func (d *DOCX) renderLatex(b *model.Block, level int) {
+	l := b.GetLatex()
+	if l == nil || l.Text == "" {
+		return
+	}
+	d.writeParagraph(paragraph{style: "Code", level: level, keepLines: true}, func() {
+		d.writeRun(run{}, l.Text)
+	})
+}
+
+func (d *DOCX) renderTable(b *model.Block) {
+	tb, err := table.NewTable(d.s, b.Id)
+	if err != nil {
+		log.Warnf("failed to render table: %v", err)
+		return
+	}
+	colIds := tb.ColumnIDs()
+	if len(colIds) == 0 {
+		return
+	}
+	widths := make([]int, len(colIds))
+	for i, colId := range colIds {
+		widths[i] = textWidthTwips / len(colIds)
+		if col := d.s.Pick(colId); col != nil {
+			if width := pbtypes.GetFloat64(col.Model().GetFields(), "width"); width > 0 {
+				widths[i] = int(width) * twipsPerPixel
+			}
+		}
+	}
+	rowIds := tb.RowIDs()
+	firstRowHeader := false
+	if len(rowIds) > 0 {
+		if row := d.s.Pick(rowIds[0]); row != nil {
+			firstRowHeader = row.Model().GetTableRow().GetIsHeader()
+		}
+	}
+
+	d.body.WriteString(`<w:tbl><w:tblPr><w:tblStyle w:val="TableGrid"/><w:tblW w:w="0" w:type="auto"/>`)
+	if firstRowHeader {
+		d.body.WriteString(`<w:tblLook w:firstRow="1" w:lastRow="0" w:firstColumn="0" w:lastColumn="0" w:noHBand="1" w:noVBand="1"/>`)
+	} else {
+		d.body.WriteString(`<w:tblLook w:firstRow="0" w:lastRow="0" w:firstColumn="0" w:lastColumn="0" w:noHBand="1" w:noVBand="1"/>`)
+	}
+	d.body.WriteString(`</w:tblPr><w:tblGrid>`)
+	for _, width := range widths {
+		fmt.Fprintf(d.body, `<w:gridCol w:w="%d"/>`, width)
+	}
+	d.body.WriteString(`</w:tblGrid>`)
+	spans, covered := tb.MergedCells()
+	for _, rowId := range rowIds {
+		row := d.s.Pick(rowId)
+		if row == nil {
+			continue
+		}
+		d.body.WriteString(`<w:tr>`)
+		if row.Model().GetTableRow().GetIsHeader() {
+			d.body.WriteString(`<w:trPr><w:tblHeader/></w:trPr>`)
+		}
+		for i, colId := range colIds {
+			cellId := table.MakeCellID(rowId, colId)
+			anchorId, isCovered := covered[cellId]
+			if !isCovered {
+				span := spans[cellId]
+				d.renderCell(d.s.Pick(cellId), spanWidth(widths, i, span.ColSpan), span, false)
+				continue
+			}
+			// cells covered by the merged cell in rows below it continue its vertical merge
+			anchorRowId, anchorColId, err := table.ParseCellID(anchorId)
+			if err == nil && anchorRowId != rowId && anchorColId == colId {
+				span := spans[anchorId]
+				d.renderCell(d.s.Pick(anchorId), spanWidth(widths, i, span.ColSpan), span, true)
+			}
+		}
+		d.body.WriteString(`</w:tr>`)
+	}
+	d.body.WriteString(`</w:tbl>`)
+	// adjacent tables are merged by Word, so the table is always followed by the paragraph
+	d.writeParagraph(paragraph{}, nil)
+}
+
+func spanWidth(widths []int, colNumber, colSpan int) (width int) {
+	for _, w := range widths[colNumber:min(colNumber+max(colSpan, 1), len(widths))] {
+		width += w
+	}
+	return width
+}
+
+// renderCell renders the cell, continued is set for cells below the merged cell, they are rendered empty
+func (d *DOCX) renderCell(cell simple.Block, width int, span table.CellSpan, continued bool) {
+	fmt.Fprintf(d.body, `<w:tc><w:tcPr><w:tcW w:w="%d" w:type="dxa"/>`, width)
+	if span.ColSpan > 1 {
+		fmt.Fprintf(d.body, `<w:gridSpan w:val="%d"/>`, span.ColSpan)
+	}
+	if continued {
+		d.body.WriteString(`<w:vMerge/>`)
+	} else if span.RowSpan > 1 {
+		d.body.WriteString(`<w:vMerge w:val="restart"/>`)
+	}
+	if cell != nil {
+		if fill := backgroundColor(cell.Model().BackgroundColor); fill != "" {
+			fmt.Fprintf(d.body, `<w:shd w:val="clear" w:color="auto" w:fill="%s"/>`, fill)
+		}
+	}
+	d.body.WriteString(`</w:tcPr>`)
+	// every cell must contain a paragraph
+	if cell != nil && cell.Model().GetText() != nil && !continued {
+		d.renderText(cell.Model(), 0, 0)
+	} else {
+		d.writeParagraph(paragraph{}, nil)
+	}
+	d.body.WriteString(`</w:tc>`)
+}
+
+func escape(value string) string {
+	buf := &strings.Builder{}
+	if err := xml.EscapeText(buf, []byte(value)); err != nil {
+		return ""
+	}
+	return buf.String()
+}
+
+var reHexColor = regexp.MustCompile(`^#?([0-9a-fA-F]{6})$`)
+
+var textColors = map[string]string{
+	"grey":   "ACA996",
+	"yellow": "ECD91B",
+	"orange": "FFB522",
+	"red":    "F55522",
+	"pink":   "E51CA0",
+	"purple": "AB50CC",
+	"blue":   "3E58EB",
+	"ice":    "2AA7EE",
+	"teal":   "0FC8BA",
+	"lime":   "5DD400",
+	"black":  "2C2B27",
+}
+
+var backgroundColors = map[string]string{
+	"grey":   "F3F2EC",
+	"yellow": "FEF9CC",
+	"orange": "FEF3C5",
+	"red":    "FFEBE5",
+	"pink":   "FEE3F5",
+	"purple": "F4E3FA",
+	"blue":   "E4E7FC",
+	"ice":    "D6EFFD",
+	"teal":   "D6F5F3",
+	"lime":   "E3F7D0",
+}
+
+func textColor(color string) string {
+	return documentColor(textColors, color)
+}
+
+func backgroundColor(color string) string {
+	return documentColor(backgroundColors, color)
+}
+
+// documentColor returns the hex color of the named color of the editor, hex colors are kept as they are
+func documentColor(colors map[string]string, color string) string {
+	if hex, ok := colors[color]; ok {
+		return hex
+	}
+	if match := reHexColor.FindStringSubmatch(color); match != nil {
+		return strings.ToUpper(match[1])
+	}
+	return ""
+}
@@ -0,0 +1,255 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type testNamer map[string]string
+
+func (n testNamer) Get(path, hash, title, ext string) string {
+	if name, ok := n[hash]; ok {
+		return name
+	}
+	name := filepath.Join(path, strings.TrimSuffix(title, ext)+ext)
+	n[hash] = name
+	return name
+}
+
+func textBlock(id string, style model.BlockContentTextStyle, value string, children ...string) simple.Block {
+	return simple.New(&model.Block{Id: id, ChildrenIds: children, Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text: value, Style: style,
+	}}})
+}
+
+func newTestState(blocks ...simple.Block) *state.State {
+	ids := make([]string, 0, len(blocks))
+	byId := map[string]simple.Block{}
+	for _, b := range blocks {
+		byId[b.Model().Id] = b
+	}
+	for _, b := range blocks {
+		if !isChild(blocks, b.Model().Id) {
+			ids = append(ids, b.Model().Id)
+		}
+	}
+	byId["root"] = simple.New(&model.Block{Id: "root", ChildrenIds: ids})
+	return state.NewDoc("root", byId).(*state.State)
+}
+
+func isChild(blocks []simple.Block, id string) bool {
+	for _, b := range blocks {
+		for _, childId := range b.Model().ChildrenIds {
+			if childId == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readPackage returns parts of the document, xml parts are checked to be well-formed
+func readPackage(t *testing.T, data []byte) map[string]string {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	parts := map[string]string{}
+	for _, f := range zr.File {
+		rd, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rd)
+		require.NoError(t, err)
+		rd.Close()
+		if ext := filepath.Ext(f.Name); ext == ".xml" || ext == ".rels" {
+			decoder := xml.NewDecoder(bytes.NewReader(content))
+			for {
+				_, err := decoder.Token()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				require.NoError(t, err, f.Name)
+			}
+		}
+		parts[f.Name] = string(content)
+	}
+	return parts
+}
+
+func TestDOCX_Convert(t *testing.T) {
+	t.Run("text styles and marks", func(t *testing.T) {
+		// given
+		marked := textBlock("text", model.BlockContentText_Paragraph, "bold link other & co")
+		marked.Model().GetText().Marks = &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+			{Range: &model.Range{From: 0, To: 4}, Type: model.BlockContentTextMark_Bold},
+			{Range: &model.Range{From: 5, To: 9}, Type: model.BlockContentTextMark_Link, Param: "https://example.com"},
+			{Range: &model.Range{From: 10, To: 15}, Type: model.BlockContentTextMark_Mention, Param: "other"},
+			{Range: &model.Range{From: 16, To: 20}, Type: model.BlockContentTextMark_TextColor, Param: "red"},
+		}}
+		checkbox := textBlock("todo", model.BlockContentText_Checkbox, "done")
+		checkbox.Model().GetText().Checked = true
+		callout := textBlock("callout", model.BlockContentText_Callout, "note")
+		callout.Model().GetText().IconEmoji = "💡"
+		s := newTestState(
+			textBlock("title", model.BlockContentText_Title, ""),
+			textBlock("h1", model.BlockContentText_Header1, "Header"),
+			marked,
+			textBlock("b1", model.BlockContentText_Marked, "bullet", "b2"),
+			textBlock("b2", model.BlockContentText_Marked, "nested"),
+			textBlock("n1", model.BlockContentText_Numbered, "first"),
+			textBlock("n2", model.BlockContentText_Numbered, "second"),
+			checkbox,
+			textBlock("n3", model.BlockContentText_Numbered, "restarted"),
+			textBlock("quote", model.BlockContentText_Quote, "quote"),
+			textBlock("code", model.BlockContentText_Code, "line 1\nline 2"),
+			callout,
+		)
+		s.SetDetail(bundle.RelationKeyName, domain.String("Page <1>"))
+		conv := NewConverter(s, testNamer{}, nil)
+		conv.SetKnownDocs(map[string]*domain.Details{
+			"other": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("Other")}),
+		})
+
+		// when
+		parts := readPackage(t, conv.Convert(model.SmartBlockType_Page))
+
+		// then
+		assert.Equal(t, ".docx", conv.Ext())
+		for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "docProps/core.xml", "word/styles.xml", "word/numbering.xml", "word/_rels/document.xml.rels"} {
+			assert.Contains(t, parts, name)
+		}
+		assert.Contains(t, parts["docProps/core.xml"], "<dc:title>Page &lt;1&gt;</dc:title>")
+
+		document := parts["word/document.xml"]
+		assert.Contains(t, document, `<w:pStyle w:val="Title"/></w:pPr><w:r><w:t xml:space="preserve">Page &lt;1&gt;</w:t></w:r>`)
+		assert.Contains(t, document, `<w:pStyle w:val="Heading1"/></w:pPr><w:r><w:t xml:space="preserve">Header</w:t>`)
+		assert.Contains(t, document, `<w:r><w:rPr><w:b/></w:rPr><w:t xml:space="preserve">bold</w:t></w:r>`)
+		assert.Contains(t, document, `<w:hyperlink r:id="rId3" w:history="1"><w:r><w:rPr><w:rStyle w:val="Hyperlink"/></w:rPr><w:t xml:space="preserve">link</w:t></w:r></w:hyperlink>`)
+		assert.Contains(t, document, `<w:hyperlink r:id="rId4" w:history="1"><w:r><w:rPr><w:rStyle w:val="Hyperlink"/></w:rPr><w:t xml:space="preserve">other</w:t></w:r></w:hyperlink>`)
+		assert.Contains(t, document, `<w:r><w:rPr><w:color w:val="F55522"/></w:rPr><w:t xml:space="preserve">&amp; co</w:t></w:r>`)
+		assert.Contains(t, document, `<w:numPr><w:ilvl w:val="0"/><w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t xml:space="preserve">bullet</w:t>`)
+		assert.Contains(t, document, `<w:numPr><w:ilvl w:val="1"/><w:numId w:val="1"/></w:numPr></w:pPr><w:r><w:t xml:space="preserve">nested</w:t>`)
+		assert.Contains(t, document, `<w:numId w:val="2"/></w:numPr></w:pPr><w:r><w:t xml:space="preserve">first</w:t>`)
+		assert.Contains(t, document, `<w:numId w:val="2"/></w:numPr></w:pPr><w:r><w:t xml:space="preserve">second</w:t>`)
+		assert.Contains(t, document, `<w:numId w:val="3"/></w:numPr></w:pPr><w:r><w:t xml:space="preserve">restarted</w:t>`)
+		assert.Contains(t, document, `<w:t xml:space="preserve">☒ </w:t></w:r><w:r><w:t xml:space="preserve">done</w:t>`)
+		assert.Contains(t, document, `<w:pStyle w:val="Quote"/>`)
+		assert.Contains(t, document, `<w:t xml:space="preserve">line 1</w:t><w:br/><w:t xml:space="preserve">line 2</w:t>`)
+		assert.Contains(t, document, `<w:pStyle w:val="Callout"/></w:pPr><w:r><w:t xml:space="preserve">💡 </w:t>`)
+
+		rels := parts["word/_rels/document.xml.rels"]
+		assert.Contains(t, rels, `<Relationship Id="rId3" Type="`+relTypeHyperlink+`" Target="https://example.com" TargetMode="External"/>`)
+		assert.Contains(t, rels, `<Relationship Id="rId4" Type="`+relTypeHyperlink+`" Target="Other.docx" TargetMode="External"/>`)
+		numbering := parts["word/numbering.xml"]
+		assert.Contains(t, numbering, `<w:num w:numId="2"><w:abstractNumId w:val="1"/><w:lvlOverride w:ilvl="0"><w:startOverride w:val="1"/></w:lvlOverride></w:num>`)
+		assert.Contains(t, numbering, `<w:num w:numId="3">`)
+	})
+	t.Run("table", func(t *testing.T) {
+		// given
+		cell := func(id, value string) simple.Block {
+			return textBlock(id, model.BlockContentText_Paragraph, value)
+		}
+		s := newTestState(
+			simple.New(&model.Block{Id: "table", ChildrenIds: []string{"columns", "rows"}, Content: &model.BlockContentOfTable{Table: &model.BlockContentTable{}}}),
+			simple.New(&model.Block{Id: "columns", ChildrenIds: []string{"c1", "c2"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableColumns}}}),
+			simple.New(&model.Block{Id: "rows", ChildrenIds: []string{"r1", "r2"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableRows}}}),
+			simple.New(&model.Block{Id: "c1", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+			simple.New(&model.Block{Id: "c2", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+			simple.New(&model.Block{Id: "r1", ChildrenIds: []string{"r1-c1", "r1-c2"}, Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{IsHeader: true}}}),
+			simple.New(&model.Block{Id: "r2", ChildrenIds: []string{"r2-c1"}, Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{}}}),
+			cell("r1-c1", "Name"),
+			cell("r1-c2", "Value"),
+			cell("r2-c1", "a"),
+		)
+		conv := NewConverter(s, testNamer{}, nil)
+
+		// when
+		document := readPackage(t, conv.Convert(model.SmartBlockType_Page))["word/document.xml"]
+
+		// then
+		assert.Contains(t, document, `<w:tblGrid><w:gridCol w:w="4513"/><w:gridCol w:w="4513"/></w:tblGrid>`)
+		assert.Contains(t, document, `<w:tr><w:trPr><w:tblHeader/></w:trPr><w:tc><w:tcPr><w:tcW w:w="4513" w:type="dxa"/></w:tcPr><w:p><w:r><w:t xml:space="preserve">Name</w:t>`)
+		// missing cells are rendered as empty ones
+		assert.Contains(t, document, `<w:t xml:space="preserve">a</w:t></w:r></w:p></w:tc><w:tc><w:tcPr><w:tcW w:w="4513" w:type="dxa"/></w:tcPr><w:p></w:p></w:tc></w:tr></w:tbl><w:p>`)
+	})
+	t.Run("table with merged cells", func(t *testing.T) {
+		// given
+		cell := func(id, value string) simple.Block {
+			return textBlock(id, model.BlockContentText_Paragraph, value)
+		}
+		row := func(id string, cells []string, spans ...*model.BlockContentTableRowCellSpan) simple.Block {
+			return simple.New(&model.Block{Id: id, ChildrenIds: cells, Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{CellSpans: spans}}})
+		}
+		s := newTestState(
+			simple.New(&model.Block{Id: "table", ChildrenIds: []string{"columns", "rows"}, Content: &model.BlockContentOfTable{Table: &model.BlockContentTable{}}}),
+			simple.New(&model.Block{Id: "columns", ChildrenIds: []string{"c1", "c2", "c3"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableColumns}}}),
+			simple.New(&model.Block{Id: "rows", ChildrenIds: []string{"r1", "r2"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableRows}}}),
+			simple.New(&model.Block{Id: "c1", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+			simple.New(&model.Block{Id: "c2", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+			simple.New(&model.Block{Id: "c3", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+			row("r1", []string{"r1-c1"}, &model.BlockContentTableRowCellSpan{ColumnId: "c1", RowSpan: 2, ColSpan: 2}),
+			row("r2", []string{"r2-c3"}),
+			cell("r1-c1", "merged"),
+			cell("r2-c3", "b"),
+		)
+		conv := NewConverter(s, testNamer{}, nil)
+
+		// when
+		document := readPackage(t, conv.Convert(model.SmartBlockType_Page))["word/document.xml"]
+
+		// then
+		assert.Contains(t, document, `<w:tr><w:tc><w:tcPr><w:tcW w:w="6016" w:type="dxa"/><w:gridSpan w:val="2"/><w:vMerge w:val="restart"/></w:tcPr><w:p><w:r><w:t xml:space="preserve">merged</w:t>`)
+		assert.Contains(t, document, `<w:tr><w:tc><w:tcPr><w:tcW w:w="6016" w:type="dxa"/><w:gridSpan w:val="2"/><w:vMerge/></w:tcPr><w:p></w:p></w:tc><w:tc><w:tcPr><w:tcW w:w="3008" w:type="dxa"/></w:tcPr><w:p><w:r><w:t xml:space="preserve">b</w:t>`)
+	})
+	t.Run("images and files", func(t *testing.T) {
+		// given
+		img := bytes.NewBuffer(nil)
+		require.NoError(t, png.Encode(img, image.NewRGBA(image.Rect(0, 0, 20, 10))))
+		file := func(id, name, target string, fileType model.BlockContentFileType) simple.Block {
+			return simple.New(&model.Block{Id: id, Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+				Name: name, Type: fileType, State: model.BlockContentFile_Done, TargetObjectId: target,
+			}}})
+		}
+		s := newTestState(
+			file("image", "cat.png", "cat", model.BlockContentFile_Image),
+			file("broken", "dog.png", "dog", model.BlockContentFile_Image),
+			file("file", "report.pdf", "report", model.BlockContentFile_PDF),
+		)
+		conv := NewConverter(s, testNamer{}, func(fileObjectId string) ([]byte, error) {
+			if fileObjectId == "cat" {
+				return img.Bytes(), nil
+			}
+			return nil, errors.New("not found")
+		})
+
+		// when
+		parts := readPackage(t, conv.Convert(model.SmartBlockType_Page))
+
+		// then
+		assert.Equal(t, img.String(), parts["word/media/image1.png"])
+		document := parts["word/document.xml"]
+		assert.Contains(t, document, `<wp:extent cx="190500" cy="95250"/>`)
+		assert.Contains(t, document, `<a:blip r:embed="rId3"/>`)
+		assert.Contains(t, parts["word/_rels/document.xml.rels"], `<Relationship Id="rId3" Type="`+relTypeImage+`" Target="media/image1.png"/>`)
+		// images which can't be loaded are linked like files
+		assert.Contains(t, parts["word/_rels/document.xml.rels"], `Target="files/dog.png" TargetMode="External"`)
+		assert.Contains(t, document, `<w:t xml:space="preserve">report.pdf</w:t>`)
+		assert.Equal(t, []string{"cat", "dog"}, conv.ImageHashes())
+		assert.Equal(t, []string{"report"}, conv.FileHashes())
+	})
+}
@@ -0,0 +1,207 @@
+package docx
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+const (
+	relTypeStyles    = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles"
+	relTypeNumbering = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/numbering"
+	relTypeHyperlink = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/hyperlink"
+	relTypeImage     = "http://schemas.openxmlformats.org/officeDocument/2006/relationships/image"
+
+	xmlHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n"
+
+	contentTypes = xmlHeader + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Default Extension="png" ContentType="image/png"/>` +
+		`<Default Extension="jpeg" ContentType="image/jpeg"/>` +
+		`<Default Extension="gif" ContentType="image/gif"/>` +
+		`<Override PartName="/word/document.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.document.main+xml"/>` +
+		`<Override PartName="/word/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.styles+xml"/>` +
+		`<Override PartName="/word/numbering.xml" ContentType="application/vnd.openxmlformats-officedocument.wordprocessingml.numbering+xml"/>` +
+		`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>` +
+		`</Types>`
+
+	packageRels = xmlHeader + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="word/document.xml"/>` +
+		`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/>` +
+		`</Relationships>`
+
+	coreProperties = xmlHeader + `<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" ` +
+		`xmlns:dc="http://purl.org/dc/elements/1.1/"><dc:title>%s</dc:title></cp:coreProperties>`
+
+	documentStart = xmlHeader + `<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main" ` +
+		`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships" ` +
+		`xmlns:wp="http://schemas.openxmlformats.org/drawingml/2006/wordprocessingDrawing" ` +
+		`xmlns:a="http://schemas.openxmlformats.org/drawingml/2006/main" ` +
+		`xmlns:pic="http://schemas.openxmlformats.org/drawingml/2006/picture"><w:body>`
+
+	// A4 page with 2.54cm margins
+	documentEnd = `<w:sectPr><w:pgSz w:w="11906" w:h="16838"/>` +
+		`<w:pgMar w:top="1440" w:right="1440" w:bottom="1440" w:left="1440" w:header="708" w:footer="708" w:gutter="0"/>` +
+		`</w:sectPr></w:body></w:document>`
+
+	stylesStart = xmlHeader + `<w:styles xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">` +
+		`<w:docDefaults><w:rPrDefault><w:rPr><w:rFonts w:ascii="Calibri" w:hAnsi="Calibri" w:eastAsia="Calibri" w:cs="Calibri"/>` +
+		`<w:sz w:val="22"/><w:szCs w:val="22"/></w:rPr></w:rPrDefault>` +
+		`<w:pPrDefault><w:pPr><w:spacing w:after="120" w:line="276" w:lineRule="auto"/></w:pPr></w:pPrDefault></w:docDefaults>` +
+		`<w:style w:type="paragraph" w:default="1" w:styleId="Normal"><w:name w:val="Normal"/><w:qFormat/></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Title"><w:name w:val="Title"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:spacing w:after="240"/></w:pPr><w:rPr><w:b/><w:sz w:val="52"/><w:szCs w:val="52"/></w:rPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Subtitle"><w:name w:val="Subtitle"/><w:basedOn w:val="Normal"/><w:next w:val="Normal"/><w:qFormat/>` +
+		`<w:rPr><w:color w:val="595959"/><w:sz w:val="26"/><w:szCs w:val="26"/></w:rPr></w:style>`
+
+	stylesEnd = `<w:style w:type="paragraph" w:styleId="Quote"><w:name w:val="Quote"/><w:basedOn w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:pBdr><w:left w:val="single" w:sz="18" w:space="8" w:color="2C2B27"/></w:pBdr><w:ind w:left="284"/></w:pPr>` +
+		`<w:rPr><w:i/></w:rPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Code"><w:name w:val="Code"/><w:basedOn w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:shd w:val="clear" w:color="auto" w:fill="F3F2EC"/><w:spacing w:line="240" w:lineRule="auto"/></w:pPr>` +
+		`<w:rPr><w:rFonts w:ascii="Courier New" w:hAnsi="Courier New" w:cs="Courier New"/><w:sz w:val="20"/><w:szCs w:val="20"/></w:rPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="Callout"><w:name w:val="Callout"/><w:basedOn w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:pBdr><w:top w:val="single" w:sz="4" w:space="4" w:color="F3F2EC"/><w:left w:val="single" w:sz="4" w:space="4" w:color="F3F2EC"/>` +
+		`<w:bottom w:val="single" w:sz="4" w:space="4" w:color="F3F2EC"/><w:right w:val="single" w:sz="4" w:space="4" w:color="F3F2EC"/></w:pBdr>` +
+		`<w:shd w:val="clear" w:color="auto" w:fill="F3F2EC"/></w:pPr></w:style>` +
+		`<w:style w:type="paragraph" w:styleId="ListParagraph"><w:name w:val="List Paragraph"/><w:basedOn w:val="Normal"/><w:qFormat/>` +
+		`<w:pPr><w:spacing w:after="0"/><w:contextualSpacing/></w:pPr></w:style>` +
+		`<w:style w:type="character" w:styleId="Hyperlink"><w:name w:val="Hyperlink"/><w:rPr><w:color w:val="0563C1"/><w:u w:val="single"/></w:rPr></w:style>` +
+		`<w:style w:type="table" w:styleId="TableGrid"><w:name w:val="Table Grid"/><w:tblPr><w:tblBorders>` +
+		`<w:top w:val="single" w:sz="4" w:space="0" w:color="DFDDD0"/><w:left w:val="single" w:sz="4" w:space="0" w:color="DFDDD0"/>` +
+		`<w:bottom w:val="single" w:sz="4" w:space="0" w:color="DFDDD0"/><w:right w:val="single" w:sz="4" w:space="0" w:color="DFDDD0"/>` +
+		`<w:insideH w:val="single" w:sz="4" w:space="0" w:color="DFDDD0"/><w:insideV w:val="single" w:sz="4" w:space="0" w:color="DFDDD0"/>` +
+		`</w:tblBorders><w:tblCellMar><w:left w:w="108" w:type="dxa"/><w:right w:w="108" w:type="dxa"/></w:tblCellMar></w:tblPr>` +
+		`<w:tblStylePr w:type="firstRow"><w:rPr><w:b/></w:rPr></w:tblStylePr></w:style>` +
+		`</w:styles>`
+
+	// headingStyle is formatted by the level of the heading
+	headingStyle = `<w:style w:type="paragraph" w:styleId="Heading%[1]d"><w:name w:val="heading %[1]d"/><w:basedOn w:val="Normal"/>` +
+		`<w:next w:val="Normal"/><w:qFormat/><w:pPr><w:keepNext/><w:spacing w:before="240" w:after="120"/><w:outlineLvl w:val="%[2]d"/></w:pPr>` +
+		`<w:rPr><w:b/><w:sz w:val="%[3]d"/><w:szCs w:val="%[3]d"/></w:rPr></w:style>`
+)
+
+// font sizes of headings in half-points
+var headingSizes = []int{36, 30, 26, 24}
+
+func stylesXML() string {
+	buf := &strings.Builder{}
+	buf.WriteString(stylesStart)
+	for i, size := range headingSizes {
+		fmt.Fprintf(buf, headingStyle, i+1, i, size)
+	}
+	buf.WriteString(stylesEnd)
+	return buf.String()
+}
+
+const (
+	bulletNumId    = 1
+	maxListLevel   = 8
+	listIndent     = 720
+	listHanging    = 360
+	abstractBullet = 0
+	abstractNumber = 1
+)
+
+var (
+	bulletSymbols = []string{"•", "◦", "▪"}
+	numberFormats = []string{"decimal", "lowerLetter", "lowerRoman"}
+)
+
+// numberingXML defines bulleted and numbered lists. Each numbered list is its own instance of the definition,
+// so numbering of every list starts from one
+func numberingXML(numberedLists []numberedList) string {
+	buf := &strings.Builder{}
+	buf.WriteString(xmlHeader)
+	buf.WriteString(`<w:numbering xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main">`)
+	fmt.Fprintf(buf, `<w:abstractNum w:abstractNumId="%d"><w:multiLevelType w:val="hybridMultilevel"/>`, abstractBullet)
+	for level := 0; level <= maxListLevel; level++ {
+		writeListLevel(buf, level, "bullet", bulletSymbols[level%len(bulletSymbols)])
+	}
+	buf.WriteString(`</w:abstractNum>`)
+	fmt.Fprintf(buf, `<w:abstractNum w:abstractNumId="%d"><w:multiLevelType w:val="hybridMultilevel"/>`, abstractNumber)
+	for level := 0; level <= maxListLevel; level++ {
+		writeListLevel(buf, level, numberFormats[level%len(numberFormats)], fmt.Sprintf("%%%d.", level+1))
+	}
+	buf.WriteString(`</w:abstractNum>`)
+	fmt.Fprintf(buf, `<w:num w:numId="%d"><w:abstractNumId w:val="%d"/></w:num>`, bulletNumId, abstractBullet)
+	for _, list := range numberedLists {
+		fmt.Fprintf(buf, `<w:num w:numId="%d"><w:abstractNumId w:val="%d"/>`+
+			`<w:lvlOverride w:ilvl="%d"><w:startOverride w:val="1"/></w:lvlOverride></w:num>`,
+			list.numId, abstractNumber, list.level)
+	}
+	buf.WriteString(`</w:numbering>`)
+	return buf.String()
+}
+
+func writeListLevel(buf *strings.Builder, level int, format, text string) {
+	fmt.Fprintf(buf, `<w:lvl w:ilvl="%d"><w:start w:val="1"/><w:numFmt w:val="%s"/><w:lvlText w:val="%s"/><w:lvlJc w:val="left"/>`+
+		`<w:pPr><w:ind w:left="%d" w:hanging="%d"/></w:pPr></w:lvl>`,
+		level, format, text, listIndent*(level+1), listHanging)
+}
+
+type relationship struct {
+	id       string
+	relType  string
+	target   string
+	external bool
+}
+
+func relationshipsXML(rels []relationship) string {
+	buf := &strings.Builder{}
+	buf.WriteString(xmlHeader)
+	buf.WriteString(`<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`)
+	for _, rel := range rels {
+		fmt.Fprintf(buf, `<Relationship Id="%s" Type="%s" Target="%s"`, rel.id, rel.relType, escape(rel.target))
+		if rel.external {
+			buf.WriteString(` TargetMode="External"`)
+		}
+		buf.WriteString(`/>`)
+	}
+	buf.WriteString(`</Relationships>`)
+	return buf.String()
+}
+
+type mediaFile struct {
+	name string
+	data []byte
+}
+
+// pack writes parts of the document to the Office Open XML package
+func (d *DOCX) pack(title string) ([]byte, error) {
+	buf := bytes.NewBuffer(nil)
+	zw := zip.NewWriter(buf)
+	parts := []struct {
+		name string
+		data []byte
+	}{
+		{"[Content_Types].xml", []byte(contentTypes)},
+		{"_rels/.rels", []byte(packageRels)},
+		{"docProps/core.xml", []byte(fmt.Sprintf(coreProperties, escape(title)))},
+		{"word/document.xml", append(append([]byte(documentStart), d.body.Bytes()...), documentEnd...)},
+		{"word/styles.xml", []byte(stylesXML())},
+		{"word/numbering.xml", []byte(numberingXML(d.numberedLists))},
+		{"word/_rels/document.xml.rels", []byte(relationshipsXML(d.rels))},
+	}
+	for _, media := range d.media {
+		parts = append(parts, struct {
+			name string
+			data []byte
+		}{"word/media/" + media.name, media.data})
+	}
+	for _, part := range parts {
+		w, err := zw.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("create %s: %w", part.name, err)
+		}
+		if _, err = w.Write(part.data); err != nil {
+			return nil, fmt.Errorf("write %s: %w", part.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -43,6 +43,30 @@ const (
 	wrapExportEnd = `</div>
 			</body>
 		</html>`
+	// wrapSiteStart is formatted with the page title
+	wrapSiteStart = `<!DOCTYPE html>
+<html>
+	<head>
+		<meta http-equiv="content-type" content="text/html; charset=utf-8" />
+		<meta name="viewport" content="width=device-width, initial-scale=1" />
+		<title>%s</title>
+		<style type="text/css">
+			body { max-width: 704px; margin: 0px auto; padding: 24px; font-family: sans-serif; }
+			nav { margin-bottom: 16px; }
+			img { max-width: 100%%; }
+			.row > * { display: flex; }
+			.paragraph {` + styleParagraph + `}
+			.callout-image { margin-right: 6px; display: inline-block; }
+			.backlinks { margin-top: 40px; border-top: 1px solid #dfddd0; }
+			kbd {` + styleKbd + `}
+		</style>
+	</head>
+	<body>
+`
+	wrapSiteEnd = `
+	</body>
+</html>
+`
 
 	styleParagraph = "font-size: 15px; line-height: 24px; letter-spacing: -0.08px; font-weight: 400; word-wrap: break-word;"
 	styleHeader1   = "padding: 23px 0px 1px 0px; font-size: 28px; line-height: 32px; letter-spacing: -0.36px; font-weight: 600;"
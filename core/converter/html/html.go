@@ -38,6 +38,14 @@ type HTML struct {
 	buf               *bytes.Buffer
 	fileService       files.Service
 	fileObjectService fileobject.Service
+
+	// static site export
+	fn          FileNamer
+	knownDocs   map[string]*domain.Details
+	anchors     map[string]struct{}
+	pagePath    string
+	fileHashes  []string
+	imageHashes []string
 }
 
 func (h *HTML) Convert() (result string) {
@@ -116,6 +124,7 @@ func (h *HTML) renderText(rs *renderState, b *model.Block) {
 			rs.OpenUL()
 		}
 		h.buf.WriteString(`<li>`)
+		h.writeAnchor(b.Id)
 		h.writeTextToBuf(text)
 		h.renderChildren(b)
 		h.buf.WriteString(`</li>`)
@@ -127,6 +136,7 @@ func (h *HTML) renderText(rs *renderState, b *model.Block) {
 			rs.OpenOL()
 		}
 		h.buf.WriteString(`<li>`)
+		h.writeAnchor(b.Id)
 		h.writeTextToBuf(text)
 		h.renderChildren(b)
 		h.buf.WriteString(`</li>`)
@@ -142,6 +152,7 @@ func (h *HTML) renderText(rs *renderState, b *model.Block) {
 		}
 
 		fmt.Fprintf(h.buf, `<div style="%s">%s`, styleCallout, img)
+		h.writeAnchor(b.Id)
 		h.writeTextToBuf(text)
 		h.renderChildren(b)
 		h.buf.WriteString(`</div>`)
@@ -155,6 +166,7 @@ func (h *HTML) renderText(rs *renderState, b *model.Block) {
 		}
 		rs.Close()
 		h.buf.WriteString(tags.OpenTag)
+		h.writeAnchor(b.Id)
 		h.writeTextToBuf(text)
 		h.renderChildren(b)
 		h.buf.WriteString(tags.CloseTag)
@@ -166,6 +178,10 @@ func (h *HTML) renderFile(b *model.Block) {
 	if file.State != model.BlockContentFile_Done {
 		return
 	}
+	if h.isSite() {
+		h.renderSiteFile(b, file)
+		return
+	}
 	goToAnytypeMsg := `<div class="message">
 		<div class="header">This content is available in Anytype.</div>
 		Follow <a href="https://anytype.io">link</a> to ask a permission to get the content
@@ -269,6 +285,10 @@ func (h *HTML) renderLayout(b *model.Block) {
 }
 
 func (h *HTML) renderLink(b *model.Block) {
+	if h.isSite() {
+		h.renderSiteLink(b)
+		return
+	}
 	if len(b.ChildrenIds) > 0 {
 		h.buf.WriteString("<div>")
 	}
@@ -300,12 +320,13 @@ func (h *HTML) renderTable(b *model.Block) {
 		}
 		colWidth[colId] = pbtypes.GetFloat64(col.Model().GetFields(), "width")
 	}
+	spans, covered := tb.MergedCells()
 	for _, rowID := range tb.RowIDs() {
-		h.renderRow(rowID, cols, colWidth)
+		h.renderRow(rowID, cols, colWidth, spans, covered)
 	}
 }
 
-func (h *HTML) renderRow(rowId string, cols *model.Block, colWidth map[string]float64) {
+func (h *HTML) renderRow(rowId string, cols *model.Block, colWidth map[string]float64, spans map[string]table.CellSpan, covered map[string]string) {
 	row := h.s.Pick(rowId)
 	if row == nil {
 		return
@@ -323,15 +344,25 @@ func (h *HTML) renderRow(rowId string, cols *model.Block, colWidth map[string]fl
 	}
 
 	for _, colId := range cols.ChildrenIds {
-		h.renderCell(colWidth, colId, colToCell)
+		cellId := table.MakeCellID(rowId, colId)
+		if _, ok := covered[cellId]; ok {
+			continue
+		}
+		h.renderCell(colWidth, colId, colToCell, spans[cellId])
 	}
 }
 
-func (h *HTML) renderCell(colWidth map[string]float64, colId string, colToCell map[string]string) {
+func (h *HTML) renderCell(colWidth map[string]float64, colId string, colToCell map[string]string, span table.CellSpan) {
 	var extraAttr, extraStyle string
-	if w := colWidth[colId]; w > 0 {
+	if w := colWidth[colId]; w > 0 && span.ColSpan <= 1 {
 		extraAttr += fmt.Sprintf(` width="%d"`, int(w))
 	}
+	if span.RowSpan > 1 {
+		extraAttr += fmt.Sprintf(` rowspan="%d"`, span.RowSpan)
+	}
+	if span.ColSpan > 1 {
+		extraAttr += fmt.Sprintf(` colspan="%d"`, span.ColSpan)
+	}
 
 	var cell simple.Block
 	cellId, ok := colToCell[colId]
@@ -387,6 +418,17 @@ func (h *HTML) writeTag(m *model.BlockContentTextMark, start bool) {
 		} else {
 			h.buf.WriteString("</a>")
 		}
+	case model.BlockContentTextMark_Mention, model.BlockContentTextMark_Object:
+		if !h.isSite() {
+			return
+		}
+		if _, path, ok := h.blockLink(m.Param, m.FocusBlockId); ok {
+			if start {
+				fmt.Fprintf(h.buf, `<a href="%s">`, path)
+			} else {
+				h.buf.WriteString("</a>")
+			}
+		}
 	case model.BlockContentTextMark_TextColor:
 		if start {
 			fmt.Fprintf(h.buf, `<span style="color:%s">`, textColor(m.Param))
@@ -85,6 +85,19 @@ func TestHTML_Convert(t *testing.T) {
 
 		assert.Equal(t, expected, givenTrimmedString(html))
 	})
+
+	t.Run("table with merged cells", func(t *testing.T) {
+		// given
+		doc := givenMergedCells()
+
+		// when
+		html := convertHtml(doc)
+
+		// then
+		assert.Contains(t, html, `line-height: 22px" rowspan="2" colspan="2">`)
+		// the merged cell and the last column in both rows
+		assert.Equal(t, 3, strings.Count(html, "<td "))
+	})
 }
 
 func convertHtml(s *state.State) string {
@@ -358,6 +371,23 @@ func givenColumns() *state.State {
 	return s
 }
 
+func givenMergedCells() *state.State {
+	return state.NewDoc("root", map[string]simple.Block{
+		"root":    simple.New(&model.Block{ChildrenIds: []string{"table"}}),
+		"table":   simple.New(&model.Block{Id: "table", ChildrenIds: []string{"columns", "rows"}, Content: &model.BlockContentOfTable{Table: &model.BlockContentTable{}}}),
+		"columns": simple.New(&model.Block{Id: "columns", ChildrenIds: []string{"c1", "c2", "c3"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableColumns}}}),
+		"rows":    simple.New(&model.Block{Id: "rows", ChildrenIds: []string{"r1", "r2"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableRows}}}),
+		"c1":      simple.New(&model.Block{Id: "c1", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+		"c2":      simple.New(&model.Block{Id: "c2", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+		"c3":      simple.New(&model.Block{Id: "c3", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+		"r1": simple.New(&model.Block{Id: "r1", ChildrenIds: []string{"r1-c1"}, Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{
+			CellSpans: []*model.BlockContentTableRowCellSpan{{ColumnId: "c1", RowSpan: 2, ColSpan: 2}},
+		}}}),
+		"r2":    simple.New(&model.Block{Id: "r2", Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{}}}),
+		"r1-c1": simple.New(&model.Block{Id: "r1-c1", Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "merged"}}}),
+	}).(*state.State)
+}
+
 func givenTrimmedString(s string) string {
 	s = strings.ReplaceAll(s, wrapCopyStart, "")
 	s = strings.ReplaceAll(s, wrapCopyEnd, "")
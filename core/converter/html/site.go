@@ -0,0 +1,204 @@
+package html
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"path/filepath"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+const (
+	IndexPage = "index.html"
+	FilesDir  = "files"
+)
+
+// FileNamer gives names to exported pages and files, the same object always gets the same name
+type FileNamer interface {
+	Get(path, hash, title, ext string) (name string)
+}
+
+// SitePage is the page listed in the index of the site
+type SitePage struct {
+	Title string
+	Path  string
+}
+
+// site renders the object as the page of the static site. Links to exported objects and files are relative,
+// links to objects which are not exported are rendered as plain text
+type site struct {
+	h *HTML
+}
+
+// NewSiteConverter creates the converter of the static site page. Names of pages and files must be
+// registered in the namer before the conversion, so links point to the same names the export writes
+func NewSiteConverter(s *state.State, fn FileNamer) converter.Converter {
+	return &site{h: &HTML{s: s, fn: fn}}
+}
+
+func (s *site) Convert(model.SmartBlockType) []byte {
+	h := s.h
+	title := PageTitle(h.s.CombinedDetails(), h.s.RootId())
+	h.pagePath = h.fn.Get("", h.s.RootId(), title, s.Ext())
+	h.buf = bytes.NewBuffer(nil)
+	fmt.Fprintf(h.buf, wrapSiteStart, html.EscapeString(title))
+	fmt.Fprintf(h.buf, `<nav><a href="%s">Index</a></nav>`, h.relativePath(IndexPage))
+	h.renderChildren(h.s.Pick(h.s.RootId()).Model())
+	h.renderBacklinks()
+	h.buf.WriteString(wrapSiteEnd)
+	return h.buf.Bytes()
+}
+
+func (s *site) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	s.h.knownDocs = docs
+	return s
+}
+
+func (s *site) SetAnchors(blockIds []string) {
+	s.h.anchors = make(map[string]struct{}, len(blockIds))
+	for _, id := range blockIds {
+		s.h.anchors[id] = struct{}{}
+	}
+}
+
+func (s *site) FileHashes() []string {
+	return s.h.fileHashes
+}
+
+func (s *site) ImageHashes() []string {
+	return s.h.imageHashes
+}
+
+func (s *site) Ext() string {
+	return ".html"
+}
+
+// SiteIndex renders the index page of the site, pages are listed in the given order
+func SiteIndex(title string, pages []SitePage) []byte {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, wrapSiteStart, html.EscapeString(title))
+	fmt.Fprintf(buf, `<h1>%s</h1><ul class="index">`, html.EscapeString(title))
+	for _, page := range pages {
+		fmt.Fprintf(buf, `<li><a href="%s">%s</a></li>`, html.EscapeString(filepath.ToSlash(page.Path)), html.EscapeString(page.Title))
+	}
+	buf.WriteString(`</ul>`)
+	buf.WriteString(wrapSiteEnd)
+	return buf.Bytes()
+}
+
+// PageTitle returns the name of the object, or its snippet for objects without names
+func PageTitle(details *domain.Details, id string) string {
+	title := details.GetString(bundle.RelationKeyName)
+	if title == "" {
+		title = details.GetString(bundle.RelationKeySnippet)
+	}
+	if title == "" {
+		title = id
+	}
+	return title
+}
+
+// IsSitePage tells exported objects from exported files, files are copied to the site as they are
+func IsSitePage(details *domain.Details) bool {
+	return !details.Has(bundle.RelationKeyFileId)
+}
+
+func (h *HTML) isSite() bool {
+	return h.fn != nil
+}
+
+// pageLink returns the relative path to the page of the exported object
+func (h *HTML) pageLink(id string) (title, path string, ok bool) {
+	details, ok := h.knownDocs[id]
+	if !ok || !IsSitePage(details) {
+		return "", "", false
+	}
+	title = PageTitle(details, id)
+	return title, h.relativePath(h.fn.Get("", id, title, ".html")), true
+}
+
+// blockLink returns the relative path to the block of the exported object
+func (h *HTML) blockLink(id, blockId string) (title, path string, ok bool) {
+	title, path, ok = h.pageLink(id)
+	if ok && blockId != "" {
+		path += "#" + html.EscapeString(blockId)
+	}
+	return
+}
+
+// writeAnchor renders the anchor of the block which is the target of links
+func (h *HTML) writeAnchor(blockId string) {
+	if _, ok := h.anchors[blockId]; ok {
+		fmt.Fprintf(h.buf, `<a id="%s"></a>`, html.EscapeString(blockId))
+	}
+}
+
+// fileLink returns the relative path to the exported file
+func (h *HTML) fileLink(id, name string) (string, bool) {
+	details, ok := h.knownDocs[id]
+	if !ok || IsSitePage(details) {
+		return "", false
+	}
+	return h.relativePath(h.fn.Get(FilesDir, id, filepath.Base(name), filepath.Ext(name))), true
+}
+
+func (h *HTML) relativePath(path string) string {
+	rel, err := filepath.Rel(filepath.Dir(h.pagePath), path)
+	if err != nil {
+		rel = path
+	}
+	return html.EscapeString(filepath.ToSlash(rel))
+}
+
+// renderBacklinks lists exported objects linking to the page
+func (h *HTML) renderBacklinks() {
+	details, ok := h.knownDocs[h.s.RootId()]
+	if !ok {
+		details = h.s.CombinedDetails()
+	}
+	var links []string
+	for _, id := range details.GetStringList(bundle.RelationKeyBacklinks) {
+		if title, path, ok := h.pageLink(id); ok {
+			links = append(links, fmt.Sprintf(`<li><a href="%s">%s</a></li>`, path, html.EscapeString(title)))
+		}
+	}
+	if len(links) == 0 {
+		return
+	}
+	h.buf.WriteString(`<section class="backlinks"><h2>Backlinks</h2><ul>`)
+	for _, link := range links {
+		h.buf.WriteString(link)
+	}
+	h.buf.WriteString(`</ul></section>`)
+}
+
+func (h *HTML) renderSiteFile(b *model.Block, file *model.BlockContentFile) {
+	name := html.EscapeString(file.Name)
+	path, ok := h.fileLink(file.TargetObjectId, file.Name)
+	switch {
+	case !ok:
+		fmt.Fprintf(h.buf, `<div class="file"><div class="name">%s</div>`, name)
+	case file.Type == model.BlockContentFile_Image:
+		h.imageHashes = append(h.imageHashes, file.TargetObjectId)
+		fmt.Fprintf(h.buf, `<div class="image"><img alt="%s" src="%s" />`, name, path)
+	default:
+		h.fileHashes = append(h.fileHashes, file.TargetObjectId)
+		fmt.Fprintf(h.buf, `<div class="file"><a href="%s">%s</a>`, path, name)
+	}
+	h.renderChildren(b)
+	h.buf.WriteString("</div>")
+}
+
+func (h *HTML) renderSiteLink(b *model.Block) {
+	h.buf.WriteString(`<div class="link">`)
+	if title, path, ok := h.blockLink(b.GetLink().TargetBlockId, b.GetLink().FocusBlockId); ok {
+		fmt.Fprintf(h.buf, `<a href="%s">%s</a>`, path, html.EscapeString(title))
+	}
+	h.renderChildren(b)
+	h.buf.WriteString("</div>")
+}
@@ -0,0 +1,118 @@
+package html
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type testNamer map[string]string
+
+func (n testNamer) Get(path, hash, title, ext string) string {
+	if name, ok := n[hash]; ok {
+		return name
+	}
+	name := filepath.Join(path, title)
+	n[hash] = name
+	return name
+}
+
+func TestSite_Convert(t *testing.T) {
+	// given
+	s := state.NewDoc("page", map[string]simple.Block{
+		"page": simple.New(&model.Block{Id: "page", ChildrenIds: []string{"text", "link", "missing", "image", "file"}}),
+		"text": simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text: "see other",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+				{Range: &model.Range{From: 4, To: 9}, Type: model.BlockContentTextMark_Mention, Param: "other"},
+			}},
+		}}}),
+		"link":    simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "other"}}}),
+		"missing": simple.New(&model.Block{Id: "missing", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "not-exported"}}}),
+		"image": simple.New(&model.Block{Id: "image", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+			Name: "cat.png", Type: model.BlockContentFile_Image, State: model.BlockContentFile_Done, TargetObjectId: "cat",
+		}}}),
+		"file": simple.New(&model.Block{Id: "file", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+			Name: "report.pdf", Type: model.BlockContentFile_PDF, State: model.BlockContentFile_Done, TargetObjectId: "report",
+		}}}),
+	}).(*state.State)
+	s.SetDetail(bundle.RelationKeyName, domain.String("Page & co"))
+	namer := testNamer{
+		"page":  filepath.Join("spaces", "space", "page.html"),
+		"other": "other.html",
+		"cat":   filepath.Join("files", "cat.png"),
+	}
+	knownDocs := map[string]*domain.Details{
+		"page": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyBacklinks: domain.StringList([]string{"other", "not-exported"}),
+		}),
+		"other": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("Other")}),
+		"cat":   domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyFileId: domain.String("cid")}),
+	}
+
+	// when
+	conv := NewSiteConverter(s, namer)
+	conv.SetKnownDocs(knownDocs)
+	result := string(conv.Convert(model.SmartBlockType_Page))
+
+	// then
+	assert.Equal(t, ".html", conv.Ext())
+	assert.Contains(t, result, "<title>Page &amp; co</title>")
+	assert.Contains(t, result, `<nav><a href="../../index.html">Index</a></nav>`)
+	assert.Contains(t, result, `see <a href="../../other.html">other</a>`)
+	assert.Contains(t, result, `<div class="link"><a href="../../other.html">Other</a></div>`)
+	assert.Contains(t, result, `<div class="link"></div>`)
+	assert.Contains(t, result, `<img alt="cat.png" src="../../files/cat.png" />`)
+	assert.Contains(t, result, `<div class="file"><div class="name">report.pdf</div></div>`)
+	assert.Contains(t, result, `<section class="backlinks"><h2>Backlinks</h2><ul><li><a href="../../other.html">Other</a></li></ul></section>`)
+	assert.Equal(t, []string{"cat"}, conv.ImageHashes())
+	assert.Empty(t, conv.FileHashes())
+}
+
+func TestSite_BlockLinks(t *testing.T) {
+	// given
+	s := state.NewDoc("page", map[string]simple.Block{
+		"page": simple.New(&model.Block{Id: "page", ChildrenIds: []string{"heading", "text", "link"}}),
+		"heading": simple.New(&model.Block{Id: "heading", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text: "Heading", Style: model.BlockContentText_Header2,
+		}}}),
+		"text": simple.New(&model.Block{Id: "text", Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+			Text: "see other",
+			Marks: &model.BlockContentTextMarks{Marks: []*model.BlockContentTextMark{
+				{Range: &model.Range{From: 4, To: 9}, Type: model.BlockContentTextMark_Mention, Param: "other", FocusBlockId: "section"},
+			}},
+		}}}),
+		"link": simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "other", FocusBlockId: "section"}}}),
+	}).(*state.State)
+	knownDocs := map[string]*domain.Details{
+		"other": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("Other")}),
+	}
+
+	// when
+	conv := NewSiteConverter(s, testNamer{"page": "page.html", "other": "other.html"})
+	conv.SetKnownDocs(knownDocs)
+	conv.(converter.AnchorsSetter).SetAnchors([]string{"heading"})
+	result := string(conv.Convert(model.SmartBlockType_Page))
+
+	// then
+	assert.Contains(t, result, `<a id="heading"></a>Heading</h2>`)
+	assert.Contains(t, result, `see <a href="other.html#section">other</a>`)
+	assert.Contains(t, result, `<div class="link"><a href="other.html#section">Other</a></div>`)
+}
+
+func TestSiteIndex(t *testing.T) {
+	result := string(SiteIndex("Index", []SitePage{
+		{Title: "A <b>", Path: "a.html"},
+		{Title: "B", Path: filepath.Join("spaces", "space", "b.html")},
+	}))
+
+	assert.Contains(t, result, `<ul class="index"><li><a href="a.html">A &lt;b&gt;</a></li><li><a href="spaces/space/b.html">B</a></li></ul>`)
+}
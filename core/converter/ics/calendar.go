@@ -0,0 +1,349 @@
+package ics
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	componentCalendar = "VCALENDAR"
+	componentEvent    = "VEVENT"
+	componentTodo     = "VTODO"
+
+	statusCompleted   = "COMPLETED"
+	statusNeedsAction = "NEEDS-ACTION"
+
+	prodId = "-//Anytype//Anytype//EN"
+
+	// maxLineLength is the max length of the content line in octets, longer lines are folded
+	maxLineLength = 75
+
+	dateLayout        = "20060102"
+	dateTimeLayout    = "20060102T150405"
+	dateTimeUTCLayout = "20060102T150405Z"
+)
+
+// Event is the VEVENT or the VTODO component of the calendar
+type Event struct {
+	Uid         string
+	Todo        bool
+	Summary     string
+	Description string
+	Url         string
+	// Rule is the RRULE value of the recurring event
+	Rule string
+	// AllDay is set when the dates of the event have no time
+	AllDay    bool
+	Start     time.Time
+	End       time.Time
+	Due       time.Time
+	Done      bool
+	Created   time.Time
+	Modified  time.Time
+	Timestamp time.Time
+}
+
+// Encode writes the events as the iCalendar (RFC 5545) document
+func Encode(events []Event) []byte {
+	w := &contentWriter{}
+	w.write("BEGIN", componentCalendar)
+	w.write("VERSION", "2.0")
+	w.write("PRODID", prodId)
+	w.write("CALSCALE", "GREGORIAN")
+	for _, event := range events {
+		event.encode(w)
+	}
+	w.write("END", componentCalendar)
+	return w.buf.Bytes()
+}
+
+func (e Event) encode(w *contentWriter) {
+	component := componentEvent
+	if e.Todo {
+		component = componentTodo
+	}
+	w.write("BEGIN", component)
+	w.write("UID", e.Uid)
+	w.writeDateTime("DTSTAMP", e.Timestamp, false)
+	if !e.Created.IsZero() {
+		w.writeDateTime("CREATED", e.Created, false)
+	}
+	if !e.Modified.IsZero() {
+		w.writeDateTime("LAST-MODIFIED", e.Modified, false)
+	}
+	if !e.Start.IsZero() {
+		w.writeDateTime("DTSTART", e.Start, e.AllDay)
+	}
+	if !e.End.IsZero() {
+		w.writeDateTime("DTEND", e.End, e.AllDay)
+	}
+	if !e.Due.IsZero() {
+		w.writeDateTime("DUE", e.Due, e.AllDay)
+	}
+	if e.Summary != "" {
+		w.write("SUMMARY", escapeText(e.Summary))
+	}
+	if e.Description != "" {
+		w.write("DESCRIPTION", escapeText(e.Description))
+	}
+	if e.Url != "" {
+		w.write("URL", e.Url)
+	}
+	if e.Rule != "" {
+		w.write("RRULE", strings.TrimPrefix(e.Rule, "RRULE:"))
+	}
+	if e.Todo {
+		status := statusNeedsAction
+		if e.Done {
+			status = statusCompleted
+		}
+		w.write("STATUS", status)
+	}
+	w.write("END", component)
+}
+
+type contentWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *contentWriter) writeDateTime(name string, t time.Time, allDay bool) {
+	if allDay {
+		w.write(name+";VALUE=DATE", t.Format(dateLayout))
+		return
+	}
+	w.write(name, t.UTC().Format(dateTimeUTCLayout))
+}
+
+// write writes the content line folding it by maxLineLength octets without splitting multibyte characters
+func (w *contentWriter) write(name, value string) {
+	line := name + ":" + value
+	limit := maxLineLength
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+		w.buf.WriteString(line[:cut])
+		w.buf.WriteString("\r\n ")
+		line = line[cut:]
+		// the leading space of the continuation line is counted too
+		limit = maxLineLength - 1
+	}
+	w.buf.WriteString(line)
+	w.buf.WriteString("\r\n")
+}
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+func escapeText(s string) string {
+	return textEscaper.Replace(s)
+}
+
+func unescapeText(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i+1 == len(s) {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n', 'N':
+			b.WriteByte('\n')
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return b.String()
+}
+
+type property struct {
+	name   string
+	params map[string]string
+	value  string
+}
+
+// Parse reads VEVENT and VTODO components of the iCalendar document. Nested components like VALARM
+// and other top-level components like VTIMEZONE are skipped
+func Parse(r io.Reader) ([]Event, error) {
+	lines, err := unfoldLines(r)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		events []Event
+		// components is the stack of the components the current line belongs to
+		components []string
+		event      *Event
+	)
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		prop, err := parseProperty(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		switch prop.name {
+		case "BEGIN":
+			name := strings.ToUpper(prop.value)
+			if len(components) == 1 && components[0] == componentCalendar && (name == componentEvent || name == componentTodo) {
+				event = &Event{Todo: name == componentTodo}
+			}
+			components = append(components, name)
+			continue
+		case "END":
+			if len(components) == 0 || components[len(components)-1] != strings.ToUpper(prop.value) {
+				return nil, fmt.Errorf("line %d: unexpected end of %s", i+1, prop.value)
+			}
+			components = components[:len(components)-1]
+			if event != nil && len(components) == 1 {
+				events = append(events, *event)
+				event = nil
+			}
+			continue
+		}
+		if event == nil || len(components) != 2 {
+			continue
+		}
+		if err = event.setProperty(prop); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+	}
+	if len(components) > 0 {
+		return nil, fmt.Errorf("component %s is not closed", components[len(components)-1])
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].date().Before(events[j].date())
+	})
+	return events, nil
+}
+
+func (e *Event) setProperty(prop property) (err error) {
+	switch prop.name {
+	case "UID":
+		e.Uid = prop.value
+	case "SUMMARY":
+		e.Summary = unescapeText(prop.value)
+	case "DESCRIPTION":
+		e.Description = unescapeText(prop.value)
+	case "URL":
+		e.Url = prop.value
+	case "RRULE":
+		e.Rule = prop.value
+	case "STATUS":
+		e.Done = strings.EqualFold(prop.value, statusCompleted)
+	case "COMPLETED":
+		e.Done = true
+	case "DTSTART":
+		e.Start, e.AllDay, err = parseDateTime(prop)
+	case "DTEND":
+		e.End, _, err = parseDateTime(prop)
+	case "DUE":
+		var allDay bool
+		e.Due, allDay, err = parseDateTime(prop)
+		if e.Start.IsZero() {
+			e.AllDay = allDay
+		}
+	case "CREATED":
+		e.Created, _, err = parseDateTime(prop)
+	case "LAST-MODIFIED":
+		e.Modified, _, err = parseDateTime(prop)
+	case "DTSTAMP":
+		e.Timestamp, _, err = parseDateTime(prop)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", prop.name, err)
+	}
+	return nil
+}
+
+// date returns the date the event is placed in the calendar at
+func (e *Event) date() time.Time {
+	if e.Start.IsZero() {
+		return e.Due
+	}
+	return e.Start
+}
+
+// unfoldLines splits the document to the content lines joining the folded ones
+func unfoldLines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 10*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read calendar: %w", err)
+	}
+	return lines, nil
+}
+
+// parseProperty parses the content line: NAME;PARAM=value;PARAM="quoted value":value
+func parseProperty(line string) (property, error) {
+	var (
+		inQuotes bool
+		parts    []string
+		start    int
+	)
+	for i := 0; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			inQuotes = !inQuotes
+		case ';':
+			if !inQuotes {
+				parts = append(parts, line[start:i])
+				start = i + 1
+			}
+		case ':':
+			if inQuotes {
+				continue
+			}
+			parts = append(parts, line[start:i])
+			prop := property{
+				name:   strings.ToUpper(parts[0]),
+				value:  line[i+1:],
+				params: make(map[string]string, len(parts)-1),
+			}
+			for _, param := range parts[1:] {
+				name, value, _ := strings.Cut(param, "=")
+				prop.params[strings.ToUpper(name)] = strings.Trim(value, `"`)
+			}
+			return prop, nil
+		}
+	}
+	return property{}, fmt.Errorf("invalid content line %q", line)
+}
+
+// parseDateTime parses DATE and DATE-TIME values. Floating times and dates are in the local time zone
+func parseDateTime(prop property) (t time.Time, allDay bool, err error) {
+	value := strings.TrimSpace(prop.value)
+	if strings.EqualFold(prop.params["VALUE"], "DATE") || len(value) == len(dateLayout) {
+		t, err = time.ParseInLocation(dateLayout, value, time.Local)
+		return t, true, err
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err = time.ParseInLocation(dateTimeUTCLayout, value, time.UTC)
+		return t, false, err
+	}
+	loc := time.Local
+	if tzid := prop.params["TZID"]; tzid != "" {
+		if tz, tzErr := time.LoadLocation(strings.TrimPrefix(tzid, "/")); tzErr == nil {
+			loc = tz
+		}
+	}
+	t, err = time.ParseInLocation(dateTimeLayout, value, loc)
+	return t, false, err
+}
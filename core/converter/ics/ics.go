@@ -0,0 +1,126 @@
+package ics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/dateutil"
+)
+
+const (
+	// StartDateRelationKey and EndDateRelationKey are the keys of the relations of the dates of imported events
+	StartDateRelationKey domain.RelationKey = "icsStartDate"
+	EndDateRelationKey   domain.RelationKey = "icsEndDate"
+)
+
+// DateRelations are the relations of the calendar
+type DateRelations struct {
+	// Date places objects in the calendar
+	Date domain.RelationKey
+	// End is the optional relation with the end date of events
+	End domain.RelationKey
+	// IncludeTime is set when the dates have time, otherwise events last all day
+	IncludeTime bool
+}
+
+// ics converts objects of the calendar view to events. Objects with the done relation or the to-do layout
+// are converted to to-dos
+type ics struct {
+	relations DateRelations
+	knownDocs map[string]*domain.Details
+	events    []Event
+}
+
+// NewMultiConverter creates the converter that places objects in the calendar by the given date relations
+func NewMultiConverter(relations DateRelations) converter.MultiConverter {
+	return &ics{relations: relations}
+}
+
+func (c *ics) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	c.knownDocs = docs
+	return c
+}
+
+func (c *ics) FileHashes() []string {
+	return nil
+}
+
+func (c *ics) ImageHashes() []string {
+	return nil
+}
+
+func (c *ics) Add(_ smartblock.Space, st *state.State) error {
+	details := st.CombinedDetails()
+	date := details.GetInt64(c.relations.Date)
+	if date == 0 {
+		return nil
+	}
+	event := Event{
+		Uid:         st.RootId(),
+		Todo:        details.Has(bundle.RelationKeyDone) || details.GetInt64(bundle.RelationKeyLayout) == int64(model.ObjectType_todo),
+		Summary:     details.GetString(bundle.RelationKeyName),
+		Description: details.GetString(bundle.RelationKeyDescription),
+		Url:         details.GetString(bundle.RelationKeyUrl),
+		Rule:        details.GetString(bundle.RelationKeyRecurrence),
+		Done:        details.GetBool(bundle.RelationKeyDone),
+		Created:     unixTime(details.GetInt64(bundle.RelationKeyCreatedDate)),
+		Modified:    unixTime(details.GetInt64(bundle.RelationKeyLastModifiedDate)),
+	}
+	event.Timestamp = event.Modified
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	event.AllDay = !c.relations.IncludeTime
+	start := c.dateTime(date)
+	if event.Todo {
+		event.Due = start
+	} else {
+		event.Start = start
+		if end := details.GetInt64(c.relations.End); c.relations.End != "" && end != 0 {
+			event.End = c.dateTime(end)
+			if event.AllDay {
+				// the end date of all-day events is exclusive
+				event.End = event.End.AddDate(0, 0, 1)
+			}
+			if !event.End.After(event.Start) {
+				event.End = time.Time{}
+			}
+		}
+	}
+	c.events = append(c.events, event)
+	return nil
+}
+
+func (c *ics) Convert(model.SmartBlockType) []byte {
+	sort.SliceStable(c.events, func(i, j int) bool {
+		if di, dj := c.events[i].date(), c.events[j].date(); !di.Equal(dj) {
+			return di.Before(dj)
+		}
+		return c.events[i].Uid < c.events[j].Uid
+	})
+	return Encode(c.events)
+}
+
+func (c *ics) Ext() string {
+	return ".ics"
+}
+
+func (c *ics) dateTime(ts int64) time.Time {
+	if c.relations.IncludeTime {
+		return time.Unix(ts, 0)
+	}
+	return dateutil.DateOnly(ts, time.Local)
+}
+
+func unixTime(ts int64) time.Time {
+	if ts == 0 {
+		return time.Time{}
+	}
+	return time.Unix(ts, 0)
+}
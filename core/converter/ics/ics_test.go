@@ -0,0 +1,218 @@
+package ics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func newObjectState(id string, details map[domain.RelationKey]domain.Value) *state.State {
+	st := state.NewDoc(id, nil).(*state.State)
+	for key, value := range details {
+		st.SetDetail(key, value)
+	}
+	return st
+}
+
+func TestIcs_Convert(t *testing.T) {
+	t.Run("dates with time", func(t *testing.T) {
+		meeting := time.Date(2024, 3, 5, 14, 30, 0, 0, time.UTC)
+		// midnight in UTC is the time of the date, not the date without time
+		night := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+
+		c := NewMultiConverter(DateRelations{Date: bundle.RelationKeyDueDate, IncludeTime: true})
+		require.NoError(t, c.Add(nil, newObjectState("meeting", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:        domain.String("Meeting, weekly"),
+			bundle.RelationKeyDescription: domain.String("line one\nline two"),
+			bundle.RelationKeyDueDate:     domain.Int64(meeting.Unix()),
+			bundle.RelationKeyRecurrence:  domain.String("FREQ=WEEKLY;BYDAY=TU"),
+			bundle.RelationKeyUrl:         domain.String("https://example.com"),
+		})))
+		require.NoError(t, c.Add(nil, newObjectState("task", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:    domain.String("Task"),
+			bundle.RelationKeyLayout:  domain.Int64(int64(model.ObjectType_todo)),
+			bundle.RelationKeyDone:    domain.Bool(true),
+			bundle.RelationKeyDueDate: domain.Int64(meeting.AddDate(0, 0, 1).Unix()),
+		})))
+		require.NoError(t, c.Add(nil, newObjectState("night", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:    domain.String("Night"),
+			bundle.RelationKeyDueDate: domain.Int64(night.Unix()),
+		})))
+		// objects without the date are not shown in the calendar
+		require.NoError(t, c.Add(nil, newObjectState("undated", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Undated"),
+		})))
+
+		result := string(c.Convert(model.SmartBlockType_Page))
+		assert.Equal(t, ".ics", c.Ext())
+		assert.True(t, strings.HasPrefix(result, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		assert.True(t, strings.HasSuffix(result, "END:VCALENDAR\r\n"))
+		assert.NotContains(t, result, "Undated")
+		assert.Contains(t, result, "DTSTART:20240305T143000Z\r\n")
+		assert.Contains(t, result, `SUMMARY:Meeting\, weekly`)
+		assert.Contains(t, result, `DESCRIPTION:line one\nline two`)
+		assert.Contains(t, result, "RRULE:FREQ=WEEKLY;BYDAY=TU\r\n")
+		assert.Contains(t, result, "URL:https://example.com\r\n")
+		assert.Contains(t, result, "BEGIN:VTODO\r\nUID:task\r\n")
+		assert.Contains(t, result, "DUE:20240306T143000Z\r\n")
+		assert.Contains(t, result, "STATUS:COMPLETED\r\n")
+		assert.Contains(t, result, "DTSTART:20240308T000000Z\r\n")
+		assert.NotContains(t, result, "VALUE=DATE")
+
+		events, err := Parse(strings.NewReader(result))
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, "Meeting, weekly", events[0].Summary)
+		assert.Equal(t, "line one\nline two", events[0].Description)
+		assert.True(t, meeting.Equal(events[0].Start))
+		assert.True(t, events[1].Todo)
+		assert.True(t, events[1].Done)
+		assert.Equal(t, "night", events[2].Uid)
+		assert.False(t, events[2].AllDay)
+	})
+	t.Run("dates without time", func(t *testing.T) {
+		holiday := time.Date(2024, 3, 1, 0, 0, 0, 0, time.Local)
+		deadline := time.Date(2024, 3, 8, 0, 0, 0, 0, time.UTC)
+
+		c := NewMultiConverter(DateRelations{Date: bundle.RelationKeyDueDate})
+		require.NoError(t, c.Add(nil, newObjectState("holiday", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:    domain.String("Holiday"),
+			bundle.RelationKeyDueDate: domain.Int64(holiday.Unix()),
+		})))
+		// dates without time can also be stored as midnight in UTC
+		require.NoError(t, c.Add(nil, newObjectState("deadline", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName:    domain.String("Deadline"),
+			bundle.RelationKeyDueDate: domain.Int64(deadline.Unix()),
+		})))
+
+		result := string(c.Convert(model.SmartBlockType_Page))
+		assert.Less(t, strings.Index(result, "UID:holiday"), strings.Index(result, "UID:deadline"))
+		assert.Contains(t, result, "DTSTART;VALUE=DATE:20240301\r\n")
+		assert.Contains(t, result, "DTSTART;VALUE=DATE:20240308\r\n")
+		assert.NotContains(t, result, "DTEND")
+
+		events, err := Parse(strings.NewReader(result))
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.True(t, events[0].AllDay)
+		assert.True(t, holiday.Equal(events[0].Start))
+		assert.True(t, events[1].AllDay)
+	})
+	t.Run("end dates", func(t *testing.T) {
+		start := time.Date(2024, 4, 10, 0, 0, 0, 0, time.Local)
+		end := time.Date(2024, 4, 12, 0, 0, 0, 0, time.Local)
+
+		c := NewMultiConverter(DateRelations{Date: StartDateRelationKey, End: EndDateRelationKey})
+		require.NoError(t, c.Add(nil, newObjectState("conference", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Conference"),
+			StartDateRelationKey:   domain.Int64(start.Unix()),
+			EndDateRelationKey:     domain.Int64(end.Unix()),
+		})))
+		require.NoError(t, c.Add(nil, newObjectState("workshop", map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyName: domain.String("Workshop"),
+			StartDateRelationKey:   domain.Int64(end.Unix()),
+		})))
+
+		result := string(c.Convert(model.SmartBlockType_Page))
+		// the end date of all-day events is exclusive
+		assert.Contains(t, result, "DTSTART;VALUE=DATE:20240410\r\nDTEND;VALUE=DATE:20240413\r\n")
+		assert.Contains(t, result, "DTSTART;VALUE=DATE:20240412\r\nSUMMARY")
+
+		events, err := Parse(strings.NewReader(result))
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.True(t, start.Equal(events[0].Start))
+		assert.True(t, end.AddDate(0, 0, 1).Equal(events[0].End))
+		assert.True(t, events[1].End.IsZero())
+	})
+}
+
+func TestEncode(t *testing.T) {
+	t.Run("long lines are folded", func(t *testing.T) {
+		summary := strings.Repeat("ёжик ", 40)
+		result := Encode([]Event{{Uid: "1", Summary: summary, Timestamp: time.Now()}})
+		for _, line := range bytes.Split(result, []byte("\r\n")) {
+			assert.LessOrEqual(t, len(line), maxLineLength)
+		}
+
+		events, err := Parse(bytes.NewReader(result))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, summary, events[0].Summary)
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Run("time zones, alarms and time zone definitions", func(t *testing.T) {
+		doc := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"BEGIN:VTIMEZONE",
+			"TZID:Europe/Berlin",
+			"BEGIN:STANDARD",
+			"DTSTART:19701025T030000",
+			"END:STANDARD",
+			"END:VTIMEZONE",
+			"BEGIN:VEVENT",
+			"UID:1",
+			`DTSTART;TZID="Europe/Berlin":20240105T090000`,
+			"DTEND;TZID=Europe/Berlin:20240105T100000",
+			"SUMMARY:Standup",
+			"BEGIN:VALARM",
+			"DESCRIPTION:Reminder",
+			"END:VALARM",
+			"END:VEVENT",
+			"BEGIN:VTODO",
+			"UID:2",
+			"DUE;VALUE=DATE:20240103",
+			"COMPLETED:20240102T100000Z",
+			"END:VTODO",
+			"END:VCALENDAR",
+		}, "\n")
+
+		events, err := Parse(strings.NewReader(doc))
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+
+		todo := events[0]
+		assert.True(t, todo.Todo)
+		assert.True(t, todo.Done)
+		assert.True(t, todo.AllDay)
+		assert.Equal(t, time.Date(2024, 1, 3, 0, 0, 0, 0, time.Local), todo.Due)
+
+		event := events[1]
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+		assert.Equal(t, "Standup", event.Summary)
+		assert.Empty(t, event.Description)
+		assert.False(t, event.AllDay)
+		assert.True(t, time.Date(2024, 1, 5, 9, 0, 0, 0, berlin).Equal(event.Start))
+		assert.True(t, time.Date(2024, 1, 5, 10, 0, 0, 0, berlin).Equal(event.End))
+	})
+	t.Run("folded lines", func(t *testing.T) {
+		doc := "BEGIN:VCALENDAR\r\nBEGIN:VEVENT\r\nSUMMARY:Long\r\n  summary\r\nDTSTART:20240105T090000Z\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n"
+
+		events, err := Parse(strings.NewReader(doc))
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		assert.Equal(t, "Long summary", events[0].Summary)
+	})
+	t.Run("invalid documents", func(t *testing.T) {
+		for _, doc := range []string{
+			"BEGIN:VCALENDAR\nBEGIN:VEVENT\nEND:VCALENDAR",
+			"BEGIN:VCALENDAR\nBEGIN:VEVENT\nDTSTART:tomorrow\nEND:VEVENT\nEND:VCALENDAR",
+			"BEGIN:VCALENDAR\nnot a content line\nEND:VCALENDAR",
+		} {
+			_, err := Parse(strings.NewReader(doc))
+			assert.Error(t, err, doc)
+		}
+	})
+}
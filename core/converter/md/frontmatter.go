@@ -0,0 +1,206 @@
+package md
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/util/dateutil"
+)
+
+const (
+	FrontMatterTitleKey = "title"
+	FrontMatterTypeKey  = "type"
+
+	frontMatterDelimiter  = "---\n"
+	frontMatterDateLayout = "2006-01-02"
+)
+
+var linkTitleEscaper = strings.NewReplacer(`[`, `\[`, `]`, `\]`)
+
+// ObjectResolver provides relations and linked objects needed to render object details
+type ObjectResolver interface {
+	GetRelationByKey(key string) (*model.Relation, error)
+	QueryByIds(ids []string) (records []database.Record, err error)
+}
+
+// relations that are either rendered separately or make no sense outside the space
+var frontMatterSkippedRelations = map[domain.RelationKey]struct{}{
+	bundle.RelationKeyName:           {},
+	bundle.RelationKeyType:           {},
+	bundle.RelationKeyLinks:          {},
+	bundle.RelationKeyBacklinks:      {},
+	bundle.RelationKeyLastOpenedDate: {},
+	bundle.RelationKeyOrigin:         {},
+	bundle.RelationKeyImportType:     {},
+	bundle.RelationKeySourceObject:   {},
+}
+
+func (h *MD) renderFrontMatter(buf writer) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	usedKeys := map[string]struct{}{}
+	addEntry := func(key string, value *yaml.Node) {
+		if _, exists := usedKeys[key]; exists {
+			return
+		}
+		usedKeys[key] = struct{}{}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, value)
+	}
+
+	details := h.s.CombinedDetails()
+	if name := details.GetString(bundle.RelationKeyName); name != "" {
+		addEntry(FrontMatterTitleKey, stringNode(name))
+	}
+	if typeName := h.objectName(details.GetString(bundle.RelationKeyType)); typeName != "" {
+		addEntry(FrontMatterTypeKey, stringNode(typeName))
+	}
+
+	for _, link := range h.s.GetRelationLinks() {
+		key := domain.RelationKey(link.Key)
+		if _, skip := frontMatterSkippedRelations[key]; skip {
+			continue
+		}
+		value := details.Get(key)
+		if !value.Ok() || value.IsNull() || value.IsEmpty() {
+			continue
+		}
+		rel, err := h.resolver.GetRelationByKey(link.Key)
+		if err != nil {
+			log.With("relationKey", link.Key).Debugf("failed to get relation for front matter: %v", err)
+			continue
+		}
+		if rel.Hidden {
+			continue
+		}
+		valueNode := h.relationValueNode(rel, value)
+		if valueNode == nil {
+			continue
+		}
+		title := rel.Name
+		if _, exists := usedKeys[title]; exists || title == "" {
+			title = rel.Key
+		}
+		addEntry(title, valueNode)
+	}
+
+	if len(node.Content) == 0 {
+		return
+	}
+	data, err := yaml.Marshal(node)
+	if err != nil {
+		log.Errorf("failed to marshal front matter: %v", err)
+		return
+	}
+	buf.WriteString(frontMatterDelimiter)
+	buf.Write(data)
+	buf.WriteString(frontMatterDelimiter)
+	buf.WriteString("\n")
+}
+
+func (h *MD) relationValueNode(rel *model.Relation, value domain.Value) *yaml.Node {
+	switch rel.Format {
+	case model.RelationFormat_checkbox:
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: strconv.FormatBool(value.Bool())}
+	case model.RelationFormat_number:
+		return &yaml.Node{Kind: yaml.ScalarNode, Value: strconv.FormatFloat(value.Float64(), 'f', -1, 64)}
+	case model.RelationFormat_date:
+		ts, ok := value.TryInt64()
+		if !ok {
+			ts = int64(value.Float64())
+		}
+		return stringNode(formatFrontMatterDate(ts, h.relationIncludeTime(rel)))
+	case model.RelationFormat_tag, model.RelationFormat_status:
+		names := make([]string, 0, len(value.WrapToList()))
+		for _, id := range value.WrapToList() {
+			if name := h.objectName(id.String()); name != "" {
+				names = append(names, name)
+			}
+		}
+		return listOrScalarNode(names, rel.Format == model.RelationFormat_status)
+	case model.RelationFormat_object, model.RelationFormat_file:
+		links := make([]string, 0, len(value.WrapToList()))
+		for _, id := range value.WrapToList() {
+			if link := h.objectLink(id.String()); link != "" {
+				links = append(links, link)
+			}
+		}
+		return listOrScalarNode(links, rel.MaxCount == 1)
+	default:
+		if list, ok := value.TryStringList(); ok {
+			return listOrScalarNode(list, false)
+		}
+		if s, ok := value.TryString(); ok {
+			return stringNode(s)
+		}
+		return stringNode(fmt.Sprint(value.Raw()))
+	}
+}
+
+// objectLink renders a relative link to an exported object or just its name if the object is not exported
+func (h *MD) objectLink(id string) string {
+	if _, exported := h.knownDocs[id]; exported {
+		title, filename, _ := h.getLinkInfo(id)
+		return fmt.Sprintf("[%s](%s)", linkTitleEscaper.Replace(title), filename)
+	}
+	return h.objectName(id)
+}
+
+func (h *MD) objectName(id string) string {
+	if id == "" {
+		return ""
+	}
+	if details, ok := h.knownDocs[id]; ok && details != nil {
+		if name := details.GetString(bundle.RelationKeyName); name != "" {
+			return name
+		}
+	}
+	records, err := h.resolver.QueryByIds([]string{id})
+	if err != nil || len(records) == 0 {
+		return ""
+	}
+	return records[0].Details.GetString(bundle.RelationKeyName)
+}
+
+// relationIncludeTime reports whether values of the date relation have time, it is set in details of the relation object
+func (h *MD) relationIncludeTime(rel *model.Relation) bool {
+	if rel.Id == "" {
+		return false
+	}
+	records, err := h.resolver.QueryByIds([]string{rel.Id})
+	if err != nil || len(records) == 0 {
+		return false
+	}
+	return records[0].Details.GetBool(bundle.RelationKeyRelationFormatIncludeTime)
+}
+
+func formatFrontMatterDate(ts int64, includeTime bool) string {
+	if includeTime {
+		return time.Unix(ts, 0).UTC().Format(time.RFC3339)
+	}
+	return dateutil.DateOnly(ts, time.Local).Format(frontMatterDateLayout)
+}
+
+func stringNode(s string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: s}
+}
+
+func listOrScalarNode(values []string, single bool) *yaml.Node {
+	if len(values) == 0 {
+		return nil
+	}
+	if single && len(values) == 1 {
+		return stringNode(values[0])
+	}
+	list := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, v := range values {
+		list.Content = append(list.Content, stringNode(v))
+	}
+	return list
+}
@@ -32,6 +32,11 @@ func NewMDConverter(s *state.State, fn FileNamer) converter.Converter {
 	return &MD{s: s, fn: fn}
 }
 
+// NewMDConverterWithFrontMatter creates a converter that prepends object details as YAML front matter
+func NewMDConverterWithFrontMatter(s *state.State, fn FileNamer, resolver ObjectResolver) converter.Converter {
+	return &MD{s: s, fn: fn, resolver: resolver}
+}
+
 type MD struct {
 	s *state.State
 
@@ -39,9 +44,11 @@ type MD struct {
 	imageHashes []string
 
 	knownDocs map[string]*domain.Details
+	anchors   map[string]struct{}
 
-	mw *marksWriter
-	fn FileNamer
+	mw       *marksWriter
+	fn       FileNamer
+	resolver ObjectResolver
 }
 
 func (h *MD) Convert(sbType model.SmartBlockType) (result []byte) {
@@ -52,6 +59,9 @@ func (h *MD) Convert(sbType model.SmartBlockType) (result []byte) {
 		return
 	}
 	buf := bytes.NewBuffer(nil)
+	if h.resolver != nil {
+		h.renderFrontMatter(buf)
+	}
 	in := new(renderState)
 	h.renderChildren(buf, in, h.s.Pick(h.s.RootId()).Model())
 	result = buf.Bytes()
@@ -127,6 +137,9 @@ func (h *MD) renderChildren(buf writer, in *renderState, parent *model.Block) {
 func (h *MD) renderText(buf writer, in *renderState, b *model.Block) {
 	text := b.GetText()
 	renderText := func() {
+		if _, ok := h.anchors[b.Id]; ok {
+			fmt.Fprintf(buf, `<a id="%s"></a>`, html.EscapeString(b.Id))
+		}
 		mw := h.marksWriter(text)
 		var (
 			i int
@@ -266,7 +279,7 @@ func (h *MD) renderLink(buf writer, in *renderState, b *model.Block) {
 		title, filename, ok := h.getLinkInfo(l.TargetBlockId)
 		if ok {
 			buf.WriteString(in.indent)
-			fmt.Fprintf(buf, "[%s](%s)    \n", escape.MarkdownCharacters(html.EscapeString(title)), filename)
+			fmt.Fprintf(buf, "[%s](%s)    \n", escape.MarkdownCharacters(html.EscapeString(title)), blockLink(filename, l.FocusBlockId))
 		}
 	}
 }
@@ -298,7 +311,13 @@ func (h *MD) renderTable(buf writer, in *renderState, b *model.Block) {
 			cells[rowIdx] = make([]string, colsCount)
 		}
 
+		// markdown has no merged cells, so content of the merged cell stays in its top-left cell
+		// and the rest of the cells it covers are left empty
+		_, covered := tb.MergedCells()
 		err = tb.Iterate(func(b simple.Block, pos table.CellPosition) bool {
+			if _, ok := covered[pos.CellID]; ok {
+				return true
+			}
 			cellBuf := &bytes.Buffer{}
 			if b != nil {
 				h.render(cellBuf, in, b.Model())
@@ -388,6 +407,22 @@ func (h *MD) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
 	return h
 }
 
+// SetAnchors sets blocks which are targets of links, anchors are rendered before their text
+func (h *MD) SetAnchors(blockIds []string) {
+	h.anchors = make(map[string]struct{}, len(blockIds))
+	for _, id := range blockIds {
+		h.anchors[id] = struct{}{}
+	}
+}
+
+// blockLink appends the anchor of the block to the link of the exported object
+func blockLink(filename, blockId string) string {
+	if blockId == "" {
+		return filename
+	}
+	return filename + "#" + blockId
+}
+
 func (h *MD) getLinkInfo(docId string) (title, filename string, ok bool) {
 	info, ok := h.knownDocs[docId]
 	if !ok {
@@ -439,7 +474,7 @@ func (mw *marksWriter) writeMarks(buf writer, pos int) {
 				if start {
 					buf.WriteString("[")
 				} else {
-					fmt.Fprintf(buf, "](%s)", filename)
+					fmt.Fprintf(buf, "](%s)", blockLink(filename, m.FocusBlockId))
 				}
 			}
 		case model.BlockContentTextMark_Keyboard:
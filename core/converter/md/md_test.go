@@ -1,12 +1,17 @@
 package md
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
@@ -170,4 +175,184 @@ func TestMD_Convert(t *testing.T) {
 		exp := "Test ⛰️   \n"
 		assert.Equal(t, exp, string(res))
 	})
+
+	t.Run("test render front matter", func(t *testing.T) {
+		s := newState(&model.Block{
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{Text: "body"},
+			},
+		})
+		s.SetDetail(bundle.RelationKeyName, domain.String("Task: 1"))
+		s.SetDetail(bundle.RelationKeyType, domain.String("typeId"))
+		s.AddRelationLinks(
+			&model.RelationLink{Key: "status", Format: model.RelationFormat_status},
+			&model.RelationLink{Key: "tag", Format: model.RelationFormat_tag},
+			&model.RelationLink{Key: "dueDate", Format: model.RelationFormat_date},
+			&model.RelationLink{Key: "meeting", Format: model.RelationFormat_date},
+			&model.RelationLink{Key: "done", Format: model.RelationFormat_checkbox},
+			&model.RelationLink{Key: "estimate", Format: model.RelationFormat_number},
+			&model.RelationLink{Key: "assignee", Format: model.RelationFormat_object},
+			&model.RelationLink{Key: "secret", Format: model.RelationFormat_longtext},
+		)
+		s.SetDetail("status", domain.StringList([]string{"statusDone"}))
+		s.SetDetail("tag", domain.StringList([]string{"tag1", "tag2"}))
+		s.SetDetail("dueDate", domain.Int64(1704153600))
+		s.SetDetail("meeting", domain.Int64(1704153600))
+		s.SetDetail("done", domain.Bool(true))
+		s.SetDetail("estimate", domain.Float64(2.5))
+		s.SetDetail("assignee", domain.StringList([]string{"exportedId", "otherId"}))
+		s.SetDetail("secret", domain.String("hidden value"))
+
+		resolver := &testResolver{
+			relations: map[string]*model.Relation{
+				"status":   {Key: "status", Name: "Status", Format: model.RelationFormat_status},
+				"tag":      {Key: "tag", Name: "Tag", Format: model.RelationFormat_tag},
+				"dueDate":  {Key: "dueDate", Name: "Due date", Format: model.RelationFormat_date},
+				"meeting":  {Id: "meetingId", Key: "meeting", Name: "Meeting", Format: model.RelationFormat_date},
+				"done":     {Key: "done", Name: "Done", Format: model.RelationFormat_checkbox},
+				"estimate": {Key: "estimate", Name: "Estimate", Format: model.RelationFormat_number},
+				"assignee": {Key: "assignee", Name: "Assignee", Format: model.RelationFormat_object},
+				"secret":   {Key: "secret", Name: "Secret", Format: model.RelationFormat_longtext, Hidden: true},
+			},
+			names: map[string]string{
+				"typeId":     "Task",
+				"statusDone": "Done",
+				"tag1":       "work",
+				"tag2":       "urgent",
+				"otherId":    "John",
+			},
+			includeTime: map[string]bool{"meetingId": true},
+		}
+		c := NewMDConverterWithFrontMatter(s, testFileNamer{}, resolver)
+		c.SetKnownDocs(map[string]*domain.Details{
+			"exportedId": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyName: domain.String("Jane [PM]"),
+			}),
+		})
+		res := c.Convert(model.SmartBlockType_Page)
+		exp := "---\n" +
+			"title: 'Task: 1'\n" +
+			"type: Task\n" +
+			"Status: Done\n" +
+			"Tag:\n    - work\n    - urgent\n" +
+			"Due date: \"2024-01-02\"\n" +
+			"Meeting: \"2024-01-02T00:00:00Z\"\n" +
+			"Done: true\n" +
+			"Estimate: 2.5\n" +
+			"Assignee:\n    - '[Jane \\[PM\\]](exportedId.md)'\n    - John\n" +
+			"---\n\n" +
+			"body   \n"
+		assert.Equal(t, exp, string(res))
+	})
+
+	t.Run("test render block links", func(t *testing.T) {
+		s := newState(&model.Block{
+			Id: "heading",
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{
+					Text:  "Heading",
+					Style: model.BlockContentText_Header2,
+				},
+			},
+		}, &model.Block{
+			Id: "mention",
+			Content: &model.BlockContentOfText{
+				Text: &model.BlockContentText{
+					Text: "see spec",
+					Marks: &model.BlockContentTextMarks{
+						Marks: []*model.BlockContentTextMark{{
+							Range:        &model.Range{From: 4, To: 8},
+							Type:         model.BlockContentTextMark_Mention,
+							Param:        "spec",
+							FocusBlockId: "section",
+						}},
+					},
+				},
+			},
+		}, &model.Block{
+			Id: "link",
+			Content: &model.BlockContentOfLink{
+				Link: &model.BlockContentLink{TargetBlockId: "spec", FocusBlockId: "section"},
+			},
+		})
+		c := NewMDConverter(s, testFileNamer{})
+		c.SetKnownDocs(map[string]*domain.Details{
+			"spec": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyName: domain.String("Spec"),
+			}),
+		})
+		c.(converter.AnchorsSetter).SetAnchors([]string{"heading"})
+		res := c.Convert(model.SmartBlockType_Page)
+		exp := "## <a id=\"heading\"></a>Heading   \n" +
+			"see [spec](spec.md#section)   \n" +
+			"[Spec](spec.md#section)    \n"
+		assert.Equal(t, exp, string(res))
+	})
+
+	t.Run("test render table with merged cells", func(t *testing.T) {
+		text := func(id, value string) simple.Block {
+			return simple.New(&model.Block{Id: id, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: value}}})
+		}
+		s := state.NewDoc("root", map[string]simple.Block{
+			"root":    simple.New(&model.Block{Id: "root", ChildrenIds: []string{"table"}}),
+			"table":   simple.New(&model.Block{Id: "table", ChildrenIds: []string{"columns", "rows"}, Content: &model.BlockContentOfTable{Table: &model.BlockContentTable{}}}),
+			"columns": simple.New(&model.Block{Id: "columns", ChildrenIds: []string{"c1", "c2"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableColumns}}}),
+			"rows":    simple.New(&model.Block{Id: "rows", ChildrenIds: []string{"r1", "r2"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_TableRows}}}),
+			"c1":      simple.New(&model.Block{Id: "c1", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+			"c2":      simple.New(&model.Block{Id: "c2", Content: &model.BlockContentOfTableColumn{TableColumn: &model.BlockContentTableColumn{}}}),
+			"r1": simple.New(&model.Block{Id: "r1", ChildrenIds: []string{"r1-c1", "r1-c2"}, Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{
+				IsHeader:  true,
+				CellSpans: []*model.BlockContentTableRowCellSpan{{ColumnId: "c1", RowSpan: 1, ColSpan: 2}},
+			}}}),
+			"r2":    simple.New(&model.Block{Id: "r2", ChildrenIds: []string{"r2-c1", "r2-c2"}, Content: &model.BlockContentOfTableRow{TableRow: &model.BlockContentTableRow{}}}),
+			"r1-c1": text("r1-c1", "Report"),
+			"r1-c2": text("r1-c2", "hidden"),
+			"r2-c1": text("r2-c1", "a"),
+			"r2-c2": text("r2-c2", "b"),
+		}).(*state.State)
+		res := NewMDConverter(s, testFileNamer{}).Convert(model.SmartBlockType_Page)
+		exp := "| Report |   |\n" +
+			"|:-------|:--|\n" +
+			"|      a | b |\n\n"
+		assert.Equal(t, exp, string(res))
+	})
+}
+
+type testResolver struct {
+	relations   map[string]*model.Relation
+	names       map[string]string
+	includeTime map[string]bool
+}
+
+func (r *testResolver) GetRelationByKey(key string) (*model.Relation, error) {
+	rel, ok := r.relations[key]
+	if !ok {
+		return nil, fmt.Errorf("relation not found")
+	}
+	return rel, nil
+}
+
+func (r *testResolver) QueryByIds(ids []string) ([]database.Record, error) {
+	var records []database.Record
+	for _, id := range ids {
+		if name, ok := r.names[id]; ok {
+			records = append(records, database.Record{Details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyId:   domain.String(id),
+				bundle.RelationKeyName: domain.String(name),
+			})})
+		}
+		if includeTime, ok := r.includeTime[id]; ok {
+			records = append(records, database.Record{Details: domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+				bundle.RelationKeyId:                        domain.String(id),
+				bundle.RelationKeyRelationFormatIncludeTime: domain.Bool(includeTime),
+			})})
+		}
+	}
+	return records, nil
+}
+
+type testFileNamer struct{}
+
+func (testFileNamer) Get(path, hash, title, ext string) string {
+	return hash + ext
 }
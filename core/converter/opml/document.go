@@ -0,0 +1,69 @@
+package opml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"golang.org/x/net/html/charset"
+)
+
+const version = "2.0"
+
+// Document is the OPML 2.0 document, see http://opml.org/spec2.opml
+type Document struct {
+	XMLName xml.Name `xml:"opml"`
+	Version string   `xml:"version,attr"`
+	Head    Head     `xml:"head"`
+	Body    Body     `xml:"body"`
+}
+
+type Head struct {
+	Title        string `xml:"title,omitempty"`
+	DateCreated  string `xml:"dateCreated,omitempty"`
+	DateModified string `xml:"dateModified,omitempty"`
+}
+
+type Body struct {
+	Outlines []*Outline `xml:"outline"`
+}
+
+// Outline is the node of the outline. Besides standard attributes, the _note and _complete attributes
+// used by outliners like OmniOutliner and Workflowy are supported
+type Outline struct {
+	Text     string     `xml:"text,attr"`
+	Title    string     `xml:"title,attr,omitempty"`
+	Type     string     `xml:"type,attr,omitempty"`
+	Note     string     `xml:"_note,attr,omitempty"`
+	Complete string     `xml:"_complete,attr,omitempty"`
+	XmlUrl   string     `xml:"xmlUrl,attr,omitempty"`
+	HtmlUrl  string     `xml:"htmlUrl,attr,omitempty"`
+	Url      string     `xml:"url,attr,omitempty"`
+	Outlines []*Outline `xml:"outline"`
+}
+
+// Parse reads the OPML document, documents in encodings other than UTF-8 are converted
+func Parse(r io.Reader) (*Document, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.CharsetReader = charset.NewReaderLabel
+	// outlines exported by some tools contain HTML entities in attributes
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+	doc := &Document{}
+	if err := decoder.Decode(doc); err != nil {
+		return nil, fmt.Errorf("decode opml: %w", err)
+	}
+	return doc, nil
+}
+
+// Encode writes the document with the XML declaration
+func Encode(doc *Document) ([]byte, error) {
+	if doc.Version == "" {
+		doc.Version = version
+	}
+	data, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("encode opml: %w", err)
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
@@ -0,0 +1,208 @@
+package opml
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/converter"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+var log = logging.Logger("opml-export")
+
+const (
+	typeLink      = "link"
+	completeValue = "true"
+)
+
+// FileNamer gives names to exported documents and files, the same object always gets the same name
+type FileNamer interface {
+	Get(path, hash, title, ext string) (name string)
+}
+
+// NewConverter creates the converter of the object to the outline. Nested blocks become nested outlines,
+// the first paragraph of the toggle becomes the note of the outline
+func NewConverter(s *state.State, fn FileNamer) converter.Converter {
+	return &OPML{s: s, fn: fn}
+}
+
+type OPML struct {
+	s  *state.State
+	fn FileNamer
+
+	knownDocs   map[string]*domain.Details
+	fileHashes  []string
+	imageHashes []string
+}
+
+func (o *OPML) Convert(model.SmartBlockType) []byte {
+	root := o.s.Pick(o.s.RootId())
+	if root == nil {
+		return nil
+	}
+	details := o.s.CombinedDetails()
+	doc := &Document{
+		Head: Head{
+			Title:        o.title(),
+			DateCreated:  formatDate(details.GetInt64(bundle.RelationKeyCreatedDate)),
+			DateModified: formatDate(details.GetInt64(bundle.RelationKeyLastModifiedDate)),
+		},
+		Body: Body{Outlines: o.outlines(root.Model().ChildrenIds)},
+	}
+	result, err := Encode(doc)
+	if err != nil {
+		log.Errorf("failed to encode outline: %v", err)
+		return nil
+	}
+	return result
+}
+
+func (o *OPML) SetKnownDocs(docs map[string]*domain.Details) converter.Converter {
+	o.knownDocs = docs
+	return o
+}
+
+func (o *OPML) FileHashes() []string {
+	return o.fileHashes
+}
+
+func (o *OPML) ImageHashes() []string {
+	return o.imageHashes
+}
+
+func (o *OPML) Ext() string {
+	return ".opml"
+}
+
+func (o *OPML) title() string {
+	details := o.s.CombinedDetails()
+	title := details.GetString(bundle.RelationKeyName)
+	if title == "" {
+		title = details.GetString(bundle.RelationKeySnippet)
+	}
+	return title
+}
+
+func (o *OPML) outlines(ids []string) []*Outline {
+	var outlines []*Outline
+	for _, id := range ids {
+		b := o.s.Pick(id)
+		if b == nil {
+			continue
+		}
+		outlines = append(outlines, o.convert(b.Model())...)
+	}
+	return outlines
+}
+
+// convert returns the outline of the block, blocks without content of their own, like layout blocks,
+// are replaced by outlines of their children
+func (o *OPML) convert(b *model.Block) []*Outline {
+	var outline *Outline
+	switch content := b.Content.(type) {
+	case *model.BlockContentOfText:
+		outline = o.text(b, content.Text)
+	case *model.BlockContentOfBookmark:
+		outline = o.bookmark(b, content.Bookmark)
+	case *model.BlockContentOfLink:
+		outline = o.link(b, content.Link)
+	case *model.BlockContentOfFile:
+		outline = o.file(b, content.File)
+	case *model.BlockContentOfLatex:
+		if content.Latex.Text != "" {
+			outline = &Outline{Text: content.Latex.Text, Outlines: o.outlines(b.ChildrenIds)}
+		}
+	case *model.BlockContentOfDiv:
+	default:
+		return o.outlines(b.ChildrenIds)
+	}
+	if outline == nil {
+		return nil
+	}
+	return []*Outline{outline}
+}
+
+func (o *OPML) text(b *model.Block, text *model.BlockContentText) *Outline {
+	switch text.Style {
+	case model.BlockContentText_Title, model.BlockContentText_Description:
+		return nil
+	}
+	outline := &Outline{Text: text.Text}
+	if text.Style == model.BlockContentText_Checkbox && text.Checked {
+		outline.Complete = completeValue
+	}
+	childrenIds := b.ChildrenIds
+	if text.Style == model.BlockContentText_Toggle && len(childrenIds) > 0 {
+		if note, ok := o.note(childrenIds[0]); ok {
+			outline.Note = note
+			childrenIds = childrenIds[1:]
+		}
+	}
+	outline.Outlines = o.outlines(childrenIds)
+	return outline
+}
+
+// note returns the text of the paragraph without children, such paragraph at the beginning of the toggle is its note
+func (o *OPML) note(id string) (string, bool) {
+	b := o.s.Pick(id)
+	if b == nil {
+		return "", false
+	}
+	m := b.Model()
+	text := m.GetText()
+	if text == nil || text.Style != model.BlockContentText_Paragraph || len(m.ChildrenIds) > 0 {
+		return "", false
+	}
+	return text.Text, true
+}
+
+func (o *OPML) bookmark(b *model.Block, bm *model.BlockContentBookmark) *Outline {
+	if bm.Url == "" {
+		return nil
+	}
+	title := bm.Title
+	if title == "" {
+		title = bm.Url
+	}
+	return &Outline{Text: title, Type: typeLink, Url: bm.Url, Outlines: o.outlines(b.ChildrenIds)}
+}
+
+func (o *OPML) link(b *model.Block, l *model.BlockContentLink) *Outline {
+	details, ok := o.knownDocs[l.TargetBlockId]
+	if !ok {
+		return nil
+	}
+	title := details.GetString(bundle.RelationKeyName)
+	if title == "" {
+		title = details.GetString(bundle.RelationKeySnippet)
+	}
+	if title == "" {
+		title = l.TargetBlockId
+	}
+	path := filepath.ToSlash(o.fn.Get("", l.TargetBlockId, title, o.Ext()))
+	return &Outline{Text: title, Type: typeLink, Url: path, Outlines: o.outlines(b.ChildrenIds)}
+}
+
+func (o *OPML) file(b *model.Block, file *model.BlockContentFile) *Outline {
+	if file.State != model.BlockContentFile_Done {
+		return nil
+	}
+	if file.Type == model.BlockContentFile_Image {
+		o.imageHashes = append(o.imageHashes, file.TargetObjectId)
+	} else {
+		o.fileHashes = append(o.fileHashes, file.TargetObjectId)
+	}
+	path := filepath.ToSlash(o.fn.Get("files", file.TargetObjectId, filepath.Base(file.Name), filepath.Ext(file.Name)))
+	return &Outline{Text: file.Name, Type: typeLink, Url: path, Outlines: o.outlines(b.ChildrenIds)}
+}
+
+func formatDate(timestamp int64) string {
+	if timestamp == 0 {
+		return ""
+	}
+	return time.Unix(timestamp, 0).UTC().Format(time.RFC1123Z)
+}
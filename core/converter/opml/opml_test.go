@@ -0,0 +1,111 @@
+package opml
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+type testNamer map[string]string
+
+func (n testNamer) Get(path, hash, title, ext string) string {
+	if name, ok := n[hash]; ok {
+		return name
+	}
+	name := filepath.Join(path, strings.TrimSuffix(title, ext)+ext)
+	n[hash] = name
+	return name
+}
+
+func textBlock(id string, style model.BlockContentTextStyle, value string, children ...string) simple.Block {
+	return simple.New(&model.Block{Id: id, ChildrenIds: children, Content: &model.BlockContentOfText{Text: &model.BlockContentText{
+		Text: value, Style: style,
+	}}})
+}
+
+func TestOPML_Convert(t *testing.T) {
+	t.Run("nested blocks", func(t *testing.T) {
+		// given
+		done := textBlock("done", model.BlockContentText_Checkbox, "Done task")
+		done.Model().GetText().Checked = true
+		s := state.NewDoc("root", map[string]simple.Block{
+			"root":   simple.New(&model.Block{Id: "root", ChildrenIds: []string{"header", "title", "item", "toggle", "layout", "div"}}),
+			"header": simple.New(&model.Block{Id: "header", ChildrenIds: []string{"title"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{Style: model.BlockContentLayout_Header}}}),
+			"title":  textBlock("title", model.BlockContentText_Title, ""),
+			"item":   textBlock("item", model.BlockContentText_Marked, "Item & more", "child", "done"),
+			"child":  textBlock("child", model.BlockContentText_Paragraph, "Child"),
+			"done":   done,
+			"toggle": textBlock("toggle", model.BlockContentText_Toggle, "Toggle", "note", "nested"),
+			"note":   textBlock("note", model.BlockContentText_Paragraph, "The note"),
+			"nested": textBlock("nested", model.BlockContentText_Paragraph, "Nested"),
+			"layout": simple.New(&model.Block{Id: "layout", ChildrenIds: []string{"bookmark", "link", "file"}, Content: &model.BlockContentOfLayout{Layout: &model.BlockContentLayout{}}}),
+			"bookmark": simple.New(&model.Block{Id: "bookmark", Content: &model.BlockContentOfBookmark{Bookmark: &model.BlockContentBookmark{
+				Url: "https://example.com/feed.xml", Title: "Feed",
+			}}}),
+			"link": simple.New(&model.Block{Id: "link", Content: &model.BlockContentOfLink{Link: &model.BlockContentLink{TargetBlockId: "page2"}}}),
+			"file": simple.New(&model.Block{Id: "file", Content: &model.BlockContentOfFile{File: &model.BlockContentFile{
+				Name: "report.pdf", TargetObjectId: "fileId", State: model.BlockContentFile_Done, Type: model.BlockContentFile_File,
+			}}}),
+			"div": simple.New(&model.Block{Id: "div", Content: &model.BlockContentOfDiv{Div: &model.BlockContentDiv{}}}),
+		}).(*state.State)
+		s.SetDetail(bundle.RelationKeyName, domain.String("Outline"))
+		s.SetDetail(bundle.RelationKeyCreatedDate, domain.Int64(1704067200))
+		conv := NewConverter(s, testNamer{})
+		conv.SetKnownDocs(map[string]*domain.Details{
+			"page2": domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{bundle.RelationKeyName: domain.String("Second page")}),
+		})
+
+		// when
+		result := conv.Convert(model.SmartBlockType_Page)
+
+		// then
+		doc, err := Parse(bytes.NewReader(result))
+		require.NoError(t, err)
+		assert.Equal(t, "2.0", doc.Version)
+		assert.Equal(t, "Outline", doc.Head.Title)
+		assert.Equal(t, "Mon, 01 Jan 2024 00:00:00 +0000", doc.Head.DateCreated)
+		assert.Equal(t, []*Outline{
+			{Text: "Item & more", Outlines: []*Outline{
+				{Text: "Child"},
+				{Text: "Done task", Complete: "true"},
+			}},
+			{Text: "Toggle", Note: "The note", Outlines: []*Outline{{Text: "Nested"}}},
+			{Text: "Feed", Type: "link", Url: "https://example.com/feed.xml"},
+			{Text: "Second page", Type: "link", Url: "Second page.opml"},
+			{Text: "report.pdf", Type: "link", Url: "files/report.pdf"},
+		}, doc.Body.Outlines)
+		assert.Equal(t, []string{"fileId"}, conv.FileHashes())
+		assert.Empty(t, conv.ImageHashes())
+	})
+}
+
+func TestParse(t *testing.T) {
+	t.Run("non-utf8 encoding and html entities", func(t *testing.T) {
+		// given
+		data := []byte("<?xml version=\"1.0\" encoding=\"ISO-8859-1\"?>\n" +
+			"<opml version=\"1.0\"><head><title>Caf\xe9</title></head><body>" +
+			"<outline text=\"A&nbsp;B\" _note=\"note\"><outline text=\"Child\"/></outline>" +
+			"</body></opml>")
+
+		// when
+		doc, err := Parse(bytes.NewReader(data))
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "Café", doc.Head.Title)
+		require.Len(t, doc.Body.Outlines, 1)
+		assert.Equal(t, "A\u00a0B", doc.Body.Outlines[0].Text)
+		assert.Equal(t, "note", doc.Body.Outlines[0].Note)
+		assert.Equal(t, "Child", doc.Body.Outlines[0].Outlines[0].Text)
+	})
+}
@@ -28,6 +28,16 @@ func TestObjectPath_String(t *testing.T) {
 			path:     NewObjectPathWithRelation("objectId", "relationKey"),
 			expected: "objectId/r/relationKey",
 		},
+		{
+			name:     "ObjectId with MessageId",
+			path:     NewObjectPathWithMessage("objectId", "messageId"),
+			expected: "objectId/m/messageId",
+		},
+		{
+			name:     "ObjectId with Page",
+			path:     NewObjectPathWithPage("objectId", 3),
+			expected: "objectId/p/3",
+		},
 	}
 
 	for _, tt := range tests {
@@ -54,6 +64,21 @@ func TestNewFromPath(t *testing.T) {
 			path:     "objectId/r/relationKey",
 			expected: NewObjectPathWithRelation("objectId", "relationKey"),
 		},
+		{
+			name:     "Valid path with MessageId",
+			path:     "objectId/m/messageId",
+			expected: NewObjectPathWithMessage("objectId", "messageId"),
+		},
+		{
+			name:     "Valid path with Page",
+			path:     "objectId/p/3",
+			expected: NewObjectPathWithPage("objectId", 3),
+		},
+		{
+			name:        "Invalid page number",
+			path:        "objectId/p/first",
+			expectError: true,
+		},
 		{
 			name:        "Invalid path format",
 			path:        "invalidFormatPath",
@@ -112,6 +137,11 @@ func TestObjectPath_ObjectRelativePath(t *testing.T) {
 			path:     NewObjectPathWithRelation("objectId", "relationKey"),
 			expected: "r/relationKey",
 		},
+		{
+			name:     "ObjectId with Page",
+			path:     NewObjectPathWithPage("objectId", 3),
+			expected: "p/3",
+		},
 	}
 
 	for _, tt := range tests {
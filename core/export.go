@@ -16,7 +16,9 @@ func (mw *Middleware) ObjectListExport(cctx context.Context, req *pb.RpcObjectLi
 			},
 		}
 		if err != nil {
-			res.Error.Code = pb.RpcObjectListExportResponseError_UNKNOWN_ERROR
+			res.Error.Code = mapErrorCode(err,
+				errToCode(export.ErrPasswordWithoutZip, pb.RpcObjectListExportResponseError_BAD_INPUT),
+			)
 			res.Error.Description = getErrorDescription(err)
 			return
 		} else {
@@ -0,0 +1,139 @@
+// Package filetext extracts plain text from the file contents to make files searchable by their content
+package filetext
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrUnsupportedFormat = errors.New("unsupported file format")
+
+// PageMaxSize limits the size of the page text. Formats without pages, like plain text, are split into pages of this size
+const PageMaxSize = 16 * 1024
+
+// Page is a part of the file text: a page of PDF, a slide of presentation, a sheet of spreadsheet or a chunk of plain text
+type Page struct {
+	// Number is 1-based
+	Number int
+	Text   string
+}
+
+type format int
+
+const (
+	formatUnknown format = iota
+	formatPlain
+	formatPdf
+	formatDocx
+	formatXlsx
+	formatPptx
+)
+
+var plainTextExtensions = map[string]struct{}{
+	".txt":      {},
+	".text":     {},
+	".md":       {},
+	".markdown": {},
+	".csv":      {},
+	".tsv":      {},
+	".json":     {},
+	".log":      {},
+}
+
+func detectFormat(name, media string) format {
+	ext := strings.ToLower(filepath.Ext(name))
+	switch {
+	case ext == ".pdf" || media == "application/pdf":
+		return formatPdf
+	case ext == ".docx" || media == "application/vnd.openxmlformats-officedocument.wordprocessingml.document":
+		return formatDocx
+	case ext == ".xlsx" || media == "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":
+		return formatXlsx
+	case ext == ".pptx" || media == "application/vnd.openxmlformats-officedocument.presentationml.presentation":
+		return formatPptx
+	}
+	if _, ok := plainTextExtensions[ext]; ok {
+		return formatPlain
+	}
+	if strings.HasPrefix(media, "text/") || media == "application/json" {
+		return formatPlain
+	}
+	return formatUnknown
+}
+
+// IsSupported reports whether text can be extracted from the file with given name and media type
+func IsSupported(name, media string) bool {
+	return detectFormat(name, media) != formatUnknown
+}
+
+// Extract extracts text of the file content. Format is detected by the file name extension and media type.
+// Pages without text are omitted
+func Extract(data []byte, name, media string) (pages []Page, err error) {
+	switch detectFormat(name, media) {
+	case formatPlain:
+		pages, err = extractPlainText(data)
+	case formatPdf:
+		pages, err = extractPdf(data)
+	case formatDocx:
+		pages, err = extractDocx(data)
+	case formatXlsx:
+		pages, err = extractXlsx(data)
+	case formatPptx:
+		pages, err = extractPptx(data)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+	return normalizePages(pages), nil
+}
+
+func extractPlainText(data []byte) ([]Page, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		return nil, ErrUnsupportedFormat
+	}
+	return splitText(string(data), 1), nil
+}
+
+// splitText splits text into pages of PageMaxSize, preferably on line breaks
+func splitText(text string, firstNumber int) []Page {
+	var pages []Page
+	number := firstNumber
+	for len(text) > PageMaxSize {
+		cut := strings.LastIndexByte(text[:PageMaxSize], '\n') + 1
+		if cut <= 0 {
+			cut = PageMaxSize
+			for cut > 0 && !utf8.RuneStart(text[cut]) {
+				cut--
+			}
+		}
+		pages = append(pages, Page{Number: number, Text: text[:cut]})
+		text = text[cut:]
+		number++
+	}
+	return append(pages, Page{Number: number, Text: text})
+}
+
+func normalizePages(pages []Page) []Page {
+	res := pages[:0]
+	for _, p := range pages {
+		p.Text = strings.TrimSpace(p.Text)
+		if p.Text == "" {
+			continue
+		}
+		if len(p.Text) > PageMaxSize {
+			cut := PageMaxSize
+			for cut > 0 && !utf8.RuneStart(p.Text[cut]) {
+				cut--
+			}
+			p.Text = p.Text[:cut]
+		}
+		res = append(res, p)
+	}
+	return res
+}
@@ -0,0 +1,230 @@
+package filetext
+
+import (
+	"archive/zip"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildPdf(trailer string, objects ...string) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.7\n")
+	for i, obj := range objects {
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	fmt.Fprintf(&buf, "trailer\n%s\n%%%%EOF\n", trailer)
+	return buf.Bytes()
+}
+
+func pdfStreamObject(dict string, data []byte) string {
+	return fmt.Sprintf("<< %s /Length %d >>\nstream\n%s\nendstream", dict, len(data), data)
+}
+
+func flate(t *testing.T, data string) []byte {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+	_, err := zw.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func buildZip(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
+
+func TestExtract_Pdf(t *testing.T) {
+	toUnicode := "/CIDInit /ProcSet findresource begin\n" +
+		"begincmap\n" +
+		"1 begincodespacerange <0000> <FFFF> endcodespacerange\n" +
+		"1 beginbfchar <0001> <0053> endbfchar\n" +
+		"1 beginbfrange <0002> <0005> <0065> endbfrange\n" +
+		"1 beginbfrange <0010> <0011> [<0070> <00E9>] endbfrange\n" +
+		"endcmap\n"
+	data := buildPdf("<< /Root 1 0 R >>",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R 4 0 R] /Count 2 /Resources << /Font << /F1 5 0 R /F2 6 0 R >> >> >>",
+		"<< /Type /Page /Parent 2 0 R /Contents 7 0 R >>",
+		"<< /Type /Page /Parent 2 0 R /Contents [8 0 R 9 0 R] >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica >>",
+		"<< /Type /Font /Subtype /Type0 /BaseFont /Custom /ToUnicode 10 0 R >>",
+		pdfStreamObject("", []byte("BT /F1 12 Tf 72 712 Td (Hello \\(PDF\\) world) Tj 0 -14 Td (Second line) Tj ET")),
+		pdfStreamObject("/Filter /FlateDecode", flate(t, "BT /F1 12 Tf [(Compressed) -500 (text)] TJ ET")),
+		pdfStreamObject("", []byte("BI /W 1 /H 1 ID \x00\x01 EI\nBT /F2 10 Tf 1 0 0 1 72 600 Tm <000100020010> Tj <0011> Tj ET")),
+		pdfStreamObject("", []byte(toUnicode)),
+	)
+
+	pages, err := Extract(data, "doc.pdf", "")
+	require.NoError(t, err)
+	assert.Equal(t, []Page{
+		{Number: 1, Text: "Hello (PDF) world\nSecond line"},
+		{Number: 2, Text: "Compressed text\nSepé"},
+	}, pages)
+}
+
+func TestExtract_PdfEncrypted(t *testing.T) {
+	data := buildPdf("<< /Root 1 0 R /Encrypt 2 0 R >>",
+		"<< /Type /Catalog /Pages 3 0 R >>",
+		"<< /Filter /Standard >>",
+		"<< /Type /Pages /Kids [] /Count 0 >>",
+	)
+
+	_, err := Extract(data, "doc.pdf", "")
+	assert.ErrorIs(t, err, errPdfEncrypted)
+}
+
+func TestExtract_PdfTruncated(t *testing.T) {
+	full := string(buildPdf("<< /Root 1 0 R >>",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Contents 4 0 R /A <41> >>",
+		pdfStreamObject("", []byte("BT (Hello) Tj BI /W 1 ID x EI ET")),
+	))
+	for _, data := range []string{
+		"%PDF-1.4\n1 0 obj <</A <41",
+		"%PDF-1.4\n1 0 obj <41",
+		"%PDF-1.4\n1 0 obj <<>>\nstream",
+		"%PDF-1.4\ntrailer <</Root <",
+		full[:strings.Index(full, "<41>")+3],
+		full[:strings.Index(full, "ID x")+2],
+	} {
+		assert.NotPanics(t, func() {
+			_, _ = extractPdf([]byte(data))
+		}, data)
+	}
+}
+
+func TestExtract_PdfOutOfRangeNumbers(t *testing.T) {
+	data := buildPdf("<< /Root 1 0 R >>",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Contents 4 0 R >>",
+		pdfStreamObject("/Length 1e30", []byte("BT (Hello) Tj ET")),
+		pdfStreamObject("/Type /ObjStm /N 1 /First 1e30", []byte("7 0 << >>")),
+		pdfStreamObject("/Type /ObjStm /N 1 /First 4", []byte("8 -1e30 << >>")),
+	)
+	assert.NotPanics(t, func() {
+		pages, err := Extract(data, "doc.pdf", "")
+		require.NoError(t, err)
+		assert.Equal(t, []Page{{Number: 1, Text: "Hello"}}, pages)
+	})
+
+	cmap := parseToUnicodeCMap([]byte("1 beginbfrange <FFFFFFFE> <FFFFFFFF> <0041> endbfrange"))
+	assert.Equal(t, map[string]string{"\xff\xff\xff\xfe": "A", "\xff\xff\xff\xff": "B"}, cmap.chars)
+}
+
+func FuzzExtractPdf(f *testing.F) {
+	f.Add([]byte("%PDF-1.4\n1 0 obj <</A <41"))
+	f.Add(buildPdf("<< /Root 1 0 R >>",
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		"<< /Type /Page /Contents 4 0 R >>",
+		pdfStreamObject("", []byte("BT (Hello) Tj ET")),
+	))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		_, _ = extractPdf(data)
+	})
+}
+
+func TestExtract_Docx(t *testing.T) {
+	data := buildZip(t, map[string]string{
+		"word/document.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<w:document xmlns:w="http://schemas.openxmlformats.org/wordprocessingml/2006/main"><w:body>
+<w:p><w:r><w:t>First</w:t></w:r><w:r><w:tab/><w:t xml:space="preserve">paragraph</w:t></w:r></w:p>
+<w:p><w:r><w:t>Second paragraph</w:t></w:r></w:p>
+<w:p><w:r><w:br w:type="page"/><w:t>Next page</w:t></w:r></w:p>
+</w:body></w:document>`,
+	})
+
+	pages, err := Extract(data, "doc.docx", "")
+	require.NoError(t, err)
+	assert.Equal(t, []Page{
+		{Number: 1, Text: "First\tparagraph\nSecond paragraph"},
+		{Number: 2, Text: "Next page"},
+	}, pages)
+}
+
+func TestExtract_Xlsx(t *testing.T) {
+	data := buildZip(t, map[string]string{
+		"xl/sharedStrings.xml": `<sst><si><t>Name</t></si><si><t>Price</t></si><si><r><t>App</t></r><r><t>le</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<worksheet><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="B1" t="s"><v>1</v></c></row>
+<row r="2"><c r="A2" t="s"><v>2</v></c><c r="B2"><v>1.5</v></c></row>
+</sheetData></worksheet>`,
+		"xl/worksheets/sheet10.xml": `<worksheet><sheetData><row r="1"><c r="A1" t="inlineStr"><is><t>Inline</t></is></c></row></sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml":  `<worksheet><sheetData/></worksheet>`,
+	})
+
+	pages, err := Extract(data, "", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet")
+	require.NoError(t, err)
+	assert.Equal(t, []Page{
+		{Number: 1, Text: "Name\tPrice\nApple\t1.5"},
+		{Number: 10, Text: "Inline"},
+	}, pages)
+}
+
+func TestExtract_Pptx(t *testing.T) {
+	slide := func(text string) string {
+		return `<p:sld xmlns:p="p" xmlns:a="a"><p:cSld><p:spTree><p:sp><p:txBody><a:p><a:r><a:t>` + text + `</a:t></a:r></a:p><a:p><a:r><a:t>Notes</a:t></a:r></a:p></p:txBody></p:sp></p:spTree></p:cSld></p:sld>`
+	}
+	data := buildZip(t, map[string]string{
+		"ppt/slides/slide2.xml":            slide("Second"),
+		"ppt/slides/slide1.xml":            slide("First"),
+		"ppt/slides/_rels/slide1.xml.rels": `<Relationships/>`,
+	})
+
+	pages, err := Extract(data, "slides.pptx", "")
+	require.NoError(t, err)
+	assert.Equal(t, []Page{
+		{Number: 1, Text: "First\nNotes"},
+		{Number: 2, Text: "Second\nNotes"},
+	}, pages)
+}
+
+func TestExtract_PlainText(t *testing.T) {
+	t.Run("small file is a single page", func(t *testing.T) {
+		pages, err := Extract([]byte("\xef\xbb\xbfname,price\napple,1\n"), "data.csv", "")
+		require.NoError(t, err)
+		assert.Equal(t, []Page{{Number: 1, Text: "name,price\napple,1"}}, pages)
+	})
+
+	t.Run("large file is split on line breaks", func(t *testing.T) {
+		line := strings.Repeat("a", 99) + "\n"
+		text := strings.Repeat(line, PageMaxSize/len(line)+10)
+
+		pages, err := Extract([]byte(text), "notes.md", "")
+		require.NoError(t, err)
+		require.Len(t, pages, 2)
+		assert.Equal(t, 1, pages[0].Number)
+		assert.Equal(t, 2, pages[1].Number)
+		assert.Equal(t, strings.TrimSpace(text), pages[0].Text+"\n"+pages[1].Text)
+	})
+
+	t.Run("binary data is not supported", func(t *testing.T) {
+		_, err := Extract([]byte{0xff, 0xfe, 0x00}, "data.txt", "")
+		assert.ErrorIs(t, err, ErrUnsupportedFormat)
+	})
+}
+
+func TestIsSupported(t *testing.T) {
+	assert.True(t, IsSupported("report.PDF", ""))
+	assert.True(t, IsSupported("file", "text/plain"))
+	assert.True(t, IsSupported("data.json", ""))
+	assert.False(t, IsSupported("image.png", "image/png"))
+	assert.False(t, IsSupported("archive.zip", "application/zip"))
+}
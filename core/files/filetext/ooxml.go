@@ -0,0 +1,347 @@
+package filetext
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ooxmlPartMaxSize protects from zip bombs
+const ooxmlPartMaxSize = 64 * 1024 * 1024
+
+var errPartNotFound = errors.New("not found")
+
+var (
+	pptxSlideRe = regexp.MustCompile(`^ppt/slides/slide(\d+)\.xml$`)
+	xlsxSheetRe = regexp.MustCompile(`^xl/worksheets/sheet(\d+)\.xml$`)
+)
+
+func openOoxml(data []byte) (*zip.Reader, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("open zip: %w", err)
+	}
+	return zr, nil
+}
+
+func openOoxmlPart(zr *zip.Reader, name string) (io.ReadCloser, error) {
+	for _, f := range zr.File {
+		if f.Name == name {
+			rc, err := f.Open()
+			if err != nil {
+				return nil, err
+			}
+			return struct {
+				io.Reader
+				io.Closer
+			}{io.LimitReader(rc, ooxmlPartMaxSize), rc}, nil
+		}
+	}
+	return nil, fmt.Errorf("part %s: %w", name, errPartNotFound)
+}
+
+// numberedParts returns parts matching re ordered by the number captured by re
+func numberedParts(zr *zip.Reader, re *regexp.Regexp) (names []string, numbers []int) {
+	type part struct {
+		name   string
+		number int
+	}
+	var parts []part
+	for _, f := range zr.File {
+		m := re.FindStringSubmatch(f.Name)
+		if m == nil {
+			continue
+		}
+		n, err := strconv.Atoi(m[1])
+		if err != nil {
+			continue
+		}
+		parts = append(parts, part{name: f.Name, number: n})
+	}
+	sort.Slice(parts, func(i, j int) bool {
+		return parts[i].number < parts[j].number
+	})
+	for _, p := range parts {
+		names = append(names, p.name)
+		numbers = append(numbers, p.number)
+	}
+	return names, numbers
+}
+
+// extractDocx splits the document into pages by explicit page breaks and the breaks rendered by the last editor
+func extractDocx(data []byte) ([]Page, error) {
+	zr, err := openOoxml(data)
+	if err != nil {
+		return nil, err
+	}
+	rc, err := openOoxmlPart(zr, "word/document.xml")
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var (
+		pages []Page
+		buf   strings.Builder
+	)
+	newPage := func() {
+		pages = append(pages, Page{Number: len(pages) + 1, Text: buf.String()})
+		buf.Reset()
+	}
+	decoder := xml.NewDecoder(rc)
+	var inText bool
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decode document: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "tab":
+				buf.WriteByte('\t')
+			case "br", "cr":
+				if xmlAttr(t, "type") == "page" {
+					newPage()
+				} else {
+					buf.WriteByte('\n')
+				}
+			case "lastRenderedPageBreak":
+				newPage()
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				buf.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				buf.Write(t)
+			}
+		}
+	}
+	newPage()
+	return pages, nil
+}
+
+// extractPptx returns a page per slide
+func extractPptx(data []byte) ([]Page, error) {
+	zr, err := openOoxml(data)
+	if err != nil {
+		return nil, err
+	}
+	names, numbers := numberedParts(zr, pptxSlideRe)
+	pages := make([]Page, 0, len(names))
+	for i, name := range names {
+		text, err := extractDrawingText(zr, name)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, Page{Number: numbers[i], Text: text})
+	}
+	return pages, nil
+}
+
+func extractDrawingText(zr *zip.Reader, name string) (string, error) {
+	rc, err := openOoxmlPart(zr, name)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	var (
+		buf    strings.Builder
+		inText bool
+	)
+	decoder := xml.NewDecoder(rc)
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return buf.String(), nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("decode %s: %w", name, err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "t":
+				inText = true
+			case "br":
+				buf.WriteByte('\n')
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "t":
+				inText = false
+			case "p":
+				buf.WriteByte('\n')
+			}
+		case xml.CharData:
+			if inText {
+				buf.Write(t)
+			}
+		}
+	}
+}
+
+// extractXlsx returns a page per sheet, cells are separated by tabs and rows by line breaks
+func extractXlsx(data []byte) ([]Page, error) {
+	zr, err := openOoxml(data)
+	if err != nil {
+		return nil, err
+	}
+	sharedStrings, err := readSharedStrings(zr)
+	if err != nil {
+		return nil, err
+	}
+	names, numbers := numberedParts(zr, xlsxSheetRe)
+	pages := make([]Page, 0, len(names))
+	for i, name := range names {
+		text, err := extractSheetText(zr, name, sharedStrings)
+		if err != nil {
+			return nil, err
+		}
+		pages = append(pages, Page{Number: numbers[i], Text: text})
+	}
+	return pages, nil
+}
+
+func readSharedStrings(zr *zip.Reader) ([]string, error) {
+	rc, err := openOoxmlPart(zr, "xl/sharedStrings.xml")
+	if errors.Is(err, errPartNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	var (
+		res    []string
+		buf    strings.Builder
+		inText bool
+	)
+	decoder := xml.NewDecoder(rc)
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return res, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decode shared strings: %w", err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "si":
+				buf.Reset()
+			case "t":
+				inText = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "si":
+				res = append(res, buf.String())
+			case "t":
+				inText = false
+			}
+		case xml.CharData:
+			if inText {
+				buf.Write(t)
+			}
+		}
+	}
+}
+
+func extractSheetText(zr *zip.Reader, name string, sharedStrings []string) (string, error) {
+	rc, err := openOoxmlPart(zr, name)
+	if err != nil {
+		return "", err
+	}
+	defer rc.Close()
+
+	var (
+		buf       strings.Builder
+		cellType  string
+		cellValue strings.Builder
+		inValue   bool
+		cellIndex int
+	)
+	decoder := xml.NewDecoder(rc)
+	for {
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return buf.String(), nil
+		}
+		if err != nil {
+			return "", fmt.Errorf("decode %s: %w", name, err)
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				cellIndex = 0
+			case "c":
+				cellType = xmlAttr(t, "t")
+				cellValue.Reset()
+			case "v", "t":
+				inValue = true
+			}
+		case xml.EndElement:
+			switch t.Name.Local {
+			case "v", "t":
+				inValue = false
+			case "c":
+				value := cellValue.String()
+				if cellType == "s" {
+					idx, err := strconv.Atoi(value)
+					if err != nil || idx < 0 || idx >= len(sharedStrings) {
+						value = ""
+					} else {
+						value = sharedStrings[idx]
+					}
+				}
+				if value == "" {
+					continue
+				}
+				if cellIndex > 0 {
+					buf.WriteByte('\t')
+				}
+				buf.WriteString(value)
+				cellIndex++
+			case "row":
+				if cellIndex > 0 {
+					buf.WriteByte('\n')
+				}
+			}
+		case xml.CharData:
+			if inValue {
+				cellValue.Write(t)
+			}
+		}
+	}
+}
+
+func xmlAttr(el xml.StartElement, name string) string {
+	for _, attr := range el.Attr {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
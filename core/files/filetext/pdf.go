@@ -0,0 +1,988 @@
+package filetext
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/ascii85"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+// The PDF reader below is intentionally minimal: it reads objects by scanning the file instead of using
+// cross-reference tables, so it also works with slightly broken files, and interprets only text-showing
+// operators of content streams
+
+var (
+	errPdfEncrypted         = errors.New("encrypted pdf is not supported")
+	errPdfCatalogNotFound   = errors.New("pdf catalog not found")
+	errPdfUnsupportedFilter = errors.New("unsupported pdf stream filter")
+)
+
+const (
+	// pdfStreamMaxSize protects from decompression bombs
+	pdfStreamMaxSize = 64 * 1024 * 1024
+	pdfMaxDepth      = 32
+	pdfFormMaxDepth  = 5
+	// pdfWordSpacing is the minimal offset in TJ array, in thousandths of text space unit, that is treated as space
+	pdfWordSpacing = 200
+)
+
+var pdfObjectRe = regexp.MustCompile(`(\d+)\s+(\d+)\s+obj\b`)
+
+type (
+	pdfName    string
+	pdfKeyword string
+	pdfString  string
+	pdfArray   []any
+	pdfDict    map[pdfName]any
+	pdfRef     struct{ num, gen int }
+	pdfStream  struct {
+		dict pdfDict
+		raw  []byte
+	}
+)
+
+type pdfDocument struct {
+	objects  map[int]any
+	trailers []pdfDict
+}
+
+func extractPdf(data []byte) ([]Page, error) {
+	doc, err := parsePdf(data)
+	if err != nil {
+		return nil, err
+	}
+	for _, trailer := range doc.trailers {
+		if _, ok := trailer["Encrypt"]; ok {
+			return nil, errPdfEncrypted
+		}
+	}
+	catalog := doc.catalog()
+	if catalog == nil {
+		return nil, errPdfCatalogNotFound
+	}
+
+	ex := &pdfTextExtractor{doc: doc, fonts: map[pdfRef]*pdfFont{}}
+	var pages []Page
+	doc.walkPages(catalog["Pages"], nil, 0, map[pdfRef]struct{}{}, func(page pdfDict, resources pdfDict) {
+		ex.out.Reset()
+		ex.showPage(page, resources)
+		pages = append(pages, Page{Number: len(pages) + 1, Text: ex.out.String()})
+	})
+	return pages, nil
+}
+
+func parsePdf(data []byte) (*pdfDocument, error) {
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n\x00"), []byte("%PDF")) {
+		return nil, fmt.Errorf("not a pdf file")
+	}
+	doc := &pdfDocument{objects: map[int]any{}}
+	var objStreams []*pdfStream
+	for _, m := range pdfObjectRe.FindAllSubmatchIndex(data, -1) {
+		num, err := strconv.Atoi(string(data[m[2]:m[3]]))
+		if err != nil {
+			continue
+		}
+		lex := &pdfLexer{data: data, pos: m[1], refs: true}
+		obj, err := lex.readObject()
+		if err != nil {
+			continue
+		}
+		if dict, ok := obj.(pdfDict); ok {
+			if stream := lex.readStream(dict); stream != nil {
+				obj = stream
+			}
+		}
+		// later definitions come from incremental updates and replace the earlier ones
+		doc.objects[num] = obj
+		if stream, ok := obj.(*pdfStream); ok {
+			switch stream.dict["Type"] {
+			case pdfName("ObjStm"):
+				objStreams = append(objStreams, stream)
+			case pdfName("XRef"):
+				doc.trailers = append(doc.trailers, stream.dict)
+			}
+		}
+	}
+	for pos := 0; ; {
+		idx := bytes.Index(data[pos:], []byte("trailer"))
+		if idx < 0 {
+			break
+		}
+		pos += idx + len("trailer")
+		lex := &pdfLexer{data: data, pos: pos, refs: true}
+		if obj, err := lex.readObject(); err == nil {
+			if dict, ok := obj.(pdfDict); ok {
+				doc.trailers = append(doc.trailers, dict)
+			}
+		}
+	}
+	for _, stream := range objStreams {
+		doc.loadObjectStream(stream)
+	}
+	return doc, nil
+}
+
+// loadObjectStream loads compressed objects, they never replace the objects defined directly
+func (d *pdfDocument) loadObjectStream(stream *pdfStream) {
+	data, err := d.decodeStream(stream)
+	if err != nil {
+		return
+	}
+	n, _ := d.resolve(stream.dict["N"]).(float64)
+	first, _ := d.resolve(stream.dict["First"]).(float64)
+	// numbers are compared before the conversion, huge values overflow int
+	if !(first > 0 && first <= float64(len(data))) {
+		return
+	}
+	header := &pdfLexer{data: data[:int(first)]}
+	for i := 0; float64(i) < n; i++ {
+		numObj, err := header.readObject()
+		if err != nil {
+			return
+		}
+		offsetObj, err := header.readObject()
+		if err != nil {
+			return
+		}
+		num, ok1 := numObj.(float64)
+		offset, ok2 := offsetObj.(float64)
+		if !ok1 || !ok2 {
+			return
+		}
+		if _, ok := d.objects[int(num)]; ok {
+			continue
+		}
+		if !(offset >= 0 && first+offset < float64(len(data))) {
+			continue
+		}
+		lex := &pdfLexer{data: data, pos: int(first + offset), refs: true}
+		if obj, err := lex.readObject(); err == nil {
+			d.objects[int(num)] = obj
+		}
+	}
+}
+
+func (d *pdfDocument) resolve(obj any) any {
+	for i := 0; i < pdfMaxDepth; i++ {
+		ref, ok := obj.(pdfRef)
+		if !ok {
+			return obj
+		}
+		obj = d.objects[ref.num]
+	}
+	return nil
+}
+
+func (d *pdfDocument) dict(obj any) pdfDict {
+	switch v := d.resolve(obj).(type) {
+	case pdfDict:
+		return v
+	case *pdfStream:
+		return v.dict
+	}
+	return nil
+}
+
+func (d *pdfDocument) catalog() pdfDict {
+	for i := len(d.trailers) - 1; i >= 0; i-- {
+		if root := d.dict(d.trailers[i]["Root"]); root != nil {
+			return root
+		}
+	}
+	for _, obj := range d.objects {
+		if dict, ok := obj.(pdfDict); ok && dict["Type"] == pdfName("Catalog") {
+			return dict
+		}
+	}
+	return nil
+}
+
+// walkPages calls fn for every page of the page tree in the document order. Resources are inherited from the parent nodes
+func (d *pdfDocument) walkPages(node any, resources pdfDict, depth int, visited map[pdfRef]struct{}, fn func(page, resources pdfDict)) {
+	if depth > pdfMaxDepth {
+		return
+	}
+	if ref, ok := node.(pdfRef); ok {
+		if _, ok = visited[ref]; ok {
+			return
+		}
+		visited[ref] = struct{}{}
+	}
+	dict := d.dict(node)
+	if dict == nil {
+		return
+	}
+	if res := d.dict(dict["Resources"]); res != nil {
+		resources = res
+	}
+	kids, isTree := d.resolve(dict["Kids"]).(pdfArray)
+	if !isTree && dict["Type"] != pdfName("Pages") {
+		fn(dict, resources)
+		return
+	}
+	for _, kid := range kids {
+		d.walkPages(kid, resources, depth+1, visited, fn)
+	}
+}
+
+func (d *pdfDocument) decodeStream(stream *pdfStream) ([]byte, error) {
+	data := stream.raw
+	var filters []any
+	switch f := d.resolve(stream.dict["Filter"]).(type) {
+	case pdfName:
+		filters = []any{f}
+	case pdfArray:
+		filters = f
+	}
+	for _, filter := range filters {
+		var err error
+		switch d.resolve(filter) {
+		case pdfName("FlateDecode"), pdfName("Fl"):
+			data, err = decodeFlate(data)
+		case pdfName("ASCIIHexDecode"), pdfName("AHx"):
+			data, err = decodeAsciiHex(data)
+		case pdfName("ASCII85Decode"), pdfName("A85"):
+			data, err = decodeAscii85(data)
+		default:
+			return nil, errPdfUnsupportedFilter
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return data, nil
+}
+
+func decodeFlate(data []byte) ([]byte, error) {
+	zr, err := zlib.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("flate: %w", err)
+	}
+	defer zr.Close()
+	res, err := io.ReadAll(io.LimitReader(zr, pdfStreamMaxSize))
+	// streams produced by some writers have broken checksums, the data is still usable
+	if err != nil && len(res) == 0 {
+		return nil, fmt.Errorf("flate: %w", err)
+	}
+	return res, nil
+}
+
+func decodeAsciiHex(data []byte) ([]byte, error) {
+	if idx := bytes.IndexByte(data, '>'); idx >= 0 {
+		data = data[:idx]
+	}
+	digits := make([]byte, 0, len(data))
+	for _, c := range data {
+		if !isPdfWhitespace(c) {
+			digits = append(digits, c)
+		}
+	}
+	if len(digits)%2 == 1 {
+		digits = append(digits, '0')
+	}
+	res := make([]byte, len(digits)/2)
+	if _, err := hex.Decode(res, digits); err != nil {
+		return nil, fmt.Errorf("ascii hex: %w", err)
+	}
+	return res, nil
+}
+
+func decodeAscii85(data []byte) ([]byte, error) {
+	data = bytes.TrimPrefix(bytes.TrimSpace(data), []byte("<~"))
+	if idx := bytes.Index(data, []byte("~>")); idx >= 0 {
+		data = data[:idx]
+	}
+	res, err := io.ReadAll(ascii85.NewDecoder(bytes.NewReader(data)))
+	if err != nil {
+		return nil, fmt.Errorf("ascii85: %w", err)
+	}
+	return res, nil
+}
+
+type pdfTextExtractor struct {
+	doc   *pdfDocument
+	fonts map[pdfRef]*pdfFont
+	out   bytes.Buffer
+}
+
+func (ex *pdfTextExtractor) showPage(page, resources pdfDict) {
+	var content []byte
+	switch contents := ex.doc.resolve(page["Contents"]).(type) {
+	case *pdfStream:
+		content, _ = ex.doc.decodeStream(contents)
+	case pdfArray:
+		for _, part := range contents {
+			stream, ok := ex.doc.resolve(part).(*pdfStream)
+			if !ok {
+				continue
+			}
+			data, err := ex.doc.decodeStream(stream)
+			if err != nil {
+				continue
+			}
+			content = append(content, data...)
+			content = append(content, '\n')
+		}
+	}
+	ex.showContent(content, resources, 0)
+}
+
+// showContent interprets text operators of the content stream
+func (ex *pdfTextExtractor) showContent(content []byte, resources pdfDict, depth int) {
+	var (
+		lex      = &pdfLexer{data: content}
+		operands []any
+		font     *pdfFont
+		fontSize float64
+		lastY    float64
+	)
+	for {
+		obj, err := lex.readObject()
+		if err != nil {
+			return
+		}
+		op, ok := obj.(pdfKeyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+		switch op {
+		case "BT":
+			lastY = 0
+		case "ET":
+			ex.writeSeparator(' ')
+		case "Tf":
+			if len(operands) >= 2 {
+				if name, ok := operands[len(operands)-2].(pdfName); ok {
+					font = ex.font(resources, name)
+				}
+				fontSize, _ = operands[len(operands)-1].(float64)
+			}
+		case "Td", "TD":
+			if len(operands) >= 2 {
+				tx, _ := operands[len(operands)-2].(float64)
+				ty, _ := operands[len(operands)-1].(float64)
+				// some writers position every glyph, so only the moves wider than a glyph are treated as spaces
+				if ty != 0 {
+					ex.writeSeparator('\n')
+				} else if math.Abs(tx) > math.Abs(fontSize) {
+					ex.writeSeparator(' ')
+				}
+			}
+		case "Tm":
+			if len(operands) >= 6 {
+				y, _ := operands[len(operands)-1].(float64)
+				if y != lastY {
+					ex.writeSeparator('\n')
+				} else {
+					ex.writeSeparator(' ')
+				}
+				lastY = y
+			}
+		case "T*":
+			ex.writeSeparator('\n')
+		case "Tj", "'", "\"":
+			if op != "Tj" {
+				ex.writeSeparator('\n')
+			}
+			if len(operands) > 0 {
+				if s, ok := operands[len(operands)-1].(pdfString); ok {
+					ex.out.WriteString(font.decode(s))
+				}
+			}
+		case "TJ":
+			if len(operands) > 0 {
+				arr, _ := operands[len(operands)-1].(pdfArray)
+				for _, item := range arr {
+					switch v := item.(type) {
+					case pdfString:
+						ex.out.WriteString(font.decode(v))
+					case float64:
+						if v < -pdfWordSpacing {
+							ex.writeSeparator(' ')
+						}
+					}
+				}
+			}
+		case "Do":
+			if len(operands) > 0 && depth < pdfFormMaxDepth {
+				if name, ok := operands[len(operands)-1].(pdfName); ok {
+					ex.showForm(resources, name, depth)
+				}
+			}
+		case "BI":
+			lex.skipInlineImage()
+		}
+		operands = operands[:0]
+	}
+}
+
+func (ex *pdfTextExtractor) showForm(resources pdfDict, name pdfName, depth int) {
+	xobjects := ex.doc.dict(resources["XObject"])
+	form, ok := ex.doc.resolve(xobjects[name]).(*pdfStream)
+	if !ok || form.dict["Subtype"] != pdfName("Form") {
+		return
+	}
+	data, err := ex.doc.decodeStream(form)
+	if err != nil {
+		return
+	}
+	if formResources := ex.doc.dict(form.dict["Resources"]); formResources != nil {
+		resources = formResources
+	}
+	ex.showContent(data, resources, depth+1)
+	ex.writeSeparator('\n')
+}
+
+// writeSeparator writes space or line break, unless the text already ends with a separator. Line break replaces space
+func (ex *pdfTextExtractor) writeSeparator(sep byte) {
+	n := ex.out.Len()
+	if n == 0 {
+		return
+	}
+	switch ex.out.Bytes()[n-1] {
+	case '\n':
+		return
+	case ' ':
+		if sep == ' ' {
+			return
+		}
+		ex.out.Truncate(n - 1)
+	}
+	ex.out.WriteByte(sep)
+}
+
+func (ex *pdfTextExtractor) font(resources pdfDict, name pdfName) *pdfFont {
+	fonts := ex.doc.dict(resources["Font"])
+	ref, isRef := fonts[name].(pdfRef)
+	if isRef {
+		if font, ok := ex.fonts[ref]; ok {
+			return font
+		}
+	}
+	font := ex.loadFont(ex.doc.dict(fonts[name]))
+	if isRef {
+		ex.fonts[ref] = font
+	}
+	return font
+}
+
+func (ex *pdfTextExtractor) loadFont(dict pdfDict) *pdfFont {
+	font := &pdfFont{charmap: charmap.Windows1252}
+	if dict == nil {
+		return font
+	}
+	if stream, ok := ex.doc.resolve(dict["ToUnicode"]).(*pdfStream); ok {
+		if data, err := ex.doc.decodeStream(stream); err == nil {
+			font.cmap = parseToUnicodeCMap(data)
+		}
+	}
+	switch {
+	case dict["Subtype"] == pdfName("Type0"):
+		font.composite = true
+	case ex.doc.resolve(dict["Encoding"]) == pdfName("MacRomanEncoding"):
+		font.charmap = charmap.Macintosh
+	}
+	return font
+}
+
+// pdfFont maps character codes of the font to unicode
+type pdfFont struct {
+	cmap *pdfCMap
+	// composite fonts use multibyte codes that can't be decoded without ToUnicode map
+	composite bool
+	charmap   *charmap.Charmap
+}
+
+func (f *pdfFont) decode(s pdfString) string {
+	if f == nil {
+		f = &pdfFont{charmap: charmap.Windows1252}
+	}
+	if f.cmap != nil {
+		return f.cmap.decode(string(s))
+	}
+	if f.composite {
+		return ""
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		sb.WriteRune(f.charmap.DecodeByte(s[i]))
+	}
+	return sb.String()
+}
+
+type pdfCodeSpace struct {
+	lo, hi []byte
+}
+
+type pdfCMap struct {
+	codeSpaces []pdfCodeSpace
+	chars      map[string]string
+}
+
+// pdfCMapRangeMaxSize limits expansion of bfrange entries
+const pdfCMapRangeMaxSize = 0x10000
+
+func parseToUnicodeCMap(data []byte) *pdfCMap {
+	cmap := &pdfCMap{chars: map[string]string{}}
+	lex := &pdfLexer{data: data}
+	var operands []any
+	for {
+		obj, err := lex.readObject()
+		if err != nil {
+			break
+		}
+		op, ok := obj.(pdfKeyword)
+		if !ok {
+			operands = append(operands, obj)
+			continue
+		}
+		switch op {
+		case "endcodespacerange":
+			for i := 0; i+1 < len(operands); i += 2 {
+				lo, ok1 := operands[i].(pdfString)
+				hi, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 && len(lo) == len(hi) && len(lo) > 0 {
+					cmap.codeSpaces = append(cmap.codeSpaces, pdfCodeSpace{lo: []byte(lo), hi: []byte(hi)})
+				}
+			}
+		case "endbfchar":
+			for i := 0; i+1 < len(operands); i += 2 {
+				src, ok1 := operands[i].(pdfString)
+				dst, ok2 := operands[i+1].(pdfString)
+				if ok1 && ok2 {
+					cmap.chars[string(src)] = decodeUtf16(string(dst))
+				}
+			}
+		case "endbfrange":
+			for i := 0; i+2 < len(operands); i += 3 {
+				cmap.addRange(operands[i], operands[i+1], operands[i+2])
+			}
+		}
+		operands = operands[:0]
+	}
+	return cmap
+}
+
+func (c *pdfCMap) addRange(loObj, hiObj, dstObj any) {
+	lo, ok1 := loObj.(pdfString)
+	hi, ok2 := hiObj.(pdfString)
+	if !ok1 || !ok2 || len(lo) != len(hi) || len(lo) == 0 || len(lo) > 4 {
+		return
+	}
+	loCode, hiCode := codeToInt(string(lo)), codeToInt(string(hi))
+	if hiCode < loCode || hiCode-loCode >= pdfCMapRangeMaxSize {
+		return
+	}
+	// the loop is driven by the offset, code overflows after the last code of 4-byte range
+	for offset := 0; offset <= int(hiCode-loCode); offset++ {
+		key := intToCode(loCode+uint32(offset), len(lo))
+		switch dst := dstObj.(type) {
+		case pdfString:
+			units := utf16Units(string(dst))
+			if len(units) == 0 {
+				return
+			}
+			units[len(units)-1] += uint16(offset)
+			c.chars[key] = string(utf16.Decode(units))
+		case pdfArray:
+			if offset >= len(dst) {
+				return
+			}
+			if s, ok := dst[offset].(pdfString); ok {
+				c.chars[key] = decodeUtf16(string(s))
+			}
+		}
+	}
+}
+
+func (c *pdfCMap) decode(s string) string {
+	var sb strings.Builder
+	for len(s) > 0 {
+		n := c.codeLen(s)
+		if n > len(s) {
+			n = len(s)
+		}
+		if r, ok := c.chars[s[:n]]; ok {
+			sb.WriteString(r)
+		}
+		s = s[n:]
+	}
+	return sb.String()
+}
+
+// codeLen returns the length of the code at the beginning of s according to the code space ranges
+func (c *pdfCMap) codeLen(s string) int {
+	for _, cs := range c.codeSpaces {
+		if len(cs.lo) > len(s) {
+			continue
+		}
+		matches := true
+		for i := range cs.lo {
+			if s[i] < cs.lo[i] || s[i] > cs.hi[i] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return len(cs.lo)
+		}
+	}
+	if len(c.codeSpaces) > 0 {
+		return len(c.codeSpaces[0].lo)
+	}
+	return 1
+}
+
+func codeToInt(code string) uint32 {
+	var res uint32
+	for i := 0; i < len(code); i++ {
+		res = res<<8 | uint32(code[i])
+	}
+	return res
+}
+
+func intToCode(code uint32, size int) string {
+	res := make([]byte, size)
+	for i := size - 1; i >= 0; i-- {
+		res[i] = byte(code)
+		code >>= 8
+	}
+	return string(res)
+}
+
+func utf16Units(s string) []uint16 {
+	units := make([]uint16, 0, len(s)/2)
+	for i := 0; i+1 < len(s); i += 2 {
+		units = append(units, uint16(s[i])<<8|uint16(s[i+1]))
+	}
+	return units
+}
+
+func decodeUtf16(s string) string {
+	return string(utf16.Decode(utf16Units(s)))
+}
+
+// pdfLexer reads objects of PDF syntax. Operators of content streams are returned as pdfKeyword
+type pdfLexer struct {
+	data []byte
+	pos  int
+	// refs enables parsing of indirect references, they are never used in content streams
+	refs bool
+}
+
+func isPdfWhitespace(c byte) bool {
+	switch c {
+	case 0, '\t', '\n', '\f', '\r', ' ':
+		return true
+	}
+	return false
+}
+
+func isPdfDelimiter(c byte) bool {
+	switch c {
+	case '(', ')', '<', '>', '[', ']', '{', '}', '/', '%':
+		return true
+	}
+	return false
+}
+
+func (l *pdfLexer) skipWhitespace() {
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if c == '%' {
+			for l.pos < len(l.data) && l.data[l.pos] != '\n' && l.data[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		if !isPdfWhitespace(c) {
+			return
+		}
+		l.pos++
+	}
+}
+
+func (l *pdfLexer) readObject() (any, error) {
+	return l.readObjectDepth(0)
+}
+
+func (l *pdfLexer) readObjectDepth(depth int) (any, error) {
+	if depth > pdfMaxDepth {
+		return nil, fmt.Errorf("pdf object is too deep")
+	}
+	l.skipWhitespace()
+	if l.pos >= len(l.data) {
+		return nil, io.EOF
+	}
+	c := l.data[l.pos]
+	switch c {
+	case '/':
+		l.pos++
+		return l.readName(), nil
+	case '(':
+		l.pos++
+		return l.readLiteralString(), nil
+	case '<':
+		if l.pos+1 < len(l.data) && l.data[l.pos+1] == '<' {
+			l.pos += 2
+			return l.readDict(depth)
+		}
+		l.pos++
+		return l.readHexString(), nil
+	case '[':
+		l.pos++
+		var arr pdfArray
+		for {
+			l.skipWhitespace()
+			if l.pos >= len(l.data) {
+				return arr, nil
+			}
+			if l.data[l.pos] == ']' {
+				l.pos++
+				return arr, nil
+			}
+			obj, err := l.readObjectDepth(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, obj)
+		}
+	case ']', '>', ')', '{', '}':
+		l.pos++
+		return pdfKeyword(c), nil
+	}
+
+	start := l.pos
+	for l.pos < len(l.data) && !isPdfWhitespace(l.data[l.pos]) && !isPdfDelimiter(l.data[l.pos]) {
+		l.pos++
+	}
+	token := string(l.data[start:l.pos])
+	switch token {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	n, err := strconv.ParseFloat(token, 64)
+	if err != nil {
+		return pdfKeyword(token), nil
+	}
+	if l.refs && !strings.ContainsAny(token, ".+-") {
+		if ref, ok := l.tryReadRef(int(n)); ok {
+			return ref, nil
+		}
+	}
+	return n, nil
+}
+
+// tryReadRef reads "gen R" part of indirect reference, restoring the position if it is not a reference
+func (l *pdfLexer) tryReadRef(num int) (pdfRef, bool) {
+	pos := l.pos
+	l.skipWhitespace()
+	start := l.pos
+	for l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '9' {
+		l.pos++
+	}
+	if start != l.pos {
+		gen, err := strconv.Atoi(string(l.data[start:l.pos]))
+		l.skipWhitespace()
+		if err == nil && l.pos < len(l.data) && l.data[l.pos] == 'R' &&
+			(l.pos+1 == len(l.data) || isPdfWhitespace(l.data[l.pos+1]) || isPdfDelimiter(l.data[l.pos+1])) {
+			l.pos++
+			return pdfRef{num: num, gen: gen}, true
+		}
+	}
+	l.pos = pos
+	return pdfRef{}, false
+}
+
+func (l *pdfLexer) readDict(depth int) (any, error) {
+	dict := pdfDict{}
+	for {
+		l.skipWhitespace()
+		if l.pos >= len(l.data) {
+			return dict, nil
+		}
+		if l.data[l.pos] == '>' {
+			l.pos++
+			if l.pos < len(l.data) && l.data[l.pos] == '>' {
+				l.pos++
+			}
+			return dict, nil
+		}
+		key, err := l.readObjectDepth(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		name, ok := key.(pdfName)
+		if !ok {
+			continue
+		}
+		value, err := l.readObjectDepth(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		dict[name] = value
+	}
+}
+
+func (l *pdfLexer) readName() pdfName {
+	var sb strings.Builder
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		if isPdfWhitespace(c) || isPdfDelimiter(c) {
+			break
+		}
+		if c == '#' && l.pos+2 < len(l.data) {
+			if b, err := hex.DecodeString(string(l.data[l.pos+1 : l.pos+3])); err == nil {
+				sb.WriteByte(b[0])
+				l.pos += 3
+				continue
+			}
+		}
+		sb.WriteByte(c)
+		l.pos++
+	}
+	return pdfName(sb.String())
+}
+
+func (l *pdfLexer) readLiteralString() pdfString {
+	var (
+		sb    strings.Builder
+		level = 1
+	)
+	for l.pos < len(l.data) {
+		c := l.data[l.pos]
+		l.pos++
+		switch c {
+		case '(':
+			level++
+		case ')':
+			level--
+			if level == 0 {
+				return pdfString(sb.String())
+			}
+		case '\\':
+			if l.pos >= len(l.data) {
+				continue
+			}
+			c = l.data[l.pos]
+			l.pos++
+			switch c {
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case '\r':
+				if l.pos < len(l.data) && l.data[l.pos] == '\n' {
+					l.pos++
+				}
+			case '\n':
+			default:
+				if c >= '0' && c <= '7' {
+					code := int(c - '0')
+					for i := 0; i < 2 && l.pos < len(l.data) && l.data[l.pos] >= '0' && l.data[l.pos] <= '7'; i++ {
+						code = code*8 + int(l.data[l.pos]-'0')
+						l.pos++
+					}
+					sb.WriteByte(byte(code))
+				} else {
+					sb.WriteByte(c)
+				}
+			}
+			continue
+		}
+		sb.WriteByte(c)
+	}
+	return pdfString(sb.String())
+}
+
+// rest returns the unread data, position can be past the end of data after reading the truncated object
+func (l *pdfLexer) rest() []byte {
+	if l.pos >= len(l.data) {
+		return nil
+	}
+	return l.data[l.pos:]
+}
+
+func (l *pdfLexer) readHexString() pdfString {
+	rest := l.rest()
+	end := bytes.IndexByte(rest, '>')
+	if end < 0 {
+		end = len(rest)
+	}
+	data, _ := decodeAsciiHex(rest[:end])
+	l.pos = min(l.pos+end+1, len(l.data))
+	return pdfString(data)
+}
+
+// readStream reads the stream data following the stream dictionary, if any
+func (l *pdfLexer) readStream(dict pdfDict) *pdfStream {
+	l.skipWhitespace()
+	if !bytes.HasPrefix(l.rest(), []byte("stream")) {
+		return nil
+	}
+	start := l.pos + len("stream")
+	if start < len(l.data) && l.data[start] == '\r' {
+		start++
+	}
+	if start < len(l.data) && l.data[start] == '\n' {
+		start++
+	}
+	if length, ok := dict["Length"].(float64); ok && length >= 0 && float64(start)+length <= float64(len(l.data)) {
+		end := start + int(length)
+		rest := bytes.TrimLeft(l.data[end:], " \t\r\n")
+		if bytes.HasPrefix(rest, []byte("endstream")) {
+			return &pdfStream{dict: dict, raw: l.data[start:end]}
+		}
+	}
+	// length is indirect or wrong, look for the end of the stream instead
+	end := bytes.Index(l.data[start:], []byte("endstream"))
+	if end < 0 {
+		return &pdfStream{dict: dict, raw: l.data[start:]}
+	}
+	raw := l.data[start : start+end]
+	raw = bytes.TrimSuffix(raw, []byte("\n"))
+	raw = bytes.TrimSuffix(raw, []byte("\r"))
+	return &pdfStream{dict: dict, raw: raw}
+}
+
+// skipInlineImage skips the image data that follows BI operator, it can't be tokenized
+func (l *pdfLexer) skipInlineImage() {
+	idx := bytes.Index(l.rest(), []byte("ID"))
+	if idx < 0 {
+		l.pos = len(l.data)
+		return
+	}
+	l.pos += idx + len("ID")
+	for {
+		idx = bytes.Index(l.rest(), []byte("EI"))
+		if idx < 0 {
+			l.pos = len(l.data)
+			return
+		}
+		l.pos += idx + len("EI")
+		if l.pos-len("EI")-1 >= 0 && isPdfWhitespace(l.data[l.pos-len("EI")-1]) && (l.pos == len(l.data) || isPdfWhitespace(l.data[l.pos])) {
+			return
+		}
+	}
+}
@@ -14,6 +14,7 @@ type reindexFlags struct {
 	removeOldFiles          bool
 	deletedObjects          bool
 	eraseLinks              bool
+	blockLinks              bool
 }
 
 func (f *reindexFlags) any() bool {
@@ -27,7 +28,8 @@ func (f *reindexFlags) any() bool {
 		f.fileKeys ||
 		f.removeOldFiles ||
 		f.deletedObjects ||
-		f.eraseLinks
+		f.eraseLinks ||
+		f.blockLinks
 }
 
 func (f *reindexFlags) enableAll() {
@@ -42,6 +44,7 @@ func (f *reindexFlags) enableAll() {
 	f.removeOldFiles = true
 	f.deletedObjects = true
 	f.eraseLinks = true
+	f.blockLinks = true
 }
 
 func (f *reindexFlags) String() string {
@@ -4,23 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"strings"
 	"time"
 
 	"github.com/anyproto/any-sync/commonspace/spacestorage"
-	"golang.org/x/exp/slices"
 
 	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/chatobject"
 	smartblock2 "github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/simple"
 	"github.com/anyproto/anytype-heart/core/block/simple/text"
 	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files/filetext"
 	"github.com/anyproto/anytype-heart/metrics"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
-	"github.com/anyproto/anytype-heart/util/slice"
 )
 
 var (
@@ -28,6 +29,10 @@ var (
 	ftIndexForceMinInterval = time.Second * 10
 	ftBatchLimit            = 1000
 	ftBlockMaxSize          = 1024 * 1024
+	// ftFileMaxSize limits the size of files which content is extracted for full-text indexing
+	ftFileMaxSize          int64 = 50 * 1024 * 1024
+	ftFileReadTimeout            = time.Minute
+	ftChatMessagesPageSize       = 1000
 )
 
 func (i *indexer) ForceFTIndex() {
@@ -64,8 +69,14 @@ func (i *indexer) ftLoopRoutine() {
 func (i *indexer) runFullTextIndexer(ctx context.Context) {
 	batcher := i.ftsearch.NewAutoBatcher()
 	err := i.store.BatchProcessFullTextQueue(ctx, ftBatchLimit, func(objectIds []string) error {
+		// files are marked as extracted only after their pages are committed to the index
+		extractedFiles := map[string]domain.FileId{}
 		for _, objectId := range objectIds {
-			objDocs, err := i.prepareSearchDocument(ctx, objectId)
+			var extractedFileId domain.FileId
+			objDocs, objRemovedIds, isPartial, err := i.prepareChangedMessagesDocuments(ctx, objectId)
+			if !isPartial && err == nil {
+				objDocs, extractedFileId, err = i.prepareSearchDocument(ctx, objectId)
+			}
 			if err != nil && !errors.Is(err, domain.ErrObjectNotFound) && !errors.Is(err, spacestorage.ErrTreeStorageAlreadyDeleted) {
 				log.With("id", objectId).Errorf("prepare document for full-text indexing: %s", err)
 				if errors.Is(err, context.Canceled) {
@@ -74,7 +85,13 @@ func (i *indexer) runFullTextIndexer(ctx context.Context) {
 				continue
 			}
 
-			objDocs, objRemovedIds, err := i.filterOutNotChangedDocuments(objectId, objDocs)
+			// only changed messages are prepared in the partial case, so other indexed messages are kept as is
+			objDocs, removedIds, err := i.filterOutNotChangedDocuments(objectId, objDocs, isPartial)
+			if err != nil {
+				log.With("id", objectId).Errorf("filter changed full-text documents: %s", err)
+				continue
+			}
+			objRemovedIds = append(objRemovedIds, removedIds...)
 			for _, removeId := range objRemovedIds {
 				err = batcher.DeleteDoc(removeId)
 				if err != nil {
@@ -83,19 +100,24 @@ func (i *indexer) runFullTextIndexer(ctx context.Context) {
 			}
 
 			for _, doc := range objDocs {
-				if err != nil {
-					return fmt.Errorf("batcher delete: %w", err)
-				}
 				err = batcher.UpdateDoc(doc)
 				if err != nil {
 					return fmt.Errorf("batcher add: %w", err)
 				}
 			}
+			if extractedFileId != "" {
+				extractedFiles[objectId] = extractedFileId
+			}
 		}
 		err := batcher.Finish()
 		if err != nil {
 			return fmt.Errorf("finish batch: %w", err)
 		}
+		for objectId, fileId := range extractedFiles {
+			if err = i.store.SaveFileContentIndexed(fileId.String(), objectId); err != nil {
+				log.With("id", objectId).Errorf("mark file content as indexed: %s", err)
+			}
+		}
 		return nil
 	})
 	if err != nil {
@@ -105,24 +127,33 @@ func (i *indexer) runFullTextIndexer(ctx context.Context) {
 
 }
 
-func (i *indexer) filterOutNotChangedDocuments(id string, newDocs []ftsearch.SearchDoc) (changed []ftsearch.SearchDoc, removedIds []string, err error) {
+// filterOutNotChangedDocuments returns documents that differ from the indexed ones and ids of indexed documents
+// missing in newDocs. With keepMessages indexed messages missing in newDocs are not treated as removed
+func (i *indexer) filterOutNotChangedDocuments(id string, newDocs []ftsearch.SearchDoc, keepMessages bool) (changed []ftsearch.SearchDoc, removedIds []string, err error) {
+	newDocsById := make(map[string]ftsearch.SearchDoc, len(newDocs))
+	for _, doc := range newDocs {
+		newDocsById[doc.Id] = doc
+	}
 	var (
 		changedDocs []ftsearch.SearchDoc
 		removeDocs  []string
+		indexedDocs = make(map[string]struct{}, len(newDocs))
 	)
 	err = i.ftsearch.Iterate(id, []string{"Title", "Text"}, func(doc *ftsearch.SearchDoc) bool {
-		newDocIndex := slice.Find(newDocs, func(d ftsearch.SearchDoc) bool {
-			return d.Id == doc.Id
-		})
-
-		if newDocIndex == -1 {
+		newDoc, ok := newDocsById[doc.Id]
+		if !ok {
+			if keepMessages {
+				if path, err := domain.NewFromPath(doc.Id); err == nil && path.HasMessage() {
+					return true
+				}
+			}
 			// doc got removed
 			removeDocs = append(removeDocs, doc.Id)
 			return true
-		} else {
-			if newDocs[newDocIndex].Text != doc.Text || newDocs[newDocIndex].Title != doc.Title {
-				changedDocs = append(changedDocs, newDocs[newDocIndex])
-			}
+		}
+		indexedDocs[doc.Id] = struct{}{}
+		if newDoc.Text != doc.Text || newDoc.Title != doc.Title {
+			changedDocs = append(changedDocs, newDoc)
 		}
 		return true
 	})
@@ -131,9 +162,7 @@ func (i *indexer) filterOutNotChangedDocuments(id string, newDocs []ftsearch.Sea
 	}
 
 	for _, doc := range newDocs {
-		if !slices.ContainsFunc(changedDocs, func(d ftsearch.SearchDoc) bool {
-			return d.Id == doc.Id
-		}) {
+		if _, ok := indexedDocs[doc.Id]; !ok {
 			// doc is new as it doesn't exist in the index
 			changedDocs = append(changedDocs, doc)
 		}
@@ -149,80 +178,296 @@ var filesLayouts = map[model.ObjectTypeLayout]struct{}{
 	model.ObjectType_pdf:   {},
 }
 
-func (i *indexer) prepareSearchDocument(ctx context.Context, id string) (docs []ftsearch.SearchDoc, err error) {
+// chatMessagesSource is implemented by chat objects, which store messages outside of the object state
+type chatMessagesSource interface {
+	GetMessages(ctx context.Context, req chatobject.GetMessagesRequest) ([]*model.ChatMessage, error)
+	GetMessagesByIds(ctx context.Context, messageIds []string) ([]*model.ChatMessage, error)
+	TakeChangedMessageIds() []string
+	RestoreChangedMessageIds(messageIds []string)
+}
+
+// prepareSearchDocument returns documents of the object. For file objects whose content is extracted in this call
+// the file id is returned, so the file is marked as extracted after the documents are committed
+func (i *indexer) prepareSearchDocument(ctx context.Context, id string) (docs []ftsearch.SearchDoc, extractedFileId domain.FileId, err error) {
 	ctx = context.WithValue(ctx, metrics.CtxKeyEntrypoint, "index_fulltext")
+	// file content is read after releasing the object, because it may take a while
+	var contentFileId domain.FullFileId
 	err = cache.DoContext(i.picker, ctx, id, func(sb smartblock2.SmartBlock) error {
 		indexDetails, _ := sb.Type().Indexable()
 		if !indexDetails {
 			return nil
 		}
+		docs = prepareObjectDocuments(ctx, sb, id)
 
-		for _, rel := range sb.GetRelationLinks() {
-			if rel.Format != model.RelationFormat_shorttext && rel.Format != model.RelationFormat_longtext {
-				continue
+		if chat, ok := sb.(chatMessagesSource); ok {
+			// all messages are indexed, so changed ones are indexed too
+			changedIds := chat.TakeChangedMessageIds()
+			messageDocs, err := prepareChatMessagesDocuments(ctx, chat, id, sb.SpaceID())
+			if err != nil {
+				chat.RestoreChangedMessageIds(changedIds)
+				return fmt.Errorf("prepare chat messages: %w", err)
+			}
+			docs = append(docs, messageDocs...)
+		}
+
+		if isFileContentExtractable(sb) {
+			contentFileId = domain.FullFileId{
+				SpaceId: sb.SpaceID(),
+				FileId:  domain.FileId(sb.Details().GetString(bundle.RelationKeyFileId)),
+			}
+		}
+		return nil
+	})
+	if err != nil || contentFileId.FileId == "" {
+		return docs, "", err
+	}
+
+	pageDocs, extracted, err := i.prepareFileContentDocuments(ctx, id, contentFileId)
+	if err != nil {
+		// the file is still searchable by its name
+		log.With("id", id).Warnf("extract file text for full-text indexing: %s", err)
+	}
+	if extracted {
+		extractedFileId = contentFileId.FileId
+	}
+	return append(docs, pageDocs...), extractedFileId, nil
+}
+
+// prepareChatMessagesDocuments returns documents of all messages of the chat, messages are read page by page from the newest
+func prepareChatMessagesDocuments(ctx context.Context, chat chatMessagesSource, id string, spaceId string) (docs []ftsearch.SearchDoc, err error) {
+	req := chatobject.GetMessagesRequest{Limit: ftChatMessagesPageSize}
+	for {
+		messages, err := chat.GetMessages(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, msg := range messages {
+			if doc, ok := chatMessageDocument(msg, id, spaceId); ok {
+				docs = append(docs, doc)
 			}
-			val := sb.Details().GetString(domain.RelationKey(rel.Key))
-			if val == "" {
+		}
+		if len(messages) < ftChatMessagesPageSize {
+			return docs, nil
+		}
+		// messages of the page are ordered from the oldest
+		req.BeforeOrderId = messages[0].OrderId
+	}
+}
+
+// prepareChangedMessagesDocuments returns documents of the chat with only changed messages and ids of documents
+// of deleted messages, so other messages are not reindexed. Documents of the object itself are returned too,
+// unchanged ones are filtered out by the caller. False is returned for objects which are not chats
+// and for chats without changed messages, they are indexed as usual
+func (i *indexer) prepareChangedMessagesDocuments(ctx context.Context, id string) (docs []ftsearch.SearchDoc, removedIds []string, ok bool, err error) {
+	ctx = context.WithValue(ctx, metrics.CtxKeyEntrypoint, "index_fulltext")
+	err = cache.DoContext(i.picker, ctx, id, func(sb smartblock2.SmartBlock) error {
+		chat, isChat := sb.(chatMessagesSource)
+		if !isChat {
+			return nil
+		}
+		if indexDetails, _ := sb.Type().Indexable(); !indexDetails {
+			return nil
+		}
+		messageIds := chat.TakeChangedMessageIds()
+		if len(messageIds) == 0 {
+			return nil
+		}
+		ok = true
+		docs = prepareObjectDocuments(ctx, sb, id)
+		messages, err := chat.GetMessagesByIds(ctx, messageIds)
+		if err != nil {
+			// messages are indexed with the next change of the chat
+			chat.RestoreChangedMessageIds(messageIds)
+			return fmt.Errorf("get changed messages: %w", err)
+		}
+		indexed := make(map[string]struct{}, len(messages))
+		for _, msg := range messages {
+			if doc, ok := chatMessageDocument(msg, id, sb.SpaceID()); ok {
+				docs = append(docs, doc)
+				indexed[msg.Id] = struct{}{}
+			}
+		}
+		for _, messageId := range messageIds {
+			if _, ok := indexed[messageId]; !ok {
+				removedIds = append(removedIds, domain.NewObjectPathWithMessage(id, messageId).String())
+			}
+		}
+		return nil
+	})
+	return docs, removedIds, ok, err
+}
+
+// prepareObjectDocuments returns documents of text relations and text blocks of the object
+func prepareObjectDocuments(ctx context.Context, sb smartblock2.SmartBlock, id string) (docs []ftsearch.SearchDoc) {
+	for _, rel := range sb.GetRelationLinks() {
+		if rel.Format != model.RelationFormat_shorttext && rel.Format != model.RelationFormat_longtext {
+			continue
+		}
+		val := sb.Details().GetString(domain.RelationKey(rel.Key))
+		if val == "" {
+			continue
+		}
+		// skip readonly and hidden system relations
+		if bundledRel, err := bundle.PickRelation(domain.RelationKey(rel.Key)); err == nil {
+			if bundledRel.ReadOnly || bundledRel.Hidden && rel.Key != bundle.RelationKeyName.String() {
 				continue
 			}
-			// skip readonly and hidden system relations
-			if bundledRel, err := bundle.PickRelation(domain.RelationKey(rel.Key)); err == nil {
-				if bundledRel.ReadOnly || bundledRel.Hidden && rel.Key != bundle.RelationKeyName.String() {
-					continue
+		}
+
+		doc := ftsearch.SearchDoc{
+			Id:      domain.NewObjectPathWithRelation(id, rel.Key).String(),
+			SpaceId: sb.SpaceID(),
+			Text:    val,
+		}
+
+		if rel.Key == bundle.RelationKeyName.String() {
+			layout, layoutValid := sb.Layout()
+			if layoutValid {
+				if _, contains := filesLayouts[layout]; !contains {
+					doc.Title = val
+					doc.Text = ""
 				}
 			}
+		}
+
+		docs = append(docs, doc)
+	}
 
+	sb.Iterate(func(b simple.Block) (isContinue bool) {
+		if ctx.Err() != nil {
+			return false
+		}
+		if tb := b.Model().GetText(); tb != nil {
+			if len(strings.TrimSpace(tb.Text)) == 0 {
+				return true
+			}
+
+			if len(pbtypes.GetStringList(b.Model().GetFields(), text.DetailsKeyFieldName)) > 0 {
+				// block doesn't store the value itself, but it's a reference to relation
+				return true
+			}
 			doc := ftsearch.SearchDoc{
-				Id:      domain.NewObjectPathWithRelation(id, rel.Key).String(),
+				Id:      domain.NewObjectPathWithBlock(id, b.Model().Id).String(),
 				SpaceId: sb.SpaceID(),
-				Text:    val,
 			}
-
-			if rel.Key == bundle.RelationKeyName.String() {
-				layout, layoutValid := sb.Layout()
-				if layoutValid {
-					if _, contains := filesLayouts[layout]; !contains {
-						doc.Title = val
-						doc.Text = ""
-					}
-				}
+			if len(tb.Text) > ftBlockMaxSize {
+				doc.Text = tb.Text[:ftBlockMaxSize]
+			} else {
+				doc.Text = tb.Text
 			}
-
 			docs = append(docs, doc)
+
 		}
+		return true
+	})
+	return docs
+}
 
-		sb.Iterate(func(b simple.Block) (isContinue bool) {
-			if ctx.Err() != nil {
-				return false
-			}
-			if tb := b.Model().GetText(); tb != nil {
-				if len(strings.TrimSpace(tb.Text)) == 0 {
-					return true
-				}
+func chatMessageDocument(msg *model.ChatMessage, id string, spaceId string) (ftsearch.SearchDoc, bool) {
+	msgText := msg.GetMessage().GetText()
+	if len(strings.TrimSpace(msgText)) == 0 {
+		return ftsearch.SearchDoc{}, false
+	}
+	if len(msgText) > ftBlockMaxSize {
+		msgText = msgText[:ftBlockMaxSize]
+	}
+	return ftsearch.SearchDoc{
+		Id:      domain.NewObjectPathWithMessage(id, msg.Id).String(),
+		SpaceId: spaceId,
+		Text:    msgText,
+	}, true
+}
 
-				if len(pbtypes.GetStringList(b.Model().GetFields(), text.DetailsKeyFieldName)) > 0 {
-					// block doesn't store the value itself, but it's a reference to relation
-					return true
-				}
-				doc := ftsearch.SearchDoc{
-					Id:      domain.NewObjectPathWithBlock(id, b.Model().Id).String(),
-					SpaceId: sb.SpaceID(),
-				}
-				if len(tb.Text) > ftBlockMaxSize {
-					doc.Text = tb.Text[:ftBlockMaxSize]
-				} else {
-					doc.Text = tb.Text
-				}
-				docs = append(docs, doc)
+func isFileContentExtractable(sb smartblock2.SmartBlock) bool {
+	layout, ok := sb.Layout()
+	if !ok {
+		return false
+	}
+	if _, isFile := filesLayouts[layout]; !isFile {
+		return false
+	}
+	details := sb.Details()
+	if details.GetString(bundle.RelationKeyFileId) == "" ||
+		details.GetInt64(bundle.RelationKeyFileIndexingStatus) != int64(model.FileIndexingStatus_Indexed) ||
+		details.GetInt64(bundle.RelationKeySizeInBytes) > ftFileMaxSize {
+		return false
+	}
+	name := details.GetString(bundle.RelationKeyName)
+	if ext := details.GetString(bundle.RelationKeyFileExt); ext != "" {
+		name += "." + ext
+	}
+	return filetext.IsSupported(name, details.GetString(bundle.RelationKeyFileMimeType))
+}
 
-			}
-			return true
-		})
+// prepareFileContentDocuments returns a document per page of the text extracted from the file content.
+// File content is immutable, so it is extracted once per file id and then pages are taken from the index.
+// Extracted is true when the file content is read in this call, even if no text is found in it
+func (i *indexer) prepareFileContentDocuments(ctx context.Context, id string, fileId domain.FullFileId) (docs []ftsearch.SearchDoc, extracted bool, err error) {
+	if i.fileService == nil {
+		return nil, false, nil
+	}
+	indexed, err := i.store.IsFileContentIndexed(fileId.FileId.String(), id)
+	if err != nil {
+		return nil, false, fmt.Errorf("check file content is indexed: %w", err)
+	}
+	if indexed {
+		docs, err = i.indexedFileContentDocuments(id)
+		return docs, false, err
+	}
+	return i.extractFileContentDocuments(ctx, id, fileId)
+}
 
-		return nil
+// indexedFileContentDocuments returns pages of the file content which are already in the index
+func (i *indexer) indexedFileContentDocuments(id string) (docs []ftsearch.SearchDoc, err error) {
+	err = i.ftsearch.Iterate(id, []string{"Text"}, func(doc *ftsearch.SearchDoc) bool {
+		if path, err := domain.NewFromPath(doc.Id); err == nil && path.ObjectId == id && path.HasPage() {
+			docs = append(docs, *doc)
+		}
+		return true
 	})
+	if err != nil {
+		return nil, fmt.Errorf("iterate over indexed pages: %w", err)
+	}
+	return docs, nil
+}
+
+func (i *indexer) extractFileContentDocuments(ctx context.Context, id string, fileId domain.FullFileId) (docs []ftsearch.SearchDoc, extracted bool, err error) {
+	ctx, cancel := context.WithTimeout(ctx, ftFileReadTimeout)
+	defer cancel()
 
-	return docs, err
+	file, err := i.fileService.FileByHash(ctx, fileId)
+	if err != nil {
+		return nil, false, fmt.Errorf("get file: %w", err)
+	}
+	if file.Meta().Size > ftFileMaxSize {
+		// the file is never extracted, so it's not retried
+		return nil, true, nil
+	}
+	reader, err := file.Reader(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("get file reader: %w", err)
+	}
+	// size in meta can be missing, a truncated file can't be parsed, so it's skipped as well
+	data, err := io.ReadAll(io.LimitReader(reader, ftFileMaxSize+1))
+	if err != nil {
+		return nil, false, fmt.Errorf("read file: %w", err)
+	}
+	if int64(len(data)) > ftFileMaxSize {
+		return nil, true, nil
+	}
+	// the file is read, so extraction is not retried even if the content is broken
+	pages, err := filetext.Extract(data, file.Meta().Name, file.Meta().Media)
+	if err != nil {
+		return nil, true, err
+	}
+	for _, page := range pages {
+		docs = append(docs, ftsearch.SearchDoc{
+			Id:      domain.NewObjectPathWithPage(id, page.Number).String(),
+			SpaceId: fileId.SpaceId,
+			Text:    page.Text,
+		})
+	}
+	return docs, true, nil
 }
 
 func (i *indexer) ftInit() error {
@@ -232,6 +477,10 @@ func (i *indexer) ftInit() error {
 			return err
 		}
 		if docCount == 0 {
+			// pages of files are not in the index anymore, so their content is extracted again
+			if err := i.store.ClearFileContentIndexed(); err != nil {
+				return err
+			}
 			// query objects that are existing in the store
 			// if they are not existing in the object store, they will be indexed and added via reindexOutdatedObjects or on receiving via any-sync
 			ids, err := i.store.ListIdsCrossSpace()
@@ -2,6 +2,8 @@ package indexer
 
 import (
 	"context"
+	"errors"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
@@ -13,10 +15,13 @@ import (
 
 	"github.com/anyproto/anytype-heart/core/anytype/config"
 	"github.com/anyproto/anytype-heart/core/block/cache/mock_cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/chatobject"
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock/smarttest"
 	"github.com/anyproto/anytype-heart/core/block/editor/state"
 	"github.com/anyproto/anytype-heart/core/block/source/mock_source"
 	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files"
+	"github.com/anyproto/anytype-heart/core/files/mock_files"
 	"github.com/anyproto/anytype-heart/core/indexer/mock_indexer"
 	"github.com/anyproto/anytype-heart/core/wallet"
 	"github.com/anyproto/anytype-heart/core/wallet/mock_wallet"
@@ -106,7 +111,7 @@ func TestPrepareSearchDocument_Success(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.NoError(t, err)
 	require.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/b/blockId1", docs[0].Id)
@@ -127,7 +132,7 @@ func TestPrepareSearchDocument_Empty_NotIndexing(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.NoError(t, err)
 	require.Len(t, docs, 0)
 }
@@ -147,7 +152,7 @@ func TestPrepareSearchDocument_NoIndexableType(t *testing.T) {
 	smartTest.SetType(coresb.SmartBlockTypeDate)
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.Len(t, docs, 0)
 	assert.NoError(t, err)
 }
@@ -161,7 +166,7 @@ func TestPrepareSearchDocument_NoTextBlock(t *testing.T) {
 	))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.Len(t, docs, 0)
 	assert.NoError(t, err)
 }
@@ -178,7 +183,7 @@ func TestPrepareSearchDocument_RelationShortText_Success(t *testing.T) {
 	}))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.NoError(t, err)
 	assert.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/r/name", docs[0].Id)
@@ -198,7 +203,7 @@ func TestPrepareSearchDocument_RelationLongText_Success(t *testing.T) {
 	}))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.NoError(t, err)
 	assert.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/r/name", docs[0].Id)
@@ -219,7 +224,7 @@ func TestPrepareSearchDocument_RelationText_EmptyValue(t *testing.T) {
 	}))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	require.NoError(t, err)
 	require.Len(t, docs, 0)
 }
@@ -237,7 +242,7 @@ func TestPrepareSearchDocument_RelationText_WrongFormat(t *testing.T) {
 	}))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.NoError(t, err)
 	require.Len(t, docs, 0)
 }
@@ -255,7 +260,7 @@ func TestPrepareSearchDocument_BlockText_LessThanMaxSize(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.NoError(t, err)
 	require.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/b/blockId1", docs[0].Id)
@@ -277,7 +282,7 @@ func TestPrepareSearchDocument_BlockText_EqualToMaxSize(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.NoError(t, err)
 	require.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/b/blockId1", docs[0].Id)
@@ -299,13 +304,283 @@ func TestPrepareSearchDocument_BlockText_GreaterThanMaxSize(t *testing.T) {
 		)))
 	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
 
-	docs, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "objectId1")
 	assert.NoError(t, err)
 	require.Len(t, docs, 1)
 	assert.Equal(t, "objectId1/b/blockId1", docs[0].Id)
 	assert.Equal(t, maxSize, len(docs[0].Text))
 }
 
+type chatObjectStub struct {
+	*smarttest.SmartTest
+	messages        []*model.ChatMessage
+	changedMessages []string
+	getErr          error
+}
+
+// GetMessages returns the page of messages before req.BeforeOrderId like the chat object does, messages are ordered by OrderId
+func (c *chatObjectStub) GetMessages(ctx context.Context, req chatobject.GetMessagesRequest) ([]*model.ChatMessage, error) {
+	end := len(c.messages)
+	if req.BeforeOrderId != "" {
+		end = slices.IndexFunc(c.messages, func(msg *model.ChatMessage) bool {
+			return msg.OrderId >= req.BeforeOrderId
+		})
+	}
+	start := 0
+	if req.Limit > 0 {
+		start = max(0, end-req.Limit)
+	}
+	return c.messages[start:end], nil
+}
+
+func (c *chatObjectStub) GetMessagesByIds(ctx context.Context, messageIds []string) ([]*model.ChatMessage, error) {
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	var messages []*model.ChatMessage
+	for _, msg := range c.messages {
+		if slices.Contains(messageIds, msg.Id) {
+			messages = append(messages, msg)
+		}
+	}
+	return messages, nil
+}
+
+func (c *chatObjectStub) TakeChangedMessageIds() []string {
+	ids := c.changedMessages
+	c.changedMessages = nil
+	return ids
+}
+
+func (c *chatObjectStub) RestoreChangedMessageIds(messageIds []string) {
+	c.changedMessages = append(c.changedMessages, messageIds...)
+}
+
+func TestPrepareSearchDocument_ChatMessages(t *testing.T) {
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("chatId1")
+	smartTest.SetSpaceId("spaceId1")
+	chat := &chatObjectStub{
+		SmartTest: smartTest,
+		messages: []*model.ChatMessage{
+			{Id: "messageId1", Message: &model.ChatMessageMessageContent{Text: "let's discuss the release"}},
+			{Id: "messageId2", Message: &model.ChatMessageMessageContent{Text: " "}},
+		},
+	}
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(chat, nil)
+
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "chatId1")
+	assert.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "chatId1/m/messageId1", docs[0].Id)
+	assert.Equal(t, "spaceId1", docs[0].SpaceId)
+	assert.Equal(t, "let's discuss the release", docs[0].Text)
+}
+
+func TestPrepareSearchDocument_ChatMessagesPages(t *testing.T) {
+	ftChatMessagesPageSize = 2
+	defer func() {
+		ftChatMessagesPageSize = 1000
+	}()
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("chatId1")
+	smartTest.SetSpaceId("spaceId1")
+	chat := &chatObjectStub{SmartTest: smartTest}
+	for i := range 5 {
+		chat.messages = append(chat.messages, &model.ChatMessage{
+			Id:      "messageId" + strconv.Itoa(i),
+			OrderId: "order" + strconv.Itoa(i),
+			Message: &model.ChatMessageMessageContent{Text: "message " + strconv.Itoa(i)},
+		})
+	}
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(chat, nil)
+
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "chatId1")
+	require.NoError(t, err)
+	var ids []string
+	for _, doc := range docs {
+		ids = append(ids, doc.Id)
+	}
+	assert.ElementsMatch(t, []string{
+		"chatId1/m/messageId0", "chatId1/m/messageId1", "chatId1/m/messageId2", "chatId1/m/messageId3", "chatId1/m/messageId4",
+	}, ids)
+}
+
+func TestFilterOutNotChangedDocuments_KeepMessages(t *testing.T) {
+	indexerFx := NewIndexerFixture(t)
+	for _, doc := range []ftsearch.SearchDoc{
+		{Id: "chatId1/r/name", SpaceId: "spaceId1", Title: "chat"},
+		{Id: "chatId1/m/messageId1", SpaceId: "spaceId1", Text: "first"},
+		{Id: "chatId1/m/messageId2", SpaceId: "spaceId1", Text: "second"},
+	} {
+		require.NoError(t, indexerFx.ftsearch.Index(doc))
+	}
+
+	changed, removedIds, err := indexerFx.filterOutNotChangedDocuments("chatId1", []ftsearch.SearchDoc{
+		{Id: "chatId1/r/name", SpaceId: "spaceId1", Title: "chat"},
+		{Id: "chatId1/m/messageId2", SpaceId: "spaceId1", Text: "edited"},
+	}, true)
+	require.NoError(t, err)
+	require.Len(t, changed, 1)
+	assert.Equal(t, "chatId1/m/messageId2", changed[0].Id)
+	assert.Empty(t, removedIds)
+}
+
+func TestPrepareChangedMessagesDocuments(t *testing.T) {
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("chatId1")
+	smartTest.SetSpaceId("spaceId1")
+	chat := &chatObjectStub{
+		SmartTest: smartTest,
+		messages: []*model.ChatMessage{
+			{Id: "messageId1", Message: &model.ChatMessageMessageContent{Text: "let's discuss the release"}},
+			{Id: "messageId2", Message: &model.ChatMessageMessageContent{Text: "edited message"}},
+		},
+		changedMessages: []string{"messageId2", "deletedId"},
+	}
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(chat, nil)
+
+	docs, removedIds, ok, err := indexerFx.prepareChangedMessagesDocuments(context.Background(), "chatId1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "chatId1/m/messageId2", docs[0].Id)
+	assert.Equal(t, []string{"chatId1/m/deletedId"}, removedIds)
+
+	// all messages are indexed when the chat has no changed messages
+	_, _, ok, err = indexerFx.prepareChangedMessagesDocuments(context.Background(), "chatId1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestPrepareChangedMessagesDocuments_Error(t *testing.T) {
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("chatId1")
+	smartTest.SetSpaceId("spaceId1")
+	chat := &chatObjectStub{
+		SmartTest:       smartTest,
+		changedMessages: []string{"messageId1"},
+		getErr:          errors.New("read failed"),
+	}
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(chat, nil)
+
+	_, _, _, err := indexerFx.prepareChangedMessagesDocuments(context.Background(), "chatId1")
+
+	// changed messages are kept to be indexed next time
+	require.Error(t, err)
+	assert.Equal(t, []string{"messageId1"}, chat.changedMessages)
+}
+
+func TestPrepareSearchDocument_FileContent(t *testing.T) {
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("fileObjectId1")
+	smartTest.SetSpaceId("spaceId1")
+	smartTest.Doc.(*state.State).SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyLayout:             domain.Int64(model.ObjectType_file),
+		bundle.RelationKeyFileId:             domain.String("fileId1"),
+		bundle.RelationKeyName:               domain.String("notes"),
+		bundle.RelationKeyFileExt:            domain.String("txt"),
+		bundle.RelationKeyFileMimeType:       domain.String("text/plain"),
+		bundle.RelationKeyFileIndexingStatus: domain.Int64(model.FileIndexingStatus_Indexed),
+	}))
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
+
+	file := mock_files.NewMockFile(t)
+	file.EXPECT().Meta().Return(&files.FileMeta{Name: "notes.txt", Media: "text/plain"})
+	file.EXPECT().Reader(mock.Anything).Return(strings.NewReader("meeting notes"), nil)
+	fileService := mock_files.NewMockService(t)
+	fileService.EXPECT().FileByHash(mock.Anything, domain.FullFileId{SpaceId: "spaceId1", FileId: "fileId1"}).Return(file, nil)
+	indexerFx.fileService = fileService
+
+	docs, _, err := indexerFx.prepareSearchDocument(context.Background(), "fileObjectId1")
+	assert.NoError(t, err)
+	require.Len(t, docs, 1)
+	assert.Equal(t, "fileObjectId1/p/1", docs[0].Id)
+	assert.Equal(t, "spaceId1", docs[0].SpaceId)
+	assert.Equal(t, "meeting notes", docs[0].Text)
+}
+
+func TestRunFullTextIndexer_FileContentExtractedOnce(t *testing.T) {
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("fileObjectId1")
+	smartTest.SetSpaceId("spaceId1")
+	smartTest.Doc.(*state.State).SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyLayout:             domain.Int64(model.ObjectType_file),
+		bundle.RelationKeyFileId:             domain.String("fileId1"),
+		bundle.RelationKeyName:               domain.String("notes"),
+		bundle.RelationKeyFileExt:            domain.String("txt"),
+		bundle.RelationKeyFileMimeType:       domain.String("text/plain"),
+		bundle.RelationKeyFileIndexingStatus: domain.Int64(model.FileIndexingStatus_Indexed),
+	}))
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
+
+	file := mock_files.NewMockFile(t)
+	file.EXPECT().Meta().Return(&files.FileMeta{Name: "notes.txt", Media: "text/plain"})
+	file.EXPECT().Reader(mock.Anything).Return(strings.NewReader("meeting notes"), nil).Once()
+	fileService := mock_files.NewMockService(t)
+	fileService.EXPECT().FileByHash(mock.Anything, domain.FullFileId{SpaceId: "spaceId1", FileId: "fileId1"}).Return(file, nil).Once()
+	indexerFx.fileService = fileService
+
+	// the file is not marked as extracted before its pages are committed
+	_, extractedFileId, err := indexerFx.prepareSearchDocument(context.Background(), "fileObjectId1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.FileId("fileId1"), extractedFileId)
+	indexed, err := indexerFx.store.IsFileContentIndexed("fileId1", "fileObjectId1")
+	require.NoError(t, err)
+	assert.False(t, indexed)
+
+	file.EXPECT().Reader(mock.Anything).Return(strings.NewReader("meeting notes"), nil).Once()
+	fileService.EXPECT().FileByHash(mock.Anything, domain.FullFileId{SpaceId: "spaceId1", FileId: "fileId1"}).Return(file, nil).Once()
+	require.NoError(t, indexerFx.store.AddToIndexQueue(context.Background(), "fileObjectId1"))
+	indexerFx.runFullTextIndexer(context.Background())
+	indexed, err = indexerFx.store.IsFileContentIndexed("fileId1", "fileObjectId1")
+	require.NoError(t, err)
+	assert.True(t, indexed)
+
+	// details change, but the file is the same
+	docs, extractedFileId, err := indexerFx.prepareSearchDocument(context.Background(), "fileObjectId1")
+	require.NoError(t, err)
+	assert.Empty(t, extractedFileId)
+	var pages []string
+	for _, doc := range docs {
+		if path, err := domain.NewFromPath(doc.Id); err == nil && path.HasPage() {
+			pages = append(pages, doc.Text)
+		}
+	}
+	assert.Equal(t, []string{"meeting notes"}, pages)
+}
+
+func TestPrepareSearchDocument_FileContentTooLarge(t *testing.T) {
+	indexerFx := NewIndexerFixture(t)
+	smartTest := smarttest.New("fileObjectId1")
+	smartTest.SetSpaceId("spaceId1")
+	smartTest.Doc.(*state.State).SetDetails(domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyLayout:             domain.Int64(model.ObjectType_file),
+		bundle.RelationKeyFileId:             domain.String("fileId1"),
+		bundle.RelationKeyName:               domain.String("notes"),
+		bundle.RelationKeyFileExt:            domain.String("txt"),
+		bundle.RelationKeyFileMimeType:       domain.String("text/plain"),
+		bundle.RelationKeyFileIndexingStatus: domain.Int64(model.FileIndexingStatus_Indexed),
+	}))
+	indexerFx.pickerFx.EXPECT().GetObject(mock.Anything, mock.Anything).Return(smartTest, nil)
+
+	file := mock_files.NewMockFile(t)
+	file.EXPECT().Meta().Return(&files.FileMeta{Name: "notes.txt", Media: "text/plain"})
+	file.EXPECT().Reader(mock.Anything).Return(strings.NewReader(strings.Repeat("a", int(ftFileMaxSize)+1)), nil)
+	fileService := mock_files.NewMockService(t)
+	fileService.EXPECT().FileByHash(mock.Anything, mock.Anything).Return(file, nil)
+	indexerFx.fileService = fileService
+
+	docs, extractedFileId, err := indexerFx.prepareSearchDocument(context.Background(), "fileObjectId1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.FileId("fileId1"), extractedFileId)
+	for _, doc := range docs {
+		path, err := domain.NewFromPath(doc.Id)
+		require.NoError(t, err)
+		assert.False(t, path.HasPage())
+	}
+}
+
 func TestRunFullTextIndexer(t *testing.T) {
 	indexerFx := NewIndexerFixture(t)
 	for i := range 10 {
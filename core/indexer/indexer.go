@@ -13,6 +13,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
 	"github.com/anyproto/anytype-heart/core/block/source"
 	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/files"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/filestore"
@@ -51,6 +52,7 @@ type Hasher interface {
 type indexer struct {
 	store          objectstore.ObjectStore
 	fileStore      filestore.FileStore
+	fileService    files.Service
 	source         source.Service
 	picker         cache.ObjectGetter
 	ftsearch       ftsearch.FTSearch
@@ -76,6 +78,7 @@ func (i *indexer) Init(a *app.App) (err error) {
 	i.source = a.MustComponent(source.CName).(source.Service)
 	i.btHash = a.MustComponent("builtintemplate").(Hasher)
 	i.fileStore = app.MustComponent[filestore.FileStore](a)
+	i.fileService = app.MustComponent[files.Service](a)
 	i.ftsearch = app.MustComponent[ftsearch.FTSearch](a)
 	i.picker = app.MustComponent[cache.ObjectGetter](a)
 	i.runCtx, i.runCtxCancel = context.WithCancel(context.Background())
@@ -42,6 +42,10 @@ const (
 	// ForceLinksReindexCounter forces to erase links from store and reindex them
 	ForceLinksReindexCounter int32 = 1
 
+	// ForceBlockLinksReindexCounter reindex objects with links to index links and mentions of blocks,
+	// other objects can't have block links
+	ForceBlockLinksReindexCounter int32 = 1
+
 	// ForceMarketplaceReindex forces to do reindex only for marketplace space
 	ForceMarketplaceReindex int32 = 1
 
@@ -68,6 +72,7 @@ func (i *indexer) buildFlags(spaceID string) (reindexFlags, error) {
 			// per space
 			FilestoreKeysForceReindexCounter: ForceFilestoreKeysReindexCounter,
 			LinksErase:                       ForceLinksReindexCounter,
+			BlockLinksReindexCounter:         ForceBlockLinksReindexCounter,
 			// global
 			BundledObjects:        ForceBundledObjectsReindexCounter,
 			AreOldFilesRemoved:    true,
@@ -109,6 +114,9 @@ func (i *indexer) buildFlags(spaceID string) (reindexFlags, error) {
 	if checksums.LinksErase != ForceLinksReindexCounter {
 		flags.eraseLinks = true
 	}
+	if checksums.BlockLinksReindexCounter != ForceBlockLinksReindexCounter {
+		flags.blockLinks = true
+	}
 	if spaceID == addr.AnytypeMarketplaceWorkspace && checksums.MarketplaceForceReindexCounter != ForceMarketplaceReindex {
 		flags.enableAll()
 	}
@@ -172,6 +180,13 @@ func (i *indexer) ReindexSpace(space clientspace.Space) (err error) {
 			}
 		}
 
+		if flags.blockLinks {
+			err := i.reindexObjectsWithLinks(ctx, space)
+			if err != nil {
+				return fmt.Errorf("reindex objects with links: %w", err)
+			}
+		}
+
 		// Index objects that updated, but not indexed yet
 		// we can have objects which actual state is newer than the indexed one
 		// this may happen e.g. if the app got closed in the middle of object updates processing
@@ -408,6 +423,16 @@ func (i *indexer) removeCommonIndexes(spaceId string, space clientspace.Space, f
 	return
 }
 
+// reindexObjectsWithLinks reindexes objects that have outbound links. Links and mentions of blocks
+// always point to linked objects, so the rest of objects don't need to be reindexed
+func (i *indexer) reindexObjectsWithLinks(ctx context.Context, space clientspace.Space) error {
+	ids, err := i.store.SpaceIndex(space.Id()).ListIdsWithOutboundLinks()
+	if err != nil {
+		return fmt.Errorf("list ids with links: %w", err)
+	}
+	return i.reindexIDs(ctx, space, metrics.ReindexTypeBlockLinks, ids)
+}
+
 func (i *indexer) reindexIDsForSmartblockTypes(ctx context.Context, space smartblock.Space, reindexType metrics.ReindexType, sbTypes ...coresb.SmartBlockType) error {
 	ids, err := i.getIdsForTypes(space, sbTypes...)
 	if err != nil {
@@ -498,6 +523,7 @@ func (i *indexer) getLatestChecksums(isMarketplace bool) (checksums model.Object
 		AreDeletedObjectsReindexed:       true,
 		LinksErase:                       ForceLinksReindexCounter,
 		ReindexDeletedObjects:            ForceReindexDeletedObjectsCounter,
+		BlockLinksReindexCounter:         ForceBlockLinksReindexCounter,
 	}
 	if isMarketplace {
 		checksums.MarketplaceForceReindexCounter = ForceMarketplaceReindex
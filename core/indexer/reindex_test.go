@@ -287,6 +287,48 @@ func TestIndexer_ReindexSpace_EraseLinks(t *testing.T) {
 	})
 }
 
+func TestIndexer_ReindexSpace_BlockLinks(t *testing.T) {
+	// given
+	const spaceId1 = "space1"
+	fx := NewIndexerFixture(t)
+	fx.sourceFx.EXPECT().IDsListerBySmartblockType(mock.Anything, mock.Anything).Return(idsLister{Ids: []string{}}, nil).Maybe()
+	fx.objectStore.AddObjects(t, spaceId1, []objectstore.TestObject{
+		{
+			bundle.RelationKeyId:      domain.String("withLinks"),
+			bundle.RelationKeySpaceId: domain.String(spaceId1),
+		},
+		{
+			bundle.RelationKeyId:      domain.String("withoutLinks"),
+			bundle.RelationKeySpaceId: domain.String(spaceId1),
+		},
+	})
+	store := fx.store.SpaceIndex(spaceId1)
+	err := store.UpdateObjectLinks(ctx, "withLinks", []string{"withoutLinks"})
+	require.NoError(t, err)
+
+	checksums := fx.getLatestChecksums(false)
+	checksums.BlockLinksReindexCounter = checksums.BlockLinksReindexCounter - 1
+	err = fx.objectStore.SaveChecksums(spaceId1, &checksums)
+	require.NoError(t, err)
+
+	space1 := mock_space.NewMockSpace(t)
+	space1.EXPECT().Id().Return(spaceId1)
+	space1.EXPECT().StoredIds().Return([]string{}).Maybe()
+	space1.EXPECT().Do("withLinks", mock.Anything).Return(nil).Once()
+
+	// when
+	err = fx.ReindexSpace(space1)
+
+	// then
+	assert.NoError(t, err)
+	links, err := store.GetOutboundLinksById("withLinks")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"withoutLinks"}, links)
+	storeChecksums, err := fx.store.GetChecksums(spaceId1)
+	assert.NoError(t, err)
+	assert.Equal(t, ForceBlockLinksReindexCounter, storeChecksums.BlockLinksReindexCounter)
+}
+
 func TestReindex_addSyncRelations(t *testing.T) {
 	t.Run("addSyncRelations local only", func(t *testing.T) {
 		// given
@@ -170,6 +170,10 @@ func (i *spaceIndexer) index(ctx context.Context, info smartblock.DocInfo, optio
 			hasError = true
 			log.With("objectID", info.Id).Errorf("failed to save object links: %v", err)
 		}
+		if err = i.spaceIndex.UpdateObjectBlockLinks(ctx, info.Id, info.BlockLinks); err != nil {
+			hasError = true
+			log.With("objectID", info.Id).Errorf("failed to save object block links: %v", err)
+		}
 	}
 
 	indexLinksTime := time.Now()
@@ -88,12 +88,11 @@ func (t *GroupTag) MakeGroups() (GroupSlice, error) {
 
 	// multiple tag groups
 	for _, rec := range t.Records {
-		tagIDs := slice.Filter(rec.Details.GetStringList(t.Key), func(tagID string) bool { // filter removed options
+		tagIDs := tagGroupIds(rec.Details.GetStringList(t.Key), func(tagID string) bool {
 			return uniqMap[tagID]
 		})
 
 		if len(tagIDs) > 1 {
-			sort.Strings(tagIDs)
 			hash := strings.Join(tagIDs, "")
 			if !uniqMap[hash] {
 				uniqMap[hash] = true
@@ -138,3 +137,12 @@ func (t *GroupTag) MakeDataViewGroups() ([]*model.BlockContentDataviewGroup, err
 
 	return result, nil
 }
+
+// tagGroupIds returns sorted ids of the tag group, filtering out empty and removed options
+func tagGroupIds(tagIDs []string, optionExists func(id string) bool) []string {
+	tagIDs = slice.Filter(tagIDs, func(tagID string) bool {
+		return tagID != "" && (optionExists == nil || optionExists(tagID))
+	})
+	sort.Strings(tagIDs)
+	return tagIDs
+}
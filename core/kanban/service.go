@@ -5,6 +5,8 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/anyproto/any-sync/app"
 
@@ -16,6 +18,8 @@ import (
 
 const (
 	CName = "kanban"
+
+	emptyGroupId = "empty"
 )
 
 type Service interface {
@@ -91,3 +95,28 @@ func Hash(id string) string {
 	idHash := hex.EncodeToString(hash[:])
 	return idHash
 }
+
+// GroupId returns the id of the group made by Grouper.MakeDataViewGroups that contains an object with the given relation value.
+// optionExists reports whether the tag option is not removed, nil means that all options exist
+func GroupId(format model.RelationFormat, value domain.Value, optionExists func(id string) bool) string {
+	switch format {
+	case model.RelationFormat_checkbox:
+		return strconv.FormatBool(value.Bool())
+	case model.RelationFormat_status:
+		for _, id := range value.WrapToList() {
+			if id.String() != "" {
+				return id.String()
+			}
+		}
+	case model.RelationFormat_tag:
+		var ids []string
+		for _, id := range value.WrapToList() {
+			ids = append(ids, id.String())
+		}
+		ids = tagGroupIds(ids, optionExists)
+		if len(ids) > 0 {
+			return Hash(strings.Join(ids, ""))
+		}
+	}
+	return emptyGroupId
+}
@@ -117,3 +117,18 @@ func Test_GrouperTags(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, groups, 5)
 }
+
+func TestGroupId(t *testing.T) {
+	require.Equal(t, "true", GroupId(model.RelationFormat_checkbox, domain.Bool(true), nil))
+	require.Equal(t, "false", GroupId(model.RelationFormat_checkbox, domain.Invalid(), nil))
+	require.Equal(t, "status1", GroupId(model.RelationFormat_status, domain.StringList([]string{"status1"}), nil))
+	require.Equal(t, "empty", GroupId(model.RelationFormat_status, domain.String(""), nil))
+	require.Equal(t, Hash("tag1"), GroupId(model.RelationFormat_tag, domain.StringList([]string{"tag1"}), nil))
+	require.Equal(t, Hash("tag1tag2"), GroupId(model.RelationFormat_tag, domain.StringList([]string{"tag2", "tag1"}), nil))
+	require.Equal(t, "empty", GroupId(model.RelationFormat_tag, domain.StringList(nil), nil))
+
+	// removed options are dropped the same way as in GroupTag.MakeGroups
+	optionExists := func(id string) bool { return id != "removed" }
+	require.Equal(t, Hash("tag1"), GroupId(model.RelationFormat_tag, domain.StringList([]string{"removed", "tag1"}), optionExists))
+	require.Equal(t, "empty", GroupId(model.RelationFormat_tag, domain.StringList([]string{"removed"}), optionExists))
+}
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/anyproto/anytype-heart/core/block/object/idresolver"
 	"github.com/anyproto/anytype-heart/pb"
@@ -80,3 +81,35 @@ func (mw *Middleware) NavigationGetObjectInfoWithLinks(_ context.Context, req *p
 
 	return response(pb.RpcNavigationGetObjectInfoWithLinksResponseError_NULL, page, nil)
 }
+
+func (mw *Middleware) NavigationGetBlockBacklinks(_ context.Context, req *pb.RpcNavigationGetBlockBacklinksRequest) *pb.RpcNavigationGetBlockBacklinksResponse {
+	response := func(code pb.RpcNavigationGetBlockBacklinksResponseErrorCode, backlinks []*model.BlockLink, err error) *pb.RpcNavigationGetBlockBacklinksResponse {
+		m := &pb.RpcNavigationGetBlockBacklinksResponse{Error: &pb.RpcNavigationGetBlockBacklinksResponseError{Code: code}, Backlinks: backlinks}
+		if err != nil {
+			m.Error.Description = getErrorDescription(err)
+		}
+		return m
+	}
+
+	if mw.applicationService.GetApp() == nil {
+		return response(pb.RpcNavigationGetBlockBacklinksResponseError_BAD_INPUT, nil, fmt.Errorf("account must be started"))
+	}
+
+	resolver := mustService[idresolver.Resolver](mw)
+	spaceId, err := resolver.ResolveSpaceID(req.ObjectId)
+	if err != nil {
+		return response(pb.RpcNavigationGetBlockBacklinksResponseError_UNKNOWN_ERROR, nil, fmt.Errorf("resolve spaceId: %w", err))
+	}
+
+	store := mustService[objectstore.ObjectStore](mw)
+	links, err := store.SpaceIndex(spaceId).GetInboundBlockLinks(req.ObjectId)
+	if err != nil {
+		return response(pb.RpcNavigationGetBlockBacklinksResponseError_UNKNOWN_ERROR, nil, err)
+	}
+	if req.BlockId != "" {
+		links = slices.DeleteFunc(links, func(link *model.BlockLink) bool {
+			return link.TargetBlockId != req.BlockId
+		})
+	}
+	return response(pb.RpcNavigationGetBlockBacklinksResponseError_NULL, links, nil)
+}
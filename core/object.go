@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/samber/lo"
 
+	"github.com/anyproto/anytype-heart/core/anytype/account"
 	"github.com/anyproto/anytype-heart/core/block"
 	importer "github.com/anyproto/anytype-heart/core/block/import"
 	"github.com/anyproto/anytype-heart/core/block/import/common"
@@ -182,6 +183,61 @@ func (mw *Middleware) ObjectSearchWithMeta(cctx context.Context, req *pb.RpcObje
 	return response(pb.RpcObjectSearchWithMetaResponseError_NULL, resultsModels, nil)
 }
 
+func (mw *Middleware) ObjectCrossSpaceSearchWithMeta(cctx context.Context, req *pb.RpcObjectCrossSpaceSearchWithMetaRequest) *pb.RpcObjectCrossSpaceSearchWithMetaResponse {
+	response := func(code pb.RpcObjectCrossSpaceSearchWithMetaResponseErrorCode, results []*model.SearchResult, err error) *pb.RpcObjectCrossSpaceSearchWithMetaResponse {
+		m := &pb.RpcObjectCrossSpaceSearchWithMetaResponse{Error: &pb.RpcObjectCrossSpaceSearchWithMetaResponseError{Code: code}, Results: results}
+		if err != nil {
+			m.Error.Description = getErrorDescription(err)
+		}
+
+		return m
+	}
+
+	if mw.applicationService.GetApp() == nil {
+		return response(pb.RpcObjectCrossSpaceSearchWithMetaResponseError_BAD_INPUT, nil, fmt.Errorf("account must be started"))
+	}
+	if req.FullText == "" {
+		return response(pb.RpcObjectCrossSpaceSearchWithMetaResponseError_BAD_INPUT, nil, fmt.Errorf("full text query is empty"))
+	}
+
+	mw.applicationService.GetApp().MustComponent(indexer.CName).(indexer.Indexer).ForceFTIndex()
+
+	ds := mw.applicationService.GetApp().MustComponent(objectstore.CName).(objectstore.ObjectStore)
+	spaceIds, err := ds.ListAvailableSpaceIds(mustService[account.Service](mw).AccountID())
+	if err != nil {
+		return response(pb.RpcObjectCrossSpaceSearchWithMetaResponseError_UNKNOWN_ERROR, nil, err)
+	}
+	if len(req.SpaceIds) > 0 {
+		spaceIds = lo.Intersect(spaceIds, req.SpaceIds)
+	}
+
+	results, err := ds.SearchCrossSpace(spaceIds, database.Query{
+		Filters:   database.FiltersFromProto(req.Filters),
+		Offset:    int(req.Offset),
+		Limit:     int(req.Limit),
+		TextQuery: req.FullText,
+	})
+	if err != nil {
+		return response(pb.RpcObjectCrossSpaceSearchWithMetaResponseError_UNKNOWN_ERROR, nil, err)
+	}
+
+	var resultsModels = make([]*model.SearchResult, 0, len(results))
+	for i, rec := range results {
+		spaceId := rec.Details.GetString(bundle.RelationKeySpaceId)
+		if len(req.Keys) > 0 {
+			rec.Details = rec.Details.CopyOnlyKeys(slice.StringsInto[domain.RelationKey](req.Keys)...)
+		}
+		resultsModels = append(resultsModels, &model.SearchResult{
+			ObjectId: rec.Details.GetString(bundle.RelationKeyId),
+			SpaceId:  spaceId,
+			Details:  rec.Details.ToProto(),
+			Meta:     []*model.SearchMeta{&(results[i].Meta)},
+		})
+	}
+
+	return response(pb.RpcObjectCrossSpaceSearchWithMetaResponseError_NULL, resultsModels, nil)
+}
+
 func (mw *Middleware) ObjectSearchSubscribe(cctx context.Context, req *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse {
 	errResponse := func(err error) *pb.RpcObjectSearchSubscribeResponse {
 		r := &pb.RpcObjectSearchSubscribeResponse{
@@ -214,6 +270,8 @@ func (mw *Middleware) ObjectSearchSubscribe(cctx context.Context, req *pb.RpcObj
 		Source:            req.Source,
 		NoDepSubscription: req.NoDepSubscription,
 		CollectionId:      req.CollectionId,
+		Formulas:          req.Formulas,
+		GroupRelationKey:  req.GroupRelationKey,
 	})
 	if err != nil {
 		return errResponse(err)
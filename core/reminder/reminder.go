@@ -0,0 +1,522 @@
+package reminder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/anyproto/any-sync/app"
+	"github.com/anyproto/any-sync/commonspace/object/tree/treestorage"
+	"github.com/dgraph-io/badger/v4"
+
+	"github.com/anyproto/anytype-heart/core/anytype/account"
+	"github.com/anyproto/anytype-heart/core/block/cache"
+	"github.com/anyproto/anytype-heart/core/block/editor/basic"
+	"github.com/anyproto/anytype-heart/core/block/editor/smartblock"
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/object/objectcreator"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/block/simple/table"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/notifications"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/datastore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
+	"github.com/anyproto/anytype-heart/pkg/lib/logging"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+	"github.com/anyproto/anytype-heart/space"
+	"github.com/anyproto/anytype-heart/space/clientspace"
+	"github.com/anyproto/anytype-heart/util/badgerhelper"
+	"github.com/anyproto/anytype-heart/util/dateutil"
+)
+
+const CName = "core.reminder"
+
+const (
+	checkInterval = 30 * time.Second
+	// dateOnlyRemindHour is the local hour to remind about the dates without time
+	dateOnlyRemindHour = 9
+	// dateOnlyShift is the max difference between the date value and the moment of the reminder
+	dateOnlyShift = 2 * 24 * time.Hour
+)
+
+var log = logging.Logger(CName)
+
+// Service sends notifications when the values of the date relations flagged with relationReminder come,
+// and creates the next occurrences of the done objects with the recurrence rule
+type Service interface {
+	app.ComponentRunnable
+}
+
+type service struct {
+	objectStore    objectstore.ObjectStore
+	notifications  notifications.Notifications
+	accountService account.Service
+	objectGetter   cache.ObjectGetter
+	objectCreator  objectcreator.Service
+	spaceService   space.Service
+	store          *reminderStore
+
+	now       func() time.Time
+	location  *time.Location
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+}
+
+func New() Service {
+	return &service{
+		now:      time.Now,
+		location: time.Local,
+	}
+}
+
+func (s *service) Init(a *app.App) error {
+	s.objectStore = app.MustComponent[objectstore.ObjectStore](a)
+	s.notifications = app.MustComponent[notifications.Notifications](a)
+	s.accountService = app.MustComponent[account.Service](a)
+	s.objectGetter = app.MustComponent[cache.ObjectGetter](a)
+	s.objectCreator = app.MustComponent[objectcreator.Service](a)
+	s.spaceService = app.MustComponent[space.Service](a)
+	db, err := app.MustComponent[datastore.Datastore](a).LocalStorage()
+	if err != nil {
+		return fmt.Errorf("get local storage: %w", err)
+	}
+	s.store = &reminderStore{db: db}
+	return nil
+}
+
+func (s *service) Name() string {
+	return CName
+}
+
+func (s *service) Run(context.Context) error {
+	s.ctx, s.ctxCancel = context.WithCancel(context.Background())
+	go s.run()
+	return nil
+}
+
+func (s *service) Close(context.Context) error {
+	if s.ctxCancel != nil {
+		s.ctxCancel()
+	}
+	return nil
+}
+
+func (s *service) run() {
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+	for {
+		s.check()
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check sends the reminders that have come since the last check, including the time when the app was closed
+func (s *service) check() {
+	now := s.now()
+	lastCheck, err := s.store.getLastCheckTime()
+	if badgerhelper.IsNotFound(err) {
+		// don't send reminders for the whole history on the first run
+		lastCheck = now
+	} else if err != nil {
+		log.Errorf("get last check time: %v", err)
+		return
+	}
+
+	spaceIds, err := s.objectStore.ListAvailableSpaceIds(s.accountService.AccountID())
+	if err != nil {
+		log.Errorf("list spaces: %v", err)
+		return
+	}
+	for _, spaceId := range spaceIds {
+		if s.ctx.Err() != nil {
+			return
+		}
+		store := s.objectStore.SpaceIndex(spaceId)
+		if err = s.sendReminders(store, lastCheck, now); err != nil {
+			log.With("spaceId", spaceId).Errorf("send reminders: %v", err)
+		}
+		if err = s.createNextOccurrences(spaceId, store); err != nil {
+			log.With("spaceId", spaceId).Errorf("create next occurrences: %v", err)
+		}
+	}
+
+	if err = s.store.setLastCheckTime(now); err != nil {
+		log.Errorf("save last check time: %v", err)
+	}
+}
+
+// reminderRelation is the date relation flagged with relationReminder
+type reminderRelation struct {
+	key         domain.RelationKey
+	includeTime bool
+}
+
+func (s *service) listReminderRelations(store spaceindex.Store) ([]reminderRelation, error) {
+	records, err := store.Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_relation),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationFormat,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.RelationFormat_date),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationReminder,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Bool(true),
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	relations := make([]reminderRelation, 0, len(records))
+	for _, rec := range records {
+		relations = append(relations, reminderRelation{
+			key:         domain.RelationKey(rec.Details.GetString(bundle.RelationKeyRelationKey)),
+			includeTime: rec.Details.GetBool(bundle.RelationKeyRelationFormatIncludeTime),
+		})
+	}
+	return relations, nil
+}
+
+func (s *service) sendReminders(store spaceindex.Store, from, to time.Time) error {
+	relations, err := s.listReminderRelations(store)
+	if err != nil {
+		return fmt.Errorf("list reminder relations: %w", err)
+	}
+	for _, rel := range relations {
+		key := rel.key
+		records, err := store.Query(database.Query{
+			Filters: []database.FilterRequest{
+				{
+					RelationKey: key,
+					Condition:   model.BlockContentDataviewFilter_Greater,
+					Value:       domain.Int64(from.Add(-dateOnlyShift).Unix()),
+				},
+				{
+					RelationKey: key,
+					Condition:   model.BlockContentDataviewFilter_LessOrEqual,
+					Value:       domain.Int64(to.Add(dateOnlyShift).Unix()),
+				},
+				{
+					RelationKey: bundle.RelationKeyDone,
+					Condition:   model.BlockContentDataviewFilter_NotEqual,
+					Value:       domain.Bool(true),
+				},
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("query objects by %s: %w", key, err)
+		}
+		for _, rec := range records {
+			date := rec.Details.GetInt64(key)
+			remindAt := reminderTime(date, rel.includeTime, s.location)
+			if !remindAt.After(from) || remindAt.After(to) {
+				continue
+			}
+			if err = s.notifications.CreateAndSend(s.newNotification(store.SpaceId(), rec.Details, key, date)); err != nil {
+				log.Errorf("send reminder: %v", err)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *service) newNotification(spaceId string, details *domain.Details, key domain.RelationKey, date int64) *model.Notification {
+	objectId := details.GetString(bundle.RelationKeyId)
+	return &model.Notification{
+		// the same id on every device of the account, so the reminder is sent only once
+		Id:    fmt.Sprintf("reminder-%s-%s-%d", objectId, key, date),
+		Space: spaceId,
+		Payload: &model.NotificationPayloadOfReminder{Reminder: &model.NotificationReminder{
+			SpaceId:     spaceId,
+			ObjectId:    objectId,
+			ObjectName:  details.GetString(bundle.RelationKeyName),
+			RelationKey: key.String(),
+			Date:        date,
+			SpaceName:   s.objectStore.GetSpaceName(spaceId),
+		}},
+	}
+}
+
+// reminderTime returns the moment to remind about the date. Dates of the relations without time
+// are reminded in the morning of the local day
+func reminderTime(date int64, includeTime bool, loc *time.Location) time.Time {
+	if includeTime {
+		return time.Unix(date, 0).In(loc)
+	}
+	y, m, d := dateutil.DateOnly(date, loc).Date()
+	return time.Date(y, m, d, dateOnlyRemindHour, 0, 0, 0, loc)
+}
+
+// createNextOccurrences creates the next occurrences of the done objects with recurrence rules. The rule is removed
+// from the done object, so the occurrence is created only once. Every device and every member of the space
+// may do it, so the id of the occurrence is derived from the done object and the date of the occurrence
+func (s *service) createNextOccurrences(spaceId string, store spaceindex.Store) error {
+	records, err := store.Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyRecurrence,
+				Condition:   model.BlockContentDataviewFilter_NotEmpty,
+			},
+			{
+				RelationKey: bundle.RelationKeyDone,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Bool(true),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if len(records) == 0 {
+		return nil
+	}
+	spc, err := s.spaceService.Get(s.ctx, spaceId)
+	if err != nil {
+		return fmt.Errorf("get space: %w", err)
+	}
+	if spc.IsReadOnly() {
+		return nil
+	}
+	relations, err := s.listReminderRelations(store)
+	if err != nil {
+		return fmt.Errorf("list reminder relations: %w", err)
+	}
+	dateRelations := []reminderRelation{{
+		key:         bundle.RelationKeyDueDate,
+		includeTime: relationIncludeTime(store, bundle.RelationKeyDueDate),
+	}}
+	for _, rel := range relations {
+		if rel.key != bundle.RelationKeyDueDate {
+			dateRelations = append(dateRelations, rel)
+		}
+	}
+	for _, rec := range records {
+		id := rec.Details.GetString(bundle.RelationKeyId)
+		if err = s.createNextOccurrence(spc, id, rec.Details, dateRelations); err != nil {
+			log.With("objectId", id).Errorf("create next occurrence: %v", err)
+		}
+	}
+	return nil
+}
+
+func (s *service) createNextOccurrence(spc clientspace.Space, id string, details *domain.Details, dateRelations []reminderRelation) error {
+	dates, nextRule, ok, err := nextOccurrenceDates(details, dateRelations, s.now().In(s.location))
+	if err != nil {
+		// keep the rule, so the user can fix it
+		log.With("objectId", id).Debugf("invalid recurrence rule: %v", err)
+		return nil
+	}
+	if ok {
+		if err = s.createOccurrence(spc, id, dates, nextRule); err != nil {
+			return err
+		}
+	}
+	return cache.Do(s.objectGetter, id, func(b basic.DetailsSettable) error {
+		return b.SetDetails(nil, []domain.Detail{{Key: bundle.RelationKeyRecurrence, Value: domain.String("")}}, false)
+	})
+}
+
+// createOccurrence creates the copy of the object with the given dates. The occurrence which already exists,
+// e.g. created by another device before the rule of the done object has been removed, is not created again
+func (s *service) createOccurrence(spc clientspace.Space, id string, dates map[domain.RelationKey]int64, rule *recurrenceRule) error {
+	occurrenceKey := fmt.Sprintf("occurrence-%s-%d", id, occurrenceDate(dates))
+	uk, err := domain.NewUniqueKey(coresb.SmartBlockTypePage, occurrenceKey)
+	if err != nil {
+		return err
+	}
+	var (
+		st             *state.State
+		objectTypeKeys []domain.TypeKey
+	)
+	err = cache.Do(s.objectGetter, id, func(sb smartblock.SmartBlock) error {
+		objectTypeKeys = sb.ObjectTypeKeys()
+		st = newOccurrenceState(sb.NewState(), uk)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for key, date := range dates {
+		st.SetDetailAndBundledRelation(key, domain.Int64(date))
+	}
+	st.SetDetailAndBundledRelation(bundle.RelationKeyDone, domain.Bool(false))
+	st.SetDetailAndBundledRelation(bundle.RelationKeyRecurrence, domain.String(rule.String()))
+	st.SetDetail(bundle.RelationKeySourceObject, domain.String(id))
+
+	_, _, err = s.objectCreator.CreateSmartBlockFromStateInSpace(s.ctx, spc, objectTypeKeys, st)
+	if errors.Is(err, treestorage.ErrTreeExists) {
+		return nil
+	}
+	return err
+}
+
+// occurrenceDate returns the date which identifies the occurrence: the due date or, if there is no due date, the earliest date
+func occurrenceDate(dates map[domain.RelationKey]int64) int64 {
+	if date, ok := dates[bundle.RelationKeyDueDate]; ok {
+		return date
+	}
+	var earliest int64
+	for _, date := range dates {
+		if earliest == 0 || date < earliest {
+			earliest = date
+		}
+	}
+	return earliest
+}
+
+// newOccurrenceState returns the new state with the details and the blocks of the object. Blocks get new ids,
+// derived from the unique key of the occurrence, so the same occurrence created on several devices has the same blocks.
+// Blocks of the header keep their ids, because they are identified by ids
+func newOccurrenceState(src *state.State, uk domain.UniqueKey) *state.State {
+	st := state.NewDocWithUniqueKey(src.RootId(), nil, uk).(*state.State)
+	st.SetDetails(src.Details().Copy())
+	st.SetObjectTypeKeys(src.ObjectTypeKeys())
+
+	keepIds := map[string]struct{}{src.RootId(): {}, template.HeaderLayoutId: {}}
+	for _, b := range src.Descendants(template.HeaderLayoutId) {
+		keepIds[b.Model().Id] = struct{}{}
+	}
+	newId := func(id string) string {
+		if _, ok := keepIds[id]; ok {
+			return id
+		}
+		// table cells are identified by ids of their rows and columns
+		parts := strings.Split(id, table.TableCellSeparator)
+		for i, part := range parts {
+			hash := sha256.Sum256([]byte(uk.Marshal() + part))
+			parts[i] = hex.EncodeToString(hash[:12])
+		}
+		return strings.Join(parts, table.TableCellSeparator)
+	}
+	_ = src.Iterate(func(b simple.Block) (isContinue bool) {
+		m := b.Copy().Model()
+		m.Id = newId(m.Id)
+		for i, childId := range m.ChildrenIds {
+			m.ChildrenIds[i] = newId(childId)
+		}
+		st.Add(simple.New(m))
+		return true
+	})
+	return st
+}
+
+// nextOccurrenceDates calculates the dates of the next occurrence. The first date of dateRelations, which start
+// with the due date, is moved to the next date of the recurrence rule, and other dates are moved by the same number of days.
+// The object without dates gets the due date of the next occurrence after now
+func nextOccurrenceDates(details *domain.Details, dateRelations []reminderRelation, now time.Time) (dates map[domain.RelationKey]int64, nextRule *recurrenceRule, ok bool, err error) {
+	rule, err := parseRecurrenceRule(details.GetString(bundle.RelationKeyRecurrence))
+	if err != nil {
+		return nil, nil, false, err
+	}
+	nextRule, ok = rule.nextRule()
+	if !ok {
+		return nil, nil, false, nil
+	}
+
+	dates = make(map[domain.RelationKey]int64)
+	includeTime := make(map[domain.RelationKey]bool)
+	var primary domain.RelationKey
+	for _, rel := range dateRelations {
+		if date, has := details.TryInt64(rel.key); has && date != 0 {
+			dates[rel.key] = date
+			includeTime[rel.key] = rel.includeTime
+			if primary == "" {
+				primary = rel.key
+			}
+		}
+	}
+	if primary == "" {
+		next, ok := rule.next(now)
+		if !ok {
+			return nil, nil, false, nil
+		}
+		return map[domain.RelationKey]int64{bundle.RelationKeyDueDate: next.Unix()}, nextRule, true, nil
+	}
+
+	base := dateInLocation(dates[primary], includeTime[primary], now.Location())
+	next, ok := rule.next(base)
+	if !ok {
+		return nil, nil, false, nil
+	}
+	days := daysBetween(base, next)
+	for key, date := range dates {
+		dates[key] = dateInLocation(date, includeTime[key], now.Location()).AddDate(0, 0, days).Unix()
+	}
+	return dates, nextRule, true, nil
+}
+
+// dateInLocation returns the date with time in the given location, so weekdays of the recurrence rule are local.
+// The date without time is returned in UTC if it's stored as midnight in UTC, so it stays the same day after the shift
+func dateInLocation(date int64, includeTime bool, loc *time.Location) time.Time {
+	t := time.Unix(date, 0).UTC()
+	if !includeTime && t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 {
+		return t
+	}
+	return t.In(loc)
+}
+
+// relationIncludeTime reports whether values of the date relation have time
+func relationIncludeTime(store spaceindex.Store, key domain.RelationKey) bool {
+	records, err := store.Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(model.ObjectType_relation),
+			},
+			{
+				RelationKey: bundle.RelationKeyRelationKey,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.String(key),
+			},
+		},
+	})
+	if err != nil || len(records) == 0 {
+		return false
+	}
+	return records[0].Details.GetBool(bundle.RelationKeyRelationFormatIncludeTime)
+}
+
+func daysBetween(from, to time.Time) int {
+	fy, fm, fd := from.Date()
+	ty, tm, td := to.Date()
+	return int(time.Date(ty, tm, td, 0, 0, 0, 0, time.UTC).Sub(time.Date(fy, fm, fd, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+}
+
+const lastCheckKey = "/reminders/lastCheck"
+
+type reminderStore struct {
+	db *badger.DB
+}
+
+func (s *reminderStore) getLastCheckTime() (time.Time, error) {
+	seconds, err := badgerhelper.GetValue(s.db, []byte(lastCheckKey), badgerhelper.UnmarshalInt)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(int64(seconds), 0), nil
+}
+
+func (s *reminderStore) setLastCheckTime(t time.Time) error {
+	return badgerhelper.SetValue(s.db, []byte(lastCheckKey), int(t.Unix()))
+}
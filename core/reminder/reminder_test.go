@@ -0,0 +1,165 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/block/editor/state"
+	"github.com/anyproto/anytype-heart/core/block/editor/template"
+	"github.com/anyproto/anytype-heart/core/block/simple"
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	coresb "github.com/anyproto/anytype-heart/pkg/lib/core/smartblock"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestReminderTime(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+
+	t.Run("date with time", func(t *testing.T) {
+		date := time.Date(2024, 5, 1, 15, 45, 0, 0, loc)
+		assert.True(t, date.Equal(reminderTime(date.Unix(), true, loc)))
+	})
+
+	t.Run("date with time at midnight in UTC", func(t *testing.T) {
+		date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		assert.True(t, date.Equal(reminderTime(date.Unix(), true, loc)))
+	})
+
+	t.Run("date without time in UTC", func(t *testing.T) {
+		date := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2024, 5, 1, dateOnlyRemindHour, 0, 0, 0, loc), reminderTime(date.Unix(), false, loc))
+	})
+
+	t.Run("date without time in local time", func(t *testing.T) {
+		date := time.Date(2024, 5, 1, 0, 0, 0, 0, loc)
+		assert.Equal(t, time.Date(2024, 5, 1, dateOnlyRemindHour, 0, 0, 0, loc), reminderTime(date.Unix(), false, loc))
+	})
+}
+
+func TestNextOccurrenceDates(t *testing.T) {
+	loc := time.FixedZone("UTC+3", 3*60*60)
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, loc)
+	reminderKey := domain.RelationKey("startDate")
+	dateRelations := []reminderRelation{{key: bundle.RelationKeyDueDate}, {key: reminderKey, includeTime: true}}
+
+	t.Run("dates are moved by the rule of the due date", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyRecurrence: domain.String("FREQ=WEEKLY;COUNT=3"),
+			bundle.RelationKeyDueDate:    domain.Int64(time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC).Unix()),
+			reminderKey:                  domain.Int64(time.Date(2024, 5, 1, 18, 0, 0, 0, loc).Unix()),
+		})
+
+		dates, rule, ok, err := nextOccurrenceDates(details, dateRelations, now)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "FREQ=WEEKLY;COUNT=2", rule.String())
+		assert.Equal(t, map[domain.RelationKey]int64{
+			bundle.RelationKeyDueDate: time.Date(2024, 5, 10, 0, 0, 0, 0, time.UTC).Unix(),
+			reminderKey:               time.Date(2024, 5, 8, 18, 0, 0, 0, loc).Unix(),
+		}, dates)
+	})
+
+	t.Run("weekdays of dates with time are local", func(t *testing.T) {
+		loc := time.FixedZone("UTC-5", -5*60*60)
+		// Friday in UTC, but Thursday in the local time
+		date := time.Date(2024, 5, 3, 0, 0, 0, 0, time.UTC)
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyRecurrence: domain.String("FREQ=WEEKLY;BYDAY=TH,FR;COUNT=3"),
+			reminderKey:                  domain.Int64(date.Unix()),
+		})
+
+		dates, _, ok, err := nextOccurrenceDates(details, dateRelations, now.In(loc))
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, map[domain.RelationKey]int64{
+			reminderKey: time.Date(2024, 5, 3, 19, 0, 0, 0, loc).Unix(),
+		}, dates)
+	})
+
+	t.Run("object without dates gets the due date", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyRecurrence: domain.String("FREQ=DAILY"),
+		})
+
+		dates, _, ok, err := nextOccurrenceDates(details, dateRelations, now)
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, map[domain.RelationKey]int64{
+			bundle.RelationKeyDueDate: now.AddDate(0, 0, 1).Unix(),
+		}, dates)
+	})
+
+	t.Run("last occurrence", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyRecurrence: domain.String("FREQ=DAILY;COUNT=1"),
+		})
+
+		_, _, ok, err := nextOccurrenceDates(details, dateRelations, now)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid rule", func(t *testing.T) {
+		details := domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyRecurrence: domain.String("every day"),
+		})
+
+		_, _, _, err := nextOccurrenceDates(details, dateRelations, now)
+		assert.ErrorIs(t, err, errUnsupportedRule)
+	})
+}
+
+func TestOccurrenceDate(t *testing.T) {
+	t.Run("due date", func(t *testing.T) {
+		assert.Equal(t, int64(20), occurrenceDate(map[domain.RelationKey]int64{bundle.RelationKeyDueDate: 20, "startDate": 10}))
+	})
+	t.Run("earliest date without due date", func(t *testing.T) {
+		assert.Equal(t, int64(10), occurrenceDate(map[domain.RelationKey]int64{"endDate": 20, "startDate": 10}))
+	})
+}
+
+func TestNewOccurrenceState(t *testing.T) {
+	src := state.NewDoc("root", nil).(*state.State)
+	for _, b := range []*model.Block{
+		{Id: "root", ChildrenIds: []string{template.HeaderLayoutId, "text"}},
+		{Id: template.HeaderLayoutId, ChildrenIds: []string{template.TitleBlockId}},
+		{Id: template.TitleBlockId, Content: &model.BlockContentOfText{Text: &model.BlockContentText{}}},
+		{Id: "text", ChildrenIds: []string{"row-col"}, Content: &model.BlockContentOfText{Text: &model.BlockContentText{Text: "text"}}},
+		{Id: "row-col", Content: &model.BlockContentOfText{Text: &model.BlockContentText{}}},
+	} {
+		src.Add(simple.New(b))
+	}
+	src.SetDetail(bundle.RelationKeyName, domain.String("task"))
+	src.SetLocalDetail(bundle.RelationKeyLastOpenedDate, domain.Int64(10))
+	uk, err := domain.NewUniqueKey(coresb.SmartBlockTypePage, "occurrence-task-10")
+	require.NoError(t, err)
+
+	st := newOccurrenceState(src, uk)
+
+	assert.Equal(t, uk.InternalKey(), st.UniqueKeyInternal())
+	assert.Equal(t, "task", st.Details().GetString(bundle.RelationKeyName))
+	assert.False(t, st.LocalDetails().Has(bundle.RelationKeyLastOpenedDate))
+
+	root := st.Pick("root")
+	require.NotNil(t, root)
+	require.Len(t, root.Model().ChildrenIds, 2)
+	assert.Equal(t, template.HeaderLayoutId, root.Model().ChildrenIds[0])
+	assert.NotNil(t, st.Pick(template.TitleBlockId))
+
+	textId := root.Model().ChildrenIds[1]
+	assert.NotEqual(t, "text", textId)
+	text := st.Pick(textId)
+	require.NotNil(t, text)
+	assert.Equal(t, "text", text.Model().GetText().Text)
+	require.Len(t, text.Model().ChildrenIds, 1)
+	assert.Contains(t, text.Model().ChildrenIds[0], "-")
+	assert.NotNil(t, st.Pick(text.Model().ChildrenIds[0]))
+	assert.Nil(t, st.Pick("text"))
+
+	// the same occurrence gets the same ids of blocks
+	assert.Equal(t, textId, newOccurrenceState(src, uk).Pick("root").Model().ChildrenIds[1])
+}
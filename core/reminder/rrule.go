@@ -0,0 +1,314 @@
+package reminder
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxRecurrencePeriods = 1000
+
+var errUnsupportedRule = errors.New("unsupported recurrence rule")
+
+type frequency string
+
+const (
+	frequencyDaily   frequency = "DAILY"
+	frequencyWeekly  frequency = "WEEKLY"
+	frequencyMonthly frequency = "MONTHLY"
+	frequencyYearly  frequency = "YEARLY"
+)
+
+var weekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// weekdayNum is the BYDAY value, n is the ordinal of the weekday in the month, e.g. -1FR is the last Friday.
+// n is 0 for every weekday of the period
+type weekdayNum struct {
+	weekday time.Weekday
+	n       int
+}
+
+// recurrenceRule is the subset of RFC 5545 RRULE: FREQ, INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL
+type recurrenceRule struct {
+	freq       frequency
+	interval   int
+	byDay      []weekdayNum
+	byMonthDay []int
+	count      int
+	until      time.Time
+}
+
+func parseRecurrenceRule(rule string) (*recurrenceRule, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	r := &recurrenceRule{interval: 1}
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid part %q", errUnsupportedRule, part)
+		}
+		var err error
+		switch strings.ToUpper(name) {
+		case "FREQ":
+			r.freq = frequency(strings.ToUpper(value))
+			if !slices.Contains([]frequency{frequencyDaily, frequencyWeekly, frequencyMonthly, frequencyYearly}, r.freq) {
+				return nil, fmt.Errorf("%w: frequency %s", errUnsupportedRule, value)
+			}
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(value)
+			if err == nil && r.interval < 1 {
+				err = fmt.Errorf("interval must be positive")
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(value)
+			if err == nil && r.count < 1 {
+				err = fmt.Errorf("count must be positive")
+			}
+		case "UNTIL":
+			r.until, err = parseRuleDate(value)
+		case "BYDAY":
+			r.byDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = parseByMonthDay(value)
+		case "WKST":
+			// weeks always start on Monday
+		default:
+			return nil, fmt.Errorf("%w: part %s", errUnsupportedRule, name)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", errUnsupportedRule, name, err)
+		}
+	}
+	if r.freq == "" {
+		return nil, fmt.Errorf("%w: frequency is not set", errUnsupportedRule)
+	}
+	return r, nil
+}
+
+func parseRuleDate(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			if strings.HasSuffix(layout, "Z") {
+				return time.ParseInLocation(layout, value, time.UTC)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q", value)
+}
+
+func parseByDay(value string) ([]weekdayNum, error) {
+	var days []weekdayNum
+	for _, day := range strings.Split(strings.ToUpper(value), ",") {
+		if len(day) < 2 {
+			return nil, fmt.Errorf("invalid weekday %q", day)
+		}
+		weekday, ok := weekdays[day[len(day)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("invalid weekday %q", day)
+		}
+		wd := weekdayNum{weekday: weekday}
+		if ordinal := day[:len(day)-2]; ordinal != "" {
+			n, err := strconv.Atoi(ordinal)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid weekday %q", day)
+			}
+			wd.n = n
+		}
+		days = append(days, wd)
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, day := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(day)
+		if err != nil || n == 0 || n < -31 || n > 31 {
+			return nil, fmt.Errorf("invalid month day %q", day)
+		}
+		days = append(days, n)
+	}
+	return days, nil
+}
+
+// String formats the rule back to the RRULE format
+func (r *recurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.freq)}
+	if r.interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.interval))
+	}
+	if len(r.byDay) > 0 {
+		days := make([]string, 0, len(r.byDay))
+		for _, wd := range r.byDay {
+			day := strings.ToUpper(wd.weekday.String()[:2])
+			if wd.n != 0 {
+				day = strconv.Itoa(wd.n) + day
+			}
+			days = append(days, day)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.byMonthDay) > 0 {
+		days := make([]string, 0, len(r.byMonthDay))
+		for _, day := range r.byMonthDay {
+			days = append(days, strconv.Itoa(day))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.count))
+	}
+	if !r.until.IsZero() {
+		parts = append(parts, "UNTIL="+r.until.UTC().Format("20060102T150405Z"))
+	}
+	return strings.Join(parts, ";")
+}
+
+// nextRule returns the rule for the next occurrence, with the count decreased by the current occurrence.
+// It returns false if the current occurrence is the last one
+func (r *recurrenceRule) nextRule() (*recurrenceRule, bool) {
+	if r.count == 0 {
+		return r, true
+	}
+	if r.count == 1 {
+		return nil, false
+	}
+	next := *r
+	next.count--
+	return &next, true
+}
+
+// next returns the first occurrence after the given one. The time of the day and the location of the given
+// occurrence are kept
+func (r *recurrenceRule) next(after time.Time) (time.Time, bool) {
+	for period := 0; period < maxRecurrencePeriods; period++ {
+		candidates := r.periodCandidates(after, period*r.interval)
+		for _, candidate := range candidates {
+			if !candidate.After(after) {
+				continue
+			}
+			if !r.until.IsZero() && candidate.After(r.until) {
+				return time.Time{}, false
+			}
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// periodCandidates returns the sorted occurrences of the period with the given offset from the period of the start
+func (r *recurrenceRule) periodCandidates(start time.Time, offset int) []time.Time {
+	y, m, d := start.Date()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), 0, start.Location())
+	}
+	var candidates []time.Time
+	switch r.freq {
+	case frequencyDaily:
+		day := at(y, m, d+offset)
+		if r.matchesWeekday(day) && r.matchesMonthDay(day) {
+			candidates = append(candidates, day)
+		}
+	case frequencyWeekly:
+		// weeks start on Monday
+		weekStart := d - (int(start.Weekday())+6)%7 + offset*7
+		for i := 0; i < 7; i++ {
+			day := at(y, m, weekStart+i)
+			if len(r.byDay) == 0 && day.Weekday() != start.Weekday() {
+				continue
+			}
+			if r.matchesWeekday(day) {
+				candidates = append(candidates, day)
+			}
+		}
+	case frequencyMonthly:
+		monthStart := at(y, m+time.Month(offset), 1)
+		candidates = r.monthCandidates(monthStart, d)
+	case frequencyYearly:
+		if len(r.byDay) > 0 || len(r.byMonthDay) > 0 {
+			candidates = r.monthCandidates(at(y+offset, m, 1), d)
+		} else if day := at(y+offset, m, d); day.Day() == d {
+			candidates = append(candidates, day)
+		}
+	}
+	return candidates
+}
+
+// monthCandidates returns the days of the month matching the rule, or the day of the start if the rule
+// doesn't specify the days. Months without such day are skipped, as RFC 5545 requires
+func (r *recurrenceRule) monthCandidates(monthStart time.Time, startDay int) []time.Time {
+	daysInMonth := monthStart.AddDate(0, 1, -1).Day()
+	var candidates []time.Time
+	for day := 1; day <= daysInMonth; day++ {
+		date := monthStart.AddDate(0, 0, day-1)
+		switch {
+		case len(r.byDay) == 0 && len(r.byMonthDay) == 0:
+			if day != startDay {
+				continue
+			}
+		case !r.matchesMonthDay(date) || !r.matchesWeekdayInMonth(date, daysInMonth):
+			continue
+		}
+		candidates = append(candidates, date)
+	}
+	return candidates
+}
+
+func (r *recurrenceRule) matchesWeekday(date time.Time) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, wd := range r.byDay {
+		if wd.weekday == date.Weekday() {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *recurrenceRule) matchesWeekdayInMonth(date time.Time, daysInMonth int) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, wd := range r.byDay {
+		if wd.weekday != date.Weekday() {
+			continue
+		}
+		switch {
+		case wd.n == 0:
+			return true
+		case wd.n > 0 && (date.Day()-1)/7+1 == wd.n:
+			return true
+		case wd.n < 0 && (daysInMonth-date.Day())/7+1 == -wd.n:
+			return true
+		}
+	}
+	return false
+}
+
+func (r *recurrenceRule) matchesMonthDay(date time.Time) bool {
+	if len(r.byMonthDay) == 0 {
+		return true
+	}
+	daysInMonth := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location()).Day()
+	for _, day := range r.byMonthDay {
+		if day == date.Day() || (day < 0 && daysInMonth+day+1 == date.Day()) {
+			return true
+		}
+	}
+	return false
+}
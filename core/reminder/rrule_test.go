@@ -0,0 +1,94 @@
+package reminder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRecurrenceRule(t *testing.T) {
+	t.Run("format back", func(t *testing.T) {
+		rule, err := parseRecurrenceRule("RRULE:FREQ=monthly;INTERVAL=2;BYDAY=-1FR,MO;COUNT=3;WKST=MO")
+		require.NoError(t, err)
+		assert.Equal(t, "FREQ=MONTHLY;INTERVAL=2;BYDAY=-1FR,MO;COUNT=3", rule.String())
+	})
+
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=WEEKLY;BYDAY=XX",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=YEARLY;BYSETPOS=1",
+		"FREQ=DAILY;UNTIL=tomorrow",
+	} {
+		t.Run("invalid "+rule, func(t *testing.T) {
+			_, err := parseRecurrenceRule(rule)
+			assert.ErrorIs(t, err, errUnsupportedRule)
+		})
+	}
+}
+
+func TestRecurrenceRule_Next(t *testing.T) {
+	// Friday
+	start := time.Date(2024, 1, 5, 10, 30, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		rule     string
+		start    time.Time
+		expected time.Time
+	}{
+		{"FREQ=DAILY", start, time.Date(2024, 1, 6, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=DAILY;INTERVAL=3", start, time.Date(2024, 1, 8, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR", start, time.Date(2024, 1, 8, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY", start, time.Date(2024, 1, 12, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY;BYDAY=MO,SA", start, time.Date(2024, 1, 6, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=WEEKLY;INTERVAL=2;BYDAY=TU", start, time.Date(2024, 1, 16, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY", start, time.Date(2024, 2, 5, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY", time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1", start, time.Date(2024, 1, 31, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY;BYDAY=-1FR", start, time.Date(2024, 1, 26, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=MONTHLY;BYDAY=1MO", start, time.Date(2024, 2, 5, 10, 30, 0, 0, time.UTC)},
+		{"FREQ=YEARLY", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+	} {
+		t.Run(tc.rule, func(t *testing.T) {
+			rule, err := parseRecurrenceRule(tc.rule)
+			require.NoError(t, err)
+			next, ok := rule.next(tc.start)
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, next)
+		})
+	}
+
+	t.Run("until", func(t *testing.T) {
+		rule, err := parseRecurrenceRule("FREQ=WEEKLY;UNTIL=20240110T000000Z")
+		require.NoError(t, err)
+		_, ok := rule.next(start)
+		assert.False(t, ok)
+	})
+
+	t.Run("keeps local time over DST", func(t *testing.T) {
+		loc, err := time.LoadLocation("Europe/Berlin")
+		require.NoError(t, err)
+		rule, err := parseRecurrenceRule("FREQ=DAILY")
+		require.NoError(t, err)
+		next, ok := rule.next(time.Date(2024, 3, 30, 9, 0, 0, 0, loc))
+		require.True(t, ok)
+		assert.Equal(t, time.Date(2024, 3, 31, 9, 0, 0, 0, loc), next)
+	})
+}
+
+func TestRecurrenceRule_NextRule(t *testing.T) {
+	rule, err := parseRecurrenceRule("FREQ=DAILY;COUNT=2")
+	require.NoError(t, err)
+
+	next, ok := rule.nextRule()
+	require.True(t, ok)
+	assert.Equal(t, "FREQ=DAILY;COUNT=1", next.String())
+
+	_, ok = next.nextRule()
+	assert.False(t, ok)
+}
@@ -35,6 +35,11 @@ type opCounter struct {
 	total     int
 	prevCount int
 	nextCount int
+	formulas  []*pb.EventObjectSubscriptionFormula
+}
+
+func (c opCounter) equalCounts(other opCounter) bool {
+	return c.subId == other.subId && c.total == other.total && c.prevCount == other.prevCount && c.nextCount == other.nextCount
 }
 
 type opGroup struct {
@@ -127,6 +132,7 @@ func (ctx *opCtx) apply() {
 				NextCount: int64(count.nextCount),
 				PrevCount: int64(count.prevCount),
 				SubId:     count.subId,
+				Formulas:  count.formulas,
 			},
 		},
 		))
@@ -0,0 +1,279 @@
+package subscription
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/kanban"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// allRecordsGroupId is used for the results calculated over all records of subscription
+const allRecordsGroupId = ""
+
+// formulaCalc calculates dataview formulas over the records of subscription and, optionally, over every kanban group.
+// Only the groups affected by changes are recalculated, each in full; the results over all records are affected by every change
+type formulaCalc struct {
+	formulas     []*model.BlockContentDataviewRelation
+	groupKey     domain.RelationKey
+	groupFormat  model.RelationFormat
+	optionExists func(id string) bool
+
+	records  map[string]*domain.Details
+	groupIds map[string]string
+	groups   map[string]map[string]struct{}
+
+	dirty   map[string]struct{}
+	results map[string][]domain.Value
+}
+
+func (s *spaceSubscriptions) makeFormulaCalc(relations []*model.BlockContentDataviewRelation, groupRelationKey string) (*formulaCalc, error) {
+	var formulas []*model.BlockContentDataviewRelation
+	for _, rel := range relations {
+		if rel.Formula != model.BlockContentDataviewRelation_None {
+			formulas = append(formulas, rel)
+		}
+	}
+	if len(formulas) == 0 {
+		return nil, nil
+	}
+	var groupFormat model.RelationFormat
+	if groupRelationKey != "" {
+		rel, err := s.objectStore.FetchRelationByKey(groupRelationKey)
+		if err != nil {
+			return nil, fmt.Errorf("fetch group relation: %w", err)
+		}
+		switch rel.Format {
+		case model.RelationFormat_tag, model.RelationFormat_status, model.RelationFormat_checkbox:
+		default:
+			return nil, fmt.Errorf("unsupported group relation format: %s", rel.Format)
+		}
+		groupFormat = rel.Format
+	}
+	c := newFormulaCalc(formulas, domain.RelationKey(groupRelationKey), groupFormat)
+	if groupFormat == model.RelationFormat_tag {
+		c.optionExists = func(id string) bool {
+			return s.tagOptionExists(groupRelationKey, id)
+		}
+	}
+	return c, nil
+}
+
+// tagOptionExists reports whether the option of the tag relation is not removed, so objects are grouped the same way as in kanban.GroupTag
+func (s *spaceSubscriptions) tagOptionExists(relationKey string, id string) bool {
+	details, err := s.objectStore.GetDetails(id)
+	if err != nil {
+		log.Errorf("formula: get tag option details: %v", err)
+		return false
+	}
+	return details.GetString(bundle.RelationKeyRelationKey) == relationKey &&
+		!details.GetBool(bundle.RelationKeyIsArchived) &&
+		!details.GetBool(bundle.RelationKeyIsDeleted)
+}
+
+func newFormulaCalc(formulas []*model.BlockContentDataviewRelation, groupKey domain.RelationKey, groupFormat model.RelationFormat) *formulaCalc {
+	return &formulaCalc{
+		formulas:    formulas,
+		groupKey:    groupKey,
+		groupFormat: groupFormat,
+		records:     map[string]*domain.Details{},
+		groupIds:    map[string]string{},
+		groups:      map[string]map[string]struct{}{},
+		dirty:       map[string]struct{}{allRecordsGroupId: {}},
+		results:     map[string][]domain.Value{},
+	}
+}
+
+func (c *formulaCalc) set(e *entry) {
+	c.remove(e.id)
+	c.records[e.id] = e.data
+	c.dirty[allRecordsGroupId] = struct{}{}
+	if c.groupKey == "" {
+		return
+	}
+	groupId := kanban.GroupId(c.groupFormat, e.data.Get(c.groupKey), c.optionExists)
+	c.groupIds[e.id] = groupId
+	members, ok := c.groups[groupId]
+	if !ok {
+		members = map[string]struct{}{}
+		c.groups[groupId] = members
+	}
+	members[e.id] = struct{}{}
+	c.dirty[groupId] = struct{}{}
+}
+
+func (c *formulaCalc) remove(id string) {
+	if _, ok := c.records[id]; !ok {
+		return
+	}
+	delete(c.records, id)
+	c.dirty[allRecordsGroupId] = struct{}{}
+	if groupId, ok := c.groupIds[id]; ok {
+		delete(c.groupIds, id)
+		delete(c.groups[groupId], id)
+		c.dirty[groupId] = struct{}{}
+	}
+}
+
+// calculate recalculates results of the groups affected by changes since the previous call and reports whether any result has changed
+func (c *formulaCalc) calculate() (changed bool) {
+	for groupId := range c.dirty {
+		delete(c.dirty, groupId)
+		records := make([]*domain.Details, 0, len(c.records))
+		if groupId == allRecordsGroupId {
+			for _, details := range c.records {
+				records = append(records, details)
+			}
+		} else {
+			members := c.groups[groupId]
+			if len(members) == 0 {
+				if _, ok := c.results[groupId]; ok {
+					changed = true
+				}
+				delete(c.groups, groupId)
+				delete(c.results, groupId)
+				continue
+			}
+			for id := range members {
+				records = append(records, c.records[id])
+			}
+		}
+
+		values := make([]domain.Value, len(c.formulas))
+		for i, rel := range c.formulas {
+			values[i] = calculateFormula(rel.Formula, domain.RelationKey(rel.Key), records)
+		}
+		if prev, ok := c.results[groupId]; !ok || !formulaValuesEqual(prev, values) {
+			changed = true
+		}
+		c.results[groupId] = values
+	}
+	return changed
+}
+
+// proto returns current results: the ones calculated over all records go first, then the results of groups ordered by group id
+func (c *formulaCalc) proto() []*pb.EventObjectSubscriptionFormula {
+	groupIds := make([]string, 0, len(c.results))
+	for groupId := range c.results {
+		groupIds = append(groupIds, groupId)
+	}
+	sort.Strings(groupIds)
+
+	res := make([]*pb.EventObjectSubscriptionFormula, 0, len(groupIds)*len(c.formulas))
+	for _, groupId := range groupIds {
+		for i, rel := range c.formulas {
+			res = append(res, &pb.EventObjectSubscriptionFormula{
+				RelationKey: rel.Key,
+				Formula:     rel.Formula,
+				GroupId:     groupId,
+				Value:       c.results[groupId][i].ToProto(),
+			})
+		}
+	}
+	return res
+}
+
+func formulaValuesEqual(a, b []domain.Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func calculateFormula(formula model.BlockContentDataviewRelationFormulaType, key domain.RelationKey, records []*domain.Details) domain.Value {
+	var (
+		emptyCount, valuesCount int
+		distinct                = map[string]struct{}{}
+		numbers                 []float64
+	)
+	for _, details := range records {
+		value := details.Get(key)
+		if isFormulaValueEmpty(value) {
+			emptyCount++
+			continue
+		}
+		values := value.WrapToList()
+		if b, ok := value.TryBool(); ok {
+			values = []domain.Value{domain.Bool(b)}
+		}
+		valuesCount += len(values)
+		for _, v := range values {
+			distinct[fmt.Sprint(v.Raw())] = struct{}{}
+			if n, ok := v.TryFloat64(); ok {
+				numbers = append(numbers, n)
+			}
+		}
+	}
+	sort.Float64s(numbers)
+
+	switch formula {
+	case model.BlockContentDataviewRelation_Count:
+		return domain.Int64(len(records))
+	case model.BlockContentDataviewRelation_CountValue:
+		return domain.Int64(valuesCount)
+	case model.BlockContentDataviewRelation_CountDistinct:
+		return domain.Int64(len(distinct))
+	case model.BlockContentDataviewRelation_CountEmpty:
+		return domain.Int64(emptyCount)
+	case model.BlockContentDataviewRelation_CountNotEmpty:
+		return domain.Int64(len(records) - emptyCount)
+	case model.BlockContentDataviewRelation_PercentEmpty:
+		return domain.Float64(percent(emptyCount, len(records)))
+	case model.BlockContentDataviewRelation_PercentNotEmpty:
+		return domain.Float64(percent(len(records)-emptyCount, len(records)))
+	case model.BlockContentDataviewRelation_MathSum:
+		var sum float64
+		for _, n := range numbers {
+			sum += n
+		}
+		return domain.Float64(sum)
+	}
+
+	if len(numbers) == 0 {
+		return domain.Null()
+	}
+	switch formula {
+	case model.BlockContentDataviewRelation_MathAverage:
+		var sum float64
+		for _, n := range numbers {
+			sum += n
+		}
+		return domain.Float64(sum / float64(len(numbers)))
+	case model.BlockContentDataviewRelation_MathMedian:
+		mid := len(numbers) / 2
+		if len(numbers)%2 == 0 {
+			return domain.Float64((numbers[mid-1] + numbers[mid]) / 2)
+		}
+		return domain.Float64(numbers[mid])
+	case model.BlockContentDataviewRelation_MathMin:
+		return domain.Float64(numbers[0])
+	case model.BlockContentDataviewRelation_MathMax:
+		return domain.Float64(numbers[len(numbers)-1])
+	case model.BlockContentDataviewRelation_Range:
+		return domain.Float64(numbers[len(numbers)-1] - numbers[0])
+	}
+	return domain.Null()
+}
+
+// isFormulaValueEmpty treats zero numbers as values, unlike domain.Value.IsEmpty
+func isFormulaValueEmpty(value domain.Value) bool {
+	if _, ok := value.TryFloat64(); ok {
+		return false
+	}
+	return value.IsEmpty()
+}
+
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) * 100 / float64(total)
+}
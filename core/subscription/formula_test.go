@@ -0,0 +1,163 @@
+package subscription
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/core/kanban"
+	"github.com/anyproto/anytype-heart/pb"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func genFormulaEntry(id string, ord int64, price domain.Value, tags []string) *entry {
+	details := genEntry(id, ord).data
+	if price.Ok() {
+		details.Set("price", price)
+	}
+	if len(tags) > 0 {
+		details.SetStringList("tag", tags)
+	}
+	return newEntry(id, details)
+}
+
+func findFormula(results []*pb.EventObjectSubscriptionFormula, groupId string, formula model.BlockContentDataviewRelationFormulaType) *types.Value {
+	for _, res := range results {
+		if res.GroupId == groupId && res.Formula == formula {
+			return res.Value
+		}
+	}
+	return nil
+}
+
+func TestCalculateFormula(t *testing.T) {
+	records := []*domain.Details{
+		genFormulaEntry("id1", 1, domain.Int64(10), []string{"a"}).data,
+		genFormulaEntry("id2", 2, domain.Int64(0), []string{"a", "b"}).data,
+		genFormulaEntry("id3", 3, domain.Int64(30), nil).data,
+		genFormulaEntry("id4", 4, domain.Int64(40), nil).data,
+		genFormulaEntry("id5", 5, domain.Invalid(), nil).data,
+	}
+
+	for _, tc := range []struct {
+		formula model.BlockContentDataviewRelationFormulaType
+		key     domain.RelationKey
+		want    domain.Value
+	}{
+		{model.BlockContentDataviewRelation_Count, "price", domain.Int64(5)},
+		{model.BlockContentDataviewRelation_CountValue, "tag", domain.Int64(3)},
+		{model.BlockContentDataviewRelation_CountDistinct, "tag", domain.Int64(2)},
+		{model.BlockContentDataviewRelation_CountEmpty, "price", domain.Int64(1)},
+		{model.BlockContentDataviewRelation_CountNotEmpty, "price", domain.Int64(4)},
+		{model.BlockContentDataviewRelation_PercentEmpty, "tag", domain.Float64(60)},
+		{model.BlockContentDataviewRelation_PercentNotEmpty, "tag", domain.Float64(40)},
+		{model.BlockContentDataviewRelation_MathSum, "price", domain.Float64(80)},
+		{model.BlockContentDataviewRelation_MathAverage, "price", domain.Float64(20)},
+		{model.BlockContentDataviewRelation_MathMedian, "price", domain.Float64(20)},
+		{model.BlockContentDataviewRelation_MathMin, "price", domain.Float64(0)},
+		{model.BlockContentDataviewRelation_MathMax, "price", domain.Float64(40)},
+		{model.BlockContentDataviewRelation_Range, "price", domain.Float64(40)},
+		{model.BlockContentDataviewRelation_MathAverage, "tag", domain.Null()},
+		{model.BlockContentDataviewRelation_MathSum, "tag", domain.Float64(0)},
+	} {
+		t.Run(tc.formula.String()+" "+string(tc.key), func(t *testing.T) {
+			assert.Equal(t, tc.want, calculateFormula(tc.formula, tc.key, records))
+		})
+	}
+}
+
+func TestSubscription_Formulas(t *testing.T) {
+	newSub := func(groupKey domain.RelationKey) *sortedSub {
+		sub := &sortedSub{
+			id:    "test",
+			order: testOrder,
+			cache: newCache(),
+			limit: 2,
+		}
+		require.NoError(t, sub.init([]*entry{
+			genFormulaEntry("id1", 1, domain.Int64(10), []string{"a"}),
+			genFormulaEntry("id2", 2, domain.Int64(20), []string{"a"}),
+			genFormulaEntry("id3", 3, domain.Int64(30), []string{"b"}),
+		}))
+		var groupFormat model.RelationFormat
+		if groupKey != "" {
+			groupFormat = model.RelationFormat_tag
+		}
+		sub.setFormulas(newFormulaCalc([]*model.BlockContentDataviewRelation{
+			{Key: "price", Formula: model.BlockContentDataviewRelation_MathSum},
+			{Key: "price", Formula: model.BlockContentDataviewRelation_Count},
+		}, groupKey, groupFormat))
+		return sub
+	}
+
+	t.Run("initial results are calculated over all records", func(t *testing.T) {
+		sub := newSub("")
+
+		results := sub.getFormulas()
+		require.Len(t, results, 2)
+		assert.Equal(t, pbtypesFloat(60), findFormula(results, allRecordsGroupId, model.BlockContentDataviewRelation_MathSum))
+		assert.Equal(t, pbtypesFloat(3), findFormula(results, allRecordsGroupId, model.BlockContentDataviewRelation_Count))
+	})
+
+	t.Run("results are sent with counters when records change", func(t *testing.T) {
+		sub := newSub("")
+
+		ctx := &opCtx{c: sub.cache, entries: []*entry{genFormulaEntry("id4", 4, domain.Int64(40), nil)}, outputs: map[string][]*pb.EventMessage{}}
+		sub.onChange(ctx)
+		require.Len(t, ctx.counters, 1)
+		assert.Equal(t, 4, ctx.counters[0].total)
+		assert.Equal(t, pbtypesFloat(100), findFormula(ctx.counters[0].formulas, allRecordsGroupId, model.BlockContentDataviewRelation_MathSum))
+		ctx.apply()
+
+		// value change doesn't affect counters, but changes formulas
+		ctx = &opCtx{c: sub.cache, entries: []*entry{genFormulaEntry("id1", 1, domain.Int64(15), []string{"a"})}, outputs: map[string][]*pb.EventMessage{}}
+		sub.onChange(ctx)
+		require.Len(t, ctx.counters, 1)
+		assert.Equal(t, pbtypesFloat(105), findFormula(ctx.counters[0].formulas, allRecordsGroupId, model.BlockContentDataviewRelation_MathSum))
+		ctx.apply()
+
+		// nothing to send when neither counters nor formulas change
+		ctx = &opCtx{c: sub.cache, entries: []*entry{genFormulaEntry("id1", 1, domain.Int64(15), []string{"a"})}, outputs: map[string][]*pb.EventMessage{}}
+		sub.onChange(ctx)
+		assert.Empty(t, ctx.counters)
+	})
+
+	t.Run("results for kanban groups", func(t *testing.T) {
+		sub := newSub("tag")
+		groupA, groupB := kanban.Hash("a"), kanban.Hash("b")
+
+		results := sub.getFormulas()
+		require.Len(t, results, 6)
+		assert.Equal(t, pbtypesFloat(30), findFormula(results, groupA, model.BlockContentDataviewRelation_MathSum))
+		assert.Equal(t, pbtypesFloat(30), findFormula(results, groupB, model.BlockContentDataviewRelation_MathSum))
+
+		// move id3 from group b to group a
+		ctx := &opCtx{c: sub.cache, entries: []*entry{genFormulaEntry("id3", 3, domain.Int64(30), []string{"a"})}, outputs: map[string][]*pb.EventMessage{}}
+		sub.onChange(ctx)
+		require.Len(t, ctx.counters, 1)
+		results = ctx.counters[0].formulas
+		assert.Equal(t, pbtypesFloat(60), findFormula(results, groupA, model.BlockContentDataviewRelation_MathSum))
+		assert.Equal(t, pbtypesFloat(3), findFormula(results, groupA, model.BlockContentDataviewRelation_Count))
+		assert.Nil(t, findFormula(results, groupB, model.BlockContentDataviewRelation_MathSum))
+	})
+
+	t.Run("removed tag options are not part of kanban groups", func(t *testing.T) {
+		sub := newSub("tag")
+		sub.formulas.optionExists = func(id string) bool { return id != "removed" }
+		groupA := kanban.Hash("a")
+
+		ctx := &opCtx{c: sub.cache, entries: []*entry{genFormulaEntry("id3", 3, domain.Int64(30), []string{"a", "removed"})}, outputs: map[string][]*pb.EventMessage{}}
+		sub.onChange(ctx)
+		require.Len(t, ctx.counters, 1)
+		results := ctx.counters[0].formulas
+		assert.Equal(t, pbtypesFloat(60), findFormula(results, groupA, model.BlockContentDataviewRelation_MathSum))
+		assert.Nil(t, findFormula(results, kanban.Hash("aremoved"), model.BlockContentDataviewRelation_MathSum))
+	})
+}
+
+func pbtypesFloat(v float64) *types.Value {
+	return domain.Float64(v).ToProto()
+}
@@ -57,6 +57,10 @@ type SubscribeRequest struct {
 	// disable dependent subscription
 	NoDepSubscription bool
 	CollectionId      string
+	// (optional) view relations with formulas to calculate over all records of subscription
+	Formulas []*model.BlockContentDataviewRelation
+	// (optional) calculate formulas for every kanban group of given relation as well
+	GroupRelationKey string
 
 	// Internal indicates that subscription will send events into message queue instead of global client's event system
 	Internal bool
@@ -392,13 +396,18 @@ func (s *spaceSubscriptions) Search(req SubscribeRequest) (*SubscribeResponse, e
 		req.Limit = 0
 	}
 
+	formulas, err := s.makeFormulaCalc(req.Formulas, req.GroupRelationKey)
+	if err != nil {
+		return nil, fmt.Errorf("init formulas: %w", err)
+	}
+
 	if req.CollectionId != "" {
-		return s.subscribeForCollection(req, f, filterDepIds)
+		return s.subscribeForCollection(req, f, filterDepIds, formulas)
 	}
-	return s.subscribeForQuery(req, f, entries, filterDepIds)
+	return s.subscribeForQuery(req, f, entries, filterDepIds, formulas)
 }
 
-func (s *spaceSubscriptions) subscribeForQuery(req SubscribeRequest, f *database.Filters, entries []*entry, filterDepIds []string) (*SubscribeResponse, error) {
+func (s *spaceSubscriptions) subscribeForQuery(req SubscribeRequest, f *database.Filters, entries []*entry, filterDepIds []string, formulas *formulaCalc) (*SubscribeResponse, error) {
 	sub := s.newSortedSub(req.SubId, req.SpaceId, slice.StringsInto[domain.RelationKey](req.Keys), f.FilterObj, f.Order, int(req.Limit), int(req.Offset))
 	if req.NoDepSubscription {
 		sub.disableDep = true
@@ -443,6 +452,7 @@ func (s *spaceSubscriptions) subscribeForQuery(req SubscribeRequest, f *database
 		if err != nil {
 			return nil, fmt.Errorf("async: init sub entries: %w", err)
 		}
+		sub.setFormulas(formulas)
 		s.onChangeWithinContext(entries, func(ctxBuf *opCtx) {
 			sub.onChange(ctxBuf)
 		})
@@ -452,6 +462,7 @@ func (s *spaceSubscriptions) subscribeForQuery(req SubscribeRequest, f *database
 		if err != nil {
 			return nil, fmt.Errorf("init sub entries: %w", err)
 		}
+		sub.setFormulas(formulas)
 	}
 
 	s.setSubscription(sub.id, sub)
@@ -475,6 +486,7 @@ func (s *spaceSubscriptions) subscribeForQuery(req SubscribeRequest, f *database
 			Total:     int64(sub.skl.Len()),
 			NextCount: int64(prev),
 			PrevCount: int64(next),
+			Formulas:  sub.getFormulas(),
 		},
 		Output: outputQueue,
 	}, nil
@@ -503,7 +515,7 @@ func queryEntries(objectStore spaceindex.Store, f *database.Filters) ([]*entry,
 	return entries, nil
 }
 
-func (s *spaceSubscriptions) subscribeForCollection(req SubscribeRequest, f *database.Filters, filterDepIds []string) (*SubscribeResponse, error) {
+func (s *spaceSubscriptions) subscribeForCollection(req SubscribeRequest, f *database.Filters, filterDepIds []string, formulas *formulaCalc) (*SubscribeResponse, error) {
 	sub, err := s.newCollectionSub(req.SubId, req.SpaceId, req.CollectionId, slice.StringsInto[domain.RelationKey](req.Keys), filterDepIds, f.FilterObj, f.Order, int(req.Limit), int(req.Offset), req.NoDepSubscription)
 	if err != nil {
 		return nil, err
@@ -511,6 +523,7 @@ func (s *spaceSubscriptions) subscribeForCollection(req SubscribeRequest, f *dat
 	if err := sub.init(nil); err != nil {
 		return nil, fmt.Errorf("subscription init error: %w", err)
 	}
+	sub.sortedSub.setFormulas(formulas)
 	s.setSubscription(sub.sortedSub.id, sub)
 	prev, next := sub.counters()
 
@@ -536,6 +549,7 @@ func (s *spaceSubscriptions) subscribeForCollection(req SubscribeRequest, f *dat
 			Total:     int64(sub.sortedSub.skl.Len()),
 			NextCount: int64(prev),
 			PrevCount: int64(next),
+			Formulas:  sub.sortedSub.getFormulas(),
 		},
 		Output: outputQueue,
 	}, nil
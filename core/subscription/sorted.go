@@ -6,6 +6,7 @@ import (
 	"github.com/huandu/skiplist"
 
 	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pb"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
 	"github.com/anyproto/anytype-heart/pkg/lib/localstore/objectstore/spaceindex"
 )
@@ -56,6 +57,9 @@ type sortedSub struct {
 
 	compCountBefore, compCountAfter opCounter
 
+	// formulas is calculated over all records of subscription, nil when client didn't request formulas
+	formulas *formulaCalc
+
 	cache *cache
 	ds    *dependencyService
 
@@ -167,8 +171,16 @@ func (s *sortedSub) onChange(ctx *opCtx) {
 	s.compCountAfter.prevCount, s.compCountAfter.nextCount = s.counters()
 	s.compCountAfter.total = s.skl.Len()
 
-	if s.compCountAfter != s.compCountBefore {
-		ctx.counters = append(ctx.counters, s.compCountAfter)
+	var formulasChanged bool
+	if s.formulas != nil {
+		formulasChanged = s.formulas.calculate()
+	}
+	if !s.compCountAfter.equalCounts(s.compCountBefore) || formulasChanged {
+		counter := s.compCountAfter
+		if s.formulas != nil {
+			counter.formulas = s.formulas.proto()
+		}
+		ctx.counters = append(ctx.counters, counter)
 		s.compCountBefore = s.compCountAfter
 	}
 
@@ -224,12 +236,18 @@ func (s *sortedSub) onEntryChange(ctx *opCtx, e *entry) (noChange bool) {
 	if curInSet && !newInSet {
 		s.skl.Remove(curr)
 		e.RemoveSubId(s.id)
+		if s.formulas != nil {
+			s.formulas.remove(e.id)
+		}
 		return
 	}
 	// add
 	if !curInSet && newInSet {
 		s.skl.Set(e, nil)
 		e.SetSub(s.id, false, false)
+		if s.formulas != nil {
+			s.formulas.set(e)
+		}
 		return
 	}
 	// change
@@ -237,6 +255,9 @@ func (s *sortedSub) onEntryChange(ctx *opCtx, e *entry) (noChange bool) {
 		s.skl.Remove(curr)
 		s.skl.Set(e, nil)
 		e.SetSub(s.id, false, false)
+		if s.formulas != nil {
+			s.formulas.set(e)
+		}
 		return
 	}
 	panic("subscription: check algo")
@@ -293,6 +314,27 @@ func (s *sortedSub) counters() (prev, next int) {
 	return
 }
 
+// setFormulas enables calculation of formulas over the records of subscription. Should be called after init
+func (s *sortedSub) setFormulas(calc *formulaCalc) {
+	s.formulas = calc
+	if calc == nil {
+		return
+	}
+	el := s.skl.Front()
+	for el != nil {
+		calc.set(el.Key().(*entry))
+		el = el.Next()
+	}
+	calc.calculate()
+}
+
+func (s *sortedSub) getFormulas() []*pb.EventObjectSubscriptionFormula {
+	if s.formulas == nil {
+		return nil
+	}
+	return s.formulas.proto()
+}
+
 func (s *sortedSub) getActiveRecords() (res []*domain.Details) {
 	reverse := s.iterateActive(func(e *entry) {
 		res = append(res, e.data.CopyOnlyKeys(s.keys...))
@@ -314,3 +314,43 @@ func (mw *Middleware) BlockTableRowSetHeader(cctx context.Context, req *pb.RpcBl
 	}
 	return response(pb.RpcBlockTableRowSetHeaderResponseError_NULL, id, nil)
 }
+
+func (mw *Middleware) BlockTableCellListMerge(cctx context.Context, req *pb.RpcBlockTableCellListMergeRequest) *pb.RpcBlockTableCellListMergeResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockTableCellListMergeResponseErrorCode, err error) *pb.RpcBlockTableCellListMergeResponse {
+		m := &pb.RpcBlockTableCellListMergeResponse{Error: &pb.RpcBlockTableCellListMergeResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = getErrorDescription(err)
+		} else {
+			m.Event = mw.getResponseEvent(ctx)
+		}
+		return m
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.TableCellListMerge(ctx, *req)
+	})
+	if err != nil {
+		return response(pb.RpcBlockTableCellListMergeResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcBlockTableCellListMergeResponseError_NULL, nil)
+}
+
+func (mw *Middleware) BlockTableCellListSplit(cctx context.Context, req *pb.RpcBlockTableCellListSplitRequest) *pb.RpcBlockTableCellListSplitResponse {
+	ctx := mw.newContext(cctx)
+	response := func(code pb.RpcBlockTableCellListSplitResponseErrorCode, err error) *pb.RpcBlockTableCellListSplitResponse {
+		m := &pb.RpcBlockTableCellListSplitResponse{Error: &pb.RpcBlockTableCellListSplitResponseError{Code: code}}
+		if err != nil {
+			m.Error.Description = getErrorDescription(err)
+		} else {
+			m.Event = mw.getResponseEvent(ctx)
+		}
+		return m
+	}
+	err := mw.doBlockService(func(bs *block.Service) (err error) {
+		return bs.TableCellListSplit(ctx, *req)
+	})
+	if err != nil {
+		return response(pb.RpcBlockTableCellListSplitResponseError_UNKNOWN_ERROR, err)
+	}
+	return response(pb.RpcBlockTableCellListSplitResponseError_NULL, nil)
+}
@@ -24,6 +24,7 @@ const (
 	ReindexTypeBundledTemplates
 	ReindexTypeOutdatedHeads
 	ReindexTypeSystem
+	ReindexTypeBlockLinks
 )
 
 func (t ReindexType) String() string {
@@ -44,6 +45,8 @@ func (t ReindexType) String() string {
 		return "outdated_heads"
 	case ReindexTypeSystem:
 		return "system"
+	case ReindexTypeBlockLinks:
+		return "block_links"
 	}
 	return "unknown"
 }
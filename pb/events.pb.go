@@ -55,7 +55,7 @@ func (x EventBlockDataviewSliceOperation) String() string {
 }
 
 func (EventBlockDataviewSliceOperation) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 0}
 }
 
 type EventStatusThreadSyncStatus int32
@@ -95,7 +95,7 @@ func (x EventStatusThreadSyncStatus) String() string {
 }
 
 func (EventStatusThreadSyncStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 0, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0, 0}
 }
 
 type EventSpaceStatus int32
@@ -129,7 +129,7 @@ func (x EventSpaceStatus) String() string {
 }
 
 func (EventSpaceStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 13, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0}
 }
 
 type EventSpaceNetwork int32
@@ -157,7 +157,7 @@ func (x EventSpaceNetwork) String() string {
 }
 
 func (EventSpaceNetwork) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 13, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 1}
 }
 
 type EventSpaceSyncError int32
@@ -188,7 +188,7 @@ func (x EventSpaceSyncError) String() string {
 }
 
 func (EventSpaceSyncError) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 13, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 2}
 }
 
 type EventP2PStatusStatus int32
@@ -219,7 +219,7 @@ func (x EventP2PStatusStatus) String() string {
 }
 
 func (EventP2PStatusStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 15, 0}
 }
 
 type ModelProcessState int32
@@ -402,6 +402,8 @@ type EventMessage struct {
 	//	*EventMessageValueOfChatUpdate
 	//	*EventMessageValueOfChatUpdateReactions
 	//	*EventMessageValueOfChatDelete
+	//	*EventMessageValueOfCommentSet
+	//	*EventMessageValueOfCommentDelete
 	Value IsEventMessageValue `protobuf_oneof:"value"`
 }
 
@@ -666,6 +668,12 @@ type EventMessageValueOfChatUpdateReactions struct {
 type EventMessageValueOfChatDelete struct {
 	ChatDelete *EventChatDelete `protobuf:"bytes,131,opt,name=chatDelete,proto3,oneof" json:"chatDelete,omitempty"`
 }
+type EventMessageValueOfCommentSet struct {
+	CommentSet *EventCommentSet `protobuf:"bytes,133,opt,name=commentSet,proto3,oneof" json:"commentSet,omitempty"`
+}
+type EventMessageValueOfCommentDelete struct {
+	CommentDelete *EventCommentDelete `protobuf:"bytes,134,opt,name=commentDelete,proto3,oneof" json:"commentDelete,omitempty"`
+}
 
 func (*EventMessageValueOfAccountShow) IsEventMessageValue()                    {}
 func (*EventMessageValueOfAccountDetails) IsEventMessageValue()                 {}
@@ -741,6 +749,8 @@ func (*EventMessageValueOfChatAdd) IsEventMessageValue()
 func (*EventMessageValueOfChatUpdate) IsEventMessageValue()                     {}
 func (*EventMessageValueOfChatUpdateReactions) IsEventMessageValue()            {}
 func (*EventMessageValueOfChatDelete) IsEventMessageValue()                     {}
+func (*EventMessageValueOfCommentSet) IsEventMessageValue()                     {}
+func (*EventMessageValueOfCommentDelete) IsEventMessageValue()                  {}
 
 func (m *EventMessage) GetValue() IsEventMessageValue {
 	if m != nil {
@@ -1274,6 +1284,20 @@ func (m *EventMessage) GetChatDelete() *EventChatDelete {
 	return nil
 }
 
+func (m *EventMessage) GetCommentSet() *EventCommentSet {
+	if x, ok := m.GetValue().(*EventMessageValueOfCommentSet); ok {
+		return x.CommentSet
+	}
+	return nil
+}
+
+func (m *EventMessage) GetCommentDelete() *EventCommentDelete {
+	if x, ok := m.GetValue().(*EventMessageValueOfCommentDelete); ok {
+		return x.CommentDelete
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventMessage) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1351,6 +1375,8 @@ func (*EventMessage) XXX_OneofWrappers() []interface{} {
 		(*EventMessageValueOfChatUpdate)(nil),
 		(*EventMessageValueOfChatUpdateReactions)(nil),
 		(*EventMessageValueOfChatDelete)(nil),
+		(*EventMessageValueOfCommentSet)(nil),
+		(*EventMessageValueOfCommentDelete)(nil),
 	}
 }
 
@@ -1598,6 +1624,132 @@ func (m *EventChatUpdateReactions) GetReactions() *model.ChatMessageReactions {
 	return nil
 }
 
+// Comments of the object, events are sent with the id of the commented object as the context id
+type EventComment struct {
+}
+
+func (m *EventComment) Reset()         { *m = EventComment{} }
+func (m *EventComment) String() string { return proto.CompactTextString(m) }
+func (*EventComment) ProtoMessage()    {}
+func (*EventComment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 2}
+}
+func (m *EventComment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventComment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventComment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventComment.Merge(m, src)
+}
+func (m *EventComment) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventComment) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventComment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventComment proto.InternalMessageInfo
+
+// comment is added or changed
+type EventCommentSet struct {
+	Comment *model.Comment `protobuf:"bytes,1,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (m *EventCommentSet) Reset()         { *m = EventCommentSet{} }
+func (m *EventCommentSet) String() string { return proto.CompactTextString(m) }
+func (*EventCommentSet) ProtoMessage()    {}
+func (*EventCommentSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 0}
+}
+func (m *EventCommentSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommentSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommentSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommentSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommentSet.Merge(m, src)
+}
+func (m *EventCommentSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommentSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommentSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommentSet proto.InternalMessageInfo
+
+func (m *EventCommentSet) GetComment() *model.Comment {
+	if m != nil {
+		return m.Comment
+	}
+	return nil
+}
+
+type EventCommentDelete struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *EventCommentDelete) Reset()         { *m = EventCommentDelete{} }
+func (m *EventCommentDelete) String() string { return proto.CompactTextString(m) }
+func (*EventCommentDelete) ProtoMessage()    {}
+func (*EventCommentDelete) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 1}
+}
+func (m *EventCommentDelete) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommentDelete) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommentDelete.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommentDelete) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommentDelete.Merge(m, src)
+}
+func (m *EventCommentDelete) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommentDelete) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommentDelete.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommentDelete proto.InternalMessageInfo
+
+func (m *EventCommentDelete) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type EventAccount struct {
 }
 
@@ -1605,7 +1757,7 @@ func (m *EventAccount) Reset()         { *m = EventAccount{} }
 func (m *EventAccount) String() string { return proto.CompactTextString(m) }
 func (*EventAccount) ProtoMessage()    {}
 func (*EventAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3}
 }
 func (m *EventAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1645,7 +1797,7 @@ func (m *EventAccountShow) Reset()         { *m = EventAccountShow{} }
 func (m *EventAccountShow) String() string { return proto.CompactTextString(m) }
 func (*EventAccountShow) ProtoMessage()    {}
 func (*EventAccountShow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 0}
 }
 func (m *EventAccountShow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1697,7 +1849,7 @@ func (m *EventAccountDetails) Reset()         { *m = EventAccountDetails{} }
 func (m *EventAccountDetails) String() string { return proto.CompactTextString(m) }
 func (*EventAccountDetails) ProtoMessage()    {}
 func (*EventAccountDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1}
 }
 func (m *EventAccountDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1899,7 @@ func (m *EventAccountConfig) Reset()         { *m = EventAccountConfig{} }
 func (m *EventAccountConfig) String() string { return proto.CompactTextString(m) }
 func (*EventAccountConfig) ProtoMessage()    {}
 func (*EventAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 2}
 }
 func (m *EventAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1785,7 +1937,7 @@ func (m *EventAccountConfigUpdate) Reset()         { *m = EventAccountConfigUpda
 func (m *EventAccountConfigUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAccountConfigUpdate) ProtoMessage()    {}
 func (*EventAccountConfigUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 2, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 2, 0}
 }
 func (m *EventAccountConfigUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1837,7 +1989,7 @@ func (m *EventAccountUpdate) Reset()         { *m = EventAccountUpdate{} }
 func (m *EventAccountUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventAccountUpdate) ProtoMessage()    {}
 func (*EventAccountUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 3}
 }
 func (m *EventAccountUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1889,7 +2041,7 @@ func (m *EventAccountLinkChallenge) Reset()         { *m = EventAccountLinkChall
 func (m *EventAccountLinkChallenge) String() string { return proto.CompactTextString(m) }
 func (*EventAccountLinkChallenge) ProtoMessage()    {}
 func (*EventAccountLinkChallenge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4}
 }
 func (m *EventAccountLinkChallenge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1942,7 +2094,7 @@ func (m *EventAccountLinkChallengeClientInfo) Reset()         { *m = EventAccoun
 func (m *EventAccountLinkChallengeClientInfo) String() string { return proto.CompactTextString(m) }
 func (*EventAccountLinkChallengeClientInfo) ProtoMessage()    {}
 func (*EventAccountLinkChallengeClientInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 2, 4, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 0}
 }
 func (m *EventAccountLinkChallengeClientInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1999,7 +2151,7 @@ func (m *EventObject) Reset()         { *m = EventObject{} }
 func (m *EventObject) String() string { return proto.CompactTextString(m) }
 func (*EventObject) ProtoMessage()    {}
 func (*EventObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4}
 }
 func (m *EventObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2035,7 +2187,7 @@ func (m *EventObjectDetails) Reset()         { *m = EventObjectDetails{} }
 func (m *EventObjectDetails) String() string { return proto.CompactTextString(m) }
 func (*EventObjectDetails) ProtoMessage()    {}
 func (*EventObjectDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 0}
 }
 func (m *EventObjectDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2075,7 +2227,7 @@ func (m *EventObjectDetailsAmend) Reset()         { *m = EventObjectDetailsAmend
 func (m *EventObjectDetailsAmend) String() string { return proto.CompactTextString(m) }
 func (*EventObjectDetailsAmend) ProtoMessage()    {}
 func (*EventObjectDetailsAmend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 0, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 0, 0}
 }
 func (m *EventObjectDetailsAmend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2134,7 +2286,7 @@ func (m *EventObjectDetailsAmendKeyValue) Reset()         { *m = EventObjectDeta
 func (m *EventObjectDetailsAmendKeyValue) String() string { return proto.CompactTextString(m) }
 func (*EventObjectDetailsAmendKeyValue) ProtoMessage()    {}
 func (*EventObjectDetailsAmendKeyValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 0, 0, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 0, 0, 0}
 }
 func (m *EventObjectDetailsAmendKeyValue) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2188,7 +2340,7 @@ func (m *EventObjectDetailsSet) Reset()         { *m = EventObjectDetailsSet{} }
 func (m *EventObjectDetailsSet) String() string { return proto.CompactTextString(m) }
 func (*EventObjectDetailsSet) ProtoMessage()    {}
 func (*EventObjectDetailsSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 0, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 0, 1}
 }
 func (m *EventObjectDetailsSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2249,7 +2401,7 @@ func (m *EventObjectDetailsUnset) Reset()         { *m = EventObjectDetailsUnset
 func (m *EventObjectDetailsUnset) String() string { return proto.CompactTextString(m) }
 func (*EventObjectDetailsUnset) ProtoMessage()    {}
 func (*EventObjectDetailsUnset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 0, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 0, 2}
 }
 func (m *EventObjectDetailsUnset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2306,7 +2458,7 @@ func (m *EventObjectSubscription) Reset()         { *m = EventObjectSubscription
 func (m *EventObjectSubscription) String() string { return proto.CompactTextString(m) }
 func (*EventObjectSubscription) ProtoMessage()    {}
 func (*EventObjectSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 1}
 }
 func (m *EventObjectSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2346,7 +2498,7 @@ func (m *EventObjectSubscriptionAdd) Reset()         { *m = EventObjectSubscript
 func (m *EventObjectSubscriptionAdd) String() string { return proto.CompactTextString(m) }
 func (*EventObjectSubscriptionAdd) ProtoMessage()    {}
 func (*EventObjectSubscriptionAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 1, 0}
 }
 func (m *EventObjectSubscriptionAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2406,7 +2558,7 @@ func (m *EventObjectSubscriptionRemove) Reset()         { *m = EventObjectSubscr
 func (m *EventObjectSubscriptionRemove) String() string { return proto.CompactTextString(m) }
 func (*EventObjectSubscriptionRemove) ProtoMessage()    {}
 func (*EventObjectSubscriptionRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 1, 1}
 }
 func (m *EventObjectSubscriptionRemove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2460,7 +2612,7 @@ func (m *EventObjectSubscriptionPosition) Reset()         { *m = EventObjectSubs
 func (m *EventObjectSubscriptionPosition) String() string { return proto.CompactTextString(m) }
 func (*EventObjectSubscriptionPosition) ProtoMessage()    {}
 func (*EventObjectSubscriptionPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 1, 2}
 }
 func (m *EventObjectSubscriptionPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2518,13 +2670,15 @@ type EventObjectSubscriptionCounters struct {
 	// how many records available before
 	PrevCount int64  `protobuf:"varint,3,opt,name=prevCount,proto3" json:"prevCount,omitempty"`
 	SubId     string `protobuf:"bytes,4,opt,name=subId,proto3" json:"subId,omitempty"`
+	// results of the formulas requested by subscription
+	Formulas []*EventObjectSubscriptionFormula `protobuf:"bytes,5,rep,name=formulas,proto3" json:"formulas,omitempty"`
 }
 
 func (m *EventObjectSubscriptionCounters) Reset()         { *m = EventObjectSubscriptionCounters{} }
 func (m *EventObjectSubscriptionCounters) String() string { return proto.CompactTextString(m) }
 func (*EventObjectSubscriptionCounters) ProtoMessage()    {}
 func (*EventObjectSubscriptionCounters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 1, 3}
 }
 func (m *EventObjectSubscriptionCounters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2581,6 +2735,83 @@ func (m *EventObjectSubscriptionCounters) GetSubId() string {
 	return ""
 }
 
+func (m *EventObjectSubscriptionCounters) GetFormulas() []*EventObjectSubscriptionFormula {
+	if m != nil {
+		return m.Formulas
+	}
+	return nil
+}
+
+type EventObjectSubscriptionFormula struct {
+	RelationKey string                                        `protobuf:"bytes,1,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	Formula     model.BlockContentDataviewRelationFormulaType `protobuf:"varint,2,opt,name=formula,proto3,enum=anytype.model.BlockContentDataviewRelationFormulaType" json:"formula,omitempty"`
+	// kanban group id, empty for the result calculated over all records
+	GroupId string `protobuf:"bytes,3,opt,name=groupId,proto3" json:"groupId,omitempty"`
+	// calculated value, null when there are no values to calculate
+	Value *types.Value `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventObjectSubscriptionFormula) Reset()         { *m = EventObjectSubscriptionFormula{} }
+func (m *EventObjectSubscriptionFormula) String() string { return proto.CompactTextString(m) }
+func (*EventObjectSubscriptionFormula) ProtoMessage()    {}
+func (*EventObjectSubscriptionFormula) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 1, 4}
+}
+func (m *EventObjectSubscriptionFormula) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventObjectSubscriptionFormula) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventObjectSubscriptionFormula.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventObjectSubscriptionFormula) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventObjectSubscriptionFormula.Merge(m, src)
+}
+func (m *EventObjectSubscriptionFormula) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventObjectSubscriptionFormula) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventObjectSubscriptionFormula.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventObjectSubscriptionFormula proto.InternalMessageInfo
+
+func (m *EventObjectSubscriptionFormula) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *EventObjectSubscriptionFormula) GetFormula() model.BlockContentDataviewRelationFormulaType {
+	if m != nil {
+		return m.Formula
+	}
+	return model.BlockContentDataviewRelation_None
+}
+
+func (m *EventObjectSubscriptionFormula) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *EventObjectSubscriptionFormula) GetValue() *types.Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type EventObjectSubscriptionGroups struct {
 	SubId  string                           `protobuf:"bytes,1,opt,name=subId,proto3" json:"subId,omitempty"`
 	Group  *model.BlockContentDataviewGroup `protobuf:"bytes,2,opt,name=group,proto3" json:"group,omitempty"`
@@ -2591,7 +2822,7 @@ func (m *EventObjectSubscriptionGroups) Reset()         { *m = EventObjectSubscr
 func (m *EventObjectSubscriptionGroups) String() string { return proto.CompactTextString(m) }
 func (*EventObjectSubscriptionGroups) ProtoMessage()    {}
 func (*EventObjectSubscriptionGroups) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 1, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 1, 5}
 }
 func (m *EventObjectSubscriptionGroups) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2879,7 @@ func (m *EventObjectRelations) Reset()         { *m = EventObjectRelations{} }
 func (m *EventObjectRelations) String() string { return proto.CompactTextString(m) }
 func (*EventObjectRelations) ProtoMessage()    {}
 func (*EventObjectRelations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 2}
 }
 func (m *EventObjectRelations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2686,7 +2917,7 @@ func (m *EventObjectRelationsAmend) Reset()         { *m = EventObjectRelationsA
 func (m *EventObjectRelationsAmend) String() string { return proto.CompactTextString(m) }
 func (*EventObjectRelationsAmend) ProtoMessage()    {}
 func (*EventObjectRelationsAmend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 2, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 2, 0}
 }
 func (m *EventObjectRelationsAmend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2738,7 +2969,7 @@ func (m *EventObjectRelationsRemove) Reset()         { *m = EventObjectRelations
 func (m *EventObjectRelationsRemove) String() string { return proto.CompactTextString(m) }
 func (*EventObjectRelationsRemove) ProtoMessage()    {}
 func (*EventObjectRelationsRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 2, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 2, 1}
 }
 func (m *EventObjectRelationsRemove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2790,7 +3021,7 @@ func (m *EventObjectRemove) Reset()         { *m = EventObjectRemove{} }
 func (m *EventObjectRemove) String() string { return proto.CompactTextString(m) }
 func (*EventObjectRemove) ProtoMessage()    {}
 func (*EventObjectRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 3}
 }
 func (m *EventObjectRemove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2833,7 +3064,7 @@ func (m *EventObjectRestrictions) Reset()         { *m = EventObjectRestrictions
 func (m *EventObjectRestrictions) String() string { return proto.CompactTextString(m) }
 func (*EventObjectRestrictions) ProtoMessage()    {}
 func (*EventObjectRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4}
 }
 func (m *EventObjectRestrictions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2871,7 +3102,7 @@ func (m *EventObjectRestrictionsSet) Reset()         { *m = EventObjectRestricti
 func (m *EventObjectRestrictionsSet) String() string { return proto.CompactTextString(m) }
 func (*EventObjectRestrictionsSet) ProtoMessage()    {}
 func (*EventObjectRestrictionsSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 4, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 0}
 }
 func (m *EventObjectRestrictionsSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2922,7 +3153,7 @@ func (m *EventObjectClose) Reset()         { *m = EventObjectClose{} }
 func (m *EventObjectClose) String() string { return proto.CompactTextString(m) }
 func (*EventObjectClose) ProtoMessage()    {}
 func (*EventObjectClose) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 3, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5}
 }
 func (m *EventObjectClose) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2965,7 +3196,7 @@ func (m *EventBlock) Reset()         { *m = EventBlock{} }
 func (m *EventBlock) String() string { return proto.CompactTextString(m) }
 func (*EventBlock) ProtoMessage()    {}
 func (*EventBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5}
 }
 func (m *EventBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3243,7 @@ func (m *EventBlockAdd) Reset()         { *m = EventBlockAdd{} }
 func (m *EventBlockAdd) String() string { return proto.CompactTextString(m) }
 func (*EventBlockAdd) ProtoMessage()    {}
 func (*EventBlockAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 0}
 }
 func (m *EventBlockAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3062,7 +3293,7 @@ func (m *EventBlockFilesUpload) Reset()         { *m = EventBlockFilesUpload{} }
 func (m *EventBlockFilesUpload) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFilesUpload) ProtoMessage()    {}
 func (*EventBlockFilesUpload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 1}
 }
 func (m *EventBlockFilesUpload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3113,7 +3344,7 @@ func (m *EventBlockDelete) Reset()         { *m = EventBlockDelete{} }
 func (m *EventBlockDelete) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDelete) ProtoMessage()    {}
 func (*EventBlockDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 2}
 }
 func (m *EventBlockDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3157,7 +3388,7 @@ func (m *EventBlockMarksInfo) Reset()         { *m = EventBlockMarksInfo{} }
 func (m *EventBlockMarksInfo) String() string { return proto.CompactTextString(m) }
 func (*EventBlockMarksInfo) ProtoMessage()    {}
 func (*EventBlockMarksInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 3}
 }
 func (m *EventBlockMarksInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3200,7 +3431,7 @@ func (m *EventBlockSet) Reset()         { *m = EventBlockSet{} }
 func (m *EventBlockSet) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSet) ProtoMessage()    {}
 func (*EventBlockSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4}
 }
 func (m *EventBlockSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3238,7 +3469,7 @@ func (m *EventBlockSetRelation) Reset()         { *m = EventBlockSetRelation{} }
 func (m *EventBlockSetRelation) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetRelation) ProtoMessage()    {}
 func (*EventBlockSetRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 0}
 }
 func (m *EventBlockSetRelation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3289,7 +3520,7 @@ func (m *EventBlockSetRelationKey) Reset()         { *m = EventBlockSetRelationK
 func (m *EventBlockSetRelationKey) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetRelationKey) ProtoMessage()    {}
 func (*EventBlockSetRelationKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 0, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 0, 0}
 }
 func (m *EventBlockSetRelationKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3334,7 +3565,7 @@ func (m *EventBlockSetFields) Reset()         { *m = EventBlockSetFields{} }
 func (m *EventBlockSetFields) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFields) ProtoMessage()    {}
 func (*EventBlockSetFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 1}
 }
 func (m *EventBlockSetFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3386,7 +3617,7 @@ func (m *EventBlockSetChildrenIds) Reset()         { *m = EventBlockSetChildrenI
 func (m *EventBlockSetChildrenIds) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetChildrenIds) ProtoMessage()    {}
 func (*EventBlockSetChildrenIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 2}
 }
 func (m *EventBlockSetChildrenIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3438,7 +3669,7 @@ func (m *EventBlockSetRestrictions) Reset()         { *m = EventBlockSetRestrict
 func (m *EventBlockSetRestrictions) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetRestrictions) ProtoMessage()    {}
 func (*EventBlockSetRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 3}
 }
 func (m *EventBlockSetRestrictions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3490,7 +3721,7 @@ func (m *EventBlockSetBackgroundColor) Reset()         { *m = EventBlockSetBackg
 func (m *EventBlockSetBackgroundColor) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBackgroundColor) ProtoMessage()    {}
 func (*EventBlockSetBackgroundColor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 4}
 }
 func (m *EventBlockSetBackgroundColor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3542,7 +3773,7 @@ func (m *EventBlockSetAlign) Reset()         { *m = EventBlockSetAlign{} }
 func (m *EventBlockSetAlign) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetAlign) ProtoMessage()    {}
 func (*EventBlockSetAlign) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 5}
 }
 func (m *EventBlockSetAlign) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3594,7 +3825,7 @@ func (m *EventBlockSetVerticalAlign) Reset()         { *m = EventBlockSetVertica
 func (m *EventBlockSetVerticalAlign) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetVerticalAlign) ProtoMessage()    {}
 func (*EventBlockSetVerticalAlign) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 6}
 }
 func (m *EventBlockSetVerticalAlign) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3652,7 +3883,7 @@ func (m *EventBlockSetText) Reset()         { *m = EventBlockSetText{} }
 func (m *EventBlockSetText) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetText) ProtoMessage()    {}
 func (*EventBlockSetText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 7}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7}
 }
 func (m *EventBlockSetText) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3739,13 +3970,15 @@ func (m *EventBlockSetText) GetIconImage() *EventBlockSetTextIconImage {
 
 type EventBlockSetTextText struct {
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// edits of the previous text, set only in changes. They are used to merge concurrent edits of the block
+	Edits []*EventBlockSetTextTextEdit `protobuf:"bytes,2,rep,name=edits,proto3" json:"edits,omitempty"`
 }
 
 func (m *EventBlockSetTextText) Reset()         { *m = EventBlockSetTextText{} }
 func (m *EventBlockSetTextText) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTextText) ProtoMessage()    {}
 func (*EventBlockSetTextText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 7, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7, 0}
 }
 func (m *EventBlockSetTextText) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3781,6 +4014,74 @@ func (m *EventBlockSetTextText) GetValue() string {
 	return ""
 }
 
+func (m *EventBlockSetTextText) GetEdits() []*EventBlockSetTextTextEdit {
+	if m != nil {
+		return m.Edits
+	}
+	return nil
+}
+
+type EventBlockSetTextTextEdit struct {
+	// position in the previous text in UTF-16 code units
+	From     int32  `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	Deleted  string `protobuf:"bytes,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Inserted string `protobuf:"bytes,3,opt,name=inserted,proto3" json:"inserted,omitempty"`
+}
+
+func (m *EventBlockSetTextTextEdit) Reset()         { *m = EventBlockSetTextTextEdit{} }
+func (m *EventBlockSetTextTextEdit) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetTextTextEdit) ProtoMessage()    {}
+func (*EventBlockSetTextTextEdit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7, 0, 0}
+}
+func (m *EventBlockSetTextTextEdit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetTextTextEdit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetTextTextEdit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetTextTextEdit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetTextTextEdit.Merge(m, src)
+}
+func (m *EventBlockSetTextTextEdit) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetTextTextEdit) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetTextTextEdit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetTextTextEdit proto.InternalMessageInfo
+
+func (m *EventBlockSetTextTextEdit) GetFrom() int32 {
+	if m != nil {
+		return m.From
+	}
+	return 0
+}
+
+func (m *EventBlockSetTextTextEdit) GetDeleted() string {
+	if m != nil {
+		return m.Deleted
+	}
+	return ""
+}
+
+func (m *EventBlockSetTextTextEdit) GetInserted() string {
+	if m != nil {
+		return m.Inserted
+	}
+	return ""
+}
+
 type EventBlockSetTextStyle struct {
 	Value model.BlockContentTextStyle `protobuf:"varint,1,opt,name=value,proto3,enum=anytype.model.BlockContentTextStyle" json:"value,omitempty"`
 }
@@ -3789,7 +4090,7 @@ func (m *EventBlockSetTextStyle) Reset()         { *m = EventBlockSetTextStyle{}
 func (m *EventBlockSetTextStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTextStyle) ProtoMessage()    {}
 func (*EventBlockSetTextStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 7, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7, 1}
 }
 func (m *EventBlockSetTextStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3827,13 +4128,15 @@ func (m *EventBlockSetTextStyle) GetValue() model.BlockContentTextStyle {
 
 type EventBlockSetTextMarks struct {
 	Value *model.BlockContentTextMarks `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	// marks of the previous text, set only in changes along with text edits. They are used to merge concurrent changes of marks
+	Previous *model.BlockContentTextMarks `protobuf:"bytes,2,opt,name=previous,proto3" json:"previous,omitempty"`
 }
 
 func (m *EventBlockSetTextMarks) Reset()         { *m = EventBlockSetTextMarks{} }
 func (m *EventBlockSetTextMarks) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTextMarks) ProtoMessage()    {}
 func (*EventBlockSetTextMarks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 7, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7, 2}
 }
 func (m *EventBlockSetTextMarks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3869,6 +4172,13 @@ func (m *EventBlockSetTextMarks) GetValue() *model.BlockContentTextMarks {
 	return nil
 }
 
+func (m *EventBlockSetTextMarks) GetPrevious() *model.BlockContentTextMarks {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
 type EventBlockSetTextChecked struct {
 	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -3877,7 +4187,7 @@ func (m *EventBlockSetTextChecked) Reset()         { *m = EventBlockSetTextCheck
 func (m *EventBlockSetTextChecked) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTextChecked) ProtoMessage()    {}
 func (*EventBlockSetTextChecked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 7, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7, 3}
 }
 func (m *EventBlockSetTextChecked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3921,7 +4231,7 @@ func (m *EventBlockSetTextColor) Reset()         { *m = EventBlockSetTextColor{}
 func (m *EventBlockSetTextColor) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTextColor) ProtoMessage()    {}
 func (*EventBlockSetTextColor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 7, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7, 4}
 }
 func (m *EventBlockSetTextColor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3965,7 +4275,7 @@ func (m *EventBlockSetTextIconEmoji) Reset()         { *m = EventBlockSetTextIco
 func (m *EventBlockSetTextIconEmoji) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTextIconEmoji) ProtoMessage()    {}
 func (*EventBlockSetTextIconEmoji) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 7, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7, 5}
 }
 func (m *EventBlockSetTextIconEmoji) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4009,7 +4319,7 @@ func (m *EventBlockSetTextIconImage) Reset()         { *m = EventBlockSetTextIco
 func (m *EventBlockSetTextIconImage) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTextIconImage) ProtoMessage()    {}
 func (*EventBlockSetTextIconImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 7, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 7, 6}
 }
 func (m *EventBlockSetTextIconImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4055,7 +4365,7 @@ func (m *EventBlockSetLatex) Reset()         { *m = EventBlockSetLatex{} }
 func (m *EventBlockSetLatex) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLatex) ProtoMessage()    {}
 func (*EventBlockSetLatex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 8}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 8}
 }
 func (m *EventBlockSetLatex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4113,7 +4423,7 @@ func (m *EventBlockSetLatexText) Reset()         { *m = EventBlockSetLatexText{}
 func (m *EventBlockSetLatexText) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLatexText) ProtoMessage()    {}
 func (*EventBlockSetLatexText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 8, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 8, 0}
 }
 func (m *EventBlockSetLatexText) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4157,7 +4467,7 @@ func (m *EventBlockSetLatexProcessor) Reset()         { *m = EventBlockSetLatexP
 func (m *EventBlockSetLatexProcessor) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLatexProcessor) ProtoMessage()    {}
 func (*EventBlockSetLatexProcessor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 8, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 8, 1}
 }
 func (m *EventBlockSetLatexProcessor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4202,7 +4512,7 @@ func (m *EventBlockSetDiv) Reset()         { *m = EventBlockSetDiv{} }
 func (m *EventBlockSetDiv) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetDiv) ProtoMessage()    {}
 func (*EventBlockSetDiv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 9}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 9}
 }
 func (m *EventBlockSetDiv) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4253,7 +4563,7 @@ func (m *EventBlockSetDivStyle) Reset()         { *m = EventBlockSetDivStyle{} }
 func (m *EventBlockSetDivStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetDivStyle) ProtoMessage()    {}
 func (*EventBlockSetDivStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 9, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 9, 0}
 }
 func (m *EventBlockSetDivStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4305,7 +4615,7 @@ func (m *EventBlockSetFile) Reset()         { *m = EventBlockSetFile{} }
 func (m *EventBlockSetFile) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFile) ProtoMessage()    {}
 func (*EventBlockSetFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10}
 }
 func (m *EventBlockSetFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4405,7 +4715,7 @@ func (m *EventBlockSetFileName) Reset()         { *m = EventBlockSetFileName{} }
 func (m *EventBlockSetFileName) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileName) ProtoMessage()    {}
 func (*EventBlockSetFileName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 0}
 }
 func (m *EventBlockSetFileName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4449,7 +4759,7 @@ func (m *EventBlockSetFileWidth) Reset()         { *m = EventBlockSetFileWidth{}
 func (m *EventBlockSetFileWidth) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileWidth) ProtoMessage()    {}
 func (*EventBlockSetFileWidth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 1}
 }
 func (m *EventBlockSetFileWidth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4493,7 +4803,7 @@ func (m *EventBlockSetFileState) Reset()         { *m = EventBlockSetFileState{}
 func (m *EventBlockSetFileState) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileState) ProtoMessage()    {}
 func (*EventBlockSetFileState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 2}
 }
 func (m *EventBlockSetFileState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4537,7 +4847,7 @@ func (m *EventBlockSetFileType) Reset()         { *m = EventBlockSetFileType{} }
 func (m *EventBlockSetFileType) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileType) ProtoMessage()    {}
 func (*EventBlockSetFileType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 3}
 }
 func (m *EventBlockSetFileType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4581,7 +4891,7 @@ func (m *EventBlockSetFileStyle) Reset()         { *m = EventBlockSetFileStyle{}
 func (m *EventBlockSetFileStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileStyle) ProtoMessage()    {}
 func (*EventBlockSetFileStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 4}
 }
 func (m *EventBlockSetFileStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4625,7 +4935,7 @@ func (m *EventBlockSetFileHash) Reset()         { *m = EventBlockSetFileHash{} }
 func (m *EventBlockSetFileHash) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileHash) ProtoMessage()    {}
 func (*EventBlockSetFileHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 5}
 }
 func (m *EventBlockSetFileHash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4669,7 +4979,7 @@ func (m *EventBlockSetFileMime) Reset()         { *m = EventBlockSetFileMime{} }
 func (m *EventBlockSetFileMime) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileMime) ProtoMessage()    {}
 func (*EventBlockSetFileMime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 6}
 }
 func (m *EventBlockSetFileMime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4713,7 +5023,7 @@ func (m *EventBlockSetFileSize) Reset()         { *m = EventBlockSetFileSize{} }
 func (m *EventBlockSetFileSize) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileSize) ProtoMessage()    {}
 func (*EventBlockSetFileSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 7}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 7}
 }
 func (m *EventBlockSetFileSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4757,7 +5067,7 @@ func (m *EventBlockSetFileTargetObjectId) Reset()         { *m = EventBlockSetFi
 func (m *EventBlockSetFileTargetObjectId) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetFileTargetObjectId) ProtoMessage()    {}
 func (*EventBlockSetFileTargetObjectId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 10, 8}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 10, 8}
 }
 func (m *EventBlockSetFileTargetObjectId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4802,13 +5112,14 @@ type EventBlockSetLink struct {
 	CardStyle     *EventBlockSetLinkCardStyle     `protobuf:"bytes,6,opt,name=cardStyle,proto3" json:"cardStyle,omitempty"`
 	Description   *EventBlockSetLinkDescription   `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
 	Relations     *EventBlockSetLinkRelations     `protobuf:"bytes,8,opt,name=relations,proto3" json:"relations,omitempty"`
+	FocusBlockId  *EventBlockSetLinkFocusBlockId  `protobuf:"bytes,9,opt,name=focusBlockId,proto3" json:"focusBlockId,omitempty"`
 }
 
 func (m *EventBlockSetLink) Reset()         { *m = EventBlockSetLink{} }
 func (m *EventBlockSetLink) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLink) ProtoMessage()    {}
 func (*EventBlockSetLink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 11}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11}
 }
 func (m *EventBlockSetLink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4893,6 +5204,13 @@ func (m *EventBlockSetLink) GetRelations() *EventBlockSetLinkRelations {
 	return nil
 }
 
+func (m *EventBlockSetLink) GetFocusBlockId() *EventBlockSetLinkFocusBlockId {
+	if m != nil {
+		return m.FocusBlockId
+	}
+	return nil
+}
+
 type EventBlockSetLinkTargetBlockId struct {
 	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -4901,7 +5219,7 @@ func (m *EventBlockSetLinkTargetBlockId) Reset()         { *m = EventBlockSetLin
 func (m *EventBlockSetLinkTargetBlockId) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkTargetBlockId) ProtoMessage()    {}
 func (*EventBlockSetLinkTargetBlockId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 11, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11, 0}
 }
 func (m *EventBlockSetLinkTargetBlockId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4937,6 +5255,50 @@ func (m *EventBlockSetLinkTargetBlockId) GetValue() string {
 	return ""
 }
 
+type EventBlockSetLinkFocusBlockId struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetLinkFocusBlockId) Reset()         { *m = EventBlockSetLinkFocusBlockId{} }
+func (m *EventBlockSetLinkFocusBlockId) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetLinkFocusBlockId) ProtoMessage()    {}
+func (*EventBlockSetLinkFocusBlockId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11, 1}
+}
+func (m *EventBlockSetLinkFocusBlockId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetLinkFocusBlockId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetLinkFocusBlockId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetLinkFocusBlockId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetLinkFocusBlockId.Merge(m, src)
+}
+func (m *EventBlockSetLinkFocusBlockId) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetLinkFocusBlockId) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetLinkFocusBlockId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetLinkFocusBlockId proto.InternalMessageInfo
+
+func (m *EventBlockSetLinkFocusBlockId) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
 type EventBlockSetLinkStyle struct {
 	Value model.BlockContentLinkStyle `protobuf:"varint,1,opt,name=value,proto3,enum=anytype.model.BlockContentLinkStyle" json:"value,omitempty"`
 }
@@ -4945,7 +5307,7 @@ func (m *EventBlockSetLinkStyle) Reset()         { *m = EventBlockSetLinkStyle{}
 func (m *EventBlockSetLinkStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkStyle) ProtoMessage()    {}
 func (*EventBlockSetLinkStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 11, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11, 2}
 }
 func (m *EventBlockSetLinkStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4989,7 +5351,7 @@ func (m *EventBlockSetLinkFields) Reset()         { *m = EventBlockSetLinkFields
 func (m *EventBlockSetLinkFields) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkFields) ProtoMessage()    {}
 func (*EventBlockSetLinkFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 11, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11, 3}
 }
 func (m *EventBlockSetLinkFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5033,7 +5395,7 @@ func (m *EventBlockSetLinkIconSize) Reset()         { *m = EventBlockSetLinkIcon
 func (m *EventBlockSetLinkIconSize) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkIconSize) ProtoMessage()    {}
 func (*EventBlockSetLinkIconSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 11, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11, 4}
 }
 func (m *EventBlockSetLinkIconSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5077,7 +5439,7 @@ func (m *EventBlockSetLinkCardStyle) Reset()         { *m = EventBlockSetLinkCar
 func (m *EventBlockSetLinkCardStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkCardStyle) ProtoMessage()    {}
 func (*EventBlockSetLinkCardStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 11, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11, 5}
 }
 func (m *EventBlockSetLinkCardStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5121,7 +5483,7 @@ func (m *EventBlockSetLinkDescription) Reset()         { *m = EventBlockSetLinkD
 func (m *EventBlockSetLinkDescription) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkDescription) ProtoMessage()    {}
 func (*EventBlockSetLinkDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 11, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11, 6}
 }
 func (m *EventBlockSetLinkDescription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5165,7 +5527,7 @@ func (m *EventBlockSetLinkRelations) Reset()         { *m = EventBlockSetLinkRel
 func (m *EventBlockSetLinkRelations) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetLinkRelations) ProtoMessage()    {}
 func (*EventBlockSetLinkRelations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 11, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 11, 7}
 }
 func (m *EventBlockSetLinkRelations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5217,7 +5579,7 @@ func (m *EventBlockSetBookmark) Reset()         { *m = EventBlockSetBookmark{} }
 func (m *EventBlockSetBookmark) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmark) ProtoMessage()    {}
 func (*EventBlockSetBookmark) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12}
 }
 func (m *EventBlockSetBookmark) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5317,7 +5679,7 @@ func (m *EventBlockSetBookmarkUrl) Reset()         { *m = EventBlockSetBookmarkU
 func (m *EventBlockSetBookmarkUrl) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmarkUrl) ProtoMessage()    {}
 func (*EventBlockSetBookmarkUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12, 0}
 }
 func (m *EventBlockSetBookmarkUrl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5361,7 +5723,7 @@ func (m *EventBlockSetBookmarkTitle) Reset()         { *m = EventBlockSetBookmar
 func (m *EventBlockSetBookmarkTitle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmarkTitle) ProtoMessage()    {}
 func (*EventBlockSetBookmarkTitle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12, 1}
 }
 func (m *EventBlockSetBookmarkTitle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5405,7 +5767,7 @@ func (m *EventBlockSetBookmarkDescription) Reset()         { *m = EventBlockSetB
 func (m *EventBlockSetBookmarkDescription) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmarkDescription) ProtoMessage()    {}
 func (*EventBlockSetBookmarkDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12, 2}
 }
 func (m *EventBlockSetBookmarkDescription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5449,7 +5811,7 @@ func (m *EventBlockSetBookmarkImageHash) Reset()         { *m = EventBlockSetBoo
 func (m *EventBlockSetBookmarkImageHash) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmarkImageHash) ProtoMessage()    {}
 func (*EventBlockSetBookmarkImageHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12, 3}
 }
 func (m *EventBlockSetBookmarkImageHash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5493,7 +5855,7 @@ func (m *EventBlockSetBookmarkFaviconHash) Reset()         { *m = EventBlockSetB
 func (m *EventBlockSetBookmarkFaviconHash) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmarkFaviconHash) ProtoMessage()    {}
 func (*EventBlockSetBookmarkFaviconHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12, 4}
 }
 func (m *EventBlockSetBookmarkFaviconHash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5537,7 +5899,7 @@ func (m *EventBlockSetBookmarkType) Reset()         { *m = EventBlockSetBookmark
 func (m *EventBlockSetBookmarkType) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmarkType) ProtoMessage()    {}
 func (*EventBlockSetBookmarkType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12, 5}
 }
 func (m *EventBlockSetBookmarkType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5581,7 +5943,7 @@ func (m *EventBlockSetBookmarkTargetObjectId) Reset()         { *m = EventBlockS
 func (m *EventBlockSetBookmarkTargetObjectId) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmarkTargetObjectId) ProtoMessage()    {}
 func (*EventBlockSetBookmarkTargetObjectId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12, 6}
 }
 func (m *EventBlockSetBookmarkTargetObjectId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5625,7 +5987,7 @@ func (m *EventBlockSetBookmarkState) Reset()         { *m = EventBlockSetBookmar
 func (m *EventBlockSetBookmarkState) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetBookmarkState) ProtoMessage()    {}
 func (*EventBlockSetBookmarkState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 12, 7}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 12, 7}
 }
 func (m *EventBlockSetBookmarkState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5662,15 +6024,16 @@ func (m *EventBlockSetBookmarkState) GetValue() model.BlockContentBookmarkState
 }
 
 type EventBlockSetTableRow struct {
-	Id       string                         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	IsHeader *EventBlockSetTableRowIsHeader `protobuf:"bytes,2,opt,name=isHeader,proto3" json:"isHeader,omitempty"`
+	Id        string                          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IsHeader  *EventBlockSetTableRowIsHeader  `protobuf:"bytes,2,opt,name=isHeader,proto3" json:"isHeader,omitempty"`
+	CellSpans *EventBlockSetTableRowCellSpans `protobuf:"bytes,3,opt,name=cellSpans,proto3" json:"cellSpans,omitempty"`
 }
 
 func (m *EventBlockSetTableRow) Reset()         { *m = EventBlockSetTableRow{} }
 func (m *EventBlockSetTableRow) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTableRow) ProtoMessage()    {}
 func (*EventBlockSetTableRow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 13}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 13}
 }
 func (m *EventBlockSetTableRow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5713,6 +6076,13 @@ func (m *EventBlockSetTableRow) GetIsHeader() *EventBlockSetTableRowIsHeader {
 	return nil
 }
 
+func (m *EventBlockSetTableRow) GetCellSpans() *EventBlockSetTableRowCellSpans {
+	if m != nil {
+		return m.CellSpans
+	}
+	return nil
+}
+
 type EventBlockSetTableRowIsHeader struct {
 	Value bool `protobuf:"varint,1,opt,name=value,proto3" json:"value,omitempty"`
 }
@@ -5721,7 +6091,7 @@ func (m *EventBlockSetTableRowIsHeader) Reset()         { *m = EventBlockSetTabl
 func (m *EventBlockSetTableRowIsHeader) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetTableRowIsHeader) ProtoMessage()    {}
 func (*EventBlockSetTableRowIsHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 13, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 13, 0}
 }
 func (m *EventBlockSetTableRowIsHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5757,6 +6127,50 @@ func (m *EventBlockSetTableRowIsHeader) GetValue() bool {
 	return false
 }
 
+type EventBlockSetTableRowCellSpans struct {
+	Value []*model.BlockContentTableRowCellSpan `protobuf:"bytes,1,rep,name=value,proto3" json:"value,omitempty"`
+}
+
+func (m *EventBlockSetTableRowCellSpans) Reset()         { *m = EventBlockSetTableRowCellSpans{} }
+func (m *EventBlockSetTableRowCellSpans) String() string { return proto.CompactTextString(m) }
+func (*EventBlockSetTableRowCellSpans) ProtoMessage()    {}
+func (*EventBlockSetTableRowCellSpans) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 13, 1}
+}
+func (m *EventBlockSetTableRowCellSpans) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlockSetTableRowCellSpans) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlockSetTableRowCellSpans.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlockSetTableRowCellSpans) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlockSetTableRowCellSpans.Merge(m, src)
+}
+func (m *EventBlockSetTableRowCellSpans) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlockSetTableRowCellSpans) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlockSetTableRowCellSpans.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlockSetTableRowCellSpans proto.InternalMessageInfo
+
+func (m *EventBlockSetTableRowCellSpans) GetValue() []*model.BlockContentTableRowCellSpan {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
 type EventBlockSetWidget struct {
 	Id     string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Layout *EventBlockSetWidgetLayout `protobuf:"bytes,2,opt,name=layout,proto3" json:"layout,omitempty"`
@@ -5768,7 +6182,7 @@ func (m *EventBlockSetWidget) Reset()         { *m = EventBlockSetWidget{} }
 func (m *EventBlockSetWidget) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetWidget) ProtoMessage()    {}
 func (*EventBlockSetWidget) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 14}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 14}
 }
 func (m *EventBlockSetWidget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5833,7 +6247,7 @@ func (m *EventBlockSetWidgetLayout) Reset()         { *m = EventBlockSetWidgetLa
 func (m *EventBlockSetWidgetLayout) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetWidgetLayout) ProtoMessage()    {}
 func (*EventBlockSetWidgetLayout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 14, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 14, 0}
 }
 func (m *EventBlockSetWidgetLayout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5877,7 +6291,7 @@ func (m *EventBlockSetWidgetLimit) Reset()         { *m = EventBlockSetWidgetLim
 func (m *EventBlockSetWidgetLimit) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetWidgetLimit) ProtoMessage()    {}
 func (*EventBlockSetWidgetLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 14, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 14, 1}
 }
 func (m *EventBlockSetWidgetLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5921,7 +6335,7 @@ func (m *EventBlockSetWidgetViewId) Reset()         { *m = EventBlockSetWidgetVi
 func (m *EventBlockSetWidgetViewId) String() string { return proto.CompactTextString(m) }
 func (*EventBlockSetWidgetViewId) ProtoMessage()    {}
 func (*EventBlockSetWidgetViewId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 4, 14, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 4, 14, 2}
 }
 func (m *EventBlockSetWidgetViewId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -5964,7 +6378,7 @@ func (m *EventBlockFill) Reset()         { *m = EventBlockFill{} }
 func (m *EventBlockFill) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFill) ProtoMessage()    {}
 func (*EventBlockFill) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5}
 }
 func (m *EventBlockFill) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6002,7 +6416,7 @@ func (m *EventBlockFillDetails) Reset()         { *m = EventBlockFillDetails{} }
 func (m *EventBlockFillDetails) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillDetails) ProtoMessage()    {}
 func (*EventBlockFillDetails) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 0}
 }
 func (m *EventBlockFillDetails) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6054,7 +6468,7 @@ func (m *EventBlockFillDatabaseRecords) Reset()         { *m = EventBlockFillDat
 func (m *EventBlockFillDatabaseRecords) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillDatabaseRecords) ProtoMessage()    {}
 func (*EventBlockFillDatabaseRecords) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 1}
 }
 func (m *EventBlockFillDatabaseRecords) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6106,7 +6520,7 @@ func (m *EventBlockFillFields) Reset()         { *m = EventBlockFillFields{} }
 func (m *EventBlockFillFields) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFields) ProtoMessage()    {}
 func (*EventBlockFillFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 2}
 }
 func (m *EventBlockFillFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6158,7 +6572,7 @@ func (m *EventBlockFillChildrenIds) Reset()         { *m = EventBlockFillChildre
 func (m *EventBlockFillChildrenIds) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillChildrenIds) ProtoMessage()    {}
 func (*EventBlockFillChildrenIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 3}
 }
 func (m *EventBlockFillChildrenIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6210,7 +6624,7 @@ func (m *EventBlockFillRestrictions) Reset()         { *m = EventBlockFillRestri
 func (m *EventBlockFillRestrictions) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillRestrictions) ProtoMessage()    {}
 func (*EventBlockFillRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 4}
 }
 func (m *EventBlockFillRestrictions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6262,7 +6676,7 @@ func (m *EventBlockFillBackgroundColor) Reset()         { *m = EventBlockFillBac
 func (m *EventBlockFillBackgroundColor) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBackgroundColor) ProtoMessage()    {}
 func (*EventBlockFillBackgroundColor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 5}
 }
 func (m *EventBlockFillBackgroundColor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6314,7 +6728,7 @@ func (m *EventBlockFillAlign) Reset()         { *m = EventBlockFillAlign{} }
 func (m *EventBlockFillAlign) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillAlign) ProtoMessage()    {}
 func (*EventBlockFillAlign) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 6}
 }
 func (m *EventBlockFillAlign) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6370,7 +6784,7 @@ func (m *EventBlockFillText) Reset()         { *m = EventBlockFillText{} }
 func (m *EventBlockFillText) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillText) ProtoMessage()    {}
 func (*EventBlockFillText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 7}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 7}
 }
 func (m *EventBlockFillText) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6449,7 +6863,7 @@ func (m *EventBlockFillTextText) Reset()         { *m = EventBlockFillTextText{}
 func (m *EventBlockFillTextText) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillTextText) ProtoMessage()    {}
 func (*EventBlockFillTextText) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 7, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 7, 0}
 }
 func (m *EventBlockFillTextText) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6493,7 +6907,7 @@ func (m *EventBlockFillTextStyle) Reset()         { *m = EventBlockFillTextStyle
 func (m *EventBlockFillTextStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillTextStyle) ProtoMessage()    {}
 func (*EventBlockFillTextStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 7, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 7, 1}
 }
 func (m *EventBlockFillTextStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6537,7 +6951,7 @@ func (m *EventBlockFillTextMarks) Reset()         { *m = EventBlockFillTextMarks
 func (m *EventBlockFillTextMarks) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillTextMarks) ProtoMessage()    {}
 func (*EventBlockFillTextMarks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 7, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 7, 2}
 }
 func (m *EventBlockFillTextMarks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6581,7 +6995,7 @@ func (m *EventBlockFillTextChecked) Reset()         { *m = EventBlockFillTextChe
 func (m *EventBlockFillTextChecked) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillTextChecked) ProtoMessage()    {}
 func (*EventBlockFillTextChecked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 7, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 7, 3}
 }
 func (m *EventBlockFillTextChecked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6625,7 +7039,7 @@ func (m *EventBlockFillTextColor) Reset()         { *m = EventBlockFillTextColor
 func (m *EventBlockFillTextColor) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillTextColor) ProtoMessage()    {}
 func (*EventBlockFillTextColor) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 7, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 7, 4}
 }
 func (m *EventBlockFillTextColor) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6670,7 +7084,7 @@ func (m *EventBlockFillDiv) Reset()         { *m = EventBlockFillDiv{} }
 func (m *EventBlockFillDiv) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillDiv) ProtoMessage()    {}
 func (*EventBlockFillDiv) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 8}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 8}
 }
 func (m *EventBlockFillDiv) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6721,7 +7135,7 @@ func (m *EventBlockFillDivStyle) Reset()         { *m = EventBlockFillDivStyle{}
 func (m *EventBlockFillDivStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillDivStyle) ProtoMessage()    {}
 func (*EventBlockFillDivStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 8, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 8, 0}
 }
 func (m *EventBlockFillDivStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6772,7 +7186,7 @@ func (m *EventBlockFillFile) Reset()         { *m = EventBlockFillFile{} }
 func (m *EventBlockFillFile) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFile) ProtoMessage()    {}
 func (*EventBlockFillFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9}
 }
 func (m *EventBlockFillFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6865,7 +7279,7 @@ func (m *EventBlockFillFileName) Reset()         { *m = EventBlockFillFileName{}
 func (m *EventBlockFillFileName) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFileName) ProtoMessage()    {}
 func (*EventBlockFillFileName) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9, 0}
 }
 func (m *EventBlockFillFileName) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6909,7 +7323,7 @@ func (m *EventBlockFillFileWidth) Reset()         { *m = EventBlockFillFileWidth
 func (m *EventBlockFillFileWidth) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFileWidth) ProtoMessage()    {}
 func (*EventBlockFillFileWidth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9, 1}
 }
 func (m *EventBlockFillFileWidth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6953,7 +7367,7 @@ func (m *EventBlockFillFileState) Reset()         { *m = EventBlockFillFileState
 func (m *EventBlockFillFileState) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFileState) ProtoMessage()    {}
 func (*EventBlockFillFileState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9, 2}
 }
 func (m *EventBlockFillFileState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -6997,7 +7411,7 @@ func (m *EventBlockFillFileType) Reset()         { *m = EventBlockFillFileType{}
 func (m *EventBlockFillFileType) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFileType) ProtoMessage()    {}
 func (*EventBlockFillFileType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9, 3}
 }
 func (m *EventBlockFillFileType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7041,7 +7455,7 @@ func (m *EventBlockFillFileStyle) Reset()         { *m = EventBlockFillFileStyle
 func (m *EventBlockFillFileStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFileStyle) ProtoMessage()    {}
 func (*EventBlockFillFileStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9, 4}
 }
 func (m *EventBlockFillFileStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7085,7 +7499,7 @@ func (m *EventBlockFillFileHash) Reset()         { *m = EventBlockFillFileHash{}
 func (m *EventBlockFillFileHash) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFileHash) ProtoMessage()    {}
 func (*EventBlockFillFileHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9, 5}
 }
 func (m *EventBlockFillFileHash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7129,7 +7543,7 @@ func (m *EventBlockFillFileMime) Reset()         { *m = EventBlockFillFileMime{}
 func (m *EventBlockFillFileMime) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFileMime) ProtoMessage()    {}
 func (*EventBlockFillFileMime) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9, 6}
 }
 func (m *EventBlockFillFileMime) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7173,7 +7587,7 @@ func (m *EventBlockFillFileSize) Reset()         { *m = EventBlockFillFileSize{}
 func (m *EventBlockFillFileSize) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillFileSize) ProtoMessage()    {}
 func (*EventBlockFillFileSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 9, 7}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 9, 7}
 }
 func (m *EventBlockFillFileSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7220,7 +7634,7 @@ func (m *EventBlockFillLink) Reset()         { *m = EventBlockFillLink{} }
 func (m *EventBlockFillLink) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillLink) ProtoMessage()    {}
 func (*EventBlockFillLink) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 10}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 10}
 }
 func (m *EventBlockFillLink) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7285,7 +7699,7 @@ func (m *EventBlockFillLinkTargetBlockId) Reset()         { *m = EventBlockFillL
 func (m *EventBlockFillLinkTargetBlockId) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillLinkTargetBlockId) ProtoMessage()    {}
 func (*EventBlockFillLinkTargetBlockId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 10, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 10, 0}
 }
 func (m *EventBlockFillLinkTargetBlockId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7329,7 +7743,7 @@ func (m *EventBlockFillLinkStyle) Reset()         { *m = EventBlockFillLinkStyle
 func (m *EventBlockFillLinkStyle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillLinkStyle) ProtoMessage()    {}
 func (*EventBlockFillLinkStyle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 10, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 10, 1}
 }
 func (m *EventBlockFillLinkStyle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7373,7 +7787,7 @@ func (m *EventBlockFillLinkFields) Reset()         { *m = EventBlockFillLinkFiel
 func (m *EventBlockFillLinkFields) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillLinkFields) ProtoMessage()    {}
 func (*EventBlockFillLinkFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 10, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 10, 2}
 }
 func (m *EventBlockFillLinkFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7424,7 +7838,7 @@ func (m *EventBlockFillBookmark) Reset()         { *m = EventBlockFillBookmark{}
 func (m *EventBlockFillBookmark) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBookmark) ProtoMessage()    {}
 func (*EventBlockFillBookmark) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 11}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 11}
 }
 func (m *EventBlockFillBookmark) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7517,7 +7931,7 @@ func (m *EventBlockFillBookmarkUrl) Reset()         { *m = EventBlockFillBookmar
 func (m *EventBlockFillBookmarkUrl) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBookmarkUrl) ProtoMessage()    {}
 func (*EventBlockFillBookmarkUrl) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 11, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 11, 0}
 }
 func (m *EventBlockFillBookmarkUrl) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7561,7 +7975,7 @@ func (m *EventBlockFillBookmarkTitle) Reset()         { *m = EventBlockFillBookm
 func (m *EventBlockFillBookmarkTitle) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBookmarkTitle) ProtoMessage()    {}
 func (*EventBlockFillBookmarkTitle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 11, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 11, 1}
 }
 func (m *EventBlockFillBookmarkTitle) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7605,7 +8019,7 @@ func (m *EventBlockFillBookmarkDescription) Reset()         { *m = EventBlockFil
 func (m *EventBlockFillBookmarkDescription) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBookmarkDescription) ProtoMessage()    {}
 func (*EventBlockFillBookmarkDescription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 11, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 11, 2}
 }
 func (m *EventBlockFillBookmarkDescription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7649,7 +8063,7 @@ func (m *EventBlockFillBookmarkImageHash) Reset()         { *m = EventBlockFillB
 func (m *EventBlockFillBookmarkImageHash) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBookmarkImageHash) ProtoMessage()    {}
 func (*EventBlockFillBookmarkImageHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 11, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 11, 3}
 }
 func (m *EventBlockFillBookmarkImageHash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7693,7 +8107,7 @@ func (m *EventBlockFillBookmarkFaviconHash) Reset()         { *m = EventBlockFil
 func (m *EventBlockFillBookmarkFaviconHash) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBookmarkFaviconHash) ProtoMessage()    {}
 func (*EventBlockFillBookmarkFaviconHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 11, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 11, 4}
 }
 func (m *EventBlockFillBookmarkFaviconHash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7737,7 +8151,7 @@ func (m *EventBlockFillBookmarkType) Reset()         { *m = EventBlockFillBookma
 func (m *EventBlockFillBookmarkType) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBookmarkType) ProtoMessage()    {}
 func (*EventBlockFillBookmarkType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 11, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 11, 5}
 }
 func (m *EventBlockFillBookmarkType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7781,7 +8195,7 @@ func (m *EventBlockFillBookmarkTargetObjectId) Reset()         { *m = EventBlock
 func (m *EventBlockFillBookmarkTargetObjectId) String() string { return proto.CompactTextString(m) }
 func (*EventBlockFillBookmarkTargetObjectId) ProtoMessage()    {}
 func (*EventBlockFillBookmarkTargetObjectId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 5, 11, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 5, 11, 6}
 }
 func (m *EventBlockFillBookmarkTargetObjectId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7824,7 +8238,7 @@ func (m *EventBlockDataview) Reset()         { *m = EventBlockDataview{} }
 func (m *EventBlockDataview) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataview) ProtoMessage()    {}
 func (*EventBlockDataview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6}
 }
 func (m *EventBlockDataview) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7864,7 +8278,7 @@ func (m *EventBlockDataviewViewSet) Reset()         { *m = EventBlockDataviewVie
 func (m *EventBlockDataviewViewSet) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewSet) ProtoMessage()    {}
 func (*EventBlockDataviewViewSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 0}
 }
 func (m *EventBlockDataviewViewSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -7927,7 +8341,7 @@ func (m *EventBlockDataviewViewUpdate) Reset()         { *m = EventBlockDataview
 func (m *EventBlockDataviewViewUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdate) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1}
 }
 func (m *EventBlockDataviewViewUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8016,7 +8430,7 @@ func (m *EventBlockDataviewViewUpdateFields) Reset()         { *m = EventBlockDa
 func (m *EventBlockDataviewViewUpdateFields) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateFields) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateFields) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 0}
 }
 func (m *EventBlockDataviewViewUpdateFields) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8124,7 +8538,6 @@ func (m *EventBlockDataviewViewUpdateFields) GetDefaultObjectTypeId() string {
 
 type EventBlockDataviewViewUpdateFilter struct {
 	// Types that are valid to be assigned to Operation:
-	//
 	//	*EventBlockDataviewViewUpdateFilterOperationOfAdd
 	//	*EventBlockDataviewViewUpdateFilterOperationOfRemove
 	//	*EventBlockDataviewViewUpdateFilterOperationOfUpdate
@@ -8136,7 +8549,7 @@ func (m *EventBlockDataviewViewUpdateFilter) Reset()         { *m = EventBlockDa
 func (m *EventBlockDataviewViewUpdateFilter) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateFilter) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateFilter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 1}
 }
 func (m *EventBlockDataviewViewUpdateFilter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8247,7 +8660,7 @@ func (m *EventBlockDataviewViewUpdateFilterAdd) Reset()         { *m = EventBloc
 func (m *EventBlockDataviewViewUpdateFilterAdd) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateFilterAdd) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateFilterAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 1, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 1, 0}
 }
 func (m *EventBlockDataviewViewUpdateFilterAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8300,7 +8713,7 @@ func (m *EventBlockDataviewViewUpdateFilterRemove) Reset() {
 func (m *EventBlockDataviewViewUpdateFilterRemove) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateFilterRemove) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateFilterRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 1, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 1, 1}
 }
 func (m *EventBlockDataviewViewUpdateFilterRemove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8347,7 +8760,7 @@ func (m *EventBlockDataviewViewUpdateFilterUpdate) Reset() {
 func (m *EventBlockDataviewViewUpdateFilterUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateFilterUpdate) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateFilterUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 1, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 1, 2}
 }
 func (m *EventBlockDataviewViewUpdateFilterUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8401,7 +8814,7 @@ func (m *EventBlockDataviewViewUpdateFilterMove) Reset() {
 func (m *EventBlockDataviewViewUpdateFilterMove) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateFilterMove) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateFilterMove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 1, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 1, 3}
 }
 func (m *EventBlockDataviewViewUpdateFilterMove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8446,7 +8859,6 @@ func (m *EventBlockDataviewViewUpdateFilterMove) GetIds() []string {
 
 type EventBlockDataviewViewUpdateRelation struct {
 	// Types that are valid to be assigned to Operation:
-	//
 	//	*EventBlockDataviewViewUpdateRelationOperationOfAdd
 	//	*EventBlockDataviewViewUpdateRelationOperationOfRemove
 	//	*EventBlockDataviewViewUpdateRelationOperationOfUpdate
@@ -8458,7 +8870,7 @@ func (m *EventBlockDataviewViewUpdateRelation) Reset()         { *m = EventBlock
 func (m *EventBlockDataviewViewUpdateRelation) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateRelation) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateRelation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 2}
 }
 func (m *EventBlockDataviewViewUpdateRelation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8571,7 +8983,7 @@ func (m *EventBlockDataviewViewUpdateRelationAdd) Reset() {
 func (m *EventBlockDataviewViewUpdateRelationAdd) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateRelationAdd) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateRelationAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 2, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 2, 0}
 }
 func (m *EventBlockDataviewViewUpdateRelationAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8626,7 +9038,7 @@ func (m *EventBlockDataviewViewUpdateRelationRemove) String() string {
 }
 func (*EventBlockDataviewViewUpdateRelationRemove) ProtoMessage() {}
 func (*EventBlockDataviewViewUpdateRelationRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 2, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 2, 1}
 }
 func (m *EventBlockDataviewViewUpdateRelationRemove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8675,7 +9087,7 @@ func (m *EventBlockDataviewViewUpdateRelationUpdate) String() string {
 }
 func (*EventBlockDataviewViewUpdateRelationUpdate) ProtoMessage() {}
 func (*EventBlockDataviewViewUpdateRelationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 2, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 2, 2}
 }
 func (m *EventBlockDataviewViewUpdateRelationUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8729,7 +9141,7 @@ func (m *EventBlockDataviewViewUpdateRelationMove) Reset() {
 func (m *EventBlockDataviewViewUpdateRelationMove) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateRelationMove) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateRelationMove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 2, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 2, 3}
 }
 func (m *EventBlockDataviewViewUpdateRelationMove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8774,7 +9186,6 @@ func (m *EventBlockDataviewViewUpdateRelationMove) GetIds() []string {
 
 type EventBlockDataviewViewUpdateSort struct {
 	// Types that are valid to be assigned to Operation:
-	//
 	//	*EventBlockDataviewViewUpdateSortOperationOfAdd
 	//	*EventBlockDataviewViewUpdateSortOperationOfRemove
 	//	*EventBlockDataviewViewUpdateSortOperationOfUpdate
@@ -8786,7 +9197,7 @@ func (m *EventBlockDataviewViewUpdateSort) Reset()         { *m = EventBlockData
 func (m *EventBlockDataviewViewUpdateSort) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateSort) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateSort) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 3}
 }
 func (m *EventBlockDataviewViewUpdateSort) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8897,7 +9308,7 @@ func (m *EventBlockDataviewViewUpdateSortAdd) Reset()         { *m = EventBlockD
 func (m *EventBlockDataviewViewUpdateSortAdd) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateSortAdd) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateSortAdd) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 3, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 3, 0}
 }
 func (m *EventBlockDataviewViewUpdateSortAdd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8950,7 +9361,7 @@ func (m *EventBlockDataviewViewUpdateSortRemove) Reset() {
 func (m *EventBlockDataviewViewUpdateSortRemove) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateSortRemove) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateSortRemove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 3, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 3, 1}
 }
 func (m *EventBlockDataviewViewUpdateSortRemove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8997,7 +9408,7 @@ func (m *EventBlockDataviewViewUpdateSortUpdate) Reset() {
 func (m *EventBlockDataviewViewUpdateSortUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateSortUpdate) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateSortUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 3, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 3, 2}
 }
 func (m *EventBlockDataviewViewUpdateSortUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9049,7 +9460,7 @@ func (m *EventBlockDataviewViewUpdateSortMove) Reset()         { *m = EventBlock
 func (m *EventBlockDataviewViewUpdateSortMove) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewUpdateSortMove) ProtoMessage()    {}
 func (*EventBlockDataviewViewUpdateSortMove) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 1, 3, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 1, 3, 3}
 }
 func (m *EventBlockDataviewViewUpdateSortMove) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9101,7 +9512,7 @@ func (m *EventBlockDataviewViewDelete) Reset()         { *m = EventBlockDataview
 func (m *EventBlockDataviewViewDelete) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewDelete) ProtoMessage()    {}
 func (*EventBlockDataviewViewDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 2}
 }
 func (m *EventBlockDataviewViewDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9153,7 +9564,7 @@ func (m *EventBlockDataviewViewOrder) Reset()         { *m = EventBlockDataviewV
 func (m *EventBlockDataviewViewOrder) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewViewOrder) ProtoMessage()    {}
 func (*EventBlockDataviewViewOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 3}
 }
 func (m *EventBlockDataviewViewOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9205,7 +9616,7 @@ func (m *EventBlockDataviewSourceSet) Reset()         { *m = EventBlockDataviewS
 func (m *EventBlockDataviewSourceSet) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewSourceSet) ProtoMessage()    {}
 func (*EventBlockDataviewSourceSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 4}
 }
 func (m *EventBlockDataviewSourceSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9257,7 +9668,7 @@ func (m *EventBlockDataviewOldRelationDelete) Reset()         { *m = EventBlockD
 func (m *EventBlockDataviewOldRelationDelete) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewOldRelationDelete) ProtoMessage()    {}
 func (*EventBlockDataviewOldRelationDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 5}
 }
 func (m *EventBlockDataviewOldRelationDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9311,7 +9722,7 @@ func (m *EventBlockDataviewOldRelationSet) Reset()         { *m = EventBlockData
 func (m *EventBlockDataviewOldRelationSet) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewOldRelationSet) ProtoMessage()    {}
 func (*EventBlockDataviewOldRelationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 6}
 }
 func (m *EventBlockDataviewOldRelationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9370,7 +9781,7 @@ func (m *EventBlockDataviewRelationDelete) Reset()         { *m = EventBlockData
 func (m *EventBlockDataviewRelationDelete) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewRelationDelete) ProtoMessage()    {}
 func (*EventBlockDataviewRelationDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 7}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 7}
 }
 func (m *EventBlockDataviewRelationDelete) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9423,7 +9834,7 @@ func (m *EventBlockDataviewRelationSet) Reset()         { *m = EventBlockDatavie
 func (m *EventBlockDataviewRelationSet) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewRelationSet) ProtoMessage()    {}
 func (*EventBlockDataviewRelationSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 8}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 8}
 }
 func (m *EventBlockDataviewRelationSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9475,7 +9886,7 @@ func (m *EventBlockDataviewGroupOrderUpdate) Reset()         { *m = EventBlockDa
 func (m *EventBlockDataviewGroupOrderUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewGroupOrderUpdate) ProtoMessage()    {}
 func (*EventBlockDataviewGroupOrderUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 9}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 9}
 }
 func (m *EventBlockDataviewGroupOrderUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9529,7 +9940,7 @@ func (m *EventBlockDataviewObjectOrderUpdate) Reset()         { *m = EventBlockD
 func (m *EventBlockDataviewObjectOrderUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewObjectOrderUpdate) ProtoMessage()    {}
 func (*EventBlockDataviewObjectOrderUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 10}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 10}
 }
 func (m *EventBlockDataviewObjectOrderUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9596,7 +10007,7 @@ func (m *EventBlockDataviewSliceChange) Reset()         { *m = EventBlockDatavie
 func (m *EventBlockDataviewSliceChange) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewSliceChange) ProtoMessage()    {}
 func (*EventBlockDataviewSliceChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 11}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 11}
 }
 func (m *EventBlockDataviewSliceChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9655,7 +10066,7 @@ func (m *EventBlockDataviewTargetObjectIdSet) Reset()         { *m = EventBlockD
 func (m *EventBlockDataviewTargetObjectIdSet) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewTargetObjectIdSet) ProtoMessage()    {}
 func (*EventBlockDataviewTargetObjectIdSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 12}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 12}
 }
 func (m *EventBlockDataviewTargetObjectIdSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9707,7 +10118,7 @@ func (m *EventBlockDataviewIsCollectionSet) Reset()         { *m = EventBlockDat
 func (m *EventBlockDataviewIsCollectionSet) String() string { return proto.CompactTextString(m) }
 func (*EventBlockDataviewIsCollectionSet) ProtoMessage()    {}
 func (*EventBlockDataviewIsCollectionSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 4, 6, 13}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 6, 13}
 }
 func (m *EventBlockDataviewIsCollectionSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9757,7 +10168,7 @@ func (m *EventUser) Reset()         { *m = EventUser{} }
 func (m *EventUser) String() string { return proto.CompactTextString(m) }
 func (*EventUser) ProtoMessage()    {}
 func (*EventUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 6}
 }
 func (m *EventUser) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9793,7 +10204,7 @@ func (m *EventUserBlock) Reset()         { *m = EventUserBlock{} }
 func (m *EventUserBlock) String() string { return proto.CompactTextString(m) }
 func (*EventUserBlock) ProtoMessage()    {}
 func (*EventUserBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 6, 0}
 }
 func (m *EventUserBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9837,7 +10248,7 @@ func (m *EventUserBlockJoin) Reset()         { *m = EventUserBlockJoin{} }
 func (m *EventUserBlockJoin) String() string { return proto.CompactTextString(m) }
 func (*EventUserBlockJoin) ProtoMessage()    {}
 func (*EventUserBlockJoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 0, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 6, 0, 0}
 }
 func (m *EventUserBlockJoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9888,7 +10299,7 @@ func (m *EventUserBlockLeft) Reset()         { *m = EventUserBlockLeft{} }
 func (m *EventUserBlockLeft) String() string { return proto.CompactTextString(m) }
 func (*EventUserBlockLeft) ProtoMessage()    {}
 func (*EventUserBlockLeft) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 0, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 6, 0, 1}
 }
 func (m *EventUserBlockLeft) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9939,7 +10350,7 @@ func (m *EventUserBlockTextRange) Reset()         { *m = EventUserBlockTextRange
 func (m *EventUserBlockTextRange) String() string { return proto.CompactTextString(m) }
 func (*EventUserBlockTextRange) ProtoMessage()    {}
 func (*EventUserBlockTextRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 0, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 6, 0, 2}
 }
 func (m *EventUserBlockTextRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10003,7 +10414,7 @@ func (m *EventUserBlockSelectRange) Reset()         { *m = EventUserBlockSelectR
 func (m *EventUserBlockSelectRange) String() string { return proto.CompactTextString(m) }
 func (*EventUserBlockSelectRange) ProtoMessage()    {}
 func (*EventUserBlockSelectRange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 5, 0, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 6, 0, 3}
 }
 func (m *EventUserBlockSelectRange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10054,7 +10465,7 @@ func (m *EventPing) Reset()         { *m = EventPing{} }
 func (m *EventPing) String() string { return proto.CompactTextString(m) }
 func (*EventPing) ProtoMessage()    {}
 func (*EventPing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 6}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 7}
 }
 func (m *EventPing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10097,7 +10508,7 @@ func (m *EventProcess) Reset()         { *m = EventProcess{} }
 func (m *EventProcess) String() string { return proto.CompactTextString(m) }
 func (*EventProcess) ProtoMessage()    {}
 func (*EventProcess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 7}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 8}
 }
 func (m *EventProcess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10134,7 +10545,7 @@ func (m *EventProcessNew) Reset()         { *m = EventProcessNew{} }
 func (m *EventProcessNew) String() string { return proto.CompactTextString(m) }
 func (*EventProcessNew) ProtoMessage()    {}
 func (*EventProcessNew) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 7, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 0}
 }
 func (m *EventProcessNew) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10178,7 +10589,7 @@ func (m *EventProcessUpdate) Reset()         { *m = EventProcessUpdate{} }
 func (m *EventProcessUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventProcessUpdate) ProtoMessage()    {}
 func (*EventProcessUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 7, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 1}
 }
 func (m *EventProcessUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10222,7 +10633,7 @@ func (m *EventProcessDone) Reset()         { *m = EventProcessDone{} }
 func (m *EventProcessDone) String() string { return proto.CompactTextString(m) }
 func (*EventProcessDone) ProtoMessage()    {}
 func (*EventProcessDone) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 7, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 2}
 }
 func (m *EventProcessDone) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10265,7 +10676,7 @@ func (m *EventStatus) Reset()         { *m = EventStatus{} }
 func (m *EventStatus) String() string { return proto.CompactTextString(m) }
 func (*EventStatus) ProtoMessage()    {}
 func (*EventStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 8}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9}
 }
 func (m *EventStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10304,7 +10715,7 @@ func (m *EventStatusThread) Reset()         { *m = EventStatusThread{} }
 func (m *EventStatusThread) String() string { return proto.CompactTextString(m) }
 func (*EventStatusThread) ProtoMessage()    {}
 func (*EventStatusThread) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0}
 }
 func (m *EventStatusThread) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10362,7 +10773,7 @@ func (m *EventStatusThreadSummary) Reset()         { *m = EventStatusThreadSumma
 func (m *EventStatusThreadSummary) String() string { return proto.CompactTextString(m) }
 func (*EventStatusThreadSummary) ProtoMessage()    {}
 func (*EventStatusThreadSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 0, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0, 0}
 }
 func (m *EventStatusThreadSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10409,7 +10820,7 @@ func (m *EventStatusThreadCafe) Reset()         { *m = EventStatusThreadCafe{} }
 func (m *EventStatusThreadCafe) String() string { return proto.CompactTextString(m) }
 func (*EventStatusThreadCafe) ProtoMessage()    {}
 func (*EventStatusThreadCafe) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 0, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0, 1}
 }
 func (m *EventStatusThreadCafe) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10477,7 +10888,7 @@ func (m *EventStatusThreadCafePinStatus) Reset()         { *m = EventStatusThrea
 func (m *EventStatusThreadCafePinStatus) String() string { return proto.CompactTextString(m) }
 func (*EventStatusThreadCafePinStatus) ProtoMessage()    {}
 func (*EventStatusThreadCafePinStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 0, 1, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0, 1, 0}
 }
 func (m *EventStatusThreadCafePinStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10548,7 +10959,7 @@ func (m *EventStatusThreadAccount) Reset()         { *m = EventStatusThreadAccou
 func (m *EventStatusThreadAccount) String() string { return proto.CompactTextString(m) }
 func (*EventStatusThreadAccount) ProtoMessage()    {}
 func (*EventStatusThreadAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 0, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0, 2}
 }
 func (m *EventStatusThreadAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10637,7 +11048,7 @@ func (m *EventStatusThreadDevice) Reset()         { *m = EventStatusThreadDevice
 func (m *EventStatusThreadDevice) String() string { return proto.CompactTextString(m) }
 func (*EventStatusThreadDevice) ProtoMessage()    {}
 func (*EventStatusThreadDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 8, 0, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0, 3}
 }
 func (m *EventStatusThreadDevice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10701,7 +11112,7 @@ func (m *EventFile) Reset()         { *m = EventFile{} }
 func (m *EventFile) String() string { return proto.CompactTextString(m) }
 func (*EventFile) ProtoMessage()    {}
 func (*EventFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 9}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10}
 }
 func (m *EventFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10739,7 +11150,7 @@ func (m *EventFileLimitReached) Reset()         { *m = EventFileLimitReached{} }
 func (m *EventFileLimitReached) String() string { return proto.CompactTextString(m) }
 func (*EventFileLimitReached) ProtoMessage()    {}
 func (*EventFileLimitReached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 0}
 }
 func (m *EventFileLimitReached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10791,7 +11202,7 @@ func (m *EventFileSpaceUsage) Reset()         { *m = EventFileSpaceUsage{} }
 func (m *EventFileSpaceUsage) String() string { return proto.CompactTextString(m) }
 func (*EventFileSpaceUsage) ProtoMessage()    {}
 func (*EventFileSpaceUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 1}
 }
 func (m *EventFileSpaceUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10842,7 +11253,7 @@ func (m *EventFileLocalUsage) Reset()         { *m = EventFileLocalUsage{} }
 func (m *EventFileLocalUsage) String() string { return proto.CompactTextString(m) }
 func (*EventFileLocalUsage) ProtoMessage()    {}
 func (*EventFileLocalUsage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 2}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 2}
 }
 func (m *EventFileLocalUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10886,7 +11297,7 @@ func (m *EventFileLimitUpdated) Reset()         { *m = EventFileLimitUpdated{} }
 func (m *EventFileLimitUpdated) String() string { return proto.CompactTextString(m) }
 func (*EventFileLimitUpdated) ProtoMessage()    {}
 func (*EventFileLimitUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 9, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 3}
 }
 func (m *EventFileLimitUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10929,7 +11340,7 @@ func (m *EventMembership) Reset()         { *m = EventMembership{} }
 func (m *EventMembership) String() string { return proto.CompactTextString(m) }
 func (*EventMembership) ProtoMessage()    {}
 func (*EventMembership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 10}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 11}
 }
 func (m *EventMembership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -10966,7 +11377,7 @@ func (m *EventMembershipUpdate) Reset()         { *m = EventMembershipUpdate{} }
 func (m *EventMembershipUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventMembershipUpdate) ProtoMessage()    {}
 func (*EventMembershipUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 10, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 11, 0}
 }
 func (m *EventMembershipUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11009,7 +11420,7 @@ func (m *EventNotification) Reset()         { *m = EventNotification{} }
 func (m *EventNotification) String() string { return proto.CompactTextString(m) }
 func (*EventNotification) ProtoMessage()    {}
 func (*EventNotification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 11}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 12}
 }
 func (m *EventNotification) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11046,7 +11457,7 @@ func (m *EventNotificationSend) Reset()         { *m = EventNotificationSend{} }
 func (m *EventNotificationSend) String() string { return proto.CompactTextString(m) }
 func (*EventNotificationSend) ProtoMessage()    {}
 func (*EventNotificationSend) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 11, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 12, 0}
 }
 func (m *EventNotificationSend) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11090,7 +11501,7 @@ func (m *EventNotificationUpdate) Reset()         { *m = EventNotificationUpdate
 func (m *EventNotificationUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventNotificationUpdate) ProtoMessage()    {}
 func (*EventNotificationUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 11, 1}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 12, 1}
 }
 func (m *EventNotificationUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11133,7 +11544,7 @@ func (m *EventPayload) Reset()         { *m = EventPayload{} }
 func (m *EventPayload) String() string { return proto.CompactTextString(m) }
 func (*EventPayload) ProtoMessage()    {}
 func (*EventPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 12}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 13}
 }
 func (m *EventPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11170,7 +11581,7 @@ func (m *EventPayloadBroadcast) Reset()         { *m = EventPayloadBroadcast{} }
 func (m *EventPayloadBroadcast) String() string { return proto.CompactTextString(m) }
 func (*EventPayloadBroadcast) ProtoMessage()    {}
 func (*EventPayloadBroadcast) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 12, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 13, 0}
 }
 func (m *EventPayloadBroadcast) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11213,7 +11624,7 @@ func (m *EventSpace) Reset()         { *m = EventSpace{} }
 func (m *EventSpace) String() string { return proto.CompactTextString(m) }
 func (*EventSpace) ProtoMessage()    {}
 func (*EventSpace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 13}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14}
 }
 func (m *EventSpace) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11249,7 +11660,7 @@ func (m *EventSpaceSyncStatus) Reset()         { *m = EventSpaceSyncStatus{} }
 func (m *EventSpaceSyncStatus) String() string { return proto.CompactTextString(m) }
 func (*EventSpaceSyncStatus) ProtoMessage()    {}
 func (*EventSpaceSyncStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 13, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0}
 }
 func (m *EventSpaceSyncStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11290,7 +11701,7 @@ func (m *EventSpaceSyncStatusUpdate) Reset()         { *m = EventSpaceSyncStatus
 func (m *EventSpaceSyncStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventSpaceSyncStatusUpdate) ProtoMessage()    {}
 func (*EventSpaceSyncStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 13, 0, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0, 0}
 }
 func (m *EventSpaceSyncStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11361,7 +11772,7 @@ func (m *EventP2PStatus) Reset()         { *m = EventP2PStatus{} }
 func (m *EventP2PStatus) String() string { return proto.CompactTextString(m) }
 func (*EventP2PStatus) ProtoMessage()    {}
 func (*EventP2PStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 14}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 15}
 }
 func (m *EventP2PStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11400,7 +11811,7 @@ func (m *EventP2PStatusUpdate) Reset()         { *m = EventP2PStatusUpdate{} }
 func (m *EventP2PStatusUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventP2PStatusUpdate) ProtoMessage()    {}
 func (*EventP2PStatusUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 14, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 15, 0}
 }
 func (m *EventP2PStatusUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11457,7 +11868,7 @@ func (m *EventImport) Reset()         { *m = EventImport{} }
 func (m *EventImport) String() string { return proto.CompactTextString(m) }
 func (*EventImport) ProtoMessage()    {}
 func (*EventImport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 15}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 16}
 }
 func (m *EventImport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11496,7 +11907,7 @@ func (m *EventImportFinish) Reset()         { *m = EventImportFinish{} }
 func (m *EventImportFinish) String() string { return proto.CompactTextString(m) }
 func (*EventImportFinish) ProtoMessage()    {}
 func (*EventImportFinish) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{0, 15, 0}
+	return fileDescriptor_a966342d378ae5f5, []int{0, 16, 0}
 }
 func (m *EventImportFinish) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11648,12 +12059,12 @@ type ModelProcess struct {
 	Progress *ModelProcessProgress `protobuf:"bytes,4,opt,name=progress,proto3" json:"progress,omitempty"`
 	SpaceId  string                `protobuf:"bytes,5,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
 	// Types that are valid to be assigned to Message:
-	//
 	//	*ModelProcessMessageOfDropFiles
 	//	*ModelProcessMessageOfImport
 	//	*ModelProcessMessageOfExport
 	//	*ModelProcessMessageOfSaveFile
 	//	*ModelProcessMessageOfMigration
+	//	*ModelProcessMessageOfBackup
 	Message IsModelProcessMessage `protobuf_oneof:"message"`
 	Error   string                `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
 }
@@ -11712,12 +12123,16 @@ type ModelProcessMessageOfSaveFile struct {
 type ModelProcessMessageOfMigration struct {
 	Migration *ModelProcessMigration `protobuf:"bytes,10,opt,name=migration,proto3,oneof" json:"migration,omitempty"`
 }
+type ModelProcessMessageOfBackup struct {
+	Backup *ModelProcessBackup `protobuf:"bytes,12,opt,name=backup,proto3,oneof" json:"backup,omitempty"`
+}
 
 func (*ModelProcessMessageOfDropFiles) IsModelProcessMessage() {}
 func (*ModelProcessMessageOfImport) IsModelProcessMessage()    {}
 func (*ModelProcessMessageOfExport) IsModelProcessMessage()    {}
 func (*ModelProcessMessageOfSaveFile) IsModelProcessMessage()  {}
 func (*ModelProcessMessageOfMigration) IsModelProcessMessage() {}
+func (*ModelProcessMessageOfBackup) IsModelProcessMessage()    {}
 
 func (m *ModelProcess) GetMessage() IsModelProcessMessage {
 	if m != nil {
@@ -11789,6 +12204,13 @@ func (m *ModelProcess) GetMigration() *ModelProcessMigration {
 	return nil
 }
 
+func (m *ModelProcess) GetBackup() *ModelProcessBackup {
+	if x, ok := m.GetMessage().(*ModelProcessMessageOfBackup); ok {
+		return x.Backup
+	}
+	return nil
+}
+
 func (m *ModelProcess) GetError() string {
 	if m != nil {
 		return m.Error
@@ -11804,6 +12226,7 @@ func (*ModelProcess) XXX_OneofWrappers() []interface{} {
 		(*ModelProcessMessageOfExport)(nil),
 		(*ModelProcessMessageOfSaveFile)(nil),
 		(*ModelProcessMessageOfMigration)(nil),
+		(*ModelProcessMessageOfBackup)(nil),
 	}
 }
 
@@ -11915,6 +12338,42 @@ func (m *ModelProcessExport) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModelProcessExport proto.InternalMessageInfo
 
+type ModelProcessBackup struct {
+}
+
+func (m *ModelProcessBackup) Reset()         { *m = ModelProcessBackup{} }
+func (m *ModelProcessBackup) String() string { return proto.CompactTextString(m) }
+func (*ModelProcessBackup) ProtoMessage()    {}
+func (*ModelProcessBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 3}
+}
+func (m *ModelProcessBackup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModelProcessBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModelProcessBackup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModelProcessBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModelProcessBackup.Merge(m, src)
+}
+func (m *ModelProcessBackup) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModelProcessBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModelProcessBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModelProcessBackup proto.InternalMessageInfo
+
 type ModelProcessSaveFile struct {
 }
 
@@ -11922,7 +12381,7 @@ func (m *ModelProcessSaveFile) Reset()         { *m = ModelProcessSaveFile{} }
 func (m *ModelProcessSaveFile) String() string { return proto.CompactTextString(m) }
 func (*ModelProcessSaveFile) ProtoMessage()    {}
 func (*ModelProcessSaveFile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 3}
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 4}
 }
 func (m *ModelProcessSaveFile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11958,7 +12417,7 @@ func (m *ModelProcessMigration) Reset()         { *m = ModelProcessMigration{} }
 func (m *ModelProcessMigration) String() string { return proto.CompactTextString(m) }
 func (*ModelProcessMigration) ProtoMessage()    {}
 func (*ModelProcessMigration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 4}
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 5}
 }
 func (m *ModelProcessMigration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -11997,7 +12456,7 @@ func (m *ModelProcessProgress) Reset()         { *m = ModelProcessProgress{} }
 func (m *ModelProcessProgress) String() string { return proto.CompactTextString(m) }
 func (*ModelProcessProgress) ProtoMessage()    {}
 func (*ModelProcessProgress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 5}
+	return fileDescriptor_a966342d378ae5f5, []int{2, 0, 6}
 }
 func (m *ModelProcessProgress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -12062,6 +12521,9 @@ func init() {
 	proto.RegisterType((*EventChatDelete)(nil), "anytype.Event.Chat.Delete")
 	proto.RegisterType((*EventChatUpdate)(nil), "anytype.Event.Chat.Update")
 	proto.RegisterType((*EventChatUpdateReactions)(nil), "anytype.Event.Chat.UpdateReactions")
+	proto.RegisterType((*EventComment)(nil), "anytype.Event.Comment")
+	proto.RegisterType((*EventCommentSet)(nil), "anytype.Event.Comment.Set")
+	proto.RegisterType((*EventCommentDelete)(nil), "anytype.Event.Comment.Delete")
 	proto.RegisterType((*EventAccount)(nil), "anytype.Event.Account")
 	proto.RegisterType((*EventAccountShow)(nil), "anytype.Event.Account.Show")
 	proto.RegisterType((*EventAccountDetails)(nil), "anytype.Event.Account.Details")
@@ -12081,6 +12543,7 @@ func init() {
 	proto.RegisterType((*EventObjectSubscriptionRemove)(nil), "anytype.Event.Object.Subscription.Remove")
 	proto.RegisterType((*EventObjectSubscriptionPosition)(nil), "anytype.Event.Object.Subscription.Position")
 	proto.RegisterType((*EventObjectSubscriptionCounters)(nil), "anytype.Event.Object.Subscription.Counters")
+	proto.RegisterType((*EventObjectSubscriptionFormula)(nil), "anytype.Event.Object.Subscription.Formula")
 	proto.RegisterType((*EventObjectSubscriptionGroups)(nil), "anytype.Event.Object.Subscription.Groups")
 	proto.RegisterType((*EventObjectRelations)(nil), "anytype.Event.Object.Relations")
 	proto.RegisterType((*EventObjectRelationsAmend)(nil), "anytype.Event.Object.Relations.Amend")
@@ -12105,6 +12568,7 @@ func init() {
 	proto.RegisterType((*EventBlockSetVerticalAlign)(nil), "anytype.Event.Block.Set.VerticalAlign")
 	proto.RegisterType((*EventBlockSetText)(nil), "anytype.Event.Block.Set.Text")
 	proto.RegisterType((*EventBlockSetTextText)(nil), "anytype.Event.Block.Set.Text.Text")
+	proto.RegisterType((*EventBlockSetTextTextEdit)(nil), "anytype.Event.Block.Set.Text.Text.Edit")
 	proto.RegisterType((*EventBlockSetTextStyle)(nil), "anytype.Event.Block.Set.Text.Style")
 	proto.RegisterType((*EventBlockSetTextMarks)(nil), "anytype.Event.Block.Set.Text.Marks")
 	proto.RegisterType((*EventBlockSetTextChecked)(nil), "anytype.Event.Block.Set.Text.Checked")
@@ -12128,6 +12592,7 @@ func init() {
 	proto.RegisterType((*EventBlockSetFileTargetObjectId)(nil), "anytype.Event.Block.Set.File.TargetObjectId")
 	proto.RegisterType((*EventBlockSetLink)(nil), "anytype.Event.Block.Set.Link")
 	proto.RegisterType((*EventBlockSetLinkTargetBlockId)(nil), "anytype.Event.Block.Set.Link.TargetBlockId")
+	proto.RegisterType((*EventBlockSetLinkFocusBlockId)(nil), "anytype.Event.Block.Set.Link.FocusBlockId")
 	proto.RegisterType((*EventBlockSetLinkStyle)(nil), "anytype.Event.Block.Set.Link.Style")
 	proto.RegisterType((*EventBlockSetLinkFields)(nil), "anytype.Event.Block.Set.Link.Fields")
 	proto.RegisterType((*EventBlockSetLinkIconSize)(nil), "anytype.Event.Block.Set.Link.IconSize")
@@ -12145,6 +12610,7 @@ func init() {
 	proto.RegisterType((*EventBlockSetBookmarkState)(nil), "anytype.Event.Block.Set.Bookmark.State")
 	proto.RegisterType((*EventBlockSetTableRow)(nil), "anytype.Event.Block.Set.TableRow")
 	proto.RegisterType((*EventBlockSetTableRowIsHeader)(nil), "anytype.Event.Block.Set.TableRow.IsHeader")
+	proto.RegisterType((*EventBlockSetTableRowCellSpans)(nil), "anytype.Event.Block.Set.TableRow.CellSpans")
 	proto.RegisterType((*EventBlockSetWidget)(nil), "anytype.Event.Block.Set.Widget")
 	proto.RegisterType((*EventBlockSetWidgetLayout)(nil), "anytype.Event.Block.Set.Widget.Layout")
 	proto.RegisterType((*EventBlockSetWidgetLimit)(nil), "anytype.Event.Block.Set.Widget.Limit")
@@ -12260,6 +12726,7 @@ func init() {
 	proto.RegisterType((*ModelProcessDropFiles)(nil), "anytype.Model.Process.DropFiles")
 	proto.RegisterType((*ModelProcessImport)(nil), "anytype.Model.Process.Import")
 	proto.RegisterType((*ModelProcessExport)(nil), "anytype.Model.Process.Export")
+	proto.RegisterType((*ModelProcessBackup)(nil), "anytype.Model.Process.Backup")
 	proto.RegisterType((*ModelProcessSaveFile)(nil), "anytype.Model.Process.SaveFile")
 	proto.RegisterType((*ModelProcessMigration)(nil), "anytype.Model.Process.Migration")
 	proto.RegisterType((*ModelProcessProgress)(nil), "anytype.Model.Process.Progress")
@@ -12268,392 +12735,410 @@ func init() {
 func init() { proto.RegisterFile("pb/protos/events.proto", fileDescriptor_a966342d378ae5f5) }
 
 var fileDescriptor_a966342d378ae5f5 = []byte{
-	// 6151 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4b, 0x8c, 0x1c, 0xc7,
-	0x79, 0x9e, 0xf7, 0xe3, 0x5f, 0x72, 0x39, 0x2c, 0x52, 0x54, 0xab, 0x45, 0x51, 0xd4, 0x8a, 0xa4,
-	0x68, 0x89, 0x1a, 0x52, 0x24, 0x45, 0xca, 0xb4, 0xf8, 0xd8, 0x17, 0x35, 0xc3, 0xc7, 0x72, 0x5d,
-	0x4b, 0xd2, 0xb2, 0x6c, 0x04, 0xee, 0x9d, 0xae, 0x9d, 0x6d, 0x73, 0xb6, 0x7b, 0xdc, 0xdd, 0xbb,
-	0xe4, 0xda, 0x8e, 0xe3, 0xd8, 0x0e, 0x82, 0x00, 0x09, 0x92, 0x43, 0x90, 0x04, 0xb9, 0x04, 0x08,
-	0x12, 0x20, 0x87, 0x20, 0x48, 0x90, 0x4b, 0x72, 0x88, 0x11, 0x20, 0x08, 0xf2, 0x3c, 0xd8, 0xb7,
-	0x5c, 0x02, 0x1b, 0xf2, 0x25, 0x87, 0xe4, 0xe0, 0x04, 0x08, 0x72, 0x0a, 0x82, 0x7a, 0x74, 0x75,
-	0x55, 0x3f, 0xa6, 0x67, 0x2c, 0x39, 0x0f, 0xc4, 0xa7, 0x99, 0xaa, 0xfa, 0xff, 0xef, 0xaf, 0xc7,
-	0xff, 0xff, 0x55, 0xf5, 0x57, 0x75, 0xc1, 0xb1, 0xf1, 0xe6, 0xf9, 0xb1, 0xef, 0x85, 0x5e, 0x70,
-	0x9e, 0xec, 0x11, 0x37, 0x0c, 0xba, 0x2c, 0x85, 0x9a, 0x96, 0xbb, 0x1f, 0xee, 0x8f, 0x89, 0x79,
-	0x6a, 0xfc, 0x64, 0x78, 0x7e, 0xe4, 0x6c, 0x9e, 0x1f, 0x6f, 0x9e, 0xdf, 0xf1, 0x6c, 0x32, 0x8a,
-	0xc8, 0x59, 0x42, 0x90, 0x9b, 0xc7, 0x87, 0x9e, 0x37, 0x1c, 0x11, 0x5e, 0xb6, 0xb9, 0xbb, 0x75,
-	0x3e, 0x08, 0xfd, 0xdd, 0x41, 0xc8, 0x4b, 0x17, 0xfe, 0xec, 0xdb, 0x65, 0xa8, 0xaf, 0x52, 0x78,
-	0x74, 0x11, 0x5a, 0x3b, 0x24, 0x08, 0xac, 0x21, 0x09, 0x8c, 0xf2, 0xc9, 0xea, 0xd9, 0xb9, 0x8b,
-	0xc7, 0xba, 0x42, 0x54, 0x97, 0x51, 0x74, 0xef, 0xf3, 0x62, 0x2c, 0xe9, 0xd0, 0x71, 0x68, 0x0f,
-	0x3c, 0x37, 0x24, 0xcf, 0xc2, 0xbe, 0x6d, 0x54, 0x4e, 0x96, 0xcf, 0xb6, 0x71, 0x9c, 0x81, 0x2e,
-	0x43, 0xdb, 0x71, 0x9d, 0xd0, 0xb1, 0x42, 0xcf, 0x37, 0xaa, 0x27, 0xcb, 0x1a, 0x24, 0xab, 0x64,
-	0x77, 0x71, 0x30, 0xf0, 0x76, 0xdd, 0x10, 0xc7, 0x84, 0xc8, 0x80, 0x66, 0xe8, 0x5b, 0x03, 0xd2,
-	0xb7, 0x8d, 0x1a, 0x43, 0x8c, 0x92, 0xe6, 0xf7, 0x2f, 0x40, 0x53, 0xd4, 0x01, 0xbd, 0x00, 0xcd,
-	0x60, 0xcc, 0xa9, 0xbe, 0x55, 0xe6, 0x64, 0x22, 0x8d, 0x6e, 0xc2, 0x9c, 0xc5, 0x61, 0x37, 0xb6,
-	0xbd, 0xa7, 0x46, 0x99, 0x09, 0x7e, 0x31, 0xd1, 0x16, 0x21, 0xb8, 0x4b, 0x49, 0x7a, 0x25, 0xac,
-	0x72, 0xa0, 0x3e, 0xcc, 0x8b, 0xe4, 0x0a, 0x09, 0x2d, 0x67, 0x14, 0x18, 0x7f, 0xc3, 0x41, 0x4e,
-	0xe4, 0x80, 0x08, 0xb2, 0x5e, 0x09, 0x27, 0x18, 0xd1, 0x67, 0xe1, 0x88, 0xc8, 0x59, 0xf6, 0xdc,
-	0x2d, 0x67, 0xf8, 0x68, 0x6c, 0x5b, 0x21, 0x31, 0xfe, 0x96, 0xe3, 0x9d, 0xca, 0xc1, 0xe3, 0xb4,
-	0x5d, 0x4e, 0xdc, 0x2b, 0xe1, 0x2c, 0x0c, 0x74, 0x1b, 0x0e, 0x8a, 0x6c, 0x01, 0xfa, 0x77, 0x1c,
-	0xf4, 0xa5, 0x1c, 0x50, 0x89, 0xa6, 0xb3, 0xa1, 0xcf, 0xc1, 0x51, 0x91, 0x71, 0xcf, 0x71, 0x9f,
-	0x2c, 0x6f, 0x5b, 0xa3, 0x11, 0x71, 0x87, 0xc4, 0xf8, 0xfb, 0xc9, 0x75, 0xd4, 0x88, 0x7b, 0x25,
-	0x9c, 0x09, 0x82, 0x1e, 0x40, 0xc7, 0xdb, 0xfc, 0x22, 0x19, 0x44, 0x1d, 0xb2, 0x41, 0x42, 0xa3,
-	0xc3, 0x70, 0x5f, 0x49, 0xe0, 0x3e, 0x60, 0x64, 0x51, 0x57, 0x76, 0x37, 0x48, 0xd8, 0x2b, 0xe1,
-	0x14, 0x33, 0x7a, 0x04, 0x48, 0xcb, 0x5b, 0xdc, 0x21, 0xae, 0x6d, 0x5c, 0x64, 0x90, 0xaf, 0x4e,
-	0x86, 0x64, 0xa4, 0xbd, 0x12, 0xce, 0x00, 0x48, 0xc1, 0x3e, 0x72, 0x03, 0x12, 0x1a, 0x97, 0xa6,
-	0x81, 0x65, 0xa4, 0x29, 0x58, 0x96, 0x4b, 0xfb, 0x96, 0xe7, 0x62, 0x32, 0xb2, 0x42, 0xc7, 0x73,
-	0x45, 0x7d, 0x2f, 0x33, 0xe0, 0xd3, 0xd9, 0xc0, 0x92, 0x56, 0xd6, 0x38, 0x13, 0x04, 0xfd, 0x14,
-	0x3c, 0x97, 0xc8, 0xc7, 0x64, 0xc7, 0xdb, 0x23, 0xc6, 0xdb, 0x0c, 0xfd, 0x4c, 0x11, 0x3a, 0xa7,
-	0xee, 0x95, 0x70, 0x36, 0x0c, 0x5a, 0x82, 0x03, 0x51, 0x01, 0x83, 0xbd, 0xc2, 0x60, 0x8f, 0xe7,
-	0xc1, 0x0a, 0x30, 0x8d, 0x87, 0xda, 0x22, 0x4f, 0x2f, 0x8f, 0xbc, 0x80, 0x18, 0x8b, 0x99, 0xb6,
-	0x28, 0x20, 0x18, 0x09, 0xb5, 0x45, 0x85, 0x43, 0x6d, 0x64, 0x10, 0xfa, 0xce, 0x80, 0x55, 0x90,
-	0x6a, 0xd1, 0xd5, 0xc9, 0x8d, 0x8c, 0x89, 0x85, 0x2a, 0x65, 0xc3, 0x20, 0x0c, 0x87, 0x82, 0xdd,
-	0xcd, 0x60, 0xe0, 0x3b, 0x63, 0x9a, 0xb7, 0x68, 0xdb, 0xc6, 0xbb, 0x93, 0x90, 0x37, 0x14, 0xe2,
-	0xee, 0xa2, 0x4d, 0x47, 0x27, 0x09, 0x80, 0x3e, 0x07, 0x48, 0xcd, 0x12, 0xdd, 0x77, 0x9d, 0xc1,
-	0x7e, 0x62, 0x0a, 0x58, 0xd9, 0x97, 0x19, 0x30, 0xc8, 0x82, 0xa3, 0x6a, 0xee, 0xba, 0x17, 0x38,
-	0xf4, 0xd7, 0xb8, 0xc1, 0xe0, 0xdf, 0x98, 0x02, 0x3e, 0x62, 0xa1, 0x8a, 0x95, 0x05, 0x95, 0x14,
-	0xb1, 0x4c, 0xcd, 0x9a, 0xf8, 0x81, 0x71, 0x73, 0x6a, 0x11, 0x11, 0x4b, 0x52, 0x44, 0x94, 0x9f,
-	0xec, 0xa2, 0xf7, 0x7c, 0x6f, 0x77, 0x1c, 0x18, 0xb7, 0xa6, 0xee, 0x22, 0xce, 0x90, 0xec, 0x22,
-	0x9e, 0x8b, 0xae, 0x40, 0x6b, 0x73, 0xe4, 0x0d, 0x9e, 0xd0, 0xc1, 0xac, 0x30, 0x48, 0x23, 0x01,
-	0xb9, 0x44, 0x8b, 0xc5, 0xf0, 0x49, 0x5a, 0xaa, 0xac, 0xec, 0xff, 0x0a, 0x19, 0x91, 0x90, 0x88,
-	0x19, 0xeb, 0xc5, 0x4c, 0x56, 0x4e, 0x42, 0x95, 0x55, 0xe1, 0x40, 0x2b, 0x30, 0xb7, 0xe5, 0x8c,
-	0x48, 0xf0, 0x68, 0x3c, 0xf2, 0x2c, 0x3e, 0x7d, 0xcd, 0x5d, 0x3c, 0x99, 0x09, 0x70, 0x3b, 0xa6,
-	0xa3, 0x28, 0x0a, 0x1b, 0xba, 0x01, 0xed, 0x1d, 0xcb, 0x7f, 0x12, 0xf4, 0xdd, 0x2d, 0xcf, 0xa8,
-	0x67, 0x4e, 0x3c, 0x1c, 0xe3, 0x7e, 0x44, 0xd5, 0x2b, 0xe1, 0x98, 0x85, 0x4e, 0x5f, 0xac, 0x52,
-	0x1b, 0x24, 0xbc, 0xed, 0x90, 0x91, 0x1d, 0x18, 0x0d, 0x06, 0xf2, 0x72, 0x26, 0xc8, 0x06, 0x09,
-	0xbb, 0x9c, 0x8c, 0x4e, 0x5f, 0x3a, 0x23, 0x7a, 0x1f, 0x8e, 0x44, 0x39, 0xcb, 0xdb, 0xce, 0xc8,
-	0xf6, 0x89, 0xdb, 0xb7, 0x03, 0xa3, 0x99, 0x39, 0x33, 0xc4, 0x78, 0x0a, 0x2d, 0x9d, 0xbd, 0x32,
-	0x20, 0xa8, 0x67, 0x8c, 0xb2, 0x55, 0x93, 0x34, 0x5a, 0x99, 0x9e, 0x31, 0x86, 0x56, 0x89, 0xa9,
-	0x76, 0x65, 0x81, 0x20, 0x1b, 0x9e, 0x8f, 0xf2, 0x97, 0xac, 0xc1, 0x93, 0xa1, 0xef, 0xed, 0xba,
-	0xf6, 0xb2, 0x37, 0xf2, 0x7c, 0xa3, 0xcd, 0xf0, 0xcf, 0xe6, 0xe2, 0x27, 0xe8, 0x7b, 0x25, 0x9c,
-	0x07, 0x85, 0x96, 0xe1, 0x40, 0x54, 0xf4, 0x90, 0x3c, 0x0b, 0x0d, 0xc8, 0x9c, 0x7e, 0x63, 0x68,
-	0x4a, 0x44, 0x1d, 0xa4, 0xca, 0xa4, 0x82, 0x50, 0x95, 0x30, 0xe6, 0x0a, 0x40, 0x28, 0x91, 0x0a,
-	0x42, 0xd3, 0x2a, 0x08, 0x9d, 0x7e, 0x8d, 0x83, 0x05, 0x20, 0x94, 0x48, 0x05, 0xa1, 0x69, 0x3a,
-	0x55, 0xcb, 0x96, 0x7a, 0xde, 0x13, 0xaa, 0x4f, 0xc6, 0x7c, 0xe6, 0x54, 0xad, 0xf4, 0x96, 0x20,
-	0xa4, 0x53, 0x75, 0x92, 0x99, 0x2e, 0x50, 0xa2, 0xbc, 0xc5, 0x91, 0x33, 0x74, 0x8d, 0x43, 0x13,
-	0x74, 0x99, 0xa2, 0x31, 0x2a, 0xba, 0x40, 0xd1, 0xd8, 0xd0, 0x2d, 0x61, 0x96, 0x1b, 0x24, 0x5c,
-	0x71, 0xf6, 0x8c, 0xc3, 0x99, 0xd3, 0x50, 0x8c, 0xb2, 0xe2, 0xec, 0x49, 0xbb, 0xe4, 0x2c, 0x6a,
-	0xd3, 0xa2, 0x49, 0xce, 0x78, 0xae, 0xa0, 0x69, 0x11, 0xa1, 0xda, 0xb4, 0x28, 0x4f, 0x6d, 0xda,
-	0x3d, 0x2b, 0x24, 0xcf, 0x8c, 0x17, 0x0a, 0x9a, 0xc6, 0xa8, 0xd4, 0xa6, 0xb1, 0x0c, 0x3a, 0xbb,
-	0x45, 0x19, 0x8f, 0x89, 0x1f, 0x3a, 0x03, 0x6b, 0xc4, 0xbb, 0xea, 0x54, 0xe6, 0x1c, 0x14, 0xe3,
-	0x69, 0xd4, 0x74, 0x76, 0xcb, 0x84, 0x51, 0x1b, 0xfe, 0xd0, 0xda, 0x1c, 0x11, 0xec, 0x3d, 0x35,
-	0x4e, 0x17, 0x34, 0x3c, 0x22, 0x54, 0x1b, 0x1e, 0xe5, 0xa9, 0xbe, 0xe5, 0x33, 0x8e, 0x3d, 0x24,
-	0xa1, 0x71, 0xb6, 0xc0, 0xb7, 0x70, 0x32, 0xd5, 0xb7, 0xf0, 0x1c, 0xe9, 0x01, 0x56, 0xac, 0xd0,
-	0xda, 0x73, 0xc8, 0xd3, 0xc7, 0x0e, 0x79, 0x4a, 0x27, 0xf6, 0x23, 0x13, 0x3c, 0x40, 0x44, 0xdb,
-	0x15, 0xc4, 0xd2, 0x03, 0x24, 0x40, 0xa4, 0x07, 0x50, 0xf3, 0x85, 0x5b, 0x3f, 0x3a, 0xc1, 0x03,
-	0x68, 0xf8, 0xd2, 0xc7, 0xe7, 0x41, 0x21, 0x0b, 0x8e, 0xa5, 0x8a, 0x1e, 0xf8, 0x36, 0xf1, 0x8d,
-	0x97, 0x98, 0x90, 0xd7, 0x8a, 0x85, 0x30, 0xf2, 0x5e, 0x09, 0xe7, 0x00, 0xa5, 0x44, 0x6c, 0x78,
-	0xbb, 0xfe, 0x80, 0xd0, 0x7e, 0x7a, 0x75, 0x1a, 0x11, 0x92, 0x3c, 0x25, 0x42, 0x96, 0xa0, 0x3d,
-	0x78, 0x49, 0x96, 0x50, 0xc1, 0x6c, 0x16, 0x65, 0xd2, 0xc5, 0xc6, 0xe2, 0x0c, 0x93, 0xd4, 0x9d,
-	0x2c, 0x29, 0xc9, 0xd5, 0x2b, 0xe1, 0xc9, 0xb0, 0x68, 0x1f, 0x4e, 0x68, 0x04, 0x7c, 0x9e, 0x57,
-	0x05, 0xbf, 0xc6, 0x04, 0x9f, 0x9f, 0x2c, 0x38, 0xc5, 0xd6, 0x2b, 0xe1, 0x02, 0x60, 0x34, 0x86,
-	0x17, 0xb5, 0xce, 0x88, 0x0c, 0x5b, 0xa8, 0xc8, 0x57, 0x99, 0xdc, 0x73, 0x93, 0xe5, 0xea, 0x3c,
-	0xbd, 0x12, 0x9e, 0x04, 0x89, 0x86, 0x60, 0x64, 0x16, 0xd3, 0x91, 0xfc, 0x4a, 0xe6, 0xb2, 0x27,
-	0x47, 0x1c, 0x1f, 0xcb, 0x5c, 0xb0, 0x4c, 0xcd, 0x17, 0xdd, 0xf9, 0xd3, 0xd3, 0x6a, 0xbe, 0xec,
-	0xc7, 0x3c, 0x28, 0x6d, 0xec, 0x68, 0xd1, 0x43, 0xcb, 0x1f, 0x92, 0x90, 0x77, 0x74, 0xdf, 0xa6,
-	0x8d, 0xfa, 0xda, 0x34, 0x63, 0x97, 0x62, 0xd3, 0xc6, 0x2e, 0x13, 0x18, 0x05, 0x70, 0x5c, 0xa3,
-	0xe8, 0x07, 0xcb, 0xde, 0x68, 0x44, 0x06, 0x51, 0x6f, 0xfe, 0x0c, 0x13, 0xfc, 0xe6, 0x64, 0xc1,
-	0x09, 0xa6, 0x5e, 0x09, 0x4f, 0x04, 0x4d, 0xb5, 0xf7, 0xc1, 0xc8, 0x4e, 0xe8, 0x8c, 0x31, 0x95,
-	0xae, 0x26, 0xd9, 0x52, 0xed, 0x4d, 0x51, 0xa4, 0x74, 0x55, 0xa1, 0xa0, 0xcd, 0x7d, 0x7e, 0x1a,
-	0x5d, 0xd5, 0x79, 0x52, 0xba, 0xaa, 0x17, 0xd3, 0xd9, 0x6d, 0x37, 0x20, 0x3e, 0xc3, 0xb8, 0xe3,
-	0x39, 0xae, 0xf1, 0x72, 0xe6, 0xec, 0xf6, 0x28, 0x20, 0xbe, 0x10, 0x44, 0xa9, 0xe8, 0xec, 0xa6,
-	0xb1, 0x69, 0x38, 0xf7, 0xc8, 0x56, 0x68, 0x9c, 0x2c, 0xc2, 0xa1, 0x54, 0x1a, 0x0e, 0xcd, 0xa0,
-	0x33, 0x85, 0xcc, 0xd8, 0x20, 0x74, 0x54, 0xb0, 0xe5, 0x0e, 0x89, 0xf1, 0x4a, 0xe6, 0x4c, 0xa1,
-	0xc0, 0x29, 0xc4, 0x74, 0xa6, 0xc8, 0x02, 0xa1, 0x3b, 0x7f, 0x99, 0x4f, 0x57, 0x64, 0x1c, 0x7a,
-	0x21, 0x73, 0xe7, 0xaf, 0x40, 0x4b, 0x52, 0xba, 0x07, 0x49, 0x03, 0xa0, 0x4f, 0x40, 0x6d, 0xec,
-	0xb8, 0x43, 0xc3, 0x66, 0x40, 0x47, 0x12, 0x40, 0xeb, 0x8e, 0x3b, 0xec, 0x95, 0x30, 0x23, 0x41,
-	0xef, 0x02, 0x8c, 0x7d, 0x6f, 0x40, 0x82, 0x60, 0x8d, 0x3c, 0x35, 0x08, 0x63, 0x30, 0x93, 0x0c,
-	0x9c, 0xa0, 0xbb, 0x46, 0xe8, 0xbc, 0xac, 0xd0, 0xa3, 0x55, 0x38, 0x28, 0x52, 0xc2, 0xca, 0xb7,
-	0x32, 0x17, 0x7f, 0x11, 0x40, 0x1c, 0x05, 0xd2, 0xb8, 0xe8, 0xde, 0x47, 0x64, 0xac, 0x78, 0x2e,
-	0x31, 0x86, 0x99, 0x7b, 0x9f, 0x08, 0x84, 0x92, 0xd0, 0x35, 0x96, 0xc2, 0x81, 0x96, 0xe0, 0x40,
-	0xb8, 0xed, 0x13, 0xcb, 0xde, 0x08, 0xad, 0x70, 0x37, 0x30, 0xdc, 0xcc, 0x65, 0x1a, 0x2f, 0xec,
-	0x3e, 0x64, 0x94, 0x74, 0x09, 0xaa, 0xf2, 0xa0, 0x35, 0xe8, 0xd0, 0x8d, 0xd0, 0x3d, 0x67, 0xc7,
-	0x09, 0x31, 0xb1, 0x06, 0xdb, 0xc4, 0x36, 0xbc, 0xcc, 0x4d, 0x14, 0x5d, 0xf6, 0x76, 0x55, 0x3a,
-	0xba, 0x5a, 0x49, 0xf2, 0xa2, 0x1e, 0xcc, 0xd3, 0xbc, 0x8d, 0xb1, 0x35, 0x20, 0x8f, 0x02, 0x6b,
-	0x48, 0x8c, 0x71, 0xa6, 0x06, 0x32, 0xb4, 0x98, 0x8a, 0x2e, 0x56, 0x74, 0xbe, 0x08, 0xe9, 0x9e,
-	0x37, 0xb0, 0x46, 0x1c, 0xe9, 0x4b, 0xf9, 0x48, 0x31, 0x55, 0x84, 0x14, 0xe7, 0x68, 0x6d, 0xe4,
-	0x7d, 0x6f, 0x1b, 0x7b, 0x05, 0x6d, 0x14, 0x74, 0x5a, 0x1b, 0x45, 0x1e, 0xc5, 0x73, 0xbd, 0xd0,
-	0xd9, 0x72, 0x06, 0xc2, 0x7e, 0x5d, 0xdb, 0xf0, 0x33, 0xf1, 0xd6, 0x14, 0xb2, 0xee, 0x06, 0x8f,
-	0x2c, 0xa5, 0x78, 0xd1, 0x43, 0x40, 0x6a, 0x9e, 0x50, 0xaa, 0x80, 0x21, 0x2e, 0x4c, 0x42, 0x94,
-	0x9a, 0x95, 0xc1, 0x4f, 0x6b, 0x39, 0xb6, 0xf6, 0xe9, 0xf6, 0x76, 0xc9, 0xf7, 0x2c, 0x7b, 0x60,
-	0x05, 0xa1, 0x11, 0x66, 0xd6, 0x72, 0x9d, 0x93, 0x75, 0x25, 0x1d, 0xad, 0x65, 0x92, 0x97, 0xe2,
-	0xed, 0x90, 0x9d, 0x4d, 0xe2, 0x07, 0xdb, 0xce, 0x58, 0xd4, 0x71, 0x37, 0x13, 0xef, 0xbe, 0x24,
-	0x8b, 0x6b, 0x98, 0xe2, 0xa5, 0x0b, 0x71, 0x16, 0x3e, 0xde, 0xd8, 0x77, 0x07, 0x5c, 0x19, 0x05,
-	0xe8, 0xd3, 0xcc, 0x85, 0x38, 0xd3, 0x8c, 0x6e, 0x4c, 0x1c, 0x43, 0x67, 0xc3, 0xa0, 0xbb, 0x70,
-	0x68, 0x7c, 0x71, 0xac, 0x21, 0x3f, 0xcb, 0x5c, 0x38, 0xaf, 0x5f, 0x5c, 0x4f, 0x42, 0x26, 0x39,
-	0xa9, 0xa9, 0x39, 0x3b, 0x63, 0xcf, 0x0f, 0x6f, 0x3b, 0xae, 0x13, 0x6c, 0x1b, 0xfb, 0x99, 0xa6,
-	0xd6, 0x67, 0x24, 0x5d, 0x4e, 0x43, 0x4d, 0x4d, 0xe5, 0x41, 0x97, 0xa1, 0x39, 0xd8, 0xb6, 0xc2,
-	0x45, 0xdb, 0x36, 0xbe, 0xce, 0x03, 0xbd, 0xcf, 0x27, 0xf8, 0x97, 0xb7, 0xad, 0x50, 0x84, 0x48,
-	0x22, 0x52, 0x74, 0x1d, 0x80, 0xfe, 0x15, 0x2d, 0xf8, 0xd9, 0x72, 0xa6, 0xaf, 0x62, 0x8c, 0xb2,
-	0xf6, 0x0a, 0x03, 0x7a, 0x1f, 0x8e, 0xc4, 0x29, 0x6a, 0xa4, 0x7c, 0xcf, 0xff, 0x8d, 0x72, 0xa6,
-	0xb7, 0x55, 0x70, 0x24, 0x6d, 0xaf, 0x84, 0xb3, 0x20, 0xa2, 0x8a, 0x89, 0xb9, 0xf8, 0x9b, 0x13,
-	0x2a, 0x26, 0xe7, 0x5d, 0x85, 0x61, 0xa9, 0x09, 0xf5, 0x3d, 0x6b, 0xb4, 0x4b, 0xcc, 0x6f, 0x57,
-	0xa0, 0x46, 0xc9, 0x4c, 0x02, 0x55, 0xda, 0xe0, 0x79, 0xa8, 0x38, 0xb6, 0xc1, 0x0f, 0x18, 0x2a,
-	0x8e, 0x8d, 0x0c, 0x68, 0x7a, 0x74, 0x1d, 0x29, 0x8f, 0x3b, 0xa2, 0x24, 0xed, 0x50, 0x71, 0x2c,
-	0x22, 0x02, 0x47, 0x66, 0xe2, 0xa8, 0x83, 0xc2, 0x46, 0x27, 0x28, 0x11, 0xa9, 0x69, 0x40, 0x43,
-	0x4c, 0xf3, 0x09, 0x49, 0xe6, 0x1a, 0x34, 0x44, 0xaf, 0x25, 0xeb, 0xa0, 0x48, 0xaa, 0x4c, 0x2f,
-	0x89, 0xc0, 0xa1, 0x64, 0xa7, 0x25, 0x81, 0x97, 0xa0, 0xed, 0xcb, 0x41, 0xa9, 0x24, 0x62, 0x3c,
-	0x29, 0xe8, 0xae, 0x04, 0xc2, 0x31, 0x9b, 0xf9, 0x47, 0x75, 0x68, 0x8a, 0x23, 0x02, 0x73, 0x0d,
-	0x6a, 0xec, 0x3c, 0xe5, 0x28, 0xd4, 0x1d, 0xd7, 0x26, 0xcf, 0x98, 0xa8, 0x3a, 0xe6, 0x09, 0x74,
-	0x01, 0x9a, 0xe2, 0xc8, 0x40, 0xc8, 0xca, 0x3b, 0x1b, 0x8a, 0xc8, 0xcc, 0x0f, 0xa0, 0x19, 0x9d,
-	0xab, 0x1c, 0x87, 0xf6, 0xd8, 0xf7, 0xa8, 0x33, 0xec, 0x47, 0x2d, 0x88, 0x33, 0xd0, 0x5b, 0xd0,
-	0xb4, 0xc5, 0xc9, 0x4d, 0x45, 0xe8, 0x36, 0x3f, 0x05, 0xeb, 0x46, 0xa7, 0x60, 0xdd, 0x0d, 0x76,
-	0x0a, 0x86, 0x23, 0x3a, 0xf3, 0xeb, 0x65, 0x68, 0xf0, 0xe3, 0x15, 0x73, 0x4f, 0xf6, 0xfc, 0xdb,
-	0xd0, 0x18, 0xb0, 0x3c, 0x23, 0x79, 0xb4, 0xa2, 0xd5, 0x50, 0x9c, 0xd7, 0x60, 0x41, 0x4c, 0xd9,
-	0x02, 0x3e, 0x09, 0x56, 0x26, 0xb2, 0x71, 0xa3, 0xc6, 0x82, 0xf8, 0x7f, 0x4c, 0xee, 0x7f, 0x96,
-	0xe1, 0xa0, 0x7e, 0x6a, 0x73, 0x1c, 0xda, 0x03, 0x79, 0x0e, 0x24, 0x7a, 0x77, 0xa0, 0x9c, 0xe9,
-	0xc0, 0x60, 0xe4, 0x10, 0x37, 0x64, 0x01, 0xca, 0x4a, 0xe6, 0xba, 0x37, 0xf3, 0x94, 0xa8, 0xbb,
-	0x2c, 0xd9, 0xb0, 0x02, 0x61, 0x7e, 0x0d, 0x20, 0x2e, 0x41, 0x27, 0xe5, 0x4a, 0x64, 0xcd, 0xda,
-	0x89, 0xc4, 0xab, 0x59, 0x0a, 0xc5, 0xba, 0x15, 0x6e, 0x0b, 0x43, 0x54, 0xb3, 0xd0, 0x39, 0x38,
-	0x1c, 0x38, 0x43, 0xd7, 0x0a, 0x77, 0x7d, 0xf2, 0x98, 0xf8, 0xce, 0x96, 0x43, 0x6c, 0x66, 0x96,
-	0x2d, 0x9c, 0x2e, 0x30, 0x7f, 0xa1, 0x0d, 0x0d, 0xbe, 0xc3, 0x30, 0xff, 0xbd, 0x22, 0x75, 0xcc,
-	0xfc, 0x8b, 0x32, 0xd4, 0xf9, 0x49, 0x4b, 0xd2, 0x50, 0x6e, 0xab, 0xfa, 0x55, 0xcd, 0x58, 0x7e,
-	0x67, 0x9d, 0x3c, 0x75, 0xef, 0x92, 0xfd, 0xc7, 0xd4, 0xc9, 0x48, 0xa5, 0x43, 0xc7, 0xa0, 0x11,
-	0xec, 0x6e, 0xf6, 0xed, 0xc0, 0xa8, 0x9e, 0xac, 0x9e, 0x6d, 0x63, 0x91, 0x32, 0xef, 0x40, 0x2b,
-	0x22, 0x46, 0x1d, 0xa8, 0x3e, 0x21, 0xfb, 0x42, 0x38, 0xfd, 0x8b, 0xce, 0x09, 0x67, 0x25, 0xcd,
-	0x26, 0xa9, 0xdb, 0x5c, 0x8a, 0xf0, 0x68, 0x5f, 0x80, 0x2a, 0x5d, 0xd3, 0x27, 0x9b, 0x30, 0xbb,
-	0x89, 0xe4, 0xd6, 0x76, 0x19, 0xea, 0xfc, 0xb4, 0x2b, 0x29, 0x03, 0x41, 0xed, 0x09, 0xd9, 0xe7,
-	0x7d, 0xd4, 0xc6, 0xec, 0x7f, 0x2e, 0xc8, 0x9f, 0x57, 0xe1, 0x80, 0x1a, 0xe1, 0x37, 0x57, 0x73,
-	0x1d, 0xb0, 0xb5, 0x15, 0xaa, 0x0e, 0x58, 0x24, 0xa9, 0x97, 0x61, 0x58, 0x6c, 0x9c, 0xdb, 0x98,
-	0x27, 0xcc, 0x2e, 0x34, 0xc4, 0xc1, 0x49, 0x12, 0x49, 0xd2, 0x57, 0x54, 0xfa, 0x3b, 0xd0, 0x92,
-	0xe7, 0x20, 0x1f, 0x55, 0xb6, 0x0f, 0x2d, 0x79, 0xe0, 0x71, 0x14, 0xea, 0xa1, 0x17, 0x5a, 0x23,
-	0x06, 0x57, 0xc5, 0x3c, 0x41, 0x0d, 0xcd, 0x25, 0xcf, 0xc2, 0x65, 0xe9, 0x05, 0xab, 0x38, 0xce,
-	0xe0, 0x4e, 0x8e, 0xec, 0xf1, 0xd2, 0x2a, 0x2f, 0x95, 0x19, 0xb1, 0xcc, 0x9a, 0x2a, 0x73, 0x1f,
-	0x1a, 0xe2, 0x14, 0x44, 0x96, 0x97, 0x95, 0x72, 0xb4, 0x08, 0xf5, 0x21, 0x2d, 0x17, 0xa3, 0xfe,
-	0x46, 0xc2, 0x45, 0xf0, 0xcd, 0xcd, 0xb2, 0xe7, 0x86, 0x54, 0x8d, 0xf5, 0xe0, 0x0e, 0xe6, 0x9c,
-	0x74, 0x08, 0x7d, 0x7e, 0xa4, 0xc5, 0x2d, 0x4a, 0xa4, 0xcc, 0xdf, 0x2d, 0x43, 0x5b, 0x9e, 0x21,
-	0x9a, 0x1f, 0xe4, 0x19, 0xcf, 0x22, 0x1c, 0xf4, 0x05, 0x15, 0xf5, 0x0e, 0x91, 0x09, 0xbd, 0x98,
-	0xa8, 0x09, 0x56, 0x68, 0xb0, 0xce, 0x61, 0xbe, 0x9b, 0x3b, 0xa8, 0x0b, 0x70, 0x20, 0x22, 0xbd,
-	0x1b, 0xab, 0x9e, 0x96, 0x67, 0x9a, 0x92, 0xbb, 0x03, 0x55, 0xc7, 0xe6, 0xb7, 0x1d, 0xda, 0x98,
-	0xfe, 0x35, 0xb7, 0xe0, 0x80, 0x7a, 0x92, 0x60, 0x3e, 0xce, 0xb6, 0x9e, 0x9b, 0x54, 0x8c, 0x72,
-	0x6a, 0x51, 0x49, 0x6c, 0x97, 0xa2, 0x26, 0xc4, 0x24, 0x58, 0x63, 0x30, 0x9f, 0x87, 0x3a, 0x3f,
-	0xdf, 0x4c, 0x4e, 0xfb, 0xbf, 0x39, 0x80, 0x3a, 0x1b, 0x04, 0xf3, 0x12, 0x37, 0x80, 0x73, 0xd0,
-	0x60, 0x7b, 0xf5, 0xe8, 0x52, 0xc6, 0xd1, 0xac, 0x11, 0xc3, 0x82, 0xc6, 0x5c, 0x86, 0x39, 0xe5,
-	0x64, 0x89, 0x6a, 0x2c, 0x2b, 0x90, 0x5a, 0x10, 0x25, 0x91, 0x09, 0x2d, 0x3a, 0x59, 0x0a, 0x07,
-	0x4a, 0xdb, 0x2f, 0xd3, 0xe6, 0x29, 0xb9, 0x28, 0x31, 0xc5, 0x49, 0x5a, 0x5f, 0xf6, 0x92, 0x4c,
-	0x9b, 0x9f, 0x87, 0xb6, 0x3c, 0x80, 0x42, 0x0f, 0xe0, 0x80, 0x38, 0x80, 0xe2, 0xfb, 0x67, 0x4a,
-	0x3c, 0x5f, 0xa0, 0x5d, 0x74, 0xb3, 0xcc, 0xce, 0xb0, 0xba, 0x0f, 0xf7, 0xc7, 0x04, 0x6b, 0x00,
-	0xe6, 0xcf, 0x9d, 0x65, 0x3d, 0x6f, 0x8e, 0xa1, 0x25, 0xa3, 0xee, 0xc9, 0x51, 0xb8, 0xca, 0x5d,
-	0x63, 0xa5, 0xf0, 0xc8, 0x88, 0xf3, 0x53, 0x07, 0xcc, 0x3c, 0xa8, 0xf9, 0x22, 0x54, 0xef, 0x92,
-	0x7d, 0x6a, 0x21, 0xdc, 0x91, 0x0a, 0x0b, 0xe1, 0x0e, 0xb3, 0x0f, 0x0d, 0x71, 0xfa, 0x95, 0x94,
-	0x77, 0x1e, 0x1a, 0x5b, 0xfc, 0x40, 0xad, 0xc0, 0x65, 0x0a, 0x32, 0xf3, 0x26, 0xcc, 0xa9, 0x67,
-	0x5e, 0x49, 0xbc, 0x93, 0x30, 0x37, 0x50, 0x4e, 0xd5, 0xf8, 0x30, 0xa8, 0x59, 0x26, 0xd1, 0xd5,
-	0x31, 0x85, 0xb0, 0x9a, 0xa9, 0x87, 0xaf, 0x64, 0x76, 0xfb, 0x04, 0x6d, 0xbc, 0x0b, 0x87, 0x92,
-	0x87, 0x5b, 0x49, 0x49, 0x67, 0xe1, 0xd0, 0x66, 0xe2, 0x28, 0x8d, 0xfb, 0xc0, 0x64, 0xb6, 0xd9,
-	0x87, 0x3a, 0x3f, 0x7c, 0x48, 0x42, 0x5c, 0x80, 0xba, 0xc5, 0x0e, 0x37, 0x28, 0xe3, 0x7c, 0x6a,
-	0xd5, 0x2a, 0xce, 0x64, 0x29, 0x05, 0xe6, 0x84, 0xa6, 0x03, 0x07, 0xf5, 0xf3, 0x8c, 0x24, 0x64,
-	0x0f, 0x0e, 0xee, 0x69, 0xe7, 0x26, 0x1c, 0x7a, 0x21, 0x13, 0x5a, 0x83, 0xc2, 0x3a, 0xa3, 0xf9,
-	0x8d, 0x06, 0xd4, 0xd8, 0x81, 0x5c, 0x52, 0xc4, 0x15, 0xa8, 0x85, 0xe4, 0x59, 0xb4, 0x46, 0x5d,
-	0x98, 0x78, 0xba, 0xc7, 0xa3, 0x42, 0x8c, 0x1e, 0x7d, 0x12, 0xea, 0x41, 0xb8, 0x3f, 0x8a, 0x76,
-	0x03, 0xaf, 0x4e, 0x66, 0xdc, 0xa0, 0xa4, 0x98, 0x73, 0x50, 0x56, 0x66, 0x0b, 0xe2, 0x00, 0xb9,
-	0x80, 0x95, 0x19, 0x21, 0xe6, 0x1c, 0xe8, 0x26, 0xdd, 0xd6, 0x91, 0xc1, 0x13, 0x62, 0x8b, 0x93,
-	0xe3, 0xd3, 0x93, 0x99, 0x97, 0x39, 0x31, 0x8e, 0xb8, 0xa8, 0xec, 0x01, 0x1b, 0xdd, 0xc6, 0x34,
-	0xb2, 0xd9, 0x88, 0x63, 0xce, 0x81, 0x56, 0xa1, 0xed, 0x0c, 0x3c, 0x77, 0x75, 0xc7, 0xfb, 0xa2,
-	0x23, 0x8e, 0x88, 0x5f, 0x9b, 0xcc, 0xde, 0x8f, 0xc8, 0x71, 0xcc, 0x19, 0xc1, 0xf4, 0x77, 0xe8,
-	0x06, 0xa7, 0x35, 0x2d, 0x0c, 0x23, 0xc7, 0x31, 0xa7, 0x79, 0x5c, 0x8c, 0x67, 0xb6, 0x91, 0xdf,
-	0x86, 0x3a, 0xeb, 0x72, 0x74, 0x5d, 0x2d, 0x9e, 0x57, 0x24, 0xe5, 0x7a, 0x2c, 0x31, 0x54, 0x12,
-	0x87, 0xf5, 0xbf, 0x8e, 0x33, 0x37, 0x0d, 0x8e, 0x18, 0x37, 0x8e, 0xf3, 0x32, 0x34, 0xc5, 0x50,
-	0xe8, 0x15, 0x6e, 0x45, 0x04, 0x2f, 0x41, 0x9d, 0x1b, 0x66, 0x76, 0x7b, 0x5e, 0x81, 0xb6, 0xec,
-	0xcc, 0xc9, 0x24, 0xac, 0x77, 0x72, 0x48, 0x7e, 0xbe, 0x02, 0x75, 0x7e, 0x30, 0x99, 0x76, 0xb5,
-	0xaa, 0x15, 0xbc, 0x3a, 0xf9, 0x9c, 0x53, 0x35, 0x83, 0xdb, 0x6c, 0xa3, 0x46, 0x17, 0xe6, 0xf2,
-	0x0e, 0xe0, 0xd9, 0x02, 0xee, 0xf5, 0x88, 0x1e, 0xc7, 0xac, 0x05, 0xc3, 0xf9, 0x00, 0xda, 0x92,
-	0x0b, 0x2d, 0xe9, 0x43, 0x7a, 0x6e, 0xe2, 0x50, 0x24, 0x45, 0x0a, 0xc0, 0x5f, 0x2b, 0x43, 0x75,
-	0xc5, 0xd9, 0x4b, 0xf5, 0xc3, 0x3b, 0x91, 0x55, 0x17, 0xb9, 0x83, 0x15, 0x67, 0x4f, 0x33, 0x6a,
-	0x73, 0x35, 0xd2, 0xb8, 0x77, 0xf5, 0xea, 0x9d, 0x99, 0xbc, 0x02, 0x8b, 0x61, 0x78, 0xc5, 0x7e,
-	0xb9, 0x09, 0x35, 0x76, 0xe6, 0x9f, 0xe5, 0xa7, 0xf6, 0xc7, 0xc5, 0x15, 0x63, 0x51, 0x45, 0x36,
-	0xe1, 0x32, 0x7a, 0xee, 0xa7, 0xac, 0xb0, 0xd8, 0x4f, 0xf1, 0x20, 0x29, 0x25, 0xc5, 0x9c, 0x83,
-	0x8a, 0xdc, 0x71, 0x76, 0x88, 0x70, 0x53, 0x05, 0x22, 0xef, 0x3b, 0x3b, 0x04, 0x33, 0x7a, 0xca,
-	0xb7, 0x6d, 0x05, 0xdb, 0xc2, 0x43, 0x15, 0xf0, 0xf5, 0xac, 0x60, 0x1b, 0x33, 0x7a, 0xca, 0xe7,
-	0xd2, 0x2d, 0x61, 0x63, 0x1a, 0x3e, 0xba, 0x53, 0xc4, 0x8c, 0x9e, 0xf2, 0x05, 0xce, 0x97, 0x89,
-	0xf0, 0x49, 0x05, 0x7c, 0x1b, 0xce, 0x97, 0x09, 0x66, 0xf4, 0xb1, 0x0b, 0x6f, 0x4d, 0xd7, 0x35,
-	0x8a, 0x0b, 0x7f, 0x08, 0xf3, 0xa1, 0x76, 0x72, 0x25, 0x2e, 0x9e, 0x9c, 0x2b, 0x18, 0x17, 0x8d,
-	0x07, 0x27, 0x30, 0xa8, 0x11, 0xb0, 0x0d, 0x70, 0xb6, 0x11, 0xbc, 0x04, 0xf5, 0xcf, 0x38, 0x76,
-	0xb8, 0xad, 0x17, 0xd7, 0x35, 0x97, 0x47, 0x87, 0x6d, 0x26, 0x97, 0xa7, 0x8e, 0x3a, 0xc7, 0x59,
-	0x81, 0x1a, 0x55, 0x9f, 0xd9, 0xf4, 0x38, 0xd6, 0xba, 0x8f, 0xe4, 0x80, 0xd5, 0x8e, 0xe6, 0x38,
-	0xc7, 0xa1, 0x46, 0x35, 0x24, 0xa7, 0x4b, 0x8e, 0x43, 0x8d, 0xea, 0x5d, 0x7e, 0x29, 0x1d, 0x6d,
-	0xbd, 0xb4, 0x1a, 0x95, 0x9e, 0x81, 0x79, 0x7d, 0x38, 0x72, 0x50, 0xbe, 0xdd, 0x84, 0x1a, 0xbb,
-	0x40, 0x93, 0xb4, 0xc8, 0x4f, 0xc3, 0x41, 0x3e, 0x7e, 0x4b, 0x62, 0x09, 0x5e, 0xc9, 0xbc, 0x3f,
-	0xa7, 0x5f, 0xcb, 0x11, 0x2a, 0x20, 0x58, 0xb0, 0x8e, 0x30, 0xfd, 0xa2, 0x82, 0x41, 0x69, 0x1a,
-	0xf9, 0xae, 0x5c, 0xbc, 0xd6, 0x0a, 0x6e, 0x6f, 0x31, 0x5e, 0xbe, 0x04, 0x8e, 0x56, 0xb2, 0x68,
-	0x09, 0x5a, 0x74, 0x6a, 0xa5, 0xdd, 0x25, 0xcc, 0xf6, 0xcc, 0x64, 0xfe, 0xbe, 0xa0, 0xc6, 0x92,
-	0x8f, 0x4e, 0xec, 0x03, 0xcb, 0xb7, 0x59, 0xad, 0x84, 0x0d, 0xbf, 0x36, 0x19, 0x64, 0x39, 0x22,
-	0xc7, 0x31, 0x27, 0xba, 0x0b, 0x73, 0x36, 0x91, 0x71, 0x02, 0x61, 0xd4, 0x9f, 0x98, 0x0c, 0xb4,
-	0x12, 0x33, 0x60, 0x95, 0x9b, 0xd6, 0x29, 0xda, 0x1b, 0x06, 0x85, 0x8b, 0x0d, 0x06, 0x15, 0xdf,
-	0x92, 0x8d, 0x39, 0xcd, 0xd3, 0x70, 0x50, 0x1b, 0xb7, 0x8f, 0x75, 0xd5, 0xa1, 0x8e, 0x25, 0xc7,
-	0xb9, 0x2a, 0xb7, 0x28, 0x6f, 0xea, 0xcb, 0x8e, 0xdc, 0x1d, 0x89, 0x60, 0xbc, 0x07, 0xad, 0x68,
-	0x60, 0xd0, 0x2d, 0xbd, 0x0e, 0xaf, 0x17, 0xd7, 0x41, 0x8e, 0xa9, 0x40, 0x5b, 0x83, 0xb6, 0x1c,
-	0x21, 0xb4, 0xa8, 0xc3, 0xbd, 0x51, 0x0c, 0x17, 0x8f, 0xae, 0xc0, 0xc3, 0x30, 0xa7, 0x0c, 0x14,
-	0x5a, 0xd6, 0x11, 0xdf, 0x2c, 0x46, 0x54, 0x87, 0x39, 0x5e, 0xf5, 0xc8, 0x11, 0x53, 0x47, 0xa5,
-	0x1a, 0x8f, 0xca, 0x1f, 0x36, 0xa1, 0x25, 0x2f, 0xad, 0x65, 0xec, 0x31, 0x77, 0xfd, 0x51, 0xe1,
-	0x1e, 0x33, 0xe2, 0xef, 0x3e, 0xf2, 0x47, 0x98, 0x72, 0xd0, 0x21, 0x0e, 0x9d, 0x50, 0x9a, 0xea,
-	0x6b, 0xc5, 0xac, 0x0f, 0x29, 0x39, 0xe6, 0x5c, 0xe8, 0x81, 0xae, 0xe5, 0xb5, 0x09, 0x97, 0x1a,
-	0x34, 0x90, 0x5c, 0x4d, 0xef, 0x43, 0xdb, 0xa1, 0x4b, 0xbf, 0x5e, 0x3c, 0xf3, 0xbe, 0x51, 0x0c,
-	0xd7, 0x8f, 0x58, 0x70, 0xcc, 0x4d, 0xeb, 0xb6, 0x65, 0xed, 0x51, 0xbb, 0x66, 0x60, 0x8d, 0x69,
-	0xeb, 0x76, 0x3b, 0x66, 0xc2, 0x2a, 0x02, 0xba, 0x26, 0xd6, 0x2e, 0xcd, 0x02, 0xcf, 0x12, 0x77,
-	0x55, 0xbc, 0x7e, 0x79, 0x3f, 0x35, 0xd3, 0x72, 0x33, 0xbe, 0x30, 0x05, 0xca, 0xc4, 0xd9, 0x96,
-	0x8e, 0x20, 0x5f, 0x19, 0xb5, 0xa7, 0x1d, 0x41, 0x75, 0x75, 0x64, 0xbe, 0x08, 0xd5, 0x47, 0xfe,
-	0x28, 0x7f, 0xae, 0x66, 0xc3, 0x9d, 0x53, 0xfc, 0xaa, 0x6e, 0x09, 0xf9, 0x0b, 0x7a, 0x39, 0x26,
-	0xb9, 0x38, 0x4a, 0xa7, 0xe7, 0x10, 0x5d, 0x17, 0x13, 0xfa, 0xdb, 0xba, 0xbd, 0xbd, 0x9c, 0xb0,
-	0x37, 0x6a, 0x61, 0xeb, 0x3e, 0xe1, 0xf7, 0x76, 0x94, 0x99, 0x7c, 0xda, 0x79, 0xf2, 0x4e, 0xb4,
-	0xfe, 0x98, 0xc9, 0x53, 0x24, 0xfb, 0x96, 0x63, 0x7d, 0xab, 0x0c, 0x2d, 0x79, 0x27, 0x31, 0x1d,
-	0x9d, 0x6f, 0x39, 0x41, 0x8f, 0x58, 0x36, 0xf1, 0x85, 0xdd, 0xbe, 0x5e, 0x78, 0xd9, 0xb1, 0xdb,
-	0x17, 0x1c, 0x58, 0xf2, 0x9a, 0x27, 0xa1, 0x15, 0xe5, 0xe6, 0x6c, 0xca, 0xbe, 0x5f, 0x81, 0x86,
-	0xb8, 0xcd, 0x98, 0xac, 0xc4, 0x0d, 0x68, 0x8c, 0xac, 0x7d, 0x6f, 0x37, 0xda, 0x32, 0x9d, 0x29,
-	0xb8, 0x20, 0xd9, 0xbd, 0xc7, 0xa8, 0xb1, 0xe0, 0x42, 0x9f, 0x82, 0xfa, 0xc8, 0xd9, 0x71, 0x42,
-	0xe1, 0x3e, 0x4e, 0x17, 0xb2, 0xb3, 0x7b, 0x0f, 0x9c, 0x87, 0x0a, 0x67, 0x97, 0x98, 0xa2, 0x2b,
-	0xe8, 0x85, 0xc2, 0x1f, 0x33, 0x6a, 0x2c, 0xb8, 0xcc, 0x3b, 0xd0, 0xe0, 0xd5, 0x99, 0x6d, 0x92,
-	0xd0, 0x5b, 0x12, 0x6b, 0x3a, 0xab, 0x5b, 0xce, 0xaa, 0xf4, 0x04, 0x34, 0xb8, 0xf0, 0x1c, 0xad,
-	0xf9, 0xde, 0x0b, 0x6c, 0xbf, 0x33, 0x32, 0xef, 0xc5, 0x87, 0x7f, 0x1f, 0xfd, 0x2c, 0xc3, 0x7c,
-	0x08, 0x87, 0x56, 0xac, 0xd0, 0xda, 0xb4, 0x02, 0x82, 0xc9, 0xc0, 0xf3, 0xed, 0x4c, 0x54, 0x9f,
-	0x17, 0x89, 0x08, 0x75, 0x3e, 0xaa, 0xa0, 0xfb, 0x49, 0xe8, 0xf0, 0x7f, 0x4f, 0xe8, 0xf0, 0x8f,
-	0x6b, 0x39, 0xf1, 0xbc, 0x69, 0x22, 0x19, 0x54, 0xe1, 0x52, 0x01, 0xbd, 0x6b, 0xfa, 0xda, 0xfb,
-	0x54, 0x01, 0xa7, 0xb6, 0xf8, 0xbe, 0xa6, 0x47, 0xf4, 0x8a, 0x78, 0xb5, 0x90, 0xde, 0xad, 0x64,
-	0x48, 0xef, 0x4c, 0x01, 0x77, 0x2a, 0xa6, 0x77, 0x4d, 0x8f, 0xe9, 0x15, 0x49, 0x57, 0x83, 0x7a,
-	0xff, 0xcf, 0xc2, 0x68, 0xbf, 0x9e, 0x13, 0xf6, 0xf9, 0xa4, 0x1e, 0xf6, 0x99, 0xa0, 0x35, 0x3f,
-	0xae, 0xb8, 0xcf, 0x6f, 0x34, 0x72, 0xe2, 0x3e, 0x57, 0xb5, 0xb8, 0xcf, 0x84, 0x9a, 0x25, 0x03,
-	0x3f, 0xd7, 0xf4, 0xc0, 0xcf, 0xa9, 0x02, 0x4e, 0x2d, 0xf2, 0x73, 0x55, 0x8b, 0xfc, 0x14, 0x09,
-	0x55, 0x42, 0x3f, 0x57, 0xb5, 0xd0, 0x4f, 0x11, 0xa3, 0x12, 0xfb, 0xb9, 0xaa, 0xc5, 0x7e, 0x8a,
-	0x18, 0x95, 0xe0, 0xcf, 0x55, 0x2d, 0xf8, 0x53, 0xc4, 0xa8, 0x44, 0x7f, 0xae, 0xe9, 0xd1, 0x9f,
-	0xe2, 0xfe, 0x51, 0x06, 0xfd, 0x27, 0x81, 0x9a, 0xff, 0xc6, 0x40, 0xcd, 0x2f, 0x55, 0x73, 0x02,
-	0x30, 0x38, 0x3b, 0x00, 0x73, 0x2e, 0x7f, 0x24, 0x8b, 0x23, 0x30, 0xd3, 0xcf, 0x02, 0xe9, 0x10,
-	0xcc, 0xf5, 0x44, 0x08, 0xe6, 0x74, 0x01, 0xb3, 0x1e, 0x83, 0xf9, 0x3f, 0x13, 0x64, 0xf8, 0xfd,
-	0xc6, 0x84, 0xfd, 0xf4, 0x3b, 0xea, 0x7e, 0x7a, 0xc2, 0x4c, 0x96, 0xde, 0x50, 0xdf, 0xd0, 0x37,
-	0xd4, 0x67, 0xa7, 0xe0, 0xd5, 0x76, 0xd4, 0xeb, 0x59, 0x3b, 0xea, 0xee, 0x14, 0x28, 0xb9, 0x5b,
-	0xea, 0x3b, 0xe9, 0x2d, 0xf5, 0xb9, 0x29, 0xf0, 0x32, 0xf7, 0xd4, 0xeb, 0x59, 0x7b, 0xea, 0x69,
-	0x6a, 0x97, 0xbb, 0xa9, 0xfe, 0x94, 0xb6, 0xa9, 0x7e, 0x6d, 0x9a, 0xee, 0x8a, 0x27, 0x87, 0xcf,
-	0xe6, 0xec, 0xaa, 0xdf, 0x9a, 0x06, 0x66, 0x72, 0x10, 0xfb, 0x27, 0xfb, 0x62, 0x5d, 0xcc, 0xef,
-	0xbd, 0x0c, 0xad, 0xe8, 0xa2, 0x8d, 0xf9, 0x25, 0x68, 0x46, 0x9f, 0xb0, 0x25, 0x2d, 0xe7, 0x98,
-	0xdc, 0xd4, 0xf1, 0xd5, 0xb3, 0x48, 0xa1, 0x1b, 0x50, 0xa3, 0xff, 0x84, 0x59, 0xbc, 0x3e, 0xdd,
-	0x85, 0x1e, 0x2a, 0x04, 0x33, 0x3e, 0xf3, 0xdf, 0x8e, 0x02, 0x28, 0x5f, 0xf6, 0x4c, 0x2b, 0xf6,
-	0x3d, 0xea, 0xcc, 0x46, 0x21, 0xf1, 0xd9, 0x45, 0xae, 0xc2, 0x2f, 0x5f, 0x62, 0x09, 0x54, 0x5b,
-	0x42, 0xe2, 0x63, 0xc1, 0x8e, 0xee, 0x43, 0x2b, 0x0a, 0xa4, 0x1a, 0x35, 0x06, 0xf5, 0xd6, 0xd4,
-	0x50, 0x51, 0x68, 0x0f, 0x4b, 0x08, 0xb4, 0x08, 0xb5, 0xc0, 0xf3, 0x43, 0xa3, 0xce, 0xa0, 0xde,
-	0x9c, 0x1a, 0x6a, 0xc3, 0xf3, 0x43, 0xcc, 0x58, 0x79, 0xd3, 0x94, 0x0f, 0xa7, 0x67, 0x69, 0x9a,
-	0xe6, 0xb1, 0xff, 0xb5, 0x2a, 0x7d, 0xe8, 0xb2, 0xb0, 0x46, 0xae, 0x43, 0xe7, 0xa7, 0x1f, 0x25,
-	0xd5, 0x2a, 0x91, 0x58, 0x04, 0xf1, 0x91, 0xe0, 0xeb, 0x9b, 0xd7, 0xa1, 0x33, 0xf0, 0xf6, 0x88,
-	0x8f, 0xe3, 0x2b, 0x4e, 0xe2, 0x16, 0x5a, 0x2a, 0x1f, 0x99, 0xd0, 0xda, 0x76, 0x6c, 0xd2, 0x1f,
-	0x08, 0xff, 0xd7, 0xc2, 0x32, 0x8d, 0xee, 0x42, 0x8b, 0xc5, 0xd8, 0xa3, 0x08, 0xff, 0x6c, 0x95,
-	0xe4, 0xa1, 0xfe, 0x08, 0x80, 0x0a, 0x62, 0xc2, 0x6f, 0x3b, 0x21, 0xeb, 0xc3, 0x16, 0x96, 0x69,
-	0x5a, 0x61, 0x76, 0x8f, 0x4c, 0xad, 0x70, 0x93, 0x57, 0x38, 0x99, 0x8f, 0x2e, 0xc3, 0x73, 0x2c,
-	0x2f, 0xb1, 0xc5, 0xe4, 0xa1, 0xfa, 0x16, 0xce, 0x2e, 0x64, 0xf7, 0xe6, 0xac, 0x21, 0xff, 0x4c,
-	0x82, 0x05, 0xef, 0xea, 0x38, 0xce, 0x40, 0xe7, 0xe0, 0xb0, 0x4d, 0xb6, 0xac, 0xdd, 0x51, 0xf8,
-	0x90, 0xec, 0x8c, 0x47, 0x56, 0x48, 0xfa, 0x36, 0xfb, 0x76, 0xbb, 0x8d, 0xd3, 0x05, 0xe8, 0x02,
-	0x1c, 0x11, 0x99, 0xdc, 0x8c, 0xe9, 0x68, 0xf4, 0x6d, 0xf6, 0x29, 0x73, 0x1b, 0x67, 0x15, 0x99,
-	0xdf, 0xab, 0xd1, 0x41, 0x67, 0xaa, 0xfd, 0x1e, 0x54, 0x2d, 0xdb, 0x16, 0xd3, 0xe6, 0xa5, 0x19,
-	0x0d, 0x44, 0xdc, 0xbd, 0xa7, 0x08, 0x68, 0x5d, 0x5e, 0xb9, 0xe3, 0x13, 0xe7, 0x95, 0x59, 0xb1,
-	0xe4, 0x93, 0x12, 0x02, 0x87, 0x22, 0xee, 0xf2, 0x5b, 0xfc, 0xd5, 0x1f, 0x0d, 0x51, 0x5e, 0xf0,
-	0x17, 0x38, 0xe8, 0x0e, 0xd4, 0x58, 0x0d, 0xf9, 0xc4, 0x7a, 0x79, 0x56, 0xbc, 0xfb, 0xbc, 0x7e,
-	0x0c, 0xc3, 0x1c, 0xf0, 0xbb, 0x6f, 0xca, 0x85, 0xcb, 0xb2, 0x7e, 0xe1, 0x72, 0x09, 0xea, 0x4e,
-	0x48, 0x76, 0xd2, 0xf7, 0x6f, 0x27, 0xaa, 0xaa, 0xf0, 0x3c, 0x9c, 0x75, 0xe2, 0x3d, 0xc0, 0x0f,
-	0x72, 0x6f, 0xdf, 0xdf, 0x82, 0x1a, 0x65, 0x4f, 0xad, 0x25, 0xa7, 0x11, 0xcc, 0x38, 0xcd, 0x8b,
-	0x50, 0xa3, 0x8d, 0x9d, 0xd0, 0x3a, 0x51, 0x9f, 0x8a, 0xac, 0xcf, 0xd2, 0x1c, 0xb4, 0xbd, 0x31,
-	0xf1, 0x99, 0x61, 0x98, 0xff, 0x52, 0x53, 0x2e, 0xc5, 0xf5, 0x55, 0x1d, 0x7b, 0x7b, 0x66, 0xcf,
-	0xa9, 0x6a, 0x19, 0x4e, 0x68, 0xd9, 0x3b, 0xb3, 0xa3, 0xa5, 0xf4, 0x0c, 0x27, 0xf4, 0xec, 0x47,
-	0xc0, 0x4c, 0x69, 0xda, 0x3d, 0x4d, 0xd3, 0xae, 0xcc, 0x8e, 0xa8, 0xe9, 0x1a, 0x29, 0xd2, 0xb5,
-	0x15, 0x5d, 0xd7, 0xba, 0xd3, 0x0d, 0xb9, 0x9c, 0x9a, 0xa6, 0xd0, 0xb6, 0xcf, 0xe7, 0x6a, 0xdb,
-	0x92, 0xa6, 0x6d, 0xb3, 0x8a, 0xfe, 0x98, 0xf4, 0xed, 0xbb, 0x35, 0xa8, 0xd1, 0xe9, 0x11, 0xad,
-	0xaa, 0xba, 0xf6, 0xd6, 0x4c, 0x53, 0xab, 0xaa, 0x67, 0x6b, 0x09, 0x3d, 0xbb, 0x3c, 0x1b, 0x52,
-	0x4a, 0xc7, 0xd6, 0x12, 0x3a, 0x36, 0x23, 0x5e, 0x4a, 0xbf, 0x7a, 0x9a, 0x7e, 0x5d, 0x9c, 0x0d,
-	0x4d, 0xd3, 0x2d, 0xab, 0x48, 0xb7, 0x6e, 0xe9, 0xba, 0x35, 0xe5, 0xea, 0x8d, 0xad, 0x55, 0xa6,
-	0xd0, 0xab, 0xf7, 0x73, 0xf5, 0xea, 0x86, 0xa6, 0x57, 0xb3, 0x88, 0xfd, 0x98, 0x74, 0xea, 0x32,
-	0x5f, 0x74, 0x66, 0x7f, 0xfc, 0x94, 0xb7, 0xe8, 0x34, 0xdf, 0x86, 0x76, 0xfc, 0x34, 0x42, 0xc6,
-	0xf5, 0x7c, 0x4e, 0x16, 0x49, 0x8d, 0x92, 0xe6, 0x25, 0x68, 0xc7, 0xcf, 0x1d, 0x64, 0xc8, 0x0a,
-	0x58, 0xa1, 0xe0, 0x12, 0x29, 0x73, 0x15, 0x0e, 0xa7, 0x3f, 0xc6, 0xce, 0x88, 0xc3, 0x2b, 0x77,
-	0xcb, 0xa3, 0x4f, 0x51, 0x94, 0x2c, 0xf3, 0x29, 0xcc, 0x27, 0x3e, 0xaf, 0x9e, 0x19, 0x03, 0x5d,
-	0x52, 0x96, 0xc8, 0xd5, 0xc4, 0xc7, 0x7a, 0xfa, 0x6d, 0xf9, 0x78, 0x21, 0x6c, 0xae, 0xc0, 0x7c,
-	0x41, 0xe5, 0xa7, 0xb9, 0x2c, 0xff, 0x05, 0x98, 0x9b, 0x54, 0xf7, 0x8f, 0xe1, 0x32, 0x7f, 0x08,
-	0x9d, 0xd4, 0xd3, 0x10, 0x49, 0x31, 0xeb, 0x00, 0x43, 0x49, 0x23, 0x94, 0xf6, 0xc2, 0x0c, 0x9f,
-	0x2e, 0x30, 0x3e, 0xac, 0x60, 0x98, 0xbf, 0x53, 0x86, 0xc3, 0xe9, 0x77, 0x21, 0xa6, 0xdd, 0xfc,
-	0x18, 0xd0, 0x64, 0x58, 0xf2, 0x8b, 0x8f, 0x28, 0x89, 0xee, 0xc3, 0x81, 0x60, 0xe4, 0x0c, 0xc8,
-	0xf2, 0xb6, 0xe5, 0x0e, 0x49, 0x20, 0x76, 0x34, 0x05, 0x6f, 0x3b, 0x6c, 0xc4, 0x1c, 0x58, 0x63,
-	0x37, 0x9f, 0xc2, 0x9c, 0x52, 0x88, 0xde, 0x85, 0x8a, 0x37, 0x4e, 0xdd, 0x6b, 0xcc, 0xc7, 0x7c,
-	0x10, 0xd9, 0x1b, 0xae, 0x78, 0xe3, 0xb4, 0x49, 0xaa, 0xe6, 0x5b, 0xd5, 0xcc, 0xd7, 0xbc, 0x0b,
-	0x87, 0xd3, 0x4f, 0x2f, 0x24, 0xbb, 0xe7, 0x4c, 0x2a, 0x4a, 0xc0, 0xbb, 0x29, 0xb9, 0xe5, 0xbf,
-	0x0a, 0x87, 0x92, 0x0f, 0x2a, 0x64, 0x7c, 0x8d, 0x13, 0x7f, 0xd4, 0x14, 0x85, 0xeb, 0x17, 0x7e,
-	0xb1, 0x0c, 0xf3, 0x7a, 0x43, 0xd0, 0x31, 0x40, 0x7a, 0xce, 0x9a, 0xe7, 0x92, 0x4e, 0x09, 0x3d,
-	0x07, 0x87, 0xf5, 0xfc, 0x45, 0xdb, 0xee, 0x94, 0xd3, 0xe4, 0xd4, 0x6d, 0x75, 0x2a, 0xc8, 0x80,
-	0xa3, 0x89, 0x1e, 0x62, 0x4e, 0xb4, 0x53, 0x45, 0x2f, 0xc0, 0x73, 0xc9, 0x92, 0xf1, 0xc8, 0x1a,
-	0x90, 0x4e, 0xcd, 0xfc, 0x61, 0x05, 0x6a, 0x8f, 0x02, 0xe2, 0x9b, 0xff, 0x54, 0x89, 0xbe, 0xd2,
-	0x78, 0x07, 0x6a, 0xec, 0xad, 0x03, 0xe5, 0x6b, 0xc6, 0x72, 0xe2, 0x6b, 0x46, 0xed, 0x8b, 0xb8,
-	0xf8, 0x6b, 0xc6, 0x77, 0xa0, 0xc6, 0x5e, 0x37, 0x98, 0x9d, 0xf3, 0x9b, 0x65, 0x68, 0xc7, 0x2f,
-	0x0d, 0xcc, 0xcc, 0xaf, 0x7e, 0x15, 0x52, 0xd1, 0xbf, 0x0a, 0x79, 0x1d, 0xea, 0x3e, 0xfb, 0x7e,
-	0x83, 0x7b, 0x99, 0xe4, 0xb7, 0x26, 0x4c, 0x20, 0xe6, 0x24, 0x26, 0x81, 0x39, 0xf5, 0x1d, 0x85,
-	0xd9, 0xab, 0x71, 0x4a, 0x3c, 0xa2, 0xd4, 0xb7, 0x83, 0x45, 0xdf, 0xb7, 0xf6, 0x85, 0x62, 0xea,
-	0x99, 0xe6, 0x71, 0xa8, 0xad, 0x3b, 0xee, 0x30, 0xfb, 0x23, 0x52, 0xf3, 0x4f, 0xca, 0xd0, 0x14,
-	0x97, 0x77, 0xcd, 0xab, 0x50, 0x5d, 0x23, 0x4f, 0x69, 0x45, 0xc4, 0xb5, 0xe1, 0x54, 0x45, 0xee,
-	0xb3, 0x56, 0x08, 0x7a, 0x1c, 0x91, 0x99, 0xd7, 0xe4, 0x34, 0x39, 0x3b, 0xef, 0x3b, 0x50, 0x63,
-	0xcf, 0x1f, 0xcc, 0xce, 0xf9, 0xa7, 0x2d, 0x68, 0xf0, 0x2f, 0x31, 0xcd, 0x3f, 0x68, 0x41, 0x83,
-	0x3f, 0x89, 0x80, 0x6e, 0x40, 0x33, 0xd8, 0xdd, 0xd9, 0xb1, 0xfc, 0x7d, 0x23, 0xfb, 0xfd, 0x4d,
-	0xed, 0x05, 0x85, 0xee, 0x06, 0xa7, 0xc5, 0x11, 0x13, 0x7a, 0x1b, 0x6a, 0x03, 0x6b, 0x8b, 0xa4,
-	0x8e, 0x73, 0xb3, 0x98, 0x97, 0xad, 0x2d, 0x82, 0x19, 0x39, 0xba, 0x05, 0x2d, 0x31, 0x2c, 0x81,
-	0x88, 0xe7, 0x4c, 0x96, 0x1b, 0x0d, 0xa6, 0xe4, 0x32, 0xef, 0x40, 0x53, 0x54, 0x06, 0xdd, 0x94,
-	0xdf, 0xa1, 0x26, 0x23, 0xcf, 0x99, 0x4d, 0x90, 0xdf, 0xca, 0xcb, 0x2f, 0x52, 0xff, 0xb2, 0x02,
-	0x35, 0x5a, 0xb9, 0x8f, 0x8c, 0x84, 0x4e, 0x00, 0x8c, 0xac, 0x20, 0x5c, 0xdf, 0x1d, 0x8d, 0x88,
-	0x2d, 0xbe, 0xb0, 0x53, 0x72, 0xd0, 0x59, 0x38, 0xc4, 0x53, 0xc1, 0xf6, 0xc6, 0xee, 0x60, 0x40,
-	0xe4, 0x67, 0xa2, 0xc9, 0x6c, 0xb4, 0x08, 0x75, 0xf6, 0x48, 0x9f, 0x58, 0x15, 0xbe, 0x51, 0xd8,
-	0xb3, 0xdd, 0x75, 0xc7, 0x15, 0xb5, 0xe1, 0x9c, 0xa6, 0x07, 0x6d, 0x99, 0x47, 0x8d, 0x70, 0xec,
-	0xb8, 0xae, 0xe3, 0x0e, 0x85, 0x46, 0x47, 0x49, 0x3a, 0xe9, 0xd0, 0xbf, 0xa2, 0xbe, 0x75, 0x2c,
-	0x52, 0x34, 0x7f, 0xcb, 0x72, 0x46, 0xa2, 0x8a, 0x75, 0x2c, 0x52, 0x14, 0x69, 0x57, 0x3c, 0x24,
-	0x51, 0x63, 0x0d, 0x8c, 0x92, 0xe6, 0x87, 0x65, 0xf9, 0x31, 0x76, 0xd6, 0xc7, 0x99, 0xa9, 0x58,
-	0xd2, 0x71, 0x35, 0xa0, 0xcd, 0x27, 0x04, 0x25, 0x44, 0x7d, 0x0c, 0x1a, 0x9e, 0x3b, 0x72, 0x5c,
-	0x22, 0x62, 0x47, 0x22, 0x95, 0xe8, 0xe3, 0x7a, 0xaa, 0x8f, 0x45, 0xf9, 0xaa, 0xed, 0xd0, 0x2a,
-	0x36, 0xe2, 0x72, 0x9e, 0x83, 0xae, 0x43, 0xd3, 0x26, 0x7b, 0xce, 0x80, 0x04, 0x46, 0x93, 0xa9,
-	0xde, 0xab, 0x13, 0xfb, 0x76, 0x85, 0xd1, 0xe2, 0x88, 0xc7, 0x0c, 0xa1, 0xc1, 0xb3, 0x64, 0x93,
-	0xca, 0x4a, 0x93, 0xe2, 0x4a, 0x57, 0x26, 0x54, 0xba, 0x5a, 0x50, 0xe9, 0x5a, 0xb2, 0xd2, 0x0b,
-	0x5f, 0x05, 0x88, 0xd5, 0x0d, 0xcd, 0x41, 0xf3, 0x91, 0xfb, 0xc4, 0xf5, 0x9e, 0xba, 0x9d, 0x12,
-	0x4d, 0x3c, 0xd8, 0xda, 0xa2, 0x52, 0x3a, 0x65, 0x9a, 0xa0, 0x74, 0x8e, 0x3b, 0xec, 0x54, 0x10,
-	0x40, 0x83, 0x26, 0x88, 0xdd, 0xa9, 0xd2, 0xff, 0xb7, 0xd9, 0xf8, 0x75, 0x6a, 0xe8, 0x79, 0x38,
-	0xd2, 0x77, 0x07, 0xde, 0xce, 0xd8, 0x0a, 0x9d, 0xcd, 0x11, 0x79, 0x4c, 0xfc, 0xc0, 0xf1, 0xdc,
-	0x4e, 0x9d, 0xce, 0x5e, 0x6b, 0x24, 0x7c, 0xea, 0xf9, 0x4f, 0xd6, 0x08, 0xb1, 0xc5, 0xfb, 0x0f,
-	0x9d, 0x86, 0xf9, 0x1f, 0x65, 0x7e, 0x1a, 0x6c, 0xde, 0x82, 0x03, 0xda, 0x8b, 0x27, 0x46, 0xfc,
-	0x2c, 0x72, 0xe2, 0x55, 0xe4, 0x63, 0x2c, 0x5e, 0x4b, 0xe2, 0xa5, 0x0c, 0x4f, 0x99, 0xb7, 0x01,
-	0x94, 0x77, 0x4e, 0x4e, 0x00, 0x6c, 0xee, 0x87, 0x24, 0xe0, 0x6f, 0x9c, 0x50, 0x88, 0x1a, 0x56,
-	0x72, 0x54, 0xfc, 0x8a, 0x86, 0x6f, 0x5e, 0x01, 0x50, 0x5e, 0x39, 0xa1, 0x76, 0x45, 0x53, 0x4b,
-	0x49, 0xb0, 0x64, 0xb6, 0xd9, 0x15, 0x2d, 0x88, 0xde, 0x33, 0x89, 0x6a, 0xc0, 0xa3, 0x77, 0x6a,
-	0x0d, 0x58, 0x8e, 0xb9, 0x0a, 0x10, 0x3f, 0xe9, 0x61, 0x5e, 0x95, 0xae, 0xfb, 0x4d, 0xa8, 0xd9,
-	0x56, 0x68, 0x09, 0xaf, 0xf9, 0x42, 0x62, 0xe6, 0x8a, 0x59, 0x30, 0x23, 0x33, 0x7f, 0xbb, 0x0c,
-	0x07, 0xd4, 0xe7, 0x4b, 0xcc, 0xf7, 0xa0, 0xc6, 0xde, 0x3f, 0xb9, 0x09, 0x07, 0xd4, 0xf7, 0x4b,
-	0x52, 0xcf, 0x47, 0x73, 0x3c, 0x95, 0x15, 0x6b, 0x0c, 0x66, 0x5f, 0x56, 0xe9, 0x23, 0x43, 0x5d,
-	0x80, 0xa6, 0x78, 0x0e, 0xc5, 0x3c, 0x0d, 0xed, 0xf8, 0xf5, 0x13, 0xea, 0x3b, 0x78, 0x7e, 0x34,
-	0xca, 0x22, 0x69, 0xfe, 0x73, 0x15, 0xea, 0x6c, 0x38, 0xcd, 0xaf, 0x57, 0x54, 0x0d, 0x35, 0x7f,
-	0x58, 0xce, 0xdd, 0x0b, 0x5e, 0xd2, 0x9e, 0x0d, 0x98, 0x4f, 0xbd, 0xfa, 0x23, 0x1e, 0x3b, 0xd1,
-	0x1d, 0xeb, 0x15, 0x68, 0xba, 0x5c, 0x33, 0x99, 0xf1, 0xcc, 0xa7, 0x5f, 0xfa, 0x61, 0x5c, 0x42,
-	0x7b, 0x71, 0x44, 0x8c, 0x2e, 0x43, 0x9d, 0xf8, 0xbe, 0xe7, 0x33, 0x93, 0x9a, 0x4f, 0xbd, 0x9f,
-	0x13, 0x3f, 0xac, 0xb2, 0x4a, 0xa9, 0x30, 0x27, 0x46, 0x97, 0xe1, 0xb9, 0x80, 0x5b, 0x11, 0x5f,
-	0x53, 0x06, 0xe2, 0xbb, 0x6a, 0xe1, 0x6d, 0xb2, 0x0b, 0x17, 0x3e, 0x1d, 0x4d, 0xb0, 0x8a, 0xe1,
-	0x95, 0x54, 0x8b, 0x2c, 0xa3, 0x36, 0xd4, 0x99, 0xa0, 0x4e, 0x45, 0x35, 0xdb, 0x6a, 0x8e, 0xe1,
-	0xd5, 0x16, 0x2e, 0x41, 0x53, 0xe4, 0x53, 0xfa, 0x45, 0x5e, 0xf7, 0x4e, 0x09, 0x1d, 0x80, 0xd6,
-	0x06, 0x19, 0x6d, 0xf5, 0xbc, 0x20, 0xec, 0x94, 0xd1, 0x41, 0x68, 0x33, 0x5b, 0x78, 0xe0, 0x8e,
-	0xf6, 0x3b, 0x95, 0x85, 0xf7, 0xa1, 0x2d, 0x5b, 0x84, 0x5a, 0x50, 0x5b, 0xdb, 0x1d, 0x8d, 0x3a,
-	0x25, 0xb6, 0x34, 0x0d, 0x3d, 0x3f, 0x0a, 0x4c, 0xaf, 0x3e, 0xa3, 0xf3, 0x4c, 0xa7, 0x9c, 0xe7,
-	0x0d, 0x2a, 0xa8, 0x03, 0x07, 0x84, 0x70, 0x5e, 0xe7, 0xaa, 0xf9, 0x8f, 0x65, 0x68, 0xcb, 0x17,
-	0x63, 0xe8, 0xba, 0x30, 0x1a, 0xe3, 0x7c, 0x3f, 0x70, 0x35, 0x31, 0xda, 0xf9, 0x0f, 0xd0, 0x24,
-	0x46, 0xfc, 0x0c, 0xcc, 0x0b, 0x97, 0x1b, 0x75, 0x3e, 0xf7, 0x9a, 0x89, 0xdc, 0x85, 0x3b, 0xb2,
-	0xd7, 0x3b, 0xcc, 0xc4, 0x96, 0x3d, 0xd7, 0x25, 0x83, 0x90, 0xf5, 0xfd, 0x21, 0x98, 0x5b, 0xf3,
-	0xc2, 0x75, 0x2f, 0x08, 0x68, 0xcb, 0x78, 0x4f, 0xc5, 0xe5, 0x15, 0x34, 0x0f, 0x10, 0xdd, 0x35,
-	0xa3, 0x4e, 0xd2, 0xfc, 0xad, 0x32, 0x34, 0xf8, 0x3b, 0x36, 0xe6, 0xaf, 0x96, 0xa1, 0x21, 0xde,
-	0xae, 0x79, 0x1d, 0x3a, 0xbe, 0x47, 0x81, 0xa3, 0x0d, 0x45, 0x7f, 0x45, 0xb4, 0x32, 0x95, 0x4f,
-	0xf7, 0xb8, 0x9e, 0xa2, 0x15, 0x62, 0x09, 0xa0, 0xe5, 0xa1, 0x6b, 0x00, 0xfc, 0x6d, 0x9c, 0x87,
-	0xfb, 0x63, 0x22, 0xd4, 0x39, 0x79, 0xc5, 0x4c, 0xbc, 0xa6, 0xc3, 0x0e, 0x63, 0x14, 0xea, 0x85,
-	0xaf, 0xc0, 0x41, 0x4c, 0x82, 0xb1, 0xe7, 0x06, 0xe4, 0xc7, 0xf5, 0x8c, 0x7e, 0xee, 0x83, 0xf8,
-	0x0b, 0xdf, 0xad, 0x43, 0x9d, 0xad, 0x2e, 0xcd, 0xbf, 0xaa, 0xcb, 0x75, 0x70, 0xca, 0xbe, 0x2f,
-	0xaa, 0x17, 0x7d, 0x54, 0x43, 0xd5, 0x16, 0xa6, 0xfa, 0x05, 0x9f, 0x4f, 0x41, 0x6b, 0xec, 0x7b,
-	0x43, 0x9f, 0xae, 0x67, 0x6b, 0x89, 0x87, 0x8a, 0x74, 0xb6, 0x75, 0x41, 0x86, 0x25, 0x83, 0xaa,
-	0x7c, 0x75, 0x5d, 0xf9, 0x6e, 0x41, 0xdb, 0xf6, 0xbd, 0x31, 0xfb, 0x44, 0x5d, 0x1c, 0xae, 0x9d,
-	0xcc, 0xc1, 0x5d, 0x89, 0xe8, 0x7a, 0x25, 0x1c, 0x33, 0x51, 0xf5, 0xe5, 0xbd, 0x2f, 0xce, 0xb5,
-	0x5f, 0xca, 0x61, 0xe7, 0xe3, 0xd5, 0x2b, 0x61, 0x41, 0x4e, 0x19, 0xc9, 0x33, 0xc6, 0xd8, 0x9a,
-	0xc8, 0xb8, 0xfa, 0x2c, 0x62, 0xe4, 0xe4, 0xe8, 0x3a, 0xb4, 0x02, 0x6b, 0x8f, 0xb0, 0xd7, 0x79,
-	0xdb, 0x13, 0xbb, 0x62, 0x43, 0x90, 0xf5, 0x4a, 0x58, 0xb2, 0xd0, 0x26, 0xef, 0x38, 0x43, 0xbe,
-	0x93, 0x14, 0x4f, 0x04, 0xe7, 0x35, 0xf9, 0x7e, 0x44, 0xc7, 0xde, 0x73, 0x8e, 0x12, 0x74, 0xe7,
-	0xc3, 0x5d, 0xe6, 0x1c, 0x3f, 0x36, 0x66, 0x09, 0x73, 0x0e, 0xda, 0xb2, 0x8b, 0xcc, 0x96, 0x34,
-	0x93, 0x16, 0x34, 0x78, 0x0b, 0x4c, 0x80, 0x56, 0x54, 0x21, 0x4a, 0x2c, 0xc1, 0xcd, 0x35, 0x68,
-	0x45, 0x83, 0x96, 0xf3, 0x2c, 0x05, 0x82, 0x9a, 0xed, 0x89, 0x25, 0x53, 0x15, 0xb3, 0xff, 0x74,
-	0x50, 0xd5, 0xf7, 0x8d, 0xda, 0xf2, 0x65, 0xa1, 0x85, 0xc5, 0xe8, 0xbe, 0x12, 0x75, 0x6d, 0x7c,
-	0x33, 0x3e, 0x07, 0x4d, 0xbc, 0xcb, 0x56, 0xb3, 0x9d, 0x32, 0xcd, 0xa6, 0x5b, 0xa4, 0x4e, 0x85,
-	0x7a, 0xc9, 0x65, 0xcb, 0x1d, 0x90, 0x11, 0x5b, 0x01, 0x49, 0xdf, 0x5b, 0x5b, 0x6a, 0x4b, 0xf0,
-	0xa5, 0xe3, 0x7f, 0xfd, 0xe1, 0x89, 0xf2, 0x77, 0x3e, 0x3c, 0x51, 0xfe, 0xfe, 0x87, 0x27, 0xca,
-	0xbf, 0xf2, 0x83, 0x13, 0xa5, 0xef, 0xfc, 0xe0, 0x44, 0xe9, 0x1f, 0x7e, 0x70, 0xa2, 0xf4, 0x41,
-	0x65, 0xbc, 0xb9, 0xd9, 0x60, 0x77, 0x4e, 0x2e, 0xfd, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9a,
-	0xe0, 0x31, 0x2b, 0x1d, 0x63, 0x00, 0x00,
+	// 6434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x4b, 0x8c, 0x5c, 0xd9,
+	0x59, 0x7f, 0xd7, 0xbb, 0xea, 0xeb, 0x76, 0xbb, 0xe6, 0x8e, 0xc7, 0x73, 0xe7, 0x8e, 0xc7, 0xe3,
+	0xe9, 0xf1, 0xd8, 0x8e, 0xc7, 0x53, 0x9e, 0x69, 0x7b, 0xec, 0x89, 0x33, 0x7e, 0xf4, 0x73, 0xba,
+	0xfd, 0x68, 0x77, 0x4e, 0xdb, 0x93, 0xc9, 0x24, 0xfa, 0x2b, 0xb7, 0xab, 0x4e, 0x77, 0xdf, 0xb8,
+	0xba, 0x6e, 0xe5, 0xde, 0xdb, 0x6d, 0x77, 0x92, 0x7f, 0xfe, 0xf9, 0x13, 0x08, 0x12, 0x0f, 0xc1,
+	0x22, 0x02, 0x56, 0x20, 0x05, 0x90, 0x58, 0x00, 0x02, 0xc1, 0x02, 0x58, 0xb0, 0x41, 0x20, 0x02,
+	0x2c, 0xc2, 0x02, 0x09, 0x21, 0xa1, 0x44, 0x93, 0x05, 0x2c, 0x60, 0x11, 0x90, 0x10, 0x2b, 0x84,
+	0xbe, 0xf3, 0xba, 0xe7, 0xdc, 0x47, 0xdd, 0xea, 0xcc, 0x84, 0xb0, 0xc8, 0xc6, 0xae, 0x73, 0xee,
+	0xf7, 0xfb, 0x9d, 0xd7, 0x77, 0xbe, 0x73, 0xce, 0x77, 0x1e, 0x0d, 0xc7, 0x87, 0x9b, 0x17, 0x87,
+	0x81, 0x1f, 0xf9, 0xe1, 0x45, 0xba, 0x4f, 0x07, 0x51, 0xd8, 0x61, 0x21, 0xab, 0xe1, 0x0e, 0x0e,
+	0xa2, 0x83, 0x21, 0x75, 0x4e, 0x0f, 0x1f, 0x6d, 0x5f, 0xec, 0x7b, 0x9b, 0x17, 0x87, 0x9b, 0x17,
+	0x77, 0xfd, 0x1e, 0xed, 0x4b, 0x71, 0x16, 0x10, 0xe2, 0xce, 0x89, 0x6d, 0xdf, 0xdf, 0xee, 0x53,
+	0xfe, 0x6d, 0x73, 0x6f, 0xeb, 0x62, 0x18, 0x05, 0x7b, 0xdd, 0x88, 0x7f, 0x9d, 0xf9, 0xf5, 0xbf,
+	0x28, 0x41, 0x6d, 0x09, 0xe9, 0xad, 0x59, 0x68, 0xee, 0xd2, 0x30, 0x74, 0xb7, 0x69, 0x68, 0x97,
+	0x4e, 0x55, 0xce, 0x4d, 0xce, 0x1e, 0xef, 0x88, 0xa4, 0x3a, 0x4c, 0xa2, 0x73, 0x8f, 0x7f, 0x26,
+	0x4a, 0xce, 0x3a, 0x01, 0xad, 0xae, 0x3f, 0x88, 0xe8, 0x93, 0x68, 0xb5, 0x67, 0x97, 0x4f, 0x95,
+	0xce, 0xb5, 0x48, 0x1c, 0x61, 0x5d, 0x86, 0x96, 0x37, 0xf0, 0x22, 0xcf, 0x8d, 0xfc, 0xc0, 0xae,
+	0x9c, 0x2a, 0x19, 0x94, 0x2c, 0x93, 0x9d, 0xb9, 0x6e, 0xd7, 0xdf, 0x1b, 0x44, 0x24, 0x16, 0xb4,
+	0x6c, 0x68, 0x44, 0x81, 0xdb, 0xa5, 0xab, 0x3d, 0xbb, 0xca, 0x18, 0x65, 0xd0, 0xf9, 0xa7, 0x37,
+	0xa0, 0x21, 0xf2, 0x60, 0x3d, 0x07, 0x8d, 0x70, 0xc8, 0xa5, 0x7e, 0xb2, 0xc4, 0xc5, 0x44, 0xd8,
+	0xba, 0x09, 0x93, 0x2e, 0xa7, 0xdd, 0xd8, 0xf1, 0x1f, 0xdb, 0x25, 0x96, 0xf0, 0xf3, 0x89, 0xb2,
+	0x88, 0x84, 0x3b, 0x28, 0xb2, 0x32, 0x41, 0x74, 0x84, 0xb5, 0x0a, 0xd3, 0x22, 0xb8, 0x48, 0x23,
+	0xd7, 0xeb, 0x87, 0xf6, 0xb7, 0x38, 0xc9, 0xc9, 0x1c, 0x12, 0x21, 0xb6, 0x32, 0x41, 0x12, 0x40,
+	0xeb, 0xd3, 0xf0, 0xb4, 0x88, 0x59, 0xf0, 0x07, 0x5b, 0xde, 0xf6, 0xc3, 0x61, 0xcf, 0x8d, 0xa8,
+	0xfd, 0x57, 0x9c, 0xef, 0x74, 0x0e, 0x1f, 0x97, 0xed, 0x70, 0xe1, 0x95, 0x09, 0x92, 0xc5, 0x61,
+	0x2d, 0xc3, 0x11, 0x11, 0x2d, 0x48, 0xff, 0x9a, 0x93, 0xbe, 0x90, 0x43, 0xaa, 0xd8, 0x4c, 0x98,
+	0xf5, 0x19, 0x38, 0x26, 0x22, 0xee, 0x7a, 0x83, 0x47, 0x0b, 0x3b, 0x6e, 0xbf, 0x4f, 0x07, 0xdb,
+	0xd4, 0xfe, 0x9b, 0xd1, 0x79, 0x34, 0x84, 0x57, 0x26, 0x48, 0x26, 0x89, 0x75, 0x1f, 0xda, 0xfe,
+	0xe6, 0xe7, 0x69, 0x57, 0x56, 0xc8, 0x06, 0x8d, 0xec, 0x36, 0xe3, 0x7d, 0x29, 0xc1, 0x7b, 0x9f,
+	0x89, 0xc9, 0xaa, 0xec, 0x6c, 0xd0, 0x68, 0x65, 0x82, 0xa4, 0xc0, 0xd6, 0x43, 0xb0, 0x8c, 0xb8,
+	0xb9, 0x5d, 0x3a, 0xe8, 0xd9, 0xb3, 0x8c, 0xf2, 0xe5, 0xd1, 0x94, 0x4c, 0x74, 0x65, 0x82, 0x64,
+	0x10, 0xa4, 0x68, 0x1f, 0x0e, 0x42, 0x1a, 0xd9, 0x97, 0xc6, 0xa1, 0x65, 0xa2, 0x29, 0x5a, 0x16,
+	0x8b, 0x75, 0xcb, 0x63, 0x09, 0xed, 0xbb, 0x91, 0xe7, 0x0f, 0x44, 0x7e, 0x2f, 0x33, 0xe2, 0x57,
+	0xb2, 0x89, 0x95, 0xac, 0xca, 0x71, 0x26, 0x89, 0xf5, 0x7f, 0xe0, 0x99, 0x44, 0x3c, 0xa1, 0xbb,
+	0xfe, 0x3e, 0xb5, 0xdf, 0x64, 0xec, 0x67, 0x8a, 0xd8, 0xb9, 0xf4, 0xca, 0x04, 0xc9, 0xa6, 0xb1,
+	0xe6, 0x61, 0x4a, 0x7e, 0x60, 0xb4, 0x57, 0x18, 0xed, 0x89, 0x3c, 0x5a, 0x41, 0x66, 0x60, 0xb0,
+	0x2f, 0xf2, 0xf0, 0x42, 0xdf, 0x0f, 0xa9, 0x3d, 0x97, 0xd9, 0x17, 0x05, 0x05, 0x13, 0xc1, 0xbe,
+	0xa8, 0x21, 0xf4, 0x42, 0x86, 0x51, 0xe0, 0x75, 0x59, 0x06, 0x51, 0x8b, 0xae, 0x8e, 0x2e, 0x64,
+	0x2c, 0x2c, 0x54, 0x29, 0x9b, 0xc6, 0x22, 0x70, 0x34, 0xdc, 0xdb, 0x0c, 0xbb, 0x81, 0x37, 0xc4,
+	0xb8, 0xb9, 0x5e, 0xcf, 0x7e, 0x7b, 0x14, 0xf3, 0x86, 0x26, 0xdc, 0x99, 0xeb, 0x61, 0xeb, 0x24,
+	0x09, 0xac, 0xcf, 0x80, 0xa5, 0x47, 0x89, 0xea, 0xbb, 0xce, 0x68, 0x3f, 0x36, 0x06, 0xad, 0xaa,
+	0xcb, 0x0c, 0x1a, 0xcb, 0x85, 0x63, 0x7a, 0xec, 0xba, 0x1f, 0x7a, 0xf8, 0xbf, 0x7d, 0x83, 0xd1,
+	0xbf, 0x3a, 0x06, 0xbd, 0x84, 0xa0, 0x62, 0x65, 0x51, 0x25, 0x93, 0x58, 0xc0, 0x6e, 0x4d, 0x83,
+	0xd0, 0xbe, 0x39, 0x76, 0x12, 0x12, 0x92, 0x4c, 0x42, 0xc6, 0x27, 0xab, 0xe8, 0x9d, 0xc0, 0xdf,
+	0x1b, 0x86, 0xf6, 0xad, 0xb1, 0xab, 0x88, 0x03, 0x92, 0x55, 0xc4, 0x63, 0xad, 0x2b, 0xd0, 0xdc,
+	0xec, 0xfb, 0xdd, 0x47, 0x73, 0x3d, 0x3e, 0x28, 0x4d, 0xce, 0xda, 0x09, 0xca, 0x79, 0xfc, 0x2c,
+	0x9a, 0x4f, 0xc9, 0xa2, 0xb2, 0xb2, 0xdf, 0x8b, 0xb4, 0x4f, 0x23, 0x6a, 0x57, 0x32, 0x95, 0x95,
+	0x43, 0xb9, 0x08, 0x2a, 0xab, 0x86, 0xb0, 0x16, 0x61, 0x72, 0xcb, 0xeb, 0xd3, 0xf0, 0xe1, 0xb0,
+	0xef, 0xbb, 0x7c, 0xf8, 0x9a, 0x9c, 0x3d, 0x95, 0x49, 0xb0, 0x1c, 0xcb, 0x21, 0x8b, 0x06, 0xb3,
+	0x6e, 0x40, 0x6b, 0xd7, 0x0d, 0x1e, 0x85, 0xab, 0x83, 0x2d, 0xdf, 0xae, 0x65, 0x0e, 0x3c, 0x9c,
+	0xe3, 0x9e, 0x94, 0x5a, 0x99, 0x20, 0x31, 0x04, 0x87, 0x2f, 0x96, 0xa9, 0x0d, 0x1a, 0x2d, 0x7b,
+	0xb4, 0xdf, 0x0b, 0xed, 0x3a, 0x23, 0x79, 0x31, 0x93, 0x64, 0x83, 0x46, 0x1d, 0x2e, 0x86, 0xc3,
+	0x97, 0x09, 0xb4, 0xde, 0x83, 0xa7, 0x65, 0xcc, 0xc2, 0x8e, 0xd7, 0xef, 0x05, 0x74, 0xb0, 0xda,
+	0x0b, 0xed, 0x46, 0xe6, 0xc8, 0x10, 0xf3, 0x69, 0xb2, 0x38, 0x7a, 0x65, 0x50, 0xa0, 0x65, 0x94,
+	0xd1, 0x7a, 0x97, 0xb4, 0x9b, 0x99, 0x96, 0x31, 0xa6, 0xd6, 0x85, 0x51, 0xbb, 0xb2, 0x48, 0xac,
+	0x1e, 0x3c, 0x2b, 0xe3, 0xe7, 0xdd, 0xee, 0xa3, 0xed, 0xc0, 0xdf, 0x1b, 0xf4, 0x16, 0xfc, 0xbe,
+	0x1f, 0xd8, 0x2d, 0xc6, 0x7f, 0x2e, 0x97, 0x3f, 0x21, 0xbf, 0x32, 0x41, 0xf2, 0xa8, 0xac, 0x05,
+	0x98, 0x92, 0x9f, 0x1e, 0xd0, 0x27, 0x91, 0x0d, 0x99, 0xc3, 0x6f, 0x4c, 0x8d, 0x42, 0x68, 0x20,
+	0x75, 0x90, 0x4e, 0x82, 0x2a, 0x61, 0x4f, 0x16, 0x90, 0xa0, 0x90, 0x4e, 0x82, 0x61, 0x9d, 0x04,
+	0x87, 0x5f, 0xfb, 0x48, 0x01, 0x09, 0x0a, 0xe9, 0x24, 0x18, 0xc6, 0xa1, 0x5a, 0x95, 0xd4, 0xf7,
+	0x1f, 0xa1, 0x3e, 0xd9, 0xd3, 0x99, 0x43, 0xb5, 0x56, 0x5b, 0x42, 0x10, 0x87, 0xea, 0x24, 0x18,
+	0x27, 0x28, 0x32, 0x6e, 0xae, 0xef, 0x6d, 0x0f, 0xec, 0xa3, 0x23, 0x74, 0x19, 0xd9, 0x98, 0x14,
+	0x4e, 0x50, 0x0c, 0x98, 0x75, 0x4b, 0x74, 0xcb, 0x0d, 0x1a, 0x2d, 0x7a, 0xfb, 0xf6, 0x53, 0x99,
+	0xc3, 0x50, 0xcc, 0xb2, 0xe8, 0xed, 0xab, 0x7e, 0xc9, 0x21, 0x7a, 0xd1, 0xe4, 0x20, 0x67, 0x3f,
+	0x53, 0x50, 0x34, 0x29, 0xa8, 0x17, 0x4d, 0xc6, 0xe9, 0x45, 0xbb, 0xeb, 0x46, 0xf4, 0x89, 0xfd,
+	0x5c, 0x41, 0xd1, 0x98, 0x94, 0x5e, 0x34, 0x16, 0x81, 0xa3, 0x9b, 0x8c, 0x78, 0x97, 0x06, 0x91,
+	0xd7, 0x75, 0xfb, 0xbc, 0xaa, 0x4e, 0x67, 0x8e, 0x41, 0x31, 0x9f, 0x21, 0x8d, 0xa3, 0x5b, 0x26,
+	0x8d, 0x5e, 0xf0, 0x07, 0xee, 0x66, 0x9f, 0x12, 0xff, 0xb1, 0xfd, 0x4a, 0x41, 0xc1, 0xa5, 0xa0,
+	0x5e, 0x70, 0x19, 0xa7, 0xdb, 0x96, 0x4f, 0x79, 0xbd, 0x6d, 0x1a, 0xd9, 0xe7, 0x0a, 0x6c, 0x0b,
+	0x17, 0xd3, 0x6d, 0x0b, 0x8f, 0x51, 0x16, 0x60, 0xd1, 0x8d, 0xdc, 0x7d, 0x8f, 0x3e, 0x7e, 0xd7,
+	0xa3, 0x8f, 0x71, 0x60, 0x7f, 0x7a, 0x84, 0x05, 0x90, 0xb2, 0x1d, 0x21, 0xac, 0x2c, 0x40, 0x82,
+	0x44, 0x59, 0x00, 0x3d, 0x5e, 0x98, 0xf5, 0x63, 0x23, 0x2c, 0x80, 0xc1, 0xaf, 0x6c, 0x7c, 0x1e,
+	0x95, 0xe5, 0xc2, 0xf1, 0xd4, 0xa7, 0xfb, 0x41, 0x8f, 0x06, 0xf6, 0x0b, 0x2c, 0x91, 0xb3, 0xc5,
+	0x89, 0x30, 0xf1, 0x95, 0x09, 0x92, 0x43, 0x94, 0x4a, 0x62, 0xc3, 0xdf, 0x0b, 0xba, 0x14, 0xeb,
+	0xe9, 0xe5, 0x71, 0x92, 0x50, 0xe2, 0xa9, 0x24, 0xd4, 0x17, 0x6b, 0x1f, 0x5e, 0x50, 0x5f, 0x30,
+	0x61, 0x36, 0x8a, 0xb2, 0xd4, 0xc5, 0xc2, 0xe2, 0x0c, 0x4b, 0xa9, 0x33, 0x3a, 0xa5, 0x24, 0x6a,
+	0x65, 0x82, 0x8c, 0xa6, 0xb5, 0x0e, 0xe0, 0xa4, 0x21, 0xc0, 0xc7, 0x79, 0x3d, 0xe1, 0xb3, 0x2c,
+	0xe1, 0x8b, 0xa3, 0x13, 0x4e, 0xc1, 0x56, 0x26, 0x48, 0x01, 0xb1, 0x35, 0x84, 0xe7, 0x8d, 0xca,
+	0x90, 0x1d, 0x5b, 0xa8, 0xc8, 0x97, 0x59, 0xba, 0x17, 0x46, 0xa7, 0x6b, 0x62, 0x56, 0x26, 0xc8,
+	0x28, 0x4a, 0x6b, 0x1b, 0xec, 0xcc, 0xcf, 0xd8, 0x92, 0x5f, 0xca, 0x9c, 0xf6, 0xe4, 0x24, 0xc7,
+	0xdb, 0x32, 0x97, 0x2c, 0x53, 0xf3, 0x45, 0x75, 0xfe, 0xdf, 0x71, 0x35, 0x5f, 0xd5, 0x63, 0x1e,
+	0x95, 0xd1, 0x76, 0xf8, 0xe9, 0x81, 0x1b, 0x6c, 0xd3, 0x88, 0x57, 0xf4, 0x6a, 0x0f, 0x0b, 0xf5,
+	0x95, 0x71, 0xda, 0x2e, 0x05, 0x33, 0xda, 0x2e, 0x93, 0xd8, 0x0a, 0xe1, 0x84, 0x21, 0xb1, 0x1a,
+	0x2e, 0xf8, 0xfd, 0x3e, 0xed, 0xca, 0xda, 0xfc, 0x7f, 0x2c, 0xe1, 0xd7, 0x46, 0x27, 0x9c, 0x00,
+	0xad, 0x4c, 0x90, 0x91, 0xa4, 0xa9, 0xf2, 0xde, 0xef, 0xf7, 0x12, 0x3a, 0x63, 0x8f, 0xa5, 0xab,
+	0x49, 0x58, 0xaa, 0xbc, 0x29, 0x89, 0x94, 0xae, 0x6a, 0x12, 0x58, 0xdc, 0x67, 0xc7, 0xd1, 0x55,
+	0x13, 0x93, 0xd2, 0x55, 0xf3, 0x33, 0x8e, 0x6e, 0x7b, 0x21, 0x0d, 0x18, 0xc7, 0x6d, 0xdf, 0x1b,
+	0xd8, 0x2f, 0x66, 0x8e, 0x6e, 0x0f, 0x43, 0x1a, 0x88, 0x84, 0x50, 0x0a, 0x47, 0x37, 0x03, 0x66,
+	0xf0, 0xdc, 0xa5, 0x5b, 0x91, 0x7d, 0xaa, 0x88, 0x07, 0xa5, 0x0c, 0x1e, 0x8c, 0xc0, 0x91, 0x42,
+	0x45, 0x6c, 0x50, 0x6c, 0x15, 0xe2, 0xa2, 0x87, 0xe2, 0xa5, 0xcc, 0x91, 0x42, 0xa3, 0xd3, 0x84,
+	0x71, 0xa4, 0xc8, 0x22, 0xc1, 0x95, 0xbf, 0x8a, 0xc7, 0x19, 0x19, 0xa7, 0x9e, 0xc9, 0x5c, 0xf9,
+	0x6b, 0xd4, 0x4a, 0x14, 0xd7, 0x20, 0x69, 0x02, 0xeb, 0x63, 0x50, 0x1d, 0x7a, 0x83, 0x6d, 0xbb,
+	0xc7, 0x88, 0x9e, 0x4e, 0x10, 0xad, 0x7b, 0x83, 0xed, 0x95, 0x09, 0xc2, 0x44, 0xac, 0xb7, 0x01,
+	0x86, 0x81, 0xdf, 0xa5, 0x61, 0xb8, 0x46, 0x1f, 0xdb, 0x94, 0x01, 0x9c, 0x24, 0x80, 0x0b, 0x74,
+	0xd6, 0x28, 0x8e, 0xcb, 0x9a, 0xbc, 0xb5, 0x04, 0x47, 0x44, 0x48, 0xf4, 0xf2, 0xad, 0xcc, 0xc9,
+	0x9f, 0x24, 0x88, 0xbd, 0x40, 0x06, 0x0a, 0xd7, 0x3e, 0x22, 0x62, 0xd1, 0x1f, 0x50, 0x7b, 0x3b,
+	0x73, 0xed, 0x23, 0x49, 0x50, 0x04, 0xe7, 0x58, 0x1a, 0x02, 0xbd, 0x05, 0xd1, 0x4e, 0x40, 0xdd,
+	0xde, 0x46, 0xe4, 0x46, 0x7b, 0xa1, 0x3d, 0xc8, 0x9c, 0xa6, 0xf1, 0x8f, 0x9d, 0x07, 0x4c, 0x12,
+	0xa7, 0xa0, 0x3a, 0xc6, 0x5a, 0x83, 0x36, 0x2e, 0x84, 0xee, 0x7a, 0xbb, 0x5e, 0x44, 0xa8, 0xdb,
+	0xdd, 0xa1, 0x3d, 0xdb, 0xcf, 0x5c, 0x44, 0xe1, 0xb4, 0xb7, 0xa3, 0xcb, 0xe1, 0x6c, 0x25, 0x89,
+	0xb5, 0x56, 0x60, 0x1a, 0xe3, 0x36, 0xd0, 0x31, 0xf8, 0x10, 0xdd, 0x86, 0xf6, 0x30, 0x53, 0x03,
+	0x19, 0x5b, 0x2c, 0x85, 0x93, 0x15, 0x13, 0x27, 0x99, 0xee, 0xfa, 0x5d, 0xb7, 0xcf, 0x99, 0xbe,
+	0x90, 0xcf, 0x14, 0x4b, 0x49, 0xa6, 0x38, 0xc6, 0x28, 0x23, 0xaf, 0xfb, 0x9e, 0xbd, 0x5f, 0x50,
+	0x46, 0x21, 0x67, 0x94, 0x51, 0xc4, 0x21, 0xdf, 0xc0, 0x8f, 0xbc, 0x2d, 0xaf, 0x2b, 0xfa, 0xef,
+	0xa0, 0x67, 0x07, 0x99, 0x7c, 0x6b, 0x9a, 0x58, 0x67, 0x83, 0x7b, 0x96, 0x52, 0x58, 0xeb, 0x01,
+	0x58, 0x7a, 0x9c, 0x50, 0xaa, 0x90, 0x31, 0xce, 0x8c, 0x62, 0x54, 0x9a, 0x95, 0x81, 0xc7, 0x5c,
+	0x0e, 0xdd, 0x03, 0x5c, 0xde, 0xce, 0x07, 0xbe, 0xdb, 0xeb, 0xba, 0x61, 0x64, 0x47, 0x99, 0xb9,
+	0x5c, 0xe7, 0x62, 0x1d, 0x25, 0x87, 0xb9, 0x4c, 0x62, 0x91, 0x6f, 0x97, 0xee, 0x6e, 0xd2, 0x20,
+	0xdc, 0xf1, 0x86, 0x22, 0x8f, 0x7b, 0x99, 0x7c, 0xf7, 0x94, 0x58, 0x9c, 0xc3, 0x14, 0x16, 0x27,
+	0xe2, 0xcc, 0x7d, 0xbc, 0x71, 0x30, 0xe8, 0x72, 0x65, 0x14, 0xa4, 0x8f, 0x33, 0x27, 0xe2, 0x4c,
+	0x33, 0x3a, 0xb1, 0x70, 0x4c, 0x9d, 0x4d, 0x63, 0xdd, 0x81, 0xa3, 0xc3, 0xd9, 0xa1, 0xc1, 0xfc,
+	0x24, 0x73, 0xe2, 0xbc, 0x3e, 0xbb, 0x9e, 0xa4, 0x4c, 0x22, 0xb1, 0xab, 0x79, 0xbb, 0x43, 0x3f,
+	0x88, 0x96, 0xbd, 0x81, 0x17, 0xee, 0xd8, 0x07, 0x99, 0x5d, 0x6d, 0x95, 0x89, 0x74, 0xb8, 0x0c,
+	0x76, 0x35, 0x1d, 0x63, 0x5d, 0x86, 0x46, 0x77, 0xc7, 0x8d, 0xd0, 0x45, 0xf2, 0x55, 0xee, 0xe8,
+	0x7d, 0x36, 0x81, 0x5f, 0xd8, 0x71, 0x23, 0xe1, 0x22, 0x91, 0xa2, 0xd6, 0x75, 0x00, 0xfc, 0x29,
+	0x4a, 0xf0, 0xff, 0x4b, 0x99, 0xb6, 0x8a, 0x01, 0x55, 0xee, 0x35, 0x00, 0xba, 0x13, 0xe2, 0x10,
+	0x76, 0x52, 0xbe, 0xe6, 0xff, 0x89, 0x52, 0xa6, 0xb5, 0xd5, 0x78, 0x94, 0x2c, 0xba, 0x13, 0x32,
+	0x28, 0x64, 0xc6, 0xc4, 0x58, 0xfc, 0xb5, 0x11, 0x19, 0x53, 0xe3, 0xae, 0x06, 0x60, 0x70, 0x7f,
+	0x77, 0x97, 0x0e, 0x22, 0x1c, 0x52, 0x7f, 0x2a, 0x07, 0xce, 0x25, 0x84, 0x3f, 0x51, 0x03, 0xe0,
+	0x40, 0x27, 0x42, 0x22, 0x03, 0x5f, 0xcf, 0x76, 0xc5, 0x4b, 0x06, 0x95, 0x07, 0x13, 0x36, 0xdf,
+	0x80, 0xda, 0xbe, 0xdb, 0xdf, 0xa3, 0xce, 0x9f, 0x96, 0xa1, 0x8a, 0xb9, 0x75, 0x28, 0x54, 0xb0,
+	0xde, 0xa7, 0xa1, 0xec, 0xf5, 0x6c, 0xbe, 0xcf, 0x51, 0xf6, 0x7a, 0xb8, 0x47, 0xe2, 0xe3, 0x74,
+	0x56, 0xed, 0xba, 0xc8, 0x20, 0xb6, 0xab, 0xd8, 0x9d, 0xb1, 0x2b, 0x89, 0x52, 0xf0, 0x1d, 0x17,
+	0xa4, 0x95, 0x1b, 0x39, 0x52, 0xd4, 0xb1, 0xa1, 0x2e, 0x6a, 0x22, 0x91, 0x92, 0xb3, 0x06, 0x75,
+	0xd1, 0x78, 0xc9, 0x3c, 0x68, 0x29, 0x95, 0xc7, 0x4f, 0x89, 0xc2, 0xd1, 0x64, 0xdb, 0x25, 0x89,
+	0xe7, 0xa1, 0x15, 0x28, 0xdd, 0x28, 0x27, 0x5c, 0x4d, 0x29, 0xea, 0x8e, 0x22, 0x22, 0x31, 0xcc,
+	0xf9, 0x2c, 0x34, 0x44, 0x65, 0x3b, 0x57, 0xa1, 0x82, 0x6d, 0xf4, 0x3a, 0x34, 0x44, 0x65, 0xdb,
+	0xa5, 0xcc, 0xad, 0x28, 0x21, 0x4f, 0xa4, 0xd8, 0x88, 0x4a, 0xf9, 0xfd, 0x1a, 0x34, 0xc4, 0x3e,
+	0x88, 0xb3, 0x06, 0x55, 0xb6, 0x69, 0x74, 0x0c, 0x6a, 0xde, 0xa0, 0x47, 0x9f, 0x30, 0xb1, 0x1a,
+	0xe1, 0x01, 0x4c, 0x55, 0xec, 0x8b, 0xd8, 0xe5, 0xcc, 0x54, 0x05, 0x0d, 0x91, 0x62, 0xce, 0xfb,
+	0xd0, 0x90, 0x9b, 0x47, 0x27, 0xa0, 0x35, 0x0c, 0x7c, 0xb4, 0xf8, 0xab, 0x32, 0xf5, 0x38, 0xc2,
+	0x7a, 0x03, 0x1a, 0x3d, 0x2e, 0x28, 0xa8, 0x9f, 0xed, 0xf0, 0xad, 0xbe, 0x8e, 0xdc, 0xea, 0xeb,
+	0x6c, 0xb0, 0xad, 0x3e, 0x22, 0xe5, 0x9c, 0xaf, 0x96, 0xa0, 0xce, 0xf7, 0x90, 0x9c, 0x7d, 0xd5,
+	0xae, 0x6f, 0x42, 0xbd, 0xcb, 0xe2, 0xec, 0xa4, 0xd2, 0x1a, 0x39, 0x14, 0x9b, 0x52, 0x44, 0x08,
+	0x23, 0x2c, 0xe4, 0x23, 0x7d, 0x79, 0x24, 0x8c, 0x5b, 0x2e, 0x22, 0x84, 0x7f, 0x64, 0xe9, 0xfe,
+	0x57, 0x09, 0x8e, 0x98, 0x5b, 0x53, 0xb8, 0x77, 0x29, 0x03, 0xb2, 0x76, 0xbb, 0xda, 0xc6, 0x15,
+	0x74, 0xfb, 0x1e, 0x1d, 0x44, 0xcc, 0x0b, 0x5b, 0xce, 0x9c, 0xdc, 0x67, 0x6e, 0x85, 0x75, 0x16,
+	0x14, 0x8c, 0x68, 0x14, 0xce, 0x57, 0x00, 0xe2, 0x2f, 0xd6, 0x29, 0x35, 0xdd, 0x5a, 0x73, 0x77,
+	0x65, 0xf2, 0x7a, 0x94, 0x26, 0xb1, 0xee, 0x46, 0x3b, 0xa2, 0x9b, 0xeb, 0x51, 0xd6, 0x05, 0x78,
+	0x2a, 0xf4, 0xb6, 0x07, 0x6e, 0xb4, 0x17, 0xd0, 0x77, 0x69, 0xe0, 0x6d, 0x79, 0xb4, 0xc7, 0x3a,
+	0x7d, 0x93, 0xa4, 0x3f, 0x38, 0xbf, 0x3a, 0x09, 0x75, 0xbe, 0x8c, 0x72, 0xfe, 0xa3, 0xac, 0x74,
+	0xcc, 0xf9, 0xb3, 0x12, 0xd4, 0xf8, 0x76, 0x52, 0xb2, 0x1b, 0x2e, 0xeb, 0xfa, 0x55, 0xc9, 0x58,
+	0x63, 0x64, 0x6d, 0xaf, 0x75, 0xee, 0xd0, 0x83, 0x77, 0xd1, 0x84, 0x29, 0xa5, 0xb3, 0x8e, 0x43,
+	0x3d, 0xdc, 0xdb, 0x44, 0xb7, 0x71, 0xe5, 0x54, 0xe5, 0x5c, 0x8b, 0x88, 0x90, 0x73, 0x1b, 0x9a,
+	0x52, 0xd8, 0x6a, 0x43, 0xe5, 0x11, 0x3d, 0x10, 0x89, 0xe3, 0x4f, 0xeb, 0x82, 0x30, 0x85, 0xaa,
+	0xdb, 0x24, 0x75, 0x9b, 0xa7, 0x22, 0xec, 0xe5, 0xe7, 0x78, 0x1f, 0x4f, 0x16, 0xe1, 0xf0, 0x5d,
+	0x24, 0x37, 0xb7, 0x0b, 0x50, 0xe3, 0x5b, 0x7a, 0xc9, 0x34, 0x2c, 0xa8, 0x3e, 0xa2, 0x07, 0xbc,
+	0x8e, 0x5a, 0x84, 0xfd, 0xce, 0x25, 0xf9, 0xbb, 0x1a, 0x4c, 0xe9, 0xdb, 0x18, 0xce, 0x52, 0xae,
+	0x79, 0x77, 0xb7, 0x22, 0xdd, 0xbc, 0x8b, 0x20, 0x5a, 0x19, 0xc6, 0xc5, 0xda, 0xb9, 0x45, 0x78,
+	0xc0, 0xe9, 0x40, 0x5d, 0xec, 0x0e, 0x25, 0x99, 0x94, 0x7c, 0x59, 0x97, 0xbf, 0x0d, 0x4d, 0xb5,
+	0xd9, 0xf3, 0x61, 0xd3, 0xfe, 0x93, 0x12, 0x34, 0xd5, 0xb6, 0xce, 0x31, 0xa8, 0x45, 0x7e, 0xe4,
+	0xf6, 0x19, 0x5f, 0x85, 0xf0, 0x00, 0xf6, 0xb4, 0x01, 0x7d, 0x12, 0x2d, 0x28, 0x33, 0x58, 0x21,
+	0x71, 0x04, 0xb7, 0x72, 0x74, 0x9f, 0x7f, 0xad, 0xf0, 0xaf, 0x2a, 0x22, 0x4e, 0xb4, 0xaa, 0x25,
+	0x6a, 0x2d, 0x43, 0x73, 0xcb, 0x0f, 0x76, 0xf7, 0xfa, 0x6e, 0x68, 0xd7, 0x98, 0x72, 0x9e, 0x1f,
+	0x63, 0xd3, 0x68, 0x99, 0x43, 0x88, 0xc2, 0x3a, 0xdf, 0x2a, 0x41, 0x43, 0xc4, 0x62, 0x87, 0x0b,
+	0xc4, 0x22, 0xf8, 0x8e, 0xd2, 0x45, 0x3d, 0xca, 0x7a, 0x00, 0x0d, 0x81, 0x64, 0xa5, 0x98, 0x9e,
+	0xbd, 0x96, 0xb0, 0x3d, 0x7c, 0x69, 0xb8, 0xe0, 0x0f, 0x22, 0x36, 0xca, 0x27, 0x5d, 0x37, 0x32,
+	0x03, 0x0f, 0x0e, 0x86, 0x94, 0x48, 0x2a, 0xac, 0x70, 0xdc, 0x55, 0x18, 0xaa, 0x8a, 0x95, 0xc1,
+	0xb8, 0x0f, 0x54, 0xc7, 0xe9, 0x03, 0x07, 0x50, 0x17, 0xfb, 0x5f, 0xaa, 0xce, 0x4a, 0x7a, 0x9d,
+	0xcd, 0x41, 0x8d, 0x11, 0xdb, 0xe5, 0xc4, 0x36, 0xde, 0xc8, 0xbc, 0x33, 0x4a, 0xc2, 0x91, 0xa8,
+	0xd7, 0x01, 0xdf, 0xcc, 0xe4, 0x66, 0x46, 0x84, 0x9c, 0xdf, 0x2c, 0x41, 0x4b, 0x16, 0x32, 0x74,
+	0xde, 0xcf, 0xb3, 0x28, 0x73, 0x70, 0x44, 0x56, 0x27, 0x9a, 0x4c, 0x69, 0x57, 0x9e, 0x4f, 0xe4,
+	0x84, 0x68, 0x32, 0xc4, 0x44, 0x38, 0x6f, 0xe7, 0x6a, 0xfa, 0x0c, 0x4c, 0x69, 0x6d, 0x25, 0xfb,
+	0xa3, 0x11, 0xe7, 0x38, 0x0a, 0xdd, 0x86, 0x8a, 0xd7, 0xe3, 0xe7, 0x5c, 0x5a, 0x04, 0x7f, 0x3a,
+	0x5b, 0x30, 0xa5, 0xef, 0x21, 0x39, 0xef, 0x66, 0x9b, 0x94, 0x9b, 0x98, 0x4c, 0x2c, 0x26, 0x2a,
+	0x33, 0x5d, 0x84, 0x58, 0x84, 0x18, 0x00, 0xe7, 0x59, 0xa8, 0xf1, 0x9d, 0xed, 0xe4, 0xa4, 0xe2,
+	0xeb, 0xdb, 0x50, 0x63, 0x8d, 0xe0, 0x5c, 0xe2, 0x56, 0xe1, 0x02, 0xd4, 0x99, 0x97, 0x46, 0x1e,
+	0xc7, 0x39, 0x96, 0xd5, 0x62, 0x44, 0xc8, 0x38, 0x0b, 0x30, 0xa9, 0xed, 0x29, 0xa2, 0x56, 0xb1,
+	0x0f, 0x4a, 0x0b, 0x64, 0xd0, 0x72, 0xa0, 0x89, 0x33, 0x08, 0x31, 0xaa, 0x60, 0xf9, 0x55, 0xd8,
+	0x39, 0xad, 0xa6, 0x3c, 0x8e, 0xd8, 0x43, 0x5d, 0x55, 0xb5, 0xa4, 0xc2, 0xce, 0x67, 0xa1, 0xa5,
+	0xb6, 0x1e, 0xad, 0xfb, 0x30, 0x25, 0xb6, 0x1e, 0xb9, 0xe7, 0x04, 0x85, 0xa7, 0x0b, 0xb4, 0x0b,
+	0xdd, 0x24, 0x6c, 0xf7, 0xb2, 0xc3, 0xba, 0x82, 0x41, 0xe0, 0x7c, 0xff, 0x55, 0x56, 0xf3, 0xce,
+	0x10, 0x9a, 0x6a, 0xbf, 0x25, 0xd9, 0x0a, 0x57, 0xf9, 0x78, 0x51, 0x2e, 0xdc, 0x2c, 0x14, 0x1d,
+	0xef, 0x0e, 0x3d, 0x60, 0xc3, 0x8a, 0xf3, 0x3c, 0x54, 0xb0, 0x27, 0x1f, 0x93, 0x3d, 0x4b, 0xf4,
+	0x10, 0xde, 0x83, 0x56, 0xa1, 0x2e, 0xf6, 0x3d, 0x93, 0xe9, 0x5d, 0x84, 0xfa, 0x16, 0xfb, 0x52,
+	0x34, 0x8e, 0x08, 0x31, 0xe7, 0x26, 0x4c, 0xea, 0xbb, 0x9d, 0x49, 0xbe, 0x53, 0x30, 0xd9, 0x8d,
+	0x3f, 0x8b, 0x66, 0xd0, 0xa3, 0x1c, 0x6a, 0xaa, 0x63, 0x8a, 0x61, 0x29, 0x53, 0x0f, 0x5f, 0xca,
+	0xac, 0xf6, 0x11, 0xda, 0x78, 0x07, 0x8e, 0x26, 0xb7, 0x35, 0x93, 0x29, 0x9d, 0x83, 0xa3, 0x9b,
+	0xa6, 0x88, 0x18, 0x18, 0x92, 0xd1, 0xce, 0x2a, 0xd4, 0xf8, 0xb6, 0x53, 0x92, 0xe2, 0x75, 0xa8,
+	0xb9, 0xf8, 0x41, 0x98, 0x4d, 0x27, 0x33, 0x97, 0x0c, 0x4a, 0xb8, 0xa0, 0xe3, 0xc1, 0x11, 0x73,
+	0x27, 0x2b, 0x49, 0xb9, 0x02, 0x47, 0xf6, 0x75, 0x01, 0x41, 0x3d, 0x93, 0x49, 0x6d, 0x50, 0x11,
+	0x13, 0xe8, 0xfc, 0x43, 0x03, 0xaa, 0x6c, 0x2b, 0x36, 0x99, 0xc4, 0x15, 0xa8, 0xe2, 0x41, 0x36,
+	0x51, 0xb5, 0x33, 0x23, 0xf7, 0x75, 0xd9, 0x3f, 0x84, 0xc9, 0x5b, 0x1f, 0x87, 0x5a, 0x18, 0x1d,
+	0xf4, 0xe5, 0x02, 0xec, 0xe5, 0xd1, 0xc0, 0x0d, 0x14, 0x25, 0x1c, 0x81, 0x50, 0xd6, 0x17, 0xec,
+	0xea, 0x38, 0x50, 0xd6, 0x09, 0x09, 0x47, 0x58, 0x37, 0x71, 0x41, 0x4f, 0xbb, 0x8f, 0x68, 0xcf,
+	0xae, 0x15, 0x74, 0x0b, 0x06, 0x5e, 0xe0, 0xc2, 0x44, 0xa2, 0x30, 0xed, 0x2e, 0x6b, 0xdd, 0xfa,
+	0x38, 0x69, 0xb3, 0x16, 0x27, 0x1c, 0x61, 0x2d, 0x41, 0xcb, 0xeb, 0xfa, 0x83, 0xa5, 0x5d, 0xff,
+	0xf3, 0x9e, 0xdd, 0x18, 0xb1, 0x2f, 0xa5, 0xe0, 0xab, 0x52, 0x9c, 0xc4, 0x48, 0x49, 0xb3, 0xba,
+	0x8b, 0x6b, 0xca, 0xe6, 0xb8, 0x34, 0x4c, 0x9c, 0xc4, 0x48, 0xe7, 0x77, 0x4a, 0xa2, 0x41, 0x33,
+	0x7b, 0xb9, 0x75, 0x1d, 0x6a, 0xb4, 0xe7, 0x45, 0x72, 0xf4, 0x39, 0x5b, 0xdc, 0xae, 0x9d, 0xa5,
+	0x9e, 0x17, 0x11, 0x8e, 0x72, 0xd6, 0xa1, 0x8a, 0x41, 0x9c, 0xf7, 0x6d, 0x05, 0xfe, 0xae, 0x58,
+	0xee, 0xb1, 0xdf, 0x68, 0x74, 0x7b, 0xcc, 0x7c, 0xaa, 0xb9, 0x93, 0x08, 0xa2, 0x39, 0xf5, 0x06,
+	0x21, 0x0d, 0x22, 0x2a, 0x47, 0x79, 0x15, 0x76, 0x96, 0xa1, 0xc6, 0x94, 0x00, 0x73, 0x16, 0xe7,
+	0x77, 0x7a, 0xf6, 0x6c, 0xa6, 0x2e, 0x1b, 0x36, 0x54, 0x28, 0x0f, 0x37, 0x5f, 0x3f, 0x5b, 0x82,
+	0x1a, 0x53, 0x09, 0x93, 0x68, 0x72, 0x1c, 0x22, 0xa1, 0x4a, 0xbc, 0x86, 0x16, 0xa0, 0x89, 0x13,
+	0x30, 0xcf, 0x57, 0x8b, 0xac, 0xb1, 0x19, 0x14, 0xd0, 0x79, 0x11, 0x1a, 0x42, 0xc5, 0xcc, 0x76,
+	0x68, 0xca, 0xec, 0xbe, 0x00, 0x35, 0x6e, 0x70, 0xb2, 0x8d, 0xf1, 0x4b, 0xd0, 0x52, 0x4a, 0x32,
+	0x5a, 0x84, 0xb5, 0x7a, 0x8e, 0xc8, 0x4f, 0x97, 0xa1, 0xc6, 0xb7, 0xda, 0xd3, 0x43, 0x88, 0xde,
+	0xbb, 0x5f, 0x1e, 0xbd, 0x73, 0xaf, 0x77, 0xef, 0x65, 0x68, 0x89, 0x55, 0x98, 0x3a, 0xd5, 0x7a,
+	0xae, 0x00, 0xbd, 0x2e, 0xe5, 0x49, 0x0c, 0x75, 0x4e, 0x8c, 0xd2, 0x52, 0xe7, 0x3e, 0xb4, 0x14,
+	0xca, 0x9a, 0x37, 0x15, 0xe3, 0xc2, 0xc8, 0xd6, 0x48, 0x26, 0x29, 0x08, 0x7f, 0xa9, 0x04, 0x15,
+	0x3c, 0x0b, 0x91, 0xac, 0x87, 0xb7, 0xa4, 0xb5, 0x2a, 0x32, 0x73, 0x8b, 0xde, 0xbe, 0x61, 0xac,
+	0x9c, 0x25, 0xa9, 0xb7, 0x6f, 0x9b, 0xd9, 0x3b, 0x33, 0x7a, 0x66, 0x19, 0xd3, 0xf0, 0x8c, 0xfd,
+	0x42, 0x03, 0xaa, 0xec, 0x14, 0x4b, 0x96, 0xfd, 0x3d, 0x18, 0x16, 0x67, 0x0c, 0xc1, 0x7c, 0x22,
+	0xc1, 0xe4, 0xb9, 0xfd, 0x75, 0xa3, 0x62, 0xfb, 0xcb, 0x80, 0x1b, 0x28, 0x4a, 0x38, 0x02, 0x93,
+	0xdc, 0xf5, 0x76, 0xe5, 0x84, 0xbb, 0x20, 0xc9, 0x7b, 0xde, 0x2e, 0x25, 0x4c, 0x1e, 0x71, 0x3b,
+	0x6e, 0xb8, 0x63, 0xd7, 0xc6, 0xc1, 0xad, 0xb8, 0xe1, 0x0e, 0x61, 0xf2, 0x88, 0x1b, 0xe0, 0xfa,
+	0xbf, 0x3e, 0x0e, 0x0e, 0xdd, 0x02, 0x84, 0xc9, 0x23, 0x2e, 0xf4, 0xbe, 0x48, 0xed, 0xc6, 0x38,
+	0xb8, 0x0d, 0xef, 0x8b, 0x94, 0x30, 0xf9, 0x78, 0x68, 0x6a, 0x8e, 0x57, 0x35, 0xda, 0xd0, 0xf4,
+	0x00, 0xa6, 0x23, 0x63, 0x2f, 0x56, 0x1c, 0xa5, 0xba, 0x50, 0xd0, 0x2e, 0x06, 0x86, 0x24, 0x38,
+	0xb0, 0x13, 0x30, 0x6f, 0x47, 0x76, 0x27, 0x78, 0x01, 0x6a, 0x9f, 0xf2, 0x7a, 0xd1, 0x8e, 0xf9,
+	0xb9, 0x26, 0x3f, 0x33, 0xc3, 0xe9, 0x46, 0x87, 0x34, 0x9c, 0x7a, 0xab, 0x73, 0x9e, 0x45, 0xa8,
+	0xa2, 0xfa, 0x1c, 0x4e, 0x8f, 0x63, 0xad, 0xd3, 0x73, 0x73, 0x68, 0x33, 0xae, 0x57, 0x34, 0xe7,
+	0x39, 0x01, 0x55, 0xd4, 0x90, 0x9c, 0x2a, 0x39, 0x01, 0x55, 0xd4, 0xbb, 0xfc, 0xaf, 0xd8, 0xda,
+	0xe6, 0xd7, 0x8a, 0xfc, 0x7a, 0x06, 0xa6, 0xcd, 0xe6, 0xc8, 0x61, 0xf9, 0xc3, 0x26, 0x54, 0xd9,
+	0x91, 0xb0, 0x64, 0x8f, 0xfc, 0x24, 0x1c, 0xe1, 0xed, 0x37, 0x2f, 0x96, 0x16, 0xe5, 0xcc, 0x13,
+	0xa1, 0xe6, 0x41, 0x33, 0xa1, 0x02, 0x02, 0x42, 0x4c, 0x86, 0xf1, 0x27, 0x4b, 0x8c, 0xca, 0xd0,
+	0xc8, 0xb7, 0xd5, 0xa4, 0xbc, 0x5a, 0x70, 0x1e, 0x91, 0x61, 0xf9, 0xd4, 0x5e, 0xce, 0xd0, 0xad,
+	0x79, 0x68, 0xe2, 0x94, 0x01, 0xab, 0x4b, 0x74, 0xdb, 0x33, 0xa3, 0xf1, 0xab, 0x42, 0x9a, 0x28,
+	0x1c, 0x4e, 0x58, 0xba, 0x6e, 0xd0, 0x63, 0xb9, 0x12, 0x7d, 0xf8, 0xec, 0x68, 0x92, 0x05, 0x29,
+	0x4e, 0x62, 0xa4, 0x75, 0x07, 0x26, 0x7b, 0x54, 0xb9, 0x29, 0xec, 0xc6, 0x88, 0xe3, 0x20, 0x8a,
+	0x68, 0x31, 0x06, 0x10, 0x1d, 0x8d, 0x79, 0x92, 0x6b, 0xde, 0xb0, 0x70, 0x12, 0xc5, 0xa8, 0xe2,
+	0x73, 0xdf, 0x31, 0xd2, 0x5a, 0x83, 0xa9, 0x2d, 0xbf, 0xbb, 0x17, 0xca, 0x96, 0xe6, 0x9d, 0xfd,
+	0x7c, 0x41, 0x15, 0x6b, 0x08, 0x62, 0xe0, 0x9d, 0x57, 0xe0, 0x88, 0xa1, 0x07, 0x39, 0xaa, 0x77,
+	0x1a, 0xa6, 0x74, 0x92, 0x1c, 0xa9, 0x1f, 0xac, 0xab, 0xe9, 0x1a, 0xc4, 0x79, 0xae, 0xaa, 0x05,
+	0xdf, 0x6b, 0xe6, 0x8c, 0x29, 0x77, 0x7d, 0x27, 0x80, 0x77, 0xa1, 0x29, 0xd5, 0xc1, 0xba, 0x65,
+	0xe6, 0xe1, 0x7c, 0x71, 0x1e, 0x94, 0x26, 0x09, 0xb6, 0x35, 0x68, 0x29, 0xbd, 0x40, 0x37, 0x8d,
+	0x4e, 0xf7, 0x6a, 0x31, 0x5d, 0xac, 0x53, 0x82, 0x8f, 0xc0, 0xa4, 0xa6, 0x1e, 0xd6, 0x82, 0xc9,
+	0xf8, 0x5a, 0x31, 0xa3, 0xae, 0x5c, 0xf1, 0x5c, 0x4b, 0xe9, 0x89, 0xde, 0x2a, 0x95, 0xb8, 0x55,
+	0x7e, 0xaf, 0x01, 0x4d, 0x75, 0xf8, 0x33, 0x63, 0xc5, 0xbe, 0x17, 0xf4, 0x0b, 0x57, 0xec, 0x12,
+	0xdf, 0x79, 0x18, 0xf4, 0x09, 0x22, 0xb0, 0x89, 0x23, 0x2f, 0x52, 0x06, 0xe2, 0x6c, 0x31, 0xf4,
+	0x01, 0x8a, 0x13, 0x8e, 0xb2, 0xee, 0x9b, 0x7d, 0xab, 0x3a, 0xe2, 0x70, 0x90, 0x41, 0x92, 0xdb,
+	0xbf, 0x56, 0xa1, 0xe5, 0xe1, 0x84, 0x73, 0x25, 0x1e, 0xef, 0x5f, 0x2d, 0xa6, 0x5b, 0x95, 0x10,
+	0x12, 0xa3, 0x31, 0x6f, 0x5b, 0xee, 0x3e, 0x5a, 0x13, 0x46, 0x56, 0x1f, 0x37, 0x6f, 0xcb, 0x31,
+	0x88, 0xe8, 0x0c, 0xd6, 0x35, 0x31, 0x63, 0x6a, 0x14, 0xd8, 0xb3, 0xb8, 0xaa, 0xe2, 0x59, 0xd3,
+	0x7b, 0xa9, 0xf1, 0x9d, 0x1b, 0x8f, 0xd7, 0xc7, 0x60, 0x19, 0x39, 0xc6, 0x63, 0x0b, 0xf2, 0xf9,
+	0x58, 0x6b, 0xdc, 0x16, 0xd4, 0xe7, 0x64, 0xe8, 0xb2, 0x79, 0x18, 0xf4, 0xf3, 0x67, 0x08, 0xac,
+	0xb9, 0x73, 0x3e, 0xbf, 0x6c, 0xf6, 0x84, 0xfc, 0x65, 0x84, 0x6a, 0x93, 0x5c, 0x1e, 0xad, 0xd2,
+	0x73, 0x84, 0xae, 0x8b, 0x69, 0xc4, 0x9b, 0x66, 0x7f, 0x7b, 0x31, 0xd1, 0xdf, 0xb0, 0x87, 0xad,
+	0x07, 0x94, 0x9f, 0x7f, 0xd3, 0xe6, 0x0f, 0xe3, 0x8e, 0xce, 0xb7, 0xe5, 0xac, 0xe7, 0x50, 0x96,
+	0x22, 0x59, 0xb7, 0x9c, 0xeb, 0x9b, 0x65, 0x68, 0xaa, 0xb3, 0xbd, 0xe9, 0x0d, 0xa0, 0xa6, 0x17,
+	0xae, 0x50, 0x17, 0xcf, 0xb3, 0x96, 0x0b, 0xcc, 0xbf, 0x24, 0xe9, 0xac, 0x0a, 0x04, 0x51, 0x58,
+	0xec, 0x31, 0x5d, 0xda, 0xef, 0x6f, 0x0c, 0xdd, 0x41, 0x28, 0x7a, 0xf1, 0xab, 0xc5, 0x44, 0x0b,
+	0x12, 0x42, 0x62, 0xb4, 0x73, 0x0a, 0x9a, 0x32, 0x81, 0x9c, 0x55, 0xe5, 0x27, 0xa1, 0xa5, 0x90,
+	0xd6, 0xa2, 0x6e, 0xa7, 0xf4, 0x13, 0xac, 0x99, 0xab, 0xd8, 0x64, 0xca, 0x92, 0xf2, 0xbb, 0x65,
+	0xa8, 0x8b, 0x33, 0xcb, 0xc9, 0x2a, 0xba, 0x01, 0xf5, 0xbe, 0x7b, 0xe0, 0xef, 0xc9, 0x65, 0xe4,
+	0x99, 0x82, 0x63, 0xd0, 0x9d, 0xbb, 0x4c, 0x9a, 0x08, 0x94, 0xf5, 0x09, 0xa8, 0xf5, 0xf1, 0x30,
+	0x8f, 0x5d, 0x29, 0xb0, 0x8b, 0x12, 0x8e, 0xc2, 0x84, 0x63, 0x30, 0x71, 0x76, 0x54, 0x51, 0x5e,
+	0x34, 0x29, 0x4c, 0xfc, 0x5d, 0x26, 0x4d, 0x04, 0xca, 0xb9, 0x0d, 0x75, 0x9e, 0x9d, 0xc3, 0x0d,
+	0x61, 0x66, 0x49, 0xe2, 0x7e, 0xc8, 0xf2, 0x96, 0x33, 0x53, 0x3f, 0x09, 0x75, 0x9e, 0x78, 0x8e,
+	0x4e, 0x7f, 0xe7, 0x39, 0xb6, 0x06, 0xec, 0x3b, 0x77, 0xe3, 0xdd, 0xef, 0x0f, 0xbf, 0x99, 0xe7,
+	0x3c, 0x80, 0xa3, 0xb8, 0x91, 0xb1, 0xe9, 0x86, 0x94, 0xd0, 0xae, 0x1f, 0xf4, 0x32, 0x59, 0x03,
+	0xfe, 0x49, 0xf8, 0x83, 0xf2, 0x59, 0x85, 0xdc, 0x8f, 0xdd, 0xc4, 0xff, 0x7b, 0xdc, 0xc4, 0x7f,
+	0x50, 0xcd, 0xf1, 0xdd, 0x8e, 0xe3, 0xdd, 0x41, 0x85, 0x4b, 0x39, 0x6f, 0xaf, 0x99, 0xeb, 0x91,
+	0xd3, 0x05, 0x48, 0x63, 0x41, 0x72, 0xcd, 0xf4, 0xde, 0x16, 0x61, 0x0d, 0xf7, 0xed, 0xad, 0xa4,
+	0xfb, 0xf6, 0x4c, 0x01, 0x3a, 0xe5, 0xbf, 0xbd, 0x66, 0xfa, 0x6f, 0x8b, 0x52, 0xd7, 0x1d, 0xb8,
+	0x05, 0xbe, 0xa8, 0x8f, 0xca, 0x41, 0xb9, 0xfc, 0xd1, 0xf8, 0x27, 0x3f, 0xb4, 0x6b, 0xf1, 0x97,
+	0x73, 0x5c, 0x61, 0x1f, 0x37, 0x5d, 0x61, 0x23, 0xb4, 0xe6, 0x87, 0xe5, 0x0b, 0xfb, 0x95, 0x7a,
+	0x8e, 0x2f, 0xec, 0xaa, 0xe1, 0x0b, 0x1b, 0x91, 0xb3, 0xa4, 0x33, 0xec, 0x9a, 0xe9, 0x0c, 0x3b,
+	0x5d, 0x80, 0x34, 0xbc, 0x61, 0x57, 0x0d, 0x6f, 0x58, 0x51, 0xa2, 0x9a, 0x3b, 0xec, 0xaa, 0xe1,
+	0x0e, 0x2b, 0x02, 0x6a, 0xfe, 0xb0, 0xab, 0x86, 0x3f, 0xac, 0x08, 0xa8, 0x39, 0xc4, 0xae, 0x1a,
+	0x0e, 0xb1, 0x22, 0xa0, 0xe6, 0x11, 0xbb, 0x66, 0x7a, 0xc4, 0x8a, 0xeb, 0x47, 0x6b, 0xf4, 0x1f,
+	0x3b, 0xaf, 0xfe, 0x07, 0x9d, 0x57, 0x3f, 0x5f, 0xc9, 0x71, 0x4a, 0x91, 0x6c, 0xa7, 0xd4, 0x85,
+	0xfc, 0x96, 0x2c, 0xf6, 0x4a, 0x8d, 0x3f, 0x0a, 0xa4, 0xdd, 0x52, 0xd7, 0x13, 0x6e, 0xa9, 0x57,
+	0x0a, 0xc0, 0xa6, 0x5f, 0x6a, 0x5c, 0x47, 0xc9, 0x8f, 0xdc, 0x05, 0xf2, 0xdb, 0xf5, 0x11, 0xab,
+	0xfd, 0xb7, 0xf4, 0xd5, 0xfe, 0x88, 0x91, 0x2c, 0xbd, 0xdc, 0xbf, 0x61, 0x2e, 0xf7, 0xcf, 0x8d,
+	0x81, 0x35, 0xd6, 0xfb, 0xeb, 0x59, 0xeb, 0xfd, 0xce, 0x18, 0x2c, 0xb9, 0x0b, 0xfe, 0xdb, 0xe9,
+	0x05, 0xff, 0x85, 0x31, 0xf8, 0x32, 0x57, 0xfc, 0xeb, 0x59, 0x2b, 0xfe, 0x71, 0x72, 0x97, 0xbb,
+	0xe4, 0xff, 0x84, 0xb1, 0xe4, 0x3f, 0x3b, 0x4e, 0x75, 0xc5, 0x83, 0xc3, 0xa7, 0x73, 0xd6, 0xfc,
+	0x6f, 0x8c, 0x43, 0x33, 0xda, 0xb1, 0xff, 0xe3, 0x55, 0xbb, 0x99, 0xcc, 0x6f, 0xbd, 0x08, 0x4d,
+	0x79, 0xa8, 0xca, 0xf9, 0x02, 0x34, 0xe4, 0x45, 0xd5, 0x64, 0xcf, 0x39, 0xae, 0x16, 0x75, 0x7c,
+	0xf6, 0x2c, 0x42, 0xd6, 0x0d, 0xa8, 0xe2, 0x2f, 0xd1, 0x2d, 0xce, 0x8f, 0x77, 0x78, 0x0b, 0x13,
+	0x21, 0x0c, 0xe7, 0xfc, 0xfb, 0x31, 0x00, 0xed, 0xfe, 0xde, 0xb8, 0xc9, 0xbe, 0x83, 0xc6, 0xac,
+	0x1f, 0xd1, 0x80, 0x9d, 0x64, 0x2c, 0xbc, 0xdf, 0x16, 0xa7, 0x80, 0xda, 0x12, 0xd1, 0x80, 0x08,
+	0xb8, 0x75, 0x0f, 0x9a, 0xd2, 0xb9, 0x6c, 0x57, 0x4f, 0x55, 0x72, 0x95, 0x2c, 0x8b, 0x4a, 0x3a,
+	0x1e, 0x89, 0xa2, 0xb0, 0xe6, 0xa0, 0x1a, 0xfa, 0x41, 0x24, 0x0e, 0xff, 0xbd, 0x36, 0x36, 0xd5,
+	0x86, 0x1f, 0x44, 0x84, 0x41, 0x79, 0xd1, 0xb4, 0xe7, 0x11, 0x0e, 0x53, 0x34, 0xc3, 0x62, 0xff,
+	0x5b, 0x45, 0xd9, 0xd0, 0x05, 0xd1, 0x1b, 0xb9, 0x0e, 0x5d, 0x1c, 0xbf, 0x95, 0xf4, 0x5e, 0x69,
+	0x89, 0x49, 0x10, 0x6f, 0x09, 0xf6, 0xdb, 0x3a, 0x0f, 0xed, 0xae, 0xbf, 0x4f, 0x03, 0xa2, 0x9d,
+	0x50, 0xe4, 0xe7, 0x08, 0x52, 0xf1, 0x78, 0xd6, 0x60, 0xc7, 0xeb, 0xd1, 0xd5, 0xae, 0xb0, 0x7f,
+	0x4d, 0xa2, 0xc2, 0xd6, 0x1d, 0x68, 0xb2, 0x7d, 0x07, 0xb9, 0xeb, 0x71, 0xb8, 0x4c, 0xf2, 0xed,
+	0x0f, 0x49, 0x80, 0x09, 0xb1, 0xc4, 0x97, 0xbd, 0x88, 0xd5, 0x61, 0x93, 0xa8, 0x30, 0x66, 0x98,
+	0x9d, 0x19, 0xd4, 0x33, 0xdc, 0xe0, 0x19, 0x4e, 0xc6, 0x5b, 0x97, 0xe1, 0x19, 0x16, 0x97, 0x58,
+	0x62, 0xf2, 0xed, 0x8b, 0x26, 0xc9, 0xfe, 0xc8, 0xce, 0x8d, 0xba, 0xdb, 0xfc, 0x32, 0x14, 0x73,
+	0x2d, 0xd6, 0x48, 0x1c, 0x81, 0x87, 0xa3, 0x7b, 0x74, 0xcb, 0xdd, 0xeb, 0x47, 0x0f, 0xe8, 0xee,
+	0xb0, 0xef, 0x46, 0x78, 0x86, 0x1e, 0x58, 0x06, 0xd2, 0x1f, 0xac, 0xd7, 0xe1, 0x69, 0x11, 0xc9,
+	0xbb, 0x31, 0xb6, 0xc6, 0x6a, 0x8f, 0x3d, 0x58, 0xd0, 0x22, 0x59, 0x9f, 0x9c, 0xef, 0x54, 0xb1,
+	0xd1, 0x99, 0x6a, 0xbf, 0x03, 0x15, 0xb7, 0xd7, 0x13, 0xc3, 0xe6, 0xa5, 0x43, 0x76, 0x10, 0x71,
+	0xc3, 0x06, 0x19, 0xac, 0x75, 0x75, 0xbc, 0x92, 0x0f, 0x9c, 0x57, 0x0e, 0xcb, 0xa5, 0x1e, 0x8e,
+	0x11, 0x3c, 0xc8, 0xb8, 0xc7, 0x24, 0xec, 0xca, 0x0f, 0xc6, 0xa8, 0xae, 0xf1, 0x08, 0x1e, 0xeb,
+	0x36, 0x54, 0x59, 0x0e, 0xf9, 0xc0, 0x7a, 0xf9, 0xb0, 0x7c, 0xf7, 0x78, 0xfe, 0x18, 0x87, 0xd3,
+	0xe5, 0xe7, 0x1c, 0xb5, 0x13, 0xc7, 0x25, 0xf3, 0xc4, 0xf1, 0x3c, 0xd4, 0xbc, 0x88, 0xee, 0xa6,
+	0x0f, 0xa0, 0x8f, 0x54, 0x55, 0x61, 0x79, 0x38, 0x74, 0xe4, 0x99, 0xcf, 0xf7, 0x73, 0x2f, 0xb7,
+	0xdc, 0x82, 0x2a, 0xc2, 0x53, 0x73, 0xc9, 0x71, 0x12, 0x66, 0x48, 0x67, 0x16, 0xaa, 0x58, 0xd8,
+	0x11, 0xa5, 0x13, 0xf9, 0x29, 0xab, 0xfc, 0xcc, 0x4f, 0x42, 0xcb, 0x1f, 0xd2, 0x80, 0x75, 0x0c,
+	0xe7, 0x5f, 0xab, 0xda, 0x01, 0xc8, 0x55, 0x5d, 0xc7, 0xde, 0x3c, 0xb4, 0xe5, 0xd4, 0xb5, 0x8c,
+	0x24, 0xb4, 0xec, 0xad, 0xc3, 0xb3, 0xa5, 0xf4, 0x8c, 0x24, 0xf4, 0xec, 0x07, 0xe0, 0x4c, 0x69,
+	0xda, 0x5d, 0x43, 0xd3, 0xae, 0x1c, 0x9e, 0xd1, 0xd0, 0x35, 0x5a, 0xa4, 0x6b, 0x8b, 0xa6, 0xae,
+	0x75, 0x0e, 0x77, 0xb4, 0x7b, 0x1c, 0x6d, 0xfb, 0x6c, 0xae, 0xb6, 0xcd, 0x1b, 0xda, 0x76, 0xd8,
+	0xa4, 0x3f, 0x22, 0x7d, 0xfb, 0xdb, 0x2a, 0x54, 0x71, 0x78, 0xb4, 0x96, 0x74, 0x5d, 0x7b, 0xe3,
+	0x50, 0x43, 0xab, 0xae, 0x67, 0x6b, 0x09, 0x3d, 0xbb, 0x7c, 0x38, 0xa6, 0x94, 0x8e, 0xad, 0x25,
+	0x74, 0xec, 0x90, 0x7c, 0x29, 0xfd, 0x5a, 0x31, 0xf4, 0x6b, 0xf6, 0x70, 0x6c, 0x86, 0x6e, 0xb9,
+	0x45, 0xba, 0x75, 0xcb, 0xd4, 0xad, 0x31, 0x67, 0x6f, 0x98, 0xd0, 0x38, 0x7a, 0xf5, 0x5e, 0xae,
+	0x5e, 0xdd, 0x30, 0xf4, 0xea, 0x30, 0xc9, 0x7e, 0x44, 0x3a, 0x75, 0x99, 0x4f, 0x3a, 0xb3, 0xaf,
+	0xd1, 0xe5, 0x4d, 0x3a, 0x9d, 0x37, 0xa1, 0x15, 0x3f, 0x80, 0x92, 0x71, 0x3f, 0x85, 0x8b, 0xc9,
+	0x54, 0x65, 0xd0, 0xb9, 0x04, 0xad, 0xf8, 0x51, 0x93, 0x8c, 0xb4, 0x42, 0xf6, 0x51, 0xa0, 0x44,
+	0xc8, 0x59, 0x82, 0xa7, 0xd2, 0x4f, 0x2e, 0x64, 0xf8, 0xe1, 0xf5, 0xab, 0x21, 0xe5, 0xd4, 0xd5,
+	0x10, 0xe7, 0x31, 0x4c, 0x27, 0x1e, 0x51, 0x38, 0x34, 0x87, 0x75, 0x49, 0x9b, 0x22, 0x57, 0x12,
+	0x57, 0x72, 0xcd, 0x9b, 0x11, 0xf1, 0x44, 0xd8, 0x59, 0x84, 0xe9, 0x82, 0xcc, 0x8f, 0x73, 0x31,
+	0xe2, 0x73, 0x30, 0x39, 0x2a, 0xef, 0x1f, 0xc1, 0xc5, 0x8d, 0x08, 0xda, 0xa9, 0x07, 0x60, 0x92,
+	0xc9, 0xac, 0x03, 0x6c, 0x2b, 0x19, 0xbb, 0x9c, 0xd8, 0x7e, 0x2e, 0xbe, 0xa6, 0xc2, 0x70, 0x44,
+	0xe3, 0x70, 0x7e, 0xa3, 0x04, 0x4f, 0xa5, 0x5f, 0x7f, 0x19, 0x77, 0xf1, 0x93, 0x7f, 0x33, 0xe7,
+	0x1e, 0x4c, 0x85, 0x7d, 0xaf, 0x4b, 0x17, 0x76, 0xf0, 0xca, 0x42, 0x28, 0x56, 0x34, 0x05, 0x2f,
+	0xb8, 0x6c, 0xc4, 0x08, 0x62, 0xc0, 0x9d, 0xc7, 0x30, 0xa9, 0x7d, 0xb4, 0xde, 0x86, 0xb2, 0x3f,
+	0x4c, 0x9d, 0xf5, 0xcc, 0xe7, 0xbc, 0x2f, 0xfb, 0x1b, 0x29, 0xfb, 0xc3, 0x74, 0x97, 0xd4, 0xbb,
+	0x6f, 0xc5, 0xe8, 0xbe, 0xce, 0x1d, 0x78, 0x2a, 0xfd, 0xc0, 0x4a, 0xb2, 0x7a, 0xce, 0xa4, 0xbc,
+	0x04, 0xbc, 0x9a, 0x12, 0xb1, 0xce, 0x55, 0x38, 0x9a, 0x7c, 0x36, 0x25, 0xe3, 0x3a, 0x5a, 0x7c,
+	0xab, 0x4f, 0xba, 0xeb, 0x67, 0x7e, 0xae, 0x04, 0xd3, 0x66, 0x41, 0xac, 0xe3, 0x60, 0x99, 0x31,
+	0x6b, 0xfe, 0x80, 0xb6, 0x27, 0xac, 0x67, 0xe0, 0x29, 0x33, 0x7e, 0xae, 0xd7, 0x6b, 0x97, 0xd2,
+	0xe2, 0x68, 0xb6, 0xda, 0x65, 0xcb, 0x86, 0x63, 0x89, 0x1a, 0x62, 0x46, 0xb4, 0x5d, 0xb1, 0x9e,
+	0x83, 0x67, 0x92, 0x5f, 0x86, 0x7d, 0xb7, 0x4b, 0xdb, 0x55, 0xe7, 0xfb, 0x65, 0xa8, 0xe2, 0x4b,
+	0x1f, 0xce, 0x3f, 0x97, 0xe5, 0x8d, 0x9c, 0xb7, 0xa0, 0xca, 0x5e, 0x34, 0xd1, 0xae, 0xf3, 0x26,
+	0x2f, 0x11, 0x1b, 0x57, 0x42, 0xe3, 0xeb, 0xbc, 0x6f, 0x41, 0x95, 0xbd, 0x61, 0x72, 0x78, 0xe4,
+	0xd7, 0x4a, 0xd0, 0x8a, 0xdf, 0x13, 0x39, 0x34, 0x5e, 0xbf, 0x01, 0x54, 0x36, 0x6f, 0x00, 0x9d,
+	0x87, 0x5a, 0x80, 0xa4, 0xc2, 0xca, 0x24, 0xef, 0x15, 0xb1, 0x04, 0x09, 0x17, 0x71, 0x28, 0x4c,
+	0xea, 0xaf, 0xa5, 0x1c, 0x3e, 0x1b, 0xa7, 0xc5, 0x53, 0x69, 0xab, 0xbd, 0x70, 0x2e, 0x08, 0xdc,
+	0x03, 0xa1, 0x98, 0x66, 0x24, 0xfa, 0x7e, 0xf1, 0x4d, 0x94, 0xec, 0x5b, 0xd4, 0xce, 0x1f, 0x95,
+	0xa0, 0x21, 0x0e, 0x34, 0xe3, 0x75, 0x6e, 0x7c, 0xf6, 0xe4, 0x75, 0x68, 0x88, 0xa3, 0xd4, 0xa9,
+	0x8c, 0xdc, 0x63, 0xa5, 0x10, 0xf2, 0x44, 0x8a, 0x39, 0xd7, 0xd4, 0x30, 0x79, 0x78, 0xec, 0x5b,
+	0x50, 0x65, 0x8f, 0x9c, 0x1c, 0x1e, 0xf9, 0xc7, 0x4d, 0xa8, 0xf3, 0xab, 0xc8, 0xce, 0xef, 0x36,
+	0xa1, 0xce, 0x1f, 0x3e, 0xb1, 0x6e, 0x40, 0x23, 0xdc, 0xdb, 0xdd, 0x75, 0x83, 0x03, 0x3b, 0xfb,
+	0x95, 0x5d, 0xe3, 0x9d, 0x94, 0xce, 0x06, 0x97, 0x25, 0x12, 0x64, 0xbd, 0x09, 0xd5, 0xae, 0xbb,
+	0x45, 0x53, 0xdb, 0xb9, 0x59, 0xe0, 0x05, 0x77, 0x8b, 0x12, 0x26, 0x6e, 0xdd, 0x82, 0xa6, 0x68,
+	0x96, 0x50, 0xf8, 0x73, 0x46, 0xa7, 0x2b, 0x1b, 0x53, 0xa1, 0x9c, 0xdb, 0xd0, 0x10, 0x99, 0xb1,
+	0x6e, 0xaa, 0x8b, 0xd8, 0x49, 0xcf, 0x73, 0x66, 0x11, 0xd4, 0x8b, 0x18, 0xea, 0x4a, 0xf6, 0x9f,
+	0xe3, 0x23, 0x07, 0x98, 0xad, 0x0f, 0xcb, 0x64, 0x9d, 0x04, 0xe8, 0xbb, 0x61, 0xb4, 0xbe, 0xd7,
+	0xef, 0x8b, 0xab, 0x17, 0x15, 0xa2, 0xc5, 0xe0, 0xde, 0x34, 0x0f, 0x85, 0x3b, 0x1b, 0x7b, 0xdd,
+	0x2e, 0x55, 0xf7, 0xa4, 0x93, 0xd1, 0x78, 0xa6, 0x86, 0x3d, 0xc5, 0x69, 0x57, 0x33, 0xcf, 0xa9,
+	0xa4, 0x6b, 0x16, 0x9f, 0xf2, 0x11, 0xb9, 0xe1, 0x48, 0xc7, 0x87, 0x96, 0x8a, 0xc3, 0x4e, 0x38,
+	0xf4, 0x06, 0x03, 0x7c, 0x09, 0x88, 0x6b, 0xb4, 0x0c, 0xe2, 0xa0, 0x83, 0x3f, 0x45, 0x7e, 0x6b,
+	0x44, 0x84, 0x30, 0x7e, 0xcb, 0xf5, 0xfa, 0x22, 0x8b, 0x35, 0x22, 0x42, 0xc8, 0xb4, 0x27, 0x9e,
+	0x8b, 0xa9, 0xb2, 0x02, 0xca, 0xa0, 0xf3, 0x41, 0x49, 0xbd, 0x46, 0x90, 0x75, 0x3b, 0x39, 0xe5,
+	0x4b, 0x3a, 0xa1, 0x3b, 0xb4, 0xf9, 0x80, 0x10, 0x47, 0x60, 0xfa, 0xfe, 0xa0, 0xef, 0x0d, 0xa8,
+	0xf0, 0x1d, 0x89, 0x50, 0xa2, 0x8e, 0x6b, 0xa9, 0x3a, 0x16, 0xdf, 0xf1, 0x6e, 0x0c, 0xed, 0xd9,
+	0xf5, 0xf8, 0x3b, 0x8f, 0xb1, 0xae, 0xe3, 0xf1, 0x8d, 0x7d, 0xaf, 0x4b, 0xf1, 0xf9, 0xd0, 0x4a,
+	0xc6, 0x26, 0x9d, 0x59, 0xb7, 0x8b, 0x4c, 0x96, 0x48, 0x8c, 0x13, 0xe1, 0xcd, 0x44, 0xfc, 0xa9,
+	0x8a, 0x54, 0xd2, 0x8a, 0x14, 0x67, 0xba, 0x3c, 0x22, 0xd3, 0x95, 0x82, 0x4c, 0x57, 0x93, 0x99,
+	0x9e, 0xf9, 0x32, 0x40, 0xac, 0x6e, 0xd6, 0x24, 0x34, 0x1e, 0x0e, 0x1e, 0x0d, 0xfc, 0xc7, 0x83,
+	0xf6, 0x04, 0x06, 0xee, 0x6f, 0x6d, 0x61, 0x2a, 0xed, 0x12, 0x06, 0x50, 0xce, 0x1b, 0x6c, 0xb7,
+	0xcb, 0x16, 0x40, 0x1d, 0x03, 0xb4, 0xd7, 0xae, 0xe0, 0xef, 0x65, 0xd6, 0x7e, 0xed, 0xaa, 0xf5,
+	0x2c, 0x3c, 0xbd, 0x3a, 0xe8, 0xfa, 0xbb, 0x43, 0x37, 0xf2, 0x36, 0xfb, 0x78, 0x33, 0x3f, 0xf4,
+	0xfc, 0x41, 0xbb, 0x86, 0xa3, 0xd7, 0x1a, 0x8d, 0x1e, 0xfb, 0xc1, 0xa3, 0x35, 0x4a, 0x7b, 0xe2,
+	0x95, 0x97, 0x76, 0xdd, 0xf9, 0xcf, 0x12, 0xdf, 0x0d, 0x76, 0x6e, 0xc1, 0x94, 0xf1, 0xae, 0x91,
+	0x1d, 0x3f, 0x7e, 0x9e, 0x78, 0xfb, 0xfc, 0x38, 0xf3, 0xd7, 0xd2, 0x78, 0x2a, 0xc3, 0x43, 0xce,
+	0x32, 0x80, 0xf6, 0x9a, 0xd1, 0x49, 0x80, 0xcd, 0x83, 0x88, 0x86, 0x2c, 0xc4, 0x28, 0xaa, 0x44,
+	0x8b, 0xd1, 0xf9, 0xcb, 0x06, 0xbf, 0x73, 0x05, 0x40, 0x7b, 0xcb, 0x08, 0xfb, 0x15, 0x86, 0xe6,
+	0x93, 0x64, 0xc9, 0x68, 0xa7, 0x23, 0x4a, 0x20, 0x5f, 0x2d, 0x92, 0x39, 0x60, 0x91, 0x46, 0x0e,
+	0x58, 0x8c, 0xb3, 0x04, 0x10, 0x3f, 0xdc, 0x83, 0x9b, 0x54, 0xc2, 0x74, 0xbf, 0x06, 0xd5, 0x9e,
+	0x1b, 0xb9, 0xc2, 0x6a, 0x3e, 0x97, 0x18, 0xb9, 0x62, 0x08, 0x61, 0x62, 0xce, 0x37, 0x4b, 0x30,
+	0xa5, 0x3f, 0x52, 0xe4, 0xbc, 0x03, 0x55, 0xf6, 0xca, 0xd1, 0x4d, 0x98, 0xd2, 0x5f, 0x29, 0x4a,
+	0x3d, 0x12, 0xcf, 0xf9, 0x74, 0x28, 0x31, 0x00, 0xce, 0xaa, 0xca, 0xd2, 0x87, 0xa6, 0x7a, 0x1d,
+	0x1a, 0xe2, 0xd1, 0x23, 0xe7, 0x15, 0x68, 0xc5, 0x6f, 0x1c, 0xa1, 0xed, 0xe0, 0xf1, 0xb2, 0x95,
+	0x45, 0xd0, 0xf9, 0x97, 0x0a, 0xd4, 0x58, 0x73, 0x3a, 0x5f, 0x2d, 0xeb, 0x1a, 0xea, 0x7c, 0xbf,
+	0x94, 0xbb, 0x16, 0xbc, 0x64, 0xbc, 0x9b, 0x31, 0x9d, 0x7a, 0xdb, 0x4b, 0x3c, 0x69, 0x64, 0x1a,
+	0xd6, 0x2b, 0xd0, 0x18, 0x70, 0xcd, 0x64, 0x9d, 0x67, 0x7a, 0xf6, 0x44, 0x26, 0x4a, 0x68, 0x2f,
+	0x91, 0xc2, 0xd6, 0x65, 0xa8, 0xd1, 0x20, 0xf0, 0x03, 0xd6, 0xa5, 0xa6, 0x67, 0x4f, 0x66, 0xa2,
+	0x30, 0xdf, 0x4b, 0x28, 0x45, 0xb8, 0x30, 0xfa, 0x81, 0x43, 0xde, 0x8b, 0xf8, 0x9c, 0x32, 0x14,
+	0xef, 0x0a, 0x08, 0x6b, 0x93, 0xfd, 0x71, 0xe6, 0x93, 0x72, 0x80, 0xd5, 0x3a, 0xde, 0x84, 0xde,
+	0x23, 0x4b, 0x56, 0x0b, 0x6a, 0x2c, 0xa1, 0x76, 0x59, 0xef, 0xb6, 0x95, 0x9c, 0x8e, 0x57, 0x9d,
+	0xb9, 0x04, 0x0d, 0x11, 0x8f, 0xf2, 0x73, 0x3c, 0xef, 0xed, 0x09, 0x6b, 0x0a, 0x9a, 0x1b, 0xb4,
+	0xbf, 0xb5, 0xe2, 0x87, 0x51, 0xbb, 0x64, 0x1d, 0x81, 0x16, 0xeb, 0x0b, 0xf7, 0x07, 0xfd, 0x83,
+	0x76, 0x79, 0xe6, 0x3d, 0x68, 0xa9, 0x12, 0x59, 0x4d, 0xa8, 0xae, 0xed, 0xf5, 0xfb, 0xed, 0x09,
+	0x36, 0x35, 0x8d, 0xfc, 0x40, 0x3a, 0xa6, 0x97, 0x9e, 0xe0, 0x38, 0xd3, 0x2e, 0xe5, 0x59, 0x83,
+	0xb2, 0xd5, 0x86, 0x29, 0x91, 0x38, 0xcf, 0x73, 0xc5, 0xf9, 0xc7, 0x12, 0xb4, 0xd4, 0xbb, 0x50,
+	0xce, 0xd7, 0xe2, 0x36, 0xce, 0xb7, 0x03, 0x57, 0x13, 0xad, 0x9d, 0xff, 0xcc, 0x54, 0xa2, 0xc5,
+	0xcf, 0xc0, 0xb4, 0x30, 0xb9, 0xb2, 0xf2, 0xb9, 0xd5, 0x4c, 0xc4, 0xce, 0xdc, 0x56, 0xb5, 0xde,
+	0x66, 0x5d, 0x6c, 0xc1, 0x1f, 0x0c, 0x68, 0x37, 0x62, 0x75, 0x7f, 0x14, 0x26, 0xd7, 0xfc, 0x68,
+	0xdd, 0x0f, 0x43, 0x2c, 0x19, 0xaf, 0xa9, 0xf8, 0x7b, 0xd9, 0x9a, 0x06, 0x90, 0x67, 0xcd, 0xd0,
+	0x48, 0x3a, 0xbf, 0x56, 0x82, 0x3a, 0x7f, 0xad, 0xca, 0xf9, 0x46, 0x09, 0xea, 0xe2, 0x85, 0xaa,
+	0xf3, 0xd0, 0x0e, 0x7c, 0x3f, 0x8a, 0x17, 0x14, 0xab, 0x8b, 0xa2, 0x94, 0xa9, 0x78, 0x5c, 0xe3,
+	0xfa, 0x9a, 0x56, 0x88, 0x29, 0x80, 0x11, 0x67, 0x5d, 0x03, 0xe0, 0x2f, 0x60, 0xa1, 0x07, 0x5f,
+	0xa8, 0x73, 0xf2, 0x88, 0x19, 0xcf, 0x05, 0xdf, 0x8c, 0xd1, 0xa4, 0x67, 0xbe, 0x04, 0x47, 0x08,
+	0x0d, 0x87, 0xfe, 0x20, 0xa4, 0x3f, 0xac, 0x3f, 0x96, 0x91, 0xfb, 0x67, 0x2f, 0x66, 0xbe, 0x51,
+	0x87, 0x1a, 0x9b, 0x5d, 0x3a, 0x3f, 0x53, 0x57, 0xf3, 0xe0, 0x54, 0xff, 0x9e, 0xd5, 0x0f, 0xfa,
+	0xe8, 0x1d, 0xd5, 0x98, 0x98, 0x9a, 0x07, 0x7c, 0x3e, 0x81, 0x17, 0x3d, 0xfd, 0xed, 0x00, 0xe7,
+	0xb3, 0xd5, 0xc4, 0x73, 0x64, 0x26, 0x6c, 0x5d, 0x88, 0x11, 0x05, 0xd0, 0x95, 0xaf, 0x66, 0x2a,
+	0xdf, 0x2d, 0x68, 0xf5, 0x02, 0x7f, 0xc8, 0x9e, 0x23, 0xb0, 0xeb, 0x89, 0x57, 0xd9, 0x4c, 0xde,
+	0x45, 0x29, 0x87, 0x4f, 0x98, 0x2b, 0x10, 0xaa, 0x2f, 0xaf, 0x7d, 0xbb, 0x91, 0x78, 0xe4, 0xc7,
+	0x84, 0xf3, 0xf6, 0x42, 0xa7, 0x1e, 0x17, 0x47, 0x20, 0x7d, 0xc2, 0x80, 0xcd, 0x91, 0xc0, 0xa5,
+	0x27, 0x12, 0xc8, 0xc5, 0xad, 0xeb, 0xd0, 0x0c, 0xdd, 0x7d, 0x8a, 0xc9, 0xdb, 0xad, 0x91, 0x55,
+	0xb1, 0x21, 0xc4, 0xf0, 0xe9, 0x78, 0x09, 0xc1, 0x22, 0xef, 0x7a, 0xdb, 0x7c, 0x25, 0x69, 0xc3,
+	0xc8, 0x22, 0xdf, 0x93, 0x72, 0x58, 0x64, 0x05, 0xc2, 0x9c, 0xe3, 0x41, 0xc9, 0xbd, 0xa1, 0x3d,
+	0x35, 0x32, 0xe7, 0xf3, 0x4c, 0x08, 0x73, 0xce, 0xc5, 0x71, 0xc9, 0xc4, 0x6d, 0xed, 0x24, 0xdf,
+	0x6f, 0x66, 0x01, 0x67, 0x12, 0x5a, 0xaa, 0x6e, 0x9d, 0xa6, 0xea, 0x5f, 0x4d, 0xa8, 0x2f, 0x3d,
+	0x91, 0xbf, 0x38, 0x95, 0x03, 0xd0, 0x94, 0x65, 0x42, 0x98, 0xca, 0x9f, 0xb3, 0x06, 0x4d, 0xd9,
+	0xee, 0x39, 0x2f, 0xbb, 0x58, 0x50, 0xed, 0xf9, 0x62, 0xd6, 0x55, 0x21, 0xec, 0x37, 0xea, 0x85,
+	0xfe, 0x02, 0x59, 0x4b, 0xbd, 0xfd, 0x35, 0x33, 0x27, 0x8f, 0x3c, 0xa1, 0x75, 0xe4, 0xeb, 0xf9,
+	0x49, 0x68, 0x90, 0x3d, 0x36, 0x21, 0x6e, 0x97, 0xac, 0x26, 0x5f, 0x65, 0xb5, 0xcb, 0x68, 0x68,
+	0x17, 0xdc, 0x41, 0x97, 0xf6, 0xd9, 0x24, 0x4a, 0x99, 0xef, 0xea, 0x7c, 0x4b, 0x91, 0xcf, 0x9f,
+	0xf8, 0xcb, 0x0f, 0x4e, 0x96, 0xbe, 0xfd, 0xc1, 0xc9, 0xd2, 0x77, 0x3f, 0x38, 0x59, 0xfa, 0xc5,
+	0xef, 0x9d, 0x9c, 0xf8, 0xf6, 0xf7, 0x4e, 0x4e, 0xfc, 0xfd, 0xf7, 0x4e, 0x4e, 0xbc, 0x5f, 0x1e,
+	0x6e, 0x6e, 0xd6, 0xd9, 0xb1, 0x95, 0x4b, 0xff, 0x3d, 0x00, 0x11, 0x9d, 0x7c, 0x43, 0x46, 0x67,
+	0x00, 0x00,
 }
 
 func (m *Event) Marshal() (dAtA []byte, err error) {
@@ -14342,6 +14827,52 @@ func (m *EventMessageValueOfChatDelete) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *EventMessageValueOfCommentSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfCommentSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommentSet != nil {
+		{
+			size, err := m.CommentSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessageValueOfCommentDelete) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessageValueOfCommentDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommentDelete != nil {
+		{
+			size, err := m.CommentDelete.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0xb2
+	}
+	return len(dAtA) - i, nil
+}
 func (m *EventMessageValueOfAccountDetails) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
@@ -14620,7 +15151,7 @@ func (m *EventChatUpdateReactions) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAccount) Marshal() (dAtA []byte, err error) {
+func (m *EventComment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14630,12 +15161,12 @@ func (m *EventAccount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAccount) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventComment) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14643,7 +15174,7 @@ func (m *EventAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAccountShow) Marshal() (dAtA []byte, err error) {
+func (m *EventCommentSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14653,19 +15184,19 @@ func (m *EventAccountShow) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAccountShow) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCommentSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAccountShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCommentSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Account != nil {
+	if m.Comment != nil {
 		{
-			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Comment.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -14673,17 +15204,12 @@ func (m *EventAccountShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.Index != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAccountDetails) Marshal() (dAtA []byte, err error) {
+func (m *EventCommentDelete) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14693,39 +15219,27 @@ func (m *EventAccountDetails) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAccountDetails) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCommentDelete) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAccountDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCommentDelete) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Details != nil {
-		{
-			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProfileId) > 0 {
-		i -= len(m.ProfileId)
-		copy(dAtA[i:], m.ProfileId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProfileId)))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAccountConfig) Marshal() (dAtA []byte, err error) {
+func (m *EventAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14735,12 +15249,12 @@ func (m *EventAccountConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAccountConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAccountConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -14748,7 +15262,7 @@ func (m *EventAccountConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAccountConfigUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventAccountShow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14758,19 +15272,19 @@ func (m *EventAccountConfigUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAccountConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAccountShow) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAccountConfigUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAccountShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != nil {
+	if m.Account != nil {
 		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -14780,9 +15294,37 @@ func (m *EventAccountConfigUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Config != nil {
+	if m.Index != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAccountDetails) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAccountDetails) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAccountDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Details != nil {
 		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Details.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -14790,12 +15332,19 @@ func (m *EventAccountConfigUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProfileId) > 0 {
+		i -= len(m.ProfileId)
+		copy(dAtA[i:], m.ProfileId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ProfileId)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAccountUpdate) Marshal() (dAtA []byte, err error) {
+func (m *EventAccountConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -14805,12 +15354,82 @@ func (m *EventAccountUpdate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventAccountConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAccountUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAccountConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAccountConfigUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAccountConfigUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAccountConfigUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAccountUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAccountUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAccountUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -15339,6 +15958,20 @@ func (m *EventObjectSubscriptionCounters) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Formulas) > 0 {
+		for iNdEx := len(m.Formulas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Formulas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.SubId) > 0 {
 		i -= len(m.SubId)
 		copy(dAtA[i:], m.SubId)
@@ -15364,6 +15997,60 @@ func (m *EventObjectSubscriptionCounters) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *EventObjectSubscriptionFormula) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObjectSubscriptionFormula) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObjectSubscriptionFormula) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Value != nil {
+		{
+			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.GroupId) > 0 {
+		i -= len(m.GroupId)
+		copy(dAtA[i:], m.GroupId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.GroupId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Formula != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Formula))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventObjectSubscriptionGroups) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15801,20 +16488,20 @@ func (m *EventBlockMarksInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	var l int
 	_ = l
 	if len(m.MarksInRange) > 0 {
-		dAtA91 := make([]byte, len(m.MarksInRange)*10)
-		var j90 int
+		dAtA95 := make([]byte, len(m.MarksInRange)*10)
+		var j94 int
 		for _, num := range m.MarksInRange {
 			for num >= 1<<7 {
-				dAtA91[j90] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA95[j94] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j90++
+				j94++
 			}
-			dAtA91[j90] = uint8(num)
-			j90++
+			dAtA95[j94] = uint8(num)
+			j94++
 		}
-		i -= j90
-		copy(dAtA[i:], dAtA91[:j90])
-		i = encodeVarintEvents(dAtA, i, uint64(j90))
+		i -= j94
+		copy(dAtA[i:], dAtA95[:j94])
+		i = encodeVarintEvents(dAtA, i, uint64(j94))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -16280,6 +16967,20 @@ func (m *EventBlockSetTextText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Edits) > 0 {
+		for iNdEx := len(m.Edits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Value) > 0 {
 		i -= len(m.Value)
 		copy(dAtA[i:], m.Value)
@@ -16290,6 +16991,48 @@ func (m *EventBlockSetTextText) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockSetTextTextEdit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetTextTextEdit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetTextTextEdit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Inserted) > 0 {
+		i -= len(m.Inserted)
+		copy(dAtA[i:], m.Inserted)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Inserted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Deleted) > 0 {
+		i -= len(m.Deleted)
+		copy(dAtA[i:], m.Deleted)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Deleted)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.From != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.From))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlockSetTextStyle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16338,6 +17081,18 @@ func (m *EventBlockSetTextMarks) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Previous != nil {
+		{
+			size, err := m.Previous.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.Value != nil {
 		{
 			size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
@@ -17064,6 +17819,18 @@ func (m *EventBlockSetLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FocusBlockId != nil {
+		{
+			size, err := m.FocusBlockId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if m.Relations != nil {
 		{
 			size, err := m.Relations.MarshalToSizedBuffer(dAtA[:i])
@@ -17188,6 +17955,36 @@ func (m *EventBlockSetLinkTargetBlockId) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockSetLinkFocusBlockId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetLinkFocusBlockId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetLinkFocusBlockId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlockSetLinkStyle) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -17749,6 +18546,18 @@ func (m *EventBlockSetTableRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CellSpans != nil {
+		{
+			size, err := m.CellSpans.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.IsHeader != nil {
 		{
 			size, err := m.IsHeader.MarshalToSizedBuffer(dAtA[:i])
@@ -17804,6 +18613,43 @@ func (m *EventBlockSetTableRowIsHeader) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBlockSetTableRowCellSpans) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBlockSetTableRowCellSpans) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlockSetTableRowCellSpans) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		for iNdEx := len(m.Value) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Value[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBlockSetWidget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -22370,13 +23216,6 @@ func (m *ModelProcess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x5a
-	}
 	if m.Message != nil {
 		{
 			size := m.Message.Size()
@@ -22386,6 +23225,13 @@ func (m *ModelProcess) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			}
 		}
 	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.SpaceId) > 0 {
 		i -= len(m.SpaceId)
 		copy(dAtA[i:], m.SpaceId)
@@ -22525,6 +23371,27 @@ func (m *ModelProcessMessageOfMigration) MarshalToSizedBuffer(dAtA []byte) (int,
 	}
 	return len(dAtA) - i, nil
 }
+func (m *ModelProcessMessageOfBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessMessageOfBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Backup != nil {
+		{
+			size, err := m.Backup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
 func (m *ModelProcessDropFiles) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -22594,6 +23461,29 @@ func (m *ModelProcessExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ModelProcessBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModelProcessBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModelProcessBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *ModelProcessSaveFile) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -23574,6 +24464,30 @@ func (m *EventMessageValueOfChatDelete) Size() (n int) {
 	}
 	return n
 }
+func (m *EventMessageValueOfCommentSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommentSet != nil {
+		l = m.CommentSet.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventMessageValueOfCommentDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommentDelete != nil {
+		l = m.CommentDelete.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *EventMessageValueOfAccountDetails) Size() (n int) {
 	if m == nil {
 		return 0
@@ -23699,6 +24613,41 @@ func (m *EventChatUpdateReactions) Size() (n int) {
 	return n
 }
 
+func (m *EventComment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventCommentSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Comment != nil {
+		l = m.Comment.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommentDelete) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventAccount) Size() (n int) {
 	if m == nil {
 		return 0
@@ -24016,6 +24965,36 @@ func (m *EventObjectSubscriptionCounters) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if len(m.Formulas) > 0 {
+		for _, e := range m.Formulas {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventObjectSubscriptionFormula) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Formula != 0 {
+		n += 1 + sovEvents(uint64(m.Formula))
+	}
+	l = len(m.GroupId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Value != nil {
+		l = m.Value.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -24406,6 +25385,32 @@ func (m *EventBlockSetTextText) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if len(m.Edits) > 0 {
+		for _, e := range m.Edits {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventBlockSetTextTextEdit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.From != 0 {
+		n += 1 + sovEvents(uint64(m.From))
+	}
+	l = len(m.Deleted)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Inserted)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -24431,6 +25436,10 @@ func (m *EventBlockSetTextMarks) Size() (n int) {
 		l = m.Value.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.Previous != nil {
+		l = m.Previous.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -24755,6 +25764,10 @@ func (m *EventBlockSetLink) Size() (n int) {
 		l = m.Relations.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.FocusBlockId != nil {
+		l = m.FocusBlockId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -24771,6 +25784,19 @@ func (m *EventBlockSetLinkTargetBlockId) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockSetLinkFocusBlockId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventBlockSetLinkStyle) Size() (n int) {
 	if m == nil {
 		return 0
@@ -25008,6 +26034,10 @@ func (m *EventBlockSetTableRow) Size() (n int) {
 		l = m.IsHeader.Size()
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.CellSpans != nil {
+		l = m.CellSpans.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
@@ -25023,6 +26053,21 @@ func (m *EventBlockSetTableRowIsHeader) Size() (n int) {
 	return n
 }
 
+func (m *EventBlockSetTableRowCellSpans) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		for _, e := range m.Value {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *EventBlockSetWidget) Size() (n int) {
 	if m == nil {
 		return 0
@@ -27067,6 +28112,18 @@ func (m *ModelProcessMessageOfMigration) Size() (n int) {
 	}
 	return n
 }
+func (m *ModelProcessMessageOfBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Backup != nil {
+		l = m.Backup.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
 func (m *ModelProcessDropFiles) Size() (n int) {
 	if m == nil {
 		return 0
@@ -27094,6 +28151,15 @@ func (m *ModelProcessExport) Size() (n int) {
 	return n
 }
 
+func (m *ModelProcessBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *ModelProcessSaveFile) Size() (n int) {
 	if m == nil {
 		return 0
@@ -29832,6 +30898,76 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			}
 			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 133:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventCommentSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfCommentSet{v}
+			iNdEx = postIndex
+		case 134:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentDelete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &EventCommentDelete{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Value = &EventMessageValueOfCommentDelete{v}
+			iNdEx = postIndex
 		case 201:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AccountDetails", wireType)
@@ -30511,7 +31647,7 @@ func (m *EventChatUpdateReactions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccount) Unmarshal(dAtA []byte) error {
+func (m *EventComment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30534,10 +31670,10 @@ func (m *EventAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Account: wiretype end group for non-group")
+			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -30561,7 +31697,7 @@ func (m *EventAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountShow) Unmarshal(dAtA []byte) error {
+func (m *EventCommentSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30584,34 +31720,15 @@ func (m *EventAccountShow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Show: wiretype end group for non-group")
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Show: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
-			}
-			m.Index = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Index |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30638,10 +31755,10 @@ func (m *EventAccountShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Account == nil {
-				m.Account = &model.Account{}
+			if m.Comment == nil {
+				m.Comment = &model.Comment{}
 			}
-			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Comment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30666,7 +31783,7 @@ func (m *EventAccountShow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountDetails) Unmarshal(dAtA []byte) error {
+func (m *EventCommentDelete) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30689,15 +31806,15 @@ func (m *EventAccountDetails) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Details: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delete: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delete: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProfileId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -30725,43 +31842,7 @@ func (m *EventAccountDetails) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProfileId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Details == nil {
-				m.Details = &types.Struct{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -30784,7 +31865,7 @@ func (m *EventAccountDetails) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountConfig) Unmarshal(dAtA []byte) error {
+func (m *EventAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30807,10 +31888,10 @@ func (m *EventAccountConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: Account: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Account: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -30834,7 +31915,7 @@ func (m *EventAccountConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountConfigUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAccountShow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30857,17 +31938,17 @@ func (m *EventAccountConfigUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Update: wiretype end group for non-group")
+			return fmt.Errorf("proto: Show: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Show: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var msglen int
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -30877,31 +31958,14 @@ func (m *EventAccountConfigUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Index |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Config == nil {
-				m.Config = &model.AccountConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -30928,10 +31992,10 @@ func (m *EventAccountConfigUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &model.AccountStatus{}
+			if m.Account == nil {
+				m.Account = &model.Account{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Account.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -30956,7 +32020,7 @@ func (m *EventAccountConfigUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventAccountDetails) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -30979,17 +32043,17 @@ func (m *EventAccountUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Update: wiretype end group for non-group")
+			return fmt.Errorf("proto: Details: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Details: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfileId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -30999,31 +32063,27 @@ func (m *EventAccountUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &model.AccountConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProfileId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31050,10 +32110,10 @@ func (m *EventAccountUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &model.AccountStatus{}
+			if m.Details == nil {
+				m.Details = &types.Struct{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31078,7 +32138,7 @@ func (m *EventAccountUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountLinkChallenge) Unmarshal(dAtA []byte) error {
+func (m *EventAccountConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31101,17 +32161,67 @@ func (m *EventAccountLinkChallenge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LinkChallenge: wiretype end group for non-group")
+			return fmt.Errorf("proto: Config: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LinkChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAccountConfigUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -31121,27 +32231,31 @@ func (m *EventAccountLinkChallenge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Challenge = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &model.AccountConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -31168,10 +32282,10 @@ func (m *EventAccountLinkChallenge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientInfo == nil {
-				m.ClientInfo = &EventAccountLinkChallengeClientInfo{}
+			if m.Status == nil {
+				m.Status = &model.AccountStatus{}
 			}
-			if err := m.ClientInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -31196,7 +32310,7 @@ func (m *EventAccountLinkChallenge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
+func (m *EventAccountUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31219,17 +32333,17 @@ func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClientInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: Update: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Update: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -31239,29 +32353,33 @@ func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessName = string(dAtA[iNdEx:postIndex])
+			if m.Config == nil {
+				m.Config = &model.AccountConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessPath", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -31271,44 +32389,280 @@ func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessPath = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerified", wireType)
+			if m.Status == nil {
+				m.Status = &model.AccountStatus{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SignatureVerified = bool(v != 0)
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAccountLinkChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LinkChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LinkChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientInfo == nil {
+				m.ClientInfo = &EventAccountLinkChallengeClientInfo{}
+			}
+			if err := m.ClientInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAccountLinkChallengeClientInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClientInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClientInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessPath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProcessPath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureVerified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SignatureVerified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -31637,13 +32991,313 @@ func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Value == nil {
+				m.Value = &types.Value{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = &types.Struct{}
+			}
+			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Unset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Unset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -31653,28 +33307,74 @@ func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Value == nil {
-				m.Value = &types.Value{}
-			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObjectSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -31696,7 +33396,7 @@ func (m *EventObjectDetailsAmendKeyValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionAdd) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31719,10 +33419,10 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Set: wiretype end group for non-group")
+			return fmt.Errorf("proto: Add: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Set: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Add: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -31759,9 +33459,9 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -31771,31 +33471,27 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Details == nil {
-				m.Details = &types.Struct{}
-			}
-			if err := m.Details.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AfterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31823,7 +33519,7 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			m.SubId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31846,7 +33542,7 @@ func (m *EventObjectDetailsSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31869,10 +33565,10 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Unset: wiretype end group for non-group")
+			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Unset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -31909,39 +33605,7 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Keys = append(m.Keys, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31969,7 +33633,7 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubIds = append(m.SubIds, string(dAtA[iNdEx:postIndex]))
+			m.SubId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -31992,57 +33656,7 @@ func (m *EventObjectDetailsUnset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscription) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventObjectSubscriptionAdd) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32065,10 +33679,10 @@ func (m *EventObjectSubscriptionAdd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Add: wiretype end group for non-group")
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Add: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -32188,7 +33802,7 @@ func (m *EventObjectSubscriptionAdd) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32211,17 +33825,17 @@ func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Remove: wiretype end group for non-group")
+			return fmt.Errorf("proto: Counters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Remove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Counters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			m.Total = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -32231,25 +33845,50 @@ func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Total |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			m.NextCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCount", wireType)
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.PrevCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrevCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
 			}
@@ -32281,6 +33920,40 @@ func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 			}
 			m.SubId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formulas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Formulas = append(m.Formulas, &EventObjectSubscriptionFormula{})
+			if err := m.Formulas[len(m.Formulas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -32302,7 +33975,7 @@ func (m *EventObjectSubscriptionRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
+func (m *EventObjectSubscriptionFormula) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32325,15 +33998,15 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: Formula: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Formula: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32361,13 +34034,13 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AfterId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Formula", wireType)
 			}
-			var stringLen uint64
+			m.Formula = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -32377,27 +34050,14 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Formula |= model.BlockContentDataviewRelationFormulaType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AfterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32425,120 +34085,13 @@ func (m *EventObjectSubscriptionPosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubId = string(dAtA[iNdEx:postIndex])
+			m.GroupId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Counters: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Counters: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
-			}
-			m.Total = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Total |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextCount", wireType)
-			}
-			m.NextCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCount", wireType)
-			}
-			m.PrevCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PrevCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -32548,23 +34101,27 @@ func (m *EventObjectSubscriptionCounters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubId = string(dAtA[iNdEx:postIndex])
+			if m.Value == nil {
+				m.Value = &types.Value{}
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -34936,18 +36493,140 @@ func (m *EventBlockSetText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Color == nil {
-				m.Color = &EventBlockSetTextColor{}
+			if m.Color == nil {
+				m.Color = &EventBlockSetTextColor{}
+			}
+			if err := m.Color.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconEmoji", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IconEmoji == nil {
+				m.IconEmoji = &EventBlockSetTextIconEmoji{}
+			}
+			if err := m.IconEmoji.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IconImage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IconImage == nil {
+				m.IconImage = &EventBlockSetTextIconImage{}
 			}
-			if err := m.Color.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IconImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetTextText) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Text: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconEmoji", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -34957,31 +36636,27 @@ func (m *EventBlockSetText) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IconEmoji == nil {
-				m.IconEmoji = &EventBlockSetTextIconEmoji{}
-			}
-			if err := m.IconEmoji.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IconImage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Edits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -35008,10 +36683,8 @@ func (m *EventBlockSetText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IconImage == nil {
-				m.IconImage = &EventBlockSetTextIconImage{}
-			}
-			if err := m.IconImage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Edits = append(m.Edits, &EventBlockSetTextTextEdit{})
+			if err := m.Edits[len(m.Edits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -35036,7 +36709,7 @@ func (m *EventBlockSetText) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetTextText) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetTextTextEdit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -35059,15 +36732,34 @@ func (m *EventBlockSetTextText) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Text: wiretype end group for non-group")
+			return fmt.Errorf("proto: Edit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Text: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Edit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			m.From = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.From |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deleted", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -35095,7 +36787,39 @@ func (m *EventBlockSetTextText) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Deleted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inserted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inserted = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35252,6 +36976,42 @@ func (m *EventBlockSetTextMarks) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Previous", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Previous == nil {
+				m.Previous = &model.BlockContentTextMarks{}
+			}
+			if err := m.Previous.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -37322,16 +39082,124 @@ func (m *EventBlockSetLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IconSize == nil {
-				m.IconSize = &EventBlockSetLinkIconSize{}
+			if m.IconSize == nil {
+				m.IconSize = &EventBlockSetLinkIconSize{}
+			}
+			if err := m.IconSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CardStyle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CardStyle == nil {
+				m.CardStyle = &EventBlockSetLinkCardStyle{}
+			}
+			if err := m.CardStyle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Description == nil {
+				m.Description = &EventBlockSetLinkDescription{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Relations == nil {
+				m.Relations = &EventBlockSetLinkRelations{}
 			}
-			if err := m.IconSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Relations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CardStyle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FocusBlockId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37358,54 +39226,68 @@ func (m *EventBlockSetLink) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CardStyle == nil {
-				m.CardStyle = &EventBlockSetLinkCardStyle{}
+			if m.FocusBlockId == nil {
+				m.FocusBlockId = &EventBlockSetLinkFocusBlockId{}
 			}
-			if err := m.CardStyle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FocusBlockId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Description == nil {
-				m.Description = &EventBlockSetLinkDescription{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetLinkTargetBlockId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 8:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TargetBlockId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TargetBlockId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -37415,27 +39297,23 @@ func (m *EventBlockSetLink) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Relations == nil {
-				m.Relations = &EventBlockSetLinkRelations{}
-			}
-			if err := m.Relations.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -37458,7 +39336,7 @@ func (m *EventBlockSetLink) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetLinkTargetBlockId) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetLinkFocusBlockId) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37481,10 +39359,10 @@ func (m *EventBlockSetLinkTargetBlockId) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TargetBlockId: wiretype end group for non-group")
+			return fmt.Errorf("proto: FocusBlockId: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TargetBlockId: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FocusBlockId: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -38892,63 +40770,200 @@ func (m *EventBlockSetBookmarkTargetObjectId) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: State: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			m.Value = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Value |= model.BlockContentBookmarkState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableRow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableRow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsHeader", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.IsHeader == nil {
+				m.IsHeader = &EventBlockSetTableRowIsHeader{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: State: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: State: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			if err := m.IsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Value = 0
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellSpans", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -38958,11 +40973,28 @@ func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Value |= model.BlockContentBookmarkState(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CellSpans == nil {
+				m.CellSpans = &EventBlockSetTableRowCellSpans{}
+			}
+			if err := m.CellSpans.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -38984,7 +41016,7 @@ func (m *EventBlockSetBookmarkState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetTableRowIsHeader) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39007,49 +41039,17 @@ func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableRow: wiretype end group for non-group")
+			return fmt.Errorf("proto: IsHeader: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableRow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IsHeader: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsHeader", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -39059,28 +41059,12 @@ func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.IsHeader == nil {
-				m.IsHeader = &EventBlockSetTableRowIsHeader{}
-			}
-			if err := m.IsHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Value = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -39102,7 +41086,7 @@ func (m *EventBlockSetTableRow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBlockSetTableRowIsHeader) Unmarshal(dAtA []byte) error {
+func (m *EventBlockSetTableRowCellSpans) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -39125,17 +41109,17 @@ func (m *EventBlockSetTableRowIsHeader) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IsHeader: wiretype end group for non-group")
+			return fmt.Errorf("proto: CellSpans: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IsHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CellSpans: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -39145,12 +41129,26 @@ func (m *EventBlockSetTableRowIsHeader) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Value = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value, &model.BlockContentTableRowCellSpan{})
+			if err := m.Value[len(m.Value)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -51526,6 +53524,41 @@ func (m *ModelProcess) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backup", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ModelProcessBackup{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Message = &ModelProcessMessageOfBackup{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -51697,6 +53730,56 @@ func (m *ModelProcessExport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ModelProcessBackup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ModelProcessSaveFile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -26,337 +26,350 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("pb/protos/service/service.proto", fileDescriptor_93a29dc403579097) }
 
 var fileDescriptor_93a29dc403579097 = []byte{
-	// 5279 bytes of a gzipped FileDescriptorProto
+	// 5482 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x9d, 0x5b, 0x6f, 0x24, 0x49,
-	0x56, 0xf8, 0xc7, 0x2f, 0xff, 0xf9, 0x93, 0xcb, 0x0e, 0x50, 0x03, 0xc3, 0xec, 0xb0, 0xdb, 0xb7,
-	0xe9, 0xb6, 0xdd, 0x6d, 0xbb, 0xdc, 0xd3, 0x3d, 0x3d, 0xb3, 0xda, 0x45, 0x42, 0x6e, 0xbb, 0xed,
-	0x31, 0x6b, 0xbb, 0x8d, 0xab, 0xdc, 0x2d, 0x8d, 0x84, 0x44, 0x38, 0x2b, 0x5c, 0x4e, 0x9c, 0x95,
-	0x91, 0x9b, 0x19, 0x55, 0xee, 0x5a, 0x04, 0x02, 0x81, 0x40, 0x20, 0x10, 0x2b, 0x6e, 0xaf, 0x48,
-	0x7c, 0x1a, 0x1e, 0xf7, 0x91, 0x47, 0x34, 0x23, 0x1e, 0xf8, 0x16, 0x28, 0x23, 0x23, 0xe3, 0x72,
-	0xf2, 0x9c, 0xc8, 0xf4, 0x3e, 0x75, 0xab, 0xce, 0xef, 0x9c, 0x13, 0xd7, 0x13, 0x27, 0x22, 0x23,
-	0xd3, 0xd1, 0xdd, 0xfc, 0x62, 0x3b, 0x2f, 0x84, 0x14, 0xe5, 0x76, 0xc9, 0x8b, 0x45, 0x12, 0xf3,
-	0xe6, 0xdf, 0xa1, 0xfa, 0x79, 0xf0, 0x3e, 0xcb, 0x96, 0x72, 0x99, 0xf3, 0x4f, 0x3e, 0xb6, 0x64,
-	0x2c, 0x66, 0x33, 0x96, 0x4d, 0xca, 0x1a, 0xf9, 0xe4, 0x23, 0x2b, 0xe1, 0x0b, 0x9e, 0x49, 0xfd,
-	0xfb, 0xb3, 0xff, 0xf9, 0xdf, 0x95, 0xe8, 0x83, 0xdd, 0x34, 0xe1, 0x99, 0xdc, 0xd5, 0x1a, 0x83,
-	0xaf, 0xa3, 0xef, 0xee, 0xe4, 0xf9, 0x01, 0x97, 0x6f, 0x78, 0x51, 0x26, 0x22, 0x1b, 0x7c, 0x3a,
-	0xd4, 0x0e, 0x86, 0x67, 0x79, 0x3c, 0xdc, 0xc9, 0xf3, 0xa1, 0x15, 0x0e, 0xcf, 0xf8, 0x4f, 0xe7,
-	0xbc, 0x94, 0x9f, 0x3c, 0x0c, 0x43, 0x65, 0x2e, 0xb2, 0x92, 0x0f, 0x2e, 0xa3, 0xdf, 0xd8, 0xc9,
-	0xf3, 0x11, 0x97, 0x7b, 0xbc, 0xaa, 0xc0, 0x48, 0x32, 0xc9, 0x07, 0x6b, 0x2d, 0x55, 0x1f, 0x30,
-	0x3e, 0xd6, 0xbb, 0x41, 0xed, 0x67, 0x1c, 0x7d, 0xa7, 0xf2, 0x73, 0x35, 0x97, 0x13, 0x71, 0x93,
-	0x0d, 0xee, 0xb7, 0x15, 0xb5, 0xc8, 0xd8, 0x7e, 0x10, 0x42, 0xb4, 0xd5, 0xb7, 0xd1, 0xaf, 0xbe,
-	0x65, 0x69, 0xca, 0xe5, 0x6e, 0xc1, 0xab, 0x82, 0xfb, 0x3a, 0xb5, 0x68, 0x58, 0xcb, 0x8c, 0xdd,
-	0x4f, 0x83, 0x8c, 0x36, 0xfc, 0x75, 0xf4, 0xdd, 0x5a, 0x72, 0xc6, 0x63, 0xb1, 0xe0, 0xc5, 0x00,
-	0xd5, 0xd2, 0x42, 0xa2, 0xc9, 0x5b, 0x10, 0xb4, 0xbd, 0x2b, 0xb2, 0x05, 0x2f, 0x24, 0x6e, 0x5b,
-	0x0b, 0xc3, 0xb6, 0x2d, 0xa4, 0x6d, 0xff, 0xed, 0x4a, 0xf4, 0xfd, 0x9d, 0x38, 0x16, 0xf3, 0x4c,
-	0x1e, 0x89, 0x98, 0xa5, 0x47, 0x49, 0x76, 0x7d, 0xc2, 0x6f, 0x76, 0xaf, 0x2a, 0x3e, 0x9b, 0xf2,
-	0xc1, 0x73, 0xbf, 0x55, 0x6b, 0x74, 0x68, 0xd8, 0xa1, 0x0b, 0x1b, 0xdf, 0x9f, 0xdf, 0x4e, 0x49,
-	0x97, 0xe5, 0x1f, 0x57, 0xa2, 0x3b, 0xb0, 0x2c, 0x23, 0x91, 0x2e, 0xb8, 0x2d, 0xcd, 0x8b, 0x0e,
-	0xc3, 0x3e, 0x6e, 0xca, 0xf3, 0xc5, 0x6d, 0xd5, 0x74, 0x89, 0xd2, 0xe8, 0x43, 0x77, 0xb8, 0x8c,
-	0x78, 0xa9, 0xa6, 0xd3, 0x63, 0x7a, 0x44, 0x68, 0xc4, 0x78, 0x7e, 0xd2, 0x07, 0xd5, 0xde, 0x92,
-	0x68, 0xa0, 0xbd, 0xa5, 0xa2, 0x34, 0xce, 0xd6, 0x51, 0x0b, 0x0e, 0x61, 0x7c, 0x3d, 0xee, 0x41,
-	0x6a, 0x57, 0x7f, 0x14, 0xfd, 0xda, 0x5b, 0x51, 0x5c, 0x97, 0x39, 0x8b, 0xb9, 0x9e, 0x0a, 0x8f,
-	0x7c, 0xed, 0x46, 0x0a, 0x67, 0xc3, 0x6a, 0x17, 0xe6, 0x0c, 0xda, 0x46, 0xf8, 0x3a, 0xe7, 0x30,
-	0x06, 0x59, 0xc5, 0x4a, 0x48, 0x0d, 0x5a, 0x08, 0x69, 0xdb, 0xd7, 0xd1, 0xc0, 0xda, 0xbe, 0xf8,
-	0x63, 0x1e, 0xcb, 0x9d, 0xc9, 0x04, 0xf6, 0x8a, 0xd5, 0x55, 0xc4, 0x70, 0x67, 0x32, 0xa1, 0x7a,
-	0x05, 0x47, 0xb5, 0xb3, 0x9b, 0xe8, 0x23, 0xe0, 0xec, 0x28, 0x29, 0x95, 0xc3, 0xad, 0xb0, 0x15,
-	0x8d, 0x19, 0xa7, 0xc3, 0xbe, 0xb8, 0x76, 0xfc, 0xe7, 0x2b, 0xd1, 0xf7, 0x10, 0xcf, 0x67, 0x7c,
-	0x26, 0x16, 0x7c, 0xf0, 0xb4, 0xdb, 0x5a, 0x4d, 0x1a, 0xff, 0x9f, 0xdd, 0x42, 0x03, 0x19, 0x26,
-	0x23, 0x9e, 0xf2, 0x58, 0x92, 0xc3, 0xa4, 0x16, 0x77, 0x0e, 0x13, 0x83, 0x39, 0x33, 0xac, 0x11,
-	0x1e, 0x70, 0xb9, 0x3b, 0x2f, 0x0a, 0x9e, 0x49, 0xb2, 0x2f, 0x2d, 0xd2, 0xd9, 0x97, 0x1e, 0x8a,
-	0xd4, 0xe7, 0x80, 0xcb, 0x9d, 0x34, 0x25, 0xeb, 0x53, 0x8b, 0x3b, 0xeb, 0x63, 0x30, 0xed, 0x21,
-	0x8e, 0x7e, 0xdd, 0x69, 0x31, 0x79, 0x98, 0x5d, 0x8a, 0x01, 0xdd, 0x16, 0x4a, 0x6e, 0x7c, 0xac,
-	0x75, 0x72, 0x48, 0x35, 0x5e, 0xbd, 0xcb, 0x45, 0x41, 0x77, 0x4b, 0x2d, 0xee, 0xac, 0x86, 0xc1,
-	0xb4, 0x87, 0x3f, 0x8c, 0x3e, 0xd0, 0x51, 0xb2, 0x59, 0xcf, 0x1e, 0xa2, 0x21, 0x14, 0x2e, 0x68,
-	0x8f, 0x3a, 0x28, 0x1b, 0x1c, 0xb4, 0x4c, 0x07, 0x9f, 0x4f, 0x51, 0x3d, 0x10, 0x7a, 0x1e, 0x86,
-	0xa1, 0x96, 0xed, 0x3d, 0x9e, 0x72, 0xd2, 0x76, 0x2d, 0xec, 0xb0, 0x6d, 0x20, 0x6d, 0xbb, 0x88,
-	0x7e, 0xcb, 0x34, 0x4b, 0xb5, 0x8e, 0x2a, 0x79, 0x15, 0xa4, 0x37, 0x88, 0x7a, 0xbb, 0x90, 0xf1,
-	0xb5, 0xd9, 0x0f, 0x6e, 0xd5, 0x47, 0xcf, 0x40, 0xbc, 0x3e, 0x60, 0xfe, 0x3d, 0x0c, 0x43, 0xda,
-	0xf6, 0xdf, 0xad, 0x44, 0x3f, 0xd0, 0xb2, 0x57, 0x19, 0xbb, 0x48, 0xb9, 0x5a, 0x12, 0x4f, 0xb8,
-	0xbc, 0x11, 0xc5, 0xf5, 0x68, 0x99, 0xc5, 0xc4, 0xf2, 0x8f, 0xc3, 0x1d, 0xcb, 0x3f, 0xa9, 0xe4,
-	0x64, 0x7c, 0xba, 0xa2, 0x52, 0xe4, 0x30, 0xe3, 0x6b, 0x6a, 0x20, 0x45, 0x4e, 0x65, 0x7c, 0x3e,
-	0xd2, 0xb2, 0x7a, 0x5c, 0x85, 0x4d, 0xdc, 0xea, 0xb1, 0x1b, 0x27, 0x1f, 0x84, 0x10, 0x1b, 0xb6,
-	0x9a, 0x01, 0x2c, 0xb2, 0xcb, 0x64, 0x7a, 0x9e, 0x4f, 0xaa, 0x61, 0xfc, 0x18, 0x1f, 0xa1, 0x0e,
-	0x42, 0x84, 0x2d, 0x02, 0xd5, 0xde, 0xfe, 0xc1, 0x26, 0x46, 0x7a, 0x2a, 0xed, 0x17, 0x62, 0x76,
-	0xc4, 0xa7, 0x2c, 0x5e, 0xea, 0xf9, 0xff, 0x79, 0x68, 0xe2, 0x41, 0xda, 0x14, 0xe2, 0xc5, 0x2d,
-	0xb5, 0x74, 0x79, 0xfe, 0x7d, 0x25, 0x7a, 0xd8, 0x54, 0xff, 0x8a, 0x65, 0x53, 0xae, 0xfb, 0xb3,
-	0x2e, 0xfd, 0x4e, 0x36, 0x39, 0xe3, 0xa5, 0x64, 0x85, 0x1c, 0xfc, 0x08, 0xaf, 0x64, 0x48, 0xc7,
-	0x94, 0xed, 0xc7, 0xbf, 0x94, 0xae, 0xed, 0xf5, 0x51, 0x15, 0xd8, 0x74, 0x08, 0xf0, 0x7b, 0x5d,
-	0x49, 0x60, 0x00, 0x78, 0x10, 0x42, 0x6c, 0xaf, 0x2b, 0xc1, 0x61, 0xb6, 0x48, 0x24, 0x3f, 0xe0,
-	0x19, 0x2f, 0xda, 0xbd, 0x5e, 0xab, 0xfa, 0x08, 0xd1, 0xeb, 0x04, 0x6a, 0x83, 0x8d, 0xe7, 0xcd,
-	0x2c, 0x8e, 0x1b, 0x01, 0x23, 0xad, 0xe5, 0x71, 0xb3, 0x1f, 0x6c, 0x77, 0x77, 0x8e, 0xcf, 0x33,
-	0xbe, 0x10, 0xd7, 0x70, 0x77, 0xe7, 0x9a, 0xa8, 0x01, 0x62, 0x77, 0x87, 0x82, 0x76, 0x05, 0x73,
-	0xfc, 0xbc, 0x49, 0xf8, 0x0d, 0x58, 0xc1, 0x5c, 0xe5, 0x4a, 0x4c, 0xac, 0x60, 0x08, 0xa6, 0x3d,
-	0x9c, 0x44, 0xbf, 0xa2, 0x84, 0xbf, 0x2f, 0x92, 0x6c, 0x70, 0x17, 0x51, 0xaa, 0x04, 0xc6, 0xea,
-	0x3d, 0x1a, 0x00, 0x25, 0xae, 0x7e, 0xdd, 0x65, 0x59, 0xcc, 0x53, 0xb4, 0xc4, 0x56, 0x1c, 0x2c,
-	0xb1, 0x87, 0xd9, 0xd4, 0x41, 0x09, 0xab, 0xf8, 0x35, 0xba, 0x62, 0x45, 0x92, 0x4d, 0x07, 0x98,
-	0xae, 0x23, 0x27, 0x52, 0x07, 0x8c, 0x03, 0x43, 0x58, 0x2b, 0xee, 0xe4, 0x79, 0x51, 0x85, 0x45,
-	0x6c, 0x08, 0xfb, 0x48, 0x70, 0x08, 0xb7, 0x50, 0xdc, 0xdb, 0x1e, 0x8f, 0xd3, 0x24, 0x0b, 0x7a,
-	0xd3, 0x48, 0x1f, 0x6f, 0x16, 0x05, 0x83, 0xf7, 0x88, 0xb3, 0x05, 0x6f, 0x6a, 0x86, 0xb5, 0x8c,
-	0x0b, 0x04, 0x07, 0x2f, 0x00, 0xed, 0x3e, 0x4d, 0x89, 0x8f, 0xd9, 0x35, 0xaf, 0x1a, 0x98, 0x57,
-	0xeb, 0xda, 0x00, 0xd3, 0xf7, 0x08, 0x62, 0x9f, 0x86, 0x93, 0xda, 0xd5, 0x3c, 0xfa, 0x48, 0xc9,
-	0x4f, 0x59, 0x21, 0x93, 0x38, 0xc9, 0x59, 0xd6, 0xe4, 0xff, 0xd8, 0xbc, 0x6e, 0x51, 0xc6, 0xe5,
-	0x56, 0x4f, 0x5a, 0xbb, 0xfd, 0xb7, 0x95, 0xe8, 0x3e, 0xf4, 0x7b, 0xca, 0x8b, 0x59, 0xa2, 0xb6,
-	0x91, 0x65, 0x1d, 0x84, 0x07, 0x5f, 0x86, 0x8d, 0xb6, 0x14, 0x4c, 0x69, 0x7e, 0x78, 0x7b, 0x45,
-	0x9b, 0x0c, 0x8d, 0x74, 0x6a, 0xfd, 0xba, 0x98, 0xb4, 0x8e, 0x59, 0x46, 0x4d, 0xbe, 0xac, 0x84,
-	0x44, 0x32, 0xd4, 0x82, 0xc0, 0x0c, 0x3f, 0xcf, 0xca, 0xc6, 0x3a, 0x36, 0xc3, 0xad, 0x38, 0x38,
-	0xc3, 0x3d, 0x4c, 0x7b, 0xf8, 0x83, 0x28, 0xaa, 0x37, 0x5b, 0x6a, 0x43, 0xec, 0xc7, 0x1c, 0xbd,
-	0x0b, 0xf3, 0x76, 0xc3, 0xf7, 0x03, 0x84, 0x5d, 0xe8, 0xea, 0xdf, 0xd5, 0x3e, 0x7f, 0x80, 0x6a,
-	0x28, 0x11, 0xb1, 0xd0, 0x01, 0x04, 0x16, 0x74, 0x74, 0x25, 0x6e, 0xf0, 0x82, 0x56, 0x92, 0x70,
-	0x41, 0x35, 0x61, 0x4f, 0xde, 0x74, 0x41, 0xb1, 0x93, 0xb7, 0xa6, 0x18, 0xa1, 0x93, 0x37, 0xc8,
-	0x68, 0xc3, 0x22, 0xfa, 0x4d, 0xd7, 0xf0, 0x4b, 0x21, 0xae, 0x67, 0xac, 0xb8, 0x1e, 0x3c, 0xa1,
-	0x95, 0x1b, 0xc6, 0x38, 0xda, 0xe8, 0xc5, 0xda, 0xa0, 0xe6, 0x3a, 0xac, 0xd2, 0xa4, 0xf3, 0x22,
-	0x05, 0x41, 0xcd, 0xb3, 0xa1, 0x11, 0x22, 0xa8, 0x11, 0xa8, 0x1d, 0x95, 0xae, 0xb7, 0x11, 0x87,
-	0x7b, 0x3d, 0x4f, 0x7d, 0xc4, 0xa9, 0xbd, 0x1e, 0x82, 0xc1, 0x21, 0x74, 0x50, 0xb0, 0xfc, 0x0a,
-	0x1f, 0x42, 0x4a, 0x14, 0x1e, 0x42, 0x0d, 0x02, 0xfb, 0x7b, 0xc4, 0x59, 0x11, 0x5f, 0xe1, 0xfd,
-	0x5d, 0xcb, 0xc2, 0xfd, 0x6d, 0x18, 0xd8, 0xdf, 0xb5, 0xe0, 0x6d, 0x22, 0xaf, 0x8e, 0xb9, 0x64,
-	0x78, 0x7f, 0xfb, 0x4c, 0xb8, 0xbf, 0x5b, 0xac, 0xcd, 0xc3, 0x5c, 0x87, 0xa3, 0xf9, 0x45, 0x19,
-	0x17, 0xc9, 0x05, 0x1f, 0x04, 0xac, 0x18, 0x88, 0xc8, 0xc3, 0x48, 0x58, 0xfb, 0xfc, 0xf9, 0x4a,
-	0x74, 0xb7, 0xe9, 0x76, 0x51, 0x96, 0x3a, 0xe6, 0xf9, 0xee, 0x5f, 0xe0, 0xfd, 0x4b, 0xe0, 0xc4,
-	0x59, 0x68, 0x0f, 0x35, 0x67, 0x4d, 0xc0, 0x8b, 0x74, 0x9e, 0x95, 0xa6, 0x50, 0x5f, 0xf6, 0xb1,
-	0xee, 0x28, 0x10, 0x6b, 0x42, 0x2f, 0x45, 0xbb, 0x1c, 0xeb, 0xfe, 0x69, 0x64, 0x87, 0x93, 0x12,
-	0x2c, 0xc7, 0x4d, 0x7b, 0x3b, 0x04, 0xb1, 0x1c, 0xe3, 0x24, 0x1c, 0x0a, 0x07, 0x85, 0x98, 0xe7,
-	0x65, 0xc7, 0x50, 0x00, 0x50, 0x78, 0x28, 0xb4, 0x61, 0xed, 0xf3, 0x5d, 0xf4, 0xdb, 0xee, 0xf0,
-	0x73, 0x1b, 0x7b, 0x8b, 0x1e, 0x53, 0x58, 0x13, 0x0f, 0xfb, 0xe2, 0x36, 0x21, 0x6d, 0x3c, 0xcb,
-	0x3d, 0x2e, 0x59, 0x92, 0x96, 0x83, 0x55, 0xdc, 0x46, 0x23, 0x27, 0x12, 0x52, 0x8c, 0x83, 0xf1,
-	0x6d, 0x6f, 0x9e, 0xa7, 0x49, 0xdc, 0x3e, 0x89, 0xd6, 0xba, 0x46, 0x1c, 0x8e, 0x6f, 0x2e, 0x06,
-	0xe3, 0x75, 0xb5, 0xe4, 0xab, 0xff, 0x8c, 0x97, 0x39, 0xc7, 0xe3, 0xb5, 0x87, 0x84, 0xe3, 0x35,
-	0x44, 0x61, 0x7d, 0x46, 0x5c, 0x1e, 0xb1, 0xa5, 0x98, 0x13, 0xf1, 0xda, 0x88, 0xc3, 0xf5, 0x71,
-	0x31, 0x9b, 0x13, 0x1a, 0x0f, 0x87, 0x99, 0xe4, 0x45, 0xc6, 0xd2, 0xfd, 0x94, 0x4d, 0xcb, 0x01,
-	0x11, 0x63, 0x7c, 0x8a, 0xc8, 0x09, 0x69, 0x1a, 0x69, 0xc6, 0xc3, 0x72, 0x9f, 0x2d, 0x44, 0x91,
-	0x48, 0xba, 0x19, 0x2d, 0xd2, 0xd9, 0x8c, 0x1e, 0x8a, 0x7a, 0xdb, 0x29, 0xe2, 0xab, 0x64, 0xc1,
-	0x27, 0x01, 0x6f, 0x0d, 0xd2, 0xc3, 0x9b, 0x83, 0x22, 0x9d, 0x36, 0x12, 0xf3, 0x22, 0xe6, 0x64,
-	0xa7, 0xd5, 0xe2, 0xce, 0x4e, 0x33, 0x98, 0xf6, 0xf0, 0x57, 0x2b, 0xd1, 0xef, 0xd4, 0x52, 0xf7,
-	0x78, 0x78, 0x8f, 0x95, 0x57, 0x17, 0x82, 0x15, 0x93, 0xc1, 0x67, 0x98, 0x1d, 0x14, 0x35, 0xae,
-	0x9f, 0xdd, 0x46, 0x05, 0x36, 0xeb, 0x51, 0x52, 0x3a, 0x33, 0x0e, 0x6d, 0x56, 0x0f, 0x09, 0x37,
-	0x2b, 0x44, 0x61, 0x00, 0x51, 0xf2, 0xfa, 0x28, 0x66, 0x95, 0xd4, 0xf7, 0xcf, 0x63, 0xd6, 0x3a,
-	0x39, 0x18, 0x1f, 0x2b, 0xa1, 0x3f, 0x5a, 0xb6, 0x28, 0x1b, 0xf8, 0x88, 0x19, 0xf6, 0xc5, 0x49,
-	0xcf, 0x66, 0x56, 0x84, 0x3d, 0xb7, 0x66, 0xc6, 0xb0, 0x2f, 0x4e, 0x78, 0x76, 0xc2, 0x5a, 0xc8,
-	0x33, 0x12, 0xda, 0x86, 0x7d, 0x71, 0x98, 0x7d, 0x69, 0xa6, 0x59, 0x17, 0x9e, 0x04, 0xec, 0xc0,
-	0xb5, 0x61, 0xa3, 0x17, 0xab, 0x1d, 0xfe, 0xcd, 0x4a, 0xf4, 0x7d, 0xeb, 0xf1, 0x58, 0x4c, 0x92,
-	0xcb, 0x65, 0x0d, 0xbd, 0x61, 0xe9, 0x9c, 0x97, 0x83, 0x67, 0x94, 0xb5, 0x36, 0x6b, 0x4a, 0xf0,
-	0xfc, 0x56, 0x3a, 0x70, 0xee, 0xec, 0xe4, 0x79, 0xba, 0x1c, 0xf3, 0x59, 0x9e, 0x92, 0x73, 0xc7,
-	0x43, 0xc2, 0x73, 0x07, 0xa2, 0x30, 0x2b, 0x1f, 0x8b, 0x2a, 0xe7, 0x47, 0xb3, 0x72, 0x25, 0x0a,
-	0x67, 0xe5, 0x0d, 0x02, 0x73, 0xa5, 0xb1, 0xd8, 0x15, 0x69, 0xca, 0x63, 0xd9, 0x7e, 0xc4, 0x6c,
-	0x34, 0x2d, 0x11, 0xce, 0x95, 0x00, 0x69, 0x4f, 0x63, 0x9a, 0x3d, 0x24, 0x2b, 0xf8, 0xcb, 0xe5,
-	0x51, 0x92, 0x5d, 0x0f, 0xf0, 0xb4, 0xc0, 0x02, 0xc4, 0x69, 0x0c, 0x0a, 0xc2, 0xbd, 0xea, 0x79,
-	0x36, 0x11, 0xf8, 0x5e, 0xb5, 0x92, 0x84, 0xf7, 0xaa, 0x9a, 0x80, 0x26, 0xcf, 0x38, 0x65, 0xb2,
-	0x92, 0x84, 0x4d, 0x6a, 0x02, 0x0b, 0x85, 0xfa, 0xcc, 0x9e, 0x0c, 0x85, 0xe0, 0x94, 0x7e, 0xad,
-	0x93, 0x83, 0x23, 0xb4, 0xd9, 0xb4, 0xee, 0x73, 0x19, 0x5f, 0xe1, 0x23, 0xd4, 0x43, 0xc2, 0x23,
-	0x14, 0xa2, 0xb0, 0x4a, 0x63, 0x61, 0x36, 0xdd, 0xab, 0xf8, 0xf8, 0x68, 0x6d, 0xb8, 0xd7, 0x3a,
-	0x39, 0xb8, 0x8d, 0x3c, 0x9c, 0xa9, 0x36, 0x43, 0x07, 0x79, 0x2d, 0x0b, 0x6f, 0x23, 0x0d, 0x03,
-	0x4b, 0x5f, 0x0b, 0xaa, 0xe6, 0xc4, 0x4b, 0x6f, 0xe5, 0xe1, 0xd2, 0x7b, 0x9c, 0x76, 0xf2, 0x2f,
-	0x66, 0x1b, 0x57, 0x4b, 0x4f, 0x44, 0x35, 0x47, 0xde, 0xb0, 0x34, 0x99, 0x30, 0xc9, 0xc7, 0xe2,
-	0x9a, 0x67, 0xf8, 0x8e, 0x49, 0x97, 0xb6, 0xe6, 0x87, 0x9e, 0x42, 0x78, 0xc7, 0x14, 0x56, 0x84,
-	0xe3, 0xa4, 0xa6, 0xcf, 0x4b, 0xbe, 0xcb, 0x4a, 0x22, 0x92, 0x79, 0x48, 0x78, 0x9c, 0x40, 0x14,
-	0xe6, 0xab, 0xb5, 0xfc, 0xd5, 0xbb, 0x9c, 0x17, 0x09, 0xcf, 0x62, 0x8e, 0xe7, 0xab, 0x90, 0x0a,
-	0xe7, 0xab, 0x08, 0x0d, 0xf7, 0x6a, 0x7b, 0x4c, 0xf2, 0x97, 0xcb, 0x71, 0x32, 0xe3, 0xa5, 0x64,
-	0xb3, 0x1c, 0xdf, 0xab, 0x01, 0x28, 0xbc, 0x57, 0x6b, 0xc3, 0xad, 0xa3, 0x21, 0x13, 0x10, 0xdb,
-	0x37, 0x53, 0x20, 0x11, 0xb8, 0x99, 0x42, 0xa0, 0xb0, 0x61, 0x2d, 0x80, 0x1e, 0x0e, 0xb7, 0xac,
-	0x04, 0x0f, 0x87, 0x69, 0xba, 0x75, 0xe0, 0x66, 0x98, 0x51, 0x35, 0x35, 0x3b, 0x8a, 0x3e, 0x72,
-	0xa7, 0xe8, 0x46, 0x2f, 0x16, 0x3f, 0xe1, 0x3b, 0xe3, 0x29, 0x53, 0xcb, 0x56, 0xe0, 0x18, 0xad,
-	0x61, 0xfa, 0x9c, 0xf0, 0x39, 0xac, 0x76, 0xf8, 0x17, 0x2b, 0xd1, 0x27, 0x98, 0xc7, 0xd7, 0xb9,
-	0xf2, 0xfb, 0xb4, 0xdb, 0x56, 0x4d, 0x12, 0x57, 0x6f, 0xc2, 0x1a, 0xba, 0x0c, 0x7f, 0x12, 0x7d,
-	0xdc, 0x88, 0xec, 0xcd, 0x1c, 0x5d, 0x00, 0x3f, 0x69, 0x33, 0xe5, 0x87, 0x9c, 0x71, 0xbf, 0xdd,
-	0x9b, 0xb7, 0xfb, 0x21, 0xbf, 0x5c, 0x25, 0xd8, 0x0f, 0x19, 0x1b, 0x5a, 0x4c, 0xec, 0x87, 0x10,
-	0xcc, 0xce, 0x4e, 0xb7, 0x7a, 0x6f, 0x13, 0x79, 0xa5, 0xf2, 0x2d, 0x30, 0x3b, 0xbd, 0xb2, 0x1a,
-	0x88, 0x98, 0x9d, 0x24, 0x0c, 0x33, 0x92, 0x06, 0xac, 0xe6, 0x26, 0x16, 0xcb, 0x8d, 0x21, 0x77,
-	0x66, 0xae, 0x77, 0x83, 0x70, 0xbc, 0x36, 0x62, 0xbd, 0xf5, 0x79, 0x12, 0xb2, 0x00, 0xb6, 0x3f,
-	0x1b, 0xbd, 0x58, 0xed, 0xf0, 0xcf, 0xa2, 0xef, 0xb5, 0x2a, 0xb6, 0xcf, 0x99, 0x9c, 0x17, 0x7c,
-	0x32, 0xd8, 0xee, 0x28, 0x77, 0x03, 0x1a, 0xd7, 0x4f, 0xfb, 0x2b, 0xb4, 0x72, 0xf4, 0x86, 0xab,
-	0x87, 0x95, 0x29, 0xc3, 0xb3, 0x90, 0x49, 0x9f, 0x0d, 0xe6, 0xe8, 0xb4, 0x4e, 0x6b, 0x9b, 0xed,
-	0x8e, 0xae, 0x9d, 0x05, 0x4b, 0x52, 0xf5, 0x90, 0xee, 0xb3, 0x90, 0x51, 0x0f, 0x0d, 0x6e, 0xb3,
-	0x49, 0x95, 0x56, 0x64, 0x56, 0x73, 0xdc, 0xd9, 0x9e, 0x6d, 0xd2, 0x91, 0x00, 0xd9, 0x9d, 0x6d,
-	0xf5, 0xa4, 0xb5, 0x5b, 0xd9, 0x2c, 0x79, 0xd5, 0xcf, 0xee, 0x20, 0xc7, 0xbc, 0x6a, 0x55, 0x64,
-	0xa4, 0x6f, 0xf5, 0xa4, 0xb5, 0xd7, 0x3f, 0x8d, 0x3e, 0x6e, 0x7b, 0xd5, 0x0b, 0xd1, 0x76, 0xa7,
-	0x29, 0xb0, 0x16, 0x3d, 0xed, 0xaf, 0x60, 0xb7, 0x34, 0x5f, 0x25, 0xa5, 0x14, 0xc5, 0x72, 0x74,
-	0x25, 0x6e, 0x9a, 0x1b, 0xef, 0xfe, 0x6c, 0xd5, 0xc0, 0xd0, 0x21, 0x88, 0x2d, 0x0d, 0x4e, 0xb6,
-	0x5c, 0xd9, 0x9b, 0xf1, 0x25, 0xe1, 0xca, 0x21, 0x3a, 0x5c, 0xf9, 0xa4, 0x8d, 0x55, 0x4d, 0xad,
-	0xec, 0x35, 0xfe, 0x35, 0xbc, 0xa8, 0xed, 0xab, 0xfc, 0xeb, 0xdd, 0xa0, 0xcd, 0x58, 0xb4, 0x78,
-	0x2f, 0xb9, 0xbc, 0x34, 0x75, 0xc2, 0x4b, 0xea, 0x22, 0x44, 0xc6, 0x42, 0xa0, 0x36, 0xe9, 0xde,
-	0x4f, 0x52, 0xae, 0x4e, 0xf4, 0x5f, 0x5f, 0x5e, 0xa6, 0x82, 0x4d, 0x40, 0xd2, 0x5d, 0x89, 0x87,
-	0xae, 0x9c, 0x48, 0xba, 0x31, 0xce, 0x3e, 0x23, 0xae, 0xa4, 0x67, 0x3c, 0x16, 0x59, 0x9c, 0xa4,
-	0xf0, 0x02, 0xa0, 0xd2, 0x34, 0x42, 0xe2, 0x19, 0x71, 0x0b, 0xb2, 0x0b, 0x63, 0x25, 0xaa, 0xa6,
-	0x7d, 0x53, 0xfe, 0x47, 0x6d, 0x45, 0x47, 0x4c, 0x2c, 0x8c, 0x08, 0x66, 0xf7, 0x9e, 0x95, 0xf0,
-	0x3c, 0x57, 0xc6, 0xef, 0xb5, 0xb5, 0x6a, 0x09, 0xb1, 0xf7, 0xf4, 0x09, 0xbb, 0x87, 0xaa, 0x7e,
-	0xdf, 0x13, 0x37, 0x99, 0x32, 0xfa, 0xa0, 0xad, 0xd2, 0xc8, 0x88, 0x3d, 0x14, 0x64, 0xb4, 0xe1,
-	0x9f, 0x44, 0xff, 0x5f, 0x19, 0x2e, 0x44, 0x3e, 0xb8, 0x83, 0x28, 0x14, 0xce, 0x5d, 0xbd, 0xbb,
-	0xa4, 0xdc, 0x5e, 0x39, 0x35, 0x63, 0xe3, 0xbc, 0x64, 0x53, 0x3e, 0x78, 0x48, 0xf4, 0xb8, 0x92,
-	0x12, 0x57, 0x4e, 0xdb, 0x94, 0x3f, 0x2a, 0x4e, 0xc4, 0x44, 0x5b, 0x47, 0x6a, 0x68, 0x84, 0xa1,
-	0x51, 0xe1, 0x42, 0x36, 0x99, 0x39, 0x61, 0x8b, 0x64, 0x6a, 0x16, 0x9c, 0x3a, 0x6e, 0x95, 0x20,
-	0x99, 0xb1, 0xcc, 0xd0, 0x81, 0x88, 0x64, 0x86, 0x84, 0xb5, 0xcf, 0x7f, 0x5e, 0x89, 0xee, 0x59,
-	0xe6, 0xa0, 0x39, 0xad, 0x3b, 0xcc, 0x2e, 0x45, 0x95, 0xfa, 0x1c, 0x25, 0xd9, 0x75, 0x39, 0xf8,
-	0x82, 0x32, 0x89, 0xf3, 0xa6, 0x28, 0x5f, 0xde, 0x5a, 0xcf, 0x66, 0xad, 0xcd, 0x51, 0x96, 0x7d,
-	0x9e, 0x5d, 0x6b, 0x80, 0xac, 0xd5, 0x9c, 0x78, 0x41, 0x8e, 0xc8, 0x5a, 0x43, 0xbc, 0xed, 0x62,
-	0xe3, 0x3c, 0x15, 0x19, 0xec, 0x62, 0x6b, 0xa1, 0x12, 0x12, 0x5d, 0xdc, 0x82, 0x6c, 0x3c, 0x6e,
-	0x44, 0xf5, 0xa9, 0xcb, 0x4e, 0x9a, 0x82, 0x78, 0x6c, 0x54, 0x0d, 0x40, 0xc4, 0x63, 0x14, 0xd4,
-	0x7e, 0xce, 0xa2, 0xef, 0x54, 0x4d, 0x7a, 0x5a, 0xf0, 0x45, 0xc2, 0xe1, 0xd5, 0x0b, 0x47, 0x42,
-	0xcc, 0x7f, 0x9f, 0xb0, 0x33, 0xeb, 0x3c, 0x2b, 0xf3, 0x94, 0x95, 0x57, 0xfa, 0x61, 0xbc, 0x5f,
-	0xe7, 0x46, 0x08, 0x1f, 0xc7, 0x3f, 0xea, 0xa0, 0x6c, 0x50, 0x6f, 0x64, 0x26, 0xc4, 0xac, 0xe2,
-	0xaa, 0xad, 0x30, 0xb3, 0xd6, 0xc9, 0xd9, 0x13, 0xef, 0x03, 0x96, 0xa6, 0xbc, 0x58, 0x36, 0xb2,
-	0x63, 0x96, 0x25, 0x97, 0xbc, 0x94, 0xe0, 0xc4, 0x5b, 0x53, 0x43, 0x88, 0x11, 0x27, 0xde, 0x01,
-	0xdc, 0x66, 0xf3, 0xc0, 0xf3, 0x61, 0x36, 0xe1, 0xef, 0x40, 0x36, 0x0f, 0xed, 0x28, 0x86, 0xc8,
-	0xe6, 0x29, 0xd6, 0x9e, 0xfc, 0xbe, 0x4c, 0x45, 0x7c, 0xad, 0x97, 0x00, 0xbf, 0x83, 0x95, 0x04,
-	0xae, 0x01, 0x0f, 0x42, 0x88, 0x5d, 0x04, 0x94, 0xe0, 0x8c, 0xe7, 0x29, 0x8b, 0xe1, 0xfd, 0x9b,
-	0x5a, 0x47, 0xcb, 0x88, 0x45, 0x00, 0x32, 0xa0, 0xb8, 0xfa, 0x5e, 0x0f, 0x56, 0x5c, 0x70, 0xad,
-	0xe7, 0x41, 0x08, 0xb1, 0xcb, 0xa0, 0x12, 0x8c, 0xf2, 0x34, 0x91, 0x60, 0x1a, 0xd4, 0x1a, 0x4a,
-	0x42, 0x4c, 0x03, 0x9f, 0x00, 0x26, 0x8f, 0x79, 0x31, 0xe5, 0xa8, 0x49, 0x25, 0x09, 0x9a, 0x6c,
-	0x08, 0x7b, 0xc9, 0xb4, 0xae, 0xbb, 0xc8, 0x97, 0xe0, 0x92, 0xa9, 0xae, 0x96, 0xc8, 0x97, 0xc4,
-	0x25, 0x53, 0x0f, 0x00, 0x45, 0x3c, 0x65, 0xa5, 0xc4, 0x8b, 0xa8, 0x24, 0xc1, 0x22, 0x36, 0x84,
-	0x5d, 0xa3, 0xeb, 0x22, 0xce, 0x25, 0x58, 0xa3, 0x75, 0x01, 0x9c, 0x27, 0xd0, 0x77, 0x49, 0xb9,
-	0x8d, 0x24, 0x75, 0xaf, 0x70, 0xb9, 0x9f, 0xf0, 0x74, 0x52, 0x82, 0x48, 0xa2, 0xdb, 0xbd, 0x91,
-	0x12, 0x91, 0xa4, 0x4d, 0x81, 0xa1, 0xa4, 0xcf, 0xc7, 0xb1, 0xda, 0x81, 0xa3, 0xf1, 0x07, 0x21,
-	0xc4, 0xc6, 0xa7, 0xa6, 0xd0, 0xbb, 0xac, 0x28, 0x92, 0x6a, 0xf1, 0x5f, 0xc5, 0x0b, 0xd4, 0xc8,
-	0x89, 0xf8, 0x84, 0x71, 0x60, 0x7a, 0x35, 0x81, 0x1b, 0x2b, 0x18, 0x0c, 0xdd, 0x9f, 0x06, 0x19,
-	0x9b, 0x71, 0x2a, 0x89, 0xf3, 0x08, 0x15, 0x6b, 0x4d, 0xe4, 0x09, 0xea, 0x6a, 0x17, 0xe6, 0xbc,
-	0x04, 0x62, 0x5c, 0x1c, 0x8b, 0x05, 0x1f, 0x8b, 0x57, 0xef, 0x92, 0x52, 0x26, 0xd9, 0x54, 0xaf,
-	0xdc, 0xcf, 0x09, 0x4b, 0x18, 0x4c, 0xbc, 0x04, 0xd2, 0xa9, 0x64, 0x13, 0x08, 0x50, 0x96, 0x13,
-	0x7e, 0x83, 0x26, 0x10, 0xd0, 0xa2, 0xe1, 0x88, 0x04, 0x22, 0xc4, 0xdb, 0x73, 0x14, 0xe3, 0x5c,
-	0xbf, 0x29, 0x3b, 0x16, 0x4d, 0x2e, 0x47, 0x59, 0x83, 0x20, 0xb1, 0x95, 0x0d, 0x2a, 0xd8, 0xfd,
-	0xa5, 0xf1, 0x6f, 0xa7, 0xd8, 0x3a, 0x61, 0xa7, 0x3d, 0xcd, 0x1e, 0xf7, 0x20, 0x11, 0x57, 0xf6,
-	0x1e, 0x00, 0xe5, 0xaa, 0x7d, 0x0d, 0xe0, 0x71, 0x0f, 0xd2, 0x39, 0x93, 0x71, 0xab, 0xf5, 0x92,
-	0xc5, 0xd7, 0xd3, 0x42, 0xcc, 0xb3, 0xc9, 0xae, 0x48, 0x45, 0x01, 0xce, 0x64, 0xbc, 0x52, 0x03,
-	0x94, 0x38, 0x93, 0xe9, 0x50, 0xb1, 0x19, 0x9c, 0x5b, 0x8a, 0x9d, 0x34, 0x99, 0xc2, 0x1d, 0xb5,
-	0x67, 0x48, 0x01, 0x44, 0x06, 0x87, 0x82, 0xc8, 0x20, 0xaa, 0x77, 0xdc, 0x32, 0x89, 0x59, 0x5a,
-	0xfb, 0xdb, 0xa6, 0xcd, 0x78, 0x60, 0xe7, 0x20, 0x42, 0x14, 0x90, 0x7a, 0x8e, 0xe7, 0x45, 0x76,
-	0x98, 0x49, 0x41, 0xd6, 0xb3, 0x01, 0x3a, 0xeb, 0xe9, 0x80, 0x20, 0xac, 0x8e, 0xf9, 0xbb, 0xaa,
-	0x34, 0xd5, 0x3f, 0x58, 0x58, 0xad, 0x7e, 0x1f, 0x6a, 0x79, 0x28, 0xac, 0x02, 0x0e, 0x54, 0x46,
-	0x3b, 0xa9, 0x07, 0x4c, 0x40, 0xdb, 0x1f, 0x26, 0xeb, 0xdd, 0x20, 0xee, 0x67, 0x24, 0x97, 0x29,
-	0x0f, 0xf9, 0x51, 0x40, 0x1f, 0x3f, 0x0d, 0x68, 0x8f, 0x5b, 0xbc, 0xfa, 0x5c, 0xf1, 0xf8, 0xba,
-	0x75, 0xad, 0xc9, 0x2f, 0x68, 0x8d, 0x10, 0xc7, 0x2d, 0x04, 0x8a, 0x77, 0xd1, 0x61, 0x2c, 0xb2,
-	0x50, 0x17, 0x55, 0xf2, 0x3e, 0x5d, 0xa4, 0x39, 0xbb, 0xf9, 0x35, 0x52, 0x3d, 0x32, 0xeb, 0x6e,
-	0xda, 0x20, 0x2c, 0xb8, 0x10, 0xb1, 0xf9, 0x25, 0x61, 0x9b, 0x93, 0x43, 0x9f, 0xc7, 0xed, 0x3b,
-	0xdf, 0x2d, 0x2b, 0xc7, 0xf4, 0x9d, 0x6f, 0x8a, 0xa5, 0x2b, 0x59, 0x8f, 0x91, 0x0e, 0x2b, 0xfe,
-	0x38, 0xd9, 0xec, 0x07, 0xdb, 0x2d, 0x8f, 0xe7, 0x73, 0x37, 0xe5, 0xac, 0xa8, 0xbd, 0x6e, 0x05,
-	0x0c, 0x59, 0x8c, 0xd8, 0xf2, 0x04, 0x70, 0x10, 0xc2, 0x3c, 0xcf, 0xbb, 0x22, 0x93, 0x3c, 0x93,
-	0x58, 0x08, 0xf3, 0x8d, 0x69, 0x30, 0x14, 0xc2, 0x28, 0x05, 0x30, 0x6e, 0xd5, 0x79, 0x10, 0x97,
-	0x27, 0x6c, 0x86, 0x66, 0x6c, 0xf5, 0x59, 0x4f, 0x2d, 0x0f, 0x8d, 0x5b, 0xc0, 0x39, 0x0f, 0xf9,
-	0x5c, 0x2f, 0x63, 0x56, 0x4c, 0xcd, 0xe9, 0xc6, 0x64, 0xf0, 0x94, 0xb6, 0xe3, 0x93, 0xc4, 0x43,
-	0xbe, 0xb0, 0x06, 0x08, 0x3b, 0x87, 0x33, 0x36, 0x35, 0x35, 0x45, 0x6a, 0xa0, 0xe4, 0xad, 0xaa,
-	0xae, 0x77, 0x83, 0xc0, 0xcf, 0x9b, 0x64, 0xc2, 0x45, 0xc0, 0x8f, 0x92, 0xf7, 0xf1, 0x03, 0x41,
-	0x90, 0xbd, 0x55, 0xf5, 0xae, 0x77, 0x74, 0x3b, 0xd9, 0x44, 0xef, 0x63, 0x87, 0x44, 0xf3, 0x00,
-	0x2e, 0x94, 0xbd, 0x11, 0x3c, 0x98, 0xa3, 0xcd, 0x01, 0x6d, 0x68, 0x8e, 0x9a, 0xf3, 0xd7, 0x3e,
-	0x73, 0x14, 0x83, 0xb5, 0xcf, 0x9f, 0xe9, 0x39, 0xba, 0xc7, 0x24, 0xab, 0xf2, 0xf6, 0x37, 0x09,
-	0xbf, 0xd1, 0x1b, 0x61, 0xa4, 0xbe, 0x0d, 0x35, 0x54, 0xaf, 0x2a, 0x82, 0x5d, 0xf1, 0x76, 0x6f,
-	0x3e, 0xe0, 0x5b, 0xef, 0x10, 0x3a, 0x7d, 0x83, 0xad, 0xc2, 0x76, 0x6f, 0x3e, 0xe0, 0x5b, 0xbf,
-	0x03, 0xdd, 0xe9, 0x1b, 0xbc, 0x08, 0xbd, 0xdd, 0x9b, 0xd7, 0xbe, 0xff, 0xb2, 0x99, 0xb8, 0xae,
-	0xf3, 0x2a, 0x0f, 0x8b, 0x65, 0xb2, 0xe0, 0x58, 0x3a, 0xe9, 0xdb, 0x33, 0x68, 0x28, 0x9d, 0xa4,
-	0x55, 0x9c, 0x0f, 0xe7, 0x60, 0xa5, 0x38, 0x15, 0x65, 0xa2, 0x1e, 0xd2, 0x3f, 0xef, 0x61, 0xb4,
-	0x81, 0x43, 0x9b, 0xa6, 0x90, 0x92, 0x7d, 0xdc, 0xe8, 0xa1, 0xf6, 0x16, 0xf3, 0x66, 0xc0, 0x5e,
-	0xfb, 0x32, 0xf3, 0x56, 0x4f, 0xda, 0x3e, 0xf8, 0xf3, 0x18, 0xf7, 0x89, 0x63, 0xa8, 0x57, 0xd1,
-	0x87, 0x8e, 0x4f, 0xfb, 0x2b, 0x68, 0xf7, 0x7f, 0xdd, 0xec, 0x2b, 0xa0, 0x7f, 0x3d, 0x09, 0x9e,
-	0xf5, 0xb1, 0x08, 0x26, 0xc2, 0xf3, 0x5b, 0xe9, 0xe8, 0x82, 0xfc, 0x7d, 0xb3, 0x81, 0x6e, 0x50,
-	0xf5, 0x2e, 0x87, 0x7a, 0xf7, 0x4f, 0xcf, 0x89, 0x50, 0xb7, 0x5a, 0x18, 0xce, 0x8c, 0x17, 0xb7,
-	0xd4, 0x72, 0x3e, 0xa3, 0xe4, 0xc1, 0xfa, 0x9d, 0x43, 0xa7, 0x3c, 0x21, 0xcb, 0x0e, 0x0d, 0x0b,
-	0xf4, 0xc5, 0x6d, 0xd5, 0xa8, 0xb9, 0xe2, 0xc0, 0xea, 0xab, 0x0c, 0xcf, 0x7b, 0x1a, 0xf6, 0xbe,
-	0xd3, 0xf0, 0xf9, 0xed, 0x94, 0x74, 0x59, 0xfe, 0x63, 0x25, 0x7a, 0xe4, 0xb1, 0xf6, 0x79, 0x02,
-	0x38, 0xf5, 0xf8, 0x71, 0xc0, 0x3e, 0xa5, 0x64, 0x0a, 0xf7, 0xbb, 0xbf, 0x9c, 0xb2, 0xfd, 0xe6,
-	0x90, 0xa7, 0xb2, 0x9f, 0xa4, 0x92, 0x17, 0xed, 0x6f, 0x0e, 0xf9, 0x76, 0x6b, 0x6a, 0x48, 0x7f,
-	0x73, 0x28, 0x80, 0x3b, 0xdf, 0x1c, 0x42, 0x3c, 0xa3, 0xdf, 0x1c, 0x42, 0xad, 0x05, 0xbf, 0x39,
-	0x14, 0xd6, 0xa0, 0xc2, 0x7b, 0x53, 0x84, 0xfa, 0xdc, 0xba, 0x97, 0x45, 0xff, 0x18, 0xfb, 0xd9,
-	0x6d, 0x54, 0x88, 0x05, 0xae, 0xe6, 0xd4, 0x3d, 0xb7, 0x1e, 0x6d, 0xea, 0xdd, 0x75, 0xdb, 0xee,
-	0xcd, 0x6b, 0xdf, 0x3f, 0xd5, 0xbb, 0x1b, 0x13, 0xce, 0x45, 0xa1, 0xbe, 0x37, 0xb5, 0x11, 0x0a,
-	0xcf, 0x95, 0x05, 0xb7, 0xe7, 0x37, 0xfb, 0xc1, 0x44, 0x75, 0x2b, 0x42, 0x77, 0xfa, 0xb0, 0xcb,
-	0x10, 0xe8, 0xf2, 0xed, 0xde, 0x3c, 0xb1, 0x8c, 0xd4, 0xbe, 0xeb, 0xde, 0xee, 0x61, 0xcc, 0xef,
-	0xeb, 0xa7, 0xfd, 0x15, 0xb4, 0xfb, 0x85, 0x4e, 0x1b, 0x5d, 0xf7, 0xaa, 0x9f, 0xb7, 0xba, 0x4c,
-	0x8d, 0xbc, 0x6e, 0x1e, 0xf6, 0xc5, 0x43, 0x09, 0x84, 0xbb, 0x84, 0x76, 0x25, 0x10, 0xe8, 0x32,
-	0xfa, 0xf9, 0xed, 0x94, 0x74, 0x59, 0xfe, 0x69, 0x25, 0xba, 0x4b, 0x96, 0x45, 0x8f, 0x83, 0x2f,
-	0xfa, 0x5a, 0x06, 0xe3, 0xe1, 0xcb, 0x5b, 0xeb, 0xe9, 0x42, 0xfd, 0xeb, 0x4a, 0x74, 0x2f, 0x50,
-	0xa8, 0x7a, 0x80, 0xdc, 0xc2, 0xba, 0x3f, 0x50, 0x7e, 0x78, 0x7b, 0x45, 0x6a, 0xb9, 0x77, 0xf1,
-	0x51, 0xfb, 0x63, 0x3c, 0x01, 0xdb, 0x23, 0xfa, 0x63, 0x3c, 0xdd, 0x5a, 0xf0, 0x90, 0x87, 0x5d,
-	0x34, 0x9b, 0x2e, 0xf4, 0x90, 0x47, 0xdd, 0x50, 0x03, 0x7b, 0x8e, 0xb5, 0x4e, 0x0e, 0x73, 0xf2,
-	0xea, 0x5d, 0xce, 0xb2, 0x09, 0xed, 0xa4, 0x96, 0x77, 0x3b, 0x31, 0x1c, 0x3c, 0x1c, 0xab, 0xa4,
-	0x67, 0xa2, 0xd9, 0x48, 0x3d, 0xa6, 0xf4, 0x0d, 0x12, 0x3c, 0x1c, 0x6b, 0xa1, 0x84, 0x37, 0x9d,
-	0x35, 0x86, 0xbc, 0x81, 0x64, 0xf1, 0x49, 0x1f, 0x14, 0xa4, 0xe8, 0xc6, 0x9b, 0x39, 0x73, 0xdf,
-	0x0c, 0x59, 0x69, 0x9d, 0xbb, 0x6f, 0xf5, 0xa4, 0x09, 0xb7, 0x23, 0x2e, 0xbf, 0xe2, 0x6c, 0xc2,
-	0x8b, 0xa0, 0x5b, 0x43, 0xf5, 0x72, 0xeb, 0xd2, 0x98, 0xdb, 0x5d, 0x91, 0xce, 0x67, 0x99, 0xee,
-	0x4c, 0xd2, 0xad, 0x4b, 0x75, 0xbb, 0x05, 0x34, 0x3c, 0x16, 0xb4, 0x6e, 0x55, 0x7a, 0xf9, 0x24,
-	0x6c, 0xc6, 0xcb, 0x2a, 0x37, 0x7a, 0xb1, 0x74, 0x3d, 0xf5, 0x30, 0xea, 0xa8, 0x27, 0x18, 0x49,
-	0x5b, 0x3d, 0x69, 0x78, 0x3e, 0xe7, 0xb8, 0x35, 0xe3, 0x69, 0xbb, 0xc3, 0x56, 0x6b, 0x48, 0x3d,
-	0xed, 0xaf, 0x00, 0x4f, 0x43, 0xf5, 0xa8, 0x3a, 0x4a, 0x4a, 0xb9, 0x9f, 0xa4, 0xe9, 0x60, 0x23,
-	0x30, 0x4c, 0x1a, 0x28, 0x78, 0x1a, 0x8a, 0xc0, 0xc4, 0x48, 0x6e, 0x4e, 0x0f, 0xb3, 0x41, 0x97,
-	0x1d, 0x45, 0xf5, 0x1a, 0xc9, 0x2e, 0x0d, 0x4e, 0xb4, 0x9c, 0xa6, 0x36, 0xb5, 0x1d, 0x86, 0x1b,
-	0xae, 0x55, 0xe1, 0xed, 0xde, 0x3c, 0x78, 0xdc, 0xae, 0x28, 0xb5, 0xb2, 0x3c, 0xa4, 0x4c, 0x78,
-	0x2b, 0xc9, 0xa3, 0x0e, 0x0a, 0x9c, 0x0a, 0xd6, 0xd3, 0xe8, 0x6d, 0x32, 0x99, 0x72, 0x89, 0x3e,
-	0x29, 0x72, 0x81, 0xe0, 0x93, 0x22, 0x00, 0x82, 0xae, 0xab, 0x7f, 0x37, 0xc7, 0xa1, 0x87, 0x13,
-	0xac, 0xeb, 0xb4, 0xb2, 0x43, 0x85, 0xba, 0x0e, 0xa5, 0x41, 0x34, 0x30, 0x6e, 0xf5, 0xeb, 0xf8,
-	0x4f, 0x42, 0x66, 0xc0, 0x3b, 0xf9, 0x1b, 0xbd, 0x58, 0xb0, 0xa2, 0x58, 0x87, 0xc9, 0x2c, 0x91,
-	0xd8, 0x8a, 0xe2, 0xd8, 0xa8, 0x90, 0xd0, 0x8a, 0xd2, 0x46, 0xa9, 0xea, 0x55, 0x39, 0xc2, 0xe1,
-	0x24, 0x5c, 0xbd, 0x9a, 0xe9, 0x57, 0x3d, 0xc3, 0xb6, 0x1e, 0x6c, 0x66, 0x66, 0xc8, 0xc8, 0x2b,
-	0xbd, 0x59, 0x46, 0xc6, 0xb6, 0x7a, 0x4d, 0x13, 0x82, 0xa1, 0xa8, 0x43, 0x29, 0xc0, 0x03, 0xfb,
-	0x8a, 0x6b, 0x9e, 0xbd, 0xe6, 0x39, 0x67, 0x05, 0xcb, 0x62, 0x74, 0x73, 0xaa, 0x0c, 0xb6, 0xc8,
-	0xd0, 0xe6, 0x94, 0xd4, 0x00, 0x8f, 0xcd, 0xfd, 0x17, 0x2c, 0x91, 0xa9, 0x60, 0xde, 0x64, 0xf4,
-	0xdf, 0xaf, 0x7c, 0xdc, 0x83, 0x84, 0x8f, 0xcd, 0x1b, 0xc0, 0x1c, 0x7c, 0xd7, 0x4e, 0x3f, 0x0b,
-	0x98, 0xf2, 0xd1, 0xd0, 0x46, 0x98, 0x56, 0x01, 0x83, 0xda, 0x24, 0xb8, 0x5c, 0xfe, 0x84, 0x2f,
-	0xb1, 0x41, 0x6d, 0xf3, 0x53, 0x85, 0x84, 0x06, 0x75, 0x1b, 0x05, 0x79, 0xa6, 0xbb, 0x0f, 0x5a,
-	0x0d, 0xe8, 0xbb, 0x5b, 0x9f, 0xb5, 0x4e, 0x0e, 0xcc, 0x9c, 0xbd, 0x64, 0xe1, 0x3d, 0x27, 0x40,
-	0x0a, 0xba, 0x97, 0x2c, 0xf0, 0xc7, 0x04, 0x1b, 0xbd, 0x58, 0xf8, 0x48, 0x9e, 0x49, 0xfe, 0xae,
-	0x79, 0x56, 0x8e, 0x14, 0x57, 0xc9, 0x5b, 0x0f, 0xcb, 0xd7, 0xbb, 0x41, 0x7b, 0x01, 0xf6, 0xb4,
-	0x10, 0x31, 0x2f, 0x4b, 0xfd, 0x85, 0x42, 0xff, 0x86, 0x91, 0x96, 0x0d, 0xc1, 0xf7, 0x09, 0x1f,
-	0x86, 0x21, 0xdb, 0x33, 0x5a, 0x64, 0xbf, 0x7a, 0xb3, 0x8a, 0x6a, 0xb6, 0x3f, 0x78, 0xb3, 0xd6,
-	0xc9, 0xd9, 0xe9, 0xa5, 0xa5, 0xee, 0x67, 0x6e, 0xd6, 0x51, 0x75, 0xec, 0x0b, 0x37, 0x8f, 0x7b,
-	0x90, 0xda, 0xd5, 0x57, 0xd1, 0xfb, 0x47, 0x62, 0x3a, 0xe2, 0xd9, 0x64, 0xf0, 0x03, 0xff, 0x0a,
-	0xad, 0x98, 0x0e, 0xab, 0x9f, 0x8d, 0xd1, 0x3b, 0x94, 0xd8, 0x5e, 0x02, 0xdc, 0xe3, 0x17, 0xf3,
-	0xe9, 0x48, 0x32, 0x09, 0x2e, 0x01, 0xaa, 0xdf, 0x87, 0x95, 0x80, 0xb8, 0x04, 0xe8, 0x01, 0xc0,
-	0xde, 0xb8, 0xe0, 0x1c, 0xb5, 0x57, 0x09, 0x82, 0xf6, 0x34, 0x60, 0xb3, 0x08, 0x63, 0xaf, 0x4a,
-	0xd4, 0xe1, 0xa5, 0x3d, 0xab, 0xa3, 0xa4, 0x44, 0x16, 0xd1, 0xa6, 0xec, 0xe0, 0xae, 0xab, 0xaf,
-	0xbe, 0x3a, 0x32, 0x9f, 0xcd, 0x58, 0xb1, 0x04, 0x83, 0x5b, 0xd7, 0xd2, 0x01, 0x88, 0xc1, 0x8d,
-	0x82, 0x76, 0xd6, 0x36, 0xcd, 0x1c, 0x5f, 0x1f, 0x88, 0x42, 0xcc, 0x65, 0x92, 0x71, 0xf8, 0xe5,
-	0x09, 0xd3, 0xa0, 0x2e, 0x43, 0xcc, 0x5a, 0x8a, 0xb5, 0x59, 0xae, 0x22, 0xea, 0xfb, 0x84, 0xea,
-	0xbb, 0xc5, 0xa5, 0x14, 0x05, 0x7c, 0x9e, 0x58, 0x5b, 0x81, 0x10, 0x91, 0xe5, 0x92, 0x30, 0xe8,
-	0xfb, 0xd3, 0x24, 0x9b, 0xa2, 0x7d, 0x7f, 0xea, 0x7e, 0xf5, 0xf3, 0x1e, 0x0d, 0xd8, 0x09, 0x55,
-	0x37, 0x5a, 0x3d, 0x01, 0xf4, 0xbb, 0x9c, 0x68, 0xa3, 0xbb, 0x04, 0x31, 0xa1, 0x70, 0x12, 0xb8,
-	0x7a, 0x9d, 0xf3, 0x8c, 0x4f, 0x9a, 0x5b, 0x73, 0x98, 0x2b, 0x8f, 0x08, 0xba, 0x82, 0xa4, 0x8d,
-	0x45, 0x4a, 0x7e, 0x36, 0xcf, 0x4e, 0x0b, 0x71, 0x99, 0xa4, 0xbc, 0x00, 0xb1, 0xa8, 0x56, 0x77,
-	0xe4, 0x44, 0x2c, 0xc2, 0x38, 0x7b, 0xfd, 0x42, 0x49, 0xbd, 0x8f, 0x6f, 0x8f, 0x0b, 0x16, 0xc3,
-	0xeb, 0x17, 0xb5, 0x8d, 0x36, 0x46, 0x9c, 0x0c, 0x06, 0x70, 0x27, 0xd1, 0xa9, 0x5d, 0x67, 0x4b,
-	0x35, 0x3e, 0xf4, 0xbb, 0x84, 0xea, 0x5b, 0x98, 0x25, 0x48, 0x74, 0xb4, 0x39, 0x8c, 0x24, 0x12,
-	0x9d, 0xb0, 0x86, 0x5d, 0x4a, 0x14, 0x77, 0xa2, 0xaf, 0x15, 0x81, 0xa5, 0xa4, 0xb6, 0xd1, 0x08,
-	0x89, 0xa5, 0xa4, 0x05, 0x81, 0x80, 0xd4, 0x4c, 0x83, 0x29, 0x1a, 0x90, 0x8c, 0x34, 0x18, 0x90,
-	0x5c, 0xca, 0x06, 0x8a, 0xc3, 0x2c, 0x91, 0x09, 0x4b, 0x47, 0x5c, 0x9e, 0xb2, 0x82, 0xcd, 0xb8,
-	0xe4, 0x05, 0x0c, 0x14, 0x1a, 0x19, 0x7a, 0x0c, 0x11, 0x28, 0x28, 0x56, 0x3b, 0xfc, 0xbd, 0xe8,
-	0xc3, 0x6a, 0xdd, 0xe7, 0x99, 0xfe, 0x33, 0x1b, 0xaf, 0xd4, 0xdf, 0xe7, 0x19, 0x7c, 0x64, 0x6c,
-	0x8c, 0x64, 0xc1, 0xd9, 0xac, 0xb1, 0xfd, 0x81, 0xf9, 0x5d, 0x81, 0x4f, 0x57, 0xaa, 0xf1, 0x7c,
-	0x22, 0x64, 0x72, 0x59, 0x6d, 0xb3, 0xf5, 0x1b, 0x44, 0x60, 0x3c, 0xbb, 0xe2, 0x61, 0xe0, 0x5b,
-	0x14, 0x18, 0x67, 0xe3, 0xb4, 0x2b, 0x3d, 0xe3, 0x79, 0x0a, 0xe3, 0xb4, 0xa7, 0xad, 0x00, 0x22,
-	0x4e, 0xa3, 0xa0, 0x9d, 0x9c, 0xae, 0x78, 0xcc, 0xc3, 0x95, 0x19, 0xf3, 0x7e, 0x95, 0x19, 0x7b,
-	0x2f, 0x65, 0xa4, 0xd1, 0x87, 0xc7, 0x7c, 0x76, 0xc1, 0x8b, 0xf2, 0x2a, 0xc9, 0x0f, 0xaa, 0x84,
-	0x8b, 0xc9, 0x39, 0x7c, 0x6d, 0xd1, 0x12, 0x43, 0x83, 0x10, 0x59, 0x29, 0x81, 0xda, 0x95, 0xc0,
-	0x02, 0x87, 0xe5, 0x09, 0x9b, 0x71, 0xf5, 0x65, 0x0d, 0xb0, 0x12, 0x38, 0x46, 0x1c, 0x88, 0x58,
-	0x09, 0x48, 0xd8, 0x79, 0xbf, 0xcb, 0x32, 0x67, 0x7c, 0x5a, 0x8d, 0xb0, 0xe2, 0x94, 0x2d, 0x67,
-	0x3c, 0x93, 0xda, 0x24, 0x38, 0x93, 0x77, 0x4c, 0xe2, 0x3c, 0x71, 0x26, 0xdf, 0x47, 0xcf, 0x09,
-	0x4d, 0x5e, 0xc3, 0x9f, 0x8a, 0x42, 0xd6, 0x7f, 0x44, 0xe7, 0xbc, 0x48, 0x41, 0x68, 0xf2, 0x1b,
-	0xd5, 0x23, 0x89, 0xd0, 0x14, 0xd6, 0x70, 0xbe, 0x3e, 0xef, 0x95, 0xe1, 0x0d, 0x2f, 0xcc, 0x38,
-	0x79, 0x35, 0x63, 0x49, 0xaa, 0x47, 0xc3, 0x8f, 0x02, 0xb6, 0x09, 0x1d, 0xe2, 0xeb, 0xf3, 0x7d,
-	0x75, 0x9d, 0xef, 0xf5, 0x87, 0x4b, 0x08, 0x1e, 0x11, 0x74, 0xd8, 0x27, 0x1e, 0x11, 0x74, 0x6b,
-	0xd9, 0x9d, 0xbb, 0x65, 0x15, 0xb7, 0x54, 0xc4, 0xae, 0x98, 0xc0, 0xf3, 0x42, 0xc7, 0x26, 0x00,
-	0x89, 0x9d, 0x7b, 0x50, 0xc1, 0xa6, 0x06, 0x16, 0xdb, 0x4f, 0x32, 0x96, 0x26, 0x3f, 0x83, 0x69,
-	0xbd, 0x63, 0xa7, 0x21, 0x88, 0xd4, 0x00, 0x27, 0x31, 0x57, 0x07, 0x5c, 0x8e, 0x93, 0x2a, 0xf4,
-	0xaf, 0x07, 0xda, 0x4d, 0x11, 0xdd, 0xae, 0x1c, 0xd2, 0xf9, 0x46, 0x2b, 0x6c, 0xd6, 0x9d, 0x3c,
-	0x1f, 0x55, 0xab, 0xea, 0x19, 0x8f, 0x79, 0x92, 0xcb, 0xc1, 0x8b, 0x70, 0x5b, 0x01, 0x9c, 0xb8,
-	0x68, 0xd1, 0x43, 0xcd, 0x79, 0x7c, 0x5f, 0xc5, 0x92, 0x51, 0xfd, 0xd7, 0xe5, 0xce, 0x4b, 0x5e,
-	0xe8, 0x44, 0xe3, 0x80, 0x4b, 0x30, 0x3b, 0x1d, 0x6e, 0xe8, 0x80, 0x55, 0x45, 0x89, 0xd9, 0x19,
-	0xd6, 0xb0, 0x87, 0x7d, 0x0e, 0x77, 0xc6, 0x4b, 0x91, 0x2e, 0xb8, 0xba, 0x6f, 0xb8, 0x49, 0x1a,
-	0x73, 0x28, 0xe2, 0xb0, 0x8f, 0xa6, 0x6d, 0xb6, 0xd6, 0x76, 0xbb, 0x93, 0x2d, 0x0f, 0xe1, 0x95,
-	0x09, 0xc4, 0x92, 0xc2, 0x88, 0x6c, 0x2d, 0x80, 0x3b, 0x87, 0xe1, 0x85, 0x60, 0x93, 0x98, 0x95,
-	0xf2, 0x94, 0x2d, 0x53, 0xc1, 0x26, 0x6a, 0x5d, 0x87, 0x87, 0xe1, 0x0d, 0x33, 0x74, 0x21, 0xea,
-	0x30, 0x9c, 0x82, 0xdd, 0xec, 0x4c, 0xfd, 0xd1, 0x3c, 0x7d, 0x97, 0x13, 0x66, 0x67, 0xaa, 0xbc,
-	0xf0, 0x1e, 0xe7, 0xc3, 0x30, 0x64, 0xdf, 0x41, 0xab, 0x45, 0x2a, 0x0d, 0xb9, 0x87, 0xe9, 0x78,
-	0x09, 0xc8, 0xfd, 0x00, 0x61, 0xbf, 0x4b, 0x51, 0xff, 0xde, 0xfc, 0xdd, 0x17, 0xa9, 0xbf, 0x64,
-	0xbd, 0x89, 0xe9, 0xba, 0xd0, 0xd0, 0xfd, 0xc0, 0xdd, 0x56, 0x4f, 0xda, 0xa6, 0x99, 0xbb, 0x57,
-	0x4c, 0xee, 0x4c, 0x26, 0xc7, 0xbc, 0x44, 0x5e, 0x28, 0xaf, 0x84, 0x43, 0x2b, 0x25, 0xd2, 0xcc,
-	0x36, 0x65, 0x07, 0x7a, 0x25, 0x7b, 0x35, 0x49, 0xa4, 0x96, 0x35, 0x37, 0xa4, 0x37, 0xdb, 0x06,
-	0xda, 0x14, 0x51, 0x2b, 0x9a, 0xb6, 0xb1, 0xbc, 0x62, 0xc6, 0x62, 0x3a, 0x4d, 0xb9, 0x86, 0xce,
-	0x38, 0xab, 0x3f, 0xe4, 0xb7, 0xdd, 0xb6, 0x85, 0x82, 0x44, 0x2c, 0x0f, 0x2a, 0xd8, 0x34, 0xb2,
-	0xc2, 0xea, 0x47, 0x52, 0x4d, 0xc3, 0xae, 0xb5, 0xcd, 0x78, 0x00, 0x91, 0x46, 0xa2, 0xa0, 0x7d,
-	0xef, 0xad, 0x12, 0x1f, 0xf0, 0xa6, 0x25, 0xe0, 0x27, 0x88, 0x94, 0xb2, 0x23, 0x26, 0xde, 0x7b,
-	0x43, 0x30, 0xbb, 0x4f, 0x00, 0x1e, 0x5e, 0x2e, 0x0f, 0x27, 0x70, 0x9f, 0x00, 0xf5, 0x15, 0x43,
-	0xec, 0x13, 0x28, 0xd6, 0xef, 0x3a, 0x73, 0xee, 0x75, 0xc4, 0x4a, 0x5b, 0x39, 0xa4, 0xeb, 0x50,
-	0x30, 0xd4, 0x75, 0x94, 0x82, 0xdf, 0xa4, 0xee, 0xd1, 0x1a, 0xd2, 0xa4, 0xd8, 0xb9, 0xda, 0x6a,
-	0x17, 0x66, 0xe3, 0x92, 0xd9, 0x4f, 0xaa, 0x2b, 0x4b, 0xf8, 0x17, 0xfc, 0x6b, 0x21, 0x11, 0x97,
-	0x5a, 0x50, 0x6d, 0xfb, 0xe5, 0xfd, 0xff, 0xfc, 0xe6, 0xce, 0xca, 0x2f, 0xbe, 0xb9, 0xb3, 0xf2,
-	0xdf, 0xdf, 0xdc, 0x59, 0xf9, 0xf9, 0xb7, 0x77, 0xde, 0xfb, 0xc5, 0xb7, 0x77, 0xde, 0xfb, 0xaf,
-	0x6f, 0xef, 0xbc, 0xf7, 0xf5, 0xfb, 0xfa, 0x8f, 0xa9, 0x5e, 0xfc, 0x3f, 0xf5, 0x27, 0x51, 0x9f,
-	0xff, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x39, 0xd6, 0xda, 0x85, 0x70, 0x75, 0x00, 0x00,
+	0x56, 0x80, 0xd7, 0x2f, 0x0c, 0x9b, 0xcb, 0x0e, 0x50, 0xb3, 0x33, 0xcc, 0x0e, 0xbb, 0x7d, 0x9b,
+	0xbe, 0xbb, 0x9d, 0xee, 0xcb, 0xf4, 0xcc, 0x6a, 0x17, 0x09, 0xb9, 0xed, 0x6e, 0x8f, 0xd9, 0xb6,
+	0xdb, 0x54, 0x95, 0xbb, 0xa5, 0x91, 0x90, 0x48, 0x67, 0x85, 0xcb, 0x89, 0xb3, 0x32, 0x73, 0x33,
+	0xb3, 0xaa, 0xbb, 0x16, 0x81, 0x40, 0x20, 0x10, 0x08, 0xc4, 0x8a, 0xdb, 0x2b, 0x12, 0x3f, 0x81,
+	0x5f, 0xc1, 0xe3, 0x3e, 0xf2, 0x88, 0x66, 0xfe, 0x02, 0x3f, 0x00, 0xc5, 0x3d, 0xe2, 0xe4, 0x39,
+	0x91, 0xe9, 0x7d, 0x18, 0xf5, 0xc8, 0xe7, 0x3b, 0xe7, 0x44, 0x64, 0x44, 0x9c, 0x38, 0x71, 0xc9,
+	0xac, 0xe8, 0x6a, 0x75, 0xba, 0x5d, 0xd5, 0x65, 0x5b, 0x36, 0xdb, 0x0d, 0xab, 0x57, 0x59, 0xca,
+	0xf4, 0xbf, 0xb1, 0xf8, 0xf3, 0xe8, 0xbd, 0xa4, 0x58, 0xb7, 0xeb, 0x8a, 0x7d, 0xf2, 0xb1, 0x25,
+	0xd3, 0x72, 0xb1, 0x48, 0x8a, 0x59, 0x23, 0x91, 0x4f, 0x3e, 0xb2, 0x12, 0xb6, 0x62, 0x45, 0xab,
+	0xfe, 0xfe, 0xf8, 0xbf, 0xfe, 0x6f, 0x23, 0x7a, 0x7f, 0x37, 0xcf, 0x58, 0xd1, 0xee, 0x2a, 0x8d,
+	0xd1, 0x57, 0xd1, 0x77, 0x77, 0xaa, 0x6a, 0x9f, 0xb5, 0xaf, 0x59, 0xdd, 0x64, 0x65, 0x31, 0xfa,
+	0x34, 0x56, 0x0e, 0xe2, 0x71, 0x95, 0xc6, 0x3b, 0x55, 0x15, 0x5b, 0x61, 0x3c, 0x66, 0x3f, 0x5b,
+	0xb2, 0xa6, 0xfd, 0xe4, 0x66, 0x18, 0x6a, 0xaa, 0xb2, 0x68, 0xd8, 0xe8, 0x2c, 0xfa, 0xed, 0x9d,
+	0xaa, 0x9a, 0xb0, 0x76, 0x8f, 0xf1, 0x0a, 0x4c, 0xda, 0xa4, 0x65, 0xa3, 0x3b, 0x1d, 0x55, 0x1f,
+	0x30, 0x3e, 0xee, 0xf6, 0x83, 0xca, 0xcf, 0x34, 0xfa, 0x0e, 0xf7, 0x73, 0xbe, 0x6c, 0x67, 0xe5,
+	0xdb, 0x62, 0x74, 0xbd, 0xab, 0xa8, 0x44, 0xc6, 0xf6, 0x8d, 0x10, 0xa2, 0xac, 0xbe, 0x89, 0x7e,
+	0xe3, 0x4d, 0x92, 0xe7, 0xac, 0xdd, 0xad, 0x19, 0x2f, 0xb8, 0xaf, 0x23, 0x45, 0xb1, 0x94, 0x19,
+	0xbb, 0x9f, 0x06, 0x19, 0x65, 0xf8, 0xab, 0xe8, 0xbb, 0x52, 0x32, 0x66, 0x69, 0xb9, 0x62, 0xf5,
+	0x08, 0xd5, 0x52, 0x42, 0xe2, 0x91, 0x77, 0x20, 0x68, 0x7b, 0xb7, 0x2c, 0x56, 0xac, 0x6e, 0x71,
+	0xdb, 0x4a, 0x18, 0xb6, 0x6d, 0x21, 0x65, 0xfb, 0xef, 0x36, 0xa2, 0x1f, 0xec, 0xa4, 0x69, 0xb9,
+	0x2c, 0xda, 0x97, 0x65, 0x9a, 0xe4, 0x2f, 0xb3, 0xe2, 0xe2, 0x88, 0xbd, 0xdd, 0x3d, 0xe7, 0x7c,
+	0x31, 0x67, 0xa3, 0x27, 0xfe, 0x53, 0x95, 0x68, 0x6c, 0xd8, 0xd8, 0x85, 0x8d, 0xef, 0xcf, 0x2e,
+	0xa7, 0xa4, 0xca, 0xf2, 0x4f, 0x1b, 0xd1, 0x15, 0x58, 0x96, 0x49, 0x99, 0xaf, 0x98, 0x2d, 0xcd,
+	0xd3, 0x1e, 0xc3, 0x3e, 0x6e, 0xca, 0xf3, 0xf9, 0x65, 0xd5, 0x54, 0x89, 0xf2, 0xe8, 0x03, 0xb7,
+	0xbb, 0x4c, 0x58, 0x23, 0x86, 0xd3, 0x3d, 0xba, 0x47, 0x28, 0xc4, 0x78, 0xbe, 0x3f, 0x04, 0x55,
+	0xde, 0xb2, 0x68, 0xa4, 0xbc, 0xe5, 0x65, 0x63, 0x9c, 0xdd, 0x45, 0x2d, 0x38, 0x84, 0xf1, 0x75,
+	0x6f, 0x00, 0xa9, 0x5c, 0xfd, 0x71, 0xf4, 0x9b, 0x6f, 0xca, 0xfa, 0xa2, 0xa9, 0x92, 0x94, 0xa9,
+	0xa1, 0x70, 0xcb, 0xd7, 0xd6, 0x52, 0x38, 0x1a, 0x6e, 0xf7, 0x61, 0x4e, 0xa7, 0xd5, 0xc2, 0x57,
+	0x15, 0x83, 0x31, 0xc8, 0x2a, 0x72, 0x21, 0xd5, 0x69, 0x21, 0xa4, 0x6c, 0x5f, 0x44, 0x23, 0x6b,
+	0xfb, 0xf4, 0x4f, 0x58, 0xda, 0xee, 0xcc, 0x66, 0xb0, 0x55, 0xac, 0xae, 0x20, 0xe2, 0x9d, 0xd9,
+	0x8c, 0x6a, 0x15, 0x1c, 0x55, 0xce, 0xde, 0x46, 0x1f, 0x01, 0x67, 0x2f, 0xb3, 0x46, 0x38, 0xdc,
+	0x0a, 0x5b, 0x51, 0x98, 0x71, 0x1a, 0x0f, 0xc5, 0x95, 0xe3, 0xbf, 0xd8, 0x88, 0xbe, 0x8f, 0x78,
+	0x1e, 0xb3, 0x45, 0xb9, 0x62, 0xa3, 0x87, 0xfd, 0xd6, 0x24, 0x69, 0xfc, 0x3f, 0xba, 0x84, 0x06,
+	0xd2, 0x4d, 0x26, 0x2c, 0x67, 0x69, 0x4b, 0x76, 0x13, 0x29, 0xee, 0xed, 0x26, 0x06, 0x73, 0x46,
+	0x98, 0x16, 0xee, 0xb3, 0x76, 0x77, 0x59, 0xd7, 0xac, 0x68, 0xc9, 0xb6, 0xb4, 0x48, 0x6f, 0x5b,
+	0x7a, 0x28, 0x52, 0x9f, 0x7d, 0xd6, 0xee, 0xe4, 0x39, 0x59, 0x1f, 0x29, 0xee, 0xad, 0x8f, 0xc1,
+	0x94, 0x87, 0x34, 0xfa, 0x2d, 0xe7, 0x89, 0xb5, 0x07, 0xc5, 0x59, 0x39, 0xa2, 0x9f, 0x85, 0x90,
+	0x1b, 0x1f, 0x77, 0x7a, 0x39, 0xa4, 0x1a, 0xcf, 0xdf, 0x55, 0x65, 0x4d, 0x37, 0x8b, 0x14, 0xf7,
+	0x56, 0xc3, 0x60, 0xca, 0xc3, 0x1f, 0x45, 0xef, 0xab, 0x28, 0xa9, 0xe7, 0xb3, 0x9b, 0x68, 0x08,
+	0x85, 0x13, 0xda, 0xad, 0x1e, 0xca, 0x06, 0x07, 0x25, 0x53, 0xc1, 0xe7, 0x53, 0x54, 0x0f, 0x84,
+	0x9e, 0x9b, 0x61, 0xa8, 0x63, 0x7b, 0x8f, 0xe5, 0x8c, 0xb4, 0x2d, 0x85, 0x3d, 0xb6, 0x0d, 0xa4,
+	0x6c, 0xd7, 0xd1, 0x87, 0xe6, 0xb1, 0xf0, 0x79, 0x54, 0xc8, 0x79, 0x90, 0xde, 0x24, 0xea, 0xed,
+	0x42, 0xc6, 0xd7, 0x83, 0x61, 0x70, 0xa7, 0x3e, 0x6a, 0x04, 0xe2, 0xf5, 0x01, 0xe3, 0xef, 0x66,
+	0x18, 0x52, 0xb6, 0xff, 0x7e, 0x23, 0xfa, 0xa1, 0x92, 0x3d, 0x2f, 0x92, 0xd3, 0x9c, 0x89, 0x29,
+	0xf1, 0x88, 0xb5, 0x6f, 0xcb, 0xfa, 0x62, 0xb2, 0x2e, 0x52, 0x62, 0xfa, 0xc7, 0xe1, 0x9e, 0xe9,
+	0x9f, 0x54, 0x72, 0x32, 0x3e, 0x55, 0xd1, 0xb6, 0xac, 0x60, 0xc6, 0xa7, 0x6b, 0xd0, 0x96, 0x15,
+	0x95, 0xf1, 0xf9, 0x48, 0xc7, 0xea, 0x21, 0x0f, 0x9b, 0xb8, 0xd5, 0x43, 0x37, 0x4e, 0xde, 0x08,
+	0x21, 0x36, 0x6c, 0xe9, 0x0e, 0x5c, 0x16, 0x67, 0xd9, 0xfc, 0xa4, 0x9a, 0xf1, 0x6e, 0x7c, 0x0f,
+	0xef, 0xa1, 0x0e, 0x42, 0x84, 0x2d, 0x02, 0x55, 0xde, 0xfe, 0xd1, 0x26, 0x46, 0x6a, 0x28, 0xbd,
+	0xa8, 0xcb, 0xc5, 0x4b, 0x36, 0x4f, 0xd2, 0xb5, 0x1a, 0xff, 0x9f, 0x85, 0x06, 0x1e, 0xa4, 0x4d,
+	0x21, 0x9e, 0x5e, 0x52, 0x4b, 0x95, 0xe7, 0x3f, 0x36, 0xa2, 0x9b, 0xba, 0xfa, 0xe7, 0x49, 0x31,
+	0x67, 0xaa, 0x3d, 0x65, 0xe9, 0x77, 0x8a, 0xd9, 0x98, 0x35, 0x6d, 0x52, 0xb7, 0xa3, 0x1f, 0xe3,
+	0x95, 0x0c, 0xe9, 0x98, 0xb2, 0xfd, 0xe4, 0x57, 0xd2, 0xb5, 0xad, 0x3e, 0xa9, 0x92, 0x94, 0xa9,
+	0x10, 0xe0, 0xb7, 0xba, 0x90, 0xc0, 0x00, 0x70, 0x23, 0x84, 0xd8, 0x56, 0x17, 0x82, 0x83, 0x62,
+	0x95, 0xb5, 0x6c, 0x9f, 0x15, 0xac, 0xee, 0xb6, 0xba, 0x54, 0xf5, 0x11, 0xa2, 0xd5, 0x09, 0xd4,
+	0x06, 0x1b, 0xcf, 0x9b, 0x99, 0x1c, 0x37, 0x03, 0x46, 0x3a, 0xd3, 0xe3, 0x83, 0x61, 0xb0, 0x5d,
+	0xdd, 0x39, 0x3e, 0xc7, 0x6c, 0x55, 0x5e, 0xc0, 0xd5, 0x9d, 0x6b, 0x42, 0x02, 0xc4, 0xea, 0x0e,
+	0x05, 0xed, 0x0c, 0xe6, 0xf8, 0x79, 0x9d, 0xb1, 0xb7, 0x60, 0x06, 0x73, 0x95, 0xb9, 0x98, 0x98,
+	0xc1, 0x10, 0x4c, 0x79, 0x38, 0x8a, 0xbe, 0x2d, 0x84, 0x7f, 0x50, 0x66, 0xc5, 0xe8, 0x2a, 0xa2,
+	0xc4, 0x05, 0xc6, 0xea, 0x35, 0x1a, 0x00, 0x25, 0xe6, 0x7f, 0xdd, 0x4d, 0x8a, 0x94, 0xe5, 0x68,
+	0x89, 0xad, 0x38, 0x58, 0x62, 0x0f, 0xb3, 0xa9, 0x83, 0x10, 0xf2, 0xf8, 0x35, 0x39, 0x4f, 0xea,
+	0xac, 0x98, 0x8f, 0x30, 0x5d, 0x47, 0x4e, 0xa4, 0x0e, 0x18, 0x07, 0xba, 0xb0, 0x52, 0xdc, 0xa9,
+	0xaa, 0xba, 0x5c, 0xe1, 0x5d, 0xd8, 0x47, 0x82, 0x5d, 0xb8, 0x83, 0xe2, 0xde, 0xf6, 0x58, 0x9a,
+	0x67, 0x45, 0xd0, 0x9b, 0x42, 0x86, 0x78, 0xb3, 0x28, 0xe8, 0xbc, 0x2f, 0x59, 0xb2, 0x62, 0xba,
+	0x66, 0xd8, 0x93, 0x71, 0x81, 0x60, 0xe7, 0x05, 0xa0, 0x5d, 0xa7, 0x09, 0xf1, 0x61, 0x72, 0xc1,
+	0xf8, 0x03, 0x66, 0x7c, 0x5e, 0x1b, 0x61, 0xfa, 0x1e, 0x41, 0xac, 0xd3, 0x70, 0x52, 0xb9, 0x5a,
+	0x46, 0x1f, 0x09, 0xf9, 0x71, 0x52, 0xb7, 0x59, 0x9a, 0x55, 0x49, 0xa1, 0xf3, 0x7f, 0x6c, 0x5c,
+	0x77, 0x28, 0xe3, 0x72, 0x6b, 0x20, 0xad, 0xdc, 0xfe, 0xfb, 0x46, 0x74, 0x1d, 0xfa, 0x3d, 0x66,
+	0xf5, 0x22, 0x13, 0xcb, 0xc8, 0x46, 0x06, 0xe1, 0xd1, 0x17, 0x61, 0xa3, 0x1d, 0x05, 0x53, 0x9a,
+	0x1f, 0x5d, 0x5e, 0xd1, 0x26, 0x43, 0x13, 0x95, 0x5a, 0xbf, 0xaa, 0x67, 0x9d, 0x6d, 0x96, 0x89,
+	0xce, 0x97, 0x85, 0x90, 0x48, 0x86, 0x3a, 0x10, 0x18, 0xe1, 0x27, 0x45, 0xa3, 0xad, 0x63, 0x23,
+	0xdc, 0x8a, 0x83, 0x23, 0xdc, 0xc3, 0x94, 0x87, 0x3f, 0x8c, 0x22, 0xb9, 0xd8, 0x12, 0x0b, 0x62,
+	0x3f, 0xe6, 0x48, 0x81, 0xbf, 0x1a, 0xbe, 0x1e, 0x20, 0xec, 0x44, 0x27, 0xff, 0x2e, 0xd6, 0xf9,
+	0x23, 0x54, 0x43, 0x88, 0x88, 0x89, 0x0e, 0x20, 0xb0, 0xa0, 0x93, 0xf3, 0xf2, 0x2d, 0x5e, 0x50,
+	0x2e, 0x09, 0x17, 0x54, 0x11, 0x76, 0xe7, 0x4d, 0x15, 0x14, 0xdb, 0x79, 0xd3, 0xc5, 0x08, 0xed,
+	0xbc, 0x41, 0x46, 0x19, 0x2e, 0xa3, 0xef, 0xb9, 0x86, 0x9f, 0x95, 0xe5, 0xc5, 0x22, 0xa9, 0x2f,
+	0x46, 0xf7, 0x69, 0x65, 0xcd, 0x18, 0x47, 0x9b, 0x83, 0x58, 0x1b, 0xd4, 0x5c, 0x87, 0x3c, 0x4d,
+	0x3a, 0xa9, 0x73, 0x10, 0xd4, 0x3c, 0x1b, 0x0a, 0x21, 0x82, 0x1a, 0x81, 0xda, 0x5e, 0xe9, 0x7a,
+	0x9b, 0x30, 0xb8, 0xd6, 0xf3, 0xd4, 0x27, 0x8c, 0x5a, 0xeb, 0x21, 0x18, 0xec, 0x42, 0xfb, 0x75,
+	0x52, 0x9d, 0xe3, 0x5d, 0x48, 0x88, 0xc2, 0x5d, 0x48, 0x23, 0xb0, 0xbd, 0x27, 0x2c, 0xa9, 0xd3,
+	0x73, 0xbc, 0xbd, 0xa5, 0x2c, 0xdc, 0xde, 0x86, 0x81, 0xed, 0x2d, 0x05, 0x6f, 0xb2, 0xf6, 0xfc,
+	0x90, 0xb5, 0x09, 0xde, 0xde, 0x3e, 0x13, 0x6e, 0xef, 0x0e, 0x6b, 0xf3, 0x30, 0xd7, 0xe1, 0x64,
+	0x79, 0xda, 0xa4, 0x75, 0x76, 0xca, 0x46, 0x01, 0x2b, 0x06, 0x22, 0xf2, 0x30, 0x12, 0x56, 0x3e,
+	0x7f, 0xb1, 0x11, 0x5d, 0xd5, 0xcd, 0x5e, 0x36, 0x8d, 0x8a, 0x79, 0xbe, 0xfb, 0xa7, 0x78, 0xfb,
+	0x12, 0x38, 0xb1, 0x17, 0x3a, 0x40, 0xcd, 0x99, 0x13, 0xf0, 0x22, 0x9d, 0x14, 0x8d, 0x29, 0xd4,
+	0x17, 0x43, 0xac, 0x3b, 0x0a, 0xc4, 0x9c, 0x30, 0x48, 0xd1, 0x59, 0x1d, 0xe1, 0x05, 0x33, 0x7d,
+	0xe3, 0xb3, 0x21, 0xc6, 0x3b, 0xbd, 0xe4, 0xe9, 0x25, 0xb5, 0x6c, 0x7a, 0xa0, 0xfa, 0x8b, 0x2e,
+	0xeb, 0xc1, 0xac, 0x01, 0xe9, 0x81, 0x6e, 0x7f, 0x87, 0x20, 0xd2, 0x03, 0x9c, 0x84, 0x5d, 0x73,
+	0xbf, 0x2e, 0x97, 0x55, 0xd3, 0xd3, 0x35, 0x01, 0x14, 0xee, 0x9a, 0x5d, 0x58, 0xf9, 0x7c, 0x17,
+	0xfd, 0x8e, 0x3b, 0x1c, 0xdc, 0xc6, 0xdf, 0xa2, 0xfb, 0x38, 0xd6, 0xe4, 0xf1, 0x50, 0xdc, 0x26,
+	0xc8, 0xda, 0x73, 0xbb, 0xc7, 0xda, 0x24, 0xcb, 0x9b, 0xd1, 0x6d, 0xdc, 0x86, 0x96, 0x13, 0x09,
+	0x32, 0xc6, 0xc1, 0x78, 0xbb, 0xb7, 0xac, 0xf2, 0x2c, 0xed, 0xee, 0x8c, 0x2b, 0x5d, 0x23, 0x0e,
+	0xc7, 0x5b, 0x17, 0x83, 0xf3, 0x07, 0x4f, 0x41, 0xc4, 0xff, 0x4c, 0xd7, 0x15, 0xc3, 0xe7, 0x0f,
+	0x0f, 0x09, 0xcf, 0x1f, 0x10, 0x85, 0xf5, 0x99, 0xb0, 0xf6, 0x65, 0xb2, 0x2e, 0x97, 0xc4, 0xfc,
+	0x61, 0xc4, 0xe1, 0xfa, 0xb8, 0x98, 0xcd, 0x51, 0x8d, 0x87, 0x83, 0xa2, 0x65, 0x75, 0x91, 0xe4,
+	0x2f, 0xf2, 0x64, 0xde, 0x8c, 0x88, 0x98, 0xe7, 0x53, 0x44, 0x8e, 0x4a, 0xd3, 0xc8, 0x63, 0x3c,
+	0x68, 0x5e, 0x24, 0xab, 0xb2, 0xce, 0x5a, 0xfa, 0x31, 0x5a, 0xa4, 0xf7, 0x31, 0x7a, 0x28, 0xea,
+	0x6d, 0xa7, 0x4e, 0xcf, 0xb3, 0x15, 0x9b, 0x05, 0xbc, 0x69, 0x64, 0x80, 0x37, 0x07, 0x45, 0x1a,
+	0x6d, 0x52, 0x2e, 0xeb, 0x94, 0x91, 0x8d, 0x26, 0xc5, 0xbd, 0x8d, 0x66, 0x30, 0xe5, 0xe1, 0xaf,
+	0x37, 0xa2, 0xdf, 0x95, 0x52, 0x77, 0xbb, 0x7a, 0x2f, 0x69, 0xce, 0x4f, 0xcb, 0xa4, 0x9e, 0x8d,
+	0x1e, 0x61, 0x76, 0x50, 0xd4, 0xb8, 0x7e, 0x7c, 0x19, 0x15, 0xf8, 0x58, 0xf9, 0xe9, 0x83, 0x1d,
+	0x71, 0xe8, 0x63, 0xf5, 0x90, 0xf0, 0x63, 0x85, 0x28, 0x0c, 0x20, 0x42, 0x2e, 0xb7, 0x86, 0x6e,
+	0x93, 0xfa, 0xfe, 0xfe, 0xd0, 0x9d, 0x5e, 0x0e, 0xc6, 0x47, 0x2e, 0xf4, 0x7b, 0xcb, 0x16, 0x65,
+	0x03, 0xef, 0x31, 0xf1, 0x50, 0x9c, 0xf4, 0x6c, 0x46, 0x45, 0xd8, 0x73, 0x67, 0x64, 0xc4, 0x43,
+	0x71, 0xc2, 0xb3, 0x13, 0xd6, 0x42, 0x9e, 0x91, 0xd0, 0x16, 0x0f, 0xc5, 0x61, 0x36, 0xa8, 0x18,
+	0x3d, 0x2f, 0xdc, 0x0f, 0xd8, 0x81, 0x73, 0xc3, 0xe6, 0x20, 0x56, 0x39, 0xfc, 0xdb, 0x8d, 0xe8,
+	0x07, 0xd6, 0xe3, 0x61, 0x39, 0xcb, 0xce, 0xd6, 0x12, 0x7a, 0x9d, 0xe4, 0x4b, 0xd6, 0x8c, 0x1e,
+	0x53, 0xd6, 0xba, 0xac, 0x29, 0xc1, 0x93, 0x4b, 0xe9, 0xc0, 0xb1, 0xb3, 0x53, 0x55, 0xf9, 0x7a,
+	0xca, 0x16, 0x55, 0x4e, 0x8e, 0x1d, 0x0f, 0x09, 0x8f, 0x1d, 0x88, 0xc2, 0x55, 0xc2, 0xb4, 0xe4,
+	0x6b, 0x10, 0x74, 0x95, 0x20, 0x44, 0xe1, 0x55, 0x82, 0x46, 0x60, 0xae, 0x34, 0x2d, 0x77, 0xcb,
+	0x3c, 0x67, 0x69, 0xdb, 0x3d, 0xf2, 0x36, 0x9a, 0x96, 0x08, 0xe7, 0x4a, 0x80, 0xb4, 0xbb, 0x43,
+	0x7a, 0x4d, 0x9b, 0xd4, 0xec, 0xd9, 0x9a, 0x1f, 0xfc, 0x8f, 0xf0, 0xb4, 0xc0, 0x02, 0xc4, 0xee,
+	0x10, 0x0a, 0xc2, 0xb5, 0xf3, 0x49, 0x31, 0x2b, 0xf1, 0xb5, 0x33, 0x97, 0x84, 0xd7, 0xce, 0x8a,
+	0x80, 0x26, 0xc7, 0x8c, 0x32, 0x39, 0x66, 0x7d, 0x26, 0xc7, 0xcc, 0x35, 0xe9, 0x85, 0x42, 0x75,
+	0x86, 0x40, 0x86, 0x42, 0x70, 0x6a, 0x70, 0xa7, 0x97, 0x83, 0x3d, 0x54, 0x2f, 0xa2, 0x5f, 0xb0,
+	0x36, 0x3d, 0xc7, 0x7b, 0xa8, 0x87, 0x84, 0x7b, 0x28, 0x44, 0x61, 0x95, 0xa6, 0xa5, 0x26, 0xf0,
+	0x2a, 0x59, 0x79, 0xb8, 0x4a, 0x1e, 0x07, 0x97, 0xb5, 0x07, 0x0b, 0xf1, 0xcc, 0xd0, 0x4e, 0x2e,
+	0x65, 0xe1, 0x65, 0xad, 0x61, 0x60, 0xe9, 0xa5, 0x80, 0x3f, 0x4e, 0xbc, 0xf4, 0x56, 0x1e, 0x2e,
+	0xbd, 0xc7, 0x29, 0x27, 0xff, 0x6a, 0x96, 0x95, 0x52, 0x7a, 0x54, 0xf2, 0x31, 0xf2, 0x3a, 0xc9,
+	0xb3, 0x59, 0xd2, 0xb2, 0x69, 0x79, 0xc1, 0x0a, 0x7c, 0x05, 0xa7, 0x4a, 0x2b, 0xf9, 0xd8, 0x53,
+	0x08, 0xaf, 0xe0, 0xc2, 0x8a, 0xb0, 0x9f, 0x48, 0xfa, 0xa4, 0x61, 0xbb, 0x49, 0x43, 0x44, 0x32,
+	0x0f, 0x09, 0xf7, 0x13, 0x88, 0xc2, 0x7c, 0x55, 0xca, 0x9f, 0xbf, 0xab, 0x58, 0x9d, 0xb1, 0x22,
+	0x65, 0x78, 0xbe, 0x0a, 0xa9, 0x70, 0xbe, 0x8a, 0xd0, 0x70, 0xad, 0xb6, 0x97, 0xb4, 0xec, 0xd9,
+	0x7a, 0x9a, 0x2d, 0x58, 0xd3, 0x26, 0x8b, 0x0a, 0x5f, 0xab, 0x01, 0x28, 0xbc, 0x56, 0xeb, 0xc2,
+	0x36, 0xe6, 0x3d, 0x4b, 0xd2, 0x8b, 0x65, 0xc5, 0x33, 0x40, 0xd6, 0xb6, 0x59, 0x31, 0x6f, 0x40,
+	0xcc, 0x93, 0xf2, 0xd8, 0x01, 0x88, 0x98, 0x87, 0x82, 0xd0, 0xcf, 0x7e, 0x9f, 0x9f, 0xfd, 0xa1,
+	0x7e, 0xf6, 0x31, 0x3f, 0x47, 0xd1, 0xb7, 0xa5, 0x78, 0xbc, 0x84, 0x87, 0x3a, 0x4a, 0x6d, 0xbc,
+	0xa4, 0x0e, 0x75, 0x3c, 0xc0, 0x6e, 0x27, 0x2b, 0x7b, 0xac, 0x69, 0xcb, 0x1a, 0xde, 0x15, 0xd0,
+	0x2a, 0x52, 0x48, 0x6c, 0x27, 0x77, 0xa0, 0xce, 0x36, 0xa1, 0x99, 0x8c, 0xba, 0xb7, 0x94, 0x20,
+	0x11, 0xb8, 0xa5, 0x44, 0xa0, 0xb0, 0x53, 0x5b, 0x00, 0x3d, 0x28, 0xe8, 0x58, 0x09, 0x1e, 0x14,
+	0xd0, 0x74, 0x67, 0xf3, 0xd5, 0x30, 0x13, 0x1e, 0x16, 0x7b, 0x8a, 0x3e, 0x71, 0xc3, 0xe3, 0xe6,
+	0x20, 0x16, 0xdf, 0xed, 0x1d, 0xb3, 0x3c, 0xe1, 0x54, 0x68, 0xb7, 0x57, 0x33, 0x43, 0x76, 0x7b,
+	0x1d, 0x56, 0x39, 0xfc, 0xcb, 0x8d, 0xe8, 0x13, 0xcc, 0xe3, 0xab, 0x4a, 0xf8, 0x7d, 0xd8, 0x6f,
+	0xeb, 0x55, 0xe5, 0x79, 0x7f, 0x74, 0x09, 0x0d, 0x55, 0x86, 0x3f, 0x8d, 0x3e, 0xd6, 0x22, 0x7b,
+	0x4b, 0x4b, 0x15, 0xc0, 0x4f, 0x98, 0x4d, 0xf9, 0x21, 0x67, 0xdc, 0x6f, 0x0f, 0xe6, 0xed, 0x5a,
+	0xd4, 0x2f, 0x57, 0x03, 0xd6, 0xa2, 0xc6, 0x86, 0x12, 0x13, 0x6b, 0x51, 0x04, 0xb3, 0x91, 0xd1,
+	0xad, 0x1e, 0xdf, 0x51, 0x13, 0xb9, 0x2e, 0x88, 0x8c, 0x5e, 0x59, 0x0d, 0x44, 0x44, 0x46, 0x12,
+	0x86, 0xd9, 0xa0, 0x06, 0xf9, 0xd8, 0xc4, 0xe6, 0x51, 0x63, 0xc8, 0x1d, 0x99, 0x77, 0xfb, 0x41,
+	0xd8, 0x5f, 0xb5, 0x58, 0x2d, 0x3b, 0xef, 0x87, 0x2c, 0x80, 0xa5, 0xe7, 0xe6, 0x20, 0x56, 0x39,
+	0xfc, 0xf3, 0xe8, 0xfb, 0x9d, 0x8a, 0xbd, 0x60, 0x49, 0xbb, 0xac, 0xd9, 0x6c, 0xb4, 0xdd, 0x53,
+	0x6e, 0x0d, 0x1a, 0xd7, 0x0f, 0x87, 0x2b, 0x74, 0xd6, 0x47, 0x9a, 0x93, 0xdd, 0xca, 0x94, 0xe1,
+	0x71, 0xc8, 0xa4, 0xcf, 0x06, 0xd7, 0x47, 0xb4, 0x4e, 0x67, 0x8b, 0xc3, 0xed, 0x5d, 0x3b, 0xab,
+	0x24, 0xcb, 0xc5, 0x81, 0xed, 0xa3, 0x90, 0x51, 0x0f, 0x0d, 0x6e, 0x71, 0x90, 0x2a, 0x9d, 0xc8,
+	0x2c, 0xc6, 0xb8, 0xb3, 0x34, 0x7e, 0x40, 0x47, 0x02, 0x64, 0x65, 0xbc, 0x35, 0x90, 0x56, 0x6e,
+	0xdb, 0xe8, 0x43, 0xfb, 0x67, 0xb7, 0x93, 0x63, 0x5e, 0x95, 0x2a, 0xd2, 0xd3, 0xb7, 0x06, 0xd2,
+	0xca, 0xeb, 0x9f, 0x45, 0x1f, 0x77, 0xbd, 0xaa, 0x89, 0x68, 0xbb, 0xd7, 0x14, 0x98, 0x8b, 0x1e,
+	0x0e, 0x57, 0xb0, 0xcb, 0xc9, 0x2f, 0x33, 0x3e, 0x0f, 0xaf, 0xf9, 0xe1, 0xa3, 0x7e, 0xfb, 0xc1,
+	0x1f, 0xad, 0x0a, 0x88, 0x1d, 0x82, 0x58, 0x4e, 0xe2, 0x64, 0xc7, 0x95, 0x7d, 0x4b, 0xa2, 0x21,
+	0x5c, 0x39, 0x44, 0x8f, 0x2b, 0x9f, 0xb4, 0xb1, 0x4a, 0xd7, 0xca, 0x88, 0x41, 0xac, 0x32, 0x45,
+	0xed, 0xbe, 0xd6, 0x71, 0xb7, 0x1f, 0xb4, 0x19, 0x8b, 0x12, 0xef, 0x65, 0x67, 0x67, 0xa6, 0x4e,
+	0x78, 0x49, 0x5d, 0x84, 0xc8, 0x58, 0x08, 0xd4, 0x2e, 0x78, 0x5e, 0x64, 0x39, 0x13, 0x47, 0x29,
+	0xaf, 0xce, 0xce, 0xf2, 0x32, 0x99, 0x81, 0x05, 0x0f, 0x17, 0xc7, 0xae, 0x9c, 0x58, 0xf0, 0x60,
+	0x9c, 0x4d, 0xf0, 0xb8, 0x74, 0xcc, 0xd2, 0xb2, 0x48, 0xb3, 0x1c, 0x26, 0x78, 0x42, 0xd3, 0x08,
+	0x89, 0x04, 0xaf, 0x03, 0xd9, 0x89, 0x91, 0x8b, 0xf8, 0xb0, 0xd7, 0xe5, 0xbf, 0xd5, 0x55, 0x74,
+	0xc4, 0xc4, 0xc4, 0x88, 0x60, 0x76, 0xdd, 0xcf, 0x85, 0x27, 0x95, 0x30, 0x7e, 0xad, 0xab, 0x75,
+	0x52, 0x79, 0x76, 0xaf, 0x07, 0x08, 0xbb, 0x7e, 0xe5, 0x7f, 0xdf, 0x2b, 0xdf, 0x16, 0xc2, 0xe8,
+	0x8d, 0xae, 0x8a, 0x96, 0x11, 0xeb, 0x57, 0xc8, 0x28, 0xc3, 0x3f, 0x8d, 0x7e, 0x5d, 0x18, 0xae,
+	0xcb, 0x6a, 0x74, 0x05, 0x51, 0xa8, 0x9d, 0x7b, 0x9b, 0x57, 0x49, 0xb9, 0xbd, 0x7e, 0x6c, 0xfa,
+	0xc6, 0x49, 0x93, 0xcc, 0xd9, 0xe8, 0x26, 0xd1, 0xe2, 0x42, 0x4a, 0x5c, 0x3f, 0xee, 0x52, 0x7e,
+	0xaf, 0x38, 0x2a, 0x67, 0xca, 0x3a, 0x52, 0x43, 0x23, 0x0c, 0xf5, 0x0a, 0x17, 0xb2, 0xc9, 0xcc,
+	0x51, 0xb2, 0xca, 0xe6, 0x66, 0xc2, 0x91, 0x71, 0xab, 0x01, 0xc9, 0x8c, 0x65, 0x62, 0x07, 0x22,
+	0x92, 0x19, 0x12, 0x56, 0x3e, 0xff, 0x65, 0x23, 0xba, 0x66, 0x99, 0x7d, 0xbd, 0x53, 0xca, 0x2f,
+	0x8d, 0xf3, 0xd4, 0x87, 0xef, 0x4f, 0x35, 0xa3, 0xcf, 0x29, 0x93, 0x38, 0x6f, 0x8a, 0xf2, 0xc5,
+	0xa5, 0xf5, 0x9c, 0xf9, 0xd7, 0x2b, 0xd5, 0xb3, 0xbc, 0x4c, 0x2f, 0xf8, 0x72, 0x29, 0x17, 0x05,
+	0x7a, 0x14, 0x30, 0xec, 0xa3, 0xc4, 0xfc, 0xdb, 0xa3, 0x62, 0x93, 0x67, 0xbd, 0x9b, 0x69, 0xaf,
+	0x58, 0xc8, 0x82, 0x83, 0xe4, 0x59, 0x63, 0x31, 0xe4, 0x88, 0xe4, 0x39, 0xc4, 0xdb, 0x9e, 0x66,
+	0x9c, 0xe7, 0x65, 0x01, 0x7b, 0x9a, 0xb5, 0xc0, 0x85, 0x44, 0x4f, 0xeb, 0x40, 0x76, 0x5a, 0xd0,
+	0x22, 0xb9, 0xf1, 0xc6, 0x5f, 0x67, 0xb8, 0x83, 0xab, 0x1a, 0x80, 0x98, 0x16, 0x50, 0x50, 0xf9,
+	0x19, 0x47, 0xdf, 0xe1, 0x2d, 0x7b, 0x5c, 0xb3, 0x15, 0xbf, 0xa7, 0xe9, 0x87, 0x21, 0x47, 0x42,
+	0x84, 0x21, 0x9f, 0xb0, 0x03, 0xfc, 0xa4, 0x68, 0xaa, 0x3c, 0x69, 0xce, 0xd5, 0xfd, 0x10, 0xbf,
+	0xce, 0x5a, 0x08, 0x6f, 0x88, 0xdc, 0xea, 0xa1, 0xec, 0xdc, 0xa2, 0x65, 0x26, 0xd2, 0xdd, 0xc6,
+	0x55, 0x3b, 0xd1, 0xee, 0x4e, 0x2f, 0x67, 0x0f, 0x3d, 0xf6, 0x93, 0x3c, 0x67, 0xf5, 0x5a, 0xcb,
+	0x0e, 0x93, 0x22, 0x3b, 0x63, 0x4d, 0x0b, 0x0e, 0x3d, 0x14, 0x15, 0x43, 0x8c, 0x38, 0xf4, 0x08,
+	0xe0, 0x76, 0x51, 0x01, 0x3c, 0x1f, 0x14, 0x33, 0xf6, 0x0e, 0x2c, 0x2a, 0xa0, 0x1d, 0xc1, 0x10,
+	0x8b, 0x0a, 0x8a, 0xb5, 0x9b, 0xff, 0x62, 0x78, 0xa9, 0x99, 0xc8, 0x6f, 0x60, 0x21, 0x81, 0x53,
+	0xd1, 0x8d, 0x10, 0x62, 0xe7, 0x22, 0x21, 0x18, 0xb3, 0x2a, 0x4f, 0x52, 0x78, 0x25, 0x4c, 0xea,
+	0x28, 0x19, 0x31, 0x17, 0x41, 0x06, 0x14, 0x57, 0x5d, 0x35, 0xc3, 0x8a, 0x0b, 0x6e, 0x9a, 0xdd,
+	0x08, 0x21, 0x76, 0x36, 0x16, 0x82, 0x49, 0x95, 0x67, 0x2d, 0x18, 0x06, 0x52, 0x43, 0x48, 0x88,
+	0x61, 0xe0, 0x13, 0xc0, 0xe4, 0x21, 0xab, 0xe7, 0x0c, 0x35, 0x29, 0x24, 0x41, 0x93, 0x9a, 0x70,
+	0xb6, 0xc8, 0x44, 0xdd, 0xcb, 0x6a, 0x0d, 0xb7, 0xc8, 0x64, 0xb5, 0xca, 0x6a, 0x4d, 0x6d, 0x91,
+	0xb9, 0x00, 0x28, 0xe2, 0x71, 0xd2, 0xb4, 0x78, 0x11, 0x85, 0x24, 0x58, 0x44, 0x4d, 0xd8, 0x54,
+	0x41, 0x16, 0x71, 0xd9, 0x82, 0x54, 0x41, 0x15, 0xc0, 0xb9, 0x84, 0x70, 0x95, 0x94, 0xdb, 0x48,
+	0x22, 0x5b, 0x85, 0xb5, 0x2f, 0x32, 0x96, 0xcf, 0x1a, 0x10, 0x49, 0xd4, 0x73, 0xd7, 0x52, 0x22,
+	0x92, 0x74, 0x29, 0xd0, 0x95, 0xd4, 0x11, 0x09, 0x56, 0x3b, 0x70, 0x3a, 0x72, 0x23, 0x84, 0xd8,
+	0xf8, 0xa4, 0x0b, 0xbd, 0x9b, 0xd4, 0x75, 0xc6, 0x73, 0x90, 0xdb, 0x78, 0x81, 0xb4, 0x9c, 0x88,
+	0x4f, 0x18, 0x07, 0x86, 0x97, 0x0e, 0xdc, 0x58, 0xc1, 0x60, 0xe8, 0xfe, 0x34, 0xc8, 0xd8, 0xc4,
+	0x57, 0x48, 0x9c, 0x53, 0x74, 0xec, 0x69, 0x22, 0x87, 0xe8, 0xb7, 0xfb, 0x30, 0xe7, 0xbd, 0x24,
+	0xe3, 0x82, 0xbf, 0x79, 0x33, 0x2d, 0x9f, 0xbf, 0xcb, 0x1a, 0xbe, 0x1b, 0xac, 0x66, 0xee, 0x27,
+	0x84, 0x25, 0x0c, 0x26, 0xde, 0x4b, 0xea, 0x55, 0xb2, 0x09, 0x04, 0x28, 0xcb, 0x11, 0x7b, 0x8b,
+	0x26, 0x10, 0xd0, 0xa2, 0xe1, 0x88, 0x04, 0x22, 0xc4, 0xdb, 0xed, 0x1c, 0xe3, 0x5c, 0xbd, 0xbc,
+	0x3d, 0x2d, 0x75, 0x4a, 0x49, 0x59, 0x83, 0x20, 0xb1, 0xa2, 0x0e, 0x2a, 0xd8, 0x65, 0xae, 0xf1,
+	0x6f, 0x87, 0xd8, 0x5d, 0xc2, 0x4e, 0x77, 0x98, 0xdd, 0x1b, 0x40, 0x22, 0xae, 0xec, 0x55, 0x10,
+	0xca, 0x55, 0xf7, 0x26, 0xc8, 0xbd, 0x01, 0xa4, 0x93, 0x9a, 0xba, 0xd5, 0xe2, 0x69, 0xe3, 0xbc,
+	0x2e, 0x97, 0xc5, 0x6c, 0xb7, 0xcc, 0xcb, 0x1a, 0xa4, 0xa6, 0x5e, 0xa9, 0x01, 0x4a, 0xa4, 0xa6,
+	0x3d, 0x2a, 0xce, 0xb1, 0x89, 0x53, 0x8a, 0x9d, 0x3c, 0x9b, 0xc3, 0x85, 0xbd, 0x67, 0x48, 0x00,
+	0xd4, 0xb1, 0x09, 0x06, 0x22, 0x9d, 0x48, 0x2e, 0xfc, 0xdb, 0x2c, 0x4d, 0x72, 0xe9, 0x6f, 0x9b,
+	0x36, 0xe3, 0x81, 0xbd, 0x9d, 0x08, 0x51, 0x40, 0xea, 0x39, 0x5d, 0xd6, 0xc5, 0x41, 0xd1, 0x96,
+	0x64, 0x3d, 0x35, 0xd0, 0x5b, 0x4f, 0x07, 0x04, 0x61, 0x75, 0xca, 0xde, 0xf1, 0xd2, 0xf0, 0x7f,
+	0xb0, 0xb0, 0xca, 0xff, 0x1e, 0x2b, 0x79, 0x28, 0xac, 0x02, 0x0e, 0x54, 0x46, 0x39, 0x91, 0x1d,
+	0x26, 0xa0, 0xed, 0x77, 0x93, 0xbb, 0xfd, 0x20, 0xee, 0x67, 0xd2, 0xae, 0x73, 0x16, 0xf2, 0x23,
+	0x80, 0x21, 0x7e, 0x34, 0x68, 0x77, 0x7d, 0xbc, 0xfa, 0x9c, 0xb3, 0xf4, 0xa2, 0x73, 0xb3, 0xcd,
+	0x2f, 0xa8, 0x44, 0x88, 0x5d, 0x1f, 0x02, 0xc5, 0x9b, 0xe8, 0x20, 0x2d, 0x8b, 0x50, 0x13, 0x71,
+	0xf9, 0x90, 0x26, 0x52, 0x9c, 0x5d, 0x83, 0x1b, 0xa9, 0xea, 0x99, 0xb2, 0x99, 0x36, 0x09, 0x0b,
+	0x2e, 0x44, 0xac, 0xc1, 0x49, 0xd8, 0xe6, 0xe4, 0xd0, 0xe7, 0x61, 0xf7, 0x35, 0x84, 0x8e, 0x95,
+	0x43, 0xfa, 0x35, 0x04, 0x8a, 0xa5, 0x2b, 0x29, 0xfb, 0x48, 0x8f, 0x15, 0xbf, 0x9f, 0x3c, 0x18,
+	0x06, 0xdb, 0x25, 0x8f, 0xe7, 0x73, 0x37, 0x67, 0x49, 0x2d, 0xbd, 0x6e, 0x05, 0x0c, 0x59, 0x8c,
+	0x58, 0xf2, 0x04, 0x70, 0x10, 0xc2, 0x3c, 0xcf, 0xbb, 0x65, 0xd1, 0xb2, 0xa2, 0xc5, 0x42, 0x98,
+	0x6f, 0x4c, 0x81, 0xa1, 0x10, 0x46, 0x29, 0x80, 0x7e, 0x2b, 0xb6, 0xa5, 0x58, 0x7b, 0x94, 0x2c,
+	0xd0, 0x8c, 0x4d, 0x6e, 0x39, 0x49, 0x79, 0xa8, 0xdf, 0x02, 0xce, 0x39, 0x6b, 0x74, 0xbd, 0x4c,
+	0x93, 0x7a, 0x6e, 0x36, 0x59, 0x66, 0xa3, 0x87, 0xb4, 0x1d, 0x9f, 0x24, 0xce, 0x1a, 0xc3, 0x1a,
+	0x20, 0xec, 0x1c, 0x2c, 0x92, 0xb9, 0xa9, 0x29, 0x52, 0x03, 0x21, 0xef, 0x54, 0xf5, 0x6e, 0x3f,
+	0x08, 0xfc, 0xbc, 0xce, 0x66, 0xac, 0x0c, 0xf8, 0x11, 0xf2, 0x21, 0x7e, 0x20, 0x08, 0xb2, 0x37,
+	0x5e, 0x6f, 0xb9, 0xa2, 0xdb, 0x29, 0x66, 0x6a, 0x1d, 0x1b, 0x13, 0x8f, 0x07, 0x70, 0xa1, 0xec,
+	0x8d, 0xe0, 0xc1, 0x18, 0xd5, 0xfb, 0xc4, 0xa1, 0x31, 0x6a, 0xb6, 0x81, 0x87, 0x8c, 0x51, 0x0c,
+	0x56, 0x3e, 0x7f, 0xae, 0xc6, 0xe8, 0x5e, 0xd2, 0x26, 0x3c, 0x6f, 0xe7, 0xaf, 0xc5, 0xaa, 0x85,
+	0x30, 0x52, 0x5f, 0x4d, 0xc5, 0x1c, 0x83, 0xab, 0xe2, 0xed, 0xc1, 0x7c, 0xc0, 0xb7, 0x5a, 0x21,
+	0xf4, 0xfa, 0x06, 0x4b, 0x85, 0xed, 0xc1, 0x7c, 0xc0, 0xb7, 0x7a, 0x2d, 0xbf, 0xd7, 0x37, 0x78,
+	0x37, 0x7f, 0x7b, 0x30, 0xaf, 0x7c, 0xff, 0x95, 0x1e, 0xb8, 0xae, 0x73, 0x9e, 0x87, 0xa5, 0x6d,
+	0xb6, 0x62, 0x58, 0x3a, 0xe9, 0xdb, 0x33, 0x68, 0x28, 0x9d, 0xa4, 0x55, 0x9c, 0x6f, 0x39, 0x61,
+	0xa5, 0x38, 0x2e, 0x9b, 0x4c, 0xdc, 0x15, 0x78, 0x32, 0xc0, 0xa8, 0x86, 0x43, 0x8b, 0xa6, 0x90,
+	0x92, 0x3d, 0xf5, 0xf4, 0x50, 0x7b, 0x91, 0xfd, 0x41, 0xc0, 0x5e, 0xf7, 0x3e, 0xfb, 0xd6, 0x40,
+	0xda, 0x9e, 0x3f, 0x7a, 0x8c, 0x7b, 0xf0, 0x19, 0x6a, 0x55, 0xf4, 0xec, 0xf3, 0xe1, 0x70, 0x05,
+	0xe5, 0xfe, 0x6f, 0xf4, 0xba, 0x02, 0xfa, 0x57, 0x83, 0xe0, 0xf1, 0x10, 0x8b, 0x60, 0x20, 0x3c,
+	0xb9, 0x94, 0x8e, 0x2a, 0xc8, 0x3f, 0xe8, 0x05, 0xb4, 0x46, 0xc5, 0xeb, 0x3c, 0xe2, 0x75, 0x54,
+	0x35, 0x26, 0x42, 0xcd, 0x6a, 0x61, 0x38, 0x32, 0x9e, 0x5e, 0x52, 0xcb, 0xf9, 0xb2, 0x97, 0x07,
+	0xab, 0xd7, 0x60, 0x9d, 0xf2, 0x84, 0x2c, 0x3b, 0x34, 0x2c, 0xd0, 0xe7, 0x97, 0x55, 0xa3, 0xc6,
+	0x8a, 0x03, 0x8b, 0x0f, 0x85, 0x3c, 0x19, 0x68, 0xd8, 0xfb, 0x74, 0xc8, 0x67, 0x97, 0x53, 0x52,
+	0x65, 0xf9, 0xcf, 0x8d, 0xe8, 0x96, 0xc7, 0xda, 0xf3, 0x04, 0xb0, 0xeb, 0xf1, 0x93, 0x80, 0x7d,
+	0x4a, 0xc9, 0x14, 0xee, 0xf7, 0x7e, 0x35, 0x65, 0xfb, 0x19, 0x2c, 0x4f, 0xe5, 0x45, 0x96, 0xb7,
+	0xac, 0xee, 0x7e, 0x06, 0xcb, 0xb7, 0x2b, 0xa9, 0x98, 0xfe, 0x0c, 0x56, 0x00, 0x77, 0x3e, 0x83,
+	0x85, 0x78, 0x46, 0x3f, 0x83, 0x85, 0x5a, 0x0b, 0x7e, 0x06, 0x2b, 0xac, 0x41, 0x85, 0x77, 0x5d,
+	0x04, 0xb9, 0x6f, 0x3d, 0xc8, 0xa2, 0xbf, 0x8d, 0xfd, 0xf8, 0x32, 0x2a, 0xc4, 0x04, 0x27, 0x39,
+	0x71, 0xdd, 0x6e, 0xc0, 0x33, 0xf5, 0xae, 0xdc, 0x6d, 0x0f, 0xe6, 0x95, 0xef, 0x9f, 0x45, 0xdf,
+	0xf3, 0x28, 0x2e, 0xe5, 0x6d, 0xbf, 0x19, 0x0a, 0xcf, 0xdc, 0x82, 0xdb, 0xf2, 0x0f, 0x86, 0xc1,
+	0x44, 0x75, 0x39, 0xa1, 0x1a, 0x3d, 0xee, 0x33, 0x04, 0x9a, 0x7c, 0x7b, 0x30, 0x4f, 0x4c, 0x23,
+	0xd2, 0xb7, 0x6c, 0xed, 0x01, 0xc6, 0xfc, 0xb6, 0x7e, 0x38, 0x5c, 0x41, 0xb9, 0x5f, 0x45, 0x1f,
+	0x7a, 0x18, 0xa7, 0xf8, 0x7f, 0xc1, 0xa1, 0x26, 0x4c, 0x4d, 0xbc, 0x66, 0x8e, 0x87, 0xe2, 0xa1,
+	0x04, 0xc2, 0x9d, 0x42, 0xfb, 0x12, 0x08, 0x74, 0x1a, 0xfd, 0xec, 0x72, 0x4a, 0xaa, 0x2c, 0xff,
+	0xbc, 0x11, 0x5d, 0x25, 0xcb, 0xa2, 0xfa, 0xc1, 0xe7, 0x43, 0x2d, 0x83, 0xfe, 0xf0, 0xc5, 0xa5,
+	0xf5, 0x54, 0xa1, 0xfe, 0x6d, 0x23, 0xba, 0x16, 0x28, 0x94, 0xec, 0x20, 0x97, 0xb0, 0xee, 0x77,
+	0x94, 0x1f, 0x5d, 0x5e, 0x91, 0x9a, 0xee, 0x5d, 0x7c, 0xd2, 0xfd, 0x3e, 0x54, 0xc0, 0xf6, 0x84,
+	0xfe, 0x3e, 0x54, 0xbf, 0x16, 0xdc, 0xe4, 0x49, 0x4e, 0xf5, 0xa2, 0x0b, 0xdd, 0xe4, 0xe1, 0x62,
+	0xb8, 0xe6, 0xb8, 0xd3, 0xcb, 0x61, 0x4e, 0x9e, 0xbf, 0xab, 0x92, 0x62, 0x46, 0x3b, 0x91, 0xf2,
+	0x7e, 0x27, 0x86, 0x83, 0x9b, 0x63, 0x5c, 0x3a, 0x2e, 0xf5, 0x42, 0xea, 0x1e, 0xa5, 0x6f, 0x90,
+	0xe0, 0xe6, 0x58, 0x07, 0x25, 0xbc, 0xa9, 0xac, 0x31, 0xe4, 0x0d, 0x24, 0x8b, 0xf7, 0x87, 0xa0,
+	0x20, 0x45, 0x37, 0xde, 0xcc, 0x9e, 0xfb, 0x83, 0x90, 0x95, 0xce, 0xbe, 0xfb, 0xd6, 0x40, 0x9a,
+	0x70, 0x3b, 0x61, 0xed, 0x97, 0x2c, 0xe1, 0x5f, 0x5b, 0x09, 0xb9, 0x35, 0xd4, 0x20, 0xb7, 0x2e,
+	0x8d, 0xb9, 0xdd, 0x2d, 0xf3, 0xe5, 0xa2, 0x50, 0x8d, 0x49, 0xba, 0x75, 0xa9, 0x7e, 0xb7, 0x80,
+	0x86, 0xdb, 0x82, 0xd6, 0xad, 0x48, 0x2f, 0xef, 0x87, 0xcd, 0x78, 0x59, 0xe5, 0xe6, 0x20, 0x96,
+	0xae, 0xa7, 0xea, 0x46, 0x3d, 0xf5, 0x04, 0x3d, 0x69, 0x6b, 0x20, 0x0d, 0xf7, 0xe7, 0x1c, 0xb7,
+	0xa6, 0x3f, 0x6d, 0xf7, 0xd8, 0xea, 0x74, 0xa9, 0x87, 0xc3, 0x15, 0xe0, 0x6e, 0xa8, 0xea, 0x55,
+	0x7c, 0x6f, 0xe4, 0x45, 0x96, 0xe7, 0xa3, 0xcd, 0x40, 0x37, 0xd1, 0x50, 0x70, 0x37, 0x14, 0x81,
+	0x89, 0x9e, 0xac, 0x77, 0x0f, 0x8b, 0x51, 0x9f, 0x1d, 0x41, 0x0d, 0xea, 0xc9, 0x2e, 0x0d, 0x76,
+	0xb4, 0x9c, 0x47, 0x6d, 0x6a, 0x1b, 0x87, 0x1f, 0x5c, 0xa7, 0xc2, 0xdb, 0x83, 0x79, 0x70, 0xdc,
+	0x2e, 0x28, 0x31, 0xb3, 0xdc, 0xa4, 0x4c, 0x78, 0x33, 0xc9, 0xad, 0x1e, 0x0a, 0x6e, 0x30, 0x8b,
+	0xba, 0xb1, 0x3c, 0x17, 0x87, 0xa3, 0xe2, 0x76, 0x04, 0xdd, 0x21, 0x5d, 0x2c, 0xb8, 0xc1, 0x8c,
+	0xe2, 0x21, 0xcf, 0xf2, 0xaa, 0x47, 0xaf, 0x67, 0xff, 0xde, 0x47, 0x3c, 0x14, 0x07, 0x3b, 0xa1,
+	0x32, 0x74, 0xbc, 0xc9, 0x66, 0x73, 0xd6, 0xa2, 0xa7, 0x63, 0x2e, 0x10, 0x3c, 0x1d, 0x03, 0x20,
+	0xe8, 0xae, 0xf2, 0xef, 0x66, 0x0b, 0xf8, 0x60, 0x86, 0x75, 0x57, 0xa5, 0xec, 0x50, 0xa1, 0xee,
+	0x8a, 0xd2, 0x20, 0x02, 0x1a, 0xb7, 0xea, 0x2b, 0x14, 0xf7, 0x43, 0x66, 0xc0, 0xa7, 0x28, 0x36,
+	0x07, 0xb1, 0x60, 0x16, 0xb5, 0x0e, 0xb3, 0x45, 0xd6, 0x62, 0xb3, 0xa8, 0x63, 0x83, 0x23, 0xa1,
+	0x59, 0xb4, 0x8b, 0x52, 0xd5, 0xe3, 0x79, 0xd1, 0xc1, 0x2c, 0x5c, 0x3d, 0xc9, 0x0c, 0xab, 0x9e,
+	0x61, 0x3b, 0x87, 0xb9, 0x85, 0xe9, 0x32, 0xed, 0xb9, 0xda, 0x20, 0x40, 0xc6, 0x33, 0xe7, 0x62,
+	0x08, 0x86, 0x22, 0x2d, 0xa5, 0x00, 0x0f, 0x29, 0x38, 0xa7, 0xcf, 0x9b, 0xab, 0x8a, 0x25, 0x75,
+	0x52, 0xa4, 0xe8, 0x82, 0x5c, 0x18, 0xec, 0x90, 0xa1, 0x05, 0x39, 0xa9, 0x01, 0xae, 0x0a, 0xf8,
+	0xef, 0x15, 0x23, 0x43, 0x41, 0x03, 0xb1, 0xff, 0x5a, 0xf1, 0xbd, 0x01, 0x24, 0xbc, 0x2a, 0xa0,
+	0x01, 0xb3, 0xd9, 0x2f, 0x9d, 0x3e, 0x0a, 0x98, 0xf2, 0xd1, 0xd0, 0xe2, 0x9f, 0x56, 0x01, 0x9d,
+	0xda, 0x24, 0xf5, 0xac, 0xfd, 0x29, 0x5b, 0x63, 0x9d, 0xda, 0xe6, 0xe4, 0x02, 0x09, 0x75, 0xea,
+	0x2e, 0x0a, 0x72, 0x6b, 0x77, 0xed, 0x77, 0x3b, 0xa0, 0xef, 0x2e, 0xf7, 0xee, 0xf4, 0x72, 0x60,
+	0xe4, 0xec, 0x65, 0x2b, 0xef, 0x6c, 0x04, 0x29, 0xe8, 0x5e, 0xb6, 0xc2, 0x8f, 0x46, 0x36, 0x07,
+	0xb1, 0xf0, 0x1a, 0x42, 0xd2, 0xb2, 0x77, 0xfa, 0x7e, 0x00, 0x52, 0x5c, 0x21, 0xef, 0x5c, 0x10,
+	0xb8, 0xdb, 0x0f, 0xda, 0x4b, 0xbf, 0xc7, 0x75, 0x99, 0xb2, 0xa6, 0x51, 0x1f, 0x0a, 0xf5, 0x6f,
+	0x55, 0x29, 0x59, 0x0c, 0x3e, 0x13, 0x7a, 0x33, 0x0c, 0xd9, 0x96, 0x51, 0x22, 0xfb, 0xb1, 0xa7,
+	0xdb, 0xa8, 0x66, 0xf7, 0x3b, 0x4f, 0x77, 0x7a, 0x39, 0x3b, 0xbc, 0x94, 0xd4, 0xfd, 0xba, 0xd3,
+	0x5d, 0x54, 0x1d, 0xfb, 0xb0, 0xd3, 0xbd, 0x01, 0xa4, 0x72, 0xf5, 0x65, 0xf4, 0xde, 0xcb, 0x72,
+	0x3e, 0x61, 0xc5, 0x6c, 0xf4, 0x43, 0x4f, 0xeb, 0x65, 0x39, 0x8f, 0xf9, 0x9f, 0x8d, 0xd1, 0x2b,
+	0x94, 0xd8, 0x5e, 0x7c, 0xdc, 0x63, 0xa7, 0xcb, 0xf9, 0xa4, 0x4d, 0x5a, 0x70, 0xf1, 0x51, 0xfc,
+	0x3d, 0xe6, 0x02, 0xe2, 0xe2, 0xa3, 0x07, 0x00, 0x7b, 0xd3, 0x9a, 0x31, 0xd4, 0x1e, 0x17, 0x04,
+	0xed, 0x29, 0xc0, 0x66, 0x4e, 0xc6, 0x1e, 0x5f, 0x9c, 0xc0, 0x8b, 0x8a, 0x56, 0x47, 0x48, 0x89,
+	0xcc, 0xa9, 0x4b, 0xd9, 0xce, 0x2d, 0xab, 0x2f, 0x3e, 0xb6, 0xb3, 0x5c, 0x2c, 0x92, 0x7a, 0x0d,
+	0x3a, 0xb7, 0xaa, 0xa5, 0x03, 0x10, 0x9d, 0x1b, 0x05, 0xed, 0xa8, 0xd5, 0x8f, 0x39, 0xbd, 0xd8,
+	0x2f, 0xeb, 0x72, 0xd9, 0x66, 0x05, 0x83, 0x1f, 0x5c, 0x31, 0x0f, 0xd4, 0x65, 0x88, 0x51, 0x4b,
+	0xb1, 0x36, 0xb3, 0x17, 0x84, 0xbc, 0x43, 0x29, 0x3e, 0x1f, 0x2e, 0xdf, 0xd5, 0xc6, 0xac, 0x40,
+	0x88, 0xc8, 0xec, 0x49, 0x18, 0xb4, 0xfd, 0x31, 0xff, 0x06, 0x2f, 0xd6, 0xf6, 0xc7, 0xee, 0xc7,
+	0x77, 0xaf, 0xd1, 0x80, 0x1d, 0x50, 0xf2, 0xa1, 0xc9, 0x01, 0xa0, 0x5e, 0xa3, 0x45, 0x1f, 0xba,
+	0x4b, 0x10, 0x03, 0x0a, 0x27, 0x81, 0xab, 0x57, 0x15, 0x2b, 0xd8, 0x4c, 0xdf, 0x14, 0xc4, 0x5c,
+	0x79, 0x44, 0xd0, 0x15, 0x24, 0x6d, 0x2c, 0x12, 0xf2, 0xf1, 0xb2, 0x38, 0xae, 0xcb, 0xb3, 0x2c,
+	0x67, 0x35, 0x88, 0x45, 0x52, 0xdd, 0x91, 0x13, 0xb1, 0x08, 0xe3, 0x6c, 0x5e, 0x2e, 0xa4, 0xde,
+	0x37, 0xf0, 0xa7, 0x75, 0x92, 0xc2, 0x15, 0x81, 0xb4, 0xd1, 0xc5, 0x88, 0xbc, 0x3c, 0x80, 0x3b,
+	0x89, 0x8e, 0x74, 0x5d, 0xac, 0x45, 0xff, 0x50, 0xaf, 0x71, 0x8a, 0x4f, 0xd2, 0x36, 0x20, 0xd1,
+	0x51, 0xe6, 0x30, 0x92, 0x48, 0x74, 0xc2, 0x1a, 0x76, 0x2a, 0x11, 0xdc, 0x91, 0xba, 0x4a, 0x05,
+	0xa6, 0x12, 0x69, 0x43, 0x0b, 0x89, 0xa9, 0xa4, 0x03, 0x81, 0x80, 0xa4, 0x87, 0xc1, 0x1c, 0x0d,
+	0x48, 0x46, 0x1a, 0x0c, 0x48, 0x2e, 0x65, 0x03, 0xc5, 0x41, 0x91, 0xb5, 0x59, 0x92, 0xf3, 0x03,
+	0xe2, 0xa4, 0x4e, 0x16, 0xac, 0x65, 0x35, 0x0c, 0x14, 0x0a, 0x89, 0x3d, 0x86, 0x08, 0x14, 0x14,
+	0xab, 0x1c, 0xfe, 0x7e, 0xf4, 0x01, 0x9f, 0xf7, 0x59, 0xa1, 0x7e, 0xed, 0xe6, 0xb9, 0xf8, 0x99,
+	0xac, 0xd1, 0x47, 0xc6, 0xc6, 0xa4, 0xad, 0x59, 0xb2, 0xd0, 0xb6, 0xdf, 0x37, 0x7f, 0x17, 0xe0,
+	0xc3, 0x0d, 0xde, 0x9f, 0xf9, 0x77, 0x4a, 0xce, 0xb2, 0xd4, 0xbc, 0xbc, 0x05, 0xfa, 0xb3, 0x2b,
+	0x8e, 0x03, 0x9f, 0x60, 0xc1, 0x38, 0x1b, 0xa7, 0x5d, 0xe9, 0x98, 0x55, 0x39, 0x8c, 0xd3, 0x9e,
+	0xb6, 0x00, 0x88, 0x38, 0x8d, 0x82, 0x76, 0x70, 0xba, 0xe2, 0x29, 0x0b, 0x57, 0x66, 0xca, 0x86,
+	0x55, 0x66, 0xea, 0xbd, 0x88, 0x92, 0x47, 0x1f, 0x1c, 0xb2, 0xc5, 0x29, 0xab, 0x9b, 0xf3, 0x4c,
+	0x7c, 0xfb, 0xa3, 0x4d, 0xda, 0x25, 0x7c, 0x63, 0xd4, 0x12, 0xb1, 0x41, 0x88, 0xac, 0x94, 0x40,
+	0xed, 0x4c, 0x60, 0x81, 0x83, 0x86, 0xdf, 0xf3, 0x11, 0x1f, 0x94, 0x01, 0x33, 0x81, 0x63, 0xc4,
+	0x81, 0x88, 0x99, 0x80, 0x84, 0x9d, 0x57, 0xeb, 0x2c, 0x33, 0x66, 0x73, 0xde, 0xc3, 0xea, 0xe3,
+	0x64, 0xbd, 0x60, 0x45, 0xab, 0x4c, 0x82, 0x73, 0x08, 0xc7, 0x24, 0xce, 0x13, 0xe7, 0x10, 0x43,
+	0xf4, 0x9c, 0xd0, 0xe4, 0x3d, 0xf8, 0xe3, 0xb2, 0x6e, 0xe5, 0x6f, 0x59, 0xf1, 0x4f, 0x11, 0x3f,
+	0x0c, 0x3c, 0x54, 0x8f, 0x24, 0x42, 0x53, 0x58, 0xc3, 0xf9, 0x11, 0x08, 0xaf, 0x0c, 0xaf, 0x59,
+	0x6d, 0xfa, 0xc9, 0xf3, 0x45, 0x92, 0xe5, 0xaa, 0x37, 0xfc, 0x38, 0x60, 0x9b, 0xd0, 0x21, 0x7e,
+	0x04, 0x62, 0xa8, 0xae, 0xf3, 0x61, 0xd8, 0x70, 0x09, 0xc1, 0xb1, 0x48, 0x8f, 0x7d, 0xe2, 0x58,
+	0xa4, 0x5f, 0xcb, 0xae, 0xdc, 0x2d, 0x2b, 0xb8, 0xb5, 0x20, 0x76, 0xcb, 0x19, 0xdc, 0x23, 0x75,
+	0x6c, 0x02, 0x90, 0x58, 0xb9, 0x07, 0x15, 0x6c, 0x6a, 0x60, 0xb1, 0x17, 0x59, 0x91, 0xe4, 0xd9,
+	0xcf, 0x61, 0x5a, 0xef, 0xd8, 0xd1, 0x04, 0x91, 0x1a, 0xe0, 0x24, 0xe6, 0x6a, 0x9f, 0xb5, 0xd3,
+	0x8c, 0x87, 0xfe, 0xbb, 0x81, 0xe7, 0x26, 0x88, 0x7e, 0x57, 0x0e, 0xe9, 0x7c, 0x2a, 0x19, 0x3e,
+	0x56, 0xfe, 0xcb, 0x81, 0x7c, 0x56, 0x1d, 0xb3, 0x94, 0x65, 0x55, 0x3b, 0x7a, 0x1a, 0x7e, 0x56,
+	0x00, 0x27, 0x2e, 0x97, 0x0c, 0x50, 0x73, 0xae, 0x2c, 0xf0, 0x58, 0x32, 0x91, 0x3f, 0xf2, 0x78,
+	0xd2, 0xb0, 0x5a, 0x25, 0x1a, 0xfb, 0xac, 0x05, 0xa3, 0xd3, 0xe1, 0x62, 0x07, 0xe4, 0x15, 0x25,
+	0x46, 0x67, 0x58, 0xc3, 0x6e, 0xf6, 0x39, 0xdc, 0x98, 0x35, 0x65, 0xbe, 0x62, 0xfc, 0x2f, 0xa3,
+	0x07, 0xa4, 0x31, 0x87, 0x22, 0x36, 0xfb, 0x68, 0xda, 0x66, 0x6b, 0x5d, 0xb7, 0x3b, 0xc5, 0xfa,
+	0x00, 0x5e, 0x13, 0x41, 0x2c, 0x09, 0x8c, 0xc8, 0xd6, 0x02, 0xb8, 0x73, 0x00, 0x50, 0x97, 0xc9,
+	0x2c, 0x4d, 0x9a, 0xf6, 0x38, 0x59, 0xf3, 0x7b, 0x98, 0x62, 0x5e, 0x87, 0x07, 0x00, 0x9a, 0x89,
+	0x5d, 0x88, 0x3a, 0x00, 0xa0, 0x60, 0x37, 0x3b, 0xe3, 0x65, 0xd2, 0xf7, 0x57, 0x61, 0x76, 0xc6,
+	0x65, 0x9d, 0xbb, 0xab, 0x37, 0xc3, 0x90, 0x7d, 0xef, 0x4e, 0x8a, 0x44, 0x1a, 0x72, 0x0d, 0xd3,
+	0xf1, 0x12, 0x90, 0xeb, 0x01, 0xc2, 0x7e, 0x12, 0x44, 0xfe, 0x5d, 0xff, 0xfc, 0x52, 0xab, 0x3e,
+	0x28, 0xff, 0x00, 0xd3, 0x75, 0xa1, 0xd8, 0xfd, 0xae, 0xe3, 0xd6, 0x40, 0xda, 0xa6, 0x99, 0xbb,
+	0xe7, 0x09, 0xbf, 0x2d, 0x72, 0xc8, 0x1a, 0xe4, 0x5d, 0x7e, 0x2e, 0x8c, 0xad, 0x94, 0x48, 0x33,
+	0xbb, 0x94, 0xed, 0xe8, 0x5c, 0xf6, 0x7c, 0x96, 0xb5, 0x4a, 0xa6, 0x6f, 0x85, 0x3f, 0xe8, 0x1a,
+	0xe8, 0x52, 0x44, 0xad, 0x68, 0xda, 0xc6, 0x72, 0xce, 0x4c, 0xcb, 0xf9, 0x3c, 0x67, 0x0a, 0x1a,
+	0xb3, 0x44, 0x7e, 0xbf, 0x72, 0xbb, 0x6b, 0x0b, 0x05, 0x89, 0x58, 0x1e, 0x54, 0xb0, 0x69, 0x24,
+	0xc7, 0xe4, 0x31, 0x9c, 0x7e, 0xb0, 0x77, 0xba, 0x66, 0x3c, 0x80, 0x48, 0x23, 0x51, 0xd0, 0xbe,
+	0xeb, 0xc7, 0xc5, 0xfb, 0x4c, 0x3f, 0x09, 0xf8, 0xf5, 0x27, 0xa1, 0xec, 0x88, 0x89, 0x77, 0xfd,
+	0x10, 0xcc, 0xae, 0x13, 0x80, 0x87, 0x67, 0x6b, 0xfe, 0xc1, 0xf4, 0xfb, 0x41, 0x7d, 0xc1, 0x10,
+	0xeb, 0x04, 0x8a, 0xf5, 0x9b, 0xce, 0xec, 0x7b, 0xbd, 0x4c, 0x1a, 0x5b, 0x39, 0xa4, 0xe9, 0x50,
+	0x30, 0xd4, 0x74, 0x94, 0x82, 0xff, 0x48, 0xdd, 0xad, 0x35, 0xe4, 0x91, 0x62, 0xfb, 0x6a, 0xb7,
+	0xfb, 0x30, 0x1b, 0x97, 0xcc, 0x7a, 0x52, 0x5c, 0xd3, 0xc2, 0x7f, 0x48, 0x43, 0x0a, 0x89, 0xb8,
+	0xd4, 0x81, 0x6c, 0x5c, 0xe2, 0xbf, 0x33, 0xcc, 0x0a, 0x61, 0xd8, 0x8f, 0x4b, 0x4a, 0xe0, 0x6d,
+	0x07, 0x5f, 0x0f, 0x10, 0xf6, 0x1d, 0x5b, 0xf5, 0x77, 0x3e, 0xe2, 0x46, 0xb8, 0x06, 0x17, 0x11,
+	0xef, 0xd8, 0x02, 0xc4, 0x3e, 0x04, 0x25, 0x40, 0x7f, 0x07, 0x50, 0x2b, 0x05, 0x7f, 0x07, 0xb0,
+	0x03, 0xd9, 0xf4, 0x46, 0x89, 0x26, 0xac, 0x55, 0xf3, 0xd1, 0x0c, 0xa4, 0x37, 0x5a, 0xd7, 0x21,
+	0x88, 0xf4, 0x06, 0x27, 0x3b, 0x0f, 0x47, 0x4c, 0x04, 0xf8, 0xc3, 0xf1, 0x66, 0x82, 0x1b, 0x21,
+	0x44, 0x5a, 0x7d, 0x76, 0xfd, 0xbf, 0xbf, 0xbe, 0xb2, 0xf1, 0xcb, 0xaf, 0xaf, 0x6c, 0xfc, 0xef,
+	0xd7, 0x57, 0x36, 0x7e, 0xf1, 0xcd, 0x95, 0x6f, 0xfd, 0xf2, 0x9b, 0x2b, 0xdf, 0xfa, 0x9f, 0x6f,
+	0xae, 0x7c, 0xeb, 0xab, 0xf7, 0xd4, 0x2f, 0x53, 0x9f, 0xfe, 0x9a, 0xf8, 0x7d, 0xe9, 0x27, 0xff,
+	0x3f, 0x00, 0x21, 0xf8, 0xd7, 0xb9, 0xbd, 0x7a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -443,6 +456,7 @@ type ClientCommandsClient interface {
 	ObjectSearchSubscribe(ctx context.Context, in *pb.RpcObjectSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchSubscribeResponse, error)
 	ObjectCrossSpaceSearchSubscribe(ctx context.Context, in *pb.RpcObjectCrossSpaceSearchSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectCrossSpaceSearchSubscribeResponse, error)
 	ObjectCrossSpaceSearchUnsubscribe(ctx context.Context, in *pb.RpcObjectCrossSpaceSearchUnsubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectCrossSpaceSearchUnsubscribeResponse, error)
+	ObjectCrossSpaceSearchWithMeta(ctx context.Context, in *pb.RpcObjectCrossSpaceSearchWithMetaRequest, opts ...grpc.CallOption) (*pb.RpcObjectCrossSpaceSearchWithMetaResponse, error)
 	ObjectSubscribeIds(ctx context.Context, in *pb.RpcObjectSubscribeIdsRequest, opts ...grpc.CallOption) (*pb.RpcObjectSubscribeIdsResponse, error)
 	ObjectGroupsSubscribe(ctx context.Context, in *pb.RpcObjectGroupsSubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectGroupsSubscribeResponse, error)
 	ObjectSearchUnsubscribe(ctx context.Context, in *pb.RpcObjectSearchUnsubscribeRequest, opts ...grpc.CallOption) (*pb.RpcObjectSearchUnsubscribeResponse, error)
@@ -479,6 +493,12 @@ type ClientCommandsClient interface {
 	ObjectImportUseCase(ctx context.Context, in *pb.RpcObjectImportUseCaseRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportUseCaseResponse, error)
 	ObjectImportExperience(ctx context.Context, in *pb.RpcObjectImportExperienceRequest, opts ...grpc.CallOption) (*pb.RpcObjectImportExperienceResponse, error)
 	ObjectDateByTimestamp(ctx context.Context, in *pb.RpcObjectDateByTimestampRequest, opts ...grpc.CallOption) (*pb.RpcObjectDateByTimestampResponse, error)
+	// Backup
+	// ***
+	BackupSetSettings(ctx context.Context, in *pb.RpcBackupSetSettingsRequest, opts ...grpc.CallOption) (*pb.RpcBackupSetSettingsResponse, error)
+	BackupGetSettings(ctx context.Context, in *pb.RpcBackupGetSettingsRequest, opts ...grpc.CallOption) (*pb.RpcBackupGetSettingsResponse, error)
+	BackupRun(ctx context.Context, in *pb.RpcBackupRunRequest, opts ...grpc.CallOption) (*pb.RpcBackupRunResponse, error)
+	BackupRestore(ctx context.Context, in *pb.RpcBackupRestoreRequest, opts ...grpc.CallOption) (*pb.RpcBackupRestoreResponse, error)
 	// Collections
 	// ***
 	ObjectCollectionAdd(ctx context.Context, in *pb.RpcObjectCollectionAddRequest, opts ...grpc.CallOption) (*pb.RpcObjectCollectionAddResponse, error)
@@ -519,6 +539,7 @@ type ClientCommandsClient interface {
 	FileNodeUsage(ctx context.Context, in *pb.RpcFileNodeUsageRequest, opts ...grpc.CallOption) (*pb.RpcFileNodeUsageResponse, error)
 	NavigationListObjects(ctx context.Context, in *pb.RpcNavigationListObjectsRequest, opts ...grpc.CallOption) (*pb.RpcNavigationListObjectsResponse, error)
 	NavigationGetObjectInfoWithLinks(ctx context.Context, in *pb.RpcNavigationGetObjectInfoWithLinksRequest, opts ...grpc.CallOption) (*pb.RpcNavigationGetObjectInfoWithLinksResponse, error)
+	NavigationGetBlockBacklinks(ctx context.Context, in *pb.RpcNavigationGetBlockBacklinksRequest, opts ...grpc.CallOption) (*pb.RpcNavigationGetBlockBacklinksResponse, error)
 	TemplateCreateFromObject(ctx context.Context, in *pb.RpcTemplateCreateFromObjectRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCreateFromObjectResponse, error)
 	TemplateClone(ctx context.Context, in *pb.RpcTemplateCloneRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCloneResponse, error)
 	TemplateExportAll(ctx context.Context, in *pb.RpcTemplateExportAllRequest, opts ...grpc.CallOption) (*pb.RpcTemplateExportAllResponse, error)
@@ -615,6 +636,8 @@ type ClientCommandsClient interface {
 	BlockTableRowListClean(ctx context.Context, in *pb.RpcBlockTableRowListCleanRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableRowListCleanResponse, error)
 	BlockTableColumnListFill(ctx context.Context, in *pb.RpcBlockTableColumnListFillRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableColumnListFillResponse, error)
 	BlockTableSort(ctx context.Context, in *pb.RpcBlockTableSortRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableSortResponse, error)
+	BlockTableCellListMerge(ctx context.Context, in *pb.RpcBlockTableCellListMergeRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableCellListMergeResponse, error)
+	BlockTableCellListSplit(ctx context.Context, in *pb.RpcBlockTableCellListSplitRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableCellListSplitResponse, error)
 	// Widget commands
 	// ***
 	BlockCreateWidget(ctx context.Context, in *pb.RpcBlockCreateWidgetRequest, opts ...grpc.CallOption) (*pb.RpcBlockCreateWidgetResponse, error)
@@ -702,6 +725,12 @@ type ClientCommandsClient interface {
 	ChatSubscribeLastMessages(ctx context.Context, in *pb.RpcChatSubscribeLastMessagesRequest, opts ...grpc.CallOption) (*pb.RpcChatSubscribeLastMessagesResponse, error)
 	ChatUnsubscribe(ctx context.Context, in *pb.RpcChatUnsubscribeRequest, opts ...grpc.CallOption) (*pb.RpcChatUnsubscribeResponse, error)
 	ObjectChatAdd(ctx context.Context, in *pb.RpcObjectChatAddRequest, opts ...grpc.CallOption) (*pb.RpcObjectChatAddResponse, error)
+	// Comments
+	CommentAdd(ctx context.Context, in *pb.RpcCommentAddRequest, opts ...grpc.CallOption) (*pb.RpcCommentAddResponse, error)
+	CommentEdit(ctx context.Context, in *pb.RpcCommentEditRequest, opts ...grpc.CallOption) (*pb.RpcCommentEditResponse, error)
+	CommentDelete(ctx context.Context, in *pb.RpcCommentDeleteRequest, opts ...grpc.CallOption) (*pb.RpcCommentDeleteResponse, error)
+	CommentSetResolved(ctx context.Context, in *pb.RpcCommentSetResolvedRequest, opts ...grpc.CallOption) (*pb.RpcCommentSetResolvedResponse, error)
+	CommentList(ctx context.Context, in *pb.RpcCommentListRequest, opts ...grpc.CallOption) (*pb.RpcCommentListResponse, error)
 }
 
 type clientCommandsClient struct {
@@ -1252,6 +1281,15 @@ func (c *clientCommandsClient) ObjectCrossSpaceSearchUnsubscribe(ctx context.Con
 	return out, nil
 }
 
+func (c *clientCommandsClient) ObjectCrossSpaceSearchWithMeta(ctx context.Context, in *pb.RpcObjectCrossSpaceSearchWithMetaRequest, opts ...grpc.CallOption) (*pb.RpcObjectCrossSpaceSearchWithMetaResponse, error) {
+	out := new(pb.RpcObjectCrossSpaceSearchWithMetaResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectCrossSpaceSearchWithMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectSubscribeIds(ctx context.Context, in *pb.RpcObjectSubscribeIdsRequest, opts ...grpc.CallOption) (*pb.RpcObjectSubscribeIdsResponse, error) {
 	out := new(pb.RpcObjectSubscribeIdsResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectSubscribeIds", in, out, opts...)
@@ -1558,6 +1596,42 @@ func (c *clientCommandsClient) ObjectDateByTimestamp(ctx context.Context, in *pb
 	return out, nil
 }
 
+func (c *clientCommandsClient) BackupSetSettings(ctx context.Context, in *pb.RpcBackupSetSettingsRequest, opts ...grpc.CallOption) (*pb.RpcBackupSetSettingsResponse, error) {
+	out := new(pb.RpcBackupSetSettingsResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BackupSetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) BackupGetSettings(ctx context.Context, in *pb.RpcBackupGetSettingsRequest, opts ...grpc.CallOption) (*pb.RpcBackupGetSettingsResponse, error) {
+	out := new(pb.RpcBackupGetSettingsResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BackupGetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) BackupRun(ctx context.Context, in *pb.RpcBackupRunRequest, opts ...grpc.CallOption) (*pb.RpcBackupRunResponse, error) {
+	out := new(pb.RpcBackupRunResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BackupRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) BackupRestore(ctx context.Context, in *pb.RpcBackupRestoreRequest, opts ...grpc.CallOption) (*pb.RpcBackupRestoreResponse, error) {
+	out := new(pb.RpcBackupRestoreResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BackupRestore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) ObjectCollectionAdd(ctx context.Context, in *pb.RpcObjectCollectionAddRequest, opts ...grpc.CallOption) (*pb.RpcObjectCollectionAddResponse, error) {
 	out := new(pb.RpcObjectCollectionAddResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/ObjectCollectionAdd", in, out, opts...)
@@ -1828,6 +1902,15 @@ func (c *clientCommandsClient) NavigationGetObjectInfoWithLinks(ctx context.Cont
 	return out, nil
 }
 
+func (c *clientCommandsClient) NavigationGetBlockBacklinks(ctx context.Context, in *pb.RpcNavigationGetBlockBacklinksRequest, opts ...grpc.CallOption) (*pb.RpcNavigationGetBlockBacklinksResponse, error) {
+	out := new(pb.RpcNavigationGetBlockBacklinksResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/NavigationGetBlockBacklinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) TemplateCreateFromObject(ctx context.Context, in *pb.RpcTemplateCreateFromObjectRequest, opts ...grpc.CallOption) (*pb.RpcTemplateCreateFromObjectResponse, error) {
 	out := new(pb.RpcTemplateCreateFromObjectResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/TemplateCreateFromObject", in, out, opts...)
@@ -2584,6 +2667,24 @@ func (c *clientCommandsClient) BlockTableSort(ctx context.Context, in *pb.RpcBlo
 	return out, nil
 }
 
+func (c *clientCommandsClient) BlockTableCellListMerge(ctx context.Context, in *pb.RpcBlockTableCellListMergeRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableCellListMergeResponse, error) {
+	out := new(pb.RpcBlockTableCellListMergeResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BlockTableCellListMerge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) BlockTableCellListSplit(ctx context.Context, in *pb.RpcBlockTableCellListSplitRequest, opts ...grpc.CallOption) (*pb.RpcBlockTableCellListSplitResponse, error) {
+	out := new(pb.RpcBlockTableCellListSplitResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BlockTableCellListSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *clientCommandsClient) BlockCreateWidget(ctx context.Context, in *pb.RpcBlockCreateWidgetRequest, opts ...grpc.CallOption) (*pb.RpcBlockCreateWidgetResponse, error) {
 	out := new(pb.RpcBlockCreateWidgetResponse)
 	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/BlockCreateWidget", in, out, opts...)
@@ -3165,6 +3266,51 @@ func (c *clientCommandsClient) ObjectChatAdd(ctx context.Context, in *pb.RpcObje
 	return out, nil
 }
 
+func (c *clientCommandsClient) CommentAdd(ctx context.Context, in *pb.RpcCommentAddRequest, opts ...grpc.CallOption) (*pb.RpcCommentAddResponse, error) {
+	out := new(pb.RpcCommentAddResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CommentAdd", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CommentEdit(ctx context.Context, in *pb.RpcCommentEditRequest, opts ...grpc.CallOption) (*pb.RpcCommentEditResponse, error) {
+	out := new(pb.RpcCommentEditResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CommentEdit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CommentDelete(ctx context.Context, in *pb.RpcCommentDeleteRequest, opts ...grpc.CallOption) (*pb.RpcCommentDeleteResponse, error) {
+	out := new(pb.RpcCommentDeleteResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CommentDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CommentSetResolved(ctx context.Context, in *pb.RpcCommentSetResolvedRequest, opts ...grpc.CallOption) (*pb.RpcCommentSetResolvedResponse, error) {
+	out := new(pb.RpcCommentSetResolvedResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CommentSetResolved", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clientCommandsClient) CommentList(ctx context.Context, in *pb.RpcCommentListRequest, opts ...grpc.CallOption) (*pb.RpcCommentListResponse, error) {
+	out := new(pb.RpcCommentListResponse)
+	err := c.cc.Invoke(ctx, "/anytype.ClientCommands/CommentList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClientCommandsServer is the server API for ClientCommands service.
 type ClientCommandsServer interface {
 	AppGetVersion(context.Context, *pb.RpcAppGetVersionRequest) *pb.RpcAppGetVersionResponse
@@ -3239,6 +3385,7 @@ type ClientCommandsServer interface {
 	ObjectSearchSubscribe(context.Context, *pb.RpcObjectSearchSubscribeRequest) *pb.RpcObjectSearchSubscribeResponse
 	ObjectCrossSpaceSearchSubscribe(context.Context, *pb.RpcObjectCrossSpaceSearchSubscribeRequest) *pb.RpcObjectCrossSpaceSearchSubscribeResponse
 	ObjectCrossSpaceSearchUnsubscribe(context.Context, *pb.RpcObjectCrossSpaceSearchUnsubscribeRequest) *pb.RpcObjectCrossSpaceSearchUnsubscribeResponse
+	ObjectCrossSpaceSearchWithMeta(context.Context, *pb.RpcObjectCrossSpaceSearchWithMetaRequest) *pb.RpcObjectCrossSpaceSearchWithMetaResponse
 	ObjectSubscribeIds(context.Context, *pb.RpcObjectSubscribeIdsRequest) *pb.RpcObjectSubscribeIdsResponse
 	ObjectGroupsSubscribe(context.Context, *pb.RpcObjectGroupsSubscribeRequest) *pb.RpcObjectGroupsSubscribeResponse
 	ObjectSearchUnsubscribe(context.Context, *pb.RpcObjectSearchUnsubscribeRequest) *pb.RpcObjectSearchUnsubscribeResponse
@@ -3275,6 +3422,12 @@ type ClientCommandsServer interface {
 	ObjectImportUseCase(context.Context, *pb.RpcObjectImportUseCaseRequest) *pb.RpcObjectImportUseCaseResponse
 	ObjectImportExperience(context.Context, *pb.RpcObjectImportExperienceRequest) *pb.RpcObjectImportExperienceResponse
 	ObjectDateByTimestamp(context.Context, *pb.RpcObjectDateByTimestampRequest) *pb.RpcObjectDateByTimestampResponse
+	// Backup
+	// ***
+	BackupSetSettings(context.Context, *pb.RpcBackupSetSettingsRequest) *pb.RpcBackupSetSettingsResponse
+	BackupGetSettings(context.Context, *pb.RpcBackupGetSettingsRequest) *pb.RpcBackupGetSettingsResponse
+	BackupRun(context.Context, *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse
+	BackupRestore(context.Context, *pb.RpcBackupRestoreRequest) *pb.RpcBackupRestoreResponse
 	// Collections
 	// ***
 	ObjectCollectionAdd(context.Context, *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse
@@ -3315,6 +3468,7 @@ type ClientCommandsServer interface {
 	FileNodeUsage(context.Context, *pb.RpcFileNodeUsageRequest) *pb.RpcFileNodeUsageResponse
 	NavigationListObjects(context.Context, *pb.RpcNavigationListObjectsRequest) *pb.RpcNavigationListObjectsResponse
 	NavigationGetObjectInfoWithLinks(context.Context, *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse
+	NavigationGetBlockBacklinks(context.Context, *pb.RpcNavigationGetBlockBacklinksRequest) *pb.RpcNavigationGetBlockBacklinksResponse
 	TemplateCreateFromObject(context.Context, *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse
 	TemplateClone(context.Context, *pb.RpcTemplateCloneRequest) *pb.RpcTemplateCloneResponse
 	TemplateExportAll(context.Context, *pb.RpcTemplateExportAllRequest) *pb.RpcTemplateExportAllResponse
@@ -3411,6 +3565,8 @@ type ClientCommandsServer interface {
 	BlockTableRowListClean(context.Context, *pb.RpcBlockTableRowListCleanRequest) *pb.RpcBlockTableRowListCleanResponse
 	BlockTableColumnListFill(context.Context, *pb.RpcBlockTableColumnListFillRequest) *pb.RpcBlockTableColumnListFillResponse
 	BlockTableSort(context.Context, *pb.RpcBlockTableSortRequest) *pb.RpcBlockTableSortResponse
+	BlockTableCellListMerge(context.Context, *pb.RpcBlockTableCellListMergeRequest) *pb.RpcBlockTableCellListMergeResponse
+	BlockTableCellListSplit(context.Context, *pb.RpcBlockTableCellListSplitRequest) *pb.RpcBlockTableCellListSplitResponse
 	// Widget commands
 	// ***
 	BlockCreateWidget(context.Context, *pb.RpcBlockCreateWidgetRequest) *pb.RpcBlockCreateWidgetResponse
@@ -3498,6 +3654,12 @@ type ClientCommandsServer interface {
 	ChatSubscribeLastMessages(context.Context, *pb.RpcChatSubscribeLastMessagesRequest) *pb.RpcChatSubscribeLastMessagesResponse
 	ChatUnsubscribe(context.Context, *pb.RpcChatUnsubscribeRequest) *pb.RpcChatUnsubscribeResponse
 	ObjectChatAdd(context.Context, *pb.RpcObjectChatAddRequest) *pb.RpcObjectChatAddResponse
+	// Comments
+	CommentAdd(context.Context, *pb.RpcCommentAddRequest) *pb.RpcCommentAddResponse
+	CommentEdit(context.Context, *pb.RpcCommentEditRequest) *pb.RpcCommentEditResponse
+	CommentDelete(context.Context, *pb.RpcCommentDeleteRequest) *pb.RpcCommentDeleteResponse
+	CommentSetResolved(context.Context, *pb.RpcCommentSetResolvedRequest) *pb.RpcCommentSetResolvedResponse
+	CommentList(context.Context, *pb.RpcCommentListRequest) *pb.RpcCommentListResponse
 }
 
 // UnimplementedClientCommandsServer can be embedded to have forward compatible implementations.
@@ -3684,6 +3846,9 @@ func (*UnimplementedClientCommandsServer) ObjectCrossSpaceSearchSubscribe(ctx co
 func (*UnimplementedClientCommandsServer) ObjectCrossSpaceSearchUnsubscribe(ctx context.Context, req *pb.RpcObjectCrossSpaceSearchUnsubscribeRequest) *pb.RpcObjectCrossSpaceSearchUnsubscribeResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) ObjectCrossSpaceSearchWithMeta(ctx context.Context, req *pb.RpcObjectCrossSpaceSearchWithMetaRequest) *pb.RpcObjectCrossSpaceSearchWithMetaResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectSubscribeIds(ctx context.Context, req *pb.RpcObjectSubscribeIdsRequest) *pb.RpcObjectSubscribeIdsResponse {
 	return nil
 }
@@ -3786,6 +3951,18 @@ func (*UnimplementedClientCommandsServer) ObjectImportExperience(ctx context.Con
 func (*UnimplementedClientCommandsServer) ObjectDateByTimestamp(ctx context.Context, req *pb.RpcObjectDateByTimestampRequest) *pb.RpcObjectDateByTimestampResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) BackupSetSettings(ctx context.Context, req *pb.RpcBackupSetSettingsRequest) *pb.RpcBackupSetSettingsResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) BackupGetSettings(ctx context.Context, req *pb.RpcBackupGetSettingsRequest) *pb.RpcBackupGetSettingsResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) BackupRun(ctx context.Context, req *pb.RpcBackupRunRequest) *pb.RpcBackupRunResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) BackupRestore(ctx context.Context, req *pb.RpcBackupRestoreRequest) *pb.RpcBackupRestoreResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) ObjectCollectionAdd(ctx context.Context, req *pb.RpcObjectCollectionAddRequest) *pb.RpcObjectCollectionAddResponse {
 	return nil
 }
@@ -3876,6 +4053,9 @@ func (*UnimplementedClientCommandsServer) NavigationListObjects(ctx context.Cont
 func (*UnimplementedClientCommandsServer) NavigationGetObjectInfoWithLinks(ctx context.Context, req *pb.RpcNavigationGetObjectInfoWithLinksRequest) *pb.RpcNavigationGetObjectInfoWithLinksResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) NavigationGetBlockBacklinks(ctx context.Context, req *pb.RpcNavigationGetBlockBacklinksRequest) *pb.RpcNavigationGetBlockBacklinksResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) TemplateCreateFromObject(ctx context.Context, req *pb.RpcTemplateCreateFromObjectRequest) *pb.RpcTemplateCreateFromObjectResponse {
 	return nil
 }
@@ -4128,6 +4308,12 @@ func (*UnimplementedClientCommandsServer) BlockTableColumnListFill(ctx context.C
 func (*UnimplementedClientCommandsServer) BlockTableSort(ctx context.Context, req *pb.RpcBlockTableSortRequest) *pb.RpcBlockTableSortResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) BlockTableCellListMerge(ctx context.Context, req *pb.RpcBlockTableCellListMergeRequest) *pb.RpcBlockTableCellListMergeResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) BlockTableCellListSplit(ctx context.Context, req *pb.RpcBlockTableCellListSplitRequest) *pb.RpcBlockTableCellListSplitResponse {
+	return nil
+}
 func (*UnimplementedClientCommandsServer) BlockCreateWidget(ctx context.Context, req *pb.RpcBlockCreateWidgetRequest) *pb.RpcBlockCreateWidgetResponse {
 	return nil
 }
@@ -4314,6 +4500,21 @@ func (*UnimplementedClientCommandsServer) ChatUnsubscribe(ctx context.Context, r
 func (*UnimplementedClientCommandsServer) ObjectChatAdd(ctx context.Context, req *pb.RpcObjectChatAddRequest) *pb.RpcObjectChatAddResponse {
 	return nil
 }
+func (*UnimplementedClientCommandsServer) CommentAdd(ctx context.Context, req *pb.RpcCommentAddRequest) *pb.RpcCommentAddResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CommentEdit(ctx context.Context, req *pb.RpcCommentEditRequest) *pb.RpcCommentEditResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CommentDelete(ctx context.Context, req *pb.RpcCommentDeleteRequest) *pb.RpcCommentDeleteResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CommentSetResolved(ctx context.Context, req *pb.RpcCommentSetResolvedRequest) *pb.RpcCommentSetResolvedResponse {
+	return nil
+}
+func (*UnimplementedClientCommandsServer) CommentList(ctx context.Context, req *pb.RpcCommentListRequest) *pb.RpcCommentListResponse {
+	return nil
+}
 
 func RegisterClientCommandsServer(s *grpc.Server, srv ClientCommandsServer) {
 	s.RegisterService(&_ClientCommands_serviceDesc, srv)
@@ -5399,6 +5600,24 @@ func _ClientCommands_ObjectCrossSpaceSearchUnsubscribe_Handler(srv interface{},
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_ObjectCrossSpaceSearchWithMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcObjectCrossSpaceSearchWithMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).ObjectCrossSpaceSearchWithMeta(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/ObjectCrossSpaceSearchWithMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).ObjectCrossSpaceSearchWithMeta(ctx, req.(*pb.RpcObjectCrossSpaceSearchWithMetaRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectSubscribeIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectSubscribeIdsRequest)
 	if err := dec(in); err != nil {
@@ -6011,6 +6230,78 @@ func _ClientCommands_ObjectDateByTimestamp_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_BackupSetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBackupSetSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BackupSetSettings(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BackupSetSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BackupSetSettings(ctx, req.(*pb.RpcBackupSetSettingsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_BackupGetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBackupGetSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BackupGetSettings(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BackupGetSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BackupGetSettings(ctx, req.(*pb.RpcBackupGetSettingsRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_BackupRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBackupRunRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BackupRun(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BackupRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BackupRun(ctx, req.(*pb.RpcBackupRunRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_BackupRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBackupRestoreRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BackupRestore(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BackupRestore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BackupRestore(ctx, req.(*pb.RpcBackupRestoreRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_ObjectCollectionAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcObjectCollectionAddRequest)
 	if err := dec(in); err != nil {
@@ -6551,6 +6842,24 @@ func _ClientCommands_NavigationGetObjectInfoWithLinks_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_NavigationGetBlockBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcNavigationGetBlockBacklinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).NavigationGetBlockBacklinks(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/NavigationGetBlockBacklinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).NavigationGetBlockBacklinks(ctx, req.(*pb.RpcNavigationGetBlockBacklinksRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_TemplateCreateFromObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcTemplateCreateFromObjectRequest)
 	if err := dec(in); err != nil {
@@ -8063,6 +8372,42 @@ func _ClientCommands_BlockTableSort_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_BlockTableCellListMerge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBlockTableCellListMergeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BlockTableCellListMerge(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BlockTableCellListMerge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BlockTableCellListMerge(ctx, req.(*pb.RpcBlockTableCellListMergeRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_BlockTableCellListSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcBlockTableCellListSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).BlockTableCellListSplit(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/BlockTableCellListSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).BlockTableCellListSplit(ctx, req.(*pb.RpcBlockTableCellListSplitRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ClientCommands_BlockCreateWidget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(pb.RpcBlockCreateWidgetRequest)
 	if err := dec(in); err != nil {
@@ -9183,6 +9528,96 @@ func _ClientCommands_ObjectChatAdd_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClientCommands_CommentAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCommentAddRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).CommentAdd(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/CommentAdd",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).CommentAdd(ctx, req.(*pb.RpcCommentAddRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_CommentEdit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCommentEditRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).CommentEdit(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/CommentEdit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).CommentEdit(ctx, req.(*pb.RpcCommentEditRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_CommentDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCommentDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).CommentDelete(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/CommentDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).CommentDelete(ctx, req.(*pb.RpcCommentDeleteRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_CommentSetResolved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCommentSetResolvedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).CommentSetResolved(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/CommentSetResolved",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).CommentSetResolved(ctx, req.(*pb.RpcCommentSetResolvedRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClientCommands_CommentList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(pb.RpcCommentListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClientCommandsServer).CommentList(ctx, in), nil
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/anytype.ClientCommands/CommentList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClientCommandsServer).CommentList(ctx, req.(*pb.RpcCommentListRequest)), nil
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "anytype.ClientCommands",
 	HandlerType: (*ClientCommandsServer)(nil),
@@ -9427,6 +9862,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectCrossSpaceSearchUnsubscribe",
 			Handler:    _ClientCommands_ObjectCrossSpaceSearchUnsubscribe_Handler,
 		},
+		{
+			MethodName: "ObjectCrossSpaceSearchWithMeta",
+			Handler:    _ClientCommands_ObjectCrossSpaceSearchWithMeta_Handler,
+		},
 		{
 			MethodName: "ObjectSubscribeIds",
 			Handler:    _ClientCommands_ObjectSubscribeIds_Handler,
@@ -9563,6 +10002,22 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectDateByTimestamp",
 			Handler:    _ClientCommands_ObjectDateByTimestamp_Handler,
 		},
+		{
+			MethodName: "BackupSetSettings",
+			Handler:    _ClientCommands_BackupSetSettings_Handler,
+		},
+		{
+			MethodName: "BackupGetSettings",
+			Handler:    _ClientCommands_BackupGetSettings_Handler,
+		},
+		{
+			MethodName: "BackupRun",
+			Handler:    _ClientCommands_BackupRun_Handler,
+		},
+		{
+			MethodName: "BackupRestore",
+			Handler:    _ClientCommands_BackupRestore_Handler,
+		},
 		{
 			MethodName: "ObjectCollectionAdd",
 			Handler:    _ClientCommands_ObjectCollectionAdd_Handler,
@@ -9683,6 +10138,10 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NavigationGetObjectInfoWithLinks",
 			Handler:    _ClientCommands_NavigationGetObjectInfoWithLinks_Handler,
 		},
+		{
+			MethodName: "NavigationGetBlockBacklinks",
+			Handler:    _ClientCommands_NavigationGetBlockBacklinks_Handler,
+		},
 		{
 			MethodName: "TemplateCreateFromObject",
 			Handler:    _ClientCommands_TemplateCreateFromObject_Handler,
@@ -10019,6 +10478,14 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockTableSort",
 			Handler:    _ClientCommands_BlockTableSort_Handler,
 		},
+		{
+			MethodName: "BlockTableCellListMerge",
+			Handler:    _ClientCommands_BlockTableCellListMerge_Handler,
+		},
+		{
+			MethodName: "BlockTableCellListSplit",
+			Handler:    _ClientCommands_BlockTableCellListSplit_Handler,
+		},
 		{
 			MethodName: "BlockCreateWidget",
 			Handler:    _ClientCommands_BlockCreateWidget_Handler,
@@ -10263,6 +10730,26 @@ var _ClientCommands_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ObjectChatAdd",
 			Handler:    _ClientCommands_ObjectChatAdd_Handler,
 		},
+		{
+			MethodName: "CommentAdd",
+			Handler:    _ClientCommands_CommentAdd_Handler,
+		},
+		{
+			MethodName: "CommentEdit",
+			Handler:    _ClientCommands_CommentEdit_Handler,
+		},
+		{
+			MethodName: "CommentDelete",
+			Handler:    _ClientCommands_CommentDelete_Handler,
+		},
+		{
+			MethodName: "CommentSetResolved",
+			Handler:    _ClientCommands_CommentSetResolved_Handler,
+		},
+		{
+			MethodName: "CommentList",
+			Handler:    _ClientCommands_CommentList_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
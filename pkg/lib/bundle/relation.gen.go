@@ -9,7 +9,7 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const RelationChecksum = "5694f1d0c15a9a770f961a84dd5ee2af89886c00522f4150c601d40dadc502e0"
+const RelationChecksum = "a77d6f6f74f2758357268ecd6bbab87194301469848731ef64738f13e7d433c5"
 const (
 	RelationKeyTag                       domain.RelationKey = "tag"
 	RelationKeyCamera                    domain.RelationKey = "camera"
@@ -145,6 +145,11 @@ const (
 	RelationKeyMentions                  domain.RelationKey = "mentions"
 	RelationKeyTimestamp                 domain.RelationKey = "timestamp"
 	RelationKeySpaceOrder                domain.RelationKey = "spaceOrder"
+	RelationKeyRelationReminder          domain.RelationKey = "relationReminder"
+	RelationKeyRelationFormatIncludeTime domain.RelationKey = "relationFormatIncludeTime"
+	RelationKeyRecurrence                domain.RelationKey = "recurrence"
+	RelationKeyAuthorName                domain.RelationKey = "authorName"
+	RelationKeyPublishedDate             domain.RelationKey = "publishedDate"
 )
 
 var (
@@ -268,6 +273,19 @@ var (
 			Revision:         1,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyAuthorName: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Name of the author of the clipped web article",
+			Format:           model.RelationFormat_shorttext,
+			Id:               "_brauthorName",
+			Key:              "authorName",
+			MaxCount:         1,
+			Name:             "Author name",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyBacklinks: {
 
 			DataSource:       model.Relation_local,
@@ -1276,6 +1294,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyPublishedDate: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Date when the clipped web article was published",
+			Format:           model.RelationFormat_date,
+			Id:               "_brpublishedDate",
+			Key:              "publishedDate",
+			MaxCount:         1,
+			Name:             "Published date",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyReadersLimit: {
 
 			DataSource:       model.Relation_derived,
@@ -1318,6 +1349,19 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRecurrence: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Recurrence rule in RRULE format, e.g. FREQ=WEEKLY;BYDAY=MO. Next occurrence of the object is created when it is done",
+			Format:           model.RelationFormat_longtext,
+			Id:               "_brrecurrence",
+			Key:              "recurrence",
+			MaxCount:         1,
+			Name:             "Repeat",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyRelationDefaultValue: {
 
 			DataSource:       model.Relation_details,
@@ -1345,6 +1389,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRelationFormatIncludeTime: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Date relation with time: values of the date relation without it are dates of the calendar day",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brrelationFormatIncludeTime",
+			Key:              "relationFormatIncludeTime",
+			MaxCount:         1,
+			Name:             "Include time",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyRelationFormatObjectTypes: {
 
 			DataSource:       model.Relation_details,
@@ -1413,6 +1471,20 @@ var (
 			ReadOnlyRelation: true,
 			Scope:            model.Relation_type,
 		},
+		RelationKeyRelationReminder: {
+
+			DataSource:       model.Relation_details,
+			Description:      "Date relation with reminder: notification is sent when the date of the object comes",
+			Format:           model.RelationFormat_checkbox,
+			Hidden:           true,
+			Id:               "_brrelationReminder",
+			Key:              "relationReminder",
+			MaxCount:         1,
+			Name:             "Remind",
+			ReadOnly:         false,
+			ReadOnlyRelation: true,
+			Scope:            model.Relation_type,
+		},
 		RelationKeyReleasedYear: {
 
 			DataSource:       model.Relation_details,
@@ -6,7 +6,7 @@ package bundle
 
 import domain "github.com/anyproto/anytype-heart/core/domain"
 
-const SystemRelationsChecksum = "cebd4ab7522c2ca901215e20861e005c1ad1a6ca2a77d7c1205e9e51edd901db"
+const SystemRelationsChecksum = "c87cf5b24adbc8218065729723fac5f6910caf93573be8626bdcef6503ffc06e"
 
 // SystemRelations contains relations that have some special biz logic depends on them in some objects
 // in case EVERY object depend on the relation please add it to RequiredInternalRelations
@@ -31,6 +31,8 @@ var SystemRelations = append(RequiredInternalRelations, []domain.RelationKey{
 	RelationKeyRelationMaxCount,
 	RelationKeyRelationOptionColor,
 	RelationKeyRelationFormatObjectTypes,
+	RelationKeyRelationReminder,
+	RelationKeyRelationFormatIncludeTime,
 	RelationKeyIsReadonly,
 	RelationKeyIsDeleted,
 	RelationKeyIsHidden,
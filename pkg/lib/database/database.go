@@ -329,6 +329,8 @@ func (r FulltextResult) Model() model.SearchMeta {
 		HighlightRanges: r.HighlightRanges,
 		RelationKey:     r.Path.RelationKey,
 		BlockId:         r.Path.BlockId,
+		MessageId:       r.Path.MessageId,
+		Page:            int32(r.Path.Page),
 	}
 }
 
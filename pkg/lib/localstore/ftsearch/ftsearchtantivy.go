@@ -39,7 +39,7 @@ const (
 	CName    = "fts"
 	ftsDir   = "fts"
 	ftsDir2  = "fts_tantivy"
-	ftsVer   = "11"
+	ftsVer   = "12"
 	docLimit = 10000
 
 	fieldTitle   = "Title"
@@ -65,6 +65,8 @@ type FTSearch interface {
 	BatchIndex(ctx context.Context, docs []SearchDoc, deletedDocs []string) (err error)
 	BatchDeleteObjects(ids []string) (err error)
 	Search(spaceId string, query string) (results []*DocumentMatch, err error)
+	// SearchCrossSpace searches in all the given spaces at once, so the results of different spaces are ranked together
+	SearchCrossSpace(spaceIds []string, query string) (results []*DocumentMatch, err error)
 	// NamePrefixSearch special prefix case search
 	NamePrefixSearch(spaceId string, query string) (results []*DocumentMatch, err error)
 	Iterate(objectId string, fields []string, shouldContinue func(doc *SearchDoc) bool) (err error)
@@ -87,6 +89,7 @@ type Highlight struct {
 type DocumentMatch struct {
 	Score     float64
 	ID        string
+	SpaceId   string
 	Fragments map[string]*Highlight
 	Fields    map[string]any
 }
@@ -237,6 +240,26 @@ func (f *ftSearchTantivy) Run(context.Context) error {
 		tantivy.TokenizerJieba,
 	)
 
+	for _, lang := range stemmedLanguages {
+		err = builder.AddTextField(
+			fieldTitleLang(lang),
+			true,
+			true,
+			false,
+			tantivy.IndexRecordOptionWithFreqsAndPositions,
+			tokenizerLang(lang),
+		)
+
+		err = builder.AddTextField(
+			fieldTextLang(lang),
+			true,
+			true,
+			false,
+			tantivy.IndexRecordOptionWithFreqsAndPositions,
+			tokenizerLang(lang),
+		)
+	}
+
 	schema, err := builder.BuildSchema()
 	if err != nil {
 		return err
@@ -257,6 +280,13 @@ func (f *ftSearchTantivy) Run(context.Context) error {
 		return err
 	}
 
+	for _, lang := range stemmedLanguages {
+		err = index.RegisterTextAnalyzerSimple(tokenizerLang(lang), 40, lang)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = index.RegisterTextAnalyzerJieba(tantivy.TokenizerJieba, 40)
 	if err != nil {
 		return err
@@ -335,6 +365,16 @@ func (f *ftSearchTantivy) convertDoc(doc SearchDoc) (*tantivy.Document, error) {
 	if err != nil {
 		return nil, err
 	}
+	if lang := detectLanguage(doc.Title + "\n" + doc.Text); lang != "" && lang != tantivy.English {
+		err = document.AddField(fieldTitleLang(lang), doc.Title, f.index)
+		if err != nil {
+			return nil, err
+		}
+		err = document.AddField(fieldTextLang(lang), doc.Text, f.index)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return document, nil
 }
 
@@ -373,31 +413,69 @@ func (f *ftSearchTantivy) BatchIndex(ctx context.Context, docs []SearchDoc, dele
 }
 
 func (f *ftSearchTantivy) NamePrefixSearch(spaceId, query string) ([]*DocumentMatch, error) {
-	return f.performSearch(spaceId, query, f.buildObjectQuery)
+	return f.performSearch(spaceIdsFilter(spaceId), query, f.buildObjectQuery)
 }
 
 func (f *ftSearchTantivy) Search(spaceId, query string) ([]*DocumentMatch, error) {
-	return f.performSearch(spaceId, query, f.buildDetailedQuery)
+	return f.performSearch(spaceIdsFilter(spaceId), query, f.buildDetailedQuery)
 }
 
-func (f *ftSearchTantivy) performSearch(spaceId, query string, buildQueryFunc func(*tantivy.QueryBuilder, string)) ([]*DocumentMatch, error) {
-	query = prepareQuery(query)
-	if query == "" {
+func (f *ftSearchTantivy) SearchCrossSpace(spaceIds []string, query string) ([]*DocumentMatch, error) {
+	if len(spaceIds) == 0 {
 		return nil, nil
 	}
+	return f.performSearch(spaceIds, query, f.buildDetailedQuery)
+}
+
+// spaceIdsFilter returns nil for the empty space id, which means search in all spaces
+func spaceIdsFilter(spaceId string) []string {
+	if spaceId == "" {
+		return nil
+	}
+	return []string{spaceId}
+}
 
-	qb := tantivy.NewQueryBuilder()
-	if len(spaceId) != 0 {
-		qb.Query(tantivy.Must, fieldSpace, spaceId, tantivy.TermQuery, 1.0)
+func (f *ftSearchTantivy) performSearch(spaceIds []string, query string, buildQueryFunc func(*tantivy.QueryBuilder, searchQuery)) ([]*DocumentMatch, error) {
+	q := parseQuery(prepareQuery(query))
+	if q.isEmpty() {
+		return nil, nil
 	}
 
-	buildQueryFunc(qb, query)
+	qb := newSpacesQueryBuilder(spaceIds)
+	buildQueryFunc(qb, q)
+	for _, term := range q.excludedTerms() {
+		qb.BooleanQuery(tantivy.MustNot, buildTermQuery(qb, term), 1.0)
+	}
 
+	results, err := f.search(qb, 100, true)
+	if err != nil {
+		return nil, err
+	}
+	return f.filterExcludedObjects(spaceIds, q, results)
+}
+
+func newSpacesQueryBuilder(spaceIds []string) *tantivy.QueryBuilder {
+	qb := tantivy.NewQueryBuilder()
+	switch len(spaceIds) {
+	case 0:
+	case 1:
+		qb.Query(tantivy.Must, fieldSpace, spaceIds[0], tantivy.TermQuery, 1.0)
+	default:
+		spacesQb := qb.NestedBuilder()
+		for _, spaceId := range spaceIds {
+			spacesQb.Query(tantivy.Should, fieldSpace, spaceId, tantivy.TermQuery, 1.0)
+		}
+		qb.BooleanQuery(tantivy.Must, spacesQb, 1.0)
+	}
+	return qb
+}
+
+func (f *ftSearchTantivy) search(qb *tantivy.QueryBuilder, docsLimit uintptr, withHighlights bool) ([]*DocumentMatch, error) {
 	finalQuery := qb.Build()
 	sCtx := tantivy.NewSearchContextBuilder().
 		SetQueryFromJson(&finalQuery).
-		SetDocsLimit(100).
-		SetWithHighlights(true).
+		SetDocsLimit(docsLimit).
+		SetWithHighlights(withHighlights).
 		Build()
 
 	result, err := f.index.SearchJson(sCtx)
@@ -415,10 +493,53 @@ func (f *ftSearchTantivy) performSearch(spaceId, query string, buildQueryFunc fu
 			return parseSearchResult(json, p)
 		},
 		fieldId,
+		fieldSpace,
 	)
 }
 
-func (f *ftSearchTantivy) buildObjectQuery(qb *tantivy.QueryBuilder, query string) {
+// filterExcludedObjects removes matches of the objects that have the excluded terms in any of their blocks or relations.
+// The query itself excludes only the documents that contain the terms, and the object consists of many documents
+func (f *ftSearchTantivy) filterExcludedObjects(spaceIds []string, q searchQuery, results []*DocumentMatch) ([]*DocumentMatch, error) {
+	excludedTerms := q.excludedTerms()
+	if len(excludedTerms) == 0 || len(results) == 0 {
+		return results, nil
+	}
+
+	qb := newSpacesQueryBuilder(spaceIds)
+	excludedQb := qb.NestedBuilder()
+	for _, term := range excludedTerms {
+		excludedQb.BooleanQuery(tantivy.Should, buildTermQuery(qb, term), 1.0)
+	}
+	qb.BooleanQuery(tantivy.Must, excludedQb, 1.0)
+	excludedMatches, err := f.search(qb, docLimit, false)
+	if err != nil {
+		return nil, fmt.Errorf("search excluded terms: %w", err)
+	}
+	if len(excludedMatches) == 0 {
+		return results, nil
+	}
+
+	excludedObjects := make(map[string]struct{}, len(excludedMatches))
+	for _, match := range excludedMatches {
+		excludedObjects[objectIdFromDocId(match.ID)] = struct{}{}
+	}
+	filtered := results[:0]
+	for _, result := range results {
+		if _, ok := excludedObjects[objectIdFromDocId(result.ID)]; !ok {
+			filtered = append(filtered, result)
+		}
+	}
+	return filtered, nil
+}
+
+// objectIdFromDocId returns the object id part of the document id, see domain.ObjectPath
+func objectIdFromDocId(id string) string {
+	objectId, _, _ := strings.Cut(id, "/")
+	return objectId
+}
+
+func (f *ftSearchTantivy) buildObjectQuery(qb *tantivy.QueryBuilder, q searchQuery) {
+	query := q.positiveText()
 	qb.Query(tantivy.Must, fieldId, bundle.RelationKeyName.String(), tantivy.TermQuery, 1.0)
 	if containsChineseCharacters(query) {
 		qb.BooleanQuery(tantivy.Must, qb.NestedBuilder().
@@ -435,32 +556,102 @@ func (f *ftSearchTantivy) buildObjectQuery(qb *tantivy.QueryBuilder, query strin
 	}
 }
 
-func (f *ftSearchTantivy) buildDetailedQuery(qb *tantivy.QueryBuilder, query string) {
-	if containsChineseCharacters(query) {
-		qb.BooleanQuery(tantivy.Must, qb.NestedBuilder().
-			Query(tantivy.Should, fieldTitleZh, query, tantivy.PhrasePrefixQuery, 20.0).
-			Query(tantivy.Should, fieldTitleZh, query, tantivy.PhraseQuery, 20.0).
-			Query(tantivy.Should, fieldTitleZh, query, tantivy.EveryTermQuery, 0.75).
-			Query(tantivy.Should, fieldTitleZh, query, tantivy.OneOfTermQuery, 0.5).
-			Query(tantivy.Should, fieldTextZh, query, tantivy.PhrasePrefixQuery, 1.0).
-			Query(tantivy.Should, fieldTextZh, query, tantivy.PhraseQuery, 1.0).
-			Query(tantivy.Should, fieldTextZh, query, tantivy.EveryTermQuery, 0.5).
-			Query(tantivy.Should, fieldTextZh, query, tantivy.OneOfTermQuery, 0.25),
-			1.0,
-		)
+func (f *ftSearchTantivy) buildDetailedQuery(qb *tantivy.QueryBuilder, q searchQuery) {
+	if query := q.text; query != "" {
+		if containsChineseCharacters(query) {
+			qb.BooleanQuery(tantivy.Must, qb.NestedBuilder().
+				Query(tantivy.Should, fieldTitleZh, query, tantivy.PhrasePrefixQuery, 20.0).
+				Query(tantivy.Should, fieldTitleZh, query, tantivy.PhraseQuery, 20.0).
+				Query(tantivy.Should, fieldTitleZh, query, tantivy.EveryTermQuery, 0.75).
+				Query(tantivy.Should, fieldTitleZh, query, tantivy.OneOfTermQuery, 0.5).
+				Query(tantivy.Should, fieldTextZh, query, tantivy.PhrasePrefixQuery, 1.0).
+				Query(tantivy.Should, fieldTextZh, query, tantivy.PhraseQuery, 1.0).
+				Query(tantivy.Should, fieldTextZh, query, tantivy.EveryTermQuery, 0.5).
+				Query(tantivy.Should, fieldTextZh, query, tantivy.OneOfTermQuery, 0.25),
+				1.0,
+			)
+		} else {
+			textQb := qb.NestedBuilder().
+				Query(tantivy.Should, fieldTitle, query, tantivy.PhrasePrefixQuery, 20.0).
+				Query(tantivy.Should, fieldTitle, query, tantivy.PhraseQuery, 20.0).
+				Query(tantivy.Should, fieldTitle, query, tantivy.EveryTermQuery, 0.75).
+				Query(tantivy.Should, fieldTitle, query, tantivy.OneOfTermQuery, 0.5).
+				Query(tantivy.Should, fieldText, query, tantivy.PhrasePrefixQuery, 1.0).
+				Query(tantivy.Should, fieldText, query, tantivy.PhraseQuery, 1.0).
+				Query(tantivy.Should, fieldText, query, tantivy.EveryTermQuery, 0.5).
+				Query(tantivy.Should, fieldText, query, tantivy.OneOfTermQuery, 0.25)
+			// stemmed fields match other forms of the words in the documents of the detected language
+			for _, lang := range queryLanguages(query) {
+				textQb.
+					Query(tantivy.Should, fieldTitleLang(lang), query, tantivy.EveryTermQuery, 0.75).
+					Query(tantivy.Should, fieldTextLang(lang), query, tantivy.EveryTermQuery, 0.5)
+			}
+			qb.BooleanQuery(tantivy.Must, textQb, 1.0)
+		}
+	}
+
+	for _, term := range q.terms {
+		if !term.exclude {
+			qb.BooleanQuery(tantivy.Must, buildTermQuery(qb, term), 1.0)
+		}
+	}
+}
+
+type weightedField struct {
+	name  string
+	boost float64
+}
+
+// termFields returns the fields to search the term in, according to its scope and language
+func termFields(term queryTerm) []weightedField {
+	var titles, texts []string
+	if containsChineseCharacters(term.text) {
+		titles, texts = []string{fieldTitleZh}, []string{fieldTextZh}
 	} else {
-		qb.BooleanQuery(tantivy.Must, qb.NestedBuilder().
-			Query(tantivy.Should, fieldTitle, query, tantivy.PhrasePrefixQuery, 20.0).
-			Query(tantivy.Should, fieldTitle, query, tantivy.PhraseQuery, 20.0).
-			Query(tantivy.Should, fieldTitle, query, tantivy.EveryTermQuery, 0.75).
-			Query(tantivy.Should, fieldTitle, query, tantivy.OneOfTermQuery, 0.5).
-			Query(tantivy.Should, fieldText, query, tantivy.PhrasePrefixQuery, 1.0).
-			Query(tantivy.Should, fieldText, query, tantivy.PhraseQuery, 1.0).
-			Query(tantivy.Should, fieldText, query, tantivy.EveryTermQuery, 0.5).
-			Query(tantivy.Should, fieldText, query, tantivy.OneOfTermQuery, 0.25),
-			1.0,
-		)
+		titles, texts = []string{fieldTitle}, []string{fieldText}
+		for _, lang := range queryLanguages(term.text) {
+			titles = append(titles, fieldTitleLang(lang))
+			texts = append(texts, fieldTextLang(lang))
+		}
+	}
+
+	var fields []weightedField
+	if term.scope != queryScopeText {
+		for _, name := range titles {
+			fields = append(fields, weightedField{name: name, boost: 2.0})
+		}
+	}
+	if term.scope != queryScopeTitle {
+		for _, name := range texts {
+			fields = append(fields, weightedField{name: name, boost: 1.0})
+		}
+	}
+	return fields
+}
+
+// buildTermQuery builds the query matching the term in any of its fields. Fuzzy terms are approximated
+// with the words within the edit distance and the prefix query, see fuzzyVariants
+func buildTermQuery(qb *tantivy.QueryBuilder, term queryTerm) *tantivy.QueryBuilder {
+	termQb := qb.NestedBuilder()
+	var variants, prefix string
+	if term.fuzzy > 0 {
+		variants = strings.Join(fuzzyVariants(term.text, term.fuzzy), " ")
+		prefix = fuzzyPrefix(term.text, term.fuzzy)
+	}
+	for _, field := range termFields(term) {
+		if term.phrase {
+			termQb.Query(tantivy.Should, field.name, term.text, tantivy.PhraseQuery, field.boost)
+			continue
+		}
+		termQb.Query(tantivy.Should, field.name, term.text, tantivy.EveryTermQuery, field.boost)
+		if variants != "" {
+			termQb.Query(tantivy.Should, field.name, variants, tantivy.OneOfTermQuery, field.boost/2)
+		}
+		if prefix != "" {
+			termQb.Query(tantivy.Should, field.name, prefix, tantivy.TermPrefixQuery, field.boost/4)
+		}
 	}
+	return termQb
 }
 
 func parseSearchResult(json string, parser *fastjson.Parser) (*DocumentMatch, error) {
@@ -476,28 +667,43 @@ func parseSearchResult(json string, parser *fastjson.Parser) (*DocumentMatch, er
 		object := val.GetObject()
 		fieldName := string(object.Get(fieldNameTxt).GetStringBytes())
 
-		if fieldName == fieldTitle || fieldName == fieldTitleZh {
+		if isTitleField(fieldName) {
 			fragments = map[string]*Highlight{}
 			break
 		}
 
-		if fieldName == fieldText || fieldName == fieldTextZh {
+		if isTextField(fieldName) {
 			extractHighlight(object, fragments, fieldName)
 		}
 	}
 
-	if len(fragments) == 2 {
-		// Remove Chinese highlights if non-Chinese highlights are present
+	if _, ok := fragments[fieldText]; ok {
+		// Remove Chinese and stemmed highlights if the default highlights are present
+		for fieldName := range fragments {
+			if fieldName != fieldText {
+				delete(fragments, fieldName)
+			}
+		}
+	} else if len(fragments) > 1 {
 		delete(fragments, fieldTextZh)
 	}
 
 	return &DocumentMatch{
 		Score:     value.GetFloat64(score),
 		ID:        string(value.GetStringBytes(fieldId)),
+		SpaceId:   string(value.GetStringBytes(fieldSpace)),
 		Fragments: fragments,
 	}, nil
 }
 
+func isTitleField(fieldName string) bool {
+	return fieldName == fieldTitle || fieldName == fieldTitleZh || strings.HasPrefix(fieldName, fieldTitle+"_")
+}
+
+func isTextField(fieldName string) bool {
+	return fieldName == fieldText || fieldName == fieldTextZh || strings.HasPrefix(fieldName, fieldText+"_")
+}
+
 func containsChineseCharacters(s string) bool {
 	for _, r := range s {
 		if unicode.Is(unicode.Han, r) {
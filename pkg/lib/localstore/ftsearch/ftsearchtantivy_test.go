@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
 	"testing"
 
 	"github.com/anyproto/any-sync/app"
@@ -90,6 +91,30 @@ func TestDifferentSpaces(t *testing.T) {
 	_ = ft.Close(nil)
 }
 
+func TestSearchCrossSpace(t *testing.T) {
+	tmpDir, _ := os.MkdirTemp("", "")
+	fixture := newFixture(tmpDir, t)
+	ft := fixture.ft
+	for i, spaceId := range []string{"space1", "space2", "space3"} {
+		require.NoError(t, ft.Index(SearchDoc{
+			Id:      strconv.Itoa(i),
+			Title:   "one",
+			SpaceId: spaceId,
+		}))
+	}
+
+	search, err := ft.SearchCrossSpace([]string{"space1", "space3"}, "one")
+	require.NoError(t, err)
+	require.Len(t, search, 2)
+	assert.ElementsMatch(t, []string{"space1", "space3"}, []string{search[0].SpaceId, search[1].SpaceId})
+
+	search, err = ft.SearchCrossSpace(nil, "one")
+	require.NoError(t, err)
+	require.Empty(t, search)
+
+	_ = ft.Close(nil)
+}
+
 func TestNamePrefixSearch(t *testing.T) {
 	tmpDir, _ := os.MkdirTemp("", "")
 	fixture := newFixture(tmpDir, t)
@@ -155,6 +180,10 @@ func TestNewFTSearch(t *testing.T) {
 			name:   "assertMultiSpace",
 			tester: assertMultiSpace,
 		},
+		{
+			name:   "assertQueryLanguage",
+			tester: assertQueryLanguage,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -327,6 +356,40 @@ func assertSearch(t *testing.T, tmpDir string) {
 	_ = ft.Close(nil)
 }
 
+func assertQueryLanguage(t *testing.T, tmpDir string) {
+	fixture := newFixture(tmpDir, t)
+	ft := fixture.ft
+	require.NoError(t, ft.Index(SearchDoc{
+		Id:    "1/r/name",
+		Title: "Weekly report",
+	}))
+	require.NoError(t, ft.Index(SearchDoc{
+		Id:   "1/b/1",
+		Text: "Draft of the quarter results",
+	}))
+	require.NoError(t, ft.Index(SearchDoc{
+		Id:   "2/b/1",
+		Text: "Final quarter results of the year",
+	}))
+	require.NoError(t, ft.Index(SearchDoc{
+		Id:   "3/b/1",
+		Text: "Die Häuser sind nicht alt und die Straßen sind breit",
+	}))
+
+	validateSearch(t, ft, "", `"quarter results"`, 2)
+	validateSearch(t, ft, "", `"results quarter"`, 0)
+	validateSearch(t, ft, "", "results -draft", 1)
+	validateSearch(t, ft, "", "quarter -title:weekly", 1)
+	validateSearch(t, ft, "", "title:report", 1)
+	validateSearch(t, ft, "", "text:report", 0)
+	validateSearch(t, ft, "", "quartr~", 2)
+	validateSearch(t, ft, "", "qaurter~", 2)
+	validateSearch(t, ft, "", "haus", 1)
+	validateSearch(t, ft, "", "-draft", 0)
+
+	_ = ft.Close(nil)
+}
+
 func validateSearch(t *testing.T, ft FTSearch, spaceID, qry string, times int) {
 	res, err := ft.Search(spaceID, qry)
 	require.NoError(t, err)
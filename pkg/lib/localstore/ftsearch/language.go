@@ -0,0 +1,177 @@
+package ftsearch
+
+import (
+	"strings"
+	"unicode"
+
+	tantivy "github.com/anyproto/tantivy-go"
+)
+
+const (
+	languageDetectMinWords     = 3
+	languageDetectMinStopwords = 2
+	languageDetectMaxWords     = 200
+)
+
+// stemmedLanguages are the languages that have their own title and text fields with the language-specific stemmer.
+// English is the default language of fieldTitle and fieldText, Chinese is handled by fieldTitleZh and fieldTextZh
+var stemmedLanguages = []string{
+	tantivy.Arabic,
+	tantivy.Danish,
+	tantivy.Dutch,
+	tantivy.Finnish,
+	tantivy.French,
+	tantivy.German,
+	tantivy.Greek,
+	tantivy.Hungarian,
+	tantivy.Italian,
+	tantivy.Norwegian,
+	tantivy.Portuguese,
+	tantivy.Romanian,
+	tantivy.Russian,
+	tantivy.Spanish,
+	tantivy.Swedish,
+	tantivy.Tamil,
+	tantivy.Turkish,
+}
+
+// scriptLanguages are detected by the script of the text
+var scriptLanguages = []struct {
+	script *unicode.RangeTable
+	lang   string
+}{
+	{unicode.Cyrillic, tantivy.Russian},
+	{unicode.Greek, tantivy.Greek},
+	{unicode.Arabic, tantivy.Arabic},
+	{unicode.Tamil, tantivy.Tamil},
+}
+
+// latinStopwords are the most frequent words of the languages using the latin script.
+// Words that are common for several languages are mostly left out, so they don't affect the detection
+var latinStopwords = map[string][]string{
+	tantivy.English:    {"the", "and", "is", "are", "was", "of", "to", "with", "that", "this", "for", "it", "you", "have", "not"},
+	tantivy.Danish:     {"og", "af", "ikke", "jeg", "hvad", "mig", "til", "der", "fra", "har", "vil", "være", "bliver", "efter"},
+	tantivy.Dutch:      {"het", "een", "en", "van", "niet", "zijn", "ook", "voor", "met", "maar", "wordt", "dat", "naar", "bij"},
+	tantivy.Finnish:    {"ja", "on", "ei", "että", "se", "hän", "oli", "kuin", "mutta", "tämä", "ovat", "myös", "kanssa"},
+	tantivy.French:     {"le", "la", "les", "et", "est", "une", "des", "du", "pour", "dans", "qui", "pas", "sur", "avec", "sont"},
+	tantivy.German:     {"der", "die", "und", "ist", "nicht", "ein", "eine", "das", "mit", "sich", "auf", "für", "auch", "wird", "sind"},
+	tantivy.Hungarian:  {"az", "és", "hogy", "nem", "egy", "meg", "van", "ez", "azt", "volt", "mint", "csak", "vagy", "már"},
+	tantivy.Italian:    {"il", "di", "che", "è", "gli", "della", "sono", "per", "non", "una", "nel", "anche", "questo", "essere"},
+	tantivy.Norwegian:  {"og", "av", "ikke", "jeg", "hva", "meg", "til", "det", "som", "har", "skal", "være", "blir", "etter"},
+	tantivy.Portuguese: {"o", "os", "não", "uma", "do", "da", "em", "é", "que", "com", "para", "mais", "também", "são", "está"},
+	tantivy.Romanian:   {"și", "în", "este", "nu", "pe", "cu", "că", "care", "sunt", "mai", "fost", "pentru", "acest"},
+	tantivy.Spanish:    {"el", "los", "las", "y", "es", "una", "del", "por", "que", "con", "para", "está", "pero", "más", "son"},
+	tantivy.Swedish:    {"och", "att", "är", "inte", "som", "det", "en", "på", "med", "för", "jag", "har", "till", "också"},
+	tantivy.Turkish:    {"ve", "bir", "bu", "için", "ile", "çok", "daha", "olarak", "gibi", "değil", "ama", "olan", "kadar"},
+}
+
+var stopwordLanguages = func() map[string][]string {
+	m := make(map[string][]string)
+	for lang, words := range latinStopwords {
+		for _, word := range words {
+			m[word] = append(m[word], lang)
+		}
+	}
+	return m
+}()
+
+// detectLanguage returns the language of the text, supported by the stemmer, or an empty string
+// if the text is too short, Chinese or the language can't be detected
+func detectLanguage(text string) string {
+	scriptCounts := make([]int, len(scriptLanguages))
+	var letters, latin int
+	for _, r := range text {
+		if !unicode.IsLetter(r) {
+			continue
+		}
+		letters++
+		if unicode.Is(unicode.Latin, r) {
+			latin++
+			continue
+		}
+		for i, sl := range scriptLanguages {
+			if unicode.Is(sl.script, r) {
+				scriptCounts[i]++
+				break
+			}
+		}
+	}
+	if letters == 0 {
+		return ""
+	}
+	for i, count := range scriptCounts {
+		if count*2 > letters {
+			return scriptLanguages[i].lang
+		}
+	}
+	if latin*2 <= letters {
+		return ""
+	}
+	return detectLatinLanguage(text)
+}
+
+func detectLatinLanguage(text string) string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	if len(words) < languageDetectMinWords {
+		return ""
+	}
+	if len(words) > languageDetectMaxWords {
+		words = words[:languageDetectMaxWords]
+	}
+	scores := make(map[string]int)
+	for _, word := range words {
+		for _, lang := range stopwordLanguages[word] {
+			scores[lang]++
+		}
+	}
+	var best, second int
+	var bestLang string
+	for lang, score := range scores {
+		switch {
+		case score > best:
+			best, second, bestLang = score, best, lang
+		case score > second:
+			second = score
+		}
+	}
+	if best < languageDetectMinStopwords || best == second {
+		return ""
+	}
+	return bestLang
+}
+
+// queryLanguages returns the languages which fields should be queried. The query is usually too short to detect
+// the language by words, so all languages with the same script are used
+func queryLanguages(query string) []string {
+	for _, r := range query {
+		if !unicode.IsLetter(r) || unicode.Is(unicode.Latin, r) {
+			continue
+		}
+		for _, sl := range scriptLanguages {
+			if unicode.Is(sl.script, r) {
+				return []string{sl.lang}
+			}
+		}
+	}
+	langs := make([]string, 0, len(stemmedLanguages))
+	for _, lang := range stemmedLanguages {
+		if _, ok := latinStopwords[lang]; ok {
+			langs = append(langs, lang)
+		}
+	}
+	return langs
+}
+
+func fieldTitleLang(lang string) string {
+	return fieldTitle + "_" + lang
+}
+
+func fieldTextLang(lang string) string {
+	return fieldText + "_" + lang
+}
+
+func tokenizerLang(lang string) string {
+	return tantivy.TokenizerSimple + "_" + lang
+}
@@ -0,0 +1,37 @@
+package ftsearch
+
+import (
+	"testing"
+
+	tantivy "github.com/anyproto/tantivy-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectLanguage(t *testing.T) {
+	for _, tc := range []struct {
+		text     string
+		expected string
+	}{
+		{"The quick brown fox jumps over the lazy dog and it was not tired", tantivy.English},
+		{"Der Hund ist nicht müde und die Katze schläft auf dem Sofa", tantivy.German},
+		{"Le chat est sur la table et les enfants sont dans le jardin", tantivy.French},
+		{"El perro está en la casa y los niños juegan con una pelota", tantivy.Spanish},
+		{"Собака спит на диване", tantivy.Russian},
+		{"Ο σκύλος κοιμάται", tantivy.Greek},
+		{"短文本", ""},
+		{"Hello world", ""},
+		{"12345", ""},
+	} {
+		t.Run(tc.text, func(t *testing.T) {
+			assert.Equal(t, tc.expected, detectLanguage(tc.text))
+		})
+	}
+}
+
+func TestQueryLanguages(t *testing.T) {
+	assert.Equal(t, []string{tantivy.Russian}, queryLanguages("собаки"))
+	langs := queryLanguages("hunde")
+	assert.Contains(t, langs, tantivy.German)
+	assert.NotContains(t, langs, tantivy.Russian)
+	assert.NotContains(t, langs, tantivy.English)
+}
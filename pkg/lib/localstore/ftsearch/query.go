@@ -0,0 +1,210 @@
+package ftsearch
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	maxFuzzyDistance     = 2
+	fuzzyWordMaxLen      = 20
+	fuzzyVariantsLimit   = 256
+	fuzzyPrefixMinLength = 3
+)
+
+type queryScope int
+
+const (
+	queryScopeAll queryScope = iota
+	queryScopeTitle
+	queryScopeText
+)
+
+var queryScopes = map[string]queryScope{
+	"title": queryScopeTitle,
+	"text":  queryScopeText,
+}
+
+// queryTerm is a part of the query that uses the query language:
+// "exact phrase", -exclusion, title:scoped and fuzzy~1 terms
+type queryTerm struct {
+	text    string
+	scope   queryScope
+	phrase  bool
+	exclude bool
+	// fuzzy is the max edit distance of the term, 0 means exact match
+	fuzzy int
+}
+
+type searchQuery struct {
+	// text contains the plain words of the query, they are searched the same way as a query without any operators
+	text  string
+	terms []queryTerm
+}
+
+func (q searchQuery) isEmpty() bool {
+	if q.text != "" {
+		return false
+	}
+	for _, term := range q.terms {
+		if !term.exclude {
+			return false
+		}
+	}
+	return true
+}
+
+func (q searchQuery) excludedTerms() []queryTerm {
+	var terms []queryTerm
+	for _, term := range q.terms {
+		if term.exclude {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// positiveText returns the text of the query without operators and excluded terms
+func (q searchQuery) positiveText() string {
+	if len(q.terms) == 0 {
+		return q.text
+	}
+	words := make([]string, 0, len(q.terms)+1)
+	if q.text != "" {
+		words = append(words, q.text)
+	}
+	for _, term := range q.terms {
+		if !term.exclude {
+			words = append(words, term.text)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// parseQuery parses the query language supported by the full-text search:
+//   - "exact phrase" matches words in the given order
+//   - -word or -"phrase" excludes objects containing them
+//   - title:word or text:"phrase" limits the term to the object title or the content
+//   - word~ or word~2 matches words within the given edit distance (1 by default)
+//
+// Everything else is treated as plain text. Unknown prefixes like "http:" are kept as is
+func parseQuery(query string) searchQuery {
+	var (
+		q     searchQuery
+		plain []string
+		rest  = strings.TrimSpace(query)
+	)
+	for rest != "" {
+		var term queryTerm
+		token := rest
+		if strings.HasPrefix(token, "-") && len(token) > 1 && !unicode.IsSpace(rune(token[1])) {
+			term.exclude = true
+			token = token[1:]
+		}
+		if name, after, ok := strings.Cut(token, ":"); ok && !strings.ContainsFunc(name, unicode.IsSpace) {
+			if scope, known := queryScopes[strings.ToLower(name)]; known && after != "" && !unicode.IsSpace(rune(after[0])) {
+				term.scope = scope
+				token = after
+			}
+		}
+
+		var word string
+		if strings.HasPrefix(token, `"`) {
+			phrase, after, _ := strings.Cut(token[1:], `"`)
+			term.text, term.phrase, rest = strings.TrimSpace(phrase), true, after
+		} else {
+			word, rest = cutWord(token)
+			term.text, term.fuzzy = parseFuzzy(word)
+		}
+		rest = strings.TrimSpace(rest)
+		if term.text == "" {
+			continue
+		}
+
+		if !term.exclude && !term.phrase && term.scope == queryScopeAll && term.fuzzy == 0 {
+			// keep the original word, so the special characters are searched as before
+			plain = append(plain, word)
+			continue
+		}
+		q.terms = append(q.terms, term)
+	}
+	q.text = strings.Join(plain, " ")
+	return q
+}
+
+func cutWord(s string) (word, rest string) {
+	if i := strings.IndexFunc(s, unicode.IsSpace); i >= 0 {
+		return s[:i], s[i:]
+	}
+	return s, ""
+}
+
+// parseFuzzy cuts the fuzzy operator from the end of the word and returns the edit distance
+func parseFuzzy(word string) (string, int) {
+	i := strings.LastIndexByte(word, '~')
+	if i <= 0 {
+		return word, 0
+	}
+	switch suffix := word[i+1:]; {
+	case suffix == "":
+		return word[:i], 1
+	case len(suffix) == 1 && suffix[0] >= '0' && suffix[0] <= '9':
+		return word[:i], min(int(suffix[0]-'0'), maxFuzzyDistance)
+	default:
+		return word, 0
+	}
+}
+
+// fuzzyVariants returns the words within the given edit distance that can be produced by deleting or transposing
+// characters. Tantivy bindings don't provide fuzzy queries, so other edits are covered by the prefix query,
+// see fuzzyPrefix
+func fuzzyVariants(word string, distance int) []string {
+	if utf8.RuneCountInString(word) > fuzzyWordMaxLen {
+		return nil
+	}
+	seen := map[string]struct{}{word: {}}
+	var variants []string
+	current := []string{word}
+	for d := 0; d < distance; d++ {
+		var next []string
+		for _, w := range current {
+			runes := []rune(w)
+			for i := range runes {
+				next = append(next, string(runes[:i])+string(runes[i+1:]))
+				if i+1 < len(runes) && runes[i] != runes[i+1] {
+					transposed := append([]rune{}, runes...)
+					transposed[i], transposed[i+1] = transposed[i+1], transposed[i]
+					next = append(next, string(transposed))
+				}
+			}
+		}
+		current = current[:0]
+		for _, variant := range next {
+			if _, ok := seen[variant]; ok || variant == "" {
+				continue
+			}
+			seen[variant] = struct{}{}
+			variants = append(variants, variant)
+			current = append(current, variant)
+			if len(variants) >= fuzzyVariantsLimit {
+				return variants
+			}
+		}
+	}
+	return variants
+}
+
+// fuzzyPrefix returns the prefix of the word that is not affected by the edits at the end of the word
+func fuzzyPrefix(word string, distance int) string {
+	runes := []rune(word)
+	if len(runes)-distance < fuzzyPrefixMinLength {
+		return ""
+	}
+	return string(runes[:len(runes)-distance])
+}
+
+// QueryHighlightText returns the words of the query that should be highlighted in the results
+func QueryHighlightText(query string) string {
+	return parseQuery(prepareQuery(query)).positiveText()
+}
@@ -0,0 +1,82 @@
+package ftsearch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseQuery(t *testing.T) {
+	for _, tc := range []struct {
+		query    string
+		expected searchQuery
+	}{
+		{
+			query:    "plain words",
+			expected: searchQuery{text: "plain words"},
+		},
+		{
+			query: `find "exact phrase" here`,
+			expected: searchQuery{text: "find here", terms: []queryTerm{
+				{text: "exact phrase", phrase: true},
+			}},
+		},
+		{
+			query: `notes -draft -"work in progress"`,
+			expected: searchQuery{text: "notes", terms: []queryTerm{
+				{text: "draft", exclude: true},
+				{text: "work in progress", phrase: true, exclude: true},
+			}},
+		},
+		{
+			query: `Title:report text:"quarter results" -title:old`,
+			expected: searchQuery{terms: []queryTerm{
+				{text: "report", scope: queryScopeTitle},
+				{text: "quarter results", scope: queryScopeText, phrase: true},
+				{text: "old", scope: queryScopeTitle, exclude: true},
+			}},
+		},
+		{
+			query: "helo~ wrld~2 mistake~5",
+			expected: searchQuery{terms: []queryTerm{
+				{text: "helo", fuzzy: 1},
+				{text: "wrld", fuzzy: 2},
+				{text: "mistake", fuzzy: 2},
+			}},
+		},
+		{
+			query:    "https://anytype.io note: a - b ~ c~d",
+			expected: searchQuery{text: "https://anytype.io note: a - b ~ c~d"},
+		},
+		{
+			query:    `unclosed "phrase`,
+			expected: searchQuery{text: "unclosed", terms: []queryTerm{{text: "phrase", phrase: true}}},
+		},
+	} {
+		t.Run(tc.query, func(t *testing.T) {
+			assert.Equal(t, tc.expected, parseQuery(tc.query))
+		})
+	}
+}
+
+func TestSearchQuery_IsEmpty(t *testing.T) {
+	assert.True(t, parseQuery(`-draft -"old notes" ""`).isEmpty())
+	assert.False(t, parseQuery(`-draft title:new`).isEmpty())
+}
+
+func TestQueryHighlightText(t *testing.T) {
+	assert.Equal(t, "find exact phrase report", QueryHighlightText(`Find "exact phrase" -draft title:report`))
+}
+
+func TestFuzzyVariants(t *testing.T) {
+	assert.ElementsMatch(t, []string{"ab", "ac", "bc", "bac", "acb"}, fuzzyVariants("abc", 1))
+	assert.Contains(t, fuzzyVariants("hello", 2), "hlo")
+	assert.NotContains(t, fuzzyVariants("hello", 2), "hello")
+	assert.Len(t, fuzzyVariants("abcdefghijklmnopqrst", 2), fuzzyVariantsLimit)
+	assert.Empty(t, fuzzyVariants("abcdefghijklmnopqrstu", 1))
+}
+
+func TestFuzzyPrefix(t *testing.T) {
+	assert.Equal(t, "hel", fuzzyPrefix("hello", 2))
+	assert.Equal(t, "", fuzzyPrefix("helo", 2))
+}
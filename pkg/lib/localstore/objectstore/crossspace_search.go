@@ -0,0 +1,126 @@
+package objectstore
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+// ListAvailableSpaceIds returns spaces that are neither deleted nor hidden and in which the participant with given identity
+// still has access to the objects
+func (s *dsObjectStore) ListAvailableSpaceIds(identity string) ([]string, error) {
+	records, err := s.SpaceIndex(s.techSpaceId).Query(database.Query{
+		Filters: []database.FilterRequest{
+			{
+				RelationKey: bundle.RelationKeyLayout,
+				Condition:   model.BlockContentDataviewFilter_Equal,
+				Value:       domain.Int64(int64(model.ObjectType_spaceView)),
+			},
+			{
+				RelationKey: bundle.RelationKeySpaceAccountStatus,
+				Condition:   model.BlockContentDataviewFilter_NotIn,
+				Value: domain.Int64List([]model.AccountStatusType{
+					model.Account_PendingDeletion,
+					model.Account_StartedDeletion,
+					model.Account_Deleted,
+				}),
+			},
+			{
+				RelationKey: bundle.RelationKeySpaceLocalStatus,
+				Condition:   model.BlockContentDataviewFilter_NotIn,
+				Value: domain.Int64List([]model.SpaceStatus{
+					model.SpaceStatus_SpaceRemoving,
+					model.SpaceStatus_SpaceDeleted,
+					model.SpaceStatus_RemoteDeleted,
+				}),
+			},
+			{
+				RelationKey: bundle.RelationKeyIsHidden,
+				Condition:   model.BlockContentDataviewFilter_NotEqual,
+				Value:       domain.Bool(true),
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("query space views: %w", err)
+	}
+	spaceIds := make([]string, 0, len(records))
+	for _, rec := range records {
+		spaceId := rec.Details.GetString(bundle.RelationKeyTargetSpaceId)
+		if spaceId == "" || !s.hasAccessToSpace(spaceId, identity) {
+			continue
+		}
+		spaceIds = append(spaceIds, spaceId)
+	}
+	return spaceIds, nil
+}
+
+// hasAccessToSpace checks the participant of the space. Participant may be not indexed yet, e.g. right after joining
+// the space, so only the participants that have explicitly lost the access are filtered out
+func (s *dsObjectStore) hasAccessToSpace(spaceId, identity string) bool {
+	details, err := s.SpaceIndex(spaceId).GetDetails(domain.NewParticipantId(spaceId, identity))
+	if err != nil || details.Len() == 0 {
+		return true
+	}
+	switch model.ParticipantStatus(details.GetInt64(bundle.RelationKeyParticipantStatus)) {
+	case model.ParticipantStatus_Removed, model.ParticipantStatus_Declined, model.ParticipantStatus_Canceled:
+		return false
+	}
+	return model.ParticipantPermissions(details.GetInt64(bundle.RelationKeyParticipantPermissions)) != model.ParticipantPermissions_NoPermissions
+}
+
+// SearchCrossSpace performs full-text search of q.TextQuery in the given spaces. Results of all spaces are ranked by score
+// together, then offset and limit of the query are applied. Sorts of the query are ignored
+func (s *dsObjectStore) SearchCrossSpace(spaceIds []string, q database.Query) ([]database.Record, error) {
+	q.TextQuery = strings.TrimSpace(q.TextQuery)
+	if q.TextQuery == "" {
+		return nil, fmt.Errorf("text query is empty")
+	}
+	matches, err := s.fts.SearchCrossSpace(spaceIds, q.TextQuery)
+	if err != nil {
+		return nil, fmt.Errorf("fulltext search: %w", err)
+	}
+	matchesBySpace := make(map[string][]*ftsearch.DocumentMatch, len(spaceIds))
+	for _, match := range matches {
+		matchesBySpace[match.SpaceId] = append(matchesBySpace[match.SpaceId], match)
+	}
+
+	spaceQuery := q
+	spaceQuery.Sorts = nil
+	spaceQuery.Limit, spaceQuery.Offset = 0, 0
+	var records []database.Record
+	for _, spaceId := range spaceIds {
+		spaceMatches := matchesBySpace[spaceId]
+		if len(spaceMatches) == 0 {
+			continue
+		}
+		spaceQuery.SpaceId = spaceId
+		spaceRecords, err := s.SpaceIndex(spaceId).QueryFromFulltextMatches(spaceQuery, spaceMatches)
+		if err != nil {
+			return nil, fmt.Errorf("query space %s: %w", spaceId, err)
+		}
+		records = append(records, spaceRecords...)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Details.GetFloat64(database.RecordScoreField) > records[j].Details.GetFloat64(database.RecordScoreField)
+	})
+	return paginateRecords(records, q.Offset, q.Limit), nil
+}
+
+func paginateRecords(records []database.Record, offset, limit int) []database.Record {
+	if offset >= len(records) {
+		return nil
+	}
+	records = records[offset:]
+	if limit > 0 && limit < len(records) {
+		records = records[:limit]
+	}
+	return records
+}
@@ -0,0 +1,59 @@
+package objectstore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anyproto/anytype-heart/core/domain"
+	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
+)
+
+func TestListAvailableSpaceIds(t *testing.T) {
+	const identity = "identity"
+	s := NewStoreFixture(t)
+	ctx := context.Background()
+
+	addSpaceView := func(spaceId string, details map[domain.RelationKey]domain.Value) {
+		details[bundle.RelationKeyId] = domain.String("view-" + spaceId)
+		details[bundle.RelationKeyLayout] = domain.Int64(int64(model.ObjectType_spaceView))
+		details[bundle.RelationKeyTargetSpaceId] = domain.String(spaceId)
+		err := s.SpaceIndex(s.techSpaceId).UpdateObjectDetails(ctx, "view-"+spaceId, domain.NewDetailsFromMap(details))
+		require.NoError(t, err)
+	}
+	addParticipant := func(spaceId string, status model.ParticipantStatus, permissions model.ParticipantPermissions) {
+		id := domain.NewParticipantId(spaceId, identity)
+		err := s.SpaceIndex(spaceId).UpdateObjectDetails(ctx, id, domain.NewDetailsFromMap(map[domain.RelationKey]domain.Value{
+			bundle.RelationKeyId:                     domain.String(id),
+			bundle.RelationKeyParticipantStatus:      domain.Int64(int64(status)),
+			bundle.RelationKeyParticipantPermissions: domain.Int64(int64(permissions)),
+		}))
+		require.NoError(t, err)
+	}
+
+	addSpaceView("active", map[domain.RelationKey]domain.Value{
+		bundle.RelationKeySpaceLocalStatus: domain.Int64(int64(model.SpaceStatus_Ok)),
+	})
+	addParticipant("active", model.ParticipantStatus_Active, model.ParticipantPermissions_Reader)
+	addSpaceView("notIndexedParticipant", map[domain.RelationKey]domain.Value{})
+	addSpaceView("hidden", map[domain.RelationKey]domain.Value{
+		bundle.RelationKeyIsHidden: domain.Bool(true),
+	})
+	addSpaceView("deleted", map[domain.RelationKey]domain.Value{
+		bundle.RelationKeySpaceAccountStatus: domain.Int64(int64(model.Account_Deleted)),
+	})
+	addSpaceView("removing", map[domain.RelationKey]domain.Value{
+		bundle.RelationKeySpaceLocalStatus: domain.Int64(int64(model.SpaceStatus_SpaceRemoving)),
+	})
+	addSpaceView("removed", map[domain.RelationKey]domain.Value{})
+	addParticipant("removed", model.ParticipantStatus_Removed, model.ParticipantPermissions_Reader)
+	addSpaceView("noPermissions", map[domain.RelationKey]domain.Value{})
+	addParticipant("noPermissions", model.ParticipantStatus_Active, model.ParticipantPermissions_NoPermissions)
+
+	spaceIds, err := s.ListAvailableSpaceIds(identity)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"active", "notIndexedParticipant"}, spaceIds)
+}
@@ -115,3 +115,32 @@ func (s *dsObjectStore) SaveChecksums(spaceId string, checksums *model.ObjectSto
 	err = s.indexerChecksums.UpsertOne(s.componentCtx, it)
 	return err
 }
+
+func (s *dsObjectStore) SaveFileContentIndexed(fileId string, objectId string) error {
+	arena := s.arenaPool.Get()
+	defer func() {
+		arena.Reset()
+		s.arenaPool.Put(arena)
+	}()
+
+	obj := arena.NewObject()
+	obj.Set("id", arena.NewString(fileId))
+	obj.Set("objectId", arena.NewString(objectId))
+	return s.fulltextFiles.UpsertOne(s.componentCtx, obj)
+}
+
+func (s *dsObjectStore) IsFileContentIndexed(fileId string, objectId string) (bool, error) {
+	doc, err := s.fulltextFiles.FindId(s.componentCtx, fileId)
+	if errors.Is(err, anystore.ErrDocNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("find file: %w", err)
+	}
+	return string(doc.Value().GetStringBytes("objectId")) == objectId, nil
+}
+
+func (s *dsObjectStore) ClearFileContentIndexed() error {
+	_, err := s.fulltextFiles.Find(nil).Delete(s.componentCtx)
+	return err
+}
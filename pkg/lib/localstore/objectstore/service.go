@@ -37,6 +37,10 @@ type CrossSpace interface {
 	QueryByIdCrossSpace(ids []string) (records []database.Record, err error)
 
 	ListIdsCrossSpace() ([]string, error)
+	// SearchCrossSpace performs full-text search in the given spaces and ranks results of all spaces together
+	SearchCrossSpace(spaceIds []string, q database.Query) (records []database.Record, err error)
+	// ListAvailableSpaceIds returns ids of spaces that are not deleted or hidden and still accessible by the identity
+	ListAvailableSpaceIds(identity string) ([]string, error)
 	BatchProcessFullTextQueue(ctx context.Context, limit int, processIds func(processIds []string) error) error
 
 	AccountStore
@@ -64,6 +68,13 @@ type IndexerStore interface {
 	GetChecksums(spaceID string) (checksums *model.ObjectStoreChecksums, err error)
 	// SaveChecksums Used to save checksums and force reindex counter
 	SaveChecksums(spaceID string, checksums *model.ObjectStoreChecksums) (err error)
+
+	// SaveFileContentIndexed marks the content of the file as extracted to the full-text index of the file object
+	SaveFileContentIndexed(fileId string, objectId string) error
+	// IsFileContentIndexed reports whether the content of the file is already in the full-text index of the file object
+	IsFileContentIndexed(fileId string, objectId string) (bool, error)
+	// ClearFileContentIndexed removes all marks, it's used when the full-text index is rebuilt from scratch
+	ClearFileContentIndexed() error
 }
 
 type AccountStore interface {
@@ -97,6 +108,7 @@ type dsObjectStore struct {
 	virtualSpaces    anystore.Collection
 	system           anystore.Collection
 	fulltextQueue    anystore.Collection
+	fulltextFiles    anystore.Collection
 
 	arenaPool *anyenc.ArenaPool
 
@@ -208,6 +220,10 @@ func (s *dsObjectStore) openDatabase(ctx context.Context, path string) error {
 	if err != nil {
 		return errors.Join(store.Close(), fmt.Errorf("open fulltextQueue collection: %w", err))
 	}
+	fulltextFiles, err := store.Collection(ctx, "fulltext_files")
+	if err != nil {
+		return errors.Join(store.Close(), fmt.Errorf("open fulltextFiles collection: %w", err))
+	}
 	system, err := store.Collection(ctx, "system")
 	if err != nil {
 		return errors.Join(store.Close(), fmt.Errorf("open system collection: %w", err))
@@ -225,6 +241,7 @@ func (s *dsObjectStore) openDatabase(ctx context.Context, path string) error {
 	s.anyStoreLockRemove = lockRemove
 
 	s.fulltextQueue = fulltextQueue
+	s.fulltextFiles = fulltextFiles
 	s.system = system
 	s.indexerChecksums = indexerChecksums
 	s.virtualSpaces = virtualSpaces
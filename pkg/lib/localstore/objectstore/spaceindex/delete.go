@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	anystore "github.com/anyproto/any-store"
+	"github.com/anyproto/any-store/query"
 
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
@@ -93,5 +94,9 @@ func (s *dsObjectStore) eraseLinksForObject(ctx context.Context, from string) er
 	if err != nil && !errors.Is(err, anystore.ErrDocNotFound) {
 		return err
 	}
+	_, err = s.blockLinks.Find(query.Key{Path: []string{blockLinkSourceField}, Filter: query.NewComp(query.CompOpEq, from)}).Delete(ctx)
+	if err != nil {
+		return fmt.Errorf("delete block links: %w", err)
+	}
 	return nil
 }
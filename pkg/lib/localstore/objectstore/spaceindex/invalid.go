@@ -8,6 +8,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/core/relationutils"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/localstore/ftsearch"
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 	"github.com/anyproto/anytype-heart/util/pbtypes"
 )
@@ -38,6 +39,10 @@ func (s *invalidStore) QueryRaw(f *database.Filters, limit int, offset int) (rec
 	return nil, s.err
 }
 
+func (s *invalidStore) QueryFromFulltextMatches(q database.Query, matches []*ftsearch.DocumentMatch) (records []database.Record, err error) {
+	return nil, s.err
+}
+
 func (s *invalidStore) QueryByIds(ids []string) (records []database.Record, err error) {
 	return nil, s.err
 }
@@ -78,6 +83,10 @@ func (s *invalidStore) UpdateObjectLinks(ctx context.Context, id string, links [
 	return s.err
 }
 
+func (s *invalidStore) UpdateObjectBlockLinks(ctx context.Context, id string, links []*model.BlockLink) error {
+	return s.err
+}
+
 func (s *invalidStore) UpdatePendingLocalDetails(id string, proc func(details *domain.Details) (*domain.Details, error)) error {
 	return s.err
 }
@@ -118,6 +127,14 @@ func (s *invalidStore) GetOutboundLinksById(id string) ([]string, error) {
 	return nil, s.err
 }
 
+func (s *invalidStore) ListIdsWithOutboundLinks() ([]string, error) {
+	return nil, s.err
+}
+
+func (s *invalidStore) GetInboundBlockLinks(id string) ([]*model.BlockLink, error) {
+	return nil, s.err
+}
+
 func (s *invalidStore) GetWithLinksInfoById(id string) (*model.ObjectInfoWithLinks, error) {
 	return nil, s.err
 }
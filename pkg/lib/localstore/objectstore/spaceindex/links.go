@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	anystore "github.com/anyproto/any-store"
 	"github.com/anyproto/any-store/query"
@@ -11,7 +12,14 @@ import (
 	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
-const linkOutboundField = "o"
+const (
+	linkOutboundField = "o"
+
+	blockLinkSourceField      = "f"
+	blockLinkSourceBlockField = "fb"
+	blockLinkTargetField      = "t"
+	blockLinkTargetBlockField = "tb"
+)
 
 func (s *dsObjectStore) GetWithLinksInfoById(id string) (*model.ObjectInfoWithLinks, error) {
 	txn, err := s.links.ReadTx(s.componentCtx)
@@ -81,6 +89,29 @@ func (s *dsObjectStore) GetInboundLinksById(id string) ([]string, error) {
 	return s.findInboundLinks(s.componentCtx, id)
 }
 
+func (s *dsObjectStore) ListIdsWithOutboundLinks() ([]string, error) {
+	iter, err := s.links.Find(nil).Iter(s.componentCtx)
+	if err != nil {
+		return nil, fmt.Errorf("find all: %w", err)
+	}
+	defer iter.Close()
+
+	var ids []string
+	for iter.Next() {
+		doc, err := iter.Doc()
+		if err != nil {
+			return nil, fmt.Errorf("get doc: %w", err)
+		}
+		if len(doc.Value().GetArray(linkOutboundField)) > 0 {
+			ids = append(ids, string(doc.Value().GetStringBytes("id")))
+		}
+	}
+	if err = iter.Err(); err != nil {
+		return nil, fmt.Errorf("iterate: %w", err)
+	}
+	return ids, nil
+}
+
 // Find to which IDs specified one has outbound links.
 func (s *dsObjectStore) findOutboundLinks(ctx context.Context, id string) ([]string, error) {
 	doc, err := s.links.FindId(ctx, id)
@@ -115,3 +146,61 @@ func (s *dsObjectStore) findInboundLinks(ctx context.Context, id string) ([]stri
 	}
 	return links, nil
 }
+
+func (s *dsObjectStore) UpdateObjectBlockLinks(ctx context.Context, id string, links []*model.BlockLink) error {
+	arena := s.arenaPool.Get()
+	defer func() {
+		arena.Reset()
+		s.arenaPool.Put(arena)
+	}()
+
+	txn, err := s.blockLinks.WriteTx(ctx)
+	if err != nil {
+		return fmt.Errorf("write txn: %w", err)
+	}
+	rollback := func(err error) error {
+		return errors.Join(txn.Rollback(), err)
+	}
+
+	_, err = s.blockLinks.Find(query.Key{Path: []string{blockLinkSourceField}, Filter: query.NewComp(query.CompOpEq, id)}).Delete(txn.Context())
+	if err != nil {
+		return rollback(fmt.Errorf("delete previous links: %w", err))
+	}
+	for _, link := range links {
+		arena.Reset()
+		doc := arena.NewObject()
+		doc.Set("id", arena.NewString(strings.Join([]string{id, link.BlockId, link.TargetObjectId, link.TargetBlockId}, "/")))
+		doc.Set(blockLinkSourceField, arena.NewString(id))
+		doc.Set(blockLinkSourceBlockField, arena.NewString(link.BlockId))
+		doc.Set(blockLinkTargetField, arena.NewString(link.TargetObjectId))
+		doc.Set(blockLinkTargetBlockField, arena.NewString(link.TargetBlockId))
+		if err = s.blockLinks.UpsertOne(txn.Context(), doc); err != nil {
+			return rollback(fmt.Errorf("upsert link: %w", err))
+		}
+	}
+	return txn.Commit()
+}
+
+func (s *dsObjectStore) GetInboundBlockLinks(id string) ([]*model.BlockLink, error) {
+	iter, err := s.blockLinks.Find(query.Key{Path: []string{blockLinkTargetField}, Filter: query.NewComp(query.CompOpEq, id)}).Iter(s.componentCtx)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var links []*model.BlockLink
+	for iter.Next() {
+		doc, err := iter.Doc()
+		if err != nil {
+			return nil, fmt.Errorf("get doc: %w", err)
+		}
+		val := doc.Value()
+		links = append(links, &model.BlockLink{
+			ObjectId:       string(val.GetStringBytes(blockLinkSourceField)),
+			BlockId:        string(val.GetStringBytes(blockLinkSourceBlockField)),
+			TargetObjectId: string(val.GetStringBytes(blockLinkTargetField)),
+			TargetBlockId:  string(val.GetStringBytes(blockLinkTargetBlockField)),
+		})
+	}
+	return links, nil
+}
@@ -169,6 +169,7 @@ func (s *dsObjectStore) QueryRaw(filters *database.Filters, limit int, offset in
 func (s *dsObjectStore) QueryFromFulltext(results []database.FulltextResult, params database.Filters, limit int, offset int, ftsSearch string) ([]database.Record, error) {
 	records := make([]database.Record, 0, len(results))
 	resultObjectMap := make(map[string]struct{})
+	ftsSearch = ftsearch.QueryHighlightText(ftsSearch)
 	// we assume that results are already sorted by score DESC.
 	// this mean we use map to ignore duplicates without checking score
 	for _, res := range results {
@@ -208,7 +209,7 @@ func (s *dsObjectStore) QueryFromFulltext(results []database.FulltextResult, par
 		rec := database.Record{Details: details}
 		if params.FilterObj == nil || params.FilterObj.FilterObject(rec.Details) {
 			rec.Meta = res.Model()
-			if rec.Meta.Highlight == "" {
+			if rec.Meta.Highlight == "" && ftsSearch != "" {
 				title := details.GetString(bundle.RelationKeyName)
 				index := strings.Index(strings.ToLower(title), strings.ToLower(ftsSearch))
 				titleArr := []byte(title)
@@ -296,6 +297,27 @@ func (s *dsObjectStore) performQuery(q database.Query) (records []database.Recor
 	return s.QueryRaw(filters, q.Limit, q.Offset)
 }
 
+func (s *dsObjectStore) QueryFromFulltextMatches(q database.Query, matches []*ftsearch.DocumentMatch) ([]database.Record, error) {
+	arena := s.arenaPool.Get()
+	defer s.arenaPool.Put(arena)
+
+	collatorBuffer := s.collatorBufferPool.get()
+	defer s.collatorBufferPool.put(collatorBuffer)
+
+	q.TextQuery = strings.TrimSpace(q.TextQuery)
+	filters, err := database.NewFilters(q, s, arena, collatorBuffer)
+	if err != nil {
+		return nil, fmt.Errorf("new filters: %w", err)
+	}
+	fulltextResults, err := s.performFulltextSearch(func() (results []*ftsearch.DocumentMatch, err error) {
+		return matches, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("perform fulltext search: %w", err)
+	}
+	return s.QueryFromFulltext(fulltextResults, *filters, 0, 0, q.TextQuery)
+}
+
 func (s *dsObjectStore) performFulltextSearch(search func() (results []*ftsearch.DocumentMatch, err error)) ([]database.FulltextResult, error) {
 	ftsResults, err := search()
 	if err != nil {
@@ -36,6 +36,8 @@ type Store interface {
 	// Query adds implicit filters on isArchived, isDeleted and objectType relations! To avoid them use QueryRaw
 	Query(q database.Query) (records []database.Record, err error)
 	QueryRaw(f *database.Filters, limit int, offset int) (records []database.Record, err error)
+	// QueryFromFulltextMatches works like Query with the text query, but uses full-text matches found by the caller. Limit and offset are ignored
+	QueryFromFulltextMatches(q database.Query, matches []*ftsearch.DocumentMatch) (records []database.Record, err error)
 	QueryByIds(ids []string) (records []database.Record, err error)
 	QueryByIdsAndSubscribeForChanges(ids []string, subscription database.Subscription) (records []database.Record, close func(), err error)
 	QueryObjectIds(q database.Query) (ids []string, total int, err error)
@@ -52,6 +54,8 @@ type Store interface {
 	UpdateObjectDetails(ctx context.Context, id string, details *domain.Details) error
 	SubscribeForAll(callback func(rec database.Record))
 	UpdateObjectLinks(ctx context.Context, id string, links []string) error
+	// UpdateObjectBlockLinks replaces links from the object to blocks of objects
+	UpdateObjectBlockLinks(ctx context.Context, id string, links []*model.BlockLink) error
 	UpdatePendingLocalDetails(id string, proc func(details *domain.Details) (*domain.Details, error)) error
 	ModifyObjectDetails(id string, proc func(details *domain.Details) (*domain.Details, bool, error)) error
 
@@ -65,6 +69,10 @@ type Store interface {
 
 	GetInboundLinksById(id string) ([]string, error)
 	GetOutboundLinksById(id string) ([]string, error)
+	// ListIdsWithOutboundLinks returns ids of objects having at least one outbound link
+	ListIdsWithOutboundLinks() ([]string, error)
+	// GetInboundBlockLinks returns links pointing to blocks of the object
+	GetInboundBlockLinks(id string) ([]*model.BlockLink, error)
 	GetWithLinksInfoById(id string) (*model.ObjectInfoWithLinks, error)
 
 	SetActiveView(objectId, blockId, viewId string) error
@@ -104,6 +112,7 @@ type dsObjectStore struct {
 	db             anystore.DB
 	objects        anystore.Collection
 	links          anystore.Collection
+	blockLinks     anystore.Collection
 	headsState     anystore.Collection
 	activeViews    anystore.Collection
 	pendingDetails anystore.Collection
@@ -187,6 +196,10 @@ func (s *dsObjectStore) openDatabase(ctx context.Context, path string) error {
 	if err != nil {
 		return errors.Join(s.db.Close(), fmt.Errorf("open links collection: %w", err))
 	}
+	blockLinks, err := s.newCollection(ctx, "blockLinks")
+	if err != nil {
+		return errors.Join(s.db.Close(), fmt.Errorf("open blockLinks collection: %w", err))
+	}
 	headsState, err := s.newCollection(ctx, "headsState")
 	if err != nil {
 		return errors.Join(s.db.Close(), fmt.Errorf("open headsState collection: %w", err))
@@ -252,8 +265,24 @@ func (s *dsObjectStore) openDatabase(ctx context.Context, path string) error {
 		log.Errorf("ensure links indexes: %s", err)
 	}
 
+	blockLinksIndexes := []anystore.IndexInfo{
+		{
+			Name:   blockLinkSourceField,
+			Fields: []string{blockLinkSourceField},
+		},
+		{
+			Name:   blockLinkTargetField,
+			Fields: []string{blockLinkTargetField},
+		},
+	}
+	err = s.addIndexes(ctx, blockLinks, blockLinksIndexes)
+	if err != nil {
+		log.Errorf("ensure block links indexes: %s", err)
+	}
+
 	s.objects = objects
 	s.links = links
+	s.blockLinks = blockLinks
 	s.headsState = headsState
 	s.activeViews = activeViews
 	s.pendingDetails = pendingDetails
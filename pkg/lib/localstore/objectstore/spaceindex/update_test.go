@@ -14,6 +14,7 @@ import (
 	"github.com/anyproto/anytype-heart/core/domain"
 	"github.com/anyproto/anytype-heart/pkg/lib/bundle"
 	"github.com/anyproto/anytype-heart/pkg/lib/database"
+	"github.com/anyproto/anytype-heart/pkg/lib/pb/model"
 )
 
 func TestUpdateObjectDetails(t *testing.T) {
@@ -320,6 +321,69 @@ func TestUpdateObjectLinks(t *testing.T) {
 		s.assertInboundLinks(t, "id2", nil)
 		s.assertInboundLinks(t, "id3", nil)
 	})
+
+	t.Run("objects with outbound links are listed", func(t *testing.T) {
+		s := NewStoreFixture(t)
+		ctx := context.Background()
+
+		require.NoError(t, s.UpdateObjectLinks(ctx, "id1", []string{"id2"}))
+		require.NoError(t, s.UpdateObjectLinks(ctx, "id2", []string{"id3"}))
+		require.NoError(t, s.UpdateObjectLinks(ctx, "id2", []string{}))
+		require.NoError(t, s.UpdateObjectLinks(ctx, "id3", []string{"id1"}))
+
+		ids, err := s.ListIdsWithOutboundLinks()
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"id1", "id3"}, ids)
+	})
+}
+
+func TestUpdateObjectBlockLinks(t *testing.T) {
+	link := func(blockId, targetId, targetBlockId string) *model.BlockLink {
+		return &model.BlockLink{ObjectId: "id1", BlockId: blockId, TargetObjectId: targetId, TargetBlockId: targetBlockId}
+	}
+
+	t.Run("links are found by the target object", func(t *testing.T) {
+		s := NewStoreFixture(t)
+		ctx := context.Background()
+
+		err := s.UpdateObjectBlockLinks(ctx, "id1", []*model.BlockLink{
+			link("block1", "id2", "heading1"),
+			link("block2", "id2", "heading2"),
+			link("block2", "id3", "heading1"),
+		})
+		require.NoError(t, err)
+
+		in, err := s.GetInboundBlockLinks("id2")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*model.BlockLink{link("block1", "id2", "heading1"), link("block2", "id2", "heading2")}, in)
+	})
+
+	t.Run("links are replaced", func(t *testing.T) {
+		s := NewStoreFixture(t)
+		ctx := context.Background()
+
+		err := s.UpdateObjectBlockLinks(ctx, "id1", []*model.BlockLink{link("block1", "id2", "heading1")})
+		require.NoError(t, err)
+		err = s.UpdateObjectBlockLinks(ctx, "id1", []*model.BlockLink{link("block1", "id2", "heading2")})
+		require.NoError(t, err)
+
+		in, err := s.GetInboundBlockLinks("id2")
+		require.NoError(t, err)
+		assert.Equal(t, []*model.BlockLink{link("block1", "id2", "heading2")}, in)
+	})
+
+	t.Run("links are deleted with object links", func(t *testing.T) {
+		s := NewStoreFixture(t)
+		ctx := context.Background()
+
+		err := s.UpdateObjectBlockLinks(ctx, "id1", []*model.BlockLink{link("block1", "id2", "heading1")})
+		require.NoError(t, err)
+		require.NoError(t, s.DeleteLinks([]string{"id1"}))
+
+		in, err := s.GetInboundBlockLinks("id2")
+		require.NoError(t, err)
+		assert.Empty(t, in)
+	})
 }
 
 func (fx *StoreFixture) assertInboundLinks(t *testing.T, id string, links []string) {
@@ -203,6 +203,75 @@ func (m *ObjectLinks) GetOutboundIDs() []string {
 	return nil
 }
 
+// BlockLink is the link or the mention pointing to the block of the object
+type BlockLink struct {
+	ObjectId       string `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	BlockId        string `protobuf:"bytes,2,opt,name=blockId,proto3" json:"blockId,omitempty"`
+	TargetObjectId string `protobuf:"bytes,3,opt,name=targetObjectId,proto3" json:"targetObjectId,omitempty"`
+	TargetBlockId  string `protobuf:"bytes,4,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
+}
+
+func (m *BlockLink) Reset()         { *m = BlockLink{} }
+func (m *BlockLink) String() string { return proto.CompactTextString(m) }
+func (*BlockLink) ProtoMessage()    {}
+func (*BlockLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9c35df71910469a5, []int{3}
+}
+func (m *BlockLink) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockLink.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockLink.Merge(m, src)
+}
+func (m *BlockLink) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockLink proto.InternalMessageInfo
+
+func (m *BlockLink) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *BlockLink) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *BlockLink) GetTargetObjectId() string {
+	if m != nil {
+		return m.TargetObjectId
+	}
+	return ""
+}
+
+func (m *BlockLink) GetTargetBlockId() string {
+	if m != nil {
+		return m.TargetBlockId
+	}
+	return ""
+}
+
 type ObjectLinksInfo struct {
 	Inbound  []*ObjectInfo `protobuf:"bytes,1,rep,name=inbound,proto3" json:"inbound,omitempty"`
 	Outbound []*ObjectInfo `protobuf:"bytes,2,rep,name=outbound,proto3" json:"outbound,omitempty"`
@@ -212,7 +281,7 @@ func (m *ObjectLinksInfo) Reset()         { *m = ObjectLinksInfo{} }
 func (m *ObjectLinksInfo) String() string { return proto.CompactTextString(m) }
 func (*ObjectLinksInfo) ProtoMessage()    {}
 func (*ObjectLinksInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c35df71910469a5, []int{3}
+	return fileDescriptor_9c35df71910469a5, []int{4}
 }
 func (m *ObjectLinksInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +334,7 @@ func (m *ObjectInfoWithLinks) Reset()         { *m = ObjectInfoWithLinks{} }
 func (m *ObjectInfoWithLinks) String() string { return proto.CompactTextString(m) }
 func (*ObjectInfoWithLinks) ProtoMessage()    {}
 func (*ObjectInfoWithLinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c35df71910469a5, []int{4}
+	return fileDescriptor_9c35df71910469a5, []int{5}
 }
 func (m *ObjectInfoWithLinks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +394,7 @@ func (m *ObjectInfoWithOutboundLinks) Reset()         { *m = ObjectInfoWithOutbo
 func (m *ObjectInfoWithOutboundLinks) String() string { return proto.CompactTextString(m) }
 func (*ObjectInfoWithOutboundLinks) ProtoMessage()    {}
 func (*ObjectInfoWithOutboundLinks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c35df71910469a5, []int{5}
+	return fileDescriptor_9c35df71910469a5, []int{6}
 }
 func (m *ObjectInfoWithOutboundLinks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +454,7 @@ func (m *ObjectInfoWithOutboundLinksIDs) Reset()         { *m = ObjectInfoWithOu
 func (m *ObjectInfoWithOutboundLinksIDs) String() string { return proto.CompactTextString(m) }
 func (*ObjectInfoWithOutboundLinksIDs) ProtoMessage()    {}
 func (*ObjectInfoWithOutboundLinksIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c35df71910469a5, []int{6}
+	return fileDescriptor_9c35df71910469a5, []int{7}
 }
 func (m *ObjectInfoWithOutboundLinksIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,13 +521,14 @@ type ObjectStoreChecksums struct {
 	LinksErase                       int32  `protobuf:"varint,14,opt,name=linksErase,proto3" json:"linksErase,omitempty"`
 	MarketplaceForceReindexCounter   int32  `protobuf:"varint,15,opt,name=marketplaceForceReindexCounter,proto3" json:"marketplaceForceReindexCounter,omitempty"`
 	ReindexDeletedObjects            int32  `protobuf:"varint,16,opt,name=reindexDeletedObjects,proto3" json:"reindexDeletedObjects,omitempty"`
+	BlockLinksReindexCounter         int32  `protobuf:"varint,17,opt,name=blockLinksReindexCounter,proto3" json:"blockLinksReindexCounter,omitempty"`
 }
 
 func (m *ObjectStoreChecksums) Reset()         { *m = ObjectStoreChecksums{} }
 func (m *ObjectStoreChecksums) String() string { return proto.CompactTextString(m) }
 func (*ObjectStoreChecksums) ProtoMessage()    {}
 func (*ObjectStoreChecksums) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9c35df71910469a5, []int{7}
+	return fileDescriptor_9c35df71910469a5, []int{8}
 }
 func (m *ObjectStoreChecksums) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,10 +669,18 @@ func (m *ObjectStoreChecksums) GetReindexDeletedObjects() int32 {
 	return 0
 }
 
+func (m *ObjectStoreChecksums) GetBlockLinksReindexCounter() int32 {
+	if m != nil {
+		return m.BlockLinksReindexCounter
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ObjectInfo)(nil), "anytype.model.ObjectInfo")
 	proto.RegisterType((*ObjectDetails)(nil), "anytype.model.ObjectDetails")
 	proto.RegisterType((*ObjectLinks)(nil), "anytype.model.ObjectLinks")
+	proto.RegisterType((*BlockLink)(nil), "anytype.model.BlockLink")
 	proto.RegisterType((*ObjectLinksInfo)(nil), "anytype.model.ObjectLinksInfo")
 	proto.RegisterType((*ObjectInfoWithLinks)(nil), "anytype.model.ObjectInfoWithLinks")
 	proto.RegisterType((*ObjectInfoWithOutboundLinks)(nil), "anytype.model.ObjectInfoWithOutboundLinks")
@@ -615,54 +693,58 @@ func init() {
 }
 
 var fileDescriptor_9c35df71910469a5 = []byte{
-	// 751 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4d, 0x6f, 0xd3, 0x4a,
-	0x14, 0xad, 0x93, 0x26, 0x69, 0x6e, 0x5e, 0xda, 0xbe, 0x79, 0xef, 0xe9, 0xcd, 0xeb, 0x43, 0x96,
-	0x15, 0x55, 0x28, 0xaa, 0x20, 0x11, 0xfd, 0xd8, 0xb0, 0x00, 0xa9, 0x2d, 0x95, 0x0a, 0x95, 0x22,
-	0xb9, 0x45, 0x48, 0xec, 0xec, 0xf8, 0xa6, 0x35, 0x99, 0x78, 0x2c, 0xcf, 0x18, 0x35, 0x0b, 0x96,
-	0x6c, 0x58, 0xf1, 0x07, 0xf8, 0x33, 0xac, 0x58, 0x76, 0xc9, 0x12, 0xb5, 0xff, 0x80, 0x5f, 0x80,
-	0x3c, 0xe3, 0xa4, 0x8e, 0xeb, 0xa6, 0x48, 0xb0, 0xf4, 0xb9, 0xe7, 0x1c, 0x9f, 0xb9, 0xd7, 0x73,
-	0x0d, 0xed, 0x70, 0x78, 0xda, 0x65, 0xbe, 0xdb, 0x0d, 0xdd, 0xee, 0x88, 0x7b, 0xc8, 0xba, 0x61,
-	0xc4, 0x25, 0x17, 0x5d, 0xc6, 0xfb, 0x0e, 0x13, 0x92, 0x47, 0xd8, 0x51, 0x08, 0x69, 0x3a, 0xc1,
-	0x58, 0x8e, 0x43, 0xec, 0x28, 0xda, 0xda, 0xbd, 0x53, 0xce, 0x4f, 0x19, 0x6a, 0xba, 0x1b, 0x0f,
-	0xba, 0x42, 0x46, 0x71, 0x5f, 0x6a, 0xf2, 0xda, 0xfa, 0x6d, 0xb6, 0xea, 0x41, 0x68, 0x56, 0xeb,
-	0xbb, 0x01, 0xd0, 0x73, 0xdf, 0x60, 0x5f, 0x1e, 0x06, 0x03, 0x4e, 0x96, 0xa1, 0xe4, 0x7b, 0xd4,
-	0xb0, 0x8c, 0x76, 0xdd, 0x2e, 0xf9, 0x1e, 0xb9, 0x0f, 0xcb, 0x5c, 0x55, 0x4f, 0xc6, 0x21, 0xbe,
-	0x8c, 0x98, 0xa0, 0x25, 0xab, 0xdc, 0xae, 0xdb, 0x39, 0x94, 0x3c, 0x82, 0x9a, 0x87, 0xd2, 0xf1,
-	0x99, 0xa0, 0x65, 0xcb, 0x68, 0x37, 0x36, 0xff, 0xed, 0xe8, 0x70, 0x9d, 0x49, 0xb8, 0xce, 0xb1,
-	0x0a, 0x67, 0x4f, 0x78, 0x64, 0x07, 0xea, 0x11, 0x32, 0x47, 0xfa, 0x3c, 0x10, 0x74, 0xd1, 0x2a,
-	0x2b, 0xd1, 0xcc, 0x01, 0x3b, 0x76, 0x5a, 0xb7, 0xaf, 0x99, 0x84, 0x42, 0x4d, 0x04, 0x7e, 0x18,
-	0xa2, 0xa4, 0x15, 0x15, 0x73, 0xf2, 0x48, 0xda, 0xb0, 0x72, 0xe6, 0x88, 0xc3, 0xc0, 0xe5, 0x71,
-	0xe0, 0x1d, 0xf9, 0xc1, 0x50, 0xd0, 0xaa, 0x65, 0xb4, 0x97, 0xec, 0x3c, 0xdc, 0xda, 0x85, 0xa6,
-	0x3e, 0xf3, 0x7e, 0x9a, 0x25, 0x13, 0xdf, 0xf8, 0xb9, 0xf8, 0xad, 0x1e, 0x34, 0xb4, 0x87, 0xb2,
-	0x24, 0x26, 0x80, 0xaf, 0x5f, 0x71, 0xb8, 0x9f, 0x98, 0x24, 0x4d, 0xca, 0x20, 0xc4, 0x82, 0x06,
-	0x8f, 0xe5, 0x94, 0xa0, 0xbb, 0x98, 0x85, 0x5a, 0xef, 0x60, 0x25, 0x63, 0xa8, 0xa6, 0xb1, 0x05,
-	0xb5, 0xd4, 0x42, 0x39, 0x36, 0x36, 0xff, 0xcb, 0x35, 0xe8, 0x7a, 0x72, 0xf6, 0x84, 0x49, 0x76,
-	0x60, 0x69, 0x62, 0xab, 0x5e, 0x33, 0x57, 0x35, 0xa5, 0xb6, 0x3e, 0x18, 0xf0, 0xd7, 0x75, 0xe1,
-	0x95, 0x2f, 0xcf, 0xf4, 0xc1, 0xf2, 0x5f, 0xc4, 0x43, 0x58, 0xf4, 0x83, 0x01, 0xa7, 0x25, 0xd5,
-	0xa7, 0x39, 0xd6, 0x8a, 0x46, 0xb6, 0xa1, 0xc2, 0xd4, 0x28, 0xf4, 0x67, 0x61, 0x16, 0xf2, 0xa7,
-	0x27, 0xb6, 0x35, 0xb9, 0xf5, 0xc9, 0x80, 0xff, 0x67, 0xc3, 0xf4, 0xd2, 0x9c, 0xbf, 0x25, 0xd4,
-	0x53, 0x68, 0xf2, 0xac, 0x1f, 0x2d, 0xdf, 0xd5, 0xa7, 0x59, 0x7e, 0xeb, 0xbd, 0x01, 0xe6, 0x9c,
-	0x7c, 0xc9, 0xc0, 0x7f, 0x31, 0xe2, 0x7a, 0x51, 0xc4, 0x7a, 0x3e, 0xc7, 0xe7, 0x2a, 0xfc, 0xad,
-	0xa5, 0xc7, 0xc9, 0x9a, 0xd8, 0x3b, 0xc3, 0xfe, 0x50, 0xc4, 0x23, 0x41, 0x3a, 0x40, 0xdc, 0x38,
-	0xf0, 0x18, 0x7a, 0xbd, 0xe9, 0x45, 0x15, 0x69, 0x9a, 0x82, 0x0a, 0xd9, 0x80, 0xd5, 0x14, 0xb5,
-	0xa7, 0x77, 0xb2, 0xa4, 0xd8, 0x37, 0xf0, 0x64, 0x27, 0xa4, 0xd8, 0x91, 0x33, 0xe6, 0xb1, 0xd4,
-	0xb3, 0xad, 0xdb, 0x39, 0x94, 0x3c, 0x81, 0x35, 0xbd, 0x25, 0xc4, 0x01, 0x8f, 0xfa, 0x68, 0xa3,
-	0x1f, 0x78, 0x78, 0xbe, 0xc7, 0xe3, 0x40, 0x62, 0x44, 0x17, 0x2d, 0xa3, 0x5d, 0xb1, 0xe7, 0x30,
-	0xc8, 0x63, 0xa0, 0x03, 0x9f, 0x61, 0xa1, 0xba, 0xa2, 0xd4, 0xb7, 0xd6, 0xc9, 0x03, 0xf8, 0xd3,
-	0xf7, 0xce, 0x6d, 0x74, 0x63, 0x9f, 0x79, 0x13, 0x51, 0x55, 0x89, 0x6e, 0x16, 0x92, 0xcd, 0x31,
-	0x88, 0x19, 0x93, 0x78, 0x2e, 0xd3, 0x0a, 0xad, 0x29, 0x6e, 0x1e, 0x4e, 0xc6, 0x32, 0x81, 0x9e,
-	0x45, 0x8e, 0x40, 0xda, 0x50, 0xbc, 0x59, 0x30, 0xd3, 0xcd, 0x13, 0x1c, 0x85, 0xcc, 0x91, 0x28,
-	0xe8, 0xd2, 0x4c, 0x37, 0xa7, 0x78, 0xa6, 0x9b, 0x7a, 0x1e, 0x82, 0xd6, 0x95, 0x65, 0x0e, 0x25,
-	0xcf, 0xc1, 0x52, 0xa7, 0x4d, 0xe6, 0xfc, 0x02, 0xc7, 0x85, 0x5d, 0x01, 0xa5, 0xbc, 0x93, 0x97,
-	0x7c, 0x1d, 0x4e, 0x84, 0x3d, 0xe6, 0x1d, 0x24, 0x4c, 0x1b, 0x47, 0xfc, 0x2d, 0x7a, 0xf4, 0x0f,
-	0xb5, 0x2c, 0x0b, 0x2a, 0xc9, 0x24, 0x9d, 0x08, 0xf7, 0x91, 0xa1, 0x9c, 0x06, 0x4a, 0x2d, 0xd1,
-	0xa3, 0x4d, 0xa5, 0x9b, 0xc3, 0x48, 0x96, 0xa3, 0xba, 0xd7, 0xba, 0x65, 0xcb, 0x2a, 0x65, 0x06,
-	0x21, 0x07, 0x60, 0x8e, 0x9c, 0x68, 0x88, 0x32, 0x64, 0x4e, 0x1f, 0x8b, 0x4e, 0xb6, 0xa2, 0x34,
-	0x77, 0xb0, 0xc8, 0x36, 0xfc, 0x13, 0x69, 0x64, 0x36, 0x09, 0x5d, 0x55, 0xf2, 0xe2, 0xe2, 0xee,
-	0xc6, 0x97, 0x4b, 0xd3, 0xb8, 0xb8, 0x34, 0x8d, 0x6f, 0x97, 0xa6, 0xf1, 0xf1, 0xca, 0x5c, 0xb8,
-	0xb8, 0x32, 0x17, 0xbe, 0x5e, 0x99, 0x0b, 0xaf, 0x57, 0xf3, 0xbf, 0x50, 0xb7, 0xaa, 0xfe, 0x07,
-	0x5b, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x63, 0xd8, 0x76, 0xf5, 0xb4, 0x07, 0x00, 0x00,
+	// 814 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x8e, 0xe3, 0x44,
+	0x10, 0x1e, 0x27, 0xf3, 0x97, 0x0a, 0x99, 0x99, 0x6d, 0x40, 0x34, 0x03, 0xb2, 0x22, 0x6b, 0x84,
+	0xa2, 0x15, 0x24, 0x62, 0x7f, 0x2e, 0x1c, 0x40, 0xca, 0x86, 0x91, 0x02, 0x2b, 0x45, 0xf2, 0x2e,
+	0x42, 0xe2, 0x66, 0xc7, 0x95, 0x8c, 0x49, 0xc7, 0x6d, 0xb9, 0xdb, 0x68, 0x72, 0xe0, 0xc8, 0x85,
+	0x0b, 0xbc, 0x00, 0xef, 0xc3, 0x71, 0x8f, 0x1c, 0xd1, 0xcc, 0x1b, 0xf0, 0x00, 0x08, 0x75, 0xb7,
+	0xed, 0xb1, 0x3d, 0x9e, 0x04, 0x09, 0x8e, 0xfd, 0xd5, 0x57, 0x95, 0xaf, 0xbe, 0xaa, 0x6e, 0x07,
+	0x06, 0xf1, 0x6a, 0x39, 0x62, 0xa1, 0x3f, 0x8a, 0xfd, 0xd1, 0x9a, 0x07, 0xc8, 0x46, 0x71, 0xc2,
+	0x25, 0x17, 0x23, 0xc6, 0xe7, 0x1e, 0x13, 0x92, 0x27, 0x38, 0xd4, 0x08, 0xe9, 0x79, 0xd1, 0x46,
+	0x6e, 0x62, 0x1c, 0x6a, 0xda, 0xf9, 0x87, 0x4b, 0xce, 0x97, 0x0c, 0x0d, 0xdd, 0x4f, 0x17, 0x23,
+	0x21, 0x93, 0x74, 0x2e, 0x0d, 0xf9, 0xfc, 0xe2, 0xa1, 0xb2, 0xfa, 0x20, 0x0c, 0xcb, 0xf9, 0xcb,
+	0x02, 0x98, 0xf9, 0xdf, 0xe3, 0x5c, 0x4e, 0xa3, 0x05, 0x27, 0x27, 0xd0, 0x0a, 0x03, 0x6a, 0xf5,
+	0xad, 0x41, 0xc7, 0x6d, 0x85, 0x01, 0xf9, 0x08, 0x4e, 0xb8, 0x8e, 0xbe, 0xde, 0xc4, 0xf8, 0x4d,
+	0xc2, 0x04, 0x6d, 0xf5, 0xdb, 0x83, 0x8e, 0x5b, 0x43, 0xc9, 0xa7, 0x70, 0x14, 0xa0, 0xf4, 0x42,
+	0x26, 0x68, 0xbb, 0x6f, 0x0d, 0xba, 0x4f, 0xde, 0x1b, 0x1a, 0x71, 0xc3, 0x5c, 0xdc, 0xf0, 0x95,
+	0x16, 0xe7, 0xe6, 0x3c, 0xf2, 0x1c, 0x3a, 0x09, 0x32, 0x4f, 0x86, 0x3c, 0x12, 0x74, 0xbf, 0xdf,
+	0xd6, 0x49, 0x95, 0x06, 0x87, 0x6e, 0x16, 0x77, 0xef, 0x98, 0x84, 0xc2, 0x91, 0x88, 0xc2, 0x38,
+	0x46, 0x49, 0x0f, 0xb4, 0xcc, 0xfc, 0x48, 0x06, 0x70, 0x7a, 0xe5, 0x89, 0x69, 0xe4, 0xf3, 0x34,
+	0x0a, 0x5e, 0x86, 0xd1, 0x4a, 0xd0, 0xc3, 0xbe, 0x35, 0x38, 0x76, 0xeb, 0xb0, 0x33, 0x86, 0x9e,
+	0xe9, 0x79, 0x92, 0x69, 0x29, 0xc9, 0xb7, 0xfe, 0x9d, 0x7c, 0x67, 0x06, 0x5d, 0x53, 0x43, 0x97,
+	0x24, 0x36, 0x40, 0x68, 0x7e, 0x62, 0x3a, 0x51, 0x45, 0x94, 0x49, 0x25, 0x84, 0xf4, 0xa1, 0xcb,
+	0x53, 0x59, 0x10, 0x8c, 0x8b, 0x65, 0xc8, 0xf9, 0xc5, 0x82, 0xce, 0x98, 0xf1, 0xf9, 0x4a, 0x15,
+	0x24, 0xe7, 0x70, 0x6c, 0x2c, 0x9e, 0xe6, 0xe3, 0x28, 0xce, 0xca, 0x02, 0x5f, 0x11, 0xa7, 0x01,
+	0x6d, 0x19, 0x0b, 0xb2, 0xa3, 0x1a, 0x97, 0xf4, 0x92, 0x25, 0xca, 0x59, 0x9e, 0xdb, 0xd6, 0x84,
+	0x1a, 0x4a, 0x2e, 0xa0, 0x67, 0x90, 0x71, 0x56, 0x67, 0x5f, 0xd3, 0xaa, 0xa0, 0xf3, 0x23, 0x9c,
+	0x96, 0x5a, 0xd4, 0xfb, 0xf1, 0x14, 0x8e, 0xb2, 0xa6, 0x74, 0x8f, 0xdd, 0x27, 0xef, 0xd7, 0x46,
+	0x76, 0xb7, 0x4b, 0x6e, 0xce, 0x24, 0xcf, 0xe1, 0x38, 0x6f, 0x94, 0xb6, 0x76, 0x65, 0x15, 0x54,
+	0xe7, 0x67, 0x0b, 0xde, 0xbe, 0x0b, 0x7c, 0x1b, 0xca, 0x2b, 0x63, 0x75, 0x7d, 0x47, 0x3f, 0x81,
+	0xfd, 0x30, 0x5a, 0x70, 0xed, 0xc5, 0xd6, 0xd2, 0x9a, 0x46, 0x9e, 0xc1, 0x01, 0xd3, 0xcb, 0x61,
+	0x16, 0xd5, 0x6e, 0xe4, 0x17, 0x1d, 0xbb, 0x86, 0xec, 0xfc, 0x66, 0xc1, 0x07, 0x55, 0x31, 0xb3,
+	0x4c, 0xe7, 0xff, 0x22, 0xea, 0x0b, 0xe8, 0xf1, 0x72, 0x3d, 0xda, 0xde, 0xe5, 0x53, 0x95, 0xef,
+	0xfc, 0x64, 0x81, 0xbd, 0x45, 0xdf, 0x74, 0xf2, 0x9f, 0x25, 0x5e, 0x34, 0x49, 0xec, 0xd4, 0x75,
+	0xfc, 0x7d, 0x08, 0xef, 0x98, 0xd4, 0x57, 0xea, 0xe1, 0x7a, 0x71, 0x85, 0xf3, 0x95, 0x48, 0xd7,
+	0x82, 0x0c, 0x81, 0xf8, 0x69, 0x14, 0x30, 0x0c, 0x66, 0xc5, 0xd3, 0x21, 0x32, 0x35, 0x0d, 0x11,
+	0xf2, 0x18, 0xce, 0x32, 0xd4, 0x2d, 0x5e, 0x09, 0xb3, 0xed, 0xf7, 0x70, 0xb5, 0xf6, 0x19, 0xf6,
+	0xd2, 0xdb, 0xf0, 0x54, 0x8a, 0x7c, 0xed, 0xab, 0x28, 0xf9, 0x1c, 0xce, 0xcd, 0x25, 0x12, 0x97,
+	0x3c, 0x99, 0xa3, 0x8b, 0x61, 0x14, 0xe0, 0xf5, 0x0b, 0x9e, 0x46, 0x12, 0x13, 0x7d, 0x07, 0x0e,
+	0xdc, 0x2d, 0x0c, 0xf2, 0x19, 0xd0, 0x45, 0xc8, 0xb0, 0x31, 0xfb, 0x40, 0x67, 0x3f, 0x18, 0x27,
+	0x1f, 0xc3, 0xa3, 0x30, 0xb8, 0x76, 0xd1, 0x4f, 0x43, 0x16, 0xe4, 0x49, 0x87, 0x3a, 0xe9, 0x7e,
+	0x40, 0xbd, 0x65, 0x8b, 0x94, 0x31, 0x89, 0xd7, 0x32, 0x8b, 0xd0, 0x23, 0xcd, 0xad, 0xc3, 0x6a,
+	0x2c, 0x39, 0xf4, 0x65, 0xe2, 0x09, 0xa4, 0x5d, 0xcd, 0xab, 0x82, 0x25, 0x37, 0x5f, 0xe3, 0x3a,
+	0x66, 0x9e, 0x44, 0x41, 0x8f, 0x2b, 0x6e, 0x16, 0x78, 0xc9, 0x4d, 0x33, 0x0f, 0x41, 0x3b, 0xba,
+	0x64, 0x0d, 0x25, 0x5f, 0x41, 0x5f, 0x77, 0xab, 0xe6, 0xfc, 0x35, 0x6e, 0x1a, 0x5d, 0x01, 0x9d,
+	0xb9, 0x93, 0xa7, 0xb6, 0xc3, 0x4b, 0x70, 0xc6, 0x82, 0x4b, 0xc5, 0x74, 0x71, 0xcd, 0x7f, 0xc0,
+	0x80, 0xbe, 0xa5, 0x9f, 0xef, 0x86, 0x88, 0x9a, 0xa4, 0x97, 0xe0, 0x04, 0x19, 0xca, 0x42, 0x50,
+	0x56, 0x12, 0x03, 0xda, 0xd3, 0x79, 0x5b, 0x18, 0xea, 0xb9, 0xd6, 0xf7, 0xda, 0x58, 0x76, 0xa2,
+	0x55, 0x96, 0x10, 0x72, 0x09, 0xf6, 0xda, 0x4b, 0x56, 0x28, 0x63, 0xe6, 0xcd, 0xb1, 0xa9, 0xb3,
+	0x53, 0x9d, 0xb3, 0x83, 0x45, 0x9e, 0xc1, 0xbb, 0x89, 0x41, 0xaa, 0x4a, 0xe8, 0x99, 0x4e, 0x6f,
+	0x0e, 0xaa, 0x3d, 0xf3, 0xf3, 0x2f, 0x81, 0xa8, 0xfd, 0xee, 0x23, 0xb3, 0x67, 0x0f, 0xc5, 0xc7,
+	0x8f, 0x7f, 0xbf, 0xb1, 0xad, 0x37, 0x37, 0xb6, 0xf5, 0xe7, 0x8d, 0x6d, 0xfd, 0x7a, 0x6b, 0xef,
+	0xbd, 0xb9, 0xb5, 0xf7, 0xfe, 0xb8, 0xb5, 0xf7, 0xbe, 0x3b, 0xab, 0xff, 0x21, 0xf0, 0x0f, 0xf5,
+	0xd7, 0xed, 0xe9, 0x3f, 0x03, 0x00, 0x71, 0xa8, 0x87, 0x19, 0x82, 0x08, 0x00, 0x00,
 }
 
 func (m *ObjectInfo) Marshal() (dAtA []byte, err error) {
@@ -823,6 +905,57 @@ func (m *ObjectLinks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockLink) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockLink) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetBlockId) > 0 {
+		i -= len(m.TargetBlockId)
+		copy(dAtA[i:], m.TargetBlockId)
+		i = encodeVarintLocalstore(dAtA, i, uint64(len(m.TargetBlockId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TargetObjectId) > 0 {
+		i -= len(m.TargetObjectId)
+		copy(dAtA[i:], m.TargetObjectId)
+		i = encodeVarintLocalstore(dAtA, i, uint64(len(m.TargetObjectId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BlockId) > 0 {
+		i -= len(m.BlockId)
+		copy(dAtA[i:], m.BlockId)
+		i = encodeVarintLocalstore(dAtA, i, uint64(len(m.BlockId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintLocalstore(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ObjectLinksInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1055,6 +1188,13 @@ func (m *ObjectStoreChecksums) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BlockLinksReindexCounter != 0 {
+		i = encodeVarintLocalstore(dAtA, i, uint64(m.BlockLinksReindexCounter))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
 	if m.ReindexDeletedObjects != 0 {
 		i = encodeVarintLocalstore(dAtA, i, uint64(m.ReindexDeletedObjects))
 		i--
@@ -1239,6 +1379,31 @@ func (m *ObjectLinks) Size() (n int) {
 	return n
 }
 
+func (m *BlockLink) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovLocalstore(uint64(l))
+	}
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovLocalstore(uint64(l))
+	}
+	l = len(m.TargetObjectId)
+	if l > 0 {
+		n += 1 + l + sovLocalstore(uint64(l))
+	}
+	l = len(m.TargetBlockId)
+	if l > 0 {
+		n += 1 + l + sovLocalstore(uint64(l))
+	}
+	return n
+}
+
 func (m *ObjectLinksInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1385,6 +1550,9 @@ func (m *ObjectStoreChecksums) Size() (n int) {
 	if m.ReindexDeletedObjects != 0 {
 		n += 2 + sovLocalstore(uint64(m.ReindexDeletedObjects))
 	}
+	if m.BlockLinksReindexCounter != 0 {
+		n += 2 + sovLocalstore(uint64(m.BlockLinksReindexCounter))
+	}
 	return n
 }
 
@@ -1830,6 +1998,184 @@ func (m *ObjectLinks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockLink) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLocalstore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockLink: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockLink: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLocalstore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLocalstore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ObjectLinksInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2791,6 +3137,25 @@ func (m *ObjectStoreChecksums) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLinksReindexCounter", wireType)
+			}
+			m.BlockLinksReindexCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLocalstore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockLinksReindexCounter |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLocalstore(dAtA[iNdEx:])
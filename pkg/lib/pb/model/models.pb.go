@@ -923,6 +923,7 @@ const (
 	BlockContentTextMark_Mention         BlockContentTextMarkType = 8
 	BlockContentTextMark_Emoji           BlockContentTextMarkType = 9
 	BlockContentTextMark_Object          BlockContentTextMarkType = 10
+	BlockContentTextMark_Comment         BlockContentTextMarkType = 11
 )
 
 var BlockContentTextMarkType_name = map[int32]string{
@@ -937,6 +938,7 @@ var BlockContentTextMarkType_name = map[int32]string{
 	8:  "Mention",
 	9:  "Emoji",
 	10: "Object",
+	11: "Comment",
 }
 
 var BlockContentTextMarkType_value = map[string]int32{
@@ -951,6 +953,7 @@ var BlockContentTextMarkType_value = map[string]int32{
 	"Mention":         8,
 	"Emoji":           9,
 	"Object":          10,
+	"Comment":         11,
 }
 
 func (x BlockContentTextMarkType) String() string {
@@ -1997,6 +2000,31 @@ func (NotificationExportCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_98a910b73321e591, []int{21, 1, 0}
 }
 
+type NotificationBackupCode int32
+
+const (
+	NotificationBackup_NULL          NotificationBackupCode = 0
+	NotificationBackup_UNKNOWN_ERROR NotificationBackupCode = 1
+)
+
+var NotificationBackupCode_name = map[int32]string{
+	0: "NULL",
+	1: "UNKNOWN_ERROR",
+}
+
+var NotificationBackupCode_value = map[string]int32{
+	"NULL":          0,
+	"UNKNOWN_ERROR": 1,
+}
+
+func (x NotificationBackupCode) String() string {
+	return proto.EnumName(NotificationBackupCode_name, int32(x))
+}
+
+func (NotificationBackupCode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21, 11, 0}
+}
+
 type ExportFormat int32
 
 const (
@@ -2006,15 +2034,27 @@ const (
 	Export_DOT        ExportFormat = 3
 	Export_SVG        ExportFormat = 4
 	Export_GRAPH_JSON ExportFormat = 5
+	Export_ICS        ExportFormat = 6
+	Export_CSV        ExportFormat = 7
+	Export_TSV        ExportFormat = 8
+	Export_HTML       ExportFormat = 9
+	Export_DOCX       ExportFormat = 10
+	Export_OPML       ExportFormat = 11
 )
 
 var ExportFormat_name = map[int32]string{
-	0: "Markdown",
-	1: "Protobuf",
-	2: "JSON",
-	3: "DOT",
-	4: "SVG",
-	5: "GRAPH_JSON",
+	0:  "Markdown",
+	1:  "Protobuf",
+	2:  "JSON",
+	3:  "DOT",
+	4:  "SVG",
+	5:  "GRAPH_JSON",
+	6:  "ICS",
+	7:  "CSV",
+	8:  "TSV",
+	9:  "HTML",
+	10: "DOCX",
+	11: "OPML",
 }
 
 var ExportFormat_value = map[string]int32{
@@ -2024,6 +2064,12 @@ var ExportFormat_value = map[string]int32{
 	"DOT":        3,
 	"SVG":        4,
 	"GRAPH_JSON": 5,
+	"ICS":        6,
+	"CSV":        7,
+	"TSV":        8,
+	"HTML":       9,
+	"DOCX":       10,
+	"OPML":       11,
 }
 
 func (x ExportFormat) String() string {
@@ -2044,16 +2090,24 @@ const (
 	Import_Html     ImportType = 4
 	Import_Txt      ImportType = 5
 	Import_Csv      ImportType = 6
+	Import_Ics      ImportType = 7
+	Import_Enex     ImportType = 8
+	Import_Outliner ImportType = 9
+	Import_Opml     ImportType = 10
 )
 
 var ImportType_name = map[int32]string{
-	0: "Notion",
-	1: "Markdown",
-	2: "External",
-	3: "Pb",
-	4: "Html",
-	5: "Txt",
-	6: "Csv",
+	0:  "Notion",
+	1:  "Markdown",
+	2:  "External",
+	3:  "Pb",
+	4:  "Html",
+	5:  "Txt",
+	6:  "Csv",
+	7:  "Ics",
+	8:  "Enex",
+	9:  "Outliner",
+	10: "Opml",
 }
 
 var ImportType_value = map[string]int32{
@@ -2064,6 +2118,10 @@ var ImportType_value = map[string]int32{
 	"Html":     4,
 	"Txt":      5,
 	"Csv":      6,
+	"Ics":      7,
+	"Enex":     8,
+	"Outliner": 9,
+	"Opml":     10,
 }
 
 func (x ImportType) String() string {
@@ -2071,7 +2129,7 @@ func (x ImportType) String() string {
 }
 
 func (ImportType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{23, 0}
+	return fileDescriptor_98a910b73321e591, []int{24, 0}
 }
 
 type ImportErrorCode int32
@@ -2090,6 +2148,7 @@ const (
 	Import_FILE_IMPORT_NO_OBJECTS_IN_DIRECTORY     ImportErrorCode = 17
 	Import_HTML_WRONG_HTML_STRUCTURE               ImportErrorCode = 10
 	Import_PB_NOT_ANYBLOCK_FORMAT                  ImportErrorCode = 11
+	Import_PB_WRONG_PASSWORD                       ImportErrorCode = 18
 	Import_CSV_LIMIT_OF_ROWS_OR_RELATIONS_EXCEEDED ImportErrorCode = 7
 	Import_INSUFFICIENT_PERMISSIONS                ImportErrorCode = 9
 )
@@ -2108,6 +2167,7 @@ var ImportErrorCode_name = map[int32]string{
 	17: "FILE_IMPORT_NO_OBJECTS_IN_DIRECTORY",
 	10: "HTML_WRONG_HTML_STRUCTURE",
 	11: "PB_NOT_ANYBLOCK_FORMAT",
+	18: "PB_WRONG_PASSWORD",
 	7:  "CSV_LIMIT_OF_ROWS_OR_RELATIONS_EXCEEDED",
 	9:  "INSUFFICIENT_PERMISSIONS",
 }
@@ -2126,6 +2186,7 @@ var ImportErrorCode_value = map[string]int32{
 	"FILE_IMPORT_NO_OBJECTS_IN_DIRECTORY":     17,
 	"HTML_WRONG_HTML_STRUCTURE":               10,
 	"PB_NOT_ANYBLOCK_FORMAT":                  11,
+	"PB_WRONG_PASSWORD":                       18,
 	"CSV_LIMIT_OF_ROWS_OR_RELATIONS_EXCEEDED": 7,
 	"INSUFFICIENT_PERMISSIONS":                9,
 }
@@ -2135,7 +2196,7 @@ func (x ImportErrorCode) String() string {
 }
 
 func (ImportErrorCode) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{23, 1}
+	return fileDescriptor_98a910b73321e591, []int{24, 1}
 }
 
 type MembershipStatus int32
@@ -2176,7 +2237,7 @@ func (x MembershipStatus) String() string {
 }
 
 func (MembershipStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{30, 0}
+	return fileDescriptor_98a910b73321e591, []int{31, 0}
 }
 
 type MembershipPaymentMethod int32
@@ -2210,7 +2271,7 @@ func (x MembershipPaymentMethod) String() string {
 }
 
 func (MembershipPaymentMethod) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{30, 1}
+	return fileDescriptor_98a910b73321e591, []int{31, 1}
 }
 
 type MembershipEmailVerificationStatus int32
@@ -2243,7 +2304,7 @@ func (x MembershipEmailVerificationStatus) String() string {
 }
 
 func (MembershipEmailVerificationStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{30, 2}
+	return fileDescriptor_98a910b73321e591, []int{31, 2}
 }
 
 type MembershipTierDataPeriodType int32
@@ -2280,7 +2341,7 @@ func (x MembershipTierDataPeriodType) String() string {
 }
 
 func (MembershipTierDataPeriodType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{31, 0}
+	return fileDescriptor_98a910b73321e591, []int{32, 0}
 }
 
 type ChatMessageAttachmentAttachmentType int32
@@ -2308,7 +2369,7 @@ func (x ChatMessageAttachmentAttachmentType) String() string {
 }
 
 func (ChatMessageAttachmentAttachmentType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{34, 1, 0}
+	return fileDescriptor_98a910b73321e591, []int{35, 1, 0}
 }
 
 type SmartBlockSnapshotBase struct {
@@ -2477,6 +2538,7 @@ type SearchResult struct {
 	ObjectId string        `protobuf:"bytes,1,opt,name=objectId,proto3" json:"objectId,omitempty"`
 	Details  *types.Struct `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
 	Meta     []*SearchMeta `protobuf:"bytes,3,rep,name=meta,proto3" json:"meta,omitempty"`
+	SpaceId  string        `protobuf:"bytes,4,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
 }
 
 func (m *SearchResult) Reset()         { *m = SearchResult{} }
@@ -2533,12 +2595,21 @@ func (m *SearchResult) GetMeta() []*SearchMeta {
 	return nil
 }
 
+func (m *SearchResult) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
 type SearchMeta struct {
 	Highlight       string        `protobuf:"bytes,1,opt,name=highlight,proto3" json:"highlight,omitempty"`
 	HighlightRanges []*Range      `protobuf:"bytes,2,rep,name=highlightRanges,proto3" json:"highlightRanges,omitempty"`
 	BlockId         string        `protobuf:"bytes,3,opt,name=blockId,proto3" json:"blockId,omitempty"`
 	RelationKey     string        `protobuf:"bytes,4,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
 	RelationDetails *types.Struct `protobuf:"bytes,5,opt,name=relationDetails,proto3" json:"relationDetails,omitempty"`
+	MessageId       string        `protobuf:"bytes,6,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	Page            int32         `protobuf:"varint,7,opt,name=page,proto3" json:"page,omitempty"`
 }
 
 func (m *SearchMeta) Reset()         { *m = SearchMeta{} }
@@ -2609,6 +2680,20 @@ func (m *SearchMeta) GetRelationDetails() *types.Struct {
 	return nil
 }
 
+func (m *SearchMeta) GetMessageId() string {
+	if m != nil {
+		return m.MessageId
+	}
+	return ""
+}
+
+func (m *SearchMeta) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
 type Block struct {
 	Id              string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Fields          *types.Struct      `protobuf:"bytes,2,opt,name=fields,proto3" json:"fields,omitempty"`
@@ -2618,7 +2703,6 @@ type Block struct {
 	Align           BlockAlign         `protobuf:"varint,6,opt,name=align,proto3,enum=anytype.model.BlockAlign" json:"align,omitempty"`
 	VerticalAlign   BlockVerticalAlign `protobuf:"varint,7,opt,name=verticalAlign,proto3,enum=anytype.model.BlockVerticalAlign" json:"verticalAlign,omitempty"`
 	// Types that are valid to be assigned to Content:
-	//
 	//	*BlockContentOfSmartblock
 	//	*BlockContentOfText
 	//	*BlockContentOfFile
@@ -2637,6 +2721,7 @@ type Block struct {
 	//	*BlockContentOfTableRow
 	//	*BlockContentOfWidget
 	//	*BlockContentOfChat
+	//	*BlockContentOfSynced
 	Content IsBlockContent `protobuf_oneof:"content"`
 }
 
@@ -2733,6 +2818,9 @@ type BlockContentOfWidget struct {
 type BlockContentOfChat struct {
 	Chat *BlockContentChat `protobuf:"bytes,30,opt,name=chat,proto3,oneof" json:"chat,omitempty"`
 }
+type BlockContentOfSynced struct {
+	Synced *BlockContentSynced `protobuf:"bytes,31,opt,name=synced,proto3,oneof" json:"synced,omitempty"`
+}
 
 func (*BlockContentOfSmartblock) IsBlockContent()        {}
 func (*BlockContentOfText) IsBlockContent()              {}
@@ -2752,6 +2840,7 @@ func (*BlockContentOfTableColumn) IsBlockContent()       {}
 func (*BlockContentOfTableRow) IsBlockContent()          {}
 func (*BlockContentOfWidget) IsBlockContent()            {}
 func (*BlockContentOfChat) IsBlockContent()              {}
+func (*BlockContentOfSynced) IsBlockContent()            {}
 
 func (m *Block) GetContent() IsBlockContent {
 	if m != nil {
@@ -2935,6 +3024,13 @@ func (m *Block) GetChat() *BlockContentChat {
 	return nil
 }
 
+func (m *Block) GetSynced() *BlockContentSynced {
+	if x, ok := m.GetContent().(*BlockContentOfSynced); ok {
+		return x.Synced
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Block) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -2956,6 +3052,7 @@ func (*Block) XXX_OneofWrappers() []interface{} {
 		(*BlockContentOfTableRow)(nil),
 		(*BlockContentOfWidget)(nil),
 		(*BlockContentOfChat)(nil),
+		(*BlockContentOfSynced)(nil),
 	}
 }
 
@@ -3126,6 +3223,7 @@ type BlockContentLink struct {
 	CardStyle     BlockContentLinkCardStyle   `protobuf:"varint,5,opt,name=cardStyle,proto3,enum=anytype.model.BlockContentLinkCardStyle" json:"cardStyle,omitempty"`
 	Description   BlockContentLinkDescription `protobuf:"varint,6,opt,name=description,proto3,enum=anytype.model.BlockContentLinkDescription" json:"description,omitempty"`
 	Relations     []string                    `protobuf:"bytes,7,rep,name=relations,proto3" json:"relations,omitempty"`
+	FocusBlockId  string                      `protobuf:"bytes,8,opt,name=focusBlockId,proto3" json:"focusBlockId,omitempty"`
 }
 
 func (m *BlockContentLink) Reset()         { *m = BlockContentLink{} }
@@ -3210,6 +3308,13 @@ func (m *BlockContentLink) GetRelations() []string {
 	return nil
 }
 
+func (m *BlockContentLink) GetFocusBlockId() string {
+	if m != nil {
+		return m.FocusBlockId
+	}
+	return ""
+}
+
 // Divider: block, that contains only one horizontal thin line
 type BlockContentDiv struct {
 	Style BlockContentDivStyle `protobuf:"varint,1,opt,name=style,proto3,enum=anytype.model.BlockContentDivStyle" json:"style,omitempty"`
@@ -3577,9 +3682,10 @@ func (m *BlockContentTextMarks) GetMarks() []*BlockContentTextMark {
 }
 
 type BlockContentTextMark struct {
-	Range *Range                   `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
-	Type  BlockContentTextMarkType `protobuf:"varint,2,opt,name=type,proto3,enum=anytype.model.BlockContentTextMarkType" json:"type,omitempty"`
-	Param string                   `protobuf:"bytes,3,opt,name=param,proto3" json:"param,omitempty"`
+	Range        *Range                   `protobuf:"bytes,1,opt,name=range,proto3" json:"range,omitempty"`
+	Type         BlockContentTextMarkType `protobuf:"varint,2,opt,name=type,proto3,enum=anytype.model.BlockContentTextMarkType" json:"type,omitempty"`
+	Param        string                   `protobuf:"bytes,3,opt,name=param,proto3" json:"param,omitempty"`
+	FocusBlockId string                   `protobuf:"bytes,4,opt,name=focusBlockId,proto3" json:"focusBlockId,omitempty"`
 }
 
 func (m *BlockContentTextMark) Reset()         { *m = BlockContentTextMark{} }
@@ -3636,6 +3742,13 @@ func (m *BlockContentTextMark) GetParam() string {
 	return ""
 }
 
+func (m *BlockContentTextMark) GetFocusBlockId() string {
+	if m != nil {
+		return m.FocusBlockId
+	}
+	return ""
+}
+
 type BlockContentFile struct {
 	Hash           string                `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 	Name           string                `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -4536,7 +4649,6 @@ func (m *BlockContentDataviewObjectOrder) GetObjectIds() []string {
 type BlockContentDataviewGroup struct {
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// Types that are valid to be assigned to Value:
-	//
 	//	*BlockContentDataviewGroupValueOfStatus
 	//	*BlockContentDataviewGroupValueOfTag
 	//	*BlockContentDataviewGroupValueOfCheckbox
@@ -5026,7 +5138,8 @@ func (m *BlockContentTableColumn) XXX_DiscardUnknown() {
 var xxx_messageInfo_BlockContentTableColumn proto.InternalMessageInfo
 
 type BlockContentTableRow struct {
-	IsHeader bool `protobuf:"varint,1,opt,name=isHeader,proto3" json:"isHeader,omitempty"`
+	IsHeader  bool                            `protobuf:"varint,1,opt,name=isHeader,proto3" json:"isHeader,omitempty"`
+	CellSpans []*BlockContentTableRowCellSpan `protobuf:"bytes,2,rep,name=cellSpans,proto3" json:"cellSpans,omitempty"`
 }
 
 func (m *BlockContentTableRow) Reset()         { *m = BlockContentTableRow{} }
@@ -5069,6 +5182,73 @@ func (m *BlockContentTableRow) GetIsHeader() bool {
 	return false
 }
 
+func (m *BlockContentTableRow) GetCellSpans() []*BlockContentTableRowCellSpan {
+	if m != nil {
+		return m.CellSpans
+	}
+	return nil
+}
+
+type BlockContentTableRowCellSpan struct {
+	ColumnId string `protobuf:"bytes,1,opt,name=columnId,proto3" json:"columnId,omitempty"`
+	RowSpan  int32  `protobuf:"varint,2,opt,name=rowSpan,proto3" json:"rowSpan,omitempty"`
+	ColSpan  int32  `protobuf:"varint,3,opt,name=colSpan,proto3" json:"colSpan,omitempty"`
+}
+
+func (m *BlockContentTableRowCellSpan) Reset()         { *m = BlockContentTableRowCellSpan{} }
+func (m *BlockContentTableRowCellSpan) String() string { return proto.CompactTextString(m) }
+func (*BlockContentTableRowCellSpan) ProtoMessage()    {}
+func (*BlockContentTableRowCellSpan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{2, 1, 15, 0}
+}
+func (m *BlockContentTableRowCellSpan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockContentTableRowCellSpan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockContentTableRowCellSpan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockContentTableRowCellSpan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContentTableRowCellSpan.Merge(m, src)
+}
+func (m *BlockContentTableRowCellSpan) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockContentTableRowCellSpan) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContentTableRowCellSpan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContentTableRowCellSpan proto.InternalMessageInfo
+
+func (m *BlockContentTableRowCellSpan) GetColumnId() string {
+	if m != nil {
+		return m.ColumnId
+	}
+	return ""
+}
+
+func (m *BlockContentTableRowCellSpan) GetRowSpan() int32 {
+	if m != nil {
+		return m.RowSpan
+	}
+	return 0
+}
+
+func (m *BlockContentTableRowCellSpan) GetColSpan() int32 {
+	if m != nil {
+		return m.ColSpan
+	}
+	return 0
+}
+
 type BlockContentWidget struct {
 	Layout BlockContentWidgetLayout `protobuf:"varint,1,opt,name=layout,proto3,enum=anytype.model.BlockContentWidgetLayout" json:"layout,omitempty"`
 	Limit  int32                    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
@@ -5165,6 +5345,59 @@ func (m *BlockContentChat) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BlockContentChat proto.InternalMessageInfo
 
+// Synced block shows the block subtree of another object, the subtree is edited in that object
+type BlockContentSynced struct {
+	TargetObjectId string `protobuf:"bytes,1,opt,name=targetObjectId,proto3" json:"targetObjectId,omitempty"`
+	TargetBlockId  string `protobuf:"bytes,2,opt,name=targetBlockId,proto3" json:"targetBlockId,omitempty"`
+}
+
+func (m *BlockContentSynced) Reset()         { *m = BlockContentSynced{} }
+func (m *BlockContentSynced) String() string { return proto.CompactTextString(m) }
+func (*BlockContentSynced) ProtoMessage()    {}
+func (*BlockContentSynced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{2, 1, 18}
+}
+func (m *BlockContentSynced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockContentSynced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockContentSynced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockContentSynced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockContentSynced.Merge(m, src)
+}
+func (m *BlockContentSynced) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockContentSynced) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockContentSynced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockContentSynced proto.InternalMessageInfo
+
+func (m *BlockContentSynced) GetTargetObjectId() string {
+	if m != nil {
+		return m.TargetObjectId
+	}
+	return ""
+}
+
+func (m *BlockContentSynced) GetTargetBlockId() string {
+	if m != nil {
+		return m.TargetBlockId
+	}
+	return ""
+}
+
 // Used to decode block meta only, without the content itself
 type BlockMetaOnly struct {
 	Id     string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -6655,6 +6888,7 @@ type ObjectView struct {
 	Restrictions      *Restrictions                 `protobuf:"bytes,8,opt,name=restrictions,proto3" json:"restrictions,omitempty"`
 	History           *ObjectViewHistorySize        `protobuf:"bytes,9,opt,name=history,proto3" json:"history,omitempty"`
 	BlockParticipants []*ObjectViewBlockParticipant `protobuf:"bytes,11,rep,name=blockParticipants,proto3" json:"blockParticipants,omitempty"`
+	FocusBlockId      string                        `protobuf:"bytes,12,opt,name=focusBlockId,proto3" json:"focusBlockId,omitempty"`
 }
 
 func (m *ObjectView) Reset()         { *m = ObjectView{} }
@@ -6753,6 +6987,13 @@ func (m *ObjectView) GetBlockParticipants() []*ObjectViewBlockParticipant {
 	return nil
 }
 
+func (m *ObjectView) GetFocusBlockId() string {
+	if m != nil {
+		return m.FocusBlockId
+	}
+	return ""
+}
+
 type ObjectViewDetailsSet struct {
 	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Details *types.Struct `protobuf:"bytes,2,opt,name=details,proto3" json:"details,omitempty"`
@@ -7023,7 +7264,6 @@ func (m *ParticipantPermissionChange) GetPerms() ParticipantPermissions {
 
 type Metadata struct {
 	// Types that are valid to be assigned to Payload:
-	//
 	//	*MetadataPayloadOfIdentity
 	Payload IsMetadataPayload `protobuf_oneof:"payload"`
 }
@@ -7180,7 +7420,6 @@ type Notification struct {
 	Status     NotificationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=anytype.model.NotificationStatus" json:"status,omitempty"`
 	IsLocal    bool               `protobuf:"varint,5,opt,name=isLocal,proto3" json:"isLocal,omitempty"`
 	// Types that are valid to be assigned to Payload:
-	//
 	//	*NotificationPayloadOfImport
 	//	*NotificationPayloadOfExport
 	//	*NotificationPayloadOfGalleryImport
@@ -7191,6 +7430,9 @@ type Notification struct {
 	//	*NotificationPayloadOfParticipantRemove
 	//	*NotificationPayloadOfParticipantRequestDecline
 	//	*NotificationPayloadOfParticipantPermissionsChange
+	//	*NotificationPayloadOfReminder
+	//	*NotificationPayloadOfBackup
+	//	*NotificationPayloadOfComment
 	Payload   IsNotificationPayload `protobuf_oneof:"payload"`
 	Space     string                `protobuf:"bytes,7,opt,name=space,proto3" json:"space,omitempty"`
 	AclHeadId string                `protobuf:"bytes,14,opt,name=aclHeadId,proto3" json:"aclHeadId,omitempty"`
@@ -7265,6 +7507,15 @@ type NotificationPayloadOfParticipantRequestDecline struct {
 type NotificationPayloadOfParticipantPermissionsChange struct {
 	ParticipantPermissionsChange *NotificationParticipantPermissionsChange `protobuf:"bytes,18,opt,name=participantPermissionsChange,proto3,oneof" json:"participantPermissionsChange,omitempty"`
 }
+type NotificationPayloadOfReminder struct {
+	Reminder *NotificationReminder `protobuf:"bytes,19,opt,name=reminder,proto3,oneof" json:"reminder,omitempty"`
+}
+type NotificationPayloadOfBackup struct {
+	Backup *NotificationBackup `protobuf:"bytes,20,opt,name=backup,proto3,oneof" json:"backup,omitempty"`
+}
+type NotificationPayloadOfComment struct {
+	Comment *NotificationComment `protobuf:"bytes,21,opt,name=comment,proto3,oneof" json:"comment,omitempty"`
+}
 
 func (*NotificationPayloadOfImport) IsNotificationPayload()                       {}
 func (*NotificationPayloadOfExport) IsNotificationPayload()                       {}
@@ -7276,6 +7527,9 @@ func (*NotificationPayloadOfRequestToLeave) IsNotificationPayload()
 func (*NotificationPayloadOfParticipantRemove) IsNotificationPayload()            {}
 func (*NotificationPayloadOfParticipantRequestDecline) IsNotificationPayload()    {}
 func (*NotificationPayloadOfParticipantPermissionsChange) IsNotificationPayload() {}
+func (*NotificationPayloadOfReminder) IsNotificationPayload()                     {}
+func (*NotificationPayloadOfBackup) IsNotificationPayload()                       {}
+func (*NotificationPayloadOfComment) IsNotificationPayload()                      {}
 
 func (m *Notification) GetPayload() IsNotificationPayload {
 	if m != nil {
@@ -7382,6 +7636,27 @@ func (m *Notification) GetParticipantPermissionsChange() *NotificationParticipan
 	return nil
 }
 
+func (m *Notification) GetReminder() *NotificationReminder {
+	if x, ok := m.GetPayload().(*NotificationPayloadOfReminder); ok {
+		return x.Reminder
+	}
+	return nil
+}
+
+func (m *Notification) GetBackup() *NotificationBackup {
+	if x, ok := m.GetPayload().(*NotificationPayloadOfBackup); ok {
+		return x.Backup
+	}
+	return nil
+}
+
+func (m *Notification) GetComment() *NotificationComment {
+	if x, ok := m.GetPayload().(*NotificationPayloadOfComment); ok {
+		return x.Comment
+	}
+	return nil
+}
+
 func (m *Notification) GetSpace() string {
 	if m != nil {
 		return m.Space
@@ -7409,6 +7684,9 @@ func (*Notification) XXX_OneofWrappers() []interface{} {
 		(*NotificationPayloadOfParticipantRemove)(nil),
 		(*NotificationPayloadOfParticipantRequestDecline)(nil),
 		(*NotificationPayloadOfParticipantPermissionsChange)(nil),
+		(*NotificationPayloadOfReminder)(nil),
+		(*NotificationPayloadOfBackup)(nil),
+		(*NotificationPayloadOfComment)(nil),
 	}
 }
 
@@ -8064,6 +8342,268 @@ func (m *NotificationParticipantPermissionsChange) GetSpaceName() string {
 	return ""
 }
 
+type NotificationReminder struct {
+	SpaceId     string `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	ObjectId    string `protobuf:"bytes,2,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	ObjectName  string `protobuf:"bytes,3,opt,name=objectName,proto3" json:"objectName,omitempty"`
+	RelationKey string `protobuf:"bytes,4,opt,name=relationKey,proto3" json:"relationKey,omitempty"`
+	Date        int64  `protobuf:"varint,5,opt,name=date,proto3" json:"date,omitempty"`
+	SpaceName   string `protobuf:"bytes,6,opt,name=spaceName,proto3" json:"spaceName,omitempty"`
+}
+
+func (m *NotificationReminder) Reset()         { *m = NotificationReminder{} }
+func (m *NotificationReminder) String() string { return proto.CompactTextString(m) }
+func (*NotificationReminder) ProtoMessage()    {}
+func (*NotificationReminder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21, 10}
+}
+func (m *NotificationReminder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationReminder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationReminder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationReminder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationReminder.Merge(m, src)
+}
+func (m *NotificationReminder) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationReminder) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationReminder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationReminder proto.InternalMessageInfo
+
+func (m *NotificationReminder) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetRelationKey() string {
+	if m != nil {
+		return m.RelationKey
+	}
+	return ""
+}
+
+func (m *NotificationReminder) GetDate() int64 {
+	if m != nil {
+		return m.Date
+	}
+	return 0
+}
+
+func (m *NotificationReminder) GetSpaceName() string {
+	if m != nil {
+		return m.SpaceName
+	}
+	return ""
+}
+
+type NotificationBackup struct {
+	ErrorCode NotificationBackupCode `protobuf:"varint,1,opt,name=errorCode,proto3,enum=anytype.model.NotificationBackupCode" json:"errorCode,omitempty"`
+	SpaceId   string                 `protobuf:"bytes,2,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	SpaceName string                 `protobuf:"bytes,3,opt,name=spaceName,proto3" json:"spaceName,omitempty"`
+	// path of the written archive, empty when nothing has changed since the previous backup
+	Path          string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	IsIncremental bool   `protobuf:"varint,5,opt,name=isIncremental,proto3" json:"isIncremental,omitempty"`
+	ObjectsCount  int64  `protobuf:"varint,6,opt,name=objectsCount,proto3" json:"objectsCount,omitempty"`
+}
+
+func (m *NotificationBackup) Reset()         { *m = NotificationBackup{} }
+func (m *NotificationBackup) String() string { return proto.CompactTextString(m) }
+func (*NotificationBackup) ProtoMessage()    {}
+func (*NotificationBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21, 11}
+}
+func (m *NotificationBackup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationBackup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationBackup.Merge(m, src)
+}
+func (m *NotificationBackup) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationBackup proto.InternalMessageInfo
+
+func (m *NotificationBackup) GetErrorCode() NotificationBackupCode {
+	if m != nil {
+		return m.ErrorCode
+	}
+	return NotificationBackup_NULL
+}
+
+func (m *NotificationBackup) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationBackup) GetSpaceName() string {
+	if m != nil {
+		return m.SpaceName
+	}
+	return ""
+}
+
+func (m *NotificationBackup) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *NotificationBackup) GetIsIncremental() bool {
+	if m != nil {
+		return m.IsIncremental
+	}
+	return false
+}
+
+func (m *NotificationBackup) GetObjectsCount() int64 {
+	if m != nil {
+		return m.ObjectsCount
+	}
+	return 0
+}
+
+// the account is mentioned in a comment
+type NotificationComment struct {
+	SpaceId   string `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	ObjectId  string `protobuf:"bytes,2,opt,name=objectId,proto3" json:"objectId,omitempty"`
+	ThreadId  string `protobuf:"bytes,3,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	CommentId string `protobuf:"bytes,4,opt,name=commentId,proto3" json:"commentId,omitempty"`
+	Identity  string `protobuf:"bytes,5,opt,name=identity,proto3" json:"identity,omitempty"`
+	Text      string `protobuf:"bytes,6,opt,name=text,proto3" json:"text,omitempty"`
+	SpaceName string `protobuf:"bytes,7,opt,name=spaceName,proto3" json:"spaceName,omitempty"`
+}
+
+func (m *NotificationComment) Reset()         { *m = NotificationComment{} }
+func (m *NotificationComment) String() string { return proto.CompactTextString(m) }
+func (*NotificationComment) ProtoMessage()    {}
+func (*NotificationComment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{21, 12}
+}
+func (m *NotificationComment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NotificationComment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NotificationComment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NotificationComment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NotificationComment.Merge(m, src)
+}
+func (m *NotificationComment) XXX_Size() int {
+	return m.Size()
+}
+func (m *NotificationComment) XXX_DiscardUnknown() {
+	xxx_messageInfo_NotificationComment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NotificationComment proto.InternalMessageInfo
+
+func (m *NotificationComment) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *NotificationComment) GetObjectId() string {
+	if m != nil {
+		return m.ObjectId
+	}
+	return ""
+}
+
+func (m *NotificationComment) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
+}
+
+func (m *NotificationComment) GetCommentId() string {
+	if m != nil {
+		return m.CommentId
+	}
+	return ""
+}
+
+func (m *NotificationComment) GetIdentity() string {
+	if m != nil {
+		return m.Identity
+	}
+	return ""
+}
+
+func (m *NotificationComment) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
+func (m *NotificationComment) GetSpaceName() string {
+	if m != nil {
+		return m.SpaceName
+	}
+	return ""
+}
+
 type Export struct {
 }
 
@@ -8100,6 +8640,132 @@ func (m *Export) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Export proto.InternalMessageInfo
 
+type Backup struct {
+}
+
+func (m *Backup) Reset()         { *m = Backup{} }
+func (m *Backup) String() string { return proto.CompactTextString(m) }
+func (*Backup) ProtoMessage()    {}
+func (*Backup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{23}
+}
+func (m *Backup) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Backup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Backup.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Backup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Backup.Merge(m, src)
+}
+func (m *Backup) XXX_Size() int {
+	return m.Size()
+}
+func (m *Backup) XXX_DiscardUnknown() {
+	xxx_messageInfo_Backup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Backup proto.InternalMessageInfo
+
+type BackupSettings struct {
+	SpaceId string `protobuf:"bytes,1,opt,name=spaceId,proto3" json:"spaceId,omitempty"`
+	// local directory for backups, backups of each space are written to its own subdirectory.
+	// Empty path disables backups of the space
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// interval between scheduled backups in seconds, 0 disables scheduled backups
+	Interval int64 `protobuf:"varint,3,opt,name=interval,proto3" json:"interval,omitempty"`
+	// number of incremental backups written after the full one before the next chain is started, default is 6
+	MaxIncrements int32 `protobuf:"varint,4,opt,name=maxIncrements,proto3" json:"maxIncrements,omitempty"`
+	// number of chains kept in the directory, older chains are removed, default is 2
+	KeepChains int32 `protobuf:"varint,5,opt,name=keepChains,proto3" json:"keepChains,omitempty"`
+	// include archived objects
+	IncludeArchived bool `protobuf:"varint,6,opt,name=includeArchived,proto3" json:"includeArchived,omitempty"`
+}
+
+func (m *BackupSettings) Reset()         { *m = BackupSettings{} }
+func (m *BackupSettings) String() string { return proto.CompactTextString(m) }
+func (*BackupSettings) ProtoMessage()    {}
+func (*BackupSettings) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{23, 0}
+}
+func (m *BackupSettings) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupSettings) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupSettings.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupSettings) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupSettings.Merge(m, src)
+}
+func (m *BackupSettings) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupSettings) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupSettings.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupSettings proto.InternalMessageInfo
+
+func (m *BackupSettings) GetSpaceId() string {
+	if m != nil {
+		return m.SpaceId
+	}
+	return ""
+}
+
+func (m *BackupSettings) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *BackupSettings) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *BackupSettings) GetMaxIncrements() int32 {
+	if m != nil {
+		return m.MaxIncrements
+	}
+	return 0
+}
+
+func (m *BackupSettings) GetKeepChains() int32 {
+	if m != nil {
+		return m.KeepChains
+	}
+	return 0
+}
+
+func (m *BackupSettings) GetIncludeArchived() bool {
+	if m != nil {
+		return m.IncludeArchived
+	}
+	return false
+}
+
 type Import struct {
 }
 
@@ -8107,7 +8773,7 @@ func (m *Import) Reset()         { *m = Import{} }
 func (m *Import) String() string { return proto.CompactTextString(m) }
 func (*Import) ProtoMessage()    {}
 func (*Import) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{23}
+	return fileDescriptor_98a910b73321e591, []int{24}
 }
 func (m *Import) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8145,7 +8811,7 @@ func (m *Invite) Reset()         { *m = Invite{} }
 func (m *Invite) String() string { return proto.CompactTextString(m) }
 func (*Invite) ProtoMessage()    {}
 func (*Invite) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{24}
+	return fileDescriptor_98a910b73321e591, []int{25}
 }
 func (m *Invite) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8202,7 +8868,7 @@ func (m *InvitePayload) Reset()         { *m = InvitePayload{} }
 func (m *InvitePayload) String() string { return proto.CompactTextString(m) }
 func (*InvitePayload) ProtoMessage()    {}
 func (*InvitePayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{25}
+	return fileDescriptor_98a910b73321e591, []int{26}
 }
 func (m *InvitePayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8293,7 +8959,7 @@ func (m *IdentityProfile) Reset()         { *m = IdentityProfile{} }
 func (m *IdentityProfile) String() string { return proto.CompactTextString(m) }
 func (*IdentityProfile) ProtoMessage()    {}
 func (*IdentityProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{26}
+	return fileDescriptor_98a910b73321e591, []int{27}
 }
 func (m *IdentityProfile) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8373,7 +9039,7 @@ func (m *FileInfo) Reset()         { *m = FileInfo{} }
 func (m *FileInfo) String() string { return proto.CompactTextString(m) }
 func (*FileInfo) ProtoMessage()    {}
 func (*FileInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{27}
+	return fileDescriptor_98a910b73321e591, []int{28}
 }
 func (m *FileInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8425,7 +9091,7 @@ func (m *FileEncryptionKey) Reset()         { *m = FileEncryptionKey{} }
 func (m *FileEncryptionKey) String() string { return proto.CompactTextString(m) }
 func (*FileEncryptionKey) ProtoMessage()    {}
 func (*FileEncryptionKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{28}
+	return fileDescriptor_98a910b73321e591, []int{29}
 }
 func (m *FileEncryptionKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8487,7 +9153,7 @@ func (m *ManifestInfo) Reset()         { *m = ManifestInfo{} }
 func (m *ManifestInfo) String() string { return proto.CompactTextString(m) }
 func (*ManifestInfo) ProtoMessage()    {}
 func (*ManifestInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{29}
+	return fileDescriptor_98a910b73321e591, []int{30}
 }
 func (m *ManifestInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8620,7 +9286,7 @@ func (m *Membership) Reset()         { *m = Membership{} }
 func (m *Membership) String() string { return proto.CompactTextString(m) }
 func (*Membership) ProtoMessage()    {}
 func (*Membership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{30}
+	return fileDescriptor_98a910b73321e591, []int{31}
 }
 func (m *Membership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8759,7 +9425,7 @@ func (m *MembershipTierData) Reset()         { *m = MembershipTierData{} }
 func (m *MembershipTierData) String() string { return proto.CompactTextString(m) }
 func (*MembershipTierData) ProtoMessage()    {}
 func (*MembershipTierData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{31}
+	return fileDescriptor_98a910b73321e591, []int{32}
 }
 func (m *MembershipTierData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8916,7 +9582,7 @@ func (m *Detail) Reset()         { *m = Detail{} }
 func (m *Detail) String() string { return proto.CompactTextString(m) }
 func (*Detail) ProtoMessage()    {}
 func (*Detail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{32}
+	return fileDescriptor_98a910b73321e591, []int{33}
 }
 func (m *Detail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -8971,7 +9637,7 @@ func (m *DeviceInfo) Reset()         { *m = DeviceInfo{} }
 func (m *DeviceInfo) String() string { return proto.CompactTextString(m) }
 func (*DeviceInfo) ProtoMessage()    {}
 func (*DeviceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{33}
+	return fileDescriptor_98a910b73321e591, []int{34}
 }
 func (m *DeviceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9051,7 +9717,7 @@ func (m *ChatMessage) Reset()         { *m = ChatMessage{} }
 func (m *ChatMessage) String() string { return proto.CompactTextString(m) }
 func (*ChatMessage) ProtoMessage()    {}
 func (*ChatMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{34}
+	return fileDescriptor_98a910b73321e591, []int{35}
 }
 func (m *ChatMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9153,7 +9819,7 @@ func (m *ChatMessageMessageContent) Reset()         { *m = ChatMessageMessageCon
 func (m *ChatMessageMessageContent) String() string { return proto.CompactTextString(m) }
 func (*ChatMessageMessageContent) ProtoMessage()    {}
 func (*ChatMessageMessageContent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{34, 0}
+	return fileDescriptor_98a910b73321e591, []int{35, 0}
 }
 func (m *ChatMessageMessageContent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9212,7 +9878,7 @@ func (m *ChatMessageAttachment) Reset()         { *m = ChatMessageAttachment{} }
 func (m *ChatMessageAttachment) String() string { return proto.CompactTextString(m) }
 func (*ChatMessageAttachment) ProtoMessage()    {}
 func (*ChatMessageAttachment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{34, 1}
+	return fileDescriptor_98a910b73321e591, []int{35, 1}
 }
 func (m *ChatMessageAttachment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9263,7 +9929,7 @@ func (m *ChatMessageReactions) Reset()         { *m = ChatMessageReactions{} }
 func (m *ChatMessageReactions) String() string { return proto.CompactTextString(m) }
 func (*ChatMessageReactions) ProtoMessage()    {}
 func (*ChatMessageReactions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{34, 2}
+	return fileDescriptor_98a910b73321e591, []int{35, 2}
 }
 func (m *ChatMessageReactions) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9307,7 +9973,7 @@ func (m *ChatMessageReactionsIdentityList) Reset()         { *m = ChatMessageRea
 func (m *ChatMessageReactionsIdentityList) String() string { return proto.CompactTextString(m) }
 func (*ChatMessageReactionsIdentityList) ProtoMessage()    {}
 func (*ChatMessageReactionsIdentityList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_98a910b73321e591, []int{34, 2, 1}
+	return fileDescriptor_98a910b73321e591, []int{35, 2, 1}
 }
 func (m *ChatMessageReactionsIdentityList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -9343,6 +10009,185 @@ func (m *ChatMessageReactionsIdentityList) GetIds() []string {
 	return nil
 }
 
+// Comment of an object. Comments are grouped into threads anchored to a text range of a block
+type Comment struct {
+	Id         string                     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ThreadId   string                     `protobuf:"bytes,2,opt,name=threadId,proto3" json:"threadId,omitempty"`
+	OrderId    string                     `protobuf:"bytes,3,opt,name=orderId,proto3" json:"orderId,omitempty"`
+	Creator    string                     `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	CreatedAt  int64                      `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ModifiedAt int64                      `protobuf:"varint,6,opt,name=modifiedAt,proto3" json:"modifiedAt,omitempty"`
+	Message    *ChatMessageMessageContent `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	Anchor     *CommentAnchor             `protobuf:"bytes,8,opt,name=anchor,proto3" json:"anchor,omitempty"`
+	Resolved   bool                       `protobuf:"varint,9,opt,name=resolved,proto3" json:"resolved,omitempty"`
+	ResolvedBy string                     `protobuf:"bytes,10,opt,name=resolvedBy,proto3" json:"resolvedBy,omitempty"`
+}
+
+func (m *Comment) Reset()         { *m = Comment{} }
+func (m *Comment) String() string { return proto.CompactTextString(m) }
+func (*Comment) ProtoMessage()    {}
+func (*Comment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{36}
+}
+func (m *Comment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Comment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Comment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Comment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Comment.Merge(m, src)
+}
+func (m *Comment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Comment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Comment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Comment proto.InternalMessageInfo
+
+func (m *Comment) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Comment) GetThreadId() string {
+	if m != nil {
+		return m.ThreadId
+	}
+	return ""
+}
+
+func (m *Comment) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *Comment) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Comment) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *Comment) GetModifiedAt() int64 {
+	if m != nil {
+		return m.ModifiedAt
+	}
+	return 0
+}
+
+func (m *Comment) GetMessage() *ChatMessageMessageContent {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+func (m *Comment) GetAnchor() *CommentAnchor {
+	if m != nil {
+		return m.Anchor
+	}
+	return nil
+}
+
+func (m *Comment) GetResolved() bool {
+	if m != nil {
+		return m.Resolved
+	}
+	return false
+}
+
+func (m *Comment) GetResolvedBy() string {
+	if m != nil {
+		return m.ResolvedBy
+	}
+	return ""
+}
+
+// Anchor is the text range the thread was started for. The range is marked by the Comment mark
+// in the text of the block, so the current range is the range of the mark
+type CommentAnchor struct {
+	BlockId string `protobuf:"bytes,1,opt,name=blockId,proto3" json:"blockId,omitempty"`
+	Range   *Range `protobuf:"bytes,2,opt,name=range,proto3" json:"range,omitempty"`
+	Quote   string `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+}
+
+func (m *CommentAnchor) Reset()         { *m = CommentAnchor{} }
+func (m *CommentAnchor) String() string { return proto.CompactTextString(m) }
+func (*CommentAnchor) ProtoMessage()    {}
+func (*CommentAnchor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98a910b73321e591, []int{36, 0}
+}
+func (m *CommentAnchor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommentAnchor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommentAnchor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommentAnchor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommentAnchor.Merge(m, src)
+}
+func (m *CommentAnchor) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommentAnchor) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommentAnchor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommentAnchor proto.InternalMessageInfo
+
+func (m *CommentAnchor) GetBlockId() string {
+	if m != nil {
+		return m.BlockId
+	}
+	return ""
+}
+
+func (m *CommentAnchor) GetRange() *Range {
+	if m != nil {
+		return m.Range
+	}
+	return nil
+}
+
+func (m *CommentAnchor) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("anytype.model.SmartBlockType", SmartBlockType_name, SmartBlockType_value)
 	proto.RegisterEnum("anytype.model.RelationFormat", RelationFormat_name, RelationFormat_value)
@@ -9394,6 +10239,7 @@ func init() {
 	proto.RegisterEnum("anytype.model.NotificationStatus", NotificationStatus_name, NotificationStatus_value)
 	proto.RegisterEnum("anytype.model.NotificationActionType", NotificationActionType_name, NotificationActionType_value)
 	proto.RegisterEnum("anytype.model.NotificationExportCode", NotificationExportCode_name, NotificationExportCode_value)
+	proto.RegisterEnum("anytype.model.NotificationBackupCode", NotificationBackupCode_name, NotificationBackupCode_value)
 	proto.RegisterEnum("anytype.model.ExportFormat", ExportFormat_name, ExportFormat_value)
 	proto.RegisterEnum("anytype.model.ImportType", ImportType_name, ImportType_value)
 	proto.RegisterEnum("anytype.model.ImportErrorCode", ImportErrorCode_name, ImportErrorCode_value)
@@ -9439,8 +10285,10 @@ func init() {
 	proto.RegisterType((*BlockContentTable)(nil), "anytype.model.Block.Content.Table")
 	proto.RegisterType((*BlockContentTableColumn)(nil), "anytype.model.Block.Content.TableColumn")
 	proto.RegisterType((*BlockContentTableRow)(nil), "anytype.model.Block.Content.TableRow")
+	proto.RegisterType((*BlockContentTableRowCellSpan)(nil), "anytype.model.Block.Content.TableRow.CellSpan")
 	proto.RegisterType((*BlockContentWidget)(nil), "anytype.model.Block.Content.Widget")
 	proto.RegisterType((*BlockContentChat)(nil), "anytype.model.Block.Content.Chat")
+	proto.RegisterType((*BlockContentSynced)(nil), "anytype.model.Block.Content.Synced")
 	proto.RegisterType((*BlockMetaOnly)(nil), "anytype.model.BlockMetaOnly")
 	proto.RegisterType((*Range)(nil), "anytype.model.Range")
 	proto.RegisterType((*Account)(nil), "anytype.model.Account")
@@ -9482,7 +10330,12 @@ func init() {
 	proto.RegisterType((*NotificationParticipantRemove)(nil), "anytype.model.Notification.ParticipantRemove")
 	proto.RegisterType((*NotificationParticipantRequestDecline)(nil), "anytype.model.Notification.ParticipantRequestDecline")
 	proto.RegisterType((*NotificationParticipantPermissionsChange)(nil), "anytype.model.Notification.ParticipantPermissionsChange")
+	proto.RegisterType((*NotificationReminder)(nil), "anytype.model.Notification.Reminder")
+	proto.RegisterType((*NotificationBackup)(nil), "anytype.model.Notification.Backup")
+	proto.RegisterType((*NotificationComment)(nil), "anytype.model.Notification.Comment")
 	proto.RegisterType((*Export)(nil), "anytype.model.Export")
+	proto.RegisterType((*Backup)(nil), "anytype.model.Backup")
+	proto.RegisterType((*BackupSettings)(nil), "anytype.model.Backup.Settings")
 	proto.RegisterType((*Import)(nil), "anytype.model.Import")
 	proto.RegisterType((*Invite)(nil), "anytype.model.Invite")
 	proto.RegisterType((*InvitePayload)(nil), "anytype.model.InvitePayload")
@@ -9500,6 +10353,8 @@ func init() {
 	proto.RegisterType((*ChatMessageReactions)(nil), "anytype.model.ChatMessage.Reactions")
 	proto.RegisterMapType((map[string]*ChatMessageReactionsIdentityList)(nil), "anytype.model.ChatMessage.Reactions.ReactionsEntry")
 	proto.RegisterType((*ChatMessageReactionsIdentityList)(nil), "anytype.model.ChatMessage.Reactions.IdentityList")
+	proto.RegisterType((*Comment)(nil), "anytype.model.Comment")
+	proto.RegisterType((*CommentAnchor)(nil), "anytype.model.Comment.Anchor")
 }
 
 func init() {
@@ -9507,566 +10362,601 @@ func init() {
 }
 
 var fileDescriptor_98a910b73321e591 = []byte{
-	// 8929 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x5b, 0x70, 0x23, 0xc9,
-	0x91, 0x18, 0xf1, 0x06, 0x12, 0x04, 0x59, 0xac, 0x79, 0x41, 0xd0, 0x68, 0x3c, 0x82, 0x56, 0xbb,
-	0xa3, 0xd1, 0x8a, 0xb3, 0x3b, 0xbb, 0xab, 0x5d, 0xed, 0x69, 0x57, 0x02, 0x41, 0x70, 0x88, 0x1d,
-	0x92, 0xa0, 0x1a, 0x98, 0x19, 0xed, 0xc6, 0x9d, 0xe9, 0x26, 0xba, 0x08, 0xb4, 0xd8, 0xe8, 0x86,
-	0xba, 0x0b, 0x1c, 0x72, 0xc3, 0x76, 0x9c, 0x5f, 0x77, 0xbe, 0x3f, 0xdd, 0xd9, 0x67, 0xfb, 0x3e,
-	0x1c, 0x27, 0xfd, 0x39, 0x7c, 0x0a, 0xbf, 0x22, 0x14, 0x7e, 0x84, 0x2f, 0xc2, 0xbe, 0x0f, 0xdb,
-	0x11, 0xfe, 0x91, 0xed, 0x1f, 0xff, 0xd9, 0x21, 0x45, 0xf8, 0xc7, 0x61, 0x5f, 0x9c, 0xfd, 0x73,
-	0xe1, 0xf0, 0x87, 0x23, 0xb3, 0xaa, 0x1f, 0x78, 0x90, 0x83, 0xd9, 0xbb, 0x73, 0xdc, 0x17, 0xbb,
-	0xb2, 0x33, 0xb3, 0xb3, 0xaa, 0xb2, 0xb2, 0x32, 0xb3, 0xb2, 0x40, 0x78, 0x65, 0x7c, 0x3a, 0x78,
-	0xe0, 0xd8, 0xc7, 0x0f, 0xc6, 0xc7, 0x0f, 0x46, 0x9e, 0x25, 0x9c, 0x07, 0x63, 0xdf, 0x93, 0x5e,
-	0xa0, 0x1a, 0xc1, 0x26, 0xb5, 0x78, 0xc5, 0x74, 0x2f, 0xe4, 0xc5, 0x58, 0x6c, 0x12, 0xb4, 0x76,
-	0x7b, 0xe0, 0x79, 0x03, 0x47, 0x28, 0xd4, 0xe3, 0xc9, 0xc9, 0x83, 0x40, 0xfa, 0x93, 0xbe, 0x54,
-	0xc8, 0xf5, 0x9f, 0x66, 0xe1, 0x66, 0x77, 0x64, 0xfa, 0x72, 0xcb, 0xf1, 0xfa, 0xa7, 0x5d, 0xd7,
-	0x1c, 0x07, 0x43, 0x4f, 0x6e, 0x99, 0x81, 0xe0, 0xaf, 0x43, 0xfe, 0x18, 0x81, 0x41, 0x35, 0x75,
-	0x37, 0x73, 0xaf, 0xfc, 0xf0, 0xfa, 0xe6, 0x14, 0xe3, 0x4d, 0xa2, 0x30, 0x34, 0x0e, 0x7f, 0x13,
-	0x0a, 0x96, 0x90, 0xa6, 0xed, 0x04, 0xd5, 0xf4, 0xdd, 0xd4, 0xbd, 0xf2, 0xc3, 0x5b, 0x9b, 0xea,
-	0xc3, 0x9b, 0xe1, 0x87, 0x37, 0xbb, 0xf4, 0x61, 0x23, 0xc4, 0xe3, 0xef, 0x42, 0xf1, 0xc4, 0x76,
-	0xc4, 0x63, 0x71, 0x11, 0x54, 0x33, 0x57, 0xd2, 0x6c, 0xa5, 0xab, 0x29, 0x23, 0x42, 0xe6, 0x4d,
-	0x58, 0x13, 0xe7, 0xd2, 0x37, 0x0d, 0xe1, 0x98, 0xd2, 0xf6, 0xdc, 0xa0, 0x9a, 0x25, 0x09, 0x6f,
-	0xcd, 0x48, 0x18, 0xbe, 0x27, 0xf2, 0x19, 0x12, 0x7e, 0x17, 0xca, 0xde, 0xf1, 0xf7, 0x44, 0x5f,
-	0xf6, 0x2e, 0xc6, 0x22, 0xa8, 0xe6, 0xee, 0x66, 0xee, 0x95, 0x8c, 0x24, 0x88, 0x7f, 0x03, 0xca,
-	0x7d, 0xcf, 0x71, 0x44, 0x5f, 0x7d, 0x23, 0x7f, 0x75, 0xb7, 0x92, 0xb8, 0xfc, 0x6d, 0xb8, 0xe1,
-	0x8b, 0x91, 0x77, 0x26, 0xac, 0x66, 0x04, 0xa5, 0x7e, 0x16, 0xe9, 0x33, 0x8b, 0x5f, 0xf2, 0x06,
-	0x54, 0x7c, 0x2d, 0xdf, 0x9e, 0xed, 0x9e, 0x06, 0xd5, 0x02, 0x75, 0xeb, 0xf3, 0x97, 0x74, 0x0b,
-	0x71, 0x8c, 0x69, 0x0a, 0xce, 0x20, 0x73, 0x2a, 0x2e, 0xaa, 0xa5, 0xbb, 0xa9, 0x7b, 0x25, 0x03,
-	0x1f, 0xf9, 0xfb, 0x50, 0xf5, 0x7c, 0x7b, 0x60, 0xbb, 0xa6, 0xd3, 0xf4, 0x85, 0x29, 0x85, 0xd5,
-	0xb3, 0x47, 0x22, 0x90, 0xe6, 0x68, 0x5c, 0x85, 0xbb, 0xa9, 0x7b, 0x19, 0xe3, 0xd2, 0xf7, 0xfc,
-	0x2d, 0x35, 0x43, 0x6d, 0xf7, 0xc4, 0xab, 0x96, 0x75, 0xf7, 0xa7, 0x65, 0xd9, 0xd1, 0xaf, 0x8d,
-	0x08, 0xb1, 0xfe, 0x87, 0x69, 0xc8, 0x77, 0x85, 0xe9, 0xf7, 0x87, 0xb5, 0x5f, 0x4d, 0x41, 0xde,
-	0x10, 0xc1, 0xc4, 0x91, 0xbc, 0x06, 0x45, 0x35, 0xb6, 0x6d, 0xab, 0x9a, 0x22, 0xe9, 0xa2, 0xf6,
-	0x67, 0xd1, 0x9d, 0x4d, 0xc8, 0x8e, 0x84, 0x34, 0xab, 0x19, 0x1a, 0xa1, 0xda, 0x8c, 0x54, 0xea,
-	0xf3, 0x9b, 0xfb, 0x42, 0x9a, 0x06, 0xe1, 0xd5, 0x7e, 0x9e, 0x82, 0x2c, 0x36, 0xf9, 0x6d, 0x28,
-	0x0d, 0xed, 0xc1, 0xd0, 0xb1, 0x07, 0x43, 0xa9, 0x05, 0x89, 0x01, 0xfc, 0x43, 0x58, 0x8f, 0x1a,
-	0x86, 0xe9, 0x0e, 0x04, 0x4a, 0xb4, 0x48, 0xf9, 0xe9, 0xa5, 0x31, 0x8b, 0xcc, 0xab, 0x50, 0xa0,
-	0xf5, 0xd0, 0xb6, 0x48, 0xa3, 0x4b, 0x46, 0xd8, 0x44, 0x75, 0x0b, 0x67, 0xea, 0xb1, 0xb8, 0xa8,
-	0x66, 0xe9, 0x6d, 0x12, 0xc4, 0x1b, 0xb0, 0x1e, 0x36, 0xb7, 0xf5, 0x68, 0xe4, 0xae, 0x1e, 0x8d,
-	0x59, 0xfc, 0xfa, 0x3f, 0xda, 0x83, 0x1c, 0x2d, 0x4b, 0xbe, 0x06, 0x69, 0x3b, 0x1c, 0xe8, 0xb4,
-	0x6d, 0xf1, 0x07, 0x90, 0x3f, 0xb1, 0x85, 0x63, 0xbd, 0x70, 0x84, 0x35, 0x1a, 0x6f, 0xc1, 0xaa,
-	0x2f, 0x02, 0xe9, 0xdb, 0x5a, 0xfb, 0xd5, 0x02, 0xfd, 0xe2, 0x22, 0x1b, 0xb0, 0x69, 0x24, 0x10,
-	0x8d, 0x29, 0x32, 0xec, 0x76, 0x7f, 0x68, 0x3b, 0x96, 0x2f, 0xdc, 0xb6, 0xa5, 0xd6, 0x69, 0xc9,
-	0x48, 0x82, 0xf8, 0x3d, 0x58, 0x3f, 0x36, 0xfb, 0xa7, 0x03, 0xdf, 0x9b, 0xb8, 0xb8, 0x20, 0x3c,
-	0x9f, 0xba, 0x5d, 0x32, 0x66, 0xc1, 0xfc, 0x0d, 0xc8, 0x99, 0x8e, 0x3d, 0x70, 0x69, 0x25, 0xae,
-	0xcd, 0x4d, 0xba, 0x92, 0xa5, 0x81, 0x18, 0x86, 0x42, 0xe4, 0xbb, 0x50, 0x39, 0x13, 0xbe, 0xb4,
-	0xfb, 0xa6, 0x43, 0xf0, 0x6a, 0x81, 0x28, 0xeb, 0x0b, 0x29, 0x9f, 0x26, 0x31, 0x8d, 0x69, 0x42,
-	0xde, 0x06, 0x08, 0xd0, 0x4c, 0xd2, 0x74, 0xea, 0xb5, 0xf0, 0xda, 0x42, 0x36, 0x4d, 0xcf, 0x95,
-	0xc2, 0x95, 0x9b, 0xdd, 0x08, 0x7d, 0x77, 0xc5, 0x48, 0x10, 0xf3, 0x77, 0x21, 0x2b, 0xc5, 0xb9,
-	0xac, 0xae, 0x5d, 0x31, 0xa2, 0x21, 0x93, 0x9e, 0x38, 0x97, 0xbb, 0x2b, 0x06, 0x11, 0x20, 0x21,
-	0x2e, 0xb2, 0xea, 0xfa, 0x12, 0x84, 0xb8, 0x2e, 0x91, 0x10, 0x09, 0xf8, 0x07, 0x90, 0x77, 0xcc,
-	0x0b, 0x6f, 0x22, 0xab, 0x8c, 0x48, 0xbf, 0x74, 0x25, 0xe9, 0x1e, 0xa1, 0xee, 0xae, 0x18, 0x9a,
-	0x88, 0xbf, 0x0d, 0x19, 0xcb, 0x3e, 0xab, 0x6e, 0x10, 0xed, 0xdd, 0x2b, 0x69, 0xb7, 0xed, 0xb3,
-	0xdd, 0x15, 0x03, 0xd1, 0x79, 0x13, 0x8a, 0xc7, 0x9e, 0x77, 0x3a, 0x32, 0xfd, 0xd3, 0x2a, 0x27,
-	0xd2, 0x2f, 0x5f, 0x49, 0xba, 0xa5, 0x91, 0x77, 0x57, 0x8c, 0x88, 0x10, 0xbb, 0x6c, 0xf7, 0x3d,
-	0xb7, 0x7a, 0x6d, 0x89, 0x2e, 0xb7, 0xfb, 0x9e, 0x8b, 0x5d, 0x46, 0x02, 0x24, 0x74, 0x6c, 0xf7,
-	0xb4, 0x7a, 0x7d, 0x09, 0x42, 0xb4, 0x9c, 0x48, 0x88, 0x04, 0x28, 0xb6, 0x65, 0x4a, 0xf3, 0xcc,
-	0x16, 0xcf, 0xab, 0x37, 0x96, 0x10, 0x7b, 0x5b, 0x23, 0xa3, 0xd8, 0x21, 0x21, 0x32, 0x09, 0x97,
-	0x66, 0xf5, 0xe6, 0x12, 0x4c, 0x42, 0x8b, 0x8e, 0x4c, 0x42, 0x42, 0xfe, 0x67, 0x61, 0xe3, 0x44,
-	0x98, 0x72, 0xe2, 0x0b, 0x2b, 0xde, 0xe8, 0x6e, 0x11, 0xb7, 0xcd, 0xab, 0xe7, 0x7e, 0x96, 0x6a,
-	0x77, 0xc5, 0x98, 0x67, 0xc5, 0xdf, 0x87, 0x9c, 0x63, 0x4a, 0x71, 0x5e, 0xad, 0x12, 0xcf, 0xfa,
-	0x0b, 0x94, 0x42, 0x8a, 0xf3, 0xdd, 0x15, 0x43, 0x91, 0xf0, 0xef, 0xc2, 0xba, 0x34, 0x8f, 0x1d,
-	0xd1, 0x39, 0xd1, 0x08, 0x41, 0xf5, 0x73, 0xc4, 0xe5, 0xf5, 0xab, 0xd5, 0x79, 0x9a, 0x66, 0x77,
-	0xc5, 0x98, 0x65, 0x83, 0x52, 0x11, 0xa8, 0x5a, 0x5b, 0x42, 0x2a, 0xe2, 0x87, 0x52, 0x11, 0x09,
-	0xdf, 0x83, 0x32, 0x3d, 0x34, 0x3d, 0x67, 0x32, 0x72, 0xab, 0x9f, 0x27, 0x0e, 0xf7, 0x5e, 0xcc,
-	0x41, 0xe1, 0xef, 0xae, 0x18, 0x49, 0x72, 0x9c, 0x44, 0x6a, 0x1a, 0xde, 0xf3, 0xea, 0xed, 0x25,
-	0x26, 0xb1, 0xa7, 0x91, 0x71, 0x12, 0x43, 0x42, 0x5c, 0x7a, 0xcf, 0x6d, 0x6b, 0x20, 0x64, 0xf5,
-	0x0b, 0x4b, 0x2c, 0xbd, 0x67, 0x84, 0x8a, 0x4b, 0x4f, 0x11, 0xa1, 0x1a, 0xf7, 0x87, 0xa6, 0xac,
-	0xde, 0x59, 0x42, 0x8d, 0x9b, 0x43, 0x93, 0x6c, 0x05, 0x12, 0xd4, 0x3e, 0x85, 0xd5, 0xa4, 0x55,
-	0xe6, 0x1c, 0xb2, 0xbe, 0x30, 0xd5, 0x8e, 0x50, 0x34, 0xe8, 0x19, 0x61, 0xc2, 0xb2, 0x25, 0xed,
-	0x08, 0x45, 0x83, 0x9e, 0xf9, 0x4d, 0xc8, 0x2b, 0xdf, 0x84, 0x0c, 0x7e, 0xd1, 0xd0, 0x2d, 0xc4,
-	0xb5, 0x7c, 0x73, 0x40, 0xfb, 0x56, 0xd1, 0xa0, 0x67, 0xc4, 0xb5, 0x7c, 0x6f, 0xdc, 0x71, 0xc9,
-	0x60, 0x17, 0x0d, 0xdd, 0xaa, 0xfd, 0x8d, 0x0f, 0xa0, 0xa0, 0x85, 0xaa, 0xfd, 0xdd, 0x14, 0xe4,
-	0x95, 0x41, 0xe1, 0xdf, 0x82, 0x5c, 0x20, 0x2f, 0x1c, 0x41, 0x32, 0xac, 0x3d, 0xfc, 0xca, 0x12,
-	0x46, 0x68, 0xb3, 0x8b, 0x04, 0x86, 0xa2, 0xab, 0x1b, 0x90, 0xa3, 0x36, 0x2f, 0x40, 0xc6, 0xf0,
-	0x9e, 0xb3, 0x15, 0x0e, 0x90, 0x57, 0x93, 0xc5, 0x52, 0x08, 0xdc, 0xb6, 0xcf, 0x58, 0x1a, 0x81,
-	0xbb, 0xc2, 0xb4, 0x84, 0xcf, 0x32, 0xbc, 0x02, 0xa5, 0x70, 0x5a, 0x02, 0x96, 0xe5, 0x0c, 0x56,
-	0x13, 0x13, 0x1e, 0xb0, 0x5c, 0xed, 0x7f, 0x65, 0x21, 0x8b, 0xeb, 0x9f, 0xbf, 0x02, 0x15, 0x69,
-	0xfa, 0x03, 0xa1, 0x1c, 0xe1, 0xc8, 0x49, 0x99, 0x06, 0xf2, 0x0f, 0xc2, 0x3e, 0xa4, 0xa9, 0x0f,
-	0xaf, 0xbd, 0xd0, 0xae, 0x4c, 0xf5, 0x20, 0xb1, 0x0b, 0x67, 0x96, 0xdb, 0x85, 0x77, 0xa0, 0x88,
-	0xe6, 0xac, 0x6b, 0x7f, 0x2a, 0x68, 0xe8, 0xd7, 0x1e, 0xde, 0x7f, 0xf1, 0x27, 0xdb, 0x9a, 0xc2,
-	0x88, 0x68, 0x79, 0x1b, 0x4a, 0x7d, 0xd3, 0xb7, 0x48, 0x18, 0x9a, 0xad, 0xb5, 0x87, 0x5f, 0x7d,
-	0x31, 0xa3, 0x66, 0x48, 0x62, 0xc4, 0xd4, 0xbc, 0x03, 0x65, 0x4b, 0x04, 0x7d, 0xdf, 0x1e, 0x93,
-	0x79, 0x53, 0x7b, 0xf1, 0xd7, 0x5e, 0xcc, 0x6c, 0x3b, 0x26, 0x32, 0x92, 0x1c, 0xd0, 0x23, 0xf3,
-	0x23, 0xfb, 0x56, 0x20, 0x07, 0x21, 0x06, 0xd4, 0xdf, 0x85, 0x62, 0xd8, 0x1f, 0xbe, 0x0a, 0x45,
-	0xfc, 0x7b, 0xe0, 0xb9, 0x82, 0xad, 0xe0, 0xdc, 0x62, 0xab, 0x3b, 0x32, 0x1d, 0x87, 0xa5, 0xf8,
-	0x1a, 0x00, 0x36, 0xf7, 0x85, 0x65, 0x4f, 0x46, 0x2c, 0x5d, 0xff, 0x85, 0x50, 0x5b, 0x8a, 0x90,
-	0x3d, 0x34, 0x07, 0x48, 0xb1, 0x0a, 0xc5, 0xd0, 0x5c, 0xb3, 0x14, 0xd2, 0x6f, 0x9b, 0xc1, 0xf0,
-	0xd8, 0x33, 0x7d, 0x8b, 0xa5, 0x79, 0x19, 0x0a, 0x0d, 0xbf, 0x3f, 0xb4, 0xcf, 0x04, 0xcb, 0xd4,
-	0x1f, 0x40, 0x39, 0x21, 0x2f, 0xb2, 0xd0, 0x1f, 0x2d, 0x41, 0xae, 0x61, 0x59, 0xc2, 0x62, 0x29,
-	0x24, 0xd0, 0x1d, 0x64, 0xe9, 0xfa, 0x57, 0xa1, 0x14, 0x8d, 0x16, 0xa2, 0xe3, 0xc6, 0xcd, 0x56,
-	0xf0, 0x09, 0xc1, 0x2c, 0x85, 0x5a, 0xd9, 0x76, 0x1d, 0xdb, 0x15, 0x2c, 0x5d, 0xfb, 0x73, 0xa4,
-	0xaa, 0xfc, 0x9b, 0xd3, 0x0b, 0xe2, 0xd5, 0x17, 0xed, 0xac, 0xd3, 0xab, 0xe1, 0xf3, 0x89, 0xfe,
-	0xed, 0xd9, 0x24, 0x5c, 0x11, 0xb2, 0xdb, 0x9e, 0x0c, 0x58, 0xaa, 0xf6, 0xdf, 0xd3, 0x50, 0x0c,
-	0x37, 0x54, 0x8c, 0x09, 0x26, 0xbe, 0xa3, 0x15, 0x1a, 0x1f, 0xf9, 0x75, 0xc8, 0x49, 0x5b, 0x6a,
-	0x35, 0x2e, 0x19, 0xaa, 0x81, 0xbe, 0x5a, 0x72, 0x66, 0x95, 0x03, 0x3b, 0x3b, 0x55, 0xf6, 0xc8,
-	0x1c, 0x88, 0x5d, 0x33, 0x18, 0x6a, 0x17, 0x36, 0x06, 0x20, 0xfd, 0x89, 0x79, 0x86, 0x3a, 0x47,
-	0xef, 0x95, 0x17, 0x97, 0x04, 0xf1, 0xb7, 0x20, 0x8b, 0x1d, 0xd4, 0x4a, 0xf3, 0x67, 0x66, 0x3a,
-	0x8c, 0x6a, 0x72, 0xe8, 0x0b, 0x9c, 0x9e, 0x4d, 0x8c, 0xc0, 0x0c, 0x42, 0xe6, 0xaf, 0xc2, 0x9a,
-	0x5a, 0x84, 0x9d, 0x30, 0x7e, 0x28, 0x10, 0xe7, 0x19, 0x28, 0x6f, 0xe0, 0x70, 0x9a, 0x52, 0x54,
-	0x8b, 0x4b, 0xe8, 0x77, 0x38, 0x38, 0x9b, 0x5d, 0x24, 0x31, 0x14, 0x65, 0xfd, 0x1d, 0x1c, 0x53,
-	0x53, 0x0a, 0x9c, 0xe6, 0xd6, 0x68, 0x2c, 0x2f, 0x94, 0xd2, 0xec, 0x08, 0xd9, 0x1f, 0xda, 0xee,
-	0x80, 0xa5, 0xd4, 0x10, 0xe3, 0x24, 0x12, 0x8a, 0xef, 0x7b, 0x3e, 0xcb, 0xd4, 0x6a, 0x90, 0x45,
-	0x1d, 0x45, 0x23, 0xe9, 0x9a, 0x23, 0xa1, 0x47, 0x9a, 0x9e, 0x6b, 0xd7, 0x60, 0x63, 0x6e, 0x3f,
-	0xae, 0xfd, 0xf3, 0xbc, 0xd2, 0x10, 0xa4, 0x20, 0x5f, 0x50, 0x53, 0x90, 0x9b, 0xf7, 0x52, 0x36,
-	0x06, 0xb9, 0x4c, 0xdb, 0x98, 0x0f, 0x20, 0x87, 0x1d, 0x0b, 0x4d, 0xcc, 0x12, 0xe4, 0xfb, 0x88,
-	0x6e, 0x28, 0x2a, 0x8c, 0x60, 0xfa, 0x43, 0xd1, 0x3f, 0x15, 0x96, 0xb6, 0xf5, 0x61, 0x13, 0x95,
-	0xa6, 0x9f, 0x70, 0xcf, 0x55, 0x83, 0x54, 0xa2, 0xef, 0xb9, 0xad, 0x91, 0xf7, 0x3d, 0x9b, 0xe6,
-	0x15, 0x55, 0x22, 0x04, 0x84, 0x6f, 0xdb, 0xa8, 0x23, 0x7a, 0xda, 0x62, 0x40, 0xad, 0x05, 0x39,
-	0xfa, 0x36, 0xae, 0x04, 0x25, 0xb3, 0xca, 0x34, 0xbc, 0xba, 0x9c, 0xcc, 0x5a, 0xe4, 0xda, 0x8f,
-	0xd3, 0x90, 0xc5, 0x36, 0xbf, 0x0f, 0x39, 0x1f, 0xe3, 0x30, 0x1a, 0xce, 0xcb, 0x62, 0x36, 0x85,
-	0xc2, 0xbf, 0xa5, 0x55, 0x31, 0xbd, 0x84, 0xb2, 0x44, 0x5f, 0x4c, 0xaa, 0xe5, 0x75, 0xc8, 0x8d,
-	0x4d, 0xdf, 0x1c, 0xe9, 0x75, 0xa2, 0x1a, 0xf5, 0x1f, 0xa6, 0x20, 0x8b, 0x48, 0x7c, 0x03, 0x2a,
-	0x5d, 0xe9, 0xdb, 0xa7, 0x42, 0x0e, 0x7d, 0x6f, 0x32, 0x18, 0x2a, 0x4d, 0x7a, 0x2c, 0x2e, 0x94,
-	0xbd, 0x51, 0x06, 0x41, 0x9a, 0x8e, 0xdd, 0x67, 0x69, 0xd4, 0xaa, 0x2d, 0xcf, 0xb1, 0x58, 0x86,
-	0xaf, 0x43, 0xf9, 0x89, 0x6b, 0x09, 0x3f, 0xe8, 0x7b, 0xbe, 0xb0, 0x58, 0x56, 0xaf, 0xee, 0x53,
-	0x96, 0xa3, 0xbd, 0x4c, 0x9c, 0x4b, 0x8a, 0x85, 0x58, 0x9e, 0x5f, 0x83, 0xf5, 0xad, 0xe9, 0x00,
-	0x89, 0x15, 0xd0, 0x26, 0xed, 0x0b, 0x17, 0x95, 0x8c, 0x15, 0x95, 0x12, 0x7b, 0xdf, 0xb3, 0x59,
-	0x09, 0x3f, 0xa6, 0xd6, 0x09, 0x83, 0xfa, 0xbf, 0x4c, 0x85, 0x96, 0xa3, 0x02, 0xa5, 0x43, 0xd3,
-	0x37, 0x07, 0xbe, 0x39, 0x46, 0xf9, 0xca, 0x50, 0x50, 0x1b, 0xe7, 0x9b, 0xca, 0xba, 0xa9, 0xc6,
-	0x43, 0x65, 0x1b, 0x55, 0xe3, 0x2d, 0x96, 0x89, 0x1b, 0x6f, 0xb3, 0x2c, 0x7e, 0xe3, 0x3b, 0x13,
-	0x4f, 0x0a, 0x96, 0x23, 0x5b, 0xe7, 0x59, 0x82, 0xe5, 0x11, 0xd8, 0x43, 0x8b, 0xc2, 0x0a, 0xd8,
-	0xe7, 0x26, 0xea, 0xcf, 0xb1, 0x77, 0xce, 0x8a, 0x28, 0x06, 0x0e, 0xa3, 0xb0, 0x58, 0x09, 0xdf,
-	0x1c, 0x4c, 0x46, 0xc7, 0x02, 0xbb, 0x09, 0xf8, 0xa6, 0xe7, 0x0d, 0x06, 0x8e, 0x60, 0x65, 0x1c,
-	0x83, 0x84, 0xf1, 0x65, 0xab, 0x64, 0x69, 0x4d, 0xc7, 0xf1, 0x26, 0x92, 0x55, 0x6a, 0x7f, 0x98,
-	0x81, 0x2c, 0x46, 0x37, 0xb8, 0x76, 0x86, 0x68, 0x67, 0xf4, 0xda, 0xc1, 0xe7, 0x68, 0x05, 0xa6,
-	0xe3, 0x15, 0xc8, 0xdf, 0xd7, 0x33, 0x9d, 0x59, 0xc2, 0xca, 0x22, 0xe3, 0xe4, 0x24, 0x73, 0xc8,
-	0x8e, 0xec, 0x91, 0xd0, 0xb6, 0x8e, 0x9e, 0x11, 0x16, 0xe0, 0x7e, 0x9c, 0xa3, 0xe4, 0x09, 0x3d,
-	0xe3, 0xaa, 0x31, 0x71, 0x5b, 0x68, 0x48, 0x5a, 0x03, 0x19, 0x23, 0x6c, 0x2e, 0xb0, 0x5e, 0xa5,
-	0x85, 0xd6, 0xeb, 0x83, 0xd0, 0x7a, 0x15, 0x96, 0x58, 0xf5, 0x24, 0x66, 0xd2, 0x72, 0xc5, 0x46,
-	0xa3, 0xb8, 0x3c, 0x79, 0x62, 0x33, 0xd9, 0xd6, 0x5a, 0x1b, 0x6f, 0x74, 0x45, 0x35, 0xca, 0x2c,
-	0x85, 0xb3, 0x49, 0xcb, 0x55, 0xd9, 0xbc, 0xa7, 0xb6, 0x25, 0x3c, 0x96, 0xa1, 0x8d, 0x70, 0x62,
-	0xd9, 0x1e, 0xcb, 0xa2, 0xe7, 0x75, 0xb8, 0xbd, 0xc3, 0x72, 0xf5, 0x57, 0x13, 0x5b, 0x52, 0x63,
-	0x22, 0x3d, 0xc5, 0x86, 0xd4, 0x37, 0xa5, 0xb4, 0xf1, 0x58, 0x58, 0x2c, 0x5d, 0xff, 0xfa, 0x02,
-	0x33, 0x5b, 0x81, 0xd2, 0x93, 0xb1, 0xe3, 0x99, 0xd6, 0x15, 0x76, 0x76, 0x15, 0x20, 0x8e, 0xaa,
-	0x6b, 0xff, 0xa6, 0x1e, 0x6f, 0xe7, 0xe8, 0x8b, 0x06, 0xde, 0xc4, 0xef, 0x0b, 0x32, 0x21, 0x25,
-	0x43, 0xb7, 0xf8, 0xb7, 0x21, 0x87, 0xef, 0xc3, 0x34, 0xce, 0xfd, 0xa5, 0x62, 0xb9, 0xcd, 0xa7,
-	0xb6, 0x78, 0x6e, 0x28, 0x42, 0x7e, 0x07, 0xc0, 0xec, 0x4b, 0xfb, 0x4c, 0x20, 0x50, 0x2f, 0xf6,
-	0x04, 0x84, 0xbf, 0x93, 0x74, 0x5f, 0xae, 0xce, 0x43, 0x26, 0xfc, 0x1a, 0x6e, 0x40, 0x19, 0x97,
-	0xee, 0xb8, 0xe3, 0xe3, 0x6a, 0xaf, 0xae, 0x12, 0xe1, 0x1b, 0xcb, 0x89, 0xf7, 0x28, 0x22, 0x34,
-	0x92, 0x4c, 0xf8, 0x13, 0x58, 0x55, 0x39, 0x35, 0xcd, 0xb4, 0x42, 0x4c, 0xdf, 0x5c, 0x8e, 0x69,
-	0x27, 0xa6, 0x34, 0xa6, 0xd8, 0xcc, 0xa7, 0x25, 0x73, 0x2f, 0x9d, 0x96, 0x7c, 0x15, 0xd6, 0x7a,
-	0xd3, 0xab, 0x40, 0x6d, 0x15, 0x33, 0x50, 0x5e, 0x87, 0x55, 0x3b, 0x88, 0xb3, 0xa2, 0x94, 0x23,
-	0x29, 0x1a, 0x53, 0xb0, 0xda, 0x7f, 0xc8, 0x43, 0x96, 0x46, 0x7e, 0x36, 0xc7, 0xd5, 0x9c, 0x32,
-	0xe9, 0x0f, 0x96, 0x9f, 0xea, 0x99, 0x15, 0x4f, 0x16, 0x24, 0x93, 0xb0, 0x20, 0xdf, 0x86, 0x5c,
-	0xe0, 0xf9, 0x32, 0x9c, 0xde, 0x25, 0x95, 0xa8, 0xeb, 0xf9, 0xd2, 0x50, 0x84, 0x7c, 0x07, 0x0a,
-	0x27, 0xb6, 0x23, 0x71, 0x52, 0xd4, 0xe0, 0xbd, 0xbe, 0x1c, 0x8f, 0x1d, 0x22, 0x32, 0x42, 0x62,
-	0xbe, 0x97, 0x54, 0xb6, 0x3c, 0x71, 0xda, 0x5c, 0x8e, 0xd3, 0x22, 0x1d, 0xbc, 0x0f, 0xac, 0xef,
-	0x9d, 0x09, 0xdf, 0x48, 0x24, 0x26, 0xd5, 0x26, 0x3d, 0x07, 0xe7, 0x35, 0x28, 0x0e, 0x6d, 0x4b,
-	0xa0, 0x9f, 0x43, 0x36, 0xa6, 0x68, 0x44, 0x6d, 0xfe, 0x18, 0x8a, 0x14, 0x1f, 0xa0, 0x55, 0x2c,
-	0xbd, 0xf4, 0xe0, 0xab, 0x50, 0x25, 0x64, 0x80, 0x1f, 0xa2, 0x8f, 0xef, 0xd8, 0x92, 0xf2, 0xd3,
-	0x45, 0x23, 0x6a, 0xa3, 0xc0, 0xa4, 0xef, 0x49, 0x81, 0xcb, 0x4a, 0xe0, 0x59, 0x38, 0x7f, 0x1b,
-	0x6e, 0x10, 0x6c, 0x66, 0x93, 0xc4, 0xa5, 0x86, 0x4c, 0x17, 0xbf, 0x44, 0x87, 0x65, 0x6c, 0x0e,
-	0xc4, 0x9e, 0x3d, 0xb2, 0x65, 0xb5, 0x72, 0x37, 0x75, 0x2f, 0x67, 0xc4, 0x00, 0xfe, 0x3a, 0x6c,
-	0x58, 0xe2, 0xc4, 0x9c, 0x38, 0xb2, 0x27, 0x46, 0x63, 0xc7, 0x94, 0xa2, 0x6d, 0x91, 0x8e, 0x96,
-	0x8c, 0xf9, 0x17, 0xfc, 0x0d, 0xb8, 0xa6, 0x81, 0x9d, 0xe8, 0x54, 0xa1, 0x6d, 0x51, 0xfa, 0xae,
-	0x64, 0x2c, 0x7a, 0x55, 0xdf, 0xd7, 0x66, 0x18, 0x37, 0x50, 0x8c, 0x53, 0x43, 0x03, 0x1a, 0x48,
-	0xb5, 0x23, 0x3f, 0x32, 0x1d, 0x47, 0xf8, 0x17, 0x2a, 0xc8, 0x7d, 0x6c, 0xba, 0xc7, 0xa6, 0xcb,
-	0x32, 0xb4, 0xc7, 0x9a, 0x8e, 0x70, 0x2d, 0xd3, 0x57, 0x3b, 0xf2, 0x23, 0xda, 0xd0, 0x73, 0xf5,
-	0x7b, 0x90, 0xa5, 0x21, 0x2d, 0x41, 0x4e, 0x45, 0x49, 0x14, 0x31, 0xeb, 0x08, 0x89, 0x2c, 0xf2,
-	0x1e, 0x2e, 0x3f, 0x96, 0xae, 0xfd, 0xef, 0x1c, 0x14, 0xc3, 0xc1, 0x0b, 0xcf, 0x10, 0x52, 0xf1,
-	0x19, 0x02, 0xba, 0x71, 0xc1, 0x53, 0x3b, 0xb0, 0x8f, 0xb5, 0x5b, 0x5a, 0x34, 0x62, 0x00, 0x7a,
-	0x42, 0xcf, 0x6d, 0x4b, 0x0e, 0x69, 0xcd, 0xe4, 0x0c, 0xd5, 0xe0, 0xf7, 0x60, 0xdd, 0xc2, 0x71,
-	0x70, 0xfb, 0xce, 0xc4, 0x12, 0x3d, 0xdc, 0x45, 0x55, 0x9a, 0x60, 0x16, 0xcc, 0x3f, 0x06, 0x90,
-	0xf6, 0x48, 0xec, 0x78, 0xfe, 0xc8, 0x94, 0x3a, 0x36, 0xf8, 0xc6, 0xcb, 0x69, 0xf5, 0x66, 0x2f,
-	0x62, 0x60, 0x24, 0x98, 0x21, 0x6b, 0xfc, 0x9a, 0x66, 0x5d, 0xf8, 0x4c, 0xac, 0xb7, 0x23, 0x06,
-	0x46, 0x82, 0x19, 0xef, 0x41, 0xe1, 0xc4, 0xf3, 0x47, 0x13, 0xc7, 0xd4, 0x7b, 0xee, 0xfb, 0x2f,
-	0xc9, 0x77, 0x47, 0x51, 0x93, 0xed, 0x09, 0x59, 0xd5, 0x7f, 0x11, 0x20, 0xfe, 0x1e, 0xbf, 0x09,
-	0x7c, 0xdf, 0x73, 0xe5, 0xb0, 0x71, 0x7c, 0xec, 0x6f, 0x89, 0x13, 0xcf, 0x17, 0xdb, 0x26, 0x6e,
-	0x96, 0x37, 0x60, 0x23, 0x82, 0x37, 0x4e, 0xa4, 0xf0, 0x11, 0x4c, 0x13, 0xda, 0x1d, 0x7a, 0xbe,
-	0x54, 0x1e, 0x1b, 0x3d, 0x3e, 0xe9, 0xb2, 0x0c, 0x6e, 0xd0, 0xed, 0x6e, 0x87, 0x65, 0xeb, 0xf7,
-	0x00, 0xe2, 0x81, 0xa2, 0xc8, 0x86, 0x9e, 0xde, 0x7c, 0xa8, 0xe3, 0x1c, 0x6a, 0x3d, 0x7c, 0x9b,
-	0xa5, 0xea, 0x3f, 0x4b, 0x41, 0x39, 0x21, 0xe0, 0x74, 0x04, 0xdc, 0xf4, 0x26, 0xae, 0x54, 0x21,
-	0x37, 0x3d, 0x3e, 0x35, 0x9d, 0x09, 0x6e, 0xd5, 0x1b, 0x50, 0xa1, 0xf6, 0xb6, 0x1d, 0x48, 0xdb,
-	0xed, 0x4b, 0x96, 0x89, 0x50, 0xd4, 0x36, 0x9f, 0x8d, 0x50, 0x0e, 0x3c, 0x0d, 0xca, 0x71, 0x06,
-	0xab, 0x87, 0xc2, 0xef, 0x8b, 0x10, 0x89, 0x5c, 0x5b, 0x0d, 0x89, 0xd0, 0x94, 0x6b, 0x6b, 0xca,
-	0x61, 0x77, 0x32, 0x62, 0x45, 0x74, 0x11, 0xb1, 0xd1, 0x38, 0x13, 0x3e, 0x7a, 0x26, 0x25, 0xfc,
-	0x0e, 0x02, 0x50, 0xb7, 0x4d, 0x97, 0x41, 0x88, 0xbd, 0x6f, 0xbb, 0xac, 0x1c, 0x35, 0xcc, 0x73,
-	0xb6, 0x8a, 0xf2, 0x53, 0x20, 0xc0, 0x2a, 0xb5, 0xff, 0x96, 0x81, 0x2c, 0x5a, 0x69, 0x8c, 0x5c,
-	0x93, 0x26, 0x45, 0x69, 0x7e, 0x12, 0xf4, 0xd9, 0xf6, 0x16, 0xe4, 0x9d, 0xdc, 0x5b, 0xde, 0x83,
-	0x72, 0x7f, 0x12, 0x48, 0x6f, 0x44, 0x1b, 0xab, 0x3e, 0xbb, 0xba, 0x39, 0x97, 0x03, 0xa2, 0xe1,
-	0x34, 0x92, 0xa8, 0xfc, 0x1d, 0xc8, 0x9f, 0x28, 0x1d, 0x56, 0x59, 0xa0, 0x2f, 0x5c, 0xb2, 0xf7,
-	0x6a, 0x3d, 0xd5, 0xc8, 0xd8, 0x2f, 0x7b, 0x6e, 0xfd, 0x25, 0x41, 0x7a, 0x0f, 0xcd, 0x47, 0x7b,
-	0xe8, 0x2f, 0xc2, 0x9a, 0xc0, 0x01, 0x3f, 0x74, 0xcc, 0xbe, 0x18, 0x09, 0x37, 0x5c, 0x34, 0x6f,
-	0xbf, 0x44, 0x8f, 0x69, 0xc6, 0xa8, 0xdb, 0x33, 0xbc, 0xd0, 0x8e, 0xb8, 0x1e, 0x6e, 0xe5, 0x61,
-	0x98, 0x5e, 0x34, 0x62, 0x40, 0xfd, 0xcb, 0xda, 0xfa, 0x15, 0x20, 0xd3, 0x08, 0xfa, 0x3a, 0x9f,
-	0x21, 0x82, 0xbe, 0x0a, 0x96, 0x9a, 0x34, 0x1c, 0x2c, 0x5d, 0x7f, 0x13, 0x4a, 0xd1, 0x17, 0x50,
-	0x79, 0x0e, 0x3c, 0xd9, 0x1d, 0x8b, 0xbe, 0x7d, 0x62, 0x0b, 0x4b, 0xe9, 0x67, 0x57, 0x9a, 0xbe,
-	0x54, 0x29, 0xc1, 0x96, 0x6b, 0xb1, 0x74, 0xed, 0x77, 0x8a, 0x90, 0x57, 0x5b, 0xa9, 0xee, 0x70,
-	0x29, 0xea, 0xf0, 0x77, 0xa0, 0xe8, 0x8d, 0x85, 0x6f, 0x4a, 0xcf, 0xd7, 0x79, 0x98, 0x77, 0x5e,
-	0x66, 0x6b, 0xde, 0xec, 0x68, 0x62, 0x23, 0x62, 0x33, 0xab, 0x4d, 0xe9, 0x79, 0x6d, 0xba, 0x0f,
-	0x2c, 0xdc, 0x85, 0x0f, 0x7d, 0xa4, 0x93, 0x17, 0x3a, 0xaa, 0x9e, 0x83, 0xf3, 0x1e, 0x94, 0xfa,
-	0x9e, 0x6b, 0xd9, 0x51, 0x4e, 0x66, 0xed, 0xe1, 0xd7, 0x5f, 0x4a, 0xc2, 0x66, 0x48, 0x6d, 0xc4,
-	0x8c, 0xf8, 0xeb, 0x90, 0x3b, 0x43, 0x35, 0x23, 0x7d, 0xba, 0x5c, 0x09, 0x15, 0x12, 0xff, 0x04,
-	0xca, 0xdf, 0x9f, 0xd8, 0xfd, 0xd3, 0x4e, 0x32, 0xe7, 0xf7, 0xde, 0x4b, 0x49, 0xf1, 0x9d, 0x98,
-	0xde, 0x48, 0x32, 0x4b, 0xa8, 0x76, 0xe1, 0x8f, 0xa0, 0xda, 0xc5, 0x79, 0xd5, 0x36, 0xa0, 0xe2,
-	0x8a, 0x40, 0x0a, 0x6b, 0x47, 0x7b, 0x5e, 0xf0, 0x19, 0x3c, 0xaf, 0x69, 0x16, 0xf5, 0x2f, 0x41,
-	0x31, 0x9c, 0x70, 0x9e, 0x87, 0xf4, 0x01, 0x86, 0x38, 0x79, 0x48, 0x77, 0x7c, 0xa5, 0x6d, 0x0d,
-	0xd4, 0xb6, 0xfa, 0xef, 0xa7, 0xa0, 0x14, 0x0d, 0xfa, 0xb4, 0xe5, 0x6c, 0x7d, 0x7f, 0x62, 0x3a,
-	0x2c, 0x45, 0xc1, 0xaf, 0x27, 0x55, 0x8b, 0x8c, 0xf5, 0x23, 0x3a, 0x7a, 0xf7, 0x59, 0x86, 0x36,
-	0x7c, 0x11, 0x04, 0x2c, 0xcb, 0x39, 0xac, 0x69, 0x70, 0xc7, 0x57, 0xa8, 0x39, 0x34, 0x7c, 0xf8,
-	0x36, 0x04, 0xe4, 0x95, 0x7f, 0x70, 0x2a, 0x94, 0x81, 0x3c, 0xf0, 0x24, 0x35, 0x8a, 0x28, 0x54,
-	0xdb, 0x65, 0x25, 0xfc, 0xe6, 0x81, 0x27, 0xdb, 0x68, 0x12, 0xa3, 0x60, 0xab, 0x1c, 0x7e, 0x9e,
-	0x5a, 0x64, 0x11, 0x1b, 0x8e, 0xd3, 0x76, 0x59, 0x45, 0xbf, 0x50, 0xad, 0x35, 0xe4, 0xd8, 0x3a,
-	0x37, 0xfb, 0x48, 0xbe, 0x8e, 0x16, 0x16, 0x69, 0x74, 0x9b, 0xe1, 0x92, 0x6c, 0x9d, 0xdb, 0x81,
-	0x0c, 0xd8, 0x46, 0xfd, 0xdf, 0xa7, 0xa0, 0x9c, 0x98, 0x60, 0x0c, 0xe6, 0x08, 0x11, 0xb7, 0x32,
-	0x15, 0xdb, 0x7d, 0x8c, 0xc3, 0xe8, 0x5b, 0xe1, 0x36, 0xd5, 0xf3, 0xf0, 0x31, 0x8d, 0xdf, 0xeb,
-	0x79, 0x23, 0xcf, 0xf7, 0xbd, 0xe7, 0xca, 0x91, 0xd9, 0x33, 0x03, 0xf9, 0x4c, 0x88, 0x53, 0x96,
-	0xc5, 0xae, 0x36, 0x27, 0xbe, 0x2f, 0x5c, 0x05, 0xc8, 0x91, 0x70, 0xe2, 0x5c, 0xb5, 0xf2, 0xc8,
-	0x14, 0x91, 0x69, 0x1f, 0x64, 0x05, 0x34, 0x04, 0x1a, 0x5b, 0x41, 0x8a, 0x88, 0x80, 0xe8, 0xaa,
-	0x59, 0xc2, 0x4d, 0x45, 0xe5, 0x1b, 0x3a, 0x27, 0xdb, 0xe6, 0x45, 0xd0, 0x18, 0x78, 0x0c, 0x66,
-	0x81, 0x07, 0xde, 0x73, 0x56, 0xae, 0x4d, 0x00, 0xe2, 0x08, 0x0b, 0x23, 0x4b, 0x54, 0x88, 0xe8,
-	0x44, 0x40, 0xb7, 0x78, 0x07, 0x00, 0x9f, 0x08, 0x33, 0x0c, 0x2f, 0x5f, 0xc2, 0xed, 0x25, 0x3a,
-	0x23, 0xc1, 0xa2, 0xf6, 0x17, 0xa0, 0x14, 0xbd, 0xe0, 0x55, 0x28, 0x90, 0x83, 0x1a, 0x7d, 0x36,
-	0x6c, 0xa2, 0xb7, 0x65, 0xbb, 0x96, 0x38, 0x27, 0xbb, 0x92, 0x33, 0x54, 0x03, 0xa5, 0x1c, 0xda,
-	0x96, 0x25, 0xdc, 0xf0, 0xdc, 0x46, 0xb5, 0x16, 0x9d, 0xae, 0x67, 0x17, 0x9e, 0xae, 0xd7, 0x7e,
-	0x09, 0xca, 0x89, 0x10, 0xf0, 0xd2, 0x6e, 0x27, 0x04, 0x4b, 0x4f, 0x0b, 0x76, 0x1b, 0x4a, 0x61,
-	0x45, 0x47, 0x40, 0x7b, 0x5b, 0xc9, 0x88, 0x01, 0xb5, 0x7f, 0x9a, 0x46, 0xbf, 0x14, 0xbb, 0x36,
-	0x1b, 0xb6, 0xed, 0x40, 0x3e, 0x90, 0xa6, 0x9c, 0x84, 0xa5, 0x09, 0x4b, 0x2e, 0xd0, 0x2e, 0xd1,
-	0xec, 0xae, 0x18, 0x9a, 0x9a, 0x7f, 0x00, 0x19, 0x69, 0x0e, 0x74, 0xda, 0xf3, 0x2b, 0xcb, 0x31,
-	0xe9, 0x99, 0x83, 0xdd, 0x15, 0x03, 0xe9, 0xf8, 0x1e, 0x14, 0xfb, 0x3a, 0x53, 0xa5, 0x8d, 0xe2,
-	0x92, 0x91, 0x55, 0x98, 0xdf, 0xda, 0x5d, 0x31, 0x22, 0x0e, 0xfc, 0xdb, 0x90, 0x45, 0x5f, 0x51,
-	0x57, 0x70, 0x2c, 0x19, 0x31, 0xe2, 0x72, 0xd9, 0x5d, 0x31, 0x88, 0x72, 0xab, 0x00, 0x39, 0xb2,
-	0xc1, 0xb5, 0x2a, 0xe4, 0x55, 0x5f, 0x67, 0x47, 0xae, 0x76, 0x0b, 0x32, 0x3d, 0x73, 0x80, 0xfe,
-	0xba, 0x6d, 0x05, 0x3a, 0xf1, 0x81, 0x8f, 0xb5, 0x57, 0xe2, 0xac, 0x5b, 0x32, 0xa1, 0x9b, 0x9a,
-	0x4a, 0xe8, 0xd6, 0xf2, 0x90, 0xc5, 0x2f, 0xd6, 0x6e, 0x5f, 0xe5, 0xfb, 0xd7, 0xfe, 0x7e, 0x06,
-	0xc3, 0x04, 0x29, 0xce, 0x17, 0x26, 0xab, 0x3f, 0x82, 0xd2, 0xd8, 0xf7, 0xfa, 0x22, 0x08, 0x3c,
-	0x5f, 0x3b, 0x47, 0xaf, 0xbf, 0xf8, 0x20, 0x79, 0xf3, 0x30, 0xa4, 0x31, 0x62, 0xf2, 0xfa, 0xbf,
-	0x4a, 0x43, 0x29, 0x7a, 0xa1, 0xa2, 0x13, 0x29, 0xce, 0x55, 0x62, 0x72, 0x5f, 0xf8, 0x23, 0xd3,
-	0xb6, 0x94, 0xf5, 0x68, 0x0e, 0xcd, 0xd0, 0xc9, 0xfd, 0xd8, 0x9b, 0xc8, 0xc9, 0xb1, 0x50, 0x09,
-	0xa9, 0xa7, 0xf6, 0x48, 0x78, 0x2c, 0x4b, 0x47, 0x41, 0xa8, 0xd8, 0x7d, 0xc7, 0x9b, 0x58, 0x2c,
-	0x87, 0xed, 0x47, 0xb4, 0xbd, 0xed, 0x9b, 0xe3, 0x40, 0xd9, 0xcc, 0x7d, 0xdb, 0xf7, 0x58, 0x01,
-	0x89, 0x76, 0xec, 0xc1, 0xc8, 0x64, 0x45, 0x64, 0xd6, 0x7b, 0x6e, 0x4b, 0x34, 0xc2, 0x25, 0x74,
-	0x53, 0x3b, 0x63, 0xe1, 0x76, 0xa5, 0x2f, 0x84, 0xdc, 0x37, 0xc7, 0x2a, 0x43, 0x69, 0x08, 0xcb,
-	0xb2, 0xa5, 0xb2, 0x9f, 0x3b, 0x66, 0x5f, 0x1c, 0x7b, 0xde, 0x29, 0x5b, 0x45, 0x43, 0xd3, 0x76,
-	0x03, 0x69, 0x0e, 0x7c, 0x73, 0xa4, 0x6c, 0x68, 0x4f, 0x38, 0x82, 0x5a, 0x6b, 0xf4, 0x6d, 0x5b,
-	0x0e, 0x27, 0xc7, 0x8f, 0x30, 0x8a, 0x5b, 0x57, 0xa7, 0x46, 0x96, 0x18, 0x0b, 0xb4, 0xa1, 0xab,
-	0x50, 0xdc, 0xb2, 0x1d, 0xfb, 0xd8, 0x76, 0x6c, 0xb6, 0x81, 0xa8, 0xad, 0xf3, 0xbe, 0xe9, 0xd8,
-	0x96, 0x6f, 0x3e, 0x67, 0x1c, 0x85, 0x7b, 0xec, 0x7b, 0xa7, 0x36, 0xbb, 0x86, 0x88, 0x14, 0xd4,
-	0x9d, 0xd9, 0x9f, 0xb2, 0xeb, 0x74, 0xf2, 0x75, 0x2a, 0x64, 0x7f, 0x78, 0x62, 0x1e, 0xb3, 0x1b,
-	0x71, 0x82, 0xee, 0x66, 0x6d, 0x03, 0xd6, 0x67, 0xce, 0xd8, 0x6b, 0x05, 0x1d, 0x4b, 0xd6, 0x2a,
-	0x50, 0x4e, 0x1c, 0x7e, 0xd6, 0x5e, 0x85, 0x62, 0x78, 0x34, 0x8a, 0x31, 0xb7, 0x1d, 0xa8, 0xa4,
-	0xae, 0x56, 0x92, 0xa8, 0x5d, 0xfb, 0xdd, 0x14, 0xe4, 0xd5, 0xb9, 0x34, 0xdf, 0x8a, 0xea, 0x48,
-	0x52, 0x4b, 0x9c, 0x45, 0x2a, 0x22, 0x7d, 0x92, 0x1b, 0x15, 0x93, 0x5c, 0x87, 0x9c, 0x43, 0xc1,
-	0xb5, 0x36, 0x5f, 0xd4, 0x48, 0x58, 0x9b, 0x4c, 0xd2, 0xda, 0xd4, 0x1b, 0xd1, 0xe9, 0x71, 0x98,
-	0x48, 0x24, 0xaf, 0xb0, 0xe7, 0x0b, 0xa1, 0x92, 0x84, 0x14, 0x1b, 0xa7, 0x69, 0xaf, 0xf0, 0x46,
-	0x63, 0xb3, 0x2f, 0x09, 0x40, 0xbb, 0x28, 0x1a, 0x53, 0x96, 0x45, 0x2d, 0x6f, 0x0e, 0x4d, 0x59,
-	0x3f, 0x81, 0xe2, 0xa1, 0x17, 0xcc, 0xee, 0xc9, 0x05, 0xc8, 0xf4, 0xbc, 0xb1, 0xf2, 0x30, 0xb7,
-	0x3c, 0x49, 0x1e, 0xa6, 0xda, 0x82, 0x4f, 0xa4, 0x52, 0x2a, 0xc3, 0x1e, 0x0c, 0xa5, 0x8a, 0xab,
-	0xdb, 0xae, 0x2b, 0x7c, 0x96, 0xc3, 0x39, 0x34, 0xc4, 0x18, 0xbd, 0x5a, 0x96, 0xc7, 0x59, 0x23,
-	0xf8, 0x8e, 0xed, 0x07, 0x92, 0x15, 0xea, 0x6d, 0xdc, 0x4d, 0xed, 0x01, 0x6d, 0x82, 0xf4, 0x40,
-	0xac, 0x56, 0x50, 0x44, 0x6a, 0x36, 0x85, 0x8b, 0x3a, 0x46, 0xd1, 0x93, 0x2a, 0x35, 0xa2, 0x0f,
-	0xa4, 0x71, 0x07, 0xa3, 0xf6, 0x47, 0x93, 0x40, 0xda, 0x27, 0x17, 0x2c, 0x53, 0x7f, 0x06, 0x95,
-	0xa9, 0xa2, 0x24, 0x7e, 0x1d, 0xd8, 0x14, 0x00, 0x45, 0x5f, 0xe1, 0xb7, 0xe0, 0xda, 0x14, 0x74,
-	0xdf, 0xb6, 0x2c, 0xca, 0xdc, 0xce, 0xbe, 0x08, 0x3b, 0xb8, 0x55, 0x82, 0x42, 0x5f, 0xcd, 0x52,
-	0xfd, 0x10, 0x2a, 0x34, 0x6d, 0xfb, 0x42, 0x9a, 0x1d, 0xd7, 0xb9, 0xf8, 0x23, 0x57, 0x8e, 0xd5,
-	0xbf, 0xaa, 0x03, 0x2c, 0xb4, 0x17, 0x27, 0xbe, 0x37, 0x22, 0x5e, 0x39, 0x83, 0x9e, 0x91, 0xbb,
-	0xf4, 0xf4, 0xdc, 0xa7, 0xa5, 0x57, 0xff, 0xf5, 0x12, 0x14, 0x1a, 0xfd, 0x3e, 0x86, 0x84, 0x73,
-	0x5f, 0x7e, 0x07, 0xf2, 0x7d, 0xcf, 0x3d, 0xb1, 0x07, 0xda, 0x1e, 0xcf, 0x7a, 0x86, 0x9a, 0x0e,
-	0x15, 0xee, 0xc4, 0x1e, 0x18, 0x1a, 0x19, 0xc9, 0xf4, 0x7e, 0x92, 0xbb, 0x92, 0x4c, 0x19, 0xd5,
-	0x68, 0xfb, 0x78, 0x00, 0x59, 0xdb, 0x3d, 0xf1, 0x74, 0x99, 0xe7, 0xe7, 0x2f, 0x21, 0xa2, 0x5a,
-	0x47, 0x42, 0xac, 0xfd, 0x97, 0x14, 0xe4, 0xd5, 0xa7, 0xf9, 0xab, 0xb0, 0x26, 0x5c, 0x5c, 0x4c,
-	0xa1, 0x29, 0xd7, 0xab, 0x68, 0x06, 0x8a, 0x4e, 0xab, 0x86, 0x88, 0xe3, 0xc9, 0x40, 0x67, 0x52,
-	0x92, 0x20, 0xfe, 0x1e, 0xdc, 0x52, 0xcd, 0x43, 0x5f, 0xf8, 0xc2, 0x11, 0x66, 0x20, 0x9a, 0x43,
-	0xd3, 0x75, 0x85, 0xa3, 0x37, 0xf6, 0xcb, 0x5e, 0xf3, 0x3a, 0xac, 0xaa, 0x57, 0xdd, 0xb1, 0xd9,
-	0x17, 0x81, 0x3e, 0xbd, 0x9b, 0x82, 0xf1, 0xaf, 0x41, 0x8e, 0xaa, 0x60, 0xab, 0xd6, 0xd5, 0x53,
-	0xa9, 0xb0, 0x6a, 0x5e, 0xb4, 0xf3, 0x34, 0x00, 0xd4, 0x30, 0x61, 0xd0, 0xa5, 0x57, 0xff, 0x17,
-	0xaf, 0x1c, 0x57, 0x8a, 0xff, 0x12, 0x44, 0x28, 0x9f, 0x25, 0x1c, 0x41, 0xe5, 0x8a, 0xb8, 0x33,
-	0xa6, 0xe9, 0x9c, 0x64, 0x0a, 0x56, 0xfb, 0x27, 0x59, 0xc8, 0xe2, 0x08, 0x23, 0xf2, 0xd0, 0x1b,
-	0x89, 0x28, 0x5b, 0xac, 0x5c, 0x8d, 0x29, 0x18, 0xba, 0x36, 0xa6, 0x3a, 0xb0, 0x8f, 0xd0, 0x94,
-	0xf1, 0x98, 0x05, 0x23, 0xe6, 0xd8, 0xf7, 0x4e, 0x6c, 0x27, 0xc6, 0xd4, 0x4e, 0xd0, 0x0c, 0x98,
-	0x7f, 0x1d, 0x6e, 0x8e, 0x4c, 0xff, 0x54, 0x48, 0x5a, 0xdd, 0xcf, 0x3c, 0xff, 0x34, 0xc0, 0x91,
-	0x6b, 0x5b, 0x3a, 0xcd, 0x78, 0xc9, 0x5b, 0xfe, 0x3a, 0x6c, 0x3c, 0x0f, 0x9b, 0xd1, 0x37, 0x54,
-	0xa2, 0x6f, 0xfe, 0x05, 0x9a, 0x5b, 0x4b, 0x9c, 0xd9, 0xc4, 0xb7, 0xa8, 0x6a, 0x61, 0xc3, 0x36,
-	0xaa, 0x92, 0xa9, 0x06, 0xb2, 0xab, 0xbf, 0xac, 0xcf, 0x8b, 0xa6, 0xa1, 0xe8, 0x6d, 0xa9, 0x1a,
-	0xa1, 0xa0, 0x6d, 0x51, 0x9e, 0xb4, 0x64, 0xc4, 0x00, 0x54, 0x34, 0xfa, 0xe4, 0x53, 0x65, 0x54,
-	0x2b, 0x2a, 0x04, 0x4d, 0x80, 0x10, 0x43, 0x8a, 0xfe, 0x30, 0xfc, 0x88, 0x4a, 0x62, 0x26, 0x41,
-	0xfc, 0x0e, 0xc0, 0xc0, 0x94, 0xe2, 0xb9, 0x79, 0xf1, 0xc4, 0x77, 0xaa, 0x42, 0x1d, 0x7c, 0xc4,
-	0x10, 0x0c, 0x62, 0x1d, 0xaf, 0x6f, 0x3a, 0x5d, 0xe9, 0xf9, 0xe6, 0x40, 0x1c, 0x9a, 0x72, 0x58,
-	0x1d, 0xa8, 0x20, 0x76, 0x16, 0x8e, 0x3d, 0x96, 0xf6, 0x48, 0x7c, 0xe2, 0xb9, 0xa2, 0x3a, 0x54,
-	0x3d, 0x0e, 0xdb, 0x28, 0x89, 0xe9, 0x9a, 0xce, 0x85, 0xb4, 0xfb, 0xd8, 0x17, 0x5b, 0x49, 0x92,
-	0x00, 0x51, 0xda, 0x40, 0x48, 0x1c, 0xc7, 0xb6, 0x55, 0xfd, 0x9e, 0xea, 0x6b, 0x04, 0xa8, 0x77,
-	0x00, 0x62, 0x95, 0x43, 0x3b, 0xde, 0xa0, 0xc3, 0x19, 0xb6, 0xa2, 0xf2, 0x48, 0xae, 0x65, 0xbb,
-	0x83, 0x6d, 0xad, 0x65, 0x2c, 0x85, 0x40, 0xca, 0x0f, 0x08, 0x2b, 0x02, 0x92, 0x27, 0x41, 0x2d,
-	0x61, 0xb1, 0x4c, 0xfd, 0xff, 0xa6, 0xa0, 0x9c, 0xa8, 0x45, 0xf8, 0x63, 0xac, 0x9f, 0xc0, 0x7d,
-	0x16, 0x77, 0x6a, 0x1c, 0x50, 0xa5, 0x81, 0x51, 0x1b, 0x87, 0x5b, 0x97, 0x4a, 0xe0, 0x5b, 0x95,
-	0x0d, 0x48, 0x40, 0x3e, 0x53, 0xed, 0x44, 0xfd, 0xa1, 0x4e, 0xa9, 0x94, 0xa1, 0xf0, 0xc4, 0x3d,
-	0x75, 0xbd, 0xe7, 0xae, 0xda, 0x40, 0xa9, 0x20, 0x66, 0xea, 0x68, 0x2f, 0xac, 0x59, 0xc9, 0xd4,
-	0xff, 0x41, 0x76, 0xa6, 0x76, 0xac, 0x05, 0x79, 0xe5, 0xc7, 0x93, 0x8b, 0x39, 0x5f, 0xec, 0x93,
-	0x44, 0xd6, 0xc7, 0x48, 0x09, 0x90, 0xa1, 0x89, 0xd1, 0xc1, 0x8e, 0x2a, 0x2b, 0xd3, 0x0b, 0x8f,
-	0xbb, 0xa6, 0x18, 0x85, 0x46, 0x73, 0xaa, 0xb8, 0x38, 0xe2, 0x50, 0xfb, 0x6b, 0x29, 0xb8, 0xbe,
-	0x08, 0x25, 0x59, 0x82, 0x9d, 0x9a, 0x2e, 0xc1, 0xee, 0xce, 0x94, 0x34, 0xa7, 0xa9, 0x37, 0x0f,
-	0x5e, 0x52, 0x88, 0xe9, 0x02, 0xe7, 0xfa, 0x8f, 0x53, 0xb0, 0x31, 0xd7, 0xe7, 0x84, 0x83, 0x01,
-	0x90, 0x57, 0x9a, 0xa5, 0x2a, 0x8e, 0xa2, 0x1a, 0x10, 0x95, 0xc3, 0xa7, 0xad, 0x37, 0x50, 0x87,
-	0xea, 0xba, 0x88, 0x5b, 0xf9, 0xaf, 0x38, 0x6b, 0x68, 0xd9, 0x07, 0x42, 0x65, 0x48, 0x95, 0x17,
-	0xa4, 0x21, 0x79, 0xe5, 0x63, 0xaa, 0x83, 0x06, 0x56, 0xa0, 0x4a, 0xa6, 0xc9, 0xd8, 0xb1, 0xfb,
-	0xd8, 0x2c, 0xf2, 0x1a, 0xdc, 0x54, 0x95, 0xfc, 0x3a, 0x9e, 0x3b, 0xe9, 0x0d, 0x6d, 0x5a, 0x1c,
-	0xac, 0x54, 0x37, 0xe0, 0xda, 0x82, 0x3e, 0x91, 0x94, 0x4f, 0xb5, 0xc4, 0x6b, 0x00, 0xdb, 0x4f,
-	0x43, 0x39, 0x59, 0x8a, 0x73, 0x58, 0xdb, 0x7e, 0x9a, 0x64, 0xa8, 0xd7, 0xcb, 0x53, 0xb4, 0x24,
-	0x01, 0xcb, 0xd4, 0x7f, 0x25, 0x15, 0x56, 0x17, 0xd4, 0xfe, 0x3c, 0x54, 0x94, 0x8c, 0x87, 0xe6,
-	0x85, 0xe3, 0x99, 0x16, 0x6f, 0xc1, 0x5a, 0x10, 0x5d, 0x2f, 0x49, 0x6c, 0x1e, 0xb3, 0x9b, 0x72,
-	0x77, 0x0a, 0xc9, 0x98, 0x21, 0x0a, 0xc3, 0x92, 0x74, 0x7c, 0x24, 0xc1, 0x29, 0xc0, 0x32, 0x69,
-	0x95, 0xad, 0x52, 0xc8, 0x64, 0xd6, 0xbf, 0x06, 0x1b, 0xdd, 0xd8, 0xd0, 0x2a, 0xff, 0x15, 0xf5,
-	0x41, 0x59, 0xe9, 0xed, 0x50, 0x1f, 0x74, 0xb3, 0xfe, 0x9f, 0xf2, 0x00, 0xf1, 0xf1, 0xcb, 0x82,
-	0x65, 0xbe, 0xa8, 0x9a, 0x60, 0xee, 0x30, 0x34, 0xf3, 0xd2, 0x87, 0xa1, 0xef, 0x45, 0x6e, 0xb4,
-	0x4a, 0xe6, 0xce, 0x96, 0x54, 0xc7, 0x32, 0xcd, 0x3a, 0xcf, 0x53, 0xc5, 0x36, 0xb9, 0xd9, 0x62,
-	0x9b, 0xbb, 0xf3, 0x95, 0x79, 0x33, 0xf6, 0x27, 0xce, 0x12, 0x14, 0xa6, 0xb2, 0x04, 0x35, 0x28,
-	0xfa, 0xc2, 0xb4, 0x3c, 0xd7, 0xb9, 0x08, 0xcf, 0xdc, 0xc2, 0x36, 0x7f, 0x0b, 0x72, 0x92, 0x6e,
-	0xc8, 0x14, 0x69, 0xb9, 0xbc, 0x60, 0xe2, 0x14, 0x2e, 0x1a, 0x33, 0x3b, 0xd0, 0xe5, 0x74, 0x6a,
-	0x07, 0x2b, 0x1a, 0x09, 0x08, 0xdf, 0x04, 0x6e, 0x63, 0xc8, 0xe4, 0x38, 0xc2, 0xda, 0xba, 0xd8,
-	0x56, 0x47, 0x61, 0xb4, 0xc7, 0x16, 0x8d, 0x05, 0x6f, 0xc2, 0xf9, 0x5f, 0x8d, 0xe7, 0x9f, 0x44,
-	0x3e, 0xb3, 0x03, 0xec, 0x69, 0x85, 0x5c, 0x89, 0xa8, 0x8d, 0xbb, 0x78, 0xb8, 0x46, 0xd5, 0x58,
-	0x92, 0xf6, 0xc6, 0xe7, 0xc9, 0x97, 0xbc, 0xad, 0xff, 0x5e, 0x3a, 0x0a, 0x37, 0x4a, 0x90, 0x3b,
-	0x36, 0x03, 0xbb, 0xaf, 0xa2, 0x4f, 0xed, 0x26, 0xa8, 0x90, 0x43, 0x7a, 0x96, 0xc7, 0xd2, 0x18,
-	0x39, 0x04, 0x42, 0x1f, 0x71, 0xc4, 0xb7, 0x86, 0x58, 0x16, 0xd7, 0x66, 0x38, 0xdf, 0xaa, 0x2a,
-	0x86, 0x48, 0x29, 0x61, 0x65, 0x45, 0xf5, 0x86, 0x14, 0x7a, 0x92, 0xed, 0x67, 0x45, 0xc4, 0x71,
-	0x3d, 0x29, 0x54, 0xba, 0x8e, 0xb4, 0x93, 0x01, 0xb2, 0x09, 0xcb, 0xe0, 0x59, 0x19, 0x5d, 0xf9,
-	0x90, 0xa9, 0xca, 0xb1, 0x05, 0x14, 0xe8, 0xac, 0xe2, 0xea, 0x9c, 0x7e, 0xc1, 0x2a, 0x28, 0x51,
-	0x7c, 0x19, 0x89, 0xad, 0x21, 0x57, 0x93, 0x6a, 0x35, 0xd6, 0xf1, 0xf1, 0x8c, 0x2a, 0x38, 0x18,
-	0x7e, 0xd5, 0x42, 0x83, 0xb1, 0x81, 0x92, 0x45, 0xae, 0x01, 0xe3, 0x18, 0xa9, 0x8c, 0x4d, 0x0c,
-	0x1b, 0xec, 0xb1, 0xe9, 0x4a, 0x76, 0x0d, 0xbb, 0x3a, 0xb6, 0x4e, 0xd8, 0x75, 0x24, 0xe9, 0x0f,
-	0x4d, 0xc9, 0x6e, 0x20, 0x0e, 0x3e, 0x6d, 0x0b, 0x1f, 0xe7, 0x93, 0xdd, 0x44, 0x1c, 0x69, 0x0e,
-	0xd8, 0xad, 0xfa, 0x6f, 0xc6, 0x15, 0xbf, 0x6f, 0x44, 0x0e, 0xfd, 0x32, 0x4a, 0x8e, 0x2e, 0xff,
-	0xa2, 0x15, 0xd7, 0x82, 0x0d, 0x5f, 0x7c, 0x7f, 0x62, 0x4f, 0xd5, 0xc1, 0x67, 0xae, 0x2e, 0xb4,
-	0x98, 0xa7, 0xa8, 0x9f, 0xc1, 0x46, 0xd8, 0x78, 0x66, 0xcb, 0x21, 0xe5, 0x56, 0xf8, 0x5b, 0x89,
-	0x42, 0xfd, 0xd4, 0xc2, 0x0b, 0x4e, 0x11, 0xcb, 0xb8, 0x30, 0x3f, 0xca, 0x9d, 0xa7, 0x97, 0xc8,
-	0x9d, 0xd7, 0xff, 0x4f, 0x3e, 0x91, 0x5e, 0x51, 0x21, 0x8e, 0x15, 0x85, 0x38, 0xf3, 0x47, 0xad,
-	0x71, 0x3a, 0x3c, 0xfd, 0x32, 0xe9, 0xf0, 0x45, 0x65, 0x0b, 0xef, 0xa3, 0xc7, 0x4d, 0xeb, 0xe7,
-	0xe9, 0x12, 0xa9, 0xfe, 0x29, 0x5c, 0xbe, 0x45, 0x07, 0xa7, 0x66, 0x57, 0xd5, 0xd4, 0xe4, 0x16,
-	0x5e, 0x9b, 0x49, 0x9e, 0x90, 0x6a, 0x4c, 0x23, 0x41, 0x95, 0xb0, 0x36, 0xf9, 0x45, 0xd6, 0x06,
-	0xa3, 0x4d, 0x6d, 0x87, 0xa2, 0xb6, 0x3a, 0x19, 0x51, 0xcf, 0x21, 0x7b, 0xf2, 0xa3, 0x8b, 0xc6,
-	0x1c, 0x1c, 0xbd, 0xb0, 0xd1, 0xc4, 0x91, 0xb6, 0x4e, 0xfe, 0xab, 0xc6, 0xec, 0xbd, 0xbe, 0xd2,
-	0xfc, 0xbd, 0xbe, 0x0f, 0x01, 0x02, 0x81, 0xab, 0x63, 0xdb, 0xee, 0x4b, 0x5d, 0x79, 0x73, 0xe7,
-	0xb2, 0xbe, 0xe9, 0x23, 0x8b, 0x04, 0x05, 0xca, 0x3f, 0x32, 0xcf, 0xe9, 0x18, 0x53, 0x97, 0x08,
-	0x44, 0xed, 0x59, 0x1b, 0xbc, 0x36, 0x6f, 0x83, 0xdf, 0x82, 0x5c, 0xd0, 0xf7, 0xc6, 0x82, 0xae,
-	0xa6, 0x5c, 0x3e, 0xbf, 0x9b, 0x5d, 0x44, 0x32, 0x14, 0x2e, 0x25, 0xf1, 0xd0, 0x4a, 0x79, 0x3e,
-	0x5d, 0x4a, 0x29, 0x19, 0x61, 0x73, 0xca, 0x0e, 0xde, 0x9c, 0xb6, 0x83, 0x35, 0x0b, 0xf2, 0x3a,
-	0x21, 0x3f, 0x1b, 0x5a, 0x87, 0xa9, 0xbc, 0x74, 0x22, 0x95, 0x17, 0xd5, 0x77, 0x66, 0x92, 0xf5,
-	0x9d, 0x33, 0xf7, 0xd6, 0x72, 0x73, 0xf7, 0xd6, 0xea, 0x9f, 0x40, 0x8e, 0x64, 0x45, 0x27, 0x42,
-	0x0d, 0xb3, 0xf2, 0x31, 0xb1, 0x53, 0x2c, 0xc5, 0xaf, 0x03, 0x0b, 0x04, 0x39, 0x21, 0xa2, 0x6b,
-	0x8e, 0x04, 0x19, 0xc9, 0x34, 0xaf, 0xc2, 0x75, 0x85, 0x1b, 0x4c, 0xbf, 0x21, 0x4f, 0xc8, 0xb1,
-	0x8f, 0x7d, 0xd3, 0xbf, 0x60, 0xd9, 0xfa, 0x87, 0x74, 0x1c, 0x1e, 0x2a, 0x54, 0x39, 0xba, 0x27,
-	0xa8, 0xcc, 0xb2, 0xa5, 0xad, 0x0f, 0xd5, 0x46, 0xe8, 0xf8, 0x48, 0x55, 0x8c, 0x51, 0x00, 0x42,
-	0x19, 0x94, 0xd5, 0xe4, 0x4e, 0xfc, 0xc7, 0xb6, 0xde, 0xea, 0x5b, 0x09, 0x57, 0x6e, 0xba, 0x04,
-	0x2c, 0xb5, 0x6c, 0x09, 0x58, 0xfd, 0x31, 0xac, 0x1b, 0xd3, 0x36, 0x9d, 0xbf, 0x07, 0x05, 0x6f,
-	0x9c, 0xe4, 0xf3, 0x22, 0xbd, 0x0c, 0xd1, 0xeb, 0x3f, 0x49, 0xc1, 0x6a, 0xdb, 0x95, 0xc2, 0x77,
-	0x4d, 0x67, 0xc7, 0x31, 0x07, 0xfc, 0xdd, 0xd0, 0x4a, 0x2d, 0x8e, 0xd6, 0x93, 0xb8, 0xd3, 0x06,
-	0xcb, 0xd1, 0x89, 0x67, 0x7e, 0x03, 0x36, 0x84, 0x65, 0x4b, 0xcf, 0x57, 0x0e, 0x6c, 0x58, 0xa9,
-	0x77, 0x1d, 0x98, 0x02, 0x77, 0x69, 0x49, 0xf4, 0xd4, 0x34, 0x57, 0xe1, 0xfa, 0x14, 0x34, 0xf4,
-	0x4e, 0xd3, 0xfc, 0x36, 0x54, 0xe3, 0xdd, 0x68, 0xdb, 0x73, 0x65, 0xdb, 0xb5, 0xc4, 0x39, 0xb9,
-	0x42, 0x2c, 0x53, 0xff, 0xb5, 0x42, 0xe8, 0x84, 0x3d, 0xd5, 0x75, 0x7c, 0xbe, 0xe7, 0xc5, 0x97,
-	0x44, 0x75, 0x2b, 0x71, 0x19, 0x39, 0xbd, 0xc4, 0x65, 0xe4, 0x0f, 0xe3, 0x0b, 0xa5, 0x6a, 0xa3,
-	0x78, 0x65, 0xe1, 0xee, 0x43, 0xe5, 0x47, 0xda, 0xed, 0xee, 0x8a, 0xc4, 0xed, 0xd2, 0x37, 0x75,
-	0xac, 0x95, 0x5d, 0xc6, 0x57, 0x55, 0x67, 0xfb, 0xef, 0xcc, 0xde, 0x62, 0x58, 0xae, 0x0c, 0x70,
-	0xce, 0x9d, 0x84, 0x97, 0x76, 0x27, 0xbf, 0x35, 0x13, 0xd6, 0x14, 0x17, 0x26, 0xb0, 0xae, 0xb8,
-	0xa3, 0xf9, 0x2d, 0x28, 0x0c, 0xed, 0x40, 0x7a, 0xbe, 0xba, 0x37, 0x3c, 0x7f, 0xcf, 0x29, 0x31,
-	0x5a, 0xbb, 0x0a, 0x91, 0x6a, 0xb6, 0x42, 0x2a, 0xfe, 0x5d, 0xd8, 0xa0, 0x81, 0x3f, 0x8c, 0xbd,
-	0x86, 0xa0, 0x5a, 0x5e, 0x58, 0x2b, 0x97, 0x60, 0xb5, 0x35, 0x43, 0x62, 0xcc, 0x33, 0xa9, 0x0d,
-	0x00, 0xe2, 0xf9, 0x99, 0xb3, 0x62, 0x9f, 0xe1, 0xde, 0xf0, 0x4d, 0xc8, 0x07, 0x93, 0xe3, 0xf8,
-	0x84, 0x4a, 0xb7, 0x6a, 0xe7, 0x50, 0x9b, 0xf3, 0x0e, 0x0e, 0x85, 0xaf, 0xc4, 0xbd, 0xf2, 0xf2,
-	0xf2, 0x87, 0xc9, 0x89, 0x57, 0xca, 0x79, 0xf7, 0x92, 0xd9, 0x8b, 0x38, 0x27, 0x34, 0xa0, 0xf6,
-	0x0e, 0x94, 0x13, 0x83, 0x8a, 0x96, 0x79, 0xe2, 0x5a, 0x5e, 0x98, 0x34, 0xc5, 0x67, 0x75, 0x79,
-	0xcb, 0x0a, 0xd3, 0xa6, 0xf4, 0x5c, 0x33, 0x80, 0xcd, 0x0e, 0xe0, 0x15, 0xa1, 0xef, 0x2b, 0x50,
-	0x49, 0xb8, 0x74, 0x51, 0x42, 0x6d, 0x1a, 0x58, 0x3f, 0x83, 0xcf, 0x27, 0xd8, 0x1d, 0x0a, 0x7f,
-	0x64, 0x07, 0xb8, 0x91, 0xa8, 0x90, 0x8e, 0xb2, 0x17, 0x96, 0x70, 0xa5, 0x2d, 0x43, 0x0b, 0x1a,
-	0xb5, 0xf9, 0x2f, 0x40, 0x6e, 0x2c, 0xfc, 0x51, 0xa0, 0xad, 0xe8, 0xac, 0x06, 0x2d, 0x64, 0x1b,
-	0x18, 0x8a, 0xa6, 0xfe, 0xf7, 0x52, 0x50, 0xdc, 0x17, 0xd2, 0x44, 0xdf, 0x81, 0xef, 0xcf, 0x7c,
-	0x65, 0xfe, 0x54, 0x35, 0x44, 0xdd, 0xd4, 0x41, 0xe6, 0x66, 0x5b, 0xe3, 0xeb, 0xf6, 0xee, 0x4a,
-	0x2c, 0x58, 0x6d, 0x0b, 0x0a, 0x1a, 0x5c, 0x7b, 0x17, 0xd6, 0x67, 0x30, 0x69, 0x5c, 0x94, 0x6f,
-	0xdf, 0xbd, 0x18, 0x85, 0xa5, 0x3f, 0xab, 0xc6, 0x34, 0x70, 0xab, 0x04, 0x85, 0xb1, 0x22, 0xa8,
-	0xff, 0xde, 0x0d, 0x2a, 0x38, 0xb1, 0x4f, 0x30, 0xd8, 0x5e, 0xb4, 0xb3, 0xde, 0x01, 0xa0, 0xad,
-	0x59, 0x95, 0x25, 0xa8, 0x24, 0x67, 0x02, 0xc2, 0xdf, 0x8f, 0xb2, 0xd3, 0xd9, 0x85, 0x4e, 0x55,
-	0x92, 0xf9, 0x6c, 0x8a, 0xba, 0x0a, 0x05, 0x3b, 0xd8, 0xc3, 0xad, 0x4d, 0x97, 0xf2, 0x84, 0x4d,
-	0xfe, 0x4d, 0xc8, 0xdb, 0xa3, 0xb1, 0xe7, 0x4b, 0x9d, 0xbe, 0xbe, 0x92, 0x6b, 0x9b, 0x30, 0x77,
-	0x57, 0x0c, 0x4d, 0x83, 0xd4, 0xe2, 0x9c, 0xa8, 0x8b, 0x2f, 0xa6, 0x6e, 0x9d, 0x87, 0xd4, 0x8a,
-	0x86, 0x7f, 0x07, 0x2a, 0x03, 0x55, 0x97, 0xa8, 0x18, 0x6b, 0x23, 0xf2, 0x95, 0xab, 0x98, 0x3c,
-	0x4a, 0x12, 0xec, 0xae, 0x18, 0xd3, 0x1c, 0x90, 0x25, 0x3a, 0xf0, 0x22, 0x90, 0x3d, 0xef, 0x23,
-	0xcf, 0x76, 0x29, 0x28, 0x7d, 0x01, 0x4b, 0x23, 0x49, 0x80, 0x2c, 0xa7, 0x38, 0xf0, 0xaf, 0xa3,
-	0xc7, 0x13, 0x48, 0x7d, 0x75, 0xfb, 0xee, 0x55, 0x9c, 0x7a, 0x22, 0xd0, 0x97, 0xae, 0x03, 0xc9,
-	0xcf, 0xa1, 0x96, 0x58, 0x24, 0xfa, 0x23, 0x8d, 0xf1, 0xd8, 0xf7, 0x30, 0xb2, 0xad, 0x10, 0xb7,
-	0xaf, 0x5f, 0xc5, 0xed, 0xf0, 0x52, 0xea, 0xdd, 0x15, 0xe3, 0x0a, 0xde, 0xbc, 0x87, 0x91, 0x9d,
-	0xee, 0xc2, 0x9e, 0x30, 0xcf, 0xc2, 0x8b, 0xdf, 0xf7, 0x97, 0x1a, 0x05, 0xa2, 0xd8, 0x5d, 0x31,
-	0x66, 0x78, 0xf0, 0x5f, 0x82, 0x8d, 0xa9, 0x6f, 0xd2, 0x5d, 0x4f, 0x75, 0x2d, 0xfc, 0x6b, 0x4b,
-	0x77, 0x03, 0x89, 0x76, 0x57, 0x8c, 0x79, 0x4e, 0x7c, 0x02, 0x9f, 0x9b, 0xef, 0xd2, 0xb6, 0xe8,
-	0x3b, 0xb6, 0x2b, 0xf4, 0x0d, 0xf2, 0x77, 0x5e, 0x6e, 0xb4, 0x34, 0xf1, 0xee, 0x8a, 0x71, 0x39,
-	0x67, 0xfe, 0x17, 0xe1, 0xf6, 0x78, 0xa1, 0x89, 0x51, 0xa6, 0x4b, 0x5f, 0x40, 0x7f, 0x6f, 0xc9,
-	0x2f, 0xcf, 0xd1, 0xef, 0xae, 0x18, 0x57, 0xf2, 0x47, 0xdf, 0x99, 0x22, 0x68, 0x5d, 0x3e, 0xad,
-	0x1a, 0xfc, 0x36, 0x94, 0xcc, 0xbe, 0xb3, 0x2b, 0x4c, 0x2b, 0xca, 0xb0, 0xc7, 0x80, 0xda, 0xff,
-	0x48, 0x41, 0x5e, 0xeb, 0xfb, 0xed, 0xe8, 0x14, 0x3d, 0x32, 0xdd, 0x31, 0x80, 0x7f, 0x00, 0x25,
-	0xe1, 0xfb, 0x9e, 0xdf, 0xf4, 0xac, 0xb0, 0x00, 0x71, 0x36, 0xfd, 0xab, 0xf8, 0x6c, 0xb6, 0x42,
-	0x34, 0x23, 0xa6, 0xe0, 0xef, 0x03, 0xa8, 0x75, 0xde, 0x8b, 0x6f, 0xc1, 0xd4, 0x16, 0xd3, 0xab,
-	0x43, 0x9b, 0x18, 0x3b, 0x4e, 0x9e, 0x85, 0x27, 0x26, 0x61, 0x33, 0x0a, 0x38, 0x73, 0x89, 0x80,
-	0xf3, 0xb6, 0xce, 0x23, 0x1c, 0xe0, 0x0b, 0x7d, 0x17, 0x2c, 0x02, 0xd4, 0xfe, 0x75, 0x0a, 0xf2,
-	0xca, 0x78, 0xf0, 0xd6, 0x7c, 0x8f, 0x5e, 0x7b, 0xb1, 0xcd, 0xd9, 0x9c, 0xed, 0xd9, 0x37, 0x01,
-	0x94, 0x0d, 0x4a, 0xf4, 0xec, 0xf6, 0x0c, 0x1f, 0x4d, 0x1a, 0x16, 0xf0, 0xc6, 0xf8, 0xf5, 0x87,
-	0xea, 0xbe, 0x12, 0xe5, 0x6a, 0x9f, 0xec, 0xed, 0xb1, 0x15, 0xbe, 0x01, 0x95, 0x27, 0x07, 0x8f,
-	0x0f, 0x3a, 0xcf, 0x0e, 0x8e, 0x5a, 0x86, 0xd1, 0x31, 0x54, 0xca, 0x76, 0xab, 0xb1, 0x7d, 0xd4,
-	0x3e, 0x38, 0x7c, 0xd2, 0x63, 0xe9, 0xda, 0x3f, 0x4b, 0x41, 0x65, 0xca, 0x76, 0xfd, 0xc9, 0x4e,
-	0x5d, 0x62, 0xf8, 0x33, 0x8b, 0x87, 0x3f, 0x7b, 0xd9, 0xf0, 0xe7, 0x66, 0x87, 0xff, 0x77, 0x52,
-	0x50, 0x99, 0xb2, 0x91, 0x49, 0xee, 0xa9, 0x69, 0xee, 0xc9, 0x9d, 0x3e, 0x3d, 0xb3, 0xd3, 0xd7,
-	0x61, 0x35, 0x7c, 0x3e, 0x88, 0x33, 0x0e, 0x53, 0xb0, 0x24, 0x0e, 0x5d, 0x18, 0xc8, 0x4e, 0xe3,
-	0xd0, 0xa5, 0x81, 0xab, 0xa5, 0xa5, 0x0b, 0x92, 0x01, 0xdd, 0x1f, 0xaf, 0x5d, 0x6e, 0x41, 0xaf,
-	0xe8, 0xc2, 0x23, 0x28, 0x8f, 0xe3, 0x65, 0xfa, 0x72, 0x6e, 0x49, 0x92, 0xf2, 0x05, 0x72, 0xfe,
-	0x38, 0x05, 0x6b, 0xd3, 0x36, 0xf7, 0x4f, 0xf5, 0xb0, 0xfe, 0xc3, 0x14, 0x6c, 0xcc, 0x59, 0xf2,
-	0x2b, 0x1d, 0xbb, 0x59, 0xb9, 0xd2, 0x4b, 0xc8, 0x95, 0x59, 0x20, 0xd7, 0xe5, 0x96, 0xe4, 0x6a,
-	0x89, 0xbb, 0xf0, 0xb9, 0x4b, 0xf7, 0x84, 0x2b, 0x86, 0x7a, 0x8a, 0x69, 0x66, 0x96, 0xe9, 0x6f,
-	0xa7, 0xe0, 0xf6, 0x55, 0xf6, 0xfe, 0xff, 0xbb, 0x5e, 0xcd, 0x4a, 0x58, 0x7f, 0x37, 0x3a, 0x7a,
-	0x2f, 0x43, 0x41, 0xff, 0x2e, 0x93, 0x2e, 0x6e, 0x1e, 0x7a, 0xcf, 0x5d, 0x95, 0x89, 0x36, 0x84,
-	0xa9, 0x6f, 0xae, 0x1b, 0x62, 0xec, 0xd8, 0x74, 0x78, 0x79, 0x0b, 0xa0, 0x41, 0x71, 0x5d, 0x78,
-	0x91, 0xa4, 0xb9, 0xd7, 0xe9, 0xb6, 0xd8, 0x4a, 0xd2, 0x89, 0xfd, 0x24, 0x34, 0xc4, 0xf5, 0x43,
-	0xc8, 0xc7, 0x97, 0x01, 0xf6, 0x4d, 0xff, 0xd4, 0x52, 0x47, 0x84, 0xab, 0x50, 0x3c, 0xd4, 0x21,
-	0x94, 0xfa, 0xd4, 0x47, 0xdd, 0xce, 0x81, 0x4a, 0x7a, 0x6f, 0x77, 0x7a, 0xea, 0x4a, 0x41, 0xf7,
-	0xe9, 0x23, 0x75, 0x56, 0xf5, 0xc8, 0x68, 0x1c, 0xee, 0x1e, 0x11, 0x46, 0xae, 0xfe, 0x5b, 0xd9,
-	0x70, 0x57, 0xab, 0x1b, 0xfa, 0xf0, 0x11, 0x20, 0x8f, 0xd6, 0xdc, 0xd3, 0x8c, 0xa3, 0xcf, 0x50,
-	0x19, 0x6c, 0xeb, 0x5c, 0xe5, 0x21, 0x58, 0x9a, 0xe7, 0x21, 0x7d, 0x78, 0xac, 0x6a, 0x77, 0x76,
-	0xe5, 0xc8, 0x51, 0x37, 0x0b, 0x7b, 0xe7, 0x92, 0xe5, 0xf0, 0xa1, 0x19, 0x9c, 0xb1, 0x7c, 0xfd,
-	0x5f, 0x64, 0xa0, 0x14, 0x99, 0xca, 0x97, 0x31, 0xdd, 0x9c, 0xc3, 0x5a, 0xfb, 0xa0, 0xd7, 0x32,
-	0x0e, 0x1a, 0x7b, 0x1a, 0x25, 0xc3, 0xaf, 0xc1, 0xfa, 0x4e, 0x7b, 0xaf, 0x75, 0xb4, 0xd7, 0x69,
-	0x6c, 0x6b, 0x60, 0x91, 0xdf, 0x04, 0xde, 0xde, 0x3f, 0xec, 0x18, 0xbd, 0xa3, 0x76, 0xf7, 0xa8,
-	0xd9, 0x38, 0x68, 0xb6, 0xf6, 0x5a, 0xdb, 0x2c, 0xcf, 0x5f, 0x81, 0xbb, 0x07, 0x9d, 0x5e, 0xbb,
-	0x73, 0x70, 0x74, 0xd0, 0x39, 0xea, 0x6c, 0x7d, 0xd4, 0x6a, 0xf6, 0xba, 0x47, 0xed, 0x83, 0x23,
-	0xe4, 0xfa, 0xc8, 0x68, 0xe0, 0x1b, 0x96, 0xe3, 0x77, 0xe1, 0xb6, 0xc6, 0xea, 0xb6, 0x8c, 0xa7,
-	0x2d, 0x03, 0x99, 0x3c, 0x39, 0x68, 0x3c, 0x6d, 0xb4, 0xf7, 0x1a, 0x5b, 0x7b, 0x2d, 0xb6, 0xca,
-	0xef, 0x40, 0x4d, 0x63, 0x18, 0x8d, 0x5e, 0xeb, 0x68, 0xaf, 0xbd, 0xdf, 0xee, 0x1d, 0xb5, 0xbe,
-	0xdb, 0x6c, 0xb5, 0xb6, 0x5b, 0xdb, 0xac, 0xc2, 0xbf, 0x02, 0x5f, 0x26, 0xa1, 0xb4, 0x10, 0xd3,
-	0x1f, 0xfb, 0xa4, 0x7d, 0x78, 0xd4, 0x30, 0x9a, 0xbb, 0xed, 0xa7, 0x2d, 0xb6, 0xc6, 0x5f, 0x83,
-	0x2f, 0x5d, 0x8e, 0xba, 0xdd, 0x36, 0x5a, 0xcd, 0x5e, 0xc7, 0xf8, 0x98, 0x6d, 0xf0, 0x2f, 0xc0,
-	0xe7, 0x76, 0x7b, 0xfb, 0x7b, 0x47, 0xcf, 0x8c, 0xce, 0xc1, 0xa3, 0x23, 0x7a, 0xec, 0xf6, 0x8c,
-	0x27, 0xcd, 0xde, 0x13, 0xa3, 0xc5, 0x80, 0xd7, 0xe0, 0xe6, 0xe1, 0xd6, 0xd1, 0x41, 0xa7, 0x77,
-	0xd4, 0x38, 0xf8, 0x78, 0x6b, 0xaf, 0xd3, 0x7c, 0x7c, 0xb4, 0xd3, 0x31, 0xf6, 0x1b, 0x3d, 0x56,
-	0xe6, 0x5f, 0x85, 0xd7, 0x9a, 0xdd, 0xa7, 0x5a, 0xcc, 0xce, 0xce, 0x91, 0xd1, 0x79, 0xd6, 0x3d,
-	0xea, 0x18, 0x47, 0x46, 0x6b, 0x8f, 0xfa, 0xdc, 0x8d, 0x65, 0x2f, 0xf0, 0xdb, 0x50, 0x6d, 0x1f,
-	0x74, 0x9f, 0xec, 0xec, 0xb4, 0x9b, 0xed, 0xd6, 0x41, 0xef, 0xe8, 0xb0, 0x65, 0xec, 0xb7, 0xbb,
-	0x5d, 0x44, 0x63, 0xa5, 0xfa, 0xb7, 0x21, 0xdf, 0x76, 0xcf, 0x6c, 0x49, 0xeb, 0x4b, 0x2b, 0xa3,
-	0x8e, 0xb8, 0xc2, 0x26, 0x2d, 0x0b, 0x7b, 0xe0, 0xd2, 0x8d, 0x79, 0x5a, 0x5d, 0xab, 0x46, 0x0c,
-	0xa8, 0xff, 0xe3, 0x34, 0x54, 0x14, 0x8b, 0x30, 0x82, 0xbb, 0x07, 0xeb, 0x3a, 0x15, 0xda, 0x9e,
-	0x36, 0x61, 0xb3, 0x60, 0xfa, 0x29, 0x2a, 0x05, 0x4a, 0x18, 0xb2, 0x24, 0x88, 0x8e, 0xd7, 0x88,
-	0x39, 0x46, 0x82, 0xea, 0x60, 0x31, 0x06, 0x7c, 0x56, 0x0b, 0x86, 0xd6, 0x51, 0x21, 0xf6, 0x3d,
-	0xb7, 0x19, 0x5d, 0xb6, 0x98, 0x82, 0xf1, 0x4f, 0xe0, 0x56, 0xd4, 0x6e, 0xb9, 0x7d, 0xff, 0x62,
-	0x1c, 0xfd, 0x62, 0x5c, 0x61, 0x61, 0x4a, 0x61, 0xc7, 0x76, 0xc4, 0x14, 0xa2, 0x71, 0x19, 0x83,
-	0xfa, 0xef, 0xa7, 0x12, 0x71, 0xaf, 0x8a, 0x6b, 0xaf, 0xb4, 0xf8, 0x8b, 0xce, 0x60, 0x30, 0xf2,
-	0xd4, 0xe2, 0x6b, 0x47, 0x44, 0x37, 0xf9, 0x21, 0x70, 0x7b, 0x5e, 0xe8, 0xec, 0x92, 0x42, 0x2f,
-	0xa0, 0x9d, 0x4d, 0xa1, 0xe7, 0xe6, 0x53, 0xe8, 0x77, 0x00, 0x06, 0x8e, 0x77, 0x6c, 0x3a, 0x09,
-	0x47, 0x33, 0x01, 0xa9, 0x3b, 0x50, 0x0c, 0x7f, 0x97, 0x8e, 0xdf, 0x84, 0x3c, 0xfd, 0x32, 0x5d,
-	0x94, 0x50, 0x54, 0x2d, 0xbe, 0x0b, 0x6b, 0x62, 0x5a, 0xe6, 0xf4, 0x92, 0x32, 0xcf, 0xd0, 0xd5,
-	0xbf, 0x01, 0x1b, 0x73, 0x48, 0x38, 0x88, 0x63, 0x53, 0x46, 0x97, 0xd3, 0xf1, 0x79, 0xfe, 0x10,
-	0xbb, 0xfe, 0x1f, 0xd3, 0xb0, 0xba, 0x6f, 0xba, 0xf6, 0x89, 0x08, 0x64, 0x28, 0x6d, 0xd0, 0x1f,
-	0x8a, 0x91, 0x19, 0x4a, 0xab, 0x5a, 0x3a, 0xcb, 0x90, 0x4e, 0xe6, 0xef, 0xe7, 0x8e, 0x7b, 0x6e,
-	0x42, 0xde, 0x9c, 0xc8, 0x61, 0x54, 0xe1, 0xad, 0x5b, 0x38, 0x77, 0x8e, 0xdd, 0x17, 0x6e, 0x10,
-	0xea, 0x66, 0xd8, 0x8c, 0xcb, 0x58, 0xf2, 0x57, 0x94, 0xb1, 0x14, 0xe6, 0xc7, 0xff, 0x2e, 0x94,
-	0x83, 0xbe, 0x2f, 0x84, 0x1b, 0x0c, 0x3d, 0x19, 0xfe, 0xa6, 0x61, 0x12, 0x44, 0xc5, 0x5e, 0xde,
-	0x73, 0x17, 0x57, 0xe8, 0x9e, 0xed, 0x9e, 0xea, 0x1a, 0xa6, 0x29, 0x18, 0xea, 0x20, 0xe5, 0x58,
-	0xec, 0x4f, 0x05, 0xc5, 0xf7, 0x39, 0x23, 0x6a, 0x53, 0x16, 0xc5, 0x94, 0x62, 0xe0, 0xf9, 0xb6,
-	0x50, 0xa9, 0xc4, 0x92, 0x91, 0x80, 0x20, 0xad, 0x63, 0xba, 0x83, 0x89, 0x39, 0x10, 0xfa, 0x50,
-	0x38, 0x6a, 0xd7, 0xff, 0x67, 0x0e, 0x60, 0x5f, 0x8c, 0x8e, 0x85, 0x1f, 0x0c, 0xed, 0x31, 0x1d,
-	0x75, 0xd8, 0xba, 0xae, 0xb5, 0x62, 0xd0, 0x33, 0x7f, 0x6f, 0xaa, 0xe4, 0x7c, 0xfe, 0x70, 0x32,
-	0x26, 0x9f, 0x4d, 0xc1, 0xe0, 0xe0, 0x98, 0x52, 0xe8, 0x0a, 0x22, 0x1a, 0xff, 0xac, 0x91, 0x04,
-	0x51, 0x71, 0x97, 0x29, 0x45, 0xcb, 0xb5, 0x54, 0x8a, 0x27, 0x6b, 0x44, 0x6d, 0xba, 0xb4, 0x12,
-	0x34, 0x26, 0xd2, 0x33, 0x84, 0x2b, 0x9e, 0x47, 0xf7, 0xb1, 0x62, 0x10, 0xdf, 0x87, 0xca, 0xd8,
-	0xbc, 0x18, 0x09, 0x57, 0xee, 0x0b, 0x39, 0xf4, 0x2c, 0x5d, 0xee, 0xf3, 0xda, 0xe5, 0x02, 0x1e,
-	0x26, 0xd1, 0x8d, 0x69, 0x6a, 0xd4, 0x09, 0x37, 0xa0, 0x55, 0xa2, 0xa6, 0x51, 0xb7, 0xf8, 0x16,
-	0x80, 0x7a, 0xa2, 0xc8, 0xa9, 0xb8, 0x38, 0x13, 0x65, 0x8e, 0x44, 0x20, 0xfc, 0x33, 0x5b, 0xd9,
-	0x31, 0x15, 0x1b, 0xc6, 0x54, 0x68, 0xf5, 0x26, 0x81, 0xf0, 0x5b, 0x23, 0xd3, 0x76, 0xf4, 0x04,
-	0xc7, 0x00, 0xfe, 0x36, 0xdc, 0x08, 0x26, 0xc7, 0xa8, 0x33, 0xc7, 0xa2, 0xe7, 0x1d, 0x88, 0xe7,
-	0x81, 0x23, 0xa4, 0x14, 0xbe, 0xae, 0x2f, 0x58, 0xfc, 0xb2, 0x3e, 0x88, 0xdc, 0x1e, 0xfa, 0xfd,
-	0x0c, 0x7c, 0x8a, 0xeb, 0x96, 0x22, 0x90, 0x2e, 0xea, 0x62, 0x29, 0xce, 0x60, 0x55, 0x81, 0x74,
-	0xcd, 0x57, 0x9a, 0x7f, 0x19, 0xbe, 0x38, 0x85, 0x64, 0xa8, 0x83, 0xe0, 0x60, 0xc7, 0x76, 0x4d,
-	0xc7, 0xfe, 0x54, 0x1d, 0xcb, 0x67, 0xea, 0x63, 0xa8, 0x4c, 0x0d, 0x1c, 0x5d, 0x20, 0xa4, 0x27,
-	0x5d, 0x05, 0xc3, 0x60, 0x55, 0xb5, 0xbb, 0xd2, 0xb7, 0xe9, 0x84, 0x23, 0x82, 0x34, 0x71, 0x9d,
-	0x7b, 0x2c, 0xcd, 0xaf, 0x03, 0x53, 0x90, 0xb6, 0x6b, 0x8e, 0xc7, 0x8d, 0xf1, 0xd8, 0x11, 0x2c,
-	0x43, 0x97, 0x33, 0x63, 0xa8, 0x2a, 0x3c, 0x67, 0xd9, 0xfa, 0x77, 0xe1, 0x16, 0x8d, 0xcc, 0x53,
-	0xe1, 0x47, 0x81, 0xad, 0xee, 0xeb, 0x0d, 0xd8, 0x50, 0x4f, 0x07, 0x9e, 0x54, 0xaf, 0xc9, 0xd9,
-	0xe3, 0xb0, 0xa6, 0xc0, 0xe8, 0xeb, 0x74, 0x05, 0x5d, 0xb9, 0x8c, 0x60, 0x11, 0x5e, 0xba, 0xfe,
-	0x93, 0x3c, 0xf0, 0x58, 0x21, 0x7a, 0xb6, 0xf0, 0xb7, 0x4d, 0x69, 0x26, 0x32, 0x93, 0x95, 0x4b,
-	0xcf, 0xd6, 0x5f, 0x5c, 0xb2, 0x76, 0x13, 0xf2, 0x76, 0x80, 0xa1, 0x98, 0x2e, 0x28, 0xd5, 0x2d,
-	0xbe, 0x07, 0x30, 0x16, 0xbe, 0xed, 0x59, 0xa4, 0x41, 0xb9, 0x85, 0x95, 0xff, 0xf3, 0x42, 0x6d,
-	0x1e, 0x46, 0x34, 0x46, 0x82, 0x1e, 0xe5, 0x50, 0x2d, 0x75, 0x52, 0x9d, 0x27, 0xa1, 0x93, 0x20,
-	0xfe, 0x06, 0x5c, 0x1b, 0xfb, 0x76, 0x5f, 0xa8, 0xe9, 0x78, 0x12, 0x58, 0x4d, 0xfa, 0xd5, 0xb9,
-	0x02, 0x61, 0x2e, 0x7a, 0x85, 0x1a, 0x68, 0xba, 0x14, 0xa0, 0x04, 0x74, 0x36, 0xab, 0xaf, 0x1c,
-	0xab, 0x92, 0xcb, 0x8a, 0xb1, 0xf8, 0x25, 0xbf, 0x0f, 0x4c, 0xbf, 0xd8, 0xb7, 0xdd, 0x3d, 0xe1,
-	0x0e, 0xe4, 0x90, 0x94, 0xbb, 0x62, 0xcc, 0xc1, 0xc9, 0x82, 0xa9, 0xdf, 0xf6, 0x51, 0xe7, 0x36,
-	0x25, 0x23, 0x6a, 0xab, 0x6b, 0xec, 0x8e, 0xe7, 0x77, 0xa5, 0xaf, 0x6b, 0x47, 0xa3, 0x36, 0xfa,
-	0x2c, 0x01, 0xc9, 0x7a, 0xe8, 0x7b, 0xd6, 0x84, 0x4e, 0x15, 0x94, 0x11, 0x9b, 0x05, 0xc7, 0x98,
-	0xfb, 0xa6, 0xab, 0xeb, 0x06, 0x2b, 0x49, 0xcc, 0x08, 0x4c, 0x31, 0x98, 0x17, 0xc4, 0x0c, 0xd7,
-	0x75, 0x0c, 0x96, 0x80, 0x69, 0x9c, 0x98, 0x15, 0x8b, 0x70, 0x62, 0x3e, 0xd4, 0x7f, 0xcb, 0xf7,
-	0x6c, 0x2b, 0xe6, 0xb5, 0xa1, 0xaa, 0x3a, 0x67, 0xe1, 0x09, 0xdc, 0x98, 0x27, 0x9f, 0xc2, 0x8d,
-	0xe0, 0xf5, 0x1f, 0xa4, 0x00, 0xe2, 0xc9, 0x47, 0x95, 0x8f, 0x5b, 0xf1, 0x12, 0xbf, 0x05, 0xd7,
-	0x92, 0x60, 0xba, 0x1c, 0x40, 0x07, 0xbc, 0x1c, 0xd6, 0xe2, 0x17, 0xdb, 0xe6, 0x45, 0xc0, 0xd2,
-	0xfa, 0x9a, 0xb0, 0x86, 0x3d, 0x13, 0x82, 0x0a, 0xe9, 0xae, 0x03, 0x8b, 0x81, 0x74, 0xf7, 0x2b,
-	0x60, 0xd9, 0x69, 0xd4, 0x8f, 0x85, 0xe9, 0x07, 0x2c, 0x57, 0xdf, 0x85, 0xbc, 0x3a, 0x5c, 0x5a,
-	0x70, 0x2c, 0xfc, 0x72, 0x35, 0x1e, 0x7f, 0x3d, 0x05, 0xb0, 0xad, 0x2a, 0x78, 0x71, 0x17, 0x5f,
-	0x70, 0xda, 0xbe, 0xc8, 0xa3, 0x32, 0x2d, 0x8b, 0x2a, 0xa1, 0x33, 0xd1, 0x2f, 0xc6, 0x60, 0x13,
-	0x35, 0xc7, 0x0c, 0x2b, 0xa7, 0xd4, 0x9a, 0x8b, 0xda, 0x6a, 0x03, 0x69, 0x7a, 0xae, 0x2b, 0xfa,
-	0xb8, 0xfd, 0x44, 0x1b, 0x48, 0x04, 0xaa, 0xff, 0xdb, 0x02, 0x94, 0x9b, 0x43, 0x53, 0xee, 0x8b,
-	0x20, 0x30, 0x07, 0x62, 0x4e, 0x96, 0x2a, 0x14, 0x3c, 0xdf, 0x12, 0x7e, 0x7c, 0x7f, 0x4b, 0x37,
-	0x93, 0x35, 0x06, 0x99, 0xe9, 0x1a, 0x83, 0xdb, 0x50, 0x52, 0x27, 0x18, 0x56, 0x43, 0x99, 0x81,
-	0x8c, 0x11, 0x03, 0x70, 0xaf, 0x1e, 0x79, 0x16, 0x19, 0xa3, 0x86, 0x4a, 0xfe, 0x67, 0x8c, 0x04,
-	0x44, 0x95, 0x74, 0x8c, 0x9d, 0x8b, 0x9e, 0xa7, 0x65, 0x6a, 0x5b, 0xf1, 0x65, 0xd7, 0x69, 0x38,
-	0x6f, 0x42, 0x61, 0xa4, 0x1a, 0xfa, 0x20, 0x63, 0x36, 0xe5, 0x9f, 0xe8, 0xda, 0xa6, 0xfe, 0xab,
-	0xef, 0x9b, 0x18, 0x21, 0x25, 0x86, 0xe8, 0xa6, 0x94, 0x66, 0x7f, 0x38, 0xd2, 0x26, 0x22, 0xb3,
-	0xe0, 0x4c, 0x33, 0xc9, 0xa8, 0x11, 0x61, 0x1b, 0x49, 0x4a, 0xbe, 0x05, 0x25, 0x5f, 0x98, 0x53,
-	0xc7, 0xaa, 0xaf, 0x5c, 0xc1, 0xc6, 0x08, 0x71, 0x8d, 0x98, 0xac, 0xf6, 0xa3, 0x14, 0xac, 0x4d,
-	0x0b, 0xfa, 0x27, 0xf1, 0xa3, 0x5f, 0xdf, 0x8c, 0x7f, 0xf4, 0xeb, 0x33, 0xfc, 0x80, 0xd6, 0x6f,
-	0xa7, 0x00, 0xe2, 0x31, 0x40, 0x93, 0xaf, 0x7e, 0x9c, 0x28, 0x74, 0x42, 0x55, 0x8b, 0xef, 0x4e,
-	0xdd, 0x81, 0x7f, 0x7b, 0xa9, 0x01, 0x4d, 0x3c, 0x26, 0xca, 0x92, 0x1f, 0xc0, 0xda, 0x34, 0x9c,
-	0x7e, 0x6e, 0xa8, 0xbd, 0xd7, 0x52, 0x29, 0x8e, 0xf6, 0x7e, 0xe3, 0x51, 0x4b, 0xdf, 0xef, 0x69,
-	0x1f, 0x3c, 0x66, 0xe9, 0xda, 0x1f, 0xa4, 0xa0, 0x14, 0x0d, 0x2f, 0xff, 0x4e, 0x72, 0x5e, 0x54,
-	0x9d, 0xc4, 0x5b, 0xcb, 0xcc, 0x4b, 0xfc, 0xd4, 0x72, 0xa5, 0x7f, 0x91, 0x9c, 0x26, 0x0f, 0xd6,
-	0xa6, 0x5f, 0x2e, 0xb0, 0x09, 0x8f, 0xa6, 0x6d, 0xc2, 0x9b, 0x4b, 0x7d, 0x32, 0x8c, 0xbc, 0xf6,
-	0xec, 0x40, 0x6a, 0x73, 0xf1, 0x7e, 0xfa, 0xbd, 0x54, 0xed, 0x2e, 0xac, 0x26, 0x5f, 0xcd, 0x5f,
-	0xe2, 0xbb, 0xff, 0x07, 0x19, 0x58, 0x9b, 0x2e, 0x35, 0xa0, 0x2b, 0x43, 0xaa, 0xcc, 0xa5, 0xe3,
-	0x58, 0x89, 0x4a, 0x6e, 0xc6, 0xd7, 0xa1, 0xac, 0x63, 0x3b, 0x02, 0x6c, 0x50, 0x12, 0xc5, 0x1b,
-	0x09, 0x76, 0x37, 0xf9, 0xc3, 0x86, 0x6f, 0x70, 0x08, 0x2f, 0x73, 0xb1, 0x31, 0x2f, 0xe9, 0x9f,
-	0x78, 0xfa, 0xe5, 0x34, 0xaf, 0x24, 0xea, 0x89, 0x7f, 0x88, 0x8e, 0xcd, 0xfa, 0xd6, 0xc4, 0xb5,
-	0x1c, 0x61, 0x45, 0xd0, 0x1f, 0x25, 0xa1, 0x51, 0x75, 0xf0, 0x2f, 0x67, 0xf9, 0x1a, 0x94, 0xba,
-	0x93, 0x63, 0x5d, 0x19, 0xfc, 0x97, 0xb2, 0xfc, 0x26, 0x6c, 0x68, 0xac, 0xb8, 0xc4, 0x8f, 0xfd,
-	0x65, 0x34, 0xc1, 0x6b, 0x0d, 0x35, 0x5e, 0x5a, 0x50, 0xf6, 0x57, 0xb2, 0x28, 0x02, 0xdd, 0x11,
-	0xfe, 0xab, 0xc4, 0x27, 0xba, 0x51, 0xc1, 0x7e, 0x25, 0xcb, 0xd7, 0x01, 0xba, 0xbd, 0xe8, 0x43,
-	0xbf, 0x96, 0xe5, 0x65, 0xc8, 0x77, 0x7b, 0xc4, 0xed, 0x07, 0x59, 0x7e, 0x03, 0x58, 0xfc, 0x56,
-	0x17, 0x3e, 0xfe, 0xba, 0x12, 0x26, 0xaa, 0x64, 0xfc, 0x8d, 0x2c, 0xf6, 0x2b, 0x1c, 0x65, 0xf6,
-	0x37, 0xb3, 0x9c, 0x41, 0x39, 0x91, 0x9a, 0x63, 0x7f, 0x2b, 0xcb, 0x39, 0x54, 0xf6, 0xed, 0x20,
-	0xb0, 0xdd, 0x81, 0xee, 0xc1, 0xaf, 0xd2, 0x97, 0x77, 0xa2, 0x4b, 0x21, 0xec, 0x37, 0xb3, 0xfc,
-	0x16, 0xf0, 0xe4, 0x71, 0x84, 0x7e, 0xf1, 0xb7, 0x89, 0x5a, 0x99, 0xfd, 0x40, 0xc3, 0xfe, 0x0e,
-	0x51, 0xa3, 0x26, 0x68, 0xc0, 0x6f, 0xd1, 0x80, 0x34, 0xe3, 0x52, 0x49, 0x0d, 0xff, 0x21, 0x11,
-	0x87, 0x93, 0xa9, 0x60, 0x3f, 0xca, 0xde, 0xff, 0x09, 0xa5, 0x93, 0x93, 0x15, 0x47, 0x7c, 0x15,
-	0x8a, 0x8e, 0xe7, 0x0e, 0xa4, 0xfa, 0x41, 0xc9, 0x0a, 0x94, 0x82, 0xa1, 0xe7, 0x4b, 0x6a, 0xd2,
-	0xad, 0x35, 0x97, 0xee, 0x2f, 0xab, 0x72, 0x72, 0x15, 0xa4, 0xa8, 0xf4, 0x9c, 0x34, 0x07, 0xac,
-	0x1c, 0x15, 0x79, 0x66, 0xa3, 0x42, 0x54, 0xba, 0x47, 0x1d, 0xde, 0x53, 0x65, 0x79, 0x44, 0x9d,
-	0xf8, 0x8e, 0x2a, 0x48, 0x15, 0xe8, 0xa0, 0xaa, 0x5f, 0x8e, 0x1b, 0x0f, 0xd1, 0x0f, 0x2e, 0x29,
-	0xa8, 0xf7, 0x3d, 0x5b, 0xdd, 0x80, 0xd4, 0xf5, 0x5d, 0x16, 0xca, 0x11, 0x95, 0x30, 0x30, 0x71,
-	0xff, 0x37, 0x52, 0xb0, 0x1a, 0xde, 0x1e, 0xb6, 0x07, 0xb6, 0xab, 0x4a, 0x5a, 0xc3, 0x9f, 0xe9,
-	0xec, 0x3b, 0xf6, 0x38, 0xfc, 0xd9, 0xbb, 0x75, 0x28, 0x5b, 0xbe, 0x39, 0x68, 0xb8, 0xd6, 0xb6,
-	0xef, 0x8d, 0x95, 0xd8, 0xea, 0xc0, 0x49, 0x95, 0xd2, 0x3e, 0x17, 0xc7, 0x88, 0x3e, 0x16, 0x3e,
-	0xcb, 0x52, 0xed, 0xd8, 0xd0, 0xf4, 0x6d, 0x77, 0xd0, 0x3a, 0x97, 0xc2, 0x0d, 0x54, 0x49, 0x6d,
-	0x19, 0x0a, 0x93, 0x40, 0xf4, 0xcd, 0x40, 0xb0, 0x3c, 0x36, 0x8e, 0x27, 0xb6, 0x23, 0x6d, 0x57,
-	0xfd, 0xda, 0x5c, 0x54, 0x33, 0x5b, 0xbc, 0xff, 0xbb, 0x29, 0x28, 0x93, 0x36, 0xc4, 0x99, 0xd4,
-	0xd8, 0xd3, 0x28, 0x43, 0x61, 0x2f, 0xfa, 0xb5, 0xb1, 0x3c, 0xa4, 0x3b, 0xa7, 0x2a, 0x93, 0xaa,
-	0xb5, 0x41, 0xdd, 0xfd, 0x53, 0x3f, 0x3c, 0x96, 0xe5, 0x9f, 0x83, 0x1b, 0x86, 0x18, 0x79, 0x52,
-	0x3c, 0x33, 0x6d, 0x99, 0xbc, 0x4e, 0x92, 0xc3, 0xa0, 0x44, 0xbd, 0x0a, 0xef, 0x8f, 0xe4, 0x29,
-	0x28, 0xc1, 0xcf, 0x86, 0x90, 0x02, 0x76, 0x9a, 0x20, 0x3a, 0x4a, 0x29, 0x46, 0x28, 0x1f, 0x79,
-	0xb6, 0x8b, 0x5f, 0xa3, 0x1b, 0xa7, 0x04, 0xa1, 0x94, 0x3c, 0x82, 0xe0, 0xfe, 0x01, 0xdc, 0x5c,
-	0x9c, 0x48, 0x56, 0x77, 0x51, 0xe9, 0x27, 0x6e, 0xe9, 0x82, 0xc1, 0x33, 0xdf, 0x56, 0x57, 0x0a,
-	0x4b, 0x90, 0xeb, 0x3c, 0x77, 0x49, 0x1b, 0x36, 0xa0, 0x72, 0xe0, 0x25, 0x68, 0x58, 0xe6, 0x7e,
-	0x7f, 0x2a, 0xf7, 0x1f, 0x0f, 0x4a, 0x28, 0xc4, 0x4a, 0xe2, 0xf2, 0x4c, 0x4a, 0x65, 0x95, 0xe9,
-	0xbf, 0x14, 0xa8, 0x7b, 0xfa, 0x3a, 0xe7, 0x6e, 0xa9, 0x7b, 0xfa, 0x91, 0x98, 0x59, 0xf5, 0xf3,
-	0x43, 0x6e, 0x5f, 0x38, 0xc2, 0x62, 0xb9, 0xfb, 0xef, 0xc1, 0xba, 0xee, 0x6a, 0x5f, 0x04, 0x41,
-	0x78, 0xf9, 0xe4, 0xd0, 0xb7, 0xcf, 0xd4, 0x6f, 0x01, 0xac, 0x42, 0xf1, 0x50, 0xf8, 0x81, 0xe7,
-	0xd2, 0xef, 0x20, 0x00, 0xe4, 0xbb, 0x43, 0xd3, 0xc7, 0x6f, 0xdc, 0x6f, 0x42, 0x89, 0x2e, 0xa3,
-	0x3c, 0xb6, 0x5d, 0x0b, 0x7b, 0xb2, 0xa5, 0xeb, 0xaf, 0xe9, 0x07, 0x67, 0xce, 0xa8, 0x7f, 0x45,
-	0xf5, 0x43, 0x9b, 0x2c, 0xcd, 0x6f, 0x02, 0xc7, 0xa0, 0x79, 0x64, 0xd2, 0xed, 0x46, 0xe7, 0x42,
-	0xfd, 0x28, 0x6b, 0xe6, 0xfe, 0xb7, 0x80, 0xab, 0xd4, 0x8f, 0x25, 0xce, 0x6d, 0x77, 0x10, 0x5d,
-	0x9c, 0x06, 0xfa, 0x15, 0x04, 0x4b, 0x9c, 0x53, 0x64, 0x55, 0x86, 0x42, 0xd8, 0x08, 0x7f, 0x8b,
-	0x61, 0xc7, 0x9b, 0xb8, 0x28, 0xc5, 0x53, 0xb8, 0xae, 0x74, 0x06, 0xc5, 0xa2, 0xab, 0x73, 0x97,
-	0xc6, 0xa3, 0xea, 0x26, 0x91, 0x9c, 0x04, 0x11, 0x2e, 0x4b, 0xa1, 0x60, 0x51, 0x2c, 0x17, 0xc3,
-	0xd3, 0xf7, 0xeb, 0x70, 0x6d, 0x41, 0x40, 0x4d, 0xc6, 0x59, 0x85, 0x15, 0x6c, 0xe5, 0xfe, 0x87,
-	0xb0, 0xa1, 0xcc, 0xc9, 0x81, 0xba, 0xdc, 0x14, 0xee, 0x8c, 0xcf, 0xda, 0x3b, 0x6d, 0x35, 0x74,
-	0xcd, 0xd6, 0xde, 0xde, 0x93, 0xbd, 0x86, 0xc1, 0x52, 0x34, 0xc1, 0x9d, 0xde, 0x51, 0xb3, 0x73,
-	0x70, 0xd0, 0x6a, 0xf6, 0x5a, 0xdb, 0x2c, 0xbd, 0x75, 0xff, 0xdf, 0xfd, 0xec, 0x4e, 0xea, 0xa7,
-	0x3f, 0xbb, 0x93, 0xfa, 0xaf, 0x3f, 0xbb, 0x93, 0xfa, 0xc1, 0xcf, 0xef, 0xac, 0xfc, 0xf4, 0xe7,
-	0x77, 0x56, 0xfe, 0xf3, 0xcf, 0xef, 0xac, 0x7c, 0xc2, 0x66, 0xff, 0x73, 0xc8, 0x71, 0x9e, 0x3c,
-	0xd9, 0xb7, 0xfe, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xfd, 0xf9, 0xc4, 0x54, 0x64, 0x00,
-	0x00,
+	// 9495 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x7d, 0x5d, 0x6c, 0x24, 0xc9,
+	0x79, 0x18, 0xe7, 0x7f, 0xe6, 0x1b, 0x92, 0x5b, 0xec, 0xdb, 0xdb, 0x1d, 0x8d, 0x56, 0xeb, 0x55,
+	0xeb, 0x74, 0x5a, 0xad, 0x4e, 0x5c, 0xdd, 0xde, 0x9d, 0xee, 0x74, 0xd6, 0xdd, 0x69, 0x38, 0x1c,
+	0x2e, 0x47, 0x4b, 0x72, 0xa8, 0x9e, 0x59, 0xee, 0xdd, 0xc1, 0x0e, 0xd3, 0x9c, 0x2e, 0xce, 0xb4,
+	0xd8, 0xd3, 0x3d, 0xea, 0xae, 0xe1, 0x92, 0x42, 0x1c, 0x38, 0x7f, 0x4e, 0x9c, 0x27, 0xd9, 0xb1,
+	0x9c, 0xe4, 0x21, 0xb0, 0xf5, 0x16, 0xc4, 0x76, 0x82, 0x04, 0x11, 0x92, 0x87, 0x18, 0x48, 0x02,
+	0x27, 0x0a, 0x90, 0x87, 0xc8, 0xc9, 0x4b, 0x80, 0x20, 0x48, 0x20, 0x01, 0x79, 0x88, 0x91, 0x04,
+	0x46, 0x5e, 0x82, 0x20, 0x0f, 0xc1, 0xf7, 0x55, 0xf5, 0xdf, 0xcc, 0x70, 0x38, 0x7b, 0xb6, 0x83,
+	0x3c, 0xb1, 0xeb, 0x9b, 0xef, 0xfb, 0xba, 0xaa, 0xba, 0xea, 0xab, 0xef, 0xb7, 0x08, 0xaf, 0x8c,
+	0xcf, 0x06, 0x0f, 0x1d, 0xfb, 0xe4, 0xe1, 0xf8, 0xe4, 0xe1, 0xc8, 0xb3, 0xb8, 0xf3, 0x70, 0xec,
+	0x7b, 0xc2, 0x0b, 0x64, 0x23, 0xd8, 0xa4, 0x96, 0xb6, 0x66, 0xba, 0x97, 0xe2, 0x72, 0xcc, 0x37,
+	0x09, 0x5a, 0xbf, 0x33, 0xf0, 0xbc, 0x81, 0xc3, 0x25, 0xea, 0xc9, 0xe4, 0xf4, 0x61, 0x20, 0xfc,
+	0x49, 0x5f, 0x48, 0x64, 0xfd, 0xc7, 0x79, 0xb8, 0xd5, 0x1d, 0x99, 0xbe, 0xd8, 0x72, 0xbc, 0xfe,
+	0x59, 0xd7, 0x35, 0xc7, 0xc1, 0xd0, 0x13, 0x5b, 0x66, 0xc0, 0xb5, 0xd7, 0xa0, 0x78, 0x82, 0xc0,
+	0xa0, 0x96, 0xb9, 0x97, 0xbb, 0x5f, 0x7d, 0x74, 0x73, 0x33, 0xc5, 0x78, 0x93, 0x28, 0x0c, 0x85,
+	0xa3, 0xbd, 0x0e, 0x25, 0x8b, 0x0b, 0xd3, 0x76, 0x82, 0x5a, 0xf6, 0x5e, 0xe6, 0x7e, 0xf5, 0xd1,
+	0xed, 0x4d, 0xf9, 0xe2, 0xcd, 0xf0, 0xc5, 0x9b, 0x5d, 0x7a, 0xb1, 0x11, 0xe2, 0x69, 0x6f, 0x43,
+	0xf9, 0xd4, 0x76, 0xf8, 0x13, 0x7e, 0x19, 0xd4, 0x72, 0x0b, 0x69, 0xb6, 0xb2, 0xb5, 0x8c, 0x11,
+	0x21, 0x6b, 0x4d, 0x58, 0xe7, 0x17, 0xc2, 0x37, 0x0d, 0xee, 0x98, 0xc2, 0xf6, 0xdc, 0xa0, 0x96,
+	0xa7, 0x1e, 0xde, 0x9e, 0xea, 0x61, 0xf8, 0x3b, 0x91, 0x4f, 0x91, 0x68, 0xf7, 0xa0, 0xea, 0x9d,
+	0x7c, 0x9b, 0xf7, 0x45, 0xef, 0x72, 0xcc, 0x83, 0x5a, 0xe1, 0x5e, 0xee, 0x7e, 0xc5, 0x48, 0x82,
+	0xb4, 0xaf, 0x41, 0xb5, 0xef, 0x39, 0x0e, 0xef, 0xcb, 0x77, 0x14, 0x17, 0x0f, 0x2b, 0x89, 0xab,
+	0xbd, 0x09, 0x2f, 0xfb, 0x7c, 0xe4, 0x9d, 0x73, 0xab, 0x19, 0x41, 0x69, 0x9c, 0x65, 0x7a, 0xcd,
+	0xfc, 0x1f, 0xb5, 0x06, 0xac, 0xf9, 0xaa, 0x7f, 0x7b, 0xb6, 0x7b, 0x16, 0xd4, 0x4a, 0x34, 0xac,
+	0x4f, 0x5f, 0x31, 0x2c, 0xc4, 0x31, 0xd2, 0x14, 0x1a, 0x83, 0xdc, 0x19, 0xbf, 0xac, 0x55, 0xee,
+	0x65, 0xee, 0x57, 0x0c, 0x7c, 0xd4, 0xde, 0x85, 0x9a, 0xe7, 0xdb, 0x03, 0xdb, 0x35, 0x9d, 0xa6,
+	0xcf, 0x4d, 0xc1, 0xad, 0x9e, 0x3d, 0xe2, 0x81, 0x30, 0x47, 0xe3, 0x1a, 0xdc, 0xcb, 0xdc, 0xcf,
+	0x19, 0x57, 0xfe, 0xae, 0xbd, 0x21, 0xbf, 0x50, 0xdb, 0x3d, 0xf5, 0x6a, 0x55, 0x35, 0xfc, 0x74,
+	0x5f, 0x76, 0xd4, 0xcf, 0x46, 0x84, 0xa8, 0xff, 0x5e, 0x0e, 0x8a, 0x5d, 0x6e, 0xfa, 0xfd, 0x61,
+	0xfd, 0x07, 0x19, 0x28, 0x1a, 0x3c, 0x98, 0x38, 0x42, 0xab, 0x43, 0x59, 0xce, 0x6d, 0xdb, 0xaa,
+	0x65, 0xa8, 0x77, 0x51, 0xfb, 0x93, 0xac, 0x9d, 0x4d, 0xc8, 0x8f, 0xb8, 0x30, 0x6b, 0x39, 0x9a,
+	0xa1, 0xfa, 0x54, 0xaf, 0xe4, 0xeb, 0x37, 0xf7, 0xb9, 0x30, 0x0d, 0xc2, 0xd3, 0x6a, 0x50, 0x0a,
+	0xc6, 0x66, 0x9f, 0xb7, 0xad, 0x5a, 0x9e, 0xde, 0x1e, 0x36, 0xeb, 0xdf, 0xcf, 0x42, 0x1e, 0x11,
+	0xb5, 0x3b, 0x50, 0x19, 0xda, 0x83, 0xa1, 0x63, 0x0f, 0x86, 0x42, 0x75, 0x31, 0x06, 0x68, 0xef,
+	0xc3, 0x8d, 0xa8, 0x61, 0x98, 0xee, 0x80, 0x63, 0x5f, 0xe7, 0x6d, 0x0b, 0xfa, 0xd1, 0x98, 0x46,
+	0xc6, 0x0e, 0xd0, 0x4e, 0x69, 0x5b, 0xb4, 0xd6, 0x2b, 0x46, 0xd8, 0xc4, 0x85, 0x18, 0x7e, 0xc3,
+	0x27, 0xfc, 0x52, 0x75, 0x2f, 0x09, 0xd2, 0x1a, 0x70, 0x23, 0x6c, 0x6e, 0xab, 0x79, 0x2a, 0x2c,
+	0x9e, 0xa7, 0x69, 0x7c, 0x1c, 0xdc, 0x88, 0x07, 0x81, 0x39, 0xc0, 0x19, 0x28, 0xca, 0xc1, 0x45,
+	0x00, 0x4d, 0x83, 0xfc, 0xd8, 0x1c, 0xf0, 0x5a, 0xe9, 0x5e, 0xe6, 0x7e, 0xc1, 0xa0, 0x67, 0xfd,
+	0x47, 0x1d, 0x28, 0xd0, 0x16, 0xd7, 0xd6, 0x21, 0x6b, 0x87, 0x1f, 0x2d, 0x6b, 0x5b, 0xda, 0x43,
+	0x28, 0x9e, 0xda, 0xdc, 0xb1, 0xae, 0xfd, 0x5a, 0x0a, 0x4d, 0x6b, 0xc1, 0xaa, 0xcf, 0x03, 0xe1,
+	0xdb, 0x6a, 0x27, 0xc9, 0xcd, 0xfe, 0xd9, 0x79, 0xf2, 0x64, 0xd3, 0x48, 0x20, 0x1a, 0x29, 0x32,
+	0x9c, 0xa8, 0xfe, 0xd0, 0x76, 0x2c, 0x9f, 0xbb, 0x6d, 0x4b, 0xee, 0xf9, 0x8a, 0x91, 0x04, 0x69,
+	0xf7, 0xe1, 0xc6, 0x89, 0xd9, 0x3f, 0x1b, 0xf8, 0xde, 0xc4, 0xc5, 0xcd, 0xe5, 0xf9, 0x34, 0x51,
+	0x15, 0x63, 0x1a, 0xac, 0x7d, 0x05, 0x0a, 0xa6, 0x63, 0x0f, 0x5c, 0x9a, 0x8b, 0xf5, 0x47, 0xf5,
+	0xb9, 0x7d, 0x69, 0x20, 0x86, 0x21, 0x11, 0xb5, 0x5d, 0x58, 0x3b, 0xe7, 0xbe, 0xb0, 0xfb, 0xa6,
+	0x43, 0x70, 0x9a, 0xac, 0xf5, 0x47, 0xfa, 0x5c, 0xca, 0xa3, 0x24, 0xa6, 0x91, 0x26, 0xd4, 0xda,
+	0x00, 0x01, 0x8a, 0x5c, 0x5a, 0x00, 0x6a, 0x5f, 0x7d, 0x61, 0x2e, 0x9b, 0xa6, 0xe7, 0x0a, 0xee,
+	0x8a, 0xcd, 0x6e, 0x84, 0xbe, 0xbb, 0x62, 0x24, 0x88, 0xb5, 0xb7, 0x21, 0x2f, 0xf8, 0x85, 0xa8,
+	0xad, 0x2f, 0x98, 0xd1, 0x90, 0x49, 0x8f, 0x5f, 0x88, 0xdd, 0x15, 0x83, 0x08, 0x90, 0x10, 0x37,
+	0x6c, 0xed, 0xc6, 0x12, 0x84, 0xb8, 0xc7, 0x91, 0x10, 0x09, 0xb4, 0xf7, 0xa0, 0xe8, 0x98, 0x97,
+	0xde, 0x44, 0xd4, 0x18, 0x91, 0x7e, 0x6e, 0x21, 0xe9, 0x1e, 0xa1, 0xee, 0xae, 0x18, 0x8a, 0x48,
+	0x7b, 0x13, 0x72, 0x96, 0x7d, 0x5e, 0xdb, 0x20, 0xda, 0x7b, 0x0b, 0x69, 0xb7, 0xed, 0xf3, 0xdd,
+	0x15, 0x03, 0xd1, 0xb5, 0x26, 0x94, 0x4f, 0x3c, 0xef, 0x6c, 0x64, 0xfa, 0x67, 0x35, 0x8d, 0x48,
+	0x3f, 0xbf, 0x90, 0x74, 0x4b, 0x21, 0xef, 0xae, 0x18, 0x11, 0x21, 0x0e, 0xd9, 0xee, 0x7b, 0x6e,
+	0xed, 0xa5, 0x25, 0x86, 0xdc, 0xee, 0x7b, 0x2e, 0x0e, 0x19, 0x09, 0x90, 0xd0, 0xb1, 0xdd, 0xb3,
+	0xda, 0xcd, 0x25, 0x08, 0x51, 0x0a, 0x23, 0x21, 0x12, 0x60, 0xb7, 0x2d, 0x53, 0x98, 0xe7, 0x36,
+	0x7f, 0x5e, 0x7b, 0x79, 0x89, 0x6e, 0x6f, 0x2b, 0x64, 0xec, 0x76, 0x48, 0x88, 0x4c, 0xc2, 0xcd,
+	0x5c, 0xbb, 0xb5, 0x04, 0x93, 0xf0, 0x74, 0x40, 0x26, 0x21, 0xa1, 0xf6, 0xa7, 0x60, 0xe3, 0x94,
+	0x9b, 0x62, 0xe2, 0x73, 0x2b, 0x3e, 0x34, 0x6f, 0x13, 0xb7, 0xcd, 0xc5, 0xdf, 0x7e, 0x9a, 0x6a,
+	0x77, 0xc5, 0x98, 0x65, 0xa5, 0xbd, 0x0b, 0x05, 0xc7, 0x14, 0xfc, 0xa2, 0x56, 0x23, 0x9e, 0xfa,
+	0x35, 0x8b, 0x42, 0xf0, 0x8b, 0xdd, 0x15, 0x43, 0x92, 0x68, 0x1f, 0xc2, 0x0d, 0x61, 0x9e, 0x38,
+	0xbc, 0x73, 0xaa, 0x10, 0x82, 0xda, 0xa7, 0x88, 0xcb, 0x6b, 0x8b, 0x97, 0x73, 0x9a, 0x66, 0x77,
+	0xc5, 0x98, 0x66, 0x83, 0xbd, 0x22, 0x50, 0xad, 0xbe, 0x44, 0xaf, 0x88, 0x1f, 0xf6, 0x8a, 0x48,
+	0xb4, 0x3d, 0xa8, 0xd2, 0x43, 0xd3, 0x73, 0x26, 0x23, 0xb7, 0xf6, 0x69, 0xe2, 0x70, 0xff, 0x7a,
+	0x0e, 0x12, 0x7f, 0x77, 0xc5, 0x48, 0x92, 0xe3, 0x47, 0xa4, 0xa6, 0xe1, 0x3d, 0xaf, 0xdd, 0x59,
+	0xe2, 0x23, 0xf6, 0x14, 0x32, 0x7e, 0xc4, 0x90, 0x10, 0xb7, 0xde, 0x73, 0xdb, 0x1a, 0x70, 0x51,
+	0xfb, 0xcc, 0x12, 0x5b, 0xef, 0x19, 0xa1, 0xe2, 0xd6, 0x93, 0x44, 0xb8, 0x8c, 0xfb, 0x43, 0x53,
+	0xd4, 0xee, 0x2e, 0xb1, 0x8c, 0x9b, 0x43, 0x93, 0x64, 0x05, 0x12, 0xe0, 0x7b, 0x83, 0x4b, 0xb7,
+	0xcf, 0xad, 0xda, 0xcf, 0x2c, 0xf1, 0xde, 0x2e, 0xa1, 0xe2, 0x7b, 0x25, 0x51, 0xfd, 0xbb, 0xb0,
+	0x9a, 0x14, 0xea, 0x78, 0xd8, 0xf8, 0xdc, 0x94, 0x07, 0x4a, 0xd9, 0xa0, 0x67, 0x84, 0x71, 0xcb,
+	0x16, 0x74, 0xa0, 0x94, 0x0d, 0x7a, 0xd6, 0x6e, 0x41, 0x51, 0xaa, 0x49, 0x74, 0x5e, 0x94, 0x0d,
+	0xd5, 0x42, 0x5c, 0xcb, 0x37, 0x07, 0x74, 0x50, 0x96, 0x0d, 0x7a, 0x46, 0x5c, 0xcb, 0xf7, 0xc6,
+	0x1d, 0x97, 0xe4, 0x7d, 0xd9, 0x50, 0xad, 0xfa, 0x1f, 0x7c, 0x00, 0x25, 0xd5, 0xb1, 0xfa, 0xdf,
+	0xca, 0x40, 0x51, 0xca, 0x23, 0xed, 0x03, 0x28, 0x04, 0xe2, 0xd2, 0xe1, 0xd4, 0x87, 0xf5, 0x47,
+	0x5f, 0x5c, 0x42, 0x86, 0x6d, 0x76, 0x91, 0xc0, 0x90, 0x74, 0xba, 0x01, 0x05, 0x6a, 0x6b, 0x25,
+	0xc8, 0x19, 0xde, 0x73, 0xb6, 0xa2, 0x01, 0x14, 0xe5, 0xb7, 0x66, 0x19, 0x04, 0x6e, 0xdb, 0xe7,
+	0x2c, 0x8b, 0xc0, 0x5d, 0x6e, 0x5a, 0xdc, 0x67, 0x39, 0x6d, 0x0d, 0x2a, 0xe1, 0x57, 0x0d, 0x58,
+	0x5e, 0x63, 0xb0, 0x9a, 0x58, 0x2f, 0x01, 0x2b, 0xd4, 0x7f, 0xbd, 0x00, 0x79, 0x14, 0x1f, 0xda,
+	0x2b, 0xb0, 0x26, 0x4c, 0x7f, 0xc0, 0xa5, 0x4e, 0x1e, 0xe9, 0x4b, 0x69, 0xa0, 0xf6, 0x5e, 0x38,
+	0x86, 0x2c, 0x8d, 0xe1, 0x0b, 0xd7, 0x8a, 0xa5, 0xd4, 0x08, 0x12, 0x87, 0x78, 0x6e, 0xb9, 0x43,
+	0x7c, 0x07, 0xca, 0x28, 0x0d, 0xbb, 0xf6, 0x77, 0x39, 0x4d, 0xfd, 0xfa, 0xa3, 0x07, 0xd7, 0xbf,
+	0xb2, 0xad, 0x28, 0x8c, 0x88, 0x56, 0x6b, 0x43, 0xa5, 0x6f, 0xfa, 0x16, 0x75, 0x86, 0xbe, 0xd6,
+	0xfa, 0xa3, 0x2f, 0x5d, 0xcf, 0xa8, 0x19, 0x92, 0x18, 0x31, 0xb5, 0xd6, 0x81, 0xaa, 0xc5, 0x83,
+	0xbe, 0x6f, 0x8f, 0x49, 0x3a, 0xca, 0xa3, 0xfc, 0xcb, 0xd7, 0x33, 0xdb, 0x8e, 0x89, 0x8c, 0x24,
+	0x07, 0xd4, 0x92, 0xfc, 0x48, 0x3c, 0x96, 0x48, 0xbf, 0x88, 0x01, 0x9a, 0x0e, 0xab, 0xa7, 0x5e,
+	0x7f, 0x12, 0x84, 0x9f, 0xa5, 0x4c, 0x9f, 0x25, 0x05, 0xd3, 0xdf, 0x86, 0x72, 0x38, 0x66, 0x6d,
+	0x15, 0xca, 0xf8, 0xf7, 0xc0, 0x73, 0x39, 0x5b, 0xc1, 0xef, 0x8f, 0xad, 0xee, 0xc8, 0x74, 0x1c,
+	0x96, 0xd1, 0xd6, 0x01, 0xb0, 0xb9, 0xcf, 0x2d, 0x7b, 0x32, 0x62, 0x59, 0xfd, 0x67, 0xc3, 0x15,
+	0x55, 0x86, 0xfc, 0xa1, 0x39, 0x40, 0x8a, 0x55, 0x28, 0x87, 0x27, 0x02, 0xcb, 0x20, 0xfd, 0xb6,
+	0x19, 0x0c, 0x4f, 0x3c, 0xd3, 0xb7, 0x58, 0x56, 0xab, 0x42, 0xa9, 0xe1, 0xf7, 0x87, 0xf6, 0x39,
+	0x67, 0x39, 0xfd, 0x21, 0x54, 0x13, 0x63, 0x42, 0x16, 0xea, 0xa5, 0x15, 0x28, 0x34, 0x2c, 0x8b,
+	0x5b, 0x2c, 0x83, 0x04, 0x6a, 0x12, 0x58, 0x56, 0xff, 0x12, 0x54, 0xa2, 0x19, 0x45, 0x74, 0xd4,
+	0x0d, 0xd8, 0x0a, 0x3e, 0x21, 0x98, 0x65, 0x70, 0xe5, 0xb6, 0x5d, 0xc7, 0x76, 0x39, 0xcb, 0xd6,
+	0xff, 0x34, 0x2d, 0x67, 0xed, 0xeb, 0xe9, 0x4d, 0xf3, 0xea, 0x75, 0x87, 0x77, 0x7a, 0xc7, 0x7c,
+	0x3a, 0x31, 0xbe, 0x3d, 0x9b, 0x3a, 0x57, 0x86, 0xfc, 0xb6, 0x27, 0x02, 0x96, 0xa9, 0xff, 0x41,
+	0x16, 0xca, 0xe1, 0x99, 0x8d, 0x26, 0xcc, 0xc4, 0x77, 0xd4, 0xa2, 0xc7, 0x47, 0xed, 0x26, 0x14,
+	0x84, 0x2d, 0xd4, 0x52, 0xaf, 0x18, 0xb2, 0x81, 0xea, 0x60, 0xf2, 0xeb, 0x4b, 0xad, 0x7a, 0xfa,
+	0x73, 0xda, 0x23, 0x73, 0xc0, 0x77, 0xcd, 0x60, 0xa8, 0xf4, 0xea, 0x18, 0x80, 0xf4, 0xa7, 0xe6,
+	0x39, 0xae, 0x4b, 0xfa, 0x5d, 0x2a, 0x8a, 0x49, 0x90, 0xf6, 0x06, 0xe4, 0x71, 0x80, 0x6a, 0x61,
+	0xfd, 0xcc, 0xd4, 0x80, 0x71, 0x29, 0x1d, 0xfa, 0x1c, 0x3f, 0xcf, 0x26, 0x1a, 0x8c, 0x06, 0x21,
+	0x6b, 0xaf, 0xc2, 0xba, 0xdc, 0xa8, 0x9d, 0xd0, 0xdc, 0x29, 0x11, 0xe7, 0x29, 0xa8, 0xd6, 0xc0,
+	0xe9, 0x34, 0x05, 0xaf, 0x95, 0x97, 0xd8, 0x03, 0xe1, 0xe4, 0x6c, 0x76, 0x91, 0xc4, 0x90, 0x94,
+	0xfa, 0x5b, 0x38, 0xa7, 0xa6, 0xe0, 0xf8, 0x99, 0x5b, 0xa3, 0xb1, 0xb8, 0x94, 0x8b, 0x66, 0x87,
+	0x8b, 0xfe, 0xd0, 0x76, 0x07, 0x2c, 0x23, 0xa7, 0x18, 0x3f, 0x22, 0xa1, 0xf8, 0xbe, 0xe7, 0xb3,
+	0x5c, 0xbd, 0x0e, 0x79, 0x5c, 0xa3, 0x28, 0x48, 0x5d, 0x73, 0xc4, 0xd5, 0x4c, 0xd3, 0x73, 0xfd,
+	0x25, 0xd8, 0x98, 0x39, 0xf2, 0xeb, 0xff, 0xb5, 0x28, 0x57, 0x08, 0x52, 0x90, 0xba, 0xa9, 0x28,
+	0xf0, 0xf9, 0xc5, 0xe4, 0x10, 0x72, 0x49, 0xcb, 0xa1, 0xf7, 0xa0, 0x80, 0x03, 0x0b, 0xc5, 0xd0,
+	0x12, 0xe4, 0xfb, 0x88, 0x6e, 0x48, 0x2a, 0x34, 0xab, 0xfa, 0x43, 0xde, 0x3f, 0xe3, 0x96, 0x3a,
+	0x0f, 0xc2, 0x26, 0x2e, 0x9a, 0x7e, 0xc2, 0x02, 0x90, 0x0d, 0x5a, 0x12, 0x7d, 0xcf, 0x6d, 0x8d,
+	0xbc, 0x6f, 0xdb, 0xa1, 0x1d, 0x14, 0x01, 0xc2, 0x5f, 0xdb, 0xa3, 0xd0, 0x18, 0xaa, 0x18, 0x31,
+	0xa0, 0xde, 0x82, 0x02, 0xbd, 0x1b, 0x77, 0x82, 0xec, 0xb3, 0x74, 0x8c, 0xbc, 0xba, 0x5c, 0x9f,
+	0x55, 0x97, 0xeb, 0xff, 0x11, 0x0d, 0x4e, 0x5c, 0xe8, 0x0f, 0xa0, 0xe0, 0xa3, 0x71, 0x48, 0xd3,
+	0x79, 0x95, 0x21, 0x29, 0x51, 0xb4, 0x0f, 0xd4, 0x52, 0xcc, 0x2e, 0xb1, 0x58, 0xa2, 0x37, 0x26,
+	0x97, 0xe5, 0x4d, 0x28, 0x8c, 0x4d, 0xdf, 0x1c, 0xa9, 0x7d, 0x22, 0x1b, 0x33, 0x22, 0x2d, 0x3f,
+	0x47, 0xa4, 0xfd, 0x4e, 0x06, 0xf2, 0xc8, 0x48, 0xdb, 0x80, 0xb5, 0xae, 0xf0, 0xed, 0x33, 0x2e,
+	0x86, 0xbe, 0x37, 0x19, 0x0c, 0xe5, 0x6a, 0x7b, 0xc2, 0x2f, 0x4f, 0xbc, 0x58, 0x68, 0x08, 0xd3,
+	0xb1, 0xfb, 0x2c, 0x8b, 0x2b, 0x6f, 0xcb, 0x73, 0x2c, 0x96, 0xd3, 0x6e, 0x40, 0xf5, 0xa9, 0x6b,
+	0x71, 0x3f, 0xe8, 0x7b, 0x3e, 0xb7, 0x58, 0x5e, 0x49, 0x80, 0x33, 0x56, 0xa0, 0x33, 0x91, 0x5f,
+	0x08, 0x32, 0xc9, 0x58, 0x51, 0x7b, 0x09, 0x6e, 0x6c, 0xa5, 0xed, 0x34, 0x56, 0x42, 0xb9, 0xb5,
+	0xcf, 0x5d, 0x5c, 0x88, 0xac, 0x2c, 0x17, 0xba, 0xf7, 0x6d, 0x9b, 0x55, 0xf0, 0x65, 0x72, 0x2f,
+	0x31, 0x90, 0xb2, 0x6d, 0x34, 0x42, 0xd9, 0x56, 0xd5, 0xff, 0x49, 0x26, 0x14, 0x35, 0x6b, 0x50,
+	0x39, 0x34, 0x7d, 0x73, 0xe0, 0x9b, 0x63, 0xec, 0x6c, 0x15, 0x4a, 0xf2, 0x34, 0x7e, 0x9d, 0x65,
+	0xe2, 0xc6, 0x23, 0x96, 0x8d, 0x1b, 0x6f, 0xb0, 0x5c, 0xdc, 0x78, 0x93, 0xe5, 0xf1, 0x85, 0xdf,
+	0x9a, 0x78, 0x82, 0xb3, 0x02, 0x09, 0x47, 0xcf, 0xe2, 0xac, 0x88, 0xc0, 0x1e, 0x8a, 0x20, 0x56,
+	0xc2, 0x09, 0x68, 0xe2, 0x82, 0x3b, 0xf1, 0x2e, 0x58, 0x19, 0xfb, 0x84, 0xf3, 0xce, 0x2d, 0x56,
+	0xc1, 0x5f, 0x0e, 0x26, 0xa3, 0x13, 0x8e, 0x63, 0x06, 0xfc, 0xa5, 0xe7, 0x0d, 0x06, 0x0e, 0x67,
+	0x55, 0xed, 0x46, 0x4a, 0x5a, 0xb3, 0x55, 0xea, 0xbe, 0xe9, 0x38, 0xde, 0x44, 0xb0, 0xb5, 0xfa,
+	0xff, 0xca, 0x41, 0x1e, 0x2d, 0x2e, 0xdc, 0x6c, 0x43, 0x14, 0x4c, 0x6a, 0xb3, 0xe1, 0x73, 0xb4,
+	0x65, 0xb3, 0xf1, 0x96, 0xd5, 0xde, 0x55, 0x4b, 0x23, 0xb7, 0x84, 0x58, 0x46, 0xc6, 0xc9, 0x55,
+	0xa1, 0x41, 0x7e, 0x64, 0x8f, 0xb8, 0xfa, 0xee, 0xf4, 0x8c, 0xb0, 0x00, 0x0f, 0xf9, 0x02, 0x39,
+	0x87, 0xe8, 0x19, 0xb7, 0x99, 0x89, 0xe7, 0x48, 0x43, 0xd0, 0xa6, 0xc9, 0x19, 0x61, 0x73, 0x8e,
+	0xb8, 0xab, 0xcc, 0x15, 0x77, 0xef, 0x85, 0xe2, 0xae, 0xb4, 0x84, 0x98, 0xa0, 0x6e, 0x26, 0x45,
+	0x5d, 0x2c, 0x65, 0xca, 0xcb, 0x93, 0x27, 0x4e, 0x9f, 0x6d, 0xb5, 0x84, 0xe3, 0x93, 0xb1, 0x2c,
+	0x67, 0x99, 0x65, 0xf0, 0x6b, 0xd2, 0xfe, 0x96, 0x42, 0xf2, 0xc8, 0xb6, 0xb8, 0xc7, 0x72, 0x74,
+	0x72, 0x4e, 0x2c, 0xdb, 0x63, 0x79, 0x54, 0xe7, 0x0e, 0xb7, 0x77, 0x58, 0x41, 0x7f, 0x35, 0x71,
+	0x86, 0x35, 0x26, 0xc2, 0x63, 0x2b, 0xd1, 0x5a, 0xce, 0xc8, 0xa5, 0x79, 0xc2, 0x2d, 0x96, 0xd5,
+	0xbf, 0x3a, 0x47, 0x2e, 0xaf, 0x41, 0xe5, 0xe9, 0xd8, 0xf1, 0x4c, 0x6b, 0x81, 0x60, 0x5e, 0x05,
+	0x88, 0x2d, 0xfd, 0xfa, 0xbf, 0xd4, 0xe3, 0xf3, 0x1f, 0x15, 0xdc, 0xc0, 0x9b, 0xf8, 0x7d, 0x4e,
+	0x32, 0xa7, 0x62, 0xa8, 0x96, 0xf6, 0x0d, 0x28, 0xe0, 0xef, 0xa1, 0x33, 0xea, 0xc1, 0x52, 0xf6,
+	0xe5, 0xe6, 0x91, 0xcd, 0x9f, 0x1b, 0x92, 0x50, 0xbb, 0x0b, 0x60, 0xf6, 0x85, 0x7d, 0xce, 0x11,
+	0xa8, 0xa4, 0x43, 0x02, 0xa2, 0xbd, 0x95, 0xd4, 0x89, 0x16, 0xfb, 0x59, 0x93, 0xca, 0x92, 0x01,
+	0x55, 0xdc, 0xc7, 0xe3, 0x8e, 0x8f, 0x5b, 0xbf, 0xb6, 0x4a, 0x84, 0x5f, 0x59, 0xae, 0x7b, 0x8f,
+	0x23, 0x42, 0x23, 0xc9, 0x44, 0x7b, 0x0a, 0xab, 0xd2, 0x67, 0xa8, 0x98, 0xae, 0x11, 0xd3, 0xd7,
+	0x97, 0x63, 0xda, 0x89, 0x29, 0x8d, 0x14, 0x9b, 0x59, 0xb7, 0x6b, 0xe1, 0x85, 0xdd, 0xae, 0xaf,
+	0xc2, 0x7a, 0x2f, 0xbd, 0x0b, 0xe4, 0xd9, 0x32, 0x05, 0x45, 0x79, 0x6b, 0x07, 0xb1, 0xd7, 0x97,
+	0xfc, 0x36, 0x65, 0x23, 0x05, 0xab, 0xff, 0x7e, 0x11, 0xf2, 0x34, 0xf3, 0xd3, 0x7e, 0xb7, 0x66,
+	0xea, 0x0c, 0x78, 0xb8, 0xfc, 0xa7, 0x9e, 0xda, 0xf1, 0x24, 0x41, 0x72, 0x09, 0x09, 0xf2, 0x0d,
+	0x28, 0x04, 0x9e, 0x2f, 0xc2, 0xcf, 0xbb, 0xe4, 0x22, 0xea, 0x7a, 0xbe, 0x30, 0x24, 0xa1, 0xb6,
+	0x03, 0xa5, 0x53, 0xdb, 0x11, 0xdc, 0x0f, 0x27, 0xef, 0xb5, 0xe5, 0x78, 0xec, 0x10, 0x91, 0x11,
+	0x12, 0x6b, 0x7b, 0xc9, 0xc5, 0x56, 0xbc, 0x97, 0xbb, 0xd6, 0x3f, 0x11, 0x71, 0x9a, 0xb7, 0x06,
+	0x1f, 0x00, 0xeb, 0x7b, 0xe7, 0xdc, 0x37, 0x12, 0xee, 0x55, 0x79, 0xaa, 0xcf, 0xc0, 0xd1, 0x3f,
+	0x3d, 0xb4, 0x2d, 0x8e, 0x8a, 0x11, 0xc9, 0x98, 0xb2, 0x11, 0xb5, 0xb5, 0x27, 0x50, 0x26, 0xa3,
+	0x03, 0xa5, 0x62, 0xe5, 0x85, 0x27, 0x5f, 0xda, 0x3f, 0x21, 0x03, 0x7c, 0x11, 0xbd, 0x7c, 0xc7,
+	0x16, 0xe4, 0x7f, 0x2f, 0x1b, 0x51, 0x1b, 0x3b, 0x4c, 0xeb, 0x3d, 0xd9, 0xe1, 0xaa, 0xec, 0xf0,
+	0x34, 0x1c, 0x43, 0x0c, 0x04, 0x9b, 0x3a, 0x31, 0x71, 0xab, 0x21, 0xd3, 0xf9, 0x3f, 0xa2, 0x86,
+	0x83, 0xde, 0xdd, 0x3d, 0x7b, 0x64, 0x8b, 0xda, 0x1a, 0xb9, 0x7b, 0x63, 0x80, 0xf6, 0x1a, 0x6c,
+	0x58, 0xfc, 0xd4, 0x9c, 0x38, 0xa2, 0xc7, 0x47, 0x63, 0xc7, 0x14, 0xe8, 0x2d, 0x5e, 0xa7, 0x0e,
+	0xcc, 0xfe, 0xa0, 0x7d, 0x05, 0x5e, 0x52, 0xc0, 0x4e, 0x14, 0x35, 0x69, 0x5b, 0xe4, 0x52, 0xac,
+	0x18, 0xf3, 0x7e, 0xd2, 0xf7, 0x95, 0x18, 0xc6, 0x03, 0x14, 0x8d, 0xdf, 0x50, 0x80, 0x06, 0x42,
+	0x9e, 0xc8, 0x8f, 0x4d, 0xc7, 0xe1, 0xfe, 0xa5, 0xb4, 0x9c, 0x9f, 0x98, 0xee, 0x89, 0xe9, 0xb2,
+	0x1c, 0x9d, 0xb1, 0xa6, 0xc3, 0x5d, 0xcb, 0xf4, 0xe5, 0x89, 0xfc, 0x98, 0x0e, 0xf4, 0x82, 0x7e,
+	0x1f, 0xf2, 0x34, 0xa5, 0x15, 0x28, 0x48, 0xb3, 0x8a, 0xcc, 0x70, 0x65, 0x52, 0x91, 0x44, 0xde,
+	0xc3, 0xed, 0xc7, 0xb2, 0xf5, 0xff, 0x59, 0x80, 0x72, 0x38, 0x79, 0x61, 0x8c, 0x24, 0x13, 0xc7,
+	0x48, 0x50, 0xef, 0x0b, 0x8e, 0xec, 0xc0, 0x3e, 0x51, 0x7a, 0x6c, 0xd9, 0x88, 0x01, 0xa8, 0x3a,
+	0x3d, 0xb7, 0x2d, 0x31, 0xa4, 0x3d, 0x53, 0x30, 0x64, 0x03, 0x7d, 0xcd, 0x16, 0xce, 0x83, 0xdb,
+	0x77, 0x26, 0x16, 0xc7, 0x98, 0x89, 0xf2, 0x3d, 0x4c, 0x83, 0xb5, 0x8f, 0x00, 0x84, 0x3d, 0xe2,
+	0x3b, 0x9e, 0x3f, 0x32, 0x85, 0x32, 0x26, 0xbe, 0xf6, 0x62, 0xab, 0x7a, 0xb3, 0x17, 0x31, 0x30,
+	0x12, 0xcc, 0x90, 0x35, 0xbe, 0x4d, 0xb1, 0x2e, 0x7d, 0x22, 0xd6, 0xdb, 0x11, 0x03, 0x23, 0xc1,
+	0x4c, 0xeb, 0x41, 0xe9, 0xd4, 0xf3, 0x47, 0x13, 0xc7, 0x54, 0x67, 0xee, 0xbb, 0x2f, 0xc8, 0x77,
+	0x47, 0x52, 0x93, 0xec, 0x09, 0x59, 0xe9, 0x3f, 0x07, 0x10, 0xbf, 0x4f, 0xbb, 0x05, 0xda, 0xbe,
+	0xe7, 0x8a, 0x61, 0xe3, 0xe4, 0xc4, 0xdf, 0xe2, 0xa7, 0x9e, 0xcf, 0xb7, 0x4d, 0x3c, 0x2c, 0x5f,
+	0x86, 0x8d, 0x08, 0xde, 0x38, 0x15, 0xdc, 0x47, 0x30, 0x7d, 0xd0, 0xee, 0xd0, 0xf3, 0x85, 0xd4,
+	0xd8, 0xe8, 0xf1, 0x69, 0x97, 0xe5, 0xf0, 0x80, 0x6e, 0x77, 0x3b, 0x2c, 0xaf, 0xdf, 0x07, 0x88,
+	0x27, 0x8a, 0x4c, 0x21, 0x7a, 0x7a, 0xfd, 0x11, 0x5b, 0x89, 0x5b, 0x8f, 0xde, 0x64, 0x19, 0xfd,
+	0x27, 0x19, 0xa8, 0x26, 0x3a, 0x98, 0x36, 0x99, 0x9b, 0xde, 0xc4, 0x15, 0xd2, 0x46, 0xa7, 0xc7,
+	0x23, 0xd3, 0x99, 0xe0, 0x51, 0xbd, 0x01, 0x6b, 0xd4, 0xde, 0xb6, 0x03, 0x61, 0xbb, 0x7d, 0xc1,
+	0x72, 0x11, 0x8a, 0x3c, 0xe6, 0xf3, 0x11, 0xca, 0x81, 0xa7, 0x40, 0x05, 0xf4, 0xf4, 0x1c, 0x72,
+	0xbf, 0xcf, 0x43, 0x24, 0xd2, 0x73, 0x15, 0x24, 0x42, 0x93, 0x7a, 0xae, 0x29, 0x86, 0xdd, 0xc9,
+	0x88, 0x95, 0x51, 0x45, 0xc4, 0x46, 0xe3, 0x9c, 0xfb, 0xa8, 0x99, 0x54, 0xf0, 0x3d, 0x08, 0xc0,
+	0xb5, 0x6d, 0xba, 0x52, 0xe3, 0xa5, 0xb6, 0xed, 0xb2, 0x6a, 0xd4, 0x30, 0x2f, 0xd8, 0x2a, 0xf6,
+	0x9f, 0x2c, 0x07, 0xb6, 0x56, 0xff, 0x2f, 0x39, 0xc8, 0xa3, 0x94, 0x46, 0x53, 0x37, 0x29, 0x52,
+	0xe4, 0xca, 0x4f, 0x82, 0x3e, 0xd9, 0xd9, 0x82, 0xbc, 0x93, 0x67, 0xcb, 0x3b, 0x50, 0xed, 0x4f,
+	0x02, 0xe1, 0x8d, 0xe8, 0x60, 0x55, 0xb1, 0xb9, 0x5b, 0x33, 0x8e, 0x25, 0x9a, 0x4e, 0x23, 0x89,
+	0xaa, 0xbd, 0x05, 0xc5, 0x53, 0xb9, 0x86, 0xa5, 0x6b, 0xe9, 0x33, 0x57, 0x9c, 0xbd, 0x6a, 0x9d,
+	0x2a, 0x64, 0x1c, 0x97, 0x3d, 0xb3, 0xff, 0x92, 0x20, 0x75, 0x86, 0x16, 0xa3, 0x33, 0xf4, 0xe7,
+	0x60, 0x9d, 0xe3, 0x84, 0x1f, 0x3a, 0x66, 0x9f, 0xa3, 0xc1, 0xa0, 0x36, 0xcd, 0x9b, 0x2f, 0x30,
+	0x62, 0xfa, 0x62, 0x34, 0xec, 0x29, 0x5e, 0x28, 0x47, 0x5c, 0x0f, 0x8f, 0xf2, 0xd0, 0xae, 0x2f,
+	0x1b, 0x31, 0x40, 0xff, 0xbc, 0x92, 0x7e, 0x25, 0xc8, 0x35, 0x82, 0xbe, 0x72, 0x80, 0xf0, 0xa0,
+	0x2f, 0x2d, 0xa7, 0x26, 0x4d, 0x07, 0xcb, 0xea, 0xaf, 0x43, 0x25, 0x7a, 0x03, 0x2e, 0x9e, 0x03,
+	0x4f, 0x74, 0xc7, 0xbc, 0x6f, 0x9f, 0xda, 0xdc, 0x92, 0xeb, 0xb3, 0x2b, 0x4c, 0x5f, 0x48, 0x3f,
+	0x63, 0xcb, 0xb5, 0x58, 0xb6, 0xfe, 0x5b, 0x65, 0x28, 0xca, 0xa3, 0x54, 0x0d, 0xb8, 0x12, 0x0d,
+	0xf8, 0x5b, 0x50, 0xf6, 0xc6, 0xdc, 0x37, 0x85, 0xe7, 0x2b, 0xc7, 0xcd, 0x5b, 0x2f, 0x72, 0x34,
+	0x6f, 0x76, 0x14, 0xb1, 0x11, 0xb1, 0x99, 0x5e, 0x4d, 0xd9, 0xd9, 0xd5, 0xf4, 0x00, 0x58, 0x78,
+	0x0a, 0x1f, 0xfa, 0x48, 0x27, 0x2e, 0x95, 0x19, 0x3e, 0x03, 0xd7, 0x7a, 0x50, 0xe9, 0x7b, 0xae,
+	0x65, 0x47, 0x4e, 0x9c, 0xf5, 0x47, 0x5f, 0x7d, 0xa1, 0x1e, 0x36, 0x43, 0x6a, 0x23, 0x66, 0xa4,
+	0xbd, 0x06, 0x85, 0x73, 0x5c, 0x66, 0xb4, 0x9e, 0xae, 0x5e, 0x84, 0x12, 0x49, 0xfb, 0x18, 0xaa,
+	0xdf, 0x99, 0xd8, 0xfd, 0xb3, 0x4e, 0xd2, 0x91, 0xf8, 0xce, 0x0b, 0xf5, 0xe2, 0x5b, 0x31, 0xbd,
+	0x91, 0x64, 0x96, 0x58, 0xda, 0xa5, 0x3f, 0xc2, 0xd2, 0x2e, 0xcf, 0x2e, 0x6d, 0x03, 0xd6, 0x5c,
+	0x1e, 0x08, 0x6e, 0xed, 0x28, 0xcd, 0x0b, 0x3e, 0x81, 0xe6, 0x95, 0x66, 0xa1, 0x7f, 0x0e, 0xca,
+	0xe1, 0x07, 0xd7, 0x8a, 0x90, 0x3d, 0x40, 0x13, 0xa7, 0x08, 0xd9, 0x8e, 0x2f, 0x57, 0x5b, 0x03,
+	0x57, 0x9b, 0xfe, 0x3f, 0x32, 0x50, 0x89, 0x26, 0x3d, 0x2d, 0x39, 0x5b, 0xdf, 0x99, 0x98, 0xe8,
+	0xdd, 0x44, 0xe3, 0xd7, 0x13, 0xb2, 0x45, 0xc2, 0xfa, 0x31, 0xa5, 0x16, 0xa0, 0x1f, 0x1c, 0x0f,
+	0x7c, 0x1e, 0xa0, 0x0b, 0x5c, 0x83, 0x75, 0x05, 0xee, 0xf8, 0x12, 0xb5, 0x80, 0x82, 0x0f, 0x7f,
+	0x0d, 0x01, 0x45, 0x42, 0xb7, 0xcf, 0xb8, 0x14, 0x90, 0x07, 0x9e, 0xa0, 0x46, 0x19, 0x3b, 0xd5,
+	0x76, 0x59, 0x05, 0xdf, 0x79, 0xe0, 0x89, 0x36, 0x8a, 0xc4, 0xc8, 0xd8, 0xaa, 0x86, 0xaf, 0xa7,
+	0x16, 0x49, 0xc4, 0x86, 0xe3, 0xb4, 0x5d, 0xb6, 0xa6, 0x7e, 0x90, 0xad, 0x75, 0xe4, 0xd8, 0xba,
+	0x30, 0xfb, 0x48, 0x7e, 0x03, 0x25, 0x2c, 0xd2, 0xa8, 0x36, 0xc3, 0x2d, 0xd9, 0xba, 0xb0, 0x03,
+	0x11, 0xb0, 0x0d, 0xfd, 0x5f, 0x67, 0xa0, 0x9a, 0xf8, 0xc0, 0x68, 0xcc, 0x11, 0x22, 0x1e, 0x65,
+	0xd2, 0xb6, 0xfb, 0x08, 0xa7, 0xd1, 0xb7, 0xc2, 0x63, 0xaa, 0xe7, 0xe1, 0x63, 0x16, 0xdf, 0xd7,
+	0xf3, 0x46, 0x9e, 0xef, 0x7b, 0xcf, 0xa5, 0x22, 0xb3, 0x67, 0x06, 0xe2, 0x19, 0xe7, 0x67, 0x2c,
+	0x8f, 0x43, 0x6d, 0x4e, 0x7c, 0x9f, 0xbb, 0x12, 0x50, 0xa0, 0xce, 0xf1, 0x0b, 0xd9, 0x2a, 0x22,
+	0x53, 0x44, 0xa6, 0x73, 0x90, 0x95, 0x50, 0x10, 0x28, 0x6c, 0x09, 0x29, 0x23, 0x02, 0xa2, 0xcb,
+	0x66, 0x05, 0x0f, 0x15, 0xe9, 0x6f, 0xe8, 0x9c, 0x6e, 0x9b, 0x97, 0x41, 0x63, 0xe0, 0x31, 0x98,
+	0x06, 0x1e, 0x78, 0xcf, 0x59, 0xb5, 0x3e, 0x01, 0x88, 0x2d, 0x2c, 0xb4, 0x2c, 0x71, 0x41, 0x44,
+	0x61, 0x06, 0xd5, 0xd2, 0x3a, 0x00, 0xf8, 0x44, 0x98, 0xa1, 0x79, 0xf9, 0x02, 0x6a, 0x2f, 0xd1,
+	0x19, 0x09, 0x16, 0xf5, 0x5f, 0x80, 0x4a, 0xf4, 0x03, 0x3a, 0x14, 0x48, 0x41, 0x8d, 0x5e, 0x1b,
+	0x36, 0x51, 0xdb, 0xb2, 0x5d, 0x8b, 0x5f, 0x90, 0x5c, 0x29, 0x18, 0xb2, 0x81, 0xbd, 0x1c, 0xda,
+	0x96, 0xc5, 0xdd, 0x30, 0x18, 0x24, 0x5b, 0xf3, 0x22, 0xfe, 0xf9, 0xb9, 0x11, 0xff, 0xfa, 0xcf,
+	0x43, 0x35, 0x61, 0x02, 0x5e, 0x39, 0xec, 0x44, 0xc7, 0xb2, 0xe9, 0x8e, 0xdd, 0x81, 0x4a, 0x98,
+	0xb1, 0x12, 0xd0, 0xd9, 0x56, 0x31, 0x62, 0x40, 0xfd, 0x1f, 0x65, 0xa1, 0x20, 0x87, 0x36, 0x6d,
+	0xb6, 0xed, 0x40, 0x31, 0x10, 0xa6, 0x98, 0x84, 0xe9, 0x12, 0x4b, 0x6e, 0xd0, 0x2e, 0xd1, 0x50,
+	0x1c, 0x8d, 0x9e, 0xb4, 0xf7, 0x20, 0x27, 0xcc, 0x81, 0xf2, 0x93, 0x7e, 0x71, 0x39, 0x26, 0x3d,
+	0x73, 0x80, 0x31, 0x74, 0x61, 0x0e, 0xb4, 0x3d, 0x28, 0xf7, 0x95, 0xa7, 0x4a, 0x09, 0xc5, 0x25,
+	0x2d, 0xab, 0xd0, 0xbf, 0x85, 0xb1, 0xc8, 0x90, 0x83, 0xf6, 0x0d, 0xc8, 0x5b, 0x78, 0xc8, 0xc9,
+	0x3c, 0x94, 0x25, 0x2d, 0x46, 0xdc, 0x2e, 0x18, 0x55, 0x44, 0xca, 0xad, 0x12, 0x14, 0x48, 0x06,
+	0xd7, 0x6b, 0x50, 0x94, 0x63, 0x9d, 0x9e, 0xb9, 0xfa, 0x6d, 0xc8, 0xf5, 0xcc, 0x01, 0xea, 0xeb,
+	0xb6, 0x15, 0x28, 0xc7, 0x07, 0x3e, 0xd6, 0x5f, 0x89, 0xbd, 0x6e, 0x49, 0x0f, 0x70, 0x26, 0xe5,
+	0x01, 0xae, 0x17, 0x21, 0x8f, 0x6f, 0xac, 0xdf, 0x59, 0xa4, 0xfb, 0xd7, 0xff, 0x4e, 0x0e, 0xcd,
+	0x04, 0x0c, 0x44, 0xcf, 0xf3, 0x6e, 0x7f, 0x13, 0x2a, 0x63, 0xdf, 0xeb, 0xf3, 0x20, 0xf0, 0x7c,
+	0xa5, 0x1c, 0xbd, 0x76, 0x7d, 0x70, 0x7b, 0xf3, 0x30, 0xa4, 0x31, 0x62, 0x72, 0xfd, 0x9f, 0x66,
+	0xa1, 0x12, 0xfd, 0x20, 0xad, 0x13, 0xc1, 0x2f, 0xa4, 0x63, 0x72, 0x9f, 0xfb, 0x23, 0xd3, 0xb6,
+	0xa4, 0xf4, 0x68, 0x0e, 0xcd, 0x50, 0xc9, 0xfd, 0xc8, 0x9b, 0x88, 0xc9, 0x09, 0x97, 0x0e, 0xa9,
+	0x23, 0x7b, 0xc4, 0xd1, 0x21, 0x85, 0xb1, 0x23, 0x5c, 0xd8, 0x7d, 0xc7, 0x9b, 0x58, 0xac, 0x80,
+	0xed, 0xc7, 0x74, 0xbc, 0xed, 0x9b, 0xe3, 0x40, 0xca, 0xcc, 0x7d, 0xdb, 0xf7, 0x58, 0x09, 0x89,
+	0x76, 0xec, 0xc1, 0xc8, 0x64, 0x65, 0x64, 0xd6, 0x7b, 0x6e, 0x0b, 0x14, 0xc2, 0x15, 0x54, 0x53,
+	0x3b, 0x63, 0xee, 0x76, 0x85, 0xcf, 0xb9, 0xd8, 0x37, 0xc7, 0xd2, 0x43, 0x69, 0x70, 0xcb, 0xb2,
+	0x85, 0x94, 0x9f, 0x3b, 0x66, 0x9f, 0x63, 0xea, 0x04, 0x5b, 0x45, 0x41, 0xd3, 0x76, 0x03, 0x81,
+	0x7e, 0xd4, 0x91, 0x94, 0xa1, 0x3d, 0xee, 0x70, 0x6a, 0xad, 0xd3, 0xbb, 0x6d, 0x31, 0x9c, 0x9c,
+	0x3c, 0x46, 0x2b, 0xee, 0x86, 0x74, 0xc5, 0x5a, 0x7c, 0xcc, 0x51, 0x86, 0xae, 0x42, 0x79, 0xcb,
+	0x76, 0xec, 0x13, 0xdb, 0xb1, 0xd9, 0x06, 0xa2, 0xb6, 0x2e, 0xfa, 0xa6, 0x63, 0x5b, 0xbe, 0xf9,
+	0x9c, 0x69, 0xd8, 0xb9, 0x27, 0xbe, 0x77, 0x66, 0xb3, 0x97, 0x10, 0x91, 0x8c, 0xba, 0x73, 0xfb,
+	0xbb, 0xec, 0x26, 0x85, 0xca, 0xce, 0x30, 0x88, 0x71, 0x6a, 0x9e, 0xb0, 0x97, 0x63, 0x07, 0xdd,
+	0xad, 0xfa, 0x06, 0xdc, 0x98, 0x8a, 0xfb, 0xd7, 0x4b, 0xca, 0x96, 0xac, 0xaf, 0x41, 0x35, 0x11,
+	0x51, 0xad, 0xff, 0x38, 0x03, 0xe5, 0x30, 0xe0, 0x8a, 0x46, 0xb7, 0x1d, 0x48, 0xaf, 0xae, 0x5a,
+	0x25, 0x51, 0x1b, 0x7d, 0x0e, 0x7d, 0xee, 0x38, 0xdd, 0xb1, 0xe9, 0x86, 0x72, 0x6e, 0x73, 0xa9,
+	0xe0, 0xfc, 0x66, 0x53, 0x91, 0x19, 0x31, 0x83, 0xfa, 0xc7, 0x50, 0x0e, 0xc1, 0xd2, 0xd4, 0xc7,
+	0xce, 0xc4, 0x39, 0x6f, 0x61, 0x1b, 0x97, 0xad, 0xef, 0x3d, 0x47, 0x34, 0x25, 0xe8, 0xc2, 0x26,
+	0xfe, 0xd2, 0xf7, 0x88, 0x81, 0x32, 0x38, 0xc3, 0x66, 0xfd, 0x77, 0x33, 0x50, 0x94, 0x61, 0x7d,
+	0x6d, 0x2b, 0x4a, 0xc3, 0xc9, 0x2c, 0x11, 0x8b, 0x95, 0x44, 0x2a, 0x92, 0x1d, 0xe5, 0xe2, 0xdc,
+	0x84, 0x82, 0x43, 0x7e, 0x00, 0x25, 0x69, 0xa9, 0x91, 0x10, 0x8c, 0xb9, 0xa4, 0x60, 0xd4, 0x1b,
+	0x51, 0xf4, 0x3c, 0xf4, 0x79, 0x92, 0x02, 0xdb, 0xf3, 0x39, 0x67, 0x99, 0xc8, 0x8c, 0xcf, 0xd2,
+	0xb1, 0xe6, 0x8d, 0xc6, 0x66, 0x5f, 0x10, 0x80, 0x0e, 0x7c, 0x94, 0xfb, 0x2c, 0x8f, 0x1b, 0x12,
+	0x13, 0x0b, 0xea, 0x47, 0x50, 0x94, 0x59, 0x02, 0x73, 0xbc, 0xc7, 0x99, 0xb9, 0xde, 0xe3, 0x99,
+	0x90, 0x78, 0x76, 0x4e, 0x48, 0x5c, 0x3f, 0x85, 0xf2, 0xa1, 0x17, 0x4c, 0xab, 0x25, 0x25, 0xc8,
+	0xf5, 0xbc, 0xb1, 0x54, 0xb2, 0xb7, 0x3c, 0x41, 0x4a, 0x36, 0xf5, 0x97, 0x9f, 0x0a, 0xb9, 0xaf,
+	0x0c, 0xcc, 0xd3, 0x93, 0xae, 0x85, 0xb6, 0xeb, 0x72, 0x9f, 0x15, 0x70, 0x19, 0x1b, 0x7c, 0x8c,
+	0x8a, 0x3d, 0x2b, 0xe2, 0xc2, 0x25, 0xf8, 0x8e, 0xed, 0x07, 0x82, 0x95, 0xf4, 0x36, 0x14, 0x64,
+	0x26, 0xd7, 0x1a, 0x54, 0xe8, 0x81, 0x58, 0xad, 0xe0, 0xd0, 0xa9, 0xd9, 0xe4, 0x2e, 0x6e, 0x33,
+	0x32, 0x20, 0x09, 0x20, 0x5f, 0x90, 0xc5, 0x43, 0x9c, 0xda, 0xdf, 0x9c, 0x04, 0xc2, 0x3e, 0xbd,
+	0x64, 0x39, 0xfd, 0x19, 0xac, 0xa5, 0x72, 0xc5, 0xb4, 0x9b, 0xc0, 0x52, 0x00, 0xec, 0xfa, 0x8a,
+	0x76, 0x1b, 0x5e, 0x4a, 0x41, 0xf7, 0x6d, 0xcb, 0x22, 0xe7, 0xf5, 0xf4, 0x0f, 0xe1, 0x00, 0xb7,
+	0x2a, 0xb8, 0x8a, 0xe8, 0xeb, 0xeb, 0x87, 0xb0, 0x46, 0x33, 0x84, 0x59, 0x8e, 0x1d, 0xd7, 0xb9,
+	0xfc, 0x23, 0x27, 0xf4, 0xe9, 0x5f, 0x52, 0x36, 0x26, 0x8a, 0xcc, 0x53, 0xdf, 0x1b, 0x11, 0xaf,
+	0x82, 0x41, 0xcf, 0xc8, 0x5d, 0x78, 0x6a, 0x4d, 0x65, 0x85, 0xa7, 0xff, 0x4a, 0x05, 0x4a, 0x8d,
+	0x7e, 0x1f, 0xad, 0xe2, 0x99, 0x37, 0xbf, 0x05, 0xc5, 0xbe, 0xe7, 0x9e, 0xda, 0x03, 0x75, 0x24,
+	0x4d, 0x2b, 0xc7, 0x8a, 0x0e, 0x17, 0xf2, 0xa9, 0x3d, 0x30, 0x14, 0x32, 0x92, 0xa9, 0x23, 0xb5,
+	0xb0, 0x90, 0x4c, 0x9e, 0x2b, 0xd1, 0x09, 0xfa, 0x10, 0xf2, 0x36, 0xa6, 0xb2, 0xca, 0x4c, 0xde,
+	0x4f, 0x5f, 0x41, 0x44, 0xe9, 0xac, 0x84, 0x58, 0xff, 0x4f, 0x19, 0xcc, 0xea, 0xa0, 0x57, 0xbe,
+	0x0a, 0xeb, 0xdc, 0xc5, 0x8d, 0x1f, 0x9e, 0x66, 0x4a, 0x8e, 0x4c, 0x41, 0x51, 0x6f, 0x57, 0x10,
+	0x7e, 0x32, 0x19, 0x28, 0x67, 0x52, 0x12, 0xa4, 0xbd, 0x03, 0xb7, 0x65, 0xf3, 0xd0, 0xe7, 0x3e,
+	0x77, 0xb8, 0x19, 0xf0, 0xe6, 0xd0, 0x74, 0x5d, 0xee, 0x28, 0xdd, 0xe6, 0xaa, 0x9f, 0xd1, 0x7b,
+	0x2c, 0x7f, 0xea, 0x8e, 0xcd, 0x3e, 0x0f, 0x54, 0xc4, 0x33, 0x05, 0xd3, 0xbe, 0x0c, 0x05, 0x4a,
+	0x74, 0xae, 0x59, 0x8b, 0x3f, 0xa5, 0xc4, 0xaa, 0x7b, 0xd1, 0xe1, 0xdb, 0x00, 0x90, 0xd3, 0x84,
+	0x76, 0xa7, 0x92, 0x2a, 0x9f, 0x5d, 0x38, 0xaf, 0x88, 0x68, 0x24, 0x88, 0xb0, 0x7f, 0x16, 0x77,
+	0x38, 0xe5, 0x9d, 0xa2, 0x72, 0x90, 0xa5, 0x50, 0x51, 0x0a, 0x56, 0xff, 0x07, 0x79, 0xc8, 0xe3,
+	0x0c, 0x23, 0xf2, 0xd0, 0x1b, 0xf1, 0x68, 0xe3, 0xcb, 0x1d, 0x9d, 0x82, 0xa1, 0x76, 0x67, 0xca,
+	0x24, 0x87, 0x08, 0x4d, 0x0a, 0xa5, 0x69, 0x30, 0x62, 0x8e, 0x7d, 0x0f, 0x33, 0x14, 0x23, 0x4c,
+	0xa5, 0x07, 0x4e, 0x81, 0xb5, 0xaf, 0xc2, 0x2d, 0x8c, 0xc3, 0x72, 0x41, 0xbb, 0xfb, 0x99, 0xe7,
+	0x9f, 0x85, 0x89, 0xc1, 0xd2, 0xd3, 0x7a, 0xc5, 0xaf, 0xe8, 0x1b, 0x7d, 0x1e, 0x36, 0xa3, 0x77,
+	0x48, 0x5f, 0xe7, 0xec, 0x0f, 0x28, 0xfa, 0x2d, 0x7e, 0x6e, 0x13, 0x5f, 0x99, 0x27, 0x12, 0xb5,
+	0x71, 0x29, 0x99, 0x72, 0x22, 0xbb, 0xea, 0xcd, 0x2a, 0x64, 0x96, 0x86, 0xa2, 0xc2, 0x29, 0x53,
+	0xb7, 0x82, 0xb6, 0x45, 0xae, 0xe2, 0x8a, 0x11, 0x03, 0x70, 0xa1, 0xd1, 0x2b, 0x8f, 0xa4, 0xb0,
+	0x5e, 0x93, 0x56, 0x78, 0x02, 0x84, 0x18, 0x82, 0xf7, 0x87, 0xe1, 0x4b, 0xa4, 0x1f, 0x37, 0x09,
+	0xc2, 0xd8, 0xcf, 0xc0, 0x14, 0xfc, 0xb9, 0x79, 0xf9, 0xd4, 0x77, 0x6a, 0x9c, 0x10, 0x12, 0x10,
+	0xb4, 0xe3, 0x1d, 0xaf, 0x6f, 0x3a, 0x5d, 0xe1, 0xa1, 0x1f, 0xea, 0xd0, 0x14, 0xc3, 0xda, 0x80,
+	0xb0, 0x66, 0xe0, 0x38, 0x62, 0x74, 0x4c, 0x7e, 0xec, 0xb9, 0xbc, 0x36, 0x94, 0x23, 0x0e, 0xdb,
+	0xd8, 0x13, 0xd3, 0x35, 0x9d, 0x4b, 0x61, 0xf7, 0x71, 0x2c, 0xb6, 0xec, 0x49, 0x02, 0x84, 0x63,
+	0x75, 0xb9, 0xc0, 0x79, 0x6c, 0x5b, 0xb5, 0x6f, 0xcb, 0xb1, 0x46, 0x00, 0xbd, 0x03, 0x10, 0x2f,
+	0x39, 0x94, 0xe3, 0x0d, 0x8a, 0x4f, 0xb1, 0x15, 0xe9, 0x4a, 0x73, 0x31, 0xa8, 0xb6, 0xad, 0x56,
+	0x19, 0xcb, 0x20, 0x90, 0x5c, 0x24, 0xdc, 0x8a, 0x80, 0xa4, 0x4c, 0x51, 0x8b, 0x5b, 0x2c, 0xa7,
+	0xff, 0x9f, 0x0c, 0x54, 0x13, 0xf9, 0x1b, 0x7f, 0x8c, 0x39, 0x27, 0xa8, 0x69, 0xa0, 0xb2, 0x82,
+	0x13, 0x2a, 0x57, 0x60, 0xd4, 0xc6, 0xe9, 0x56, 0xe9, 0x25, 0xf8, 0xab, 0x74, 0x88, 0x24, 0x20,
+	0x9f, 0x28, 0xdf, 0x44, 0x7f, 0xa4, 0xbc, 0x4a, 0x55, 0x28, 0x3d, 0x75, 0xcf, 0x5c, 0xef, 0xb9,
+	0xcb, 0x56, 0xa2, 0x24, 0xa2, 0x54, 0x74, 0x33, 0xcc, 0xf3, 0xc9, 0xe9, 0x7f, 0x37, 0x3f, 0x95,
+	0x93, 0xd7, 0x82, 0xa2, 0x34, 0x65, 0x48, 0xcb, 0x9e, 0x4d, 0xa2, 0x4a, 0x22, 0xab, 0x48, 0x5a,
+	0x02, 0x64, 0x28, 0x62, 0xb4, 0x31, 0xa2, 0x84, 0xd7, 0xec, 0xdc, 0x88, 0x5f, 0x8a, 0x51, 0x28,
+	0x34, 0x93, 0xc0, 0x38, 0xf3, 0xb5, 0xfe, 0x97, 0x32, 0x70, 0x73, 0x1e, 0x4a, 0x32, 0x97, 0x3e,
+	0x93, 0xce, 0xa5, 0xef, 0x4e, 0x65, 0x9a, 0x67, 0x69, 0x34, 0x0f, 0x5f, 0xb0, 0x13, 0xe9, 0xbc,
+	0x73, 0xfd, 0xb7, 0x33, 0xb0, 0x31, 0x33, 0xe6, 0x84, 0x82, 0x01, 0x50, 0x94, 0x2b, 0x4b, 0x66,
+	0x69, 0x45, 0x79, 0x33, 0x32, 0x8c, 0x41, 0x47, 0x6f, 0x20, 0xf3, 0x0a, 0x54, 0x36, 0xbe, 0x54,
+	0xe1, 0xf1, 0xab, 0xa1, 0x64, 0x1f, 0x70, 0xe9, 0x24, 0x96, 0xda, 0x95, 0x82, 0x14, 0xa5, 0x9a,
+	0x2d, 0x63, 0x2d, 0xac, 0x44, 0xd9, 0x5f, 0x93, 0xb1, 0x63, 0xf7, 0xb1, 0x59, 0xd6, 0xea, 0x70,
+	0x4b, 0x16, 0x6b, 0x28, 0x93, 0xf6, 0xb4, 0x37, 0xb4, 0x69, 0x73, 0xb0, 0x8a, 0x6e, 0xc0, 0x4b,
+	0x73, 0xc6, 0x44, 0xbd, 0x3c, 0x52, 0x3d, 0x5e, 0x07, 0xd8, 0x3e, 0x0a, 0xfb, 0xc9, 0x32, 0xe8,
+	0x89, 0xd9, 0x3e, 0x4a, 0x32, 0x54, 0xfb, 0xe5, 0x08, 0x25, 0x49, 0xc0, 0x72, 0xfa, 0x2f, 0x65,
+	0xc2, 0x6c, 0x8b, 0xfa, 0x9f, 0x81, 0x35, 0xd9, 0xc7, 0x43, 0xf3, 0xd2, 0xf1, 0x4c, 0x4b, 0x6b,
+	0xc1, 0x7a, 0x10, 0x55, 0x10, 0x25, 0x0e, 0x8f, 0xe9, 0x43, 0xb9, 0x9b, 0x42, 0x32, 0xa6, 0x88,
+	0x42, 0xcb, 0x2c, 0x1b, 0x47, 0x65, 0x34, 0xb2, 0x31, 0x4d, 0xda, 0x65, 0xab, 0x64, 0x35, 0x9a,
+	0xfa, 0x97, 0x61, 0xa3, 0x1b, 0x0b, 0x5a, 0xa5, 0xc1, 0x47, 0xc5, 0x1d, 0xdb, 0xe1, 0x7a, 0x50,
+	0x4d, 0xfd, 0xdf, 0x15, 0x01, 0xe2, 0x08, 0xd4, 0x9c, 0x6d, 0x3e, 0x2f, 0xa1, 0x62, 0x26, 0x1e,
+	0x9c, 0x7b, 0xe1, 0x78, 0xf0, 0x3b, 0x91, 0x7a, 0x2e, 0xfd, 0xd9, 0xd3, 0x99, 0xee, 0x71, 0x9f,
+	0xa6, 0x95, 0xf2, 0x54, 0x82, 0x52, 0x61, 0x3a, 0x41, 0xe9, 0xde, 0x6c, 0xc6, 0xe3, 0x94, 0xfc,
+	0x89, 0x1d, 0x25, 0xa5, 0x94, 0xa3, 0xa4, 0x8e, 0x69, 0xe4, 0xa6, 0xe5, 0xb9, 0xce, 0x65, 0x18,
+	0x76, 0x0c, 0xdb, 0xda, 0x1b, 0x50, 0x10, 0x54, 0x04, 0x55, 0xbe, 0x97, 0xbb, 0xfe, 0xc3, 0x49,
+	0x5c, 0x14, 0x66, 0x76, 0xa0, 0x52, 0x10, 0xe5, 0x09, 0x56, 0x36, 0x12, 0x10, 0x6d, 0x13, 0x34,
+	0x1b, 0xad, 0x46, 0xc7, 0xe1, 0xd6, 0xd6, 0xe5, 0xb6, 0x8c, 0x06, 0xd2, 0x19, 0x5b, 0x36, 0xe6,
+	0xfc, 0x12, 0x7e, 0xff, 0xd5, 0xf8, 0xfb, 0x53, 0x97, 0xcf, 0xed, 0x00, 0x47, 0xba, 0x46, 0xaa,
+	0x44, 0xd4, 0xc6, 0x53, 0x3c, 0xdc, 0xa3, 0x72, 0x2e, 0x69, 0xf5, 0xc6, 0x21, 0xf5, 0x2b, 0x7e,
+	0xd5, 0xff, 0x79, 0x36, 0x32, 0x63, 0x2a, 0x50, 0x38, 0x31, 0x03, 0xbb, 0x2f, 0x0d, 0x70, 0xa5,
+	0x26, 0x48, 0x53, 0x46, 0x78, 0x96, 0xc7, 0xb2, 0x68, 0x39, 0x04, 0x5c, 0x45, 0x79, 0xe2, 0xc2,
+	0x30, 0x96, 0xc7, 0xbd, 0x19, 0x7e, 0x6f, 0x99, 0x18, 0x44, 0xa4, 0xe4, 0xb3, 0xb3, 0xa2, 0x1c,
+	0x4d, 0xb2, 0xbe, 0x49, 0xf6, 0xb3, 0x32, 0xe2, 0xb8, 0x9e, 0xe0, 0xd2, 0x63, 0x49, 0xab, 0x93,
+	0x01, 0xb2, 0x09, 0xab, 0x13, 0x58, 0x15, 0x55, 0xf9, 0x90, 0xa9, 0x74, 0x33, 0x06, 0x64, 0x40,
+	0xad, 0xe2, 0xee, 0x4c, 0xff, 0xc0, 0xd6, 0xb0, 0x47, 0x71, 0xbd, 0x19, 0x5b, 0x47, 0xae, 0x26,
+	0xa5, 0xab, 0xdc, 0xc0, 0xc7, 0x73, 0x4a, 0x62, 0x61, 0xf8, 0x56, 0x0b, 0x05, 0xc6, 0x06, 0xf6,
+	0x2c, 0x52, 0x0d, 0x98, 0x86, 0x96, 0xca, 0xd8, 0x44, 0xb3, 0xc1, 0x1e, 0x9b, 0xae, 0x60, 0x2f,
+	0xe1, 0x50, 0xc7, 0xd6, 0x29, 0xbb, 0x89, 0x24, 0x98, 0xf4, 0xcd, 0x5e, 0x46, 0x1c, 0x7c, 0xda,
+	0xe6, 0x3e, 0x7e, 0x4f, 0x76, 0x0b, 0x71, 0x84, 0x39, 0x60, 0xb7, 0xf5, 0xef, 0xc7, 0x99, 0xd4,
+	0x5f, 0x89, 0x14, 0xfa, 0x65, 0x16, 0x39, 0xaa, 0xfc, 0xf3, 0x76, 0x5c, 0x0b, 0x36, 0x7c, 0xfe,
+	0x9d, 0x89, 0x9d, 0x2a, 0x4f, 0xc8, 0x2d, 0xce, 0x35, 0x99, 0xa5, 0xd0, 0xcf, 0x61, 0x23, 0x6c,
+	0x3c, 0xb3, 0xc5, 0x90, 0xdc, 0x4b, 0x58, 0xc3, 0x16, 0xd5, 0x4f, 0x64, 0xe6, 0xd6, 0xb0, 0x45,
+	0x2c, 0x23, 0xc4, 0x38, 0x7c, 0x90, 0x5d, 0x22, 0x7c, 0xa0, 0xff, 0xef, 0x62, 0xc2, 0xc3, 0x24,
+	0x4d, 0x1c, 0x2b, 0x32, 0x71, 0x66, 0xa3, 0xcd, 0x71, 0x44, 0x20, 0xfb, 0x22, 0x11, 0x81, 0x79,
+	0x99, 0x1b, 0xef, 0xa2, 0xc6, 0x4d, 0xfb, 0xe7, 0x68, 0x89, 0x68, 0x47, 0x0a, 0x57, 0xdb, 0xa2,
+	0xd8, 0xb1, 0xd9, 0x95, 0x69, 0x45, 0x85, 0xb9, 0xd5, 0x4c, 0xc9, 0x20, 0xb1, 0xc2, 0x34, 0x12,
+	0x54, 0x09, 0x69, 0x53, 0x9c, 0x27, 0x6d, 0xd0, 0xda, 0x54, 0x72, 0x28, 0x6a, 0xcb, 0xe0, 0x90,
+	0x7c, 0x0e, 0xd9, 0x93, 0x1e, 0x5d, 0x36, 0x66, 0xe0, 0xa8, 0x85, 0x8d, 0x26, 0x8e, 0xb0, 0x55,
+	0xfc, 0x43, 0x36, 0xa6, 0x4b, 0x37, 0x2b, 0xb3, 0xa5, 0x9b, 0xef, 0x03, 0x04, 0x1c, 0x77, 0xc7,
+	0xb6, 0xdd, 0x17, 0x2a, 0xf9, 0xe8, 0xee, 0x55, 0x63, 0x53, 0x51, 0x9b, 0x04, 0x05, 0xf6, 0x7f,
+	0x64, 0x5e, 0x50, 0x24, 0x57, 0x65, 0x49, 0x44, 0xed, 0x69, 0x19, 0xbc, 0x3e, 0x2b, 0x83, 0xdf,
+	0x80, 0x42, 0xd0, 0xf7, 0xc6, 0xbc, 0x76, 0x73, 0xe1, 0xf7, 0xdd, 0xec, 0x22, 0x92, 0x21, 0x71,
+	0xc9, 0xed, 0x83, 0x52, 0xca, 0xf3, 0xa9, 0x56, 0xa8, 0x62, 0x84, 0xcd, 0x94, 0x1c, 0xbc, 0x95,
+	0x96, 0x83, 0x75, 0x0b, 0x8a, 0x9d, 0x71, 0x62, 0xdd, 0xc5, 0xa6, 0x75, 0xe8, 0xcd, 0xcc, 0x26,
+	0xbc, 0x99, 0x51, 0x4e, 0x6c, 0x2e, 0x99, 0x13, 0x3b, 0x55, 0x80, 0x58, 0x98, 0x29, 0x40, 0xd4,
+	0x3f, 0x86, 0x02, 0xf5, 0x15, 0x95, 0x08, 0x39, 0xcd, 0x52, 0xc7, 0xc4, 0x41, 0xb1, 0x0c, 0xfa,
+	0x2c, 0x02, 0x4e, 0x4a, 0x08, 0xef, 0x9a, 0x23, 0x4e, 0x42, 0x32, 0xab, 0xd5, 0xe0, 0xa6, 0xc4,
+	0x0d, 0xd2, 0xbf, 0x90, 0x26, 0xe4, 0xd8, 0x27, 0xbe, 0xe9, 0x5f, 0xb2, 0xbc, 0xfe, 0x3e, 0x65,
+	0x04, 0x84, 0x0b, 0xaa, 0x1a, 0x95, 0x82, 0x4a, 0xb1, 0x6c, 0x29, 0xe9, 0x43, 0xe9, 0x21, 0xca,
+	0x3e, 0x92, 0x49, 0x73, 0x64, 0x80, 0x90, 0x07, 0x65, 0x35, 0x79, 0x12, 0xff, 0xb1, 0xed, 0x37,
+	0x7d, 0x2b, 0xa1, 0xca, 0xa5, 0xb3, 0xe0, 0x32, 0xcb, 0x66, 0xc1, 0xe9, 0x4f, 0xe0, 0x86, 0x91,
+	0x96, 0xe9, 0xda, 0x3b, 0x50, 0xf2, 0xc6, 0x49, 0x3e, 0xd7, 0xad, 0xcb, 0x10, 0x5d, 0xff, 0x61,
+	0x06, 0x56, 0xdb, 0xae, 0xe0, 0xbe, 0x6b, 0x3a, 0x3b, 0x8e, 0x39, 0xd0, 0xde, 0x0e, 0xa5, 0xd4,
+	0x7c, 0x6b, 0x3d, 0x89, 0x9b, 0x16, 0x58, 0x8e, 0xf2, 0xbd, 0x63, 0xa2, 0x05, 0xb7, 0x6c, 0xe1,
+	0xf9, 0x52, 0x81, 0x0d, 0x93, 0x15, 0x6f, 0x02, 0x93, 0xe0, 0x2e, 0x6d, 0x89, 0x9e, 0xfc, 0xcc,
+	0x35, 0xb8, 0x99, 0x82, 0x86, 0xda, 0x69, 0x56, 0xbb, 0x03, 0xb5, 0xf8, 0x34, 0xda, 0xf6, 0x5c,
+	0xd1, 0xc6, 0xa0, 0x0d, 0xa9, 0x42, 0x2c, 0xa7, 0xff, 0x4e, 0x29, 0x54, 0xc2, 0x8e, 0x54, 0x2a,
+	0xa3, 0xef, 0x79, 0xb1, 0xaf, 0x4f, 0xb5, 0x12, 0xf5, 0xe6, 0xd9, 0x25, 0xea, 0xcd, 0xdf, 0x8f,
+	0x6b, 0x86, 0xe5, 0x41, 0xf1, 0xca, 0xdc, 0xd3, 0xe7, 0x88, 0xe2, 0x0e, 0x12, 0xb1, 0xcb, 0x13,
+	0x05, 0xc4, 0xaf, 0x2b, 0x5b, 0x2b, 0xbf, 0x8c, 0xae, 0x4a, 0xa8, 0xda, 0x5b, 0xd3, 0xd5, 0x21,
+	0xcb, 0x65, 0x42, 0xce, 0xa8, 0x93, 0xf0, 0xc2, 0xea, 0xe4, 0x07, 0x53, 0x66, 0x4d, 0x79, 0xae,
+	0x03, 0x6b, 0x41, 0xe9, 0xec, 0x07, 0x50, 0x1a, 0xda, 0x81, 0xf0, 0x7c, 0x59, 0x1a, 0x3e, 0x5b,
+	0x7e, 0x96, 0x98, 0xad, 0x5d, 0x89, 0x48, 0x69, 0x6b, 0x21, 0x95, 0xf6, 0x21, 0x6c, 0xd0, 0xc4,
+	0x1f, 0xc6, 0x5a, 0x43, 0x50, 0xab, 0xce, 0x4d, 0x17, 0x4c, 0xb0, 0xda, 0x9a, 0x22, 0x31, 0x66,
+	0x99, 0xcc, 0xa4, 0xa0, 0xaf, 0xce, 0xa6, 0xa0, 0xd7, 0x07, 0x00, 0xf1, 0x37, 0x9c, 0x91, 0x74,
+	0x9f, 0xa0, 0x7c, 0x1c, 0xd3, 0x69, 0x27, 0x27, 0x71, 0x20, 0x4f, 0xb5, 0xea, 0x17, 0x50, 0x9f,
+	0xd1, 0x20, 0x0e, 0xb9, 0x2f, 0x87, 0xb4, 0xb0, 0x86, 0xfd, 0xfd, 0xe4, 0xe2, 0x90, 0x0b, 0xf8,
+	0xde, 0x15, 0x5f, 0x38, 0xe2, 0x9c, 0x58, 0x25, 0xf5, 0xb7, 0xa0, 0x9a, 0x98, 0x78, 0x94, 0xde,
+	0x13, 0xd7, 0xf2, 0x42, 0xc7, 0x2a, 0x3e, 0x6b, 0x54, 0x38, 0x67, 0x85, 0xae, 0x55, 0x7a, 0xae,
+	0x1b, 0xc0, 0xa6, 0x27, 0x79, 0x81, 0x79, 0xfc, 0x0a, 0xac, 0x25, 0xd4, 0xbe, 0xd8, 0x8d, 0x9e,
+	0x02, 0xea, 0xe7, 0xf0, 0xe9, 0x04, 0xbb, 0x43, 0xee, 0x8f, 0xec, 0x00, 0x0f, 0x1b, 0x69, 0xf6,
+	0x91, 0x87, 0xc3, 0xe2, 0xae, 0xb0, 0x45, 0x28, 0x65, 0xa3, 0xb6, 0xf6, 0xb3, 0x50, 0x18, 0x73,
+	0x7f, 0x14, 0x28, 0x49, 0x3b, 0xbd, 0xca, 0xe6, 0xb2, 0x0d, 0x0c, 0x49, 0xa3, 0xff, 0xed, 0x0c,
+	0x94, 0xd1, 0x47, 0x6d, 0x99, 0xc2, 0xd4, 0xf6, 0xa7, 0xde, 0x32, 0x1b, 0x7c, 0x0e, 0x51, 0x37,
+	0x95, 0x21, 0xba, 0xd9, 0x56, 0xf8, 0xaa, 0x8d, 0xf1, 0xca, 0x90, 0x45, 0x7d, 0x0b, 0x4a, 0x0a,
+	0x5c, 0x7f, 0x1b, 0x6e, 0x4c, 0x61, 0xd2, 0xbc, 0x48, 0xfd, 0xbf, 0x7b, 0x39, 0x0a, 0x33, 0xa4,
+	0x56, 0x8d, 0x34, 0x10, 0x5d, 0xea, 0x63, 0x49, 0xa0, 0xff, 0xb5, 0x3b, 0x94, 0x97, 0x63, 0x9f,
+	0xa2, 0x41, 0x3e, 0xef, 0xf4, 0xbd, 0x0b, 0x40, 0xc7, 0xb7, 0xcc, 0xde, 0x90, 0x8e, 0xd0, 0x04,
+	0x44, 0x7b, 0x37, 0xf2, 0x60, 0xe7, 0xe7, 0x2a, 0x5e, 0x49, 0xe6, 0xd3, 0x6e, 0xec, 0x1a, 0x94,
+	0xec, 0x60, 0x0f, 0x8f, 0x3f, 0x95, 0xf1, 0x14, 0x36, 0xb5, 0xaf, 0x43, 0xd1, 0x1e, 0x8d, 0x3d,
+	0x5f, 0x28, 0x17, 0xf7, 0x42, 0xae, 0x6d, 0xc2, 0xc4, 0x00, 0xb3, 0xa4, 0x41, 0x6a, 0x7e, 0x41,
+	0xd4, 0xe5, 0xeb, 0xa9, 0x5b, 0x17, 0x21, 0xb5, 0xa4, 0xd1, 0xbe, 0x05, 0x6b, 0x03, 0x99, 0xbe,
+	0x29, 0x19, 0xd7, 0x2a, 0x73, 0x03, 0xd5, 0x29, 0x26, 0x8f, 0x93, 0x04, 0xbb, 0x2b, 0x46, 0x9a,
+	0x03, 0xb2, 0x44, 0x25, 0x9f, 0x07, 0xa2, 0xe7, 0x7d, 0xd3, 0xb3, 0xdd, 0x1a, 0x5c, 0xcf, 0xd2,
+	0x48, 0x12, 0x20, 0xcb, 0x14, 0x07, 0xed, 0xab, 0xa8, 0x15, 0x05, 0x42, 0x55, 0xdd, 0xdf, 0x5b,
+	0xc4, 0xa9, 0xc7, 0x03, 0x55, 0x2f, 0x1f, 0x08, 0xed, 0x02, 0xea, 0x89, 0x4d, 0xa2, 0x5e, 0xd2,
+	0x18, 0x8f, 0x7d, 0xbc, 0xc6, 0x83, 0x54, 0xc4, 0xea, 0xa3, 0xaf, 0x2e, 0xe2, 0x76, 0x78, 0x25,
+	0xf5, 0xee, 0x8a, 0xb1, 0x80, 0xb7, 0xd6, 0x43, 0xeb, 0x4f, 0x0d, 0x61, 0x8f, 0x9b, 0xe7, 0x61,
+	0xcd, 0xfe, 0x83, 0xa5, 0x66, 0x81, 0x28, 0x76, 0x57, 0x8c, 0x29, 0x1e, 0xda, 0xcf, 0xc3, 0x46,
+	0xea, 0x9d, 0x54, 0x67, 0x2b, 0x2b, 0xfa, 0xbf, 0xbc, 0xf4, 0x30, 0x90, 0x08, 0xeb, 0xc1, 0x67,
+	0x38, 0x69, 0x13, 0xf8, 0xd4, 0xec, 0x90, 0xb6, 0x79, 0xdf, 0xb1, 0x5d, 0xae, 0x8a, 0xff, 0xdf,
+	0x7a, 0xb1, 0xd9, 0x52, 0xc4, 0xbb, 0x2b, 0xc6, 0xd5, 0x9c, 0xb5, 0x3f, 0x0b, 0x77, 0xc6, 0x73,
+	0x45, 0x8c, 0x14, 0x5d, 0xea, 0xee, 0x80, 0x77, 0x96, 0x7c, 0xf3, 0x0c, 0xfd, 0xee, 0x8a, 0xb1,
+	0x90, 0xbf, 0xb6, 0x85, 0x9a, 0xfa, 0xc8, 0x76, 0x31, 0xcc, 0x2c, 0xaf, 0x19, 0x78, 0x65, 0xf1,
+	0x57, 0x92, 0xb8, 0xb2, 0x54, 0x5f, 0x3e, 0xe3, 0x2e, 0xc4, 0xd4, 0x95, 0xc9, 0xb8, 0x76, 0xf3,
+	0xfa, 0x5d, 0xb8, 0x45, 0x98, 0xb8, 0x0b, 0x25, 0x0d, 0x1e, 0xf4, 0x7d, 0x59, 0x09, 0x55, 0x7b,
+	0x79, 0x6e, 0xb1, 0x76, 0x8a, 0x5c, 0x15, 0x4d, 0xed, 0xae, 0x18, 0x21, 0x15, 0x9a, 0x08, 0xe4,
+	0x28, 0x50, 0x89, 0xf2, 0xb2, 0x81, 0x5e, 0x29, 0xb3, 0xef, 0xa0, 0xbb, 0x2d, 0x0a, 0x24, 0xc4,
+	0x80, 0xfa, 0x7f, 0xcb, 0x40, 0x51, 0x6d, 0xd9, 0x3b, 0x51, 0xbe, 0x44, 0x74, 0xfa, 0xc4, 0x00,
+	0xed, 0x3d, 0xa8, 0x70, 0xdf, 0xf7, 0x7c, 0xcc, 0x10, 0xa8, 0x65, 0xe7, 0x7a, 0xb9, 0x25, 0x9f,
+	0xcd, 0x56, 0x88, 0x66, 0xc4, 0x14, 0xda, 0xbb, 0x00, 0x52, 0x54, 0xf5, 0xe2, 0x7a, 0xa7, 0xfa,
+	0x7c, 0x7a, 0x19, 0x9b, 0x8a, 0xb1, 0xaf, 0xbe, 0x00, 0x26, 0xb2, 0xab, 0x0b, 0x09, 0xbb, 0xfa,
+	0x8e, 0x72, 0x97, 0x1c, 0xe0, 0x0f, 0xaa, 0x4c, 0x30, 0x02, 0xd4, 0xff, 0x59, 0x06, 0x73, 0xc3,
+	0x68, 0xbc, 0xad, 0xd9, 0x11, 0x7d, 0xe1, 0x7a, 0xb1, 0xb9, 0x39, 0x3d, 0xb2, 0xaf, 0x03, 0xf0,
+	0x8b, 0xb0, 0xaf, 0x6a, 0x64, 0x77, 0xa6, 0xf8, 0x28, 0xd2, 0x30, 0x55, 0x3b, 0xc6, 0xc7, 0x10,
+	0x00, 0x71, 0x41, 0x97, 0xf4, 0xd3, 0xbd, 0x3d, 0xb6, 0x82, 0xf9, 0x1d, 0x4f, 0x0f, 0x9e, 0x1c,
+	0x74, 0x9e, 0x1d, 0x1c, 0xb7, 0x0c, 0xa3, 0x63, 0x48, 0xcf, 0xf4, 0x56, 0x63, 0xfb, 0xb8, 0x7d,
+	0x70, 0xf8, 0xb4, 0xc7, 0xb2, 0xf5, 0x7f, 0x9c, 0x81, 0xb5, 0x94, 0xf8, 0xfd, 0x93, 0xfd, 0x74,
+	0x89, 0xe9, 0xcf, 0xcd, 0x9f, 0xfe, 0xfc, 0x55, 0xd3, 0x5f, 0x98, 0x9e, 0xfe, 0xdf, 0xca, 0xc0,
+	0x5a, 0x4a, 0xcc, 0x27, 0xb9, 0x67, 0xd2, 0xdc, 0x93, 0xca, 0x4a, 0x76, 0x4a, 0x59, 0xc1, 0x62,
+	0x1c, 0xf5, 0x7c, 0x10, 0x3b, 0x56, 0x52, 0xb0, 0x24, 0x0e, 0x95, 0x86, 0xe4, 0xd3, 0x38, 0x08,
+	0xbb, 0xa6, 0xb7, 0x54, 0x3b, 0x1b, 0xd0, 0xf5, 0x03, 0xf5, 0xab, 0x0f, 0x81, 0x05, 0x43, 0x78,
+	0x0c, 0xd5, 0x71, 0x2c, 0x69, 0x5e, 0x4c, 0xb3, 0x4a, 0x52, 0x5e, 0xd3, 0xcf, 0xdf, 0xce, 0xc0,
+	0x7a, 0xfa, 0xd8, 0xf8, 0xff, 0x7a, 0x5a, 0xff, 0x5e, 0x06, 0x36, 0x66, 0x0e, 0xa3, 0x85, 0xba,
+	0xe9, 0x74, 0xbf, 0xb2, 0x4b, 0xf4, 0x2b, 0x37, 0xa7, 0x5f, 0x57, 0x4b, 0x92, 0xc5, 0x3d, 0xee,
+	0xc2, 0xa7, 0xae, 0x3c, 0xd6, 0x16, 0x4c, 0x75, 0x8a, 0x69, 0x6e, 0x9a, 0xe9, 0x6f, 0x64, 0xe0,
+	0xce, 0xa2, 0x23, 0xeb, 0xff, 0xf9, 0xba, 0x9a, 0xe9, 0xe1, 0x3f, 0xcc, 0xa0, 0x73, 0x54, 0x1d,
+	0x6e, 0x0b, 0x57, 0x94, 0x97, 0x4e, 0x05, 0x88, 0xda, 0xa8, 0x4c, 0xcb, 0xe7, 0xc4, 0x1b, 0x12,
+	0x90, 0x25, 0x6e, 0xd0, 0xd2, 0x12, 0xe9, 0x8a, 0x39, 0x99, 0x80, 0x78, 0x8d, 0x8c, 0xff, 0xab,
+	0x59, 0x28, 0xca, 0xd3, 0x35, 0x2d, 0xe3, 0x33, 0xd7, 0xcb, 0x78, 0x49, 0xb6, 0xb9, 0x40, 0x04,
+	0x66, 0x5f, 0xe0, 0x13, 0xcb, 0xcb, 0xb9, 0x44, 0x78, 0x81, 0x01, 0x3d, 0xa3, 0xc1, 0x62, 0x07,
+	0x6d, 0xb7, 0xef, 0x53, 0xdd, 0x41, 0x64, 0x08, 0xa4, 0x81, 0xb8, 0x9a, 0x95, 0x07, 0x4e, 0x7a,
+	0x32, 0x65, 0xe9, 0x6e, 0x0a, 0xa6, 0x7f, 0x6e, 0x89, 0xb3, 0xa3, 0xfe, 0xa3, 0x4c, 0x54, 0x60,
+	0xfd, 0x09, 0x3f, 0x21, 0x66, 0x07, 0x0c, 0x7d, 0xa9, 0x3f, 0xc8, 0x11, 0x46, 0x6d, 0x1c, 0xbe,
+	0xd2, 0x3e, 0xa2, 0x2d, 0x15, 0x03, 0x52, 0x5b, 0xba, 0x30, 0xb5, 0xa5, 0x43, 0x1f, 0x67, 0x31,
+	0xe1, 0xe3, 0x4c, 0x4d, 0x66, 0x69, 0x6a, 0x32, 0xf5, 0xb7, 0xa3, 0x7c, 0x17, 0x4c, 0x54, 0x94,
+	0xf7, 0xdd, 0xa9, 0xa2, 0x8a, 0x21, 0x86, 0xce, 0x29, 0xfc, 0x63, 0x70, 0x53, 0x5d, 0xb1, 0x81,
+	0x39, 0x60, 0x36, 0x65, 0x0c, 0xdc, 0x06, 0x68, 0x90, 0x33, 0x25, 0x2c, 0x60, 0x6b, 0xee, 0x75,
+	0xba, 0x2d, 0xb6, 0x92, 0xb4, 0x0a, 0x7f, 0x2d, 0xd2, 0x0b, 0xf4, 0x5f, 0xce, 0x40, 0x31, 0x2e,
+	0x43, 0xc2, 0xa2, 0x70, 0x4b, 0x46, 0xe6, 0x57, 0xa1, 0x7c, 0xa8, 0xbc, 0x12, 0xf2, 0x65, 0xdf,
+	0xec, 0x76, 0x0e, 0x64, 0xac, 0x69, 0xbb, 0xd3, 0x93, 0xc5, 0x4c, 0xdd, 0xa3, 0xc7, 0x32, 0x44,
+	0xfc, 0xd8, 0x68, 0x1c, 0xee, 0x1e, 0x13, 0x46, 0x01, 0x7f, 0x68, 0x37, 0xbb, 0xac, 0x88, 0x0f,
+	0xcd, 0xee, 0x11, 0x2b, 0xe1, 0x43, 0xaf, 0x7b, 0x24, 0xa3, 0x4b, 0xbb, 0xbd, 0xfd, 0x3d, 0x56,
+	0xc1, 0xa7, 0xed, 0x4e, 0xf3, 0x43, 0x06, 0xf8, 0xd4, 0x39, 0xdc, 0xdf, 0x63, 0x55, 0xfd, 0xdf,
+	0x64, 0xc2, 0xa5, 0x5c, 0xff, 0xbd, 0x0c, 0x94, 0xbb, 0x5c, 0x08, 0xdb, 0x1d, 0x04, 0x0b, 0xbe,
+	0x64, 0xb8, 0xe4, 0xb2, 0x89, 0x25, 0x87, 0xdf, 0xc1, 0x15, 0xdc, 0x3f, 0x37, 0x65, 0x9e, 0x52,
+	0xce, 0x88, 0xda, 0xb8, 0x1c, 0x47, 0xe6, 0x45, 0xb4, 0xf4, 0xa4, 0x51, 0x5b, 0x30, 0xd2, 0x40,
+	0xdc, 0xc6, 0x67, 0x9c, 0x8f, 0x9b, 0x43, 0xd3, 0x76, 0x65, 0xe6, 0x56, 0xc1, 0x48, 0x40, 0x30,
+	0x87, 0x47, 0xd5, 0x37, 0x44, 0x61, 0x45, 0x19, 0x55, 0x98, 0x06, 0xeb, 0xff, 0x21, 0x1f, 0x2a,
+	0x9c, 0xfa, 0x2f, 0xa8, 0xf4, 0x07, 0x80, 0x22, 0x6e, 0x42, 0x4f, 0xcd, 0x71, 0x34, 0xe3, 0x54,
+	0x8b, 0xd0, 0xba, 0x90, 0x9e, 0x50, 0x96, 0xc5, 0xc2, 0x81, 0xc3, 0x13, 0x99, 0x95, 0xb8, 0x2b,
+	0x46, 0x8e, 0x2c, 0xef, 0xee, 0x5d, 0x08, 0x39, 0xc1, 0xcd, 0xe0, 0x5c, 0x4e, 0x70, 0xbb, 0x1f,
+	0xb0, 0x12, 0x22, 0xb5, 0x5c, 0x8e, 0x95, 0xfd, 0xab, 0x50, 0xee, 0x4c, 0x04, 0x8a, 0x6a, 0x5f,
+	0xce, 0x72, 0x67, 0x3c, 0x72, 0x18, 0xe8, 0xbf, 0x9f, 0x83, 0x4a, 0xa4, 0xf0, 0xbc, 0x88, 0x02,
+	0x86, 0x51, 0xbd, 0xf6, 0x41, 0xaf, 0x65, 0x1c, 0x34, 0xf6, 0x14, 0x4a, 0x0e, 0x13, 0x57, 0x76,
+	0xda, 0x7b, 0xad, 0xe3, 0xbd, 0x4e, 0x63, 0x5b, 0x01, 0xcb, 0x58, 0x24, 0xd7, 0xde, 0x3f, 0xec,
+	0x18, 0xbd, 0xe3, 0x76, 0xf7, 0xb8, 0xd9, 0x38, 0x68, 0xb6, 0xf6, 0x5a, 0xdb, 0xac, 0xa8, 0xbd,
+	0x02, 0xf7, 0x0e, 0x3a, 0xbd, 0x76, 0xe7, 0xe0, 0xf8, 0xa0, 0x73, 0xdc, 0xd9, 0xfa, 0x66, 0xab,
+	0xd9, 0xeb, 0x1e, 0xb7, 0x0f, 0x8e, 0x91, 0xeb, 0x63, 0xa3, 0x81, 0xbf, 0xb0, 0x82, 0x76, 0x0f,
+	0xee, 0x28, 0xac, 0x6e, 0xcb, 0x38, 0x6a, 0x19, 0xc8, 0xe4, 0xe9, 0x41, 0xe3, 0xa8, 0xd1, 0xde,
+	0x6b, 0x6c, 0xed, 0xb5, 0xd8, 0xaa, 0x76, 0x17, 0xea, 0x0a, 0xc3, 0x68, 0xf4, 0x5a, 0xc7, 0x7b,
+	0xed, 0xfd, 0x76, 0xef, 0xb8, 0xf5, 0x61, 0xb3, 0xd5, 0xda, 0x6e, 0x6d, 0xb3, 0x35, 0xed, 0x8b,
+	0xf0, 0x79, 0xea, 0x94, 0xea, 0x44, 0xfa, 0x65, 0x1f, 0xb7, 0x0f, 0x8f, 0x1b, 0x46, 0x73, 0xb7,
+	0x7d, 0xd4, 0x62, 0xeb, 0xda, 0x17, 0xe0, 0x73, 0x57, 0xa3, 0x6e, 0xb7, 0x8d, 0x56, 0xb3, 0xd7,
+	0x31, 0x3e, 0x62, 0x1b, 0xda, 0x67, 0xe0, 0x53, 0xb8, 0x60, 0x8f, 0x9f, 0x19, 0x9d, 0x83, 0xc7,
+	0xc7, 0xf4, 0xd8, 0xed, 0x19, 0x4f, 0x9b, 0xbd, 0xa7, 0x46, 0x8b, 0x01, 0xa6, 0x37, 0x1c, 0x6e,
+	0x1d, 0x1f, 0x74, 0x7a, 0xc7, 0x8d, 0x83, 0x8f, 0xb6, 0xf6, 0x3a, 0xcd, 0x27, 0xc7, 0x3b, 0x1d,
+	0x63, 0xbf, 0xd1, 0x63, 0x55, 0x74, 0x59, 0x1f, 0x6e, 0x29, 0xc2, 0xc3, 0x46, 0xb7, 0xfb, 0xac,
+	0x63, 0x6c, 0x33, 0x4d, 0xfb, 0x12, 0x7c, 0xa1, 0xd9, 0x3d, 0x52, 0xbd, 0xef, 0xec, 0x1c, 0x1b,
+	0x9d, 0x67, 0xdd, 0xe3, 0x8e, 0x71, 0x6c, 0xb4, 0xf6, 0x68, 0x2a, 0xba, 0xf1, 0x90, 0x4a, 0xe8,
+	0xaf, 0x6e, 0x1f, 0x74, 0x9f, 0xee, 0xec, 0xb4, 0x9b, 0xed, 0xd6, 0x41, 0xef, 0xf8, 0xb0, 0x65,
+	0xec, 0xb7, 0xbb, 0x5d, 0x44, 0x63, 0x15, 0xfd, 0x1b, 0x78, 0xf7, 0xcd, 0xb9, 0x2d, 0x48, 0x64,
+	0xab, 0xbd, 0xad, 0x3c, 0x42, 0x61, 0x93, 0xa4, 0x8c, 0x3d, 0x70, 0xe9, 0xa6, 0x14, 0xda, 0x26,
+	0xab, 0x46, 0x0c, 0xd0, 0xff, 0x7e, 0x16, 0xd6, 0x24, 0x8b, 0xd0, 0xc3, 0x74, 0x1f, 0x6e, 0xa8,
+	0x70, 0x4e, 0x3b, 0xad, 0x9f, 0x4c, 0x83, 0xe9, 0x96, 0x43, 0x09, 0x4a, 0x68, 0x29, 0x49, 0x10,
+	0xbe, 0xdb, 0x26, 0xe6, 0x78, 0xd8, 0xc9, 0xe4, 0x88, 0x18, 0xf0, 0x49, 0xd5, 0x13, 0x3c, 0x2c,
+	0x24, 0x62, 0xdf, 0x73, 0x9b, 0x51, 0xcd, 0x5c, 0x0a, 0xa6, 0x7d, 0x0c, 0xb7, 0xa3, 0x76, 0xcb,
+	0xed, 0xfb, 0x97, 0xe3, 0xe8, 0x62, 0xd3, 0xd2, 0x5c, 0x97, 0x27, 0x5e, 0xb1, 0x90, 0x42, 0x34,
+	0xae, 0x62, 0x80, 0x55, 0x45, 0xb1, 0x5f, 0x4e, 0xfa, 0xdd, 0x16, 0xaa, 0x73, 0xf3, 0xe2, 0xc8,
+	0xe8, 0x19, 0x53, 0xdd, 0x57, 0x56, 0x86, 0x6a, 0x6a, 0x87, 0xa0, 0xd9, 0xb3, 0x9d, 0xce, 0x2f,
+	0xd9, 0xe9, 0x39, 0xb4, 0xd3, 0x61, 0xc0, 0xc2, 0x6c, 0x18, 0x10, 0xb3, 0xeb, 0x1c, 0xef, 0xc4,
+	0x74, 0x12, 0x1a, 0x46, 0x02, 0xa2, 0x3b, 0x50, 0x0e, 0xaf, 0x4f, 0x45, 0x87, 0x34, 0x8e, 0x38,
+	0x0e, 0x8a, 0xc8, 0x96, 0xb6, 0x8b, 0x69, 0xa7, 0xa9, 0x3e, 0x67, 0x97, 0xec, 0xf3, 0x14, 0x9d,
+	0xfe, 0x35, 0xd8, 0x98, 0x41, 0x8a, 0x04, 0x7d, 0x26, 0x21, 0xe8, 0x67, 0x12, 0x71, 0xf4, 0x7f,
+	0x9b, 0x85, 0xd5, 0x7d, 0xd3, 0xb5, 0x4f, 0x79, 0x20, 0xc2, 0xde, 0x06, 0xfd, 0x21, 0x1f, 0x99,
+	0x61, 0x6f, 0x65, 0x4b, 0x79, 0x41, 0xb3, 0xc9, 0x18, 0xe4, 0x4c, 0xc8, 0xfa, 0x16, 0x14, 0xcd,
+	0x89, 0x18, 0x46, 0x85, 0x3a, 0xaa, 0x85, 0xdf, 0xce, 0xb1, 0xfb, 0xdc, 0x0d, 0xc2, 0xb5, 0x19,
+	0x36, 0xe3, 0x54, 0xbc, 0xe2, 0x82, 0x54, 0xbc, 0xd2, 0xec, 0xfc, 0x63, 0x86, 0x64, 0xdf, 0xe7,
+	0xdc, 0x0d, 0x86, 0x9e, 0x08, 0xaf, 0xde, 0x4d, 0x82, 0x28, 0x61, 0xd5, 0x7b, 0xee, 0xe2, 0x0e,
+	0xc5, 0x40, 0x8b, 0xca, 0xc3, 0x4c, 0xc1, 0x70, 0x0d, 0x92, 0x0f, 0x18, 0x8b, 0xff, 0x41, 0x86,
+	0x82, 0xc3, 0x36, 0x79, 0x79, 0x4d, 0xc1, 0x07, 0x9e, 0x6f, 0x73, 0x19, 0x0e, 0xa9, 0x18, 0x09,
+	0x08, 0xd2, 0x3a, 0xa6, 0x3b, 0x98, 0xe0, 0x75, 0x42, 0x32, 0xae, 0x11, 0xb5, 0xf5, 0xff, 0x5e,
+	0x00, 0xd8, 0xe7, 0x58, 0x9b, 0x15, 0x0c, 0xed, 0x31, 0xa9, 0x32, 0xb6, 0xaa, 0x4e, 0x58, 0x33,
+	0xe8, 0x19, 0xb3, 0x88, 0x12, 0x95, 0x43, 0xb3, 0x09, 0x16, 0x31, 0xf9, 0xb4, 0x8b, 0x18, 0x27,
+	0xc7, 0x14, 0x5c, 0x65, 0x41, 0xd2, 0xfc, 0xe7, 0x8d, 0x24, 0x08, 0xbb, 0x86, 0xcd, 0x96, 0x6b,
+	0xc9, 0xd3, 0x3a, 0x6f, 0x44, 0x6d, 0xa4, 0xb6, 0x03, 0xbc, 0xe0, 0xc4, 0xe0, 0x2e, 0x7f, 0x1e,
+	0x95, 0xd5, 0xc6, 0x20, 0x6d, 0x1f, 0x03, 0x09, 0x97, 0x78, 0xac, 0xef, 0x73, 0x31, 0xf4, 0xac,
+	0x5a, 0x71, 0xae, 0x5a, 0x9c, 0xe8, 0xe0, 0x61, 0x12, 0xdd, 0x48, 0x53, 0xe3, 0x9a, 0x70, 0x83,
+	0x84, 0xc2, 0xa6, 0x5a, 0x98, 0xa2, 0x20, 0x9f, 0xc8, 0x2d, 0x52, 0x9e, 0xef, 0x29, 0x37, 0x47,
+	0x3c, 0xe0, 0x3e, 0xe6, 0xd6, 0x86, 0x98, 0x46, 0x82, 0x0a, 0xa5, 0xde, 0x24, 0xe0, 0x7e, 0x6b,
+	0x64, 0xda, 0x8e, 0xfa, 0xc0, 0x31, 0x00, 0x6f, 0x51, 0x08, 0x26, 0x27, 0xb8, 0x66, 0x4e, 0x78,
+	0xcf, 0x3b, 0xe0, 0xcf, 0x03, 0x87, 0x0b, 0xc1, 0x7d, 0x95, 0x23, 0x35, 0xff, 0x47, 0x7d, 0x10,
+	0x69, 0x91, 0x74, 0x27, 0x12, 0x3e, 0xc5, 0xb9, 0x97, 0x11, 0x48, 0x25, 0xa6, 0xb2, 0x0c, 0x66,
+	0xf7, 0x49, 0x90, 0xca, 0x5b, 0xcd, 0x6a, 0x9f, 0x87, 0xcf, 0xa6, 0x90, 0x0c, 0x99, 0xcc, 0x12,
+	0xec, 0xd8, 0xae, 0xe9, 0xd8, 0xdf, 0x95, 0xa9, 0x45, 0x39, 0x7d, 0x0c, 0x6b, 0xa9, 0x89, 0xa3,
+	0x3a, 0x70, 0x7a, 0x52, 0x99, 0x7c, 0x0c, 0x56, 0x65, 0x1b, 0x6f, 0x66, 0xa2, 0x28, 0x6d, 0x04,
+	0x69, 0xe2, 0x3e, 0xc7, 0x34, 0xa6, 0x9b, 0xc0, 0x24, 0xa4, 0xed, 0x9a, 0xe3, 0x71, 0x63, 0x3c,
+	0x76, 0x30, 0x08, 0x8f, 0x35, 0xf6, 0x31, 0x54, 0xd6, 0x0f, 0xb1, 0xbc, 0xfe, 0x21, 0xdc, 0xa6,
+	0x99, 0x39, 0xe2, 0x7e, 0x64, 0xd1, 0xa8, 0xb1, 0xbe, 0x0c, 0x1b, 0xf2, 0xe9, 0xc0, 0x13, 0xf2,
+	0x67, 0xd2, 0x9d, 0x35, 0x58, 0x97, 0x60, 0x54, 0x81, 0xba, 0x9c, 0x2a, 0xe7, 0x23, 0x58, 0x84,
+	0x97, 0xd5, 0x7f, 0x58, 0x04, 0x2d, 0x5e, 0x10, 0x3d, 0x1b, 0xab, 0xfa, 0x85, 0x99, 0x88, 0x9c,
+	0xac, 0x5d, 0x99, 0x1f, 0x74, 0x7d, 0xda, 0xed, 0x2d, 0x28, 0xda, 0x01, 0xfa, 0x59, 0x54, 0x52,
+	0xbc, 0x6a, 0x69, 0x7b, 0x00, 0x63, 0xee, 0xdb, 0x9e, 0x45, 0x2b, 0xa8, 0x30, 0xb7, 0x80, 0x6b,
+	0xb6, 0x53, 0x9b, 0x87, 0x11, 0x8d, 0x91, 0xa0, 0xc7, 0x7e, 0xc8, 0x96, 0xcc, 0xb6, 0x29, 0x52,
+	0xa7, 0x93, 0x20, 0xbc, 0x13, 0x63, 0xec, 0xdb, 0x7d, 0x2e, 0x3f, 0xc7, 0xd3, 0xc0, 0x6a, 0x92,
+	0x3e, 0x5c, 0x22, 0xcc, 0x79, 0x3f, 0xe1, 0x0a, 0x34, 0x5d, 0xf2, 0x3e, 0x48, 0x8b, 0x4c, 0xdd,
+	0x1c, 0x21, 0xd3, 0xc6, 0xd7, 0x8c, 0xf9, 0x3f, 0x62, 0x12, 0x8d, 0xfa, 0x61, 0xdf, 0x76, 0xf7,
+	0xb8, 0x3b, 0x10, 0x43, 0x5a, 0xdc, 0x6b, 0xc6, 0x0c, 0x9c, 0x24, 0x98, 0xbc, 0xd3, 0x4d, 0xc6,
+	0x9e, 0x2b, 0x46, 0xd4, 0x56, 0x25, 0x4a, 0x9e, 0xdf, 0x15, 0xbe, 0xca, 0x7f, 0x8f, 0xda, 0xa8,
+	0xb3, 0x04, 0xd4, 0xd7, 0x43, 0xdf, 0xb3, 0x26, 0x64, 0xd6, 0x49, 0x21, 0x36, 0x0d, 0x8e, 0x31,
+	0xf7, 0x4d, 0x57, 0xe5, 0x3e, 0xaf, 0x25, 0x31, 0x23, 0x30, 0x39, 0x58, 0xbc, 0x20, 0x66, 0x78,
+	0x43, 0x39, 0x58, 0x12, 0x30, 0x85, 0x13, 0xb3, 0x62, 0x11, 0x4e, 0xcc, 0x87, 0xc6, 0x6f, 0xf9,
+	0x9e, 0x6d, 0xc5, 0xbc, 0x36, 0x08, 0x6f, 0x06, 0x9e, 0xc0, 0x8d, 0x79, 0x6a, 0x29, 0xdc, 0x08,
+	0xae, 0x7f, 0x2f, 0x03, 0x10, 0x7f, 0x7c, 0x52, 0x36, 0xa3, 0x56, 0xbc, 0xc5, 0x6f, 0xc3, 0x4b,
+	0x49, 0x30, 0x15, 0x4e, 0x51, 0x92, 0x8a, 0x06, 0xeb, 0xf1, 0x0f, 0x58, 0x71, 0xcb, 0xb2, 0xea,
+	0xb6, 0x07, 0x05, 0xc3, 0xe2, 0x5e, 0x4c, 0x06, 0xbe, 0x09, 0x2c, 0x06, 0x52, 0x09, 0x2f, 0x66,
+	0x05, 0xa7, 0x50, 0x3f, 0xe2, 0xa6, 0x1f, 0xb0, 0x82, 0xbe, 0x8b, 0xe9, 0xc5, 0x02, 0x85, 0xd5,
+	0x6c, 0x6a, 0xcb, 0x8b, 0xe5, 0xa9, 0xfd, 0x95, 0x0c, 0xc6, 0xd1, 0xa9, 0x0a, 0x01, 0x4f, 0xf1,
+	0x39, 0x19, 0x43, 0xf3, 0x34, 0x2a, 0xd3, 0xb2, 0xa8, 0x9a, 0x23, 0x17, 0x5d, 0xfc, 0x85, 0x4d,
+	0x5c, 0x39, 0x66, 0x68, 0xa6, 0xc9, 0x3d, 0x17, 0xb5, 0xe5, 0x01, 0xd2, 0xf4, 0x5c, 0x97, 0xf7,
+	0xf1, 0xf8, 0x89, 0x0e, 0x90, 0x08, 0xa4, 0xff, 0xa8, 0x04, 0x55, 0xac, 0x05, 0xdb, 0x97, 0x77,
+	0x90, 0xcf, 0xf4, 0xa5, 0x06, 0x25, 0xcf, 0xb7, 0xb8, 0x1f, 0x3b, 0x4b, 0x54, 0x33, 0x99, 0x27,
+	0x95, 0x4b, 0xe7, 0x49, 0xa1, 0x1f, 0x41, 0x5a, 0xf4, 0x0d, 0x29, 0x06, 0x72, 0x46, 0x0c, 0xc0,
+	0xb3, 0x7a, 0xe4, 0x59, 0x24, 0x8c, 0x1a, 0x32, 0x38, 0x99, 0x33, 0x12, 0x10, 0x99, 0x96, 0x36,
+	0x76, 0x2e, 0x7b, 0xde, 0x7e, 0x74, 0x51, 0x7a, 0x74, 0x67, 0x41, 0x1a, 0xae, 0x35, 0xa1, 0xa4,
+	0x2e, 0x4f, 0xaf, 0x15, 0xe7, 0x86, 0x24, 0x13, 0x43, 0xdb, 0x54, 0x7f, 0x55, 0x2d, 0x9e, 0x11,
+	0x52, 0xa2, 0xff, 0xcd, 0x14, 0xc2, 0xec, 0x0f, 0x47, 0x4a, 0x44, 0xe4, 0xe6, 0xe4, 0x65, 0x24,
+	0x19, 0x35, 0x22, 0x6c, 0x23, 0x49, 0xa9, 0x6d, 0x61, 0xea, 0x81, 0x99, 0x4a, 0x0d, 0x79, 0x65,
+	0x01, 0x1b, 0x23, 0xc4, 0x35, 0x62, 0x32, 0xbc, 0xa9, 0x7f, 0x3d, 0xdd, 0xd1, 0x3f, 0x89, 0xcb,
+	0x1e, 0xbf, 0x1e, 0x5f, 0xf6, 0xf8, 0x09, 0x2e, 0x4e, 0xfc, 0x8d, 0x0c, 0x40, 0x3c, 0x07, 0x28,
+	0xf2, 0x65, 0xf9, 0x5f, 0xa8, 0x84, 0xca, 0x96, 0xb6, 0x9b, 0xba, 0xca, 0xe4, 0xcd, 0xa5, 0x26,
+	0x34, 0xf1, 0x98, 0x28, 0xad, 0x78, 0x08, 0xeb, 0x69, 0x38, 0xdd, 0x1a, 0xd7, 0xde, 0x6b, 0x49,
+	0x8f, 0x51, 0x7b, 0xbf, 0xf1, 0xb8, 0xa5, 0x6a, 0x1f, 0xdb, 0x07, 0x4f, 0x58, 0xb6, 0xfe, 0x87,
+	0x19, 0xcc, 0x19, 0x53, 0x73, 0xaa, 0x7d, 0x2b, 0xf9, 0x5d, 0x64, 0xae, 0xd7, 0x1b, 0xcb, 0x7c,
+	0x97, 0xf8, 0xa9, 0xe5, 0x0a, 0xff, 0x32, 0xf9, 0x99, 0x3c, 0xf4, 0xd1, 0x27, 0x7f, 0x9c, 0x23,
+	0x13, 0x1e, 0xa7, 0x65, 0xc2, 0xeb, 0x4b, 0xbd, 0x32, 0xb4, 0xbc, 0x30, 0xe5, 0x58, 0x89, 0x8b,
+	0x77, 0xb3, 0xef, 0x64, 0xea, 0xf7, 0x60, 0x35, 0xf9, 0xd3, 0x6c, 0x2d, 0xb6, 0xfe, 0x2f, 0x72,
+	0xb1, 0x6f, 0x70, 0x7a, 0x17, 0x27, 0xbd, 0x7e, 0xd9, 0x29, 0xaf, 0x5f, 0x62, 0x87, 0xe7, 0xae,
+	0xdc, 0xe1, 0xf9, 0x05, 0x3b, 0xbc, 0xb0, 0x78, 0x87, 0x17, 0x67, 0x76, 0x78, 0x62, 0xd7, 0x96,
+	0x3e, 0xf1, 0xae, 0x7d, 0x0b, 0x8a, 0xa6, 0xdb, 0x47, 0xf3, 0xa5, 0x3c, 0xb7, 0xf4, 0x50, 0x4d,
+	0xc5, 0x66, 0x83, 0x90, 0x0c, 0x85, 0x2c, 0x73, 0x38, 0x03, 0xcf, 0x89, 0x73, 0xe5, 0xa3, 0x36,
+	0xf6, 0x3b, 0x7c, 0xde, 0xba, 0x54, 0x85, 0x5e, 0x09, 0x08, 0xe6, 0x78, 0x4a, 0x6e, 0x0b, 0x32,
+	0x7b, 0xa2, 0xbb, 0x44, 0xb3, 0xd7, 0xdf, 0x25, 0x7a, 0x13, 0x0a, 0xdf, 0x99, 0x78, 0x22, 0x34,
+	0xcb, 0x64, 0xe3, 0xc1, 0x1f, 0xe6, 0x60, 0x3d, 0x9d, 0xf6, 0x46, 0xe5, 0xab, 0x32, 0xe5, 0xb2,
+	0xe3, 0x58, 0x89, 0xaa, 0x22, 0x86, 0xb9, 0xe1, 0xca, 0x46, 0x27, 0xc0, 0x06, 0xb9, 0xd3, 0xbc,
+	0x11, 0x67, 0xf7, 0x92, 0x17, 0x13, 0x7f, 0x05, 0xbd, 0x72, 0xb2, 0xf6, 0x98, 0x8d, 0xb5, 0x8a,
+	0xba, 0x71, 0xf1, 0x17, 0xb3, 0xda, 0x5a, 0xa2, 0xb6, 0xe5, 0x37, 0x51, 0x41, 0xbd, 0xb1, 0x35,
+	0x71, 0x2d, 0x87, 0x5b, 0x11, 0xf4, 0x07, 0x49, 0x68, 0x54, 0xa9, 0xf2, 0x8b, 0xe8, 0x15, 0xad,
+	0x74, 0x27, 0x27, 0xaa, 0x4a, 0xe5, 0xcf, 0xe5, 0xb5, 0x5b, 0xb0, 0xa1, 0xb0, 0xe2, 0x74, 0x73,
+	0xf6, 0xe7, 0xf1, 0x28, 0x5d, 0x6f, 0xc8, 0x29, 0x50, 0x1d, 0x65, 0x7f, 0x01, 0x0b, 0x7c, 0xe9,
+	0x46, 0x00, 0xf6, 0x17, 0x89, 0x4f, 0x54, 0xdd, 0xc7, 0x7e, 0x09, 0x6f, 0xe3, 0x80, 0x6e, 0x2f,
+	0x7a, 0xd1, 0x2f, 0xe7, 0xb5, 0x2a, 0x14, 0xbb, 0x3d, 0xe2, 0xf6, 0xbd, 0xbc, 0xf6, 0x32, 0xb0,
+	0xf8, 0x57, 0x95, 0x84, 0xff, 0x2b, 0xb2, 0x33, 0x51, 0x56, 0xfd, 0xaf, 0xe6, 0x71, 0x5c, 0xe1,
+	0x6e, 0x61, 0xbf, 0x86, 0x77, 0x7c, 0x57, 0x13, 0xf1, 0x13, 0xf6, 0xeb, 0x78, 0xe5, 0xc9, 0xda,
+	0x3e, 0x86, 0x4d, 0xdc, 0x81, 0x1a, 0xc1, 0x5f, 0xa6, 0x37, 0xef, 0x44, 0x05, 0x8a, 0xec, 0xfb,
+	0x79, 0xed, 0x36, 0x68, 0xc9, 0x78, 0x82, 0xfa, 0xe1, 0xaf, 0x13, 0xb5, 0x3c, 0xbe, 0x03, 0x05,
+	0xfb, 0x1b, 0x44, 0x8d, 0xcb, 0x56, 0x01, 0xfe, 0x26, 0x4d, 0x48, 0x33, 0x4e, 0xdb, 0x57, 0xf0,
+	0xdf, 0x24, 0xe2, 0xf0, 0x63, 0x4a, 0xd8, 0x0f, 0xf2, 0x0f, 0x7e, 0x48, 0x31, 0xbf, 0x64, 0xf6,
+	0x2b, 0x3a, 0x3a, 0x1d, 0xcf, 0x1d, 0x08, 0x79, 0x21, 0x34, 0x96, 0x0d, 0x0c, 0x3d, 0x5f, 0x50,
+	0x93, 0x2a, 0xa8, 0x5d, 0xba, 0x4e, 0x44, 0x96, 0x36, 0x49, 0x63, 0x93, 0xe5, 0xc2, 0xca, 0x80,
+	0x6a, 0x54, 0x70, 0x90, 0x8f, 0x8a, 0x22, 0xe8, 0x5a, 0x93, 0xf0, 0xda, 0x08, 0xe9, 0x5b, 0x9d,
+	0xf8, 0x8e, 0x2c, 0x8e, 0xe0, 0x68, 0x68, 0xc8, 0x5b, 0x5d, 0xc7, 0x43, 0xcf, 0x55, 0xd5, 0x11,
+	0x9c, 0x2e, 0x78, 0x85, 0x44, 0xae, 0xb1, 0x85, 0xfd, 0x88, 0x52, 0xe5, 0x18, 0x7f, 0xf0, 0xab,
+	0x19, 0x58, 0x0d, 0x2f, 0xf3, 0xc0, 0x7f, 0x5d, 0x23, 0xcb, 0x2b, 0xc2, 0x6b, 0xb6, 0xfb, 0x8e,
+	0x3d, 0x0e, 0xaf, 0xa4, 0xbd, 0x01, 0x55, 0xbc, 0x20, 0xbe, 0xe1, 0x5a, 0xdb, 0xbe, 0x37, 0x96,
+	0xdd, 0x96, 0x59, 0x01, 0xb2, 0xac, 0xe3, 0x39, 0x3f, 0x41, 0xf4, 0x31, 0xc7, 0xab, 0xe5, 0x30,
+	0x8f, 0x79, 0x68, 0xfa, 0xb6, 0x3b, 0x40, 0x87, 0xb1, 0x1b, 0xc8, 0xf2, 0x8e, 0x2a, 0x94, 0x26,
+	0x01, 0xef, 0x9b, 0x01, 0x56, 0x78, 0x54, 0xa1, 0x74, 0x32, 0xb1, 0x1d, 0x61, 0xbb, 0xac, 0x94,
+	0xaa, 0xdf, 0x28, 0x3f, 0xf8, 0xdd, 0x0c, 0x54, 0x69, 0x35, 0xc4, 0x01, 0x86, 0x58, 0x63, 0xac,
+	0x42, 0x69, 0x2f, 0xba, 0xfc, 0x13, 0x6f, 0xd4, 0x39, 0x93, 0x01, 0x06, 0xb5, 0x1a, 0x64, 0x1d,
+	0xba, 0xbc, 0x07, 0x34, 0xaf, 0x7d, 0x0a, 0x5e, 0xc6, 0x78, 0xa6, 0xe0, 0xcf, 0x4c, 0x5b, 0x24,
+	0x4b, 0x1b, 0x0b, 0x68, 0x5c, 0xca, 0x9f, 0xc2, 0x5a, 0xc6, 0x22, 0x19, 0x97, 0xf8, 0xda, 0x10,
+	0x52, 0xc2, 0x41, 0x13, 0x44, 0x59, 0x9b, 0xe5, 0x08, 0x05, 0x83, 0xe5, 0xf8, 0x36, 0xba, 0x00,
+	0x82, 0x20, 0x14, 0x37, 0x45, 0x10, 0x3c, 0x38, 0x80, 0x5b, 0xf3, 0xa3, 0x7d, 0xf2, 0x6a, 0x08,
+	0xba, 0xc6, 0x9e, 0x8a, 0xdd, 0x9e, 0xf9, 0xb6, 0x2c, 0x6f, 0xaf, 0x40, 0xa1, 0xf3, 0xdc, 0xa5,
+	0xd5, 0xb0, 0x01, 0x6b, 0x07, 0x5e, 0x82, 0x86, 0xe5, 0x1e, 0xf4, 0x53, 0x01, 0xda, 0x78, 0x52,
+	0xc2, 0x4e, 0xac, 0x24, 0x0a, 0x39, 0x33, 0x32, 0xd8, 0x42, 0xff, 0x14, 0x49, 0x5e, 0x9b, 0xa3,
+	0x02, 0xa3, 0x96, 0xbc, 0x36, 0x27, 0xea, 0x26, 0xd5, 0xde, 0x34, 0x4d, 0xb7, 0xcf, 0x1d, 0x6e,
+	0xb1, 0xc2, 0x83, 0x77, 0xe0, 0x86, 0x1a, 0x6a, 0x9f, 0x07, 0x41, 0x58, 0x08, 0x79, 0xe8, 0xdb,
+	0xe7, 0xf2, 0x6a, 0x1e, 0x0c, 0xb7, 0x70, 0x3f, 0xf0, 0x5c, 0xba, 0x96, 0x08, 0xa0, 0xd8, 0x1d,
+	0x9a, 0x3e, 0xbe, 0xe3, 0x41, 0x13, 0x2a, 0x54, 0x18, 0xf9, 0xc4, 0x76, 0x2d, 0x1c, 0xc9, 0x96,
+	0xaa, 0x05, 0xa2, 0xfb, 0xdf, 0xce, 0x69, 0x7c, 0x65, 0x79, 0x51, 0x36, 0xcb, 0xa2, 0x0f, 0x1e,
+	0x9d, 0x1f, 0x23, 0x93, 0x2a, 0xed, 0x9d, 0x4b, 0x79, 0xa9, 0x7a, 0xee, 0xc1, 0x07, 0xa0, 0x49,
+	0x17, 0x9e, 0xc5, 0x2f, 0x6c, 0x77, 0x10, 0xdd, 0x63, 0x02, 0x74, 0x29, 0x91, 0xc5, 0x2f, 0xc8,
+	0x42, 0xae, 0x42, 0x29, 0x6c, 0x84, 0x57, 0x23, 0xed, 0x78, 0x13, 0xbc, 0x4b, 0xe9, 0xc1, 0x11,
+	0xdc, 0x94, 0x6b, 0x06, 0xbb, 0x45, 0x65, 0xdc, 0x57, 0xfa, 0x15, 0x64, 0x55, 0xab, 0x98, 0x04,
+	0x11, 0x2e, 0xcb, 0x60, 0xc7, 0x22, 0x9b, 0x3c, 0x86, 0x67, 0x1f, 0xe8, 0xf0, 0xd2, 0x1c, 0xc7,
+	0x08, 0x09, 0x67, 0x69, 0x1e, 0xb2, 0x95, 0x07, 0xef, 0xc3, 0x86, 0x14, 0x27, 0x07, 0xb2, 0xd0,
+	0x36, 0xd4, 0x70, 0x9e, 0xb5, 0x77, 0xda, 0x72, 0xea, 0x9a, 0xad, 0xbd, 0xbd, 0xa7, 0x7b, 0x0d,
+	0x8c, 0x5e, 0xe0, 0x07, 0xee, 0xf4, 0x8e, 0x9b, 0x9d, 0x83, 0x83, 0x56, 0xb3, 0xd7, 0xda, 0x66,
+	0xd9, 0xad, 0x07, 0xff, 0xea, 0x27, 0x77, 0x33, 0x3f, 0xfe, 0xc9, 0xdd, 0xcc, 0x7f, 0xfe, 0xc9,
+	0xdd, 0xcc, 0xf7, 0x7e, 0x7a, 0x77, 0xe5, 0xc7, 0x3f, 0xbd, 0xbb, 0xf2, 0xef, 0x7f, 0x7a, 0x77,
+	0xe5, 0x63, 0x36, 0xfd, 0x8f, 0xca, 0x4e, 0x8a, 0x64, 0x91, 0xbc, 0xf1, 0x7f, 0x07, 0x00, 0xb0,
+	0x19, 0x97, 0x54, 0xc3, 0x6c, 0x00, 0x00,
 }
 
 func (m *SmartBlockSnapshotBase) Marshal() (dAtA []byte, err error) {
@@ -10255,6 +11145,13 @@ func (m *SearchResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Meta) > 0 {
 		for iNdEx := len(m.Meta) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -10311,6 +11208,18 @@ func (m *SearchMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Page != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.MessageId) > 0 {
+		i -= len(m.MessageId)
+		copy(dAtA[i:], m.MessageId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.MessageId)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.RelationDetails != nil {
 		{
 			size, err := m.RelationDetails.MarshalToSizedBuffer(dAtA[:i])
@@ -10858,6 +11767,29 @@ func (m *BlockContentOfChat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
+func (m *BlockContentOfSynced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentOfSynced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Synced != nil {
+		{
+			size, err := m.Synced.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	return len(dAtA) - i, nil
+}
 func (m *BlockRestrictions) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11002,6 +11934,13 @@ func (m *BlockContentLink) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FocusBlockId) > 0 {
+		i -= len(m.FocusBlockId)
+		copy(dAtA[i:], m.FocusBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.FocusBlockId)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Relations) > 0 {
 		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Relations[iNdEx])
@@ -11344,6 +12283,13 @@ func (m *BlockContentTextMark) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FocusBlockId) > 0 {
+		i -= len(m.FocusBlockId)
+		copy(dAtA[i:], m.FocusBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.FocusBlockId)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Param) > 0 {
 		i -= len(m.Param)
 		copy(dAtA[i:], m.Param)
@@ -12541,6 +13487,20 @@ func (m *BlockContentTableRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CellSpans) > 0 {
+		for iNdEx := len(m.CellSpans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CellSpans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintModels(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if m.IsHeader {
 		i--
 		if m.IsHeader {
@@ -12554,6 +13514,46 @@ func (m *BlockContentTableRow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockContentTableRowCellSpan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentTableRowCellSpan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentTableRowCellSpan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ColSpan != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ColSpan))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RowSpan != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.RowSpan))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ColumnId) > 0 {
+		i -= len(m.ColumnId)
+		copy(dAtA[i:], m.ColumnId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ColumnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockContentWidget) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -12617,6 +13617,43 @@ func (m *BlockContentChat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockContentSynced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockContentSynced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockContentSynced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetBlockId) > 0 {
+		i -= len(m.TargetBlockId)
+		copy(dAtA[i:], m.TargetBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetBlockId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TargetObjectId) > 0 {
+		i -= len(m.TargetObjectId)
+		copy(dAtA[i:], m.TargetObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.TargetObjectId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *BlockMetaOnly) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13104,20 +14141,20 @@ func (m *Restrictions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		}
 	}
 	if len(m.Object) > 0 {
-		dAtA41 := make([]byte, len(m.Object)*10)
-		var j40 int
+		dAtA42 := make([]byte, len(m.Object)*10)
+		var j41 int
 		for _, num := range m.Object {
 			for num >= 1<<7 {
-				dAtA41[j40] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA42[j41] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j40++
+				j41++
 			}
-			dAtA41[j40] = uint8(num)
-			j40++
+			dAtA42[j41] = uint8(num)
+			j41++
 		}
-		i -= j40
-		copy(dAtA[i:], dAtA41[:j40])
-		i = encodeVarintModels(dAtA, i, uint64(j40))
+		i -= j41
+		copy(dAtA[i:], dAtA42[:j41])
+		i = encodeVarintModels(dAtA, i, uint64(j41))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -13145,20 +14182,20 @@ func (m *RestrictionsDataviewRestrictions) MarshalToSizedBuffer(dAtA []byte) (in
 	var l int
 	_ = l
 	if len(m.Restrictions) > 0 {
-		dAtA43 := make([]byte, len(m.Restrictions)*10)
-		var j42 int
+		dAtA44 := make([]byte, len(m.Restrictions)*10)
+		var j43 int
 		for _, num := range m.Restrictions {
 			for num >= 1<<7 {
-				dAtA43[j42] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA44[j43] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j42++
+				j43++
 			}
-			dAtA43[j42] = uint8(num)
-			j42++
+			dAtA44[j43] = uint8(num)
+			j43++
 		}
-		i -= j42
-		copy(dAtA[i:], dAtA43[:j42])
-		i = encodeVarintModels(dAtA, i, uint64(j42))
+		i -= j43
+		copy(dAtA[i:], dAtA44[:j43])
+		i = encodeVarintModels(dAtA, i, uint64(j43))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -13340,20 +14377,20 @@ func (m *ObjectType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x48
 	}
 	if len(m.Types) > 0 {
-		dAtA45 := make([]byte, len(m.Types)*10)
-		var j44 int
+		dAtA46 := make([]byte, len(m.Types)*10)
+		var j45 int
 		for _, num := range m.Types {
 			for num >= 1<<7 {
-				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA46[j45] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j44++
+				j45++
 			}
-			dAtA45[j44] = uint8(num)
-			j44++
+			dAtA46[j45] = uint8(num)
+			j45++
 		}
-		i -= j44
-		copy(dAtA[i:], dAtA45[:j44])
-		i = encodeVarintModels(dAtA, i, uint64(j44))
+		i -= j45
+		copy(dAtA[i:], dAtA46[:j45])
+		i = encodeVarintModels(dAtA, i, uint64(j45))
 		i--
 		dAtA[i] = 0x42
 	}
@@ -13887,6 +14924,13 @@ func (m *ObjectView) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FocusBlockId) > 0 {
+		i -= len(m.FocusBlockId)
+		copy(dAtA[i:], m.FocusBlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.FocusBlockId)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.BlockParticipants) > 0 {
 		for iNdEx := len(m.BlockParticipants) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -14591,6 +15635,75 @@ func (m *NotificationPayloadOfParticipantPermissionsChange) MarshalToSizedBuffer
 	}
 	return len(dAtA) - i, nil
 }
+func (m *NotificationPayloadOfReminder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPayloadOfReminder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reminder != nil {
+		{
+			size, err := m.Reminder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NotificationPayloadOfBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPayloadOfBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Backup != nil {
+		{
+			size, err := m.Backup.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NotificationPayloadOfComment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationPayloadOfComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Comment != nil {
+		{
+			size, err := m.Comment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	return len(dAtA) - i, nil
+}
 func (m *NotificationImport) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15059,6 +16172,205 @@ func (m *NotificationParticipantPermissionsChange) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *NotificationReminder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationReminder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationReminder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpaceName) > 0 {
+		i -= len(m.SpaceName)
+		copy(dAtA[i:], m.SpaceName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Date != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Date))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RelationKey) > 0 {
+		i -= len(m.RelationKey)
+		copy(dAtA[i:], m.RelationKey)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.RelationKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ObjectName) > 0 {
+		i -= len(m.ObjectName)
+		copy(dAtA[i:], m.ObjectName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationBackup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationBackup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationBackup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObjectsCount != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ObjectsCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsIncremental {
+		i--
+		if m.IsIncremental {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SpaceName) > 0 {
+		i -= len(m.SpaceName)
+		copy(dAtA[i:], m.SpaceName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ErrorCode != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ErrorCode))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NotificationComment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NotificationComment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NotificationComment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpaceName) > 0 {
+		i -= len(m.SpaceName)
+		copy(dAtA[i:], m.SpaceName)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceName)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Text) > 0 {
+		i -= len(m.Text)
+		copy(dAtA[i:], m.Text)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Text)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Identity) > 0 {
+		i -= len(m.Identity)
+		copy(dAtA[i:], m.Identity)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Identity)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CommentId) > 0 {
+		i -= len(m.CommentId)
+		copy(dAtA[i:], m.CommentId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.CommentId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ObjectId) > 0 {
+		i -= len(m.ObjectId)
+		copy(dAtA[i:], m.ObjectId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ObjectId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Export) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -15082,6 +16394,91 @@ func (m *Export) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Backup) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Backup) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Backup) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupSettings) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackupSettings) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupSettings) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IncludeArchived {
+		i--
+		if m.IncludeArchived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.KeepChains != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.KeepChains))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxIncrements != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.MaxIncrements))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Interval != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SpaceId) > 0 {
+		i -= len(m.SpaceId)
+		copy(dAtA[i:], m.SpaceId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.SpaceId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Import) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16079,6 +17476,157 @@ func (m *ChatMessageReactionsIdentityList) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
+func (m *Comment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Comment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Comment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ResolvedBy) > 0 {
+		i -= len(m.ResolvedBy)
+		copy(dAtA[i:], m.ResolvedBy)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ResolvedBy)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Resolved {
+		i--
+		if m.Resolved {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Anchor != nil {
+		{
+			size, err := m.Anchor.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ModifiedAt != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.ModifiedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintModels(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ThreadId) > 0 {
+		i -= len(m.ThreadId)
+		copy(dAtA[i:], m.ThreadId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.ThreadId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommentAnchor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommentAnchor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommentAnchor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.Quote)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Range != nil {
+		{
+			size, err := m.Range.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintModels(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockId) > 0 {
+		i -= len(m.BlockId)
+		copy(dAtA[i:], m.BlockId)
+		i = encodeVarintModels(dAtA, i, uint64(len(m.BlockId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintModels(dAtA []byte, offset int, v uint64) int {
 	offset -= sovModels(v)
 	base := offset
@@ -16181,6 +17729,10 @@ func (m *SearchResult) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -16212,6 +17764,13 @@ func (m *SearchMeta) Size() (n int) {
 		l = m.RelationDetails.Size()
 		n += 1 + l + sovModels(uint64(l))
 	}
+	l = len(m.MessageId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovModels(uint64(m.Page))
+	}
 	return n
 }
 
@@ -16471,6 +18030,18 @@ func (m *BlockContentOfChat) Size() (n int) {
 	}
 	return n
 }
+func (m *BlockContentOfSynced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Synced != nil {
+		l = m.Synced.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
 func (m *BlockRestrictions) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16548,6 +18119,10 @@ func (m *BlockContentLink) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	l = len(m.FocusBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -16691,6 +18266,10 @@ func (m *BlockContentTextMark) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovModels(uint64(l))
 	}
+	l = len(m.FocusBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -17226,6 +18805,31 @@ func (m *BlockContentTableRow) Size() (n int) {
 	if m.IsHeader {
 		n += 2
 	}
+	if len(m.CellSpans) > 0 {
+		for _, e := range m.CellSpans {
+			l = e.Size()
+			n += 1 + l + sovModels(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockContentTableRowCellSpan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ColumnId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.RowSpan != 0 {
+		n += 1 + sovModels(uint64(m.RowSpan))
+	}
+	if m.ColSpan != 0 {
+		n += 1 + sovModels(uint64(m.ColSpan))
+	}
 	return n
 }
 
@@ -17257,6 +18861,23 @@ func (m *BlockContentChat) Size() (n int) {
 	return n
 }
 
+func (m *BlockContentSynced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.TargetBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
 func (m *BlockMetaOnly) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17847,6 +19468,10 @@ func (m *ObjectView) Size() (n int) {
 			n += 1 + l + sovModels(uint64(l))
 		}
 	}
+	l = len(m.FocusBlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
 	return n
 }
 
@@ -18139,6 +19764,42 @@ func (m *NotificationPayloadOfParticipantPermissionsChange) Size() (n int) {
 	}
 	return n
 }
+func (m *NotificationPayloadOfReminder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reminder != nil {
+		l = m.Reminder.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *NotificationPayloadOfBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Backup != nil {
+		l = m.Backup.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
+func (m *NotificationPayloadOfComment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Comment != nil {
+		l = m.Comment.Size()
+		n += 2 + l + sovModels(uint64(l))
+	}
+	return n
+}
 func (m *NotificationImport) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18366,6 +20027,105 @@ func (m *NotificationParticipantPermissionsChange) Size() (n int) {
 	return n
 }
 
+func (m *NotificationReminder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.RelationKey)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Date != 0 {
+		n += 1 + sovModels(uint64(m.Date))
+	}
+	l = len(m.SpaceName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *NotificationBackup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ErrorCode != 0 {
+		n += 1 + sovModels(uint64(m.ErrorCode))
+	}
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.SpaceName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.IsIncremental {
+		n += 2
+	}
+	if m.ObjectsCount != 0 {
+		n += 1 + sovModels(uint64(m.ObjectsCount))
+	}
+	return n
+}
+
+func (m *NotificationComment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ObjectId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.CommentId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Identity)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Text)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.SpaceName)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
 func (m *Export) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18375,6 +20135,44 @@ func (m *Export) Size() (n int) {
 	return n
 }
 
+func (m *Backup) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BackupSettings) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpaceId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Interval != 0 {
+		n += 1 + sovModels(uint64(m.Interval))
+	}
+	if m.MaxIncrements != 0 {
+		n += 1 + sovModels(uint64(m.MaxIncrements))
+	}
+	if m.KeepChains != 0 {
+		n += 1 + sovModels(uint64(m.KeepChains))
+	}
+	if m.IncludeArchived {
+		n += 2
+	}
+	return n
+}
+
 func (m *Import) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18847,6 +20645,73 @@ func (m *ChatMessageReactionsIdentityList) Size() (n int) {
 	return n
 }
 
+func (m *Comment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.ThreadId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovModels(uint64(m.CreatedAt))
+	}
+	if m.ModifiedAt != 0 {
+		n += 1 + sovModels(uint64(m.ModifiedAt))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Anchor != nil {
+		l = m.Anchor.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Resolved {
+		n += 2
+	}
+	l = len(m.ResolvedBy)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
+func (m *CommentAnchor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BlockId)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	if m.Range != nil {
+		l = m.Range.Size()
+		n += 1 + l + sovModels(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovModels(uint64(l))
+	}
+	return n
+}
+
 func sovModels(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -19445,59 +21310,91 @@ func (m *SearchResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SearchMeta) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Highlight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SearchMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Meta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Meta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Highlight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19661,6 +21558,57 @@ func (m *SearchMeta) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -20547,101 +22495,11 @@ func (m *Block) Unmarshal(dAtA []byte) error {
 			}
 			m.Content = &BlockContentOfChat{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BlockRestrictions) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Read = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Edit = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Synced", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -20651,52 +22509,177 @@ func (m *BlockRestrictions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Remove = bool(v != 0)
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Drag", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthModels
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			m.Drag = bool(v != 0)
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DropOn", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			v := &BlockContentSynced{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.DropOn = bool(v != 0)
+			m.Content = &BlockContentOfSynced{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRestrictions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Restrictions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Restrictions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Read", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Read = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Edit = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drag", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drag = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropOn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DropOn = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -21042,6 +23025,38 @@ func (m *BlockContentLink) Unmarshal(dAtA []byte) error {
 			}
 			m.Relations = append(m.Relations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FocusBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FocusBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -21997,6 +24012,38 @@ func (m *BlockContentTextMark) Unmarshal(dAtA []byte) error {
 			}
 			m.Param = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FocusBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FocusBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -25196,6 +27243,40 @@ func (m *BlockContentTableRow) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsHeader = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CellSpans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CellSpans = append(m.CellSpans, &BlockContentTableRowCellSpan{})
+			if err := m.CellSpans[len(m.CellSpans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -25217,7 +27298,7 @@ func (m *BlockContentTableRow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockContentWidget) Unmarshal(dAtA []byte) error {
+func (m *BlockContentTableRowCellSpan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25240,53 +27321,173 @@ func (m *BlockContentWidget) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Widget: wiretype end group for non-group")
+			return fmt.Errorf("proto: CellSpan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Widget: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CellSpan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
-			}
-			m.Layout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Layout |= BlockContentWidgetLayout(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowSpan", wireType)
+			}
+			m.RowSpan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowSpan |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColSpan", wireType)
+			}
+			m.ColSpan = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ColSpan |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockContentWidget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Widget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Widget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			m.Layout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Layout |= BlockContentWidgetLayout(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ViewId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -25387,6 +27588,120 @@ func (m *BlockContentChat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockContentSynced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Synced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Synced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *BlockMetaOnly) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -29400,6 +31715,38 @@ func (m *ObjectView) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FocusBlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FocusBlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -30742,61 +33089,11 @@ func (m *Notification) Unmarshal(dAtA []byte) error {
 			}
 			m.Payload = &NotificationPayloadOfParticipantPermissionsChange{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationImport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Import: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Import: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProcessId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reminder", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -30806,99 +33103,32 @@ func (m *NotificationImport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProcessId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
-			}
-			m.ErrorCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ErrorCode |= ImportErrorCode(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImportType", wireType)
-			}
-			m.ImportType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ImportType |= ImportType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			v := &NotificationReminder{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.Payload = &NotificationPayloadOfReminder{v}
 			iNdEx = postIndex
-		case 5:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backup", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -30908,29 +33138,32 @@ func (m *NotificationImport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			v := &NotificationBackup{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Payload = &NotificationPayloadOfBackup{v}
 			iNdEx = postIndex
-		case 6:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -30940,112 +33173,27 @@ func (m *NotificationImport) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
+			v := &NotificationComment{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationExport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Export: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Export: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
-			}
-			m.ErrorCode = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ErrorCode |= NotificationExportCode(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExportType", wireType)
-			}
-			m.ExportType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ExportType |= ExportFormat(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			m.Payload = &NotificationPayloadOfComment{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -31067,7 +33215,7 @@ func (m *NotificationExport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
+func (m *NotificationImport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31090,10 +33238,10 @@ func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GalleryImport: wiretype end group for non-group")
+			return fmt.Errorf("proto: Import: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GalleryImport: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Import: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -31148,6 +33296,25 @@ func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportType", wireType)
+			}
+			m.ImportType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImportType |= ImportType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
@@ -31179,7 +33346,7 @@ func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
 			}
 			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -31211,7 +33378,7 @@ func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
 			}
@@ -31264,7 +33431,7 @@ func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
+func (m *NotificationExport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31287,17 +33454,17 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestToJoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: Export: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestToJoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Export: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
 			}
-			var stringLen uint64
+			m.ErrorCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -31307,27 +33474,83 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ErrorCode |= NotificationExportCode(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExportType", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.ExportType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExportType |= ExportFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationGalleryImport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GalleryImport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GalleryImport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProcessId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31355,11 +33578,30 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.ProcessId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= ImportErrorCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31387,11 +33629,11 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31419,7 +33661,7 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -31474,7 +33716,7 @@ func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationTest) Unmarshal(dAtA []byte) error {
+func (m *NotificationRequestToJoin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31497,65 +33739,47 @@ func (m *NotificationTest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Test: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestToJoin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Test: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestToJoin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantRequestApproved: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantRequestApproved: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31583,13 +33807,13 @@ func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
 			}
-			m.Permissions = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -31599,14 +33823,59 @@ func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permissions |= ParticipantPermissions(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31657,7 +33926,7 @@ func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
+func (m *NotificationTest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31680,79 +33949,65 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RequestToLeave: wiretype end group for non-group")
+			return fmt.Errorf("proto: Test: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RequestToLeave: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Test: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthModels
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationParticipantRequestApproved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParticipantRequestApproved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParticipantRequestApproved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31780,13 +34035,13 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
 			}
-			var stringLen uint64
+			m.Permissions = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -31796,24 +34051,11 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Permissions |= ParticipantPermissions(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
@@ -31867,7 +34109,7 @@ func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
+func (m *NotificationRequestToLeave) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -31890,15 +34132,15 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantRemove: wiretype end group for non-group")
+			return fmt.Errorf("proto: RequestToLeave: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantRemove: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RequestToLeave: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31926,11 +34168,11 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Identity = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31958,11 +34200,11 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityName = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -31990,11 +34232,11 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
+			m.IdentityName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32022,7 +34264,7 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
@@ -32077,7 +34319,7 @@ func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
+func (m *NotificationParticipantRemove) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32100,15 +34342,15 @@ func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantRequestDecline: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantRemove: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantRequestDecline: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantRemove: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32136,11 +34378,11 @@ func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.Identity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32168,61 +34410,11 @@ func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			m.IdentityName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthModels
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ParticipantPermissionsChange: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParticipantPermissionsChange: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIcon", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32250,13 +34442,13 @@ func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.IdentityIcon = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			m.Permissions = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -32266,12 +34458,25 @@ func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Permissions |= ParticipantPermissions(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
 			}
@@ -32324,7 +34529,7 @@ func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *Export) Unmarshal(dAtA []byte) error {
+func (m *NotificationParticipantRequestDecline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32347,62 +34552,76 @@ func (m *Export) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Export: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantRequestDecline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Export: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantRequestDecline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipModels(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Import) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowModels
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Import: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Import: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModels(dAtA[iNdEx:])
@@ -32424,7 +34643,7 @@ func (m *Import) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Invite) Unmarshal(dAtA []byte) error {
+func (m *NotificationParticipantPermissionsChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32447,17 +34666,17 @@ func (m *Invite) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Invite: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipantPermissionsChange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Invite: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipantPermissionsChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -32467,31 +34686,48 @@ func (m *Invite) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			m.Permissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permissions |= ParticipantPermissions(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -32501,25 +34737,23 @@ func (m *Invite) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -32542,7 +34776,7 @@ func (m *Invite) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InvitePayload) Unmarshal(dAtA []byte) error {
+func (m *NotificationReminder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32565,15 +34799,15 @@ func (m *InvitePayload) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InvitePayload: wiretype end group for non-group")
+			return fmt.Errorf("proto: Reminder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InvitePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Reminder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorIdentity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32601,11 +34835,11 @@ func (m *InvitePayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatorIdentity = string(dAtA[iNdEx:postIndex])
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32633,13 +34867,13 @@ func (m *InvitePayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatorName = string(dAtA[iNdEx:postIndex])
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InviteKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -32649,29 +34883,27 @@ func (m *InvitePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthModels
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthModels
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InviteKey = append(m.InviteKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.InviteKey == nil {
-				m.InviteKey = []byte{}
-			}
+			m.ObjectName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32699,13 +34931,13 @@ func (m *InvitePayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			m.RelationKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
-			var stringLen uint64
+			m.Date = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowModels
@@ -32715,27 +34947,14 @@ func (m *InvitePayload) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Date |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpaceName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceIconCid", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -32763,41 +34982,1222 @@ func (m *InvitePayload) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpaceIconCid = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpaceIconEncryptionKeys", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowModels
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthModels
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthModels
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SpaceIconEncryptionKeys = append(m.SpaceIconEncryptionKeys, &FileEncryptionKey{})
-			if err := m.SpaceIconEncryptionKeys[len(m.SpaceIconEncryptionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationBackup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCode", wireType)
+			}
+			m.ErrorCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorCode |= NotificationBackupCode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsIncremental", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsIncremental = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectsCount", wireType)
+			}
+			m.ObjectsCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObjectsCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NotificationComment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ObjectId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Identity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Identity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Text", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Text = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Export) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Export: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Export: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Backup) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Backup: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Backup: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupSettings) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Settings: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Settings: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxIncrements", wireType)
+			}
+			m.MaxIncrements = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxIncrements |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeepChains", wireType)
+			}
+			m.KeepChains = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeepChains |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludeArchived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IncludeArchived = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Import) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Import: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Import: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Invite) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Invite: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Invite: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InvitePayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InvitePayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InvitePayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorIdentity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorIdentity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InviteKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InviteKey = append(m.InviteKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.InviteKey == nil {
+				m.InviteKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceIconCid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceIconCid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpaceIconEncryptionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpaceIconEncryptionKeys = append(m.SpaceIconEncryptionKeys, &FileEncryptionKey{})
+			if err := m.SpaceIconEncryptionKeys[len(m.SpaceIconEncryptionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -35597,6 +38997,496 @@ func (m *ChatMessageReactionsIdentityList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Comment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Comment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Comment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThreadId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ThreadId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModifiedAt", wireType)
+			}
+			m.ModifiedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ModifiedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Message == nil {
+				m.Message = &ChatMessageMessageContent{}
+			}
+			if err := m.Message.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Anchor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Anchor == nil {
+				m.Anchor = &CommentAnchor{}
+			}
+			if err := m.Anchor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolved", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Resolved = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResolvedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommentAnchor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModels
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Anchor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Anchor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Range", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Range == nil {
+				m.Range = &Range{}
+			}
+			if err := m.Range.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModels
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModels
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModels
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModels(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModels
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipModels(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,289 @@
+// Package archivecrypt encrypts export archives with the password.
+//
+// The key is derived from the password with Argon2id using the random salt, so every archive has its own key.
+// The archive is encrypted with AES-256-GCM in chunks, the nonce of each chunk is its number with the flag
+// of the last chunk, so reordered, dropped or truncated chunks are detected. The header is authenticated
+// as the additional data of every chunk.
+package archivecrypt
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	version = 1
+
+	saltSize  = 16
+	keySize   = 32
+	chunkSize = 64 * 1024
+
+	// parameters of the key derivation, stored in the header of each archive
+	kdfTime    = 3
+	kdfMemory  = 64 * 1024 // KiB
+	kdfThreads = 4
+
+	// limits of the parameters read from the header, so the broken archive can't exhaust the memory
+	maxKdfTime    = 10
+	maxKdfMemory  = 1024 * 1024 // KiB
+	maxChunkSize  = 16 * 1024 * 1024
+	lastChunkFlag = 1
+)
+
+var magic = []byte("ANYTYPE-ENCRYPTED")
+
+var (
+	ErrPasswordRequired = errors.New("archive is encrypted, password is required")
+	ErrWrongPassword    = errors.New("wrong password or archive is corrupted")
+	ErrCorrupted        = errors.New("encrypted archive is corrupted")
+)
+
+type header struct {
+	kdfTime    uint32
+	kdfMemory  uint32
+	kdfThreads uint8
+	salt       [saltSize]byte
+	chunkSize  uint32
+}
+
+// headerSize is the size of the header following the magic
+const headerSize = 1 + 4 + 4 + 1 + saltSize + 4
+
+func (h *header) marshal() []byte {
+	buf := make([]byte, 0, len(magic)+headerSize)
+	buf = append(buf, magic...)
+	buf = append(buf, version)
+	buf = binary.BigEndian.AppendUint32(buf, h.kdfTime)
+	buf = binary.BigEndian.AppendUint32(buf, h.kdfMemory)
+	buf = append(buf, h.kdfThreads)
+	buf = append(buf, h.salt[:]...)
+	buf = binary.BigEndian.AppendUint32(buf, h.chunkSize)
+	return buf
+}
+
+func readHeader(r io.Reader) (*header, []byte, error) {
+	buf := make([]byte, len(magic)+headerSize)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, nil, ErrCorrupted
+	}
+	if !bytes.Equal(buf[:len(magic)], magic) {
+		return nil, nil, ErrCorrupted
+	}
+	data := buf[len(magic):]
+	if data[0] != version {
+		return nil, nil, fmt.Errorf("unsupported encrypted archive version: %d", data[0])
+	}
+	h := &header{
+		kdfTime:    binary.BigEndian.Uint32(data[1:5]),
+		kdfMemory:  binary.BigEndian.Uint32(data[5:9]),
+		kdfThreads: data[9],
+		chunkSize:  binary.BigEndian.Uint32(data[10+saltSize:]),
+	}
+	copy(h.salt[:], data[10:10+saltSize])
+	if h.kdfTime == 0 || h.kdfTime > maxKdfTime || h.kdfMemory == 0 || h.kdfMemory > maxKdfMemory ||
+		h.kdfThreads == 0 || h.chunkSize == 0 || h.chunkSize > maxChunkSize {
+		return nil, nil, ErrCorrupted
+	}
+	return h, buf, nil
+}
+
+func (h *header) aead(password string) (cipher.AEAD, error) {
+	key := argon2.IDKey([]byte(password), h.salt[:], h.kdfTime, h.kdfMemory, h.kdfThreads, keySize)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func chunkNonce(aead cipher.AEAD, counter uint64, last bool) []byte {
+	nonce := make([]byte, aead.NonceSize())
+	binary.BigEndian.PutUint64(nonce, counter)
+	if last {
+		nonce[len(nonce)-1] = lastChunkFlag
+	}
+	return nonce
+}
+
+// Encrypt writes the encrypted src to dst
+func Encrypt(dst io.Writer, src io.Reader, password string) error {
+	if password == "" {
+		return ErrPasswordRequired
+	}
+	h := &header{
+		kdfTime:    kdfTime,
+		kdfMemory:  kdfMemory,
+		kdfThreads: kdfThreads,
+		chunkSize:  chunkSize,
+	}
+	if _, err := rand.Read(h.salt[:]); err != nil {
+		return fmt.Errorf("generate salt: %w", err)
+	}
+	aead, err := h.aead(password)
+	if err != nil {
+		return err
+	}
+	additionalData := h.marshal()
+	if _, err = dst.Write(additionalData); err != nil {
+		return err
+	}
+
+	reader := bufio.NewReaderSize(src, chunkSize)
+	plaintext := make([]byte, chunkSize)
+	var sealed []byte
+	for counter := uint64(0); ; counter++ {
+		n, err := io.ReadFull(reader, plaintext)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+		last := err != nil
+		if !last {
+			if _, err = reader.Peek(1); errors.Is(err, io.EOF) {
+				last = true
+			} else if err != nil {
+				return err
+			}
+		}
+		sealed = aead.Seal(sealed[:0], chunkNonce(aead, counter, last), plaintext[:n], additionalData)
+		if _, err = dst.Write(sealed); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// Decrypt writes the decrypted src to dst. ErrWrongPassword is returned if the first chunk can't be decrypted
+func Decrypt(dst io.Writer, src io.Reader, password string) error {
+	if password == "" {
+		return ErrPasswordRequired
+	}
+	h, additionalData, err := readHeader(src)
+	if err != nil {
+		return err
+	}
+	aead, err := h.aead(password)
+	if err != nil {
+		return err
+	}
+
+	reader := bufio.NewReaderSize(src, int(h.chunkSize)+aead.Overhead())
+	ciphertext := make([]byte, int(h.chunkSize)+aead.Overhead())
+	var plaintext []byte
+	for counter := uint64(0); ; counter++ {
+		n, err := io.ReadFull(reader, ciphertext)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return err
+		}
+		last := err != nil
+		if !last {
+			if _, err = reader.Peek(1); errors.Is(err, io.EOF) {
+				last = true
+			} else if err != nil {
+				return err
+			}
+		}
+		plaintext, err = aead.Open(plaintext[:0], chunkNonce(aead, counter, last), ciphertext[:n], additionalData)
+		if err != nil {
+			if counter == 0 {
+				return ErrWrongPassword
+			}
+			return ErrCorrupted
+		}
+		if _, err = dst.Write(plaintext); err != nil {
+			return err
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// IsEncrypted checks the header of the file, directories and missing files are not encrypted
+func IsEncrypted(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	buf := make([]byte, len(magic))
+	if _, err = io.ReadFull(f, buf); err != nil {
+		return false
+	}
+	return bytes.Equal(buf, magic)
+}
+
+// EncryptFile writes the encrypted copy of the src file to dst
+func EncryptFile(src, dst, password string) error {
+	return processFile(src, dst, func(w io.Writer, r io.Reader) error {
+		return Encrypt(w, r, password)
+	})
+}
+
+// DecryptFile writes the decrypted copy of the src file to dst
+func DecryptFile(src, dst, password string) error {
+	return processFile(src, dst, func(w io.Writer, r io.Reader) error {
+		return Decrypt(w, r, password)
+	})
+}
+
+// DecryptedPath returns the path of the decrypted copy of the archive created in the tempDir if the archive is
+// encrypted, otherwise the path itself is returned. The returned function removes the decrypted copy
+func DecryptedPath(path, tempDir, password string) (string, func(), error) {
+	if !IsEncrypted(path) {
+		return path, func() {}, nil
+	}
+	if password == "" {
+		return "", func() {}, ErrPasswordRequired
+	}
+	f, err := os.CreateTemp(tempDir, "decrypted_*.zip")
+	if err != nil {
+		return "", func() {}, err
+	}
+	decryptedPath := f.Name()
+	f.Close()
+	cleanup := func() {
+		os.Remove(decryptedPath)
+	}
+	if err = DecryptFile(path, decryptedPath, password); err != nil {
+		cleanup()
+		return "", func() {}, err
+	}
+	return decryptedPath, cleanup, nil
+}
+
+func processFile(src, dst string, process func(w io.Writer, r io.Reader) error) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			os.Remove(dst)
+		}
+	}()
+	writer := bufio.NewWriter(out)
+	if err = process(writer, in); err != nil {
+		return err
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,92 @@
+package archivecrypt
+
+import (
+	"bytes"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	for _, size := range []int{0, 10, chunkSize, 2*chunkSize + 7} {
+		data := make([]byte, size)
+		_, err := rand.Read(data)
+		require.NoError(t, err)
+
+		var encrypted bytes.Buffer
+		require.NoError(t, Encrypt(&encrypted, bytes.NewReader(data), "secret"))
+		assert.True(t, bytes.HasPrefix(encrypted.Bytes(), magic))
+
+		var decrypted bytes.Buffer
+		require.NoError(t, Decrypt(&decrypted, bytes.NewReader(encrypted.Bytes()), "secret"))
+		assert.True(t, bytes.Equal(data, decrypted.Bytes()))
+	}
+}
+
+func TestDecrypt(t *testing.T) {
+	data := bytes.Repeat([]byte("anytype"), chunkSize/3)
+	var encrypted bytes.Buffer
+	require.NoError(t, Encrypt(&encrypted, bytes.NewReader(data), "secret"))
+
+	t.Run("wrong password", func(t *testing.T) {
+		err := Decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()), "wrong")
+		assert.ErrorIs(t, err, ErrWrongPassword)
+	})
+	t.Run("no password", func(t *testing.T) {
+		err := Decrypt(&bytes.Buffer{}, bytes.NewReader(encrypted.Bytes()), "")
+		assert.ErrorIs(t, err, ErrPasswordRequired)
+	})
+	t.Run("truncated", func(t *testing.T) {
+		truncated := encrypted.Bytes()[:len(magic)+headerSize+2*(chunkSize+16)]
+		err := Decrypt(&bytes.Buffer{}, bytes.NewReader(truncated), "secret")
+		assert.ErrorIs(t, err, ErrCorrupted)
+	})
+	t.Run("modified", func(t *testing.T) {
+		modified := bytes.Clone(encrypted.Bytes())
+		modified[len(modified)-1] ^= 1
+		err := Decrypt(&bytes.Buffer{}, bytes.NewReader(modified), "secret")
+		assert.ErrorIs(t, err, ErrCorrupted)
+	})
+}
+
+func TestDecryptedPath(t *testing.T) {
+	dir := t.TempDir()
+	plainPath := filepath.Join(dir, "export.zip")
+	require.NoError(t, os.WriteFile(plainPath, []byte("zip content"), 0600))
+	encryptedPath := filepath.Join(dir, "encrypted.zip")
+	require.NoError(t, EncryptFile(plainPath, encryptedPath, "secret"))
+
+	t.Run("not encrypted", func(t *testing.T) {
+		path, cleanup, err := DecryptedPath(plainPath, dir, "")
+		require.NoError(t, err)
+		defer cleanup()
+		assert.Equal(t, plainPath, path)
+		assert.False(t, IsEncrypted(plainPath))
+	})
+	t.Run("encrypted", func(t *testing.T) {
+		path, cleanup, err := DecryptedPath(encryptedPath, dir, "secret")
+		require.NoError(t, err)
+		assert.Equal(t, ".zip", filepath.Ext(path))
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "zip content", string(data))
+
+		cleanup()
+		assert.NoFileExists(t, path)
+	})
+	t.Run("no password", func(t *testing.T) {
+		_, _, err := DecryptedPath(encryptedPath, dir, "")
+		assert.ErrorIs(t, err, ErrPasswordRequired)
+	})
+	t.Run("wrong password", func(t *testing.T) {
+		_, _, err := DecryptedPath(encryptedPath, dir, "wrong")
+		assert.ErrorIs(t, err, ErrWrongPassword)
+		entries, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Len(t, entries, 2)
+	})
+}
@@ -62,3 +62,15 @@ func (do dateObject) Name() string {
 func (do dateObject) Time() time.Time {
 	return do.t
 }
+
+// DateOnly returns the midnight of the date without time in the given location. Whether the date has time
+// is defined by the relation, see bundle.RelationKeyRelationFormatIncludeTime. Dates without time are stored as midnight
+// in UTC or in the local time of the client, so the date of the midnight in UTC is kept as is
+func DateOnly(ts int64, loc *time.Location) time.Time {
+	t := time.Unix(ts, 0).UTC()
+	if t.Hour() != 0 || t.Minute() != 0 || t.Second() != 0 {
+		t = t.In(loc)
+	}
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, loc)
+}
@@ -120,3 +120,22 @@ func TestBuildDateObjectFromId(t *testing.T) {
 	})
 
 }
+
+func TestDateOnly(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	for _, tc := range []struct {
+		name     string
+		ts       time.Time
+		expected time.Time
+	}{
+		{name: "midnight in UTC", ts: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), expected: time.Date(2024, 5, 1, 0, 0, 0, 0, loc)},
+		{name: "midnight in the location", ts: time.Date(2024, 5, 1, 0, 0, 0, 0, loc), expected: time.Date(2024, 5, 1, 0, 0, 0, 0, loc)},
+		{name: "date with time is truncated", ts: time.Date(2024, 5, 1, 21, 30, 0, 0, loc), expected: time.Date(2024, 5, 1, 0, 0, 0, 0, loc)},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			date := DateOnly(tc.ts.Unix(), loc)
+			assert.True(t, tc.expected.Equal(date))
+			assert.Equal(t, loc, date.Location())
+		})
+	}
+}